@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"log"
 	"net/http"
 	"os"
@@ -12,9 +13,12 @@ import (
 	"github.com/demola234/api_gateway/config"
 	"github.com/demola234/api_gateway/infrastructure/grpc_clients"
 	"github.com/demola234/api_gateway/infrastructure/middleware"
+	"github.com/demola234/api_gateway/infrastructure/middleware/apikey"
+	"github.com/demola234/api_gateway/infrastructure/middleware/revocation"
 	"github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
 	"github.com/demola234/api_gateway/internal/handler"
 	routes "github.com/demola234/api_gateway/routes"
+	"github.com/demola234/shared/rbac"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,19 +30,25 @@ func main() {
 		log.Fatalf("Failed to load env file: %v", err)
 	}
 
+	// Calls to the services are signed with the gateway's own key and carry the signed in user's access token
+	serviceCredentials, err := middleware.NewServiceCredentials(middleware.GatewayService, configs.ServiceSecretKey)
+	if err != nil {
+		log.Fatalf("Invalid service secret key: %v", err)
+	}
+
 	// Initialize gRPC client with dynamic address
-	authClient, err := grpc_clients.NewAuthenticationClient("127.0.0.1:9091", 20*time.Second)
+	authClient, err := grpc_clients.NewAuthenticationClient("127.0.0.1:9091", 20*time.Second, serviceCredentials)
 	if err != nil {
 		log.Fatalf("Failed to connect to Authentication service: %v", err)
 	}
 	defer authClient.Close() // Ensure gRPC connection is closed on shutdown
 
-	// propertyClient, err := grpc_clients.NewPropertyClient("127.0.0.1:9092", 20*time.Second)
+	// propertyClient, err := grpc_clients.NewPropertyClient("127.0.0.1:9092", 20*time.Second, serviceCredentials)
 	// if err != nil {
 	// 	log.Fatalf("Failed to connect to Property service: %v", err)
 	// }
 
-	// messageClient, err := grpc_clients.NewMessagingClient("127.0.0.1:9093", 20*time.Second)
+	// messageClient, err := grpc_clients.NewMessagingClient("127.0.0.1:9093", 20*time.Second, serviceCredentials)
 	// if err != nil {
 	// 	log.Fatalf("Failed to connect to Property service: %v", err)
 	// }
@@ -46,15 +56,42 @@ func main() {
 	// Create a new Gin router
 	router := gin.Default()
 
-	tokenMaker, err := token_maker.NewTokenMaker(configs.TokenSymmetricKey)
+	// Only the proxies in front of the gateway may set the client IP, which
+	// lockouts and rate limits are keyed on
+	if err := router.SetTrustedProxies(configs.TrustedProxies); err != nil {
+		log.Fatalf("Invalid trusted proxies: %v", err)
+	}
+
+	// Tokens are verified against the public keys the authentication service publishes
+	tokenKeys := token_maker.NewRemoteKeySet(configs.TokenKeysURL, http.DefaultClient, configs.TokenKeysCacheTTL)
+
+	// Revoked sessions are looked up in the authentication database and cached until it notifies a change
+	authDB, err := sql.Open("postgres", configs.AuthDBSource)
 	if err != nil {
-		panic("failed to create token maker: " + err.Error())
+		log.Fatalf("Failed to connect to authentication database: %v", err)
 	}
+	defer authDB.Close()
+
+	revocations := revocation.NewList(authDB, configs.RevocationCacheSize)
+	go func() {
+		if err := revocations.Listen(context.Background(), configs.AuthDBSource); err != nil {
+			log.Printf("Failed to listen for session revocations: %v", err)
+		}
+	}()
+
+	tokenVerifier := token_maker.NewPasetoV4Verifier(tokenKeys)
+	authMiddleware := middleware.AuthMiddleware(tokenVerifier, revocations, nil)
 
-	authMiddleware := middleware.AuthMiddleware(tokenMaker)
+	// Integrations may send an API key instead of an access token on the routes
+	// that name a scope the key must grant
+	apiKeys := apikey.NewExchanger(authClient.ApiKeys, configs.APIKeyCacheSize)
+	organizationReadMiddleware := middleware.AuthMiddleware(tokenVerifier, revocations, apiKeys, rbac.PermOrganizationRead)
 
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(authClient)
+	adminHandler := handler.NewAdminHandler(authClient)
+	organizationHandler := handler.NewOrganizationHandler(authClient)
+	apiKeyHandler := handler.NewAPIKeyHandler(authClient)
 	// propertyHandler := handler.NewPropertyHandler(propertyClient)
 	// messageHandler := handler.NewMessageHandler(messageClient)
 
@@ -71,6 +108,9 @@ func main() {
 
 	// Define authentication routes
 	routes.RegisterRoutes(v1, authHandler, authMiddleware)
+	routes.RegisterAdminRoutes(v1, adminHandler, authMiddleware)
+	routes.RegisterOrganizationRoutes(v1, organizationHandler, authMiddleware, organizationReadMiddleware)
+	routes.RegisterAPIKeyRoutes(v1, apiKeyHandler, authMiddleware)
 	// routes.RegisterPropertyRoutes(v1, propertyHandler, authMiddleware)
 	// routes.RegisterMessageRoutes(v1, messageHandler, authMiddleware)
 
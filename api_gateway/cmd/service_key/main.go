@@ -0,0 +1,32 @@
+// Command service_key generates the Ed25519 key pair a service signs its calls
+// to the other services with. The secret key goes in the service's own
+// SERVICE_SECRET_KEY and the public entry in TRUSTED_SERVICE_KEYS of every
+// service it calls.
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"log"
+
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+)
+
+func main() {
+	service := flag.String("service", "", "name of the service the key is for, such as api_gateway")
+	flag.Parse()
+
+	if *service == "" {
+		log.Fatal("-service is required")
+	}
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Fatalf("failed to generate key: %v", err)
+	}
+
+	fmt.Printf("SERVICE_SECRET_KEY=%s\n", token.EncodeSecretKey(privateKey))
+	fmt.Printf("TRUSTED_SERVICE_KEYS entry: %s=%s\n", *service, token.EncodePublicKey(publicKey))
+}
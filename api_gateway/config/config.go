@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 )
 
@@ -9,7 +11,23 @@ type Config struct {
 	AuthenticationGRPCAddress string `mapstructure:"AUTH_GRPC_ADDRESS"`
 	SentryConfig              string `mapstructure:"SENTRY_CONFIG"`
 	SentryConst               string `mapstructure:"SENTRY_CONST"`
-	TokenSymmetricKey         string `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+
+	// Proxies allowed to report the client's IP through X-Forwarded-For; none when empty
+	TrustedProxies []string `mapstructure:"TRUSTED_PROXIES"`
+
+	// Public keys access tokens are verified with, published by the authentication service
+	TokenKeysURL      string        `mapstructure:"TOKEN_KEYS_URL"`
+	TokenKeysCacheTTL time.Duration `mapstructure:"TOKEN_KEYS_CACHE_TTL"`
+
+	// Revocation list kept by the authentication service
+	AuthDBSource        string `mapstructure:"AUTH_DB_SOURCE"`
+	RevocationCacheSize int    `mapstructure:"REVOCATION_CACHE_SIZE"`
+
+	// The gateway's own k4.secret key, which signs every call it makes to the services
+	ServiceSecretKey string `mapstructure:"SERVICE_SECRET_KEY"`
+
+	// Access tokens exchanged for API keys, cached per key until shortly before they expire
+	APIKeyCacheSize int `mapstructure:"API_KEY_CACHE_SIZE"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
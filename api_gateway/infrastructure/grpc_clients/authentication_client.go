@@ -6,23 +6,30 @@ import (
 	"log"
 	"time"
 
+	"github.com/demola234/api_gateway/infrastructure/middleware"
 	pb "github.com/demola234/authentication/infrastructure/api/grpc"
 
 	"google.golang.org/grpc"
 )
 
 type AuthenticationClient struct {
-	Client pb.AuthServiceClient
-	conn   *grpc.ClientConn
+	Client       pb.AuthServiceClient
+	Admin        pb.AdminServiceClient
+	Organization pb.OrganizationServiceClient
+	ApiKeys      pb.ApiKeyServiceClient
+	conn         *grpc.ClientConn
 }
 
 // NewAuthenticationClient creates a new gRPC client for the Authentication service
-func NewAuthenticationClient(address string, timeout time.Duration) (*AuthenticationClient, error) {
+func NewAuthenticationClient(address string, timeout time.Duration, credentials *middleware.ServiceCredentials) (*AuthenticationClient, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	// Establish the connection with timeout
-	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	// Establish the connection with timeout; every call carries the gateway's
+	// signed identity and the access token of the user it is made for
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(credentials.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(credentials.StreamClientInterceptor()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to gRPC server at %s: %w", address, err)
 	}
@@ -31,8 +38,11 @@ func NewAuthenticationClient(address string, timeout time.Duration) (*Authentica
 	client := pb.NewAuthServiceClient(conn)
 
 	return &AuthenticationClient{
-		Client: client,
-		conn:   conn,
+		Client:       client,
+		Admin:        pb.NewAdminServiceClient(conn),
+		Organization: pb.NewOrganizationServiceClient(conn),
+		ApiKeys:      pb.NewApiKeyServiceClient(conn),
+		conn:         conn,
 	}, nil
 }
 
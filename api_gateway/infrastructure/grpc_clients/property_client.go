@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/demola234/api_gateway/infrastructure/middleware"
 	pb "github.com/demola234/property/infrastructure/api/grpc"
 
 	"google.golang.org/grpc"
@@ -17,12 +18,15 @@ type PropertyClient struct {
 }
 
 // NewAuthenticationClient creates a new gRPC client for the Authentication service
-func NewPropertyClient(address string, timeout time.Duration) (*PropertyClient, error) {
+func NewPropertyClient(address string, timeout time.Duration, credentials *middleware.ServiceCredentials) (*PropertyClient, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	// Establish the connection with timeout
-	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	// Establish the connection with timeout; every call carries the gateway's
+	// signed identity and the access token of the user it is made for
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(credentials.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(credentials.StreamClientInterceptor()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to gRPC server at %s: %w", address, err)
 	}
@@ -0,0 +1,107 @@
+package apikey
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+)
+
+// refreshBefore is how long before its expiry a cached access token is
+// exchanged again, so requests are not sent with a token about to expire
+const refreshBefore = 30 * time.Second
+
+// Exchanger trades the API keys sent by integrations for short-lived access
+// tokens from the authentication service. Tokens are cached per key until
+// shortly before they expire; revoking a key revokes its cached tokens through
+// the revocation list, so the cache never keeps a revoked key working.
+type Exchanger struct {
+	client pb.ApiKeyServiceClient
+	now    func() time.Time
+
+	mu      sync.Mutex
+	size    int
+	entries map[string]cachedToken
+}
+
+type cachedToken struct {
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewExchanger creates an exchanger that caches tokens for up to cacheSize keys.
+func NewExchanger(client pb.ApiKeyServiceClient, cacheSize int) *Exchanger {
+	return &Exchanger{
+		client:  client,
+		now:     time.Now,
+		size:    cacheSize,
+		entries: make(map[string]cachedToken, cacheSize),
+	}
+}
+
+// AuthenticateAPIKey returns an access token for the key, exchanging it with
+// the authentication service unless a cached token is still fresh.
+func (e *Exchanger) AuthenticateAPIKey(ctx context.Context, apiKey string) (string, error) {
+	// Only a hash of the key is kept in memory
+	sum := sha256.Sum256([]byte(apiKey))
+	cacheKey := hex.EncodeToString(sum[:])
+
+	if accessToken, ok := e.get(cacheKey); ok {
+		return accessToken, nil
+	}
+
+	res, err := e.client.AuthenticateApiKey(ctx, &pb.AuthenticateApiKeyRequest{ApiKey: apiKey})
+	if err != nil {
+		return "", err
+	}
+
+	e.add(cacheKey, cachedToken{
+		accessToken: res.AccessToken,
+		expiresAt:   res.AccessTokenExpiresAt.AsTime(),
+	})
+
+	return res.AccessToken, nil
+}
+
+func (e *Exchanger) get(cacheKey string) (string, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	entry, ok := e.entries[cacheKey]
+	if !ok {
+		return "", false
+	}
+	if !e.now().Add(refreshBefore).Before(entry.expiresAt) {
+		delete(e.entries, cacheKey)
+		return "", false
+	}
+
+	return entry.accessToken, true
+}
+
+func (e *Exchanger) add(cacheKey string, entry cachedToken) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if len(e.entries) >= e.size {
+		e.evictExpired()
+	}
+	// Tokens are short-lived, so starting over is cheaper than tracking use
+	if len(e.entries) >= e.size {
+		e.entries = make(map[string]cachedToken, e.size)
+	}
+
+	e.entries[cacheKey] = entry
+}
+
+func (e *Exchanger) evictExpired() {
+	now := e.now()
+	for cacheKey, entry := range e.entries {
+		if !now.Add(refreshBefore).Before(entry.expiresAt) {
+			delete(e.entries, cacheKey)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeAPIKeyService stands in for the authentication service
+type fakeAPIKeyService struct {
+	pb.ApiKeyServiceClient
+	exchanges int
+	expiresAt time.Time
+	err       error
+}
+
+func (f *fakeAPIKeyService) AuthenticateApiKey(ctx context.Context, in *pb.AuthenticateApiKeyRequest, opts ...grpc.CallOption) (*pb.AuthenticateApiKeyResponse, error) {
+	f.exchanges++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &pb.AuthenticateApiKeyResponse{
+		AccessToken:          "token-for-" + in.ApiKey,
+		AccessTokenExpiresAt: timestamppb.New(f.expiresAt),
+	}, nil
+}
+
+func TestAuthenticateAPIKeyCachesTokens(t *testing.T) {
+	now := time.Now()
+	service := &fakeAPIKeyService{expiresAt: now.Add(5 * time.Minute)}
+	exchanger := NewExchanger(service, 10)
+	exchanger.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	accessToken, err := exchanger.AuthenticateAPIKey(ctx, "rk_one")
+	require.NoError(t, err)
+	require.Equal(t, "token-for-rk_one", accessToken)
+
+	accessToken, err = exchanger.AuthenticateAPIKey(ctx, "rk_one")
+	require.NoError(t, err)
+	require.Equal(t, "token-for-rk_one", accessToken)
+	require.Equal(t, 1, service.exchanges)
+
+	// Another key is exchanged separately
+	accessToken, err = exchanger.AuthenticateAPIKey(ctx, "rk_two")
+	require.NoError(t, err)
+	require.Equal(t, "token-for-rk_two", accessToken)
+	require.Equal(t, 2, service.exchanges)
+}
+
+func TestAuthenticateAPIKeyRefreshesBeforeExpiry(t *testing.T) {
+	now := time.Now()
+	service := &fakeAPIKeyService{expiresAt: now.Add(time.Minute)}
+	exchanger := NewExchanger(service, 10)
+	exchanger.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, err := exchanger.AuthenticateAPIKey(ctx, "rk_one")
+	require.NoError(t, err)
+
+	now = now.Add(time.Minute - refreshBefore)
+	_, err = exchanger.AuthenticateAPIKey(ctx, "rk_one")
+	require.NoError(t, err)
+	require.Equal(t, 2, service.exchanges)
+}
+
+func TestAuthenticateAPIKeyDoesNotCacheFailures(t *testing.T) {
+	service := &fakeAPIKeyService{err: errors.New("api key is invalid, expired or revoked")}
+	exchanger := NewExchanger(service, 10)
+	ctx := context.Background()
+
+	_, err := exchanger.AuthenticateAPIKey(ctx, "rk_one")
+	require.Error(t, err)
+	_, err = exchanger.AuthenticateAPIKey(ctx, "rk_one")
+	require.Error(t, err)
+	require.Equal(t, 2, service.exchanges)
+}
+
+func TestAuthenticateAPIKeyBoundsCache(t *testing.T) {
+	service := &fakeAPIKeyService{expiresAt: time.Now().Add(5 * time.Minute)}
+	exchanger := NewExchanger(service, 2)
+	ctx := context.Background()
+
+	for _, key := range []string{"rk_one", "rk_two", "rk_three"} {
+		_, err := exchanger.AuthenticateAPIKey(ctx, key)
+		require.NoError(t, err)
+	}
+
+	require.LessOrEqual(t, len(exchanger.entries), 2)
+}
@@ -1,11 +1,13 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	interfaces "github.com/demola234/api_gateway/infrastructure/error_response"
 	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	"github.com/demola234/shared/rbac"
 
 	"net/http"
 	"strings"
@@ -16,10 +18,29 @@ import (
 const (
 	authorizationHeader     = "authorization"
 	authorizationBearer     = "bearer"
+	authorizationAPIKey     = "apikey"
 	authorizationPayloadKey = "authorization_payload"
+	authorizationTokenKey   = "authorization_token"
 )
 
-func AuthMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+// RevocationChecker reports whether an access token's session was revoked
+// after the token was issued
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, payload *token.Payload) (bool, error)
+}
+
+// APIKeyAuthenticator exchanges an integration's API key for an access token
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, apiKey string) (string, error)
+}
+
+// AuthMiddleware accepts requests carrying a valid access token whose session
+// has not been revoked, logged out, deactivated or deleted. When apiKeys is
+// set, requests may instead carry an API key ("ApiKey <key>"), which is
+// exchanged for an access token and then checked the same way. Tokens
+// exchanged for an API key are only accepted when scopes are given and the
+// key grants every one of them.
+func AuthMiddleware(tokenVerifier token.Verifier, revocations RevocationChecker, apiKeys APIKeyAuthenticator, scopes ...rbac.Permission) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authHeader := ctx.GetHeader(authorizationHeader)
 		if len(authHeader) == 0 {
@@ -35,21 +56,71 @@ func AuthMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 			return
 		}
 
+		accessToken := stringSplit[1]
 		authType := strings.ToLower(stringSplit[0])
-		if authType != authorizationBearer {
+		switch {
+		case authType == authorizationBearer:
+		case authType == authorizationAPIKey && apiKeys != nil:
+			var err error
+			accessToken, err = apiKeys.AuthenticateAPIKey(ctx.Request.Context(), accessToken)
+			if err != nil {
+				err := fmt.Errorf("invalid api key: %w", err)
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, interfaces.ErrorResponse(err, http.StatusUnauthorized))
+				return
+			}
+
+		default:
 			err := fmt.Errorf("unsupported authorization type %s", authType)
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, interfaces.ErrorResponse(err, http.StatusUnauthorized))
 			return
 		}
 
-		accessToken := stringSplit[1]
-		payload, err := tokenMaker.VerifyToken(accessToken)
+		payload, err := tokenVerifier.VerifyToken(accessToken)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, interfaces.ErrorResponse(err, http.StatusUnauthorized))
+			return
+		}
+
+		// Tokens that cannot be revoked are not accepted
+		if payload.SessionID == "" {
+			err := errors.New("token is not bound to a session")
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, interfaces.ErrorResponse(err, http.StatusUnauthorized))
+			return
+		}
+
+		revoked, err := revocations.IsRevoked(ctx.Request.Context(), payload)
 		if err != nil {
+			err := fmt.Errorf("failed to check token revocation: %w", err)
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, interfaces.ErrorResponse(err, http.StatusServiceUnavailable))
+			return
+		}
+		if revoked {
+			err := errors.New("token has been revoked")
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, interfaces.ErrorResponse(err, http.StatusUnauthorized))
 			return
 		}
 
+		if payload.IsAPIKey() {
+			if apiKeys == nil || len(scopes) == 0 {
+				ctx.AbortWithStatusJSON(http.StatusForbidden, interfaces.ErrorResponse(errAPIKeyForbidden, http.StatusForbidden))
+				return
+			}
+			for _, scope := range scopes {
+				if !payload.HasPermission(scope) {
+					err := fmt.Errorf("api key is missing scope %s", scope)
+					ctx.AbortWithStatusJSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+					return
+				}
+			}
+		}
+
+		if payload.IsImpersonation() {
+			auditImpersonation(ctx, payload)
+		}
+
+		// Handlers forward the access token so the services can verify the user themselves
 		ctx.Set(authorizationPayloadKey, payload)
+		ctx.Set(authorizationTokenKey, accessToken)
 		ctx.Next()
 	}
 }
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	"github.com/demola234/shared/rbac"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -29,18 +31,56 @@ func (m *MockTokenMaker) VerifyToken(token string) (*token_maker.Payload, error)
 }
 
 // Mock the CreateToken method to satisfy the token.Maker interface
-func (m *MockTokenMaker) CreateToken(email string, userID string, duration time.Duration) (string, *token_maker.Payload, error) {
-	args := m.Called(email, userID, duration)
+func (m *MockTokenMaker) CreateToken(email string, userID string, sessionID string, roles []string, duration time.Duration) (string, *token_maker.Payload, error) {
+	args := m.Called(email, userID, sessionID, roles, duration)
 	if payload, ok := args.Get(1).(*token_maker.Payload); ok {
 		return args.String(0), payload, args.Error(2)
 	}
 	return args.String(0), nil, args.Error(2)
 }
 
+// Mock the CreateImpersonationToken method to satisfy the token.Maker interface
+func (m *MockTokenMaker) CreateImpersonationToken(impersonatorID string, email string, userID string, sessionID string, roles []string, duration time.Duration) (string, *token_maker.Payload, error) {
+	args := m.Called(impersonatorID, email, userID, sessionID, roles, duration)
+	if payload, ok := args.Get(1).(*token_maker.Payload); ok {
+		return args.String(0), payload, args.Error(2)
+	}
+	return args.String(0), nil, args.Error(2)
+}
+
+// Mock the CreateOrganizationToken method to satisfy the token.Maker interface
+func (m *MockTokenMaker) CreateOrganizationToken(email string, userID string, sessionID string, roles []string, organizationID string, organizationRole string, duration time.Duration) (string, *token_maker.Payload, error) {
+	args := m.Called(email, userID, sessionID, roles, organizationID, organizationRole, duration)
+	if payload, ok := args.Get(1).(*token_maker.Payload); ok {
+		return args.String(0), payload, args.Error(2)
+	}
+	return args.String(0), nil, args.Error(2)
+}
+
+// Mock the CreateAPIKeyToken method to satisfy the token.Maker interface
+func (m *MockTokenMaker) CreateAPIKeyToken(apiKeyID string, email string, userID string, roles []string, scopes []string, organizationID string, organizationRole string, duration time.Duration) (string, *token_maker.Payload, error) {
+	args := m.Called(apiKeyID, email, userID, roles, scopes, organizationID, organizationRole, duration)
+	if payload, ok := args.Get(1).(*token_maker.Payload); ok {
+		return args.String(0), payload, args.Error(2)
+	}
+	return args.String(0), nil, args.Error(2)
+}
+
+// MockRevocationChecker is a mock for the RevocationChecker interface
+type MockRevocationChecker struct {
+	mock.Mock
+}
+
+func (m *MockRevocationChecker) IsRevoked(ctx context.Context, payload *token_maker.Payload) (bool, error) {
+	args := m.Called(payload.SessionID)
+	return args.Bool(0), args.Error(1)
+}
+
 func TestAuthMiddleware(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	mockTokenMaker := new(MockTokenMaker)
-	authMiddleware := AuthMiddleware(mockTokenMaker)
+	mockRevocations := new(MockRevocationChecker)
+	authMiddleware := AuthMiddleware(mockTokenMaker, mockRevocations, nil)
 
 	router := gin.New()
 	router.Use(authMiddleware)
@@ -94,9 +134,51 @@ func TestAuthMiddleware(t *testing.T) {
 		mockTokenMaker.AssertExpectations(t)
 	})
 
+	t.Run("token without session", func(t *testing.T) {
+		payload := &token_maker.Payload{Email: "12345", UserID: "12345"}
+		mockTokenMaker.On("VerifyToken", "unbound_token").Return(payload, nil).Once()
+
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set(authorizationHeader, "bearer unbound_token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "token is not bound to a session")
+	})
+
+	t.Run("revoked session", func(t *testing.T) {
+		payload := &token_maker.Payload{Email: "12345", UserID: "12345", SessionID: "revoked-session"}
+		mockTokenMaker.On("VerifyToken", "revoked_token").Return(payload, nil).Once()
+		mockRevocations.On("IsRevoked", "revoked-session").Return(true, nil).Once()
+
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set(authorizationHeader, "bearer revoked_token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "token has been revoked")
+		mockRevocations.AssertExpectations(t)
+	})
+
+	t.Run("revocation list unavailable", func(t *testing.T) {
+		payload := &token_maker.Payload{Email: "12345", UserID: "12345", SessionID: "unknown-session"}
+		mockTokenMaker.On("VerifyToken", "unchecked_token").Return(payload, nil).Once()
+		mockRevocations.On("IsRevoked", "unknown-session").Return(false, errors.New("database unavailable")).Once()
+
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set(authorizationHeader, "bearer unchecked_token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
 	t.Run("valid token", func(t *testing.T) {
-		payload := &token_maker.Payload{Email: "12345", UserID: "12345"} // Replace 'ID' with the actual field name
+		payload := &token_maker.Payload{Email: "12345", UserID: "12345", SessionID: "active-session"} // Replace 'ID' with the actual field name
 		mockTokenMaker.On("VerifyToken", "valid_token").Return(payload, nil).Once()
+		mockRevocations.On("IsRevoked", "active-session").Return(false, nil).Once()
 
 		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
 		req.Header.Set(authorizationHeader, "bearer valid_token")
@@ -108,3 +190,200 @@ func TestAuthMiddleware(t *testing.T) {
 		mockTokenMaker.AssertExpectations(t)
 	})
 }
+
+// MockAPIKeyAuthenticator is a mock for the APIKeyAuthenticator interface
+type MockAPIKeyAuthenticator struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyAuthenticator) AuthenticateAPIKey(ctx context.Context, apiKey string) (string, error) {
+	args := m.Called(apiKey)
+	return args.String(0), args.Error(1)
+}
+
+func TestAuthMiddlewareAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockTokenMaker := new(MockTokenMaker)
+	mockRevocations := new(MockRevocationChecker)
+	mockAPIKeys := new(MockAPIKeyAuthenticator)
+
+	handler := func(ctx *gin.Context) {
+		payload := ctx.MustGet(authorizationPayloadKey).(*token_maker.Payload)
+		ctx.JSON(http.StatusOK, gin.H{"api_key_id": payload.APIKeyID, "access_token": ctx.GetString(authorizationTokenKey)})
+	}
+
+	scopes := []string{string(rbac.PermPropertyRead)}
+
+	router := gin.New()
+	router.GET("/keys", AuthMiddleware(mockTokenMaker, mockRevocations, mockAPIKeys, rbac.PermPropertyRead), handler)
+	router.POST("/keys", AuthMiddleware(mockTokenMaker, mockRevocations, mockAPIKeys, rbac.PermPropertyRead), BlockImpersonation(), handler)
+	router.GET("/messages", AuthMiddleware(mockTokenMaker, mockRevocations, mockAPIKeys, rbac.PermMessageRead), handler)
+	router.GET("/unscoped", AuthMiddleware(mockTokenMaker, mockRevocations, mockAPIKeys), handler)
+	router.GET("/account", AuthMiddleware(mockTokenMaker, mockRevocations, nil), handler)
+
+	t.Run("api key is exchanged for an access token", func(t *testing.T) {
+		payload := &token_maker.Payload{UserID: "user-1", SessionID: "key-1", APIKeyID: "key-1", Scopes: scopes}
+		mockAPIKeys.On("AuthenticateAPIKey", "rk_valid").Return("key_token", nil).Once()
+		mockTokenMaker.On("VerifyToken", "key_token").Return(payload, nil).Once()
+		mockRevocations.On("IsRevoked", "key-1").Return(false, nil).Once()
+
+		req, _ := http.NewRequest(http.MethodGet, "/keys", nil)
+		req.Header.Set(authorizationHeader, "ApiKey rk_valid")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"api_key_id":"key-1"`)
+		assert.Contains(t, w.Body.String(), `"access_token":"key_token"`)
+	})
+
+	t.Run("invalid api key", func(t *testing.T) {
+		mockAPIKeys.On("AuthenticateAPIKey", "rk_revoked").Return("", errors.New("api key is invalid, expired or revoked")).Once()
+
+		req, _ := http.NewRequest(http.MethodGet, "/keys", nil)
+		req.Header.Set(authorizationHeader, "ApiKey rk_revoked")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid api key")
+	})
+
+	t.Run("revoked api key token", func(t *testing.T) {
+		payload := &token_maker.Payload{UserID: "user-1", SessionID: "key-2", APIKeyID: "key-2", Scopes: scopes}
+		mockAPIKeys.On("AuthenticateAPIKey", "rk_cached").Return("cached_token", nil).Once()
+		mockTokenMaker.On("VerifyToken", "cached_token").Return(payload, nil).Once()
+		mockRevocations.On("IsRevoked", "key-2").Return(true, nil).Once()
+
+		req, _ := http.NewRequest(http.MethodGet, "/keys", nil)
+		req.Header.Set(authorizationHeader, "ApiKey rk_cached")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "token has been revoked")
+	})
+
+	t.Run("sensitive operations are blocked for api keys", func(t *testing.T) {
+		payload := &token_maker.Payload{UserID: "user-1", SessionID: "key-3", APIKeyID: "key-3", Scopes: scopes}
+		mockAPIKeys.On("AuthenticateAPIKey", "rk_other").Return("other_token", nil).Once()
+		mockTokenMaker.On("VerifyToken", "other_token").Return(payload, nil).Once()
+		mockRevocations.On("IsRevoked", "key-3").Return(false, nil).Once()
+
+		req, _ := http.NewRequest(http.MethodPost, "/keys", nil)
+		req.Header.Set(authorizationHeader, "ApiKey rk_other")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), errAPIKeyForbidden.Error())
+	})
+
+	t.Run("api key without the route's scope", func(t *testing.T) {
+		payload := &token_maker.Payload{UserID: "user-1", SessionID: "key-4", APIKeyID: "key-4", Scopes: scopes}
+		mockAPIKeys.On("AuthenticateAPIKey", "rk_property").Return("property_token", nil).Once()
+		mockTokenMaker.On("VerifyToken", "property_token").Return(payload, nil).Once()
+		mockRevocations.On("IsRevoked", "key-4").Return(false, nil).Once()
+
+		req, _ := http.NewRequest(http.MethodGet, "/messages", nil)
+		req.Header.Set(authorizationHeader, "ApiKey rk_property")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "api key is missing scope message:read")
+	})
+
+	t.Run("routes without a scope reject api keys", func(t *testing.T) {
+		payload := &token_maker.Payload{UserID: "user-1", SessionID: "key-5", APIKeyID: "key-5", Scopes: scopes}
+		mockAPIKeys.On("AuthenticateAPIKey", "rk_unscoped").Return("unscoped_token", nil).Once()
+		mockTokenMaker.On("VerifyToken", "unscoped_token").Return(payload, nil).Once()
+		mockRevocations.On("IsRevoked", "key-5").Return(false, nil).Once()
+
+		req, _ := http.NewRequest(http.MethodGet, "/unscoped", nil)
+		req.Header.Set(authorizationHeader, "ApiKey rk_unscoped")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), errAPIKeyForbidden.Error())
+	})
+
+	t.Run("api key token sent as a bearer token", func(t *testing.T) {
+		payload := &token_maker.Payload{UserID: "user-1", SessionID: "key-6", APIKeyID: "key-6", Scopes: scopes}
+		mockTokenMaker.On("VerifyToken", "exchanged_token").Return(payload, nil).Once()
+		mockRevocations.On("IsRevoked", "key-6").Return(false, nil).Once()
+
+		req, _ := http.NewRequest(http.MethodGet, "/account", nil)
+		req.Header.Set(authorizationHeader, "Bearer exchanged_token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), errAPIKeyForbidden.Error())
+	})
+
+	t.Run("routes without api key support", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/account", nil)
+		req.Header.Set(authorizationHeader, "ApiKey rk_valid")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "unsupported authorization type apikey")
+		mockAPIKeys.AssertExpectations(t)
+	})
+}
+
+func TestImpersonationMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockTokenMaker := new(MockTokenMaker)
+	mockRevocations := new(MockRevocationChecker)
+
+	router := gin.New()
+	router.Use(AuthMiddleware(mockTokenMaker, mockRevocations, nil))
+	router.GET("/profile", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+	router.POST("/change-password", BlockImpersonation(), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	impersonation := &token_maker.Payload{UserID: "user-1", SessionID: "impersonation-session", ImpersonatorID: "admin-1"}
+	mockTokenMaker.On("VerifyToken", "impersonation_token").Return(impersonation, nil)
+	mockRevocations.On("IsRevoked", "impersonation-session").Return(false, nil)
+
+	own := &token_maker.Payload{UserID: "user-1", SessionID: "own-session"}
+	mockTokenMaker.On("VerifyToken", "own_token").Return(own, nil)
+	mockRevocations.On("IsRevoked", "own-session").Return(false, nil)
+
+	t.Run("impersonated requests are tagged", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/profile", nil)
+		req.Header.Set(authorizationHeader, "bearer impersonation_token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "admin-1", w.Header().Get(impersonatedByHeader))
+	})
+
+	t.Run("sensitive operations are blocked while impersonating", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/change-password", nil)
+		req.Header.Set(authorizationHeader, "bearer impersonation_token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), errImpersonationForbidden.Error())
+	})
+
+	t.Run("the user's own token is not affected", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/change-password", nil)
+		req.Header.Set(authorizationHeader, "bearer own_token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get(impersonatedByHeader))
+	})
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	interfaces "github.com/demola234/api_gateway/infrastructure/error_response"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+
+	"github.com/gin-gonic/gin"
+)
+
+// impersonatedByHeader tells clients the response was served to an admin acting as the user
+const impersonatedByHeader = "X-Impersonated-By"
+
+var (
+	errImpersonationForbidden = errors.New("this action is not allowed while impersonating a user")
+	errAPIKeyForbidden        = errors.New("this action is not allowed with an api key")
+)
+
+// BlockImpersonation rejects the request when an admin is acting as the user,
+// for sensitive operations such as changing the password or deleting the
+// account. Integrations using an API key are rejected too. It must run after
+// AuthMiddleware.
+func BlockImpersonation() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		value, exists := ctx.Get(authorizationPayloadKey)
+		if !exists {
+			err := errors.New("authorization payload not found")
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, interfaces.ErrorResponse(err, http.StatusUnauthorized))
+			return
+		}
+
+		payload, ok := value.(*token.Payload)
+		if !ok || payload.IsImpersonation() {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, interfaces.ErrorResponse(errImpersonationForbidden, http.StatusForbidden))
+			return
+		}
+		if payload.IsAPIKey() {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, interfaces.ErrorResponse(errAPIKeyForbidden, http.StatusForbidden))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// auditImpersonation logs a request made by an admin acting as a user and
+// tags the response so clients can show that an admin is in the session.
+func auditImpersonation(ctx *gin.Context, payload *token.Payload) {
+	log.Printf("impersonation: admin %s as user %s (session %s) %s %s from %s",
+		payload.ImpersonatorID, payload.UserID, payload.SessionID, ctx.Request.Method, ctx.Request.URL.Path, ctx.ClientIP())
+
+	ctx.Header(impersonatedByHeader, payload.ImpersonatorID)
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	interfaces "github.com/demola234/api_gateway/infrastructure/error_response"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission only lets the request through when the authenticated caller
+// holds every listed permission. It must run after AuthMiddleware.
+func RequirePermission(permissions ...rbac.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		value, exists := ctx.Get(authorizationPayloadKey)
+		if !exists {
+			err := errors.New("authorization payload not found")
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, interfaces.ErrorResponse(err, http.StatusUnauthorized))
+			return
+		}
+
+		payload, ok := value.(*token.Payload)
+		if !ok {
+			err := errors.New("invalid authorization payload")
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, interfaces.ErrorResponse(err, http.StatusUnauthorized))
+			return
+		}
+
+		for _, perm := range permissions {
+			if !payload.HasPermission(perm) {
+				err := fmt.Errorf("missing permission %s", perm)
+				ctx.AbortWithStatusJSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+				return
+			}
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequirePermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockTokenMaker := new(MockTokenMaker)
+	mockRevocations := new(MockRevocationChecker)
+	mockRevocations.On("IsRevoked", mock.Anything).Return(false, nil)
+
+	router := gin.New()
+	router.POST("/property", AuthMiddleware(mockTokenMaker, mockRevocations, nil), RequirePermission(rbac.PermPropertyCreate), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	t.Run("missing permission", func(t *testing.T) {
+		payload, _ := token_maker.NewPayload("buyer@example.com", "1", uuid.New().String(), []string{string(rbac.RoleBuyer)}, time.Minute)
+		mockTokenMaker.On("VerifyToken", "buyer_token").Return(payload, nil).Once()
+
+		req, _ := http.NewRequest(http.MethodPost, "/property", nil)
+		req.Header.Set(authorizationHeader, "bearer buyer_token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "missing permission property:create")
+	})
+
+	t.Run("has permission", func(t *testing.T) {
+		payload, _ := token_maker.NewPayload("seller@example.com", "2", uuid.New().String(), []string{string(rbac.RoleSeller)}, time.Minute)
+		mockTokenMaker.On("VerifyToken", "seller_token").Return(payload, nil).Once()
+
+		req, _ := http.NewRequest(http.MethodPost, "/property", nil)
+		req.Header.Set(authorizationHeader, "bearer seller_token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("without auth middleware", func(t *testing.T) {
+		r := gin.New()
+		r.GET("/admin", RequirePermission(rbac.PermUserAdmin), func(ctx *gin.Context) {
+			ctx.Status(http.StatusOK)
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "/admin", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestPermissionInterceptor(t *testing.T) {
+	interceptor := PermissionInterceptor(map[string]rbac.Permission{
+		"/pb.PropertyService/CreateProperty": rbac.PermPropertyCreate,
+	})
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	t.Run("unlisted method passes through", func(t *testing.T) {
+		res, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/pb.PropertyService/GetProperties"}, handler)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	})
+
+	t.Run("missing caller", func(t *testing.T) {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/pb.PropertyService/CreateProperty"}, handler)
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("missing permission", func(t *testing.T) {
+		payload, _ := token_maker.NewPayload("buyer@example.com", "1", uuid.New().String(), []string{string(rbac.RoleBuyer)}, time.Minute)
+
+		ctx := context.WithValue(context.Background(), payloadContextKey{}, payload)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/pb.PropertyService/CreateProperty"}, handler)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("has permission", func(t *testing.T) {
+		payload, _ := token_maker.NewPayload("agent@example.com", "3", uuid.New().String(), []string{string(rbac.RoleAgent)}, time.Minute)
+
+		ctx := context.WithValue(context.Background(), payloadContextKey{}, payload)
+		res, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/pb.PropertyService/CreateProperty"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			payload, ok := PayloadFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, "3", payload.UserID)
+			return "ok", nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	})
+}
+
+func TestBlockImpersonationInterceptor(t *testing.T) {
+	interceptor := BlockImpersonationInterceptor("/pb.AuthService/ChangePassword")
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.AuthService/ChangePassword"}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	t.Run("unlisted method passes through", func(t *testing.T) {
+		payload, _ := token_maker.NewImpersonationPayload("admin-1", "buyer@example.com", "1", uuid.New().String(), []string{string(rbac.RoleBuyer)}, time.Minute)
+
+		ctx := context.WithValue(context.Background(), payloadContextKey{}, payload)
+		res, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/pb.AuthService/GetProfile"}, handler)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	})
+
+	t.Run("missing caller", func(t *testing.T) {
+		_, err := interceptor(context.Background(), nil, info, handler)
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("impersonating admin", func(t *testing.T) {
+		payload, _ := token_maker.NewImpersonationPayload("admin-1", "buyer@example.com", "1", uuid.New().String(), []string{string(rbac.RoleBuyer)}, time.Minute)
+
+		ctx := context.WithValue(context.Background(), payloadContextKey{}, payload)
+		_, err := interceptor(ctx, nil, info, handler)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("api key", func(t *testing.T) {
+		apiKeyID := uuid.New().String()
+		payload, _ := token_maker.NewAPIKeyPayload(apiKeyID, "buyer@example.com", "1", []string{string(rbac.RoleBuyer)}, []string{string(rbac.PermPropertyRead)}, "", "", time.Minute)
+
+		ctx := context.WithValue(context.Background(), payloadContextKey{}, payload)
+		_, err := interceptor(ctx, nil, info, handler)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("user's own token", func(t *testing.T) {
+		payload, _ := token_maker.NewPayload("buyer@example.com", "1", uuid.New().String(), []string{string(rbac.RoleBuyer)}, time.Minute)
+
+		ctx := context.WithValue(context.Background(), payloadContextKey{}, payload)
+		res, err := interceptor(ctx, nil, info, handler)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	})
+}
@@ -0,0 +1,86 @@
+package revocation
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// lruCache keeps the revocation status of the most recently seen sessions. A
+// zero revokedAt records that the session was not revoked.
+type lruCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type cacheEntry struct {
+	sessionID string
+	revokedAt time.Time
+}
+
+func newLRUCache(size int) *lruCache {
+	return &lruCache{
+		size:    size,
+		entries: make(map[string]*list.Element, size),
+		order:   list.New(),
+	}
+}
+
+func (c *lruCache) get(sessionID string) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[sessionID]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	c.order.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).revokedAt, true
+}
+
+func (c *lruCache) add(sessionID string, revokedAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[sessionID]; ok {
+		elem.Value.(*cacheEntry).revokedAt = revokedAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[sessionID] = c.order.PushFront(&cacheEntry{sessionID: sessionID, revokedAt: revokedAt})
+
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).sessionID)
+	}
+}
+
+func (c *lruCache) remove(sessionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[sessionID]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, sessionID)
+	}
+}
+
+func (c *lruCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*list.Element, c.size)
+	c.order.Init()
+}
+
+func (c *lruCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
@@ -0,0 +1,133 @@
+package revocation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"sync/atomic"
+	"time"
+
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// notifyChannel is the channel the authentication database notifies on
+	// whenever a session is added to the revocation list
+	notifyChannel = "session_revoked"
+
+	listenerMinReconnect = 10 * time.Second
+	listenerMaxReconnect = time.Minute
+	listenerPingInterval = 90 * time.Second
+)
+
+// List reports whether the session an access token belongs to was revoked. It
+// reads the revocation list kept by the authentication service in Postgres and
+// caches the answer per session. Cached entries are dropped when the database
+// notifies a revocation; while the notification connection is down the cache
+// is bypassed so no revocation is missed.
+type List struct {
+	lookup    func(ctx context.Context, sessionID string) (time.Time, error)
+	cache     *lruCache
+	listening atomic.Bool
+
+	// generation changes on every notification so a lookup racing with a
+	// revocation does not cache the stale answer
+	generation atomic.Uint64
+}
+
+// NewList creates a revocation list backed by the authentication database that
+// caches up to cacheSize sessions.
+func NewList(conn *sql.DB, cacheSize int) *List {
+	return &List{
+		lookup: func(ctx context.Context, sessionID string) (time.Time, error) {
+			var revokedAt time.Time
+			err := conn.QueryRowContext(ctx,
+				"SELECT revoked_at FROM revoked_sessions WHERE session_id = $1", sessionID).Scan(&revokedAt)
+			if errors.Is(err, sql.ErrNoRows) {
+				return time.Time{}, nil
+			}
+			return revokedAt, err
+		},
+		cache: newLRUCache(cacheSize),
+	}
+}
+
+// IsRevoked reports whether the token was issued before its session was revoked.
+// Tokens issued after a revocation, e.g. on a new login reusing the session, are accepted.
+func (l *List) IsRevoked(ctx context.Context, payload *token.Payload) (bool, error) {
+	revokedAt, ok := l.cache.get(payload.SessionID)
+	if !ok || !l.listening.Load() {
+		generation := l.generation.Load()
+
+		var err error
+		revokedAt, err = l.lookup(ctx, payload.SessionID)
+		if err != nil {
+			return false, err
+		}
+
+		if l.listening.Load() && l.generation.Load() == generation {
+			l.cache.add(payload.SessionID, revokedAt)
+		}
+	}
+
+	return !revokedAt.IsZero() && !payload.IssuedAt.After(revokedAt), nil
+}
+
+// Listen subscribes to revocation notifications on the database at dsn and
+// keeps the cache in sync until ctx is cancelled.
+func (l *List) Listen(ctx context.Context, dsn string) error {
+	listener := pq.NewListener(dsn, listenerMinReconnect, listenerMaxReconnect, l.handleEvent)
+	defer listener.Close()
+
+	if err := listener.Listen(notifyChannel); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-listener.Notify:
+			l.handleNotification(notification)
+		case <-time.After(listenerPingInterval):
+			// Surface a dead connection even when nothing is being revoked
+			go func() {
+				if err := listener.Ping(); err != nil {
+					log.Printf("revocation listener ping failed: %v", err)
+				}
+			}()
+		}
+	}
+}
+
+// handleEvent tracks the state of the notification connection
+func (l *List) handleEvent(event pq.ListenerEventType, err error) {
+	l.generation.Add(1)
+
+	switch event {
+	case pq.ListenerEventConnected, pq.ListenerEventReconnected:
+		// Revocations may have been missed while disconnected
+		l.cache.purge()
+		l.listening.Store(true)
+	case pq.ListenerEventDisconnected, pq.ListenerEventConnectionAttemptFailed:
+		l.listening.Store(false)
+		l.cache.purge()
+		log.Printf("revocation listener disconnected: %v", err)
+	}
+}
+
+// handleNotification drops the cached status of a revoked session
+func (l *List) handleNotification(notification *pq.Notification) {
+	l.generation.Add(1)
+
+	// A nil notification is sent after reconnecting
+	if notification == nil {
+		l.cache.purge()
+		return
+	}
+
+	l.cache.remove(notification.Extra)
+}
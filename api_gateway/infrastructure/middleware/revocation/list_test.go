@@ -0,0 +1,126 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRevocations stands in for the revoked_sessions table
+type fakeRevocations struct {
+	revoked map[string]time.Time
+	lookups int
+	err     error
+}
+
+func (f *fakeRevocations) lookup(ctx context.Context, sessionID string) (time.Time, error) {
+	f.lookups++
+	return f.revoked[sessionID], f.err
+}
+
+func newTestList(size int) (*List, *fakeRevocations) {
+	store := &fakeRevocations{revoked: map[string]time.Time{}}
+	list := &List{lookup: store.lookup, cache: newLRUCache(size)}
+	list.handleEvent(pq.ListenerEventConnected, nil)
+	return list, store
+}
+
+func newTestPayload(t *testing.T, sessionID string) *token.Payload {
+	payload, err := token.NewPayload("user@example.com", uuid.New().String(), sessionID, nil, time.Minute)
+	require.NoError(t, err)
+	return payload
+}
+
+func TestIsRevoked(t *testing.T) {
+	list, store := newTestList(10)
+	ctx := context.Background()
+
+	payload := newTestPayload(t, uuid.New().String())
+
+	revoked, err := list.IsRevoked(ctx, payload)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	// The answer is cached until the database notifies a revocation
+	store.revoked[payload.SessionID] = time.Now()
+	revoked, err = list.IsRevoked(ctx, payload)
+	require.NoError(t, err)
+	require.False(t, revoked)
+	require.Equal(t, 1, store.lookups)
+
+	list.handleNotification(&pq.Notification{Channel: notifyChannel, Extra: payload.SessionID})
+	revoked, err = list.IsRevoked(ctx, payload)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	require.Equal(t, 2, store.lookups)
+}
+
+func TestIsRevokedAcceptsTokensIssuedAfterRevocation(t *testing.T) {
+	list, store := newTestList(10)
+
+	sessionID := uuid.New().String()
+	store.revoked[sessionID] = time.Now().Add(-time.Minute)
+
+	revoked, err := list.IsRevoked(context.Background(), newTestPayload(t, sessionID))
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
+
+func TestIsRevokedBypassesCacheWhileDisconnected(t *testing.T) {
+	list, store := newTestList(10)
+	ctx := context.Background()
+
+	payload := newTestPayload(t, uuid.New().String())
+	_, err := list.IsRevoked(ctx, payload)
+	require.NoError(t, err)
+
+	list.handleEvent(pq.ListenerEventDisconnected, errors.New("connection reset"))
+	store.revoked[payload.SessionID] = time.Now()
+
+	revoked, err := list.IsRevoked(ctx, payload)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	revoked, err = list.IsRevoked(ctx, payload)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	require.Equal(t, 3, store.lookups)
+	require.Zero(t, list.cache.len())
+}
+
+func TestIsRevokedLookupError(t *testing.T) {
+	list, store := newTestList(10)
+	store.err = errors.New("database unavailable")
+
+	_, err := list.IsRevoked(context.Background(), newTestPayload(t, uuid.New().String()))
+	require.Error(t, err)
+	require.Zero(t, list.cache.len())
+}
+
+func TestLRUCacheEvictsOldest(t *testing.T) {
+	cache := newLRUCache(2)
+
+	cache.add("a", time.Time{})
+	cache.add("b", time.Time{})
+
+	// Reading a keeps it while b becomes the oldest entry
+	_, ok := cache.get("a")
+	require.True(t, ok)
+
+	cache.add("c", time.Time{})
+	require.Equal(t, 2, cache.len())
+
+	_, ok = cache.get("b")
+	require.False(t, ok)
+	_, ok = cache.get("a")
+	require.True(t, ok)
+	_, ok = cache.get("c")
+	require.True(t, ok)
+}
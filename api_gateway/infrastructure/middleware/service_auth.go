@@ -0,0 +1,253 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	"github.com/demola234/shared/rbac"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GatewayService is the name the API gateway signs its calls with
+const GatewayService = "api_gateway"
+
+const (
+	// serviceIdentityHeader carries the calling service's signed assertion of
+	// who it is
+	serviceIdentityHeader = "x-service-identity"
+
+	// serviceIdentityDuration bounds how long an intercepted assertion could be replayed
+	serviceIdentityDuration = 30 * time.Second
+)
+
+var (
+	errMissingServiceIdentity = errors.New("service identity not found")
+	errInvalidServiceIdentity = errors.New("service identity is invalid")
+	errInvalidAccessToken     = errors.New("access token is invalid")
+)
+
+type (
+	accessTokenContextKey struct{}
+	serviceContextKey     struct{}
+)
+
+// WithAccessToken records the access token of the user a call to another
+// service is made for. It is forwarded so the service can verify it itself.
+func WithAccessToken(ctx context.Context, accessToken string) context.Context {
+	return context.WithValue(ctx, accessTokenContextKey{}, accessToken)
+}
+
+// ServiceCredentials sign every call a service makes to another with its own
+// Ed25519 key, bound to the method called, and forward the access token set
+// with WithAccessToken.
+type ServiceCredentials struct {
+	service    string
+	privateKey ed25519.PrivateKey
+}
+
+// NewServiceCredentials creates the credentials of the named service from its
+// k4.secret key
+func NewServiceCredentials(service string, secretKey string) (*ServiceCredentials, error) {
+	privateKey, err := token.DecodeSecretKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceCredentials{service: service, privateKey: privateKey}, nil
+}
+
+// UnaryClientInterceptor signs every outgoing unary call
+func (c *ServiceCredentials) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, err := c.outgoingContext(ctx, method)
+		if err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor signs every outgoing streaming call
+func (c *ServiceCredentials) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, err := c.outgoingContext(ctx, method)
+		if err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func (c *ServiceCredentials) outgoingContext(ctx context.Context, method string) (context.Context, error) {
+	now := time.Now()
+	assertion, err := token.SignServiceToken(c.privateKey, &token.ServiceClaims{
+		Service:   c.service,
+		Method:    method,
+		IssuedAt:  now,
+		ExpiredAt: now.Add(serviceIdentityDuration),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign service identity: %v", err)
+	}
+
+	// Headers are replaced rather than appended to, so metadata passed through
+	// from an HTTP request cannot stand in for the service's own
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(serviceIdentityHeader, assertion)
+	if accessToken, _ := ctx.Value(accessTokenContextKey{}).(string); accessToken != "" {
+		md.Set(authorizationHeader, "Bearer "+accessToken)
+	}
+	return metadata.NewOutgoingContext(ctx, md), nil
+}
+
+// ServiceAuthenticator accepts calls only from services holding one of the
+// trusted keys. The user a call is made for is taken from the access token
+// forwarded with it, verified against the authentication service's keys, and
+// made available through PayloadFromContext; handlers never take identity
+// from request fields.
+//
+// The token is held to the same rules as at the gateway, so a call that
+// reaches the service some other way gains nothing: its session must not be
+// revoked, and tokens exchanged for an API key may only call the methods in
+// apiKeyScopes, with a key granting every scope listed for the method.
+type ServiceAuthenticator struct {
+	services      *token.ServiceKeys
+	tokenVerifier token.Verifier
+	revocations   RevocationChecker
+	apiKeyScopes  map[string][]rbac.Permission
+}
+
+// NewServiceAuthenticator creates a ServiceAuthenticator trusting the services
+// in trustedKeys, given as "<service>=<k4.public key>"
+func NewServiceAuthenticator(trustedKeys []string, tokenVerifier token.Verifier, revocations RevocationChecker, apiKeyScopes map[string][]rbac.Permission) (*ServiceAuthenticator, error) {
+	services, err := token.ParseServiceKeys(trustedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceAuthenticator{
+		services:      services,
+		tokenVerifier: tokenVerifier,
+		revocations:   revocations,
+		apiKeyScopes:  apiKeyScopes,
+	}, nil
+}
+
+// UnaryServerInterceptor rejects unary calls without a valid assertion and
+// stores the user the call is made for, if any, in the context
+func (a *ServiceAuthenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.incomingContext(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor
+func (a *ServiceAuthenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.incomingContext(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *ServiceAuthenticator) incomingContext(ctx context.Context, method string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(serviceIdentityHeader)
+	if len(values) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", errMissingServiceIdentity)
+	}
+
+	claims, err := token.VerifyServiceToken(values[0], a.services)
+	if err != nil || claims.Method != method {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", errInvalidServiceIdentity)
+	}
+
+	ctx = context.WithValue(ctx, serviceContextKey{}, claims.Service)
+
+	authorization := md.Get(authorizationHeader)
+	if len(authorization) == 0 {
+		return ctx, nil
+	}
+
+	payload, err := a.verifyAccessToken(authorization[0])
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%v: %v", errInvalidAccessToken, err)
+	}
+	if err := a.checkCaller(ctx, method, payload); err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, payloadContextKey{}, payload), nil
+}
+
+// checkCaller applies AuthMiddleware's revocation and API key rules to the
+// access token forwarded with a call to method
+func (a *ServiceAuthenticator) checkCaller(ctx context.Context, method string, payload *token.Payload) error {
+	// Tokens that cannot be revoked are not accepted
+	if payload.SessionID == "" {
+		return status.Errorf(codes.Unauthenticated, "%v: token is not bound to a session", errInvalidAccessToken)
+	}
+
+	revoked, err := a.revocations.IsRevoked(ctx, payload)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to check token revocation: %v", err)
+	}
+	if revoked {
+		return status.Errorf(codes.Unauthenticated, "%v: token has been revoked", errInvalidAccessToken)
+	}
+
+	if payload.IsAPIKey() {
+		scopes := a.apiKeyScopes[method]
+		if len(scopes) == 0 {
+			return status.Errorf(codes.PermissionDenied, "%v", errAPIKeyForbidden)
+		}
+		for _, scope := range scopes {
+			if !payload.HasPermission(scope) {
+				return status.Errorf(codes.PermissionDenied, "api key is missing scope %s", scope)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *ServiceAuthenticator) verifyAccessToken(authorization string) (*token.Payload, error) {
+	fields := strings.Fields(authorization)
+	if len(fields) != 2 || strings.ToLower(fields[0]) != authorizationBearer {
+		return nil, fmt.Errorf("unsupported authorization format")
+	}
+	return a.tokenVerifier.VerifyToken(fields[1])
+}
+
+// ServiceFromContext returns the service that made the call, as verified by
+// ServiceAuthenticator. Headers such as X-Forwarded-For are only to be
+// trusted from a service that sets them itself.
+func ServiceFromContext(ctx context.Context) (string, bool) {
+	service, ok := ctx.Value(serviceContextKey{}).(string)
+	return service, ok
+}
+
+// authenticatedStream exposes the context carrying the caller to stream handlers
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
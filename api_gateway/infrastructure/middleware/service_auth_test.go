@@ -0,0 +1,211 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newServiceKey generates a key pair and returns the service's k4.secret key
+// and its trusted key entry
+func newServiceKey(t *testing.T, service string) (string, string) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return token_maker.EncodeSecretKey(privateKey), service + "=" + token_maker.EncodePublicKey(publicKey)
+}
+
+// signedContext makes a call to method through the client interceptor and
+// returns the context the server would receive
+func signedContext(t *testing.T, credentials *ServiceCredentials, ctx context.Context, method string) context.Context {
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	err := credentials.UnaryClientInterceptor()(ctx, method, nil, nil, nil, invoker)
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// revokedSessions is a RevocationChecker over a fixed set of sessions
+type revokedSessions map[string]bool
+
+func (r revokedSessions) IsRevoked(ctx context.Context, payload *token_maker.Payload) (bool, error) {
+	return r[payload.SessionID], nil
+}
+
+func TestServiceAuth(t *testing.T) {
+	gatewaySecret, gatewayKey := newServiceKey(t, "api_gateway")
+	gateway, err := NewServiceCredentials("api_gateway", gatewaySecret)
+	require.NoError(t, err)
+
+	revokedSessionID := uuid.New().String()
+	tokenMaker := token_maker.NewPasetoV4Maker(newTestKeyRing(t))
+	authenticator, err := NewServiceAuthenticator([]string{gatewayKey}, tokenMaker, revokedSessions{revokedSessionID: true},
+		map[string][]rbac.Permission{"/pb.PropertyService/GetProperties": {rbac.PermPropertyRead}})
+	require.NoError(t, err)
+
+	interceptor := authenticator.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.PropertyService/DeleteProperty"}
+
+	accessToken, caller, err := tokenMaker.CreateToken("seller@example.com", uuid.New().String(), uuid.New().String(), []string{string(rbac.RoleSeller)}, time.Minute)
+	require.NoError(t, err)
+
+	rejected := func(t *testing.T, ctx context.Context) {
+		_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			t.Fatal("handler must not run")
+			return nil, nil
+		})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	t.Run("caller is passed to the handler", func(t *testing.T) {
+		ctx := signedContext(t, gateway, WithAccessToken(context.Background(), accessToken), info.FullMethod)
+
+		res, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			payload, ok := PayloadFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, caller.UserID, payload.UserID)
+			assert.Equal(t, caller.Scopes, payload.Scopes)
+			return "ok", nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	})
+
+	t.Run("calls without a user are accepted without a caller", func(t *testing.T) {
+		ctx := signedContext(t, gateway, context.Background(), info.FullMethod)
+
+		_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			_, ok := PayloadFromContext(ctx)
+			assert.False(t, ok)
+			return "ok", nil
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("forwarded request headers are kept but cannot replace the identity", func(t *testing.T) {
+		forwarded := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(
+			"x-service-identity", "forged",
+			"authorization", "Bearer "+accessToken,
+		))
+		ctx := signedContext(t, gateway, forwarded, info.FullMethod)
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		require.Len(t, md.Get("x-service-identity"), 1)
+
+		_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			payload, ok := PayloadFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, caller.UserID, payload.UserID)
+			return "ok", nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("missing identity", func(t *testing.T) {
+		rejected(t, metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+accessToken)))
+	})
+
+	t.Run("identity signed by an untrusted service", func(t *testing.T) {
+		otherSecret, _ := newServiceKey(t, "api_gateway")
+		other, err := NewServiceCredentials("api_gateway", otherSecret)
+		require.NoError(t, err)
+
+		rejected(t, signedContext(t, other, WithAccessToken(context.Background(), accessToken), info.FullMethod))
+	})
+
+	t.Run("trusted key naming another service", func(t *testing.T) {
+		impostor, err := NewServiceCredentials("authentication", gatewaySecret)
+		require.NoError(t, err)
+
+		rejected(t, signedContext(t, impostor, context.Background(), info.FullMethod))
+	})
+
+	t.Run("identity for another method", func(t *testing.T) {
+		rejected(t, signedContext(t, gateway, WithAccessToken(context.Background(), accessToken), "/pb.PropertyService/GetProperties"))
+	})
+
+	t.Run("access token not signed by the authentication service", func(t *testing.T) {
+		forged, _, err := token_maker.NewPasetoV4Maker(newTestKeyRing(t)).CreateToken("admin@example.com", uuid.New().String(), uuid.New().String(), []string{string(rbac.RoleAdmin)}, time.Minute)
+		require.NoError(t, err)
+
+		rejected(t, signedContext(t, gateway, WithAccessToken(context.Background(), forged), info.FullMethod))
+	})
+
+	t.Run("revoked session", func(t *testing.T) {
+		revoked, _, err := tokenMaker.CreateToken("seller@example.com", uuid.New().String(), revokedSessionID, []string{string(rbac.RoleSeller)}, time.Minute)
+		require.NoError(t, err)
+
+		rejected(t, signedContext(t, gateway, WithAccessToken(context.Background(), revoked), info.FullMethod))
+	})
+
+	t.Run("api keys only call the methods granted to them", func(t *testing.T) {
+		apiKeyToken, _, err := tokenMaker.CreateAPIKeyToken(uuid.New().String(), "seller@example.com", uuid.New().String(), []string{string(rbac.RoleSeller)},
+			[]string{string(rbac.PermPropertyRead)}, "", "", time.Minute)
+		require.NoError(t, err)
+
+		ctx := signedContext(t, gateway, WithAccessToken(context.Background(), apiKeyToken), info.FullMethod)
+		_, err = interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			t.Fatal("handler must not run")
+			return nil, nil
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		readInfo := &grpc.UnaryServerInfo{FullMethod: "/pb.PropertyService/GetProperties"}
+		ctx = signedContext(t, gateway, WithAccessToken(context.Background(), apiKeyToken), readInfo.FullMethod)
+		_, err = interceptor(ctx, nil, readInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("api key missing the method's scope", func(t *testing.T) {
+		apiKeyToken, _, err := tokenMaker.CreateAPIKeyToken(uuid.New().String(), "seller@example.com", uuid.New().String(), []string{string(rbac.RoleSeller)},
+			[]string{string(rbac.PermOrganizationRead)}, "", "", time.Minute)
+		require.NoError(t, err)
+
+		readInfo := &grpc.UnaryServerInfo{FullMethod: "/pb.PropertyService/GetProperties"}
+		ctx := signedContext(t, gateway, WithAccessToken(context.Background(), apiKeyToken), readInfo.FullMethod)
+		_, err = interceptor(ctx, nil, readInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+			t.Fatal("handler must not run")
+			return nil, nil
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestNewServiceAuthenticatorWithoutKeys(t *testing.T) {
+	_, err := NewServiceAuthenticator(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, token_maker.ErrNoServiceKeys)
+
+	_, err = NewServiceCredentials("api_gateway", "")
+	assert.Error(t, err)
+}
+
+func newTestKeyRing(t *testing.T) *token_maker.KeyRing {
+	key, err := token_maker.GenerateSigningKey()
+	require.NoError(t, err)
+
+	ring := token_maker.NewKeyRing()
+	require.NoError(t, ring.Set([]*token_maker.SigningKey{key}, key.ID))
+	return ring
+}
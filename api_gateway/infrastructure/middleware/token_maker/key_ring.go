@@ -0,0 +1,179 @@
+package token_maker
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	paserkPublicPrefix = "k4.public."
+	paserkPIDPrefix    = "k4.pid."
+)
+
+// SigningKey is an Ed25519 key pair held in a KeyRing
+type SigningKey struct {
+	ID         string
+	PrivateKey ed25519.PrivateKey
+	CreatedAt  time.Time
+	// ExpiresAt is set once the key is rotated out. Tokens it signed are
+	// accepted until then, but it signs nothing new.
+	ExpiresAt *time.Time
+}
+
+// PublicKey is the part of a SigningKey that can be published
+type PublicKey struct {
+	ID        string
+	Key       ed25519.PublicKey
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+}
+
+// GenerateSigningKey creates a new random Ed25519 signing key
+func GenerateSigningKey() (*SigningKey, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	return NewSigningKey(privateKey, time.Now().UTC(), nil), nil
+}
+
+// NewSigningKey wraps an existing private key, deriving its ID from the public key
+func NewSigningKey(privateKey ed25519.PrivateKey, createdAt time.Time, expiresAt *time.Time) *SigningKey {
+	return &SigningKey{
+		ID:         KeyID(privateKey.Public().(ed25519.PublicKey)),
+		PrivateKey: privateKey,
+		CreatedAt:  createdAt,
+		ExpiresAt:  expiresAt,
+	}
+}
+
+// KeyID returns the PASERK k4.pid identifier of a public key
+func KeyID(publicKey ed25519.PublicKey) string {
+	hash, _ := blake2b.New(33, nil)
+	hash.Write([]byte(paserkPIDPrefix))
+	hash.Write([]byte(EncodePublicKey(publicKey)))
+	return paserkPIDPrefix + base64.RawURLEncoding.EncodeToString(hash.Sum(nil))
+}
+
+// EncodePublicKey serialises a public key as a PASERK k4.public string
+func EncodePublicKey(publicKey ed25519.PublicKey) string {
+	return paserkPublicPrefix + base64.RawURLEncoding.EncodeToString(publicKey)
+}
+
+// DecodePublicKey parses a PASERK k4.public string
+func DecodePublicKey(encoded string) (ed25519.PublicKey, error) {
+	if !strings.HasPrefix(encoded, paserkPublicPrefix) {
+		return nil, fmt.Errorf("public key is not a k4.public paserk")
+	}
+
+	key, err := base64.RawURLEncoding.DecodeString(encoded[len(paserkPublicPrefix):])
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid k4.public key")
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// KeyRing holds the key that signs new tokens together with the recently
+// rotated keys whose tokens are still accepted. It is safe for concurrent use.
+type KeyRing struct {
+	mu     sync.RWMutex
+	active string
+	keys   map[string]*SigningKey
+	now    func() time.Time
+}
+
+// NewKeyRing creates an empty key ring
+func NewKeyRing() *KeyRing {
+	return &KeyRing{keys: map[string]*SigningKey{}, now: time.Now}
+}
+
+// Set replaces the contents of the ring. The key with activeID signs new tokens.
+func (r *KeyRing) Set(keys []*SigningKey, activeID string) error {
+	ring := make(map[string]*SigningKey, len(keys))
+	for _, key := range keys {
+		ring[key.ID] = key
+	}
+
+	active, ok := ring[activeID]
+	if !ok || active.ExpiresAt != nil {
+		return fmt.Errorf("%w: %s", ErrNoSigningKey, activeID)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys = ring
+	r.active = activeID
+	return nil
+}
+
+// Rotate makes next the signing key. The previous one keeps verifying the
+// tokens it signed for retainFor, which must cover the longest token lifetime.
+func (r *KeyRing) Rotate(next *SigningKey, retainFor time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if previous, ok := r.keys[r.active]; ok {
+		expiresAt := r.now().Add(retainFor)
+		previous.ExpiresAt = &expiresAt
+	}
+
+	r.keys[next.ID] = next
+	r.active = next.ID
+}
+
+// Active returns the key new tokens are signed with
+func (r *KeyRing) Active() (*SigningKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, ok := r.keys[r.active]
+	if !ok {
+		return nil, ErrNoSigningKey
+	}
+	return key, nil
+}
+
+// PublicKey implements PublicKeys. Keys past their expiry are unknown.
+func (r *KeyRing) PublicKey(kid string) (ed25519.PublicKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, ok := r.keys[kid]
+	if !ok || (key.ExpiresAt != nil && r.now().After(*key.ExpiresAt)) {
+		return nil, ErrUnknownKey
+	}
+	return key.PrivateKey.Public().(ed25519.PublicKey), nil
+}
+
+// PublicKeys lists every key that still verifies tokens, for publishing
+func (r *KeyRing) PublicKeys() []PublicKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := r.now()
+	keys := make([]PublicKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		if key.ExpiresAt != nil && now.After(*key.ExpiresAt) {
+			continue
+		}
+		keys = append(keys, PublicKey{
+			ID:        key.ID,
+			Key:       key.PrivateKey.Public().(ed25519.PublicKey),
+			CreatedAt: key.CreatedAt,
+			ExpiresAt: key.ExpiresAt,
+		})
+	}
+
+	// Newest first, so the signing key leads the list
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.After(keys[j].CreatedAt) })
+	return keys
+}
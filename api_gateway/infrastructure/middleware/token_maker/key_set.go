@@ -0,0 +1,138 @@
+package token_maker
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// minKeyRefreshInterval stops tokens with made up key IDs from hammering the key endpoint
+	minKeyRefreshInterval = time.Minute
+	keyFetchTimeout       = 10 * time.Second
+)
+
+// RemoteKeySet fetches the public keys published by the authentication service
+// and caches them for a TTL. An unknown key ID triggers an early refresh so a
+// rotation is picked up without waiting for the TTL to run out.
+type RemoteKeySet struct {
+	url        string
+	client     *http.Client
+	ttl        time.Duration
+	minRefresh time.Duration
+	now        func() time.Time
+
+	mu          sync.Mutex
+	keys        map[string]remoteKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+type remoteKey struct {
+	key       ed25519.PublicKey
+	expiresAt *time.Time
+}
+
+// publishedKeys is the JSON document served by the authentication service
+type publishedKeys struct {
+	Keys []struct {
+		KeyID     string     `json:"kid"`
+		PublicKey string     `json:"public_key"`
+		ExpiresAt *time.Time `json:"expires_at"`
+	} `json:"keys"`
+}
+
+// NewRemoteKeySet creates a key set for the public keys published at url
+func NewRemoteKeySet(url string, client *http.Client, ttl time.Duration) *RemoteKeySet {
+	return &RemoteKeySet{
+		url:        url,
+		client:     client,
+		ttl:        ttl,
+		minRefresh: minKeyRefreshInterval,
+		now:        time.Now,
+	}
+}
+
+// PublicKey implements PublicKeys
+func (s *RemoteKeySet) PublicKey(kid string) (ed25519.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	expired := s.keys == nil || now.Sub(s.fetchedAt) >= s.ttl
+
+	if (expired || s.lookup(kid, now) == nil) && now.Sub(s.attemptedAt) >= s.minRefresh {
+		s.attemptedAt = now
+
+		keys, err := s.fetch()
+		if err != nil {
+			// Keep verifying with the keys we have if the endpoint is briefly unavailable
+			if s.keys == nil {
+				return nil, err
+			}
+			log.Printf("failed to refresh token keys: %v", err)
+		} else {
+			s.keys = keys
+			s.fetchedAt = now
+		}
+	}
+
+	key := s.lookup(kid, now)
+	if key == nil {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+func (s *RemoteKeySet) lookup(kid string, now time.Time) ed25519.PublicKey {
+	key, ok := s.keys[kid]
+	if !ok || (key.expiresAt != nil && now.After(*key.expiresAt)) {
+		return nil
+	}
+	return key.key
+}
+
+func (s *RemoteKeySet) fetch() (map[string]remoteKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), keyFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch token keys: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch token keys: %s", resp.Status)
+	}
+
+	var published publishedKeys
+	if err := json.NewDecoder(resp.Body).Decode(&published); err != nil {
+		return nil, fmt.Errorf("failed to decode token keys: %w", err)
+	}
+
+	keys := make(map[string]remoteKey, len(published.Keys))
+	for _, k := range published.Keys {
+		publicKey, err := DecodePublicKey(k.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", k.KeyID, err)
+		}
+		// The ID is derived from the key, so a mislabelled key is never trusted
+		if KeyID(publicKey) != k.KeyID {
+			return nil, fmt.Errorf("key %s does not match its id", k.KeyID)
+		}
+		keys[k.KeyID] = remoteKey{key: publicKey, expiresAt: k.ExpiresAt}
+	}
+
+	return keys, nil
+}
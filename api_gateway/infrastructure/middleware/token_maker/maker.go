@@ -5,9 +5,24 @@ import (
 )
 
 type Maker interface {
-	// CreateToken creates a new token for a specific username and duration
-	CreateToken(email string, userID string, duration time.Duration) (string, *Payload, error)
+	// CreateToken creates a new token for a specific user, the session it belongs to, their roles and duration
+	CreateToken(email string, userID string, sessionID string, roles []string, duration time.Duration) (string, *Payload, error)
 
+	// CreateImpersonationToken creates a token for an admin acting as a user, carrying both their IDs
+	CreateImpersonationToken(impersonatorID string, email string, userID string, sessionID string, roles []string, duration time.Duration) (string, *Payload, error)
+
+	// CreateOrganizationToken creates a token for a user acting for an organization, carrying its ID and their role in it
+	CreateOrganizationToken(email string, userID string, sessionID string, roles []string, organizationID string, organizationRole string, duration time.Duration) (string, *Payload, error)
+
+	// CreateAPIKeyToken creates a token for an API key acting as the user who created it, carrying the key's scopes and organization
+	CreateAPIKeyToken(apiKeyID string, email string, userID string, roles []string, scopes []string, organizationID string, organizationRole string, duration time.Duration) (string, *Payload, error)
+
+	Verifier
+}
+
+// Verifier is the part of a Maker that services authenticating requests need.
+// With public tokens it can be satisfied without holding any signing key.
+type Verifier interface {
 	// VerifyToken checks if the token is valid or not
 	VerifyToken(token string) (*Payload, error)
-}
\ No newline at end of file
+}
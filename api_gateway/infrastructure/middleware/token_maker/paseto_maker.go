@@ -32,12 +32,43 @@ func NewTokenMaker(symmetricKey string) (Maker, error) {
 	return maker, nil 
 }
 
-func (maker *PasetoMaker) CreateToken(email string, userID string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(email, userID, duration)
+func (maker *PasetoMaker) CreateToken(email string, userID string, sessionID string, roles []string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(email, userID, sessionID, roles, duration)
 	if err != nil {
 		return "", payload, err
 	}
 
+	return maker.encrypt(payload)
+}
+
+func (maker *PasetoMaker) CreateImpersonationToken(impersonatorID string, email string, userID string, sessionID string, roles []string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewImpersonationPayload(impersonatorID, email, userID, sessionID, roles, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.encrypt(payload)
+}
+
+func (maker *PasetoMaker) CreateOrganizationToken(email string, userID string, sessionID string, roles []string, organizationID string, organizationRole string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewOrganizationPayload(email, userID, sessionID, roles, organizationID, organizationRole, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.encrypt(payload)
+}
+
+func (maker *PasetoMaker) CreateAPIKeyToken(apiKeyID string, email string, userID string, roles []string, scopes []string, organizationID string, organizationRole string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewAPIKeyPayload(apiKeyID, email, userID, roles, scopes, organizationID, organizationRole, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.encrypt(payload)
+}
+
+func (maker *PasetoMaker) encrypt(payload *Payload) (string, *Payload, error) {
 	token, err := maker.paseto.Encrypt(maker.symmetricKey, payload, nil)
 	if err != nil {
 		return "", payload, err
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/demola234/shared/rbac"
 	"github.com/demola234/shared/utils"
 
 	"github.com/google/uuid"
@@ -16,10 +17,11 @@ func TestPasetoMaker(t *testing.T) {
 
 	username := utils.RandomString(6)
 	userID := utils.RandomString(6)
+	sessionID := uuid.New().String()
 	duration := time.Minute
 	issuedAt := time.Now()
 	expiredAt := issuedAt.Add(duration)
-	token, payload, err := maker.CreateToken(username, userID, duration)
+	token, payload, err := maker.CreateToken(username, userID, sessionID, []string{string(rbac.RoleSeller)}, duration)
 	require.NoError(t, err)
 
 	require.NotEmpty(t, token)
@@ -34,9 +36,12 @@ func TestPasetoMaker(t *testing.T) {
 	require.NotEmpty(t, payload)
 
 	require.Equal(t, username, payload.Email)
+	require.Equal(t, sessionID, payload.SessionID)
 	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
 	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
 	require.NoError(t, payload.Valid())
+	require.True(t, payload.HasPermission(rbac.PermPropertyCreate))
+	require.False(t, payload.HasPermission(rbac.PermUserAdmin))
 
 }
 
@@ -45,7 +50,7 @@ func TestExpiredPasetoToken(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, maker)
 
-	token, pasto_payload, err := maker.CreateToken(utils.RandomOwner(), uuid.New().String(), -time.Minute)
+	token, pasto_payload, err := maker.CreateToken(utils.RandomOwner(), uuid.New().String(), uuid.New().String(), nil, -time.Minute)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, pasto_payload)
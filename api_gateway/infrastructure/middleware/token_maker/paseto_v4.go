@@ -0,0 +1,207 @@
+package token_maker
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	v4PublicHeader = "v4.public."
+
+	// maxFooterSize bounds the untrusted footer parsed before the signature is checked
+	maxFooterSize = 512
+)
+
+var (
+	ErrNoSigningKey = errors.New("no active signing key")
+	ErrUnknownKey   = errors.New("token signed by an unknown key")
+)
+
+// PublicKeys resolves the key ID in a token footer to the key that verifies it
+type PublicKeys interface {
+	PublicKey(kid string) (ed25519.PublicKey, error)
+}
+
+type v4Footer struct {
+	KeyID string `json:"kid"`
+}
+
+// PasetoV4Maker signs v4.public tokens with the active key of a KeyRing. The
+// key ID goes in the footer so verifiers can pick the right public key while
+// keys are rotated.
+type PasetoV4Maker struct {
+	ring *KeyRing
+}
+
+// NewPasetoV4Maker creates a Maker that signs with ring's active key
+func NewPasetoV4Maker(ring *KeyRing) Maker {
+	return &PasetoV4Maker{ring: ring}
+}
+
+func (maker *PasetoV4Maker) CreateToken(email string, userID string, sessionID string, roles []string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(email, userID, sessionID, roles, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.sign(payload)
+}
+
+func (maker *PasetoV4Maker) CreateImpersonationToken(impersonatorID string, email string, userID string, sessionID string, roles []string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewImpersonationPayload(impersonatorID, email, userID, sessionID, roles, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.sign(payload)
+}
+
+func (maker *PasetoV4Maker) CreateOrganizationToken(email string, userID string, sessionID string, roles []string, organizationID string, organizationRole string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewOrganizationPayload(email, userID, sessionID, roles, organizationID, organizationRole, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.sign(payload)
+}
+
+func (maker *PasetoV4Maker) CreateAPIKeyToken(apiKeyID string, email string, userID string, roles []string, scopes []string, organizationID string, organizationRole string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewAPIKeyPayload(apiKeyID, email, userID, roles, scopes, organizationID, organizationRole, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.sign(payload)
+}
+
+func (maker *PasetoV4Maker) sign(payload *Payload) (string, *Payload, error) {
+	key, err := maker.ring.Active()
+	if err != nil {
+		return "", payload, err
+	}
+
+	message, err := json.Marshal(payload)
+	if err != nil {
+		return "", payload, err
+	}
+
+	footer, err := json.Marshal(v4Footer{KeyID: key.ID})
+	if err != nil {
+		return "", payload, err
+	}
+
+	return signV4Public(key.PrivateKey, message, footer), payload, nil
+}
+
+func (maker *PasetoV4Maker) VerifyToken(token string) (*Payload, error) {
+	return verifyV4Public(token, maker.ring)
+}
+
+// PasetoV4Verifier checks v4.public tokens against public keys only
+type PasetoV4Verifier struct {
+	keys PublicKeys
+}
+
+// NewPasetoV4Verifier creates a Verifier for tokens signed by any of keys
+func NewPasetoV4Verifier(keys PublicKeys) Verifier {
+	return &PasetoV4Verifier{keys: keys}
+}
+
+func (verifier *PasetoV4Verifier) VerifyToken(token string) (*Payload, error) {
+	return verifyV4Public(token, verifier.keys)
+}
+
+func verifyV4Public(token string, keys PublicKeys) (*Payload, error) {
+	message, _, err := openV4Public(token, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &Payload{}
+	if err := json.Unmarshal(message, payload); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if err := payload.Valid(); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// openV4Public checks the signature of a v4.public token against the key its
+// footer names and returns the signed message along with that key's ID
+func openV4Public(token string, keys PublicKeys) ([]byte, string, error) {
+	if !strings.HasPrefix(token, v4PublicHeader) {
+		return nil, "", ErrInvalidToken
+	}
+
+	parts := strings.Split(token[len(v4PublicHeader):], ".")
+	if len(parts) != 2 {
+		return nil, "", ErrInvalidToken
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil || len(body) < ed25519.SignatureSize {
+		return nil, "", ErrInvalidToken
+	}
+
+	footer, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || len(footer) > maxFooterSize {
+		return nil, "", ErrInvalidToken
+	}
+
+	var f v4Footer
+	if err := json.Unmarshal(footer, &f); err != nil || f.KeyID == "" {
+		return nil, "", ErrInvalidToken
+	}
+
+	publicKey, err := keys.PublicKey(f.KeyID)
+	if err != nil {
+		return nil, "", ErrInvalidToken
+	}
+
+	message := body[:len(body)-ed25519.SignatureSize]
+	signature := body[len(body)-ed25519.SignatureSize:]
+	if !ed25519.Verify(publicKey, preAuthEncode([]byte(v4PublicHeader), message, footer, nil), signature) {
+		return nil, "", ErrInvalidToken
+	}
+
+	return message, f.KeyID, nil
+}
+
+// signV4Public builds a v4.public token as specified by PASETO version 4
+func signV4Public(privateKey ed25519.PrivateKey, message, footer []byte) string {
+	signature := ed25519.Sign(privateKey, preAuthEncode([]byte(v4PublicHeader), message, footer, nil))
+
+	token := v4PublicHeader + base64.RawURLEncoding.EncodeToString(append(message[:len(message):len(message)], signature...))
+	if len(footer) > 0 {
+		token += "." + base64.RawURLEncoding.EncodeToString(footer)
+	}
+	return token
+}
+
+// preAuthEncode is PASETO's PAE: every piece is prefixed with its length so
+// no two different inputs can produce the same signed bytes
+func preAuthEncode(pieces ...[]byte) []byte {
+	var buf bytes.Buffer
+	writeLength(&buf, len(pieces))
+	for _, piece := range pieces {
+		writeLength(&buf, len(piece))
+		buf.Write(piece)
+	}
+	return buf.Bytes()
+}
+
+func writeLength(buf *bytes.Buffer, n int) {
+	var le [8]byte
+	// The most significant bit is cleared for interoperability with languages without unsigned ints
+	binary.LittleEndian.PutUint64(le[:], uint64(n)&^(1<<63))
+	buf.Write(le[:])
+}
@@ -0,0 +1,269 @@
+package token_maker
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/demola234/shared/rbac"
+	"github.com/demola234/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyRing(t *testing.T) *KeyRing {
+	key, err := GenerateSigningKey()
+	require.NoError(t, err)
+
+	ring := NewKeyRing()
+	require.NoError(t, ring.Set([]*SigningKey{key}, key.ID))
+	return ring
+}
+
+func TestSignV4PublicTestVector(t *testing.T) {
+	// Test vector 4-S-2 from the PASETO specification
+	seed, err := hex.DecodeString("b4cbfb43df4ce210727d953e4a713307fa19bb7d9f85041438d9e11b942a3774")
+	require.NoError(t, err)
+	privateKey := ed25519.NewKeyFromSeed(seed)
+
+	token := signV4Public(privateKey,
+		[]byte(`{"data":"this is a signed message","exp":"2022-01-01T00:00:00+00:00"}`),
+		[]byte(`{"kid":"zVhMiPBP9fRf2snEcT7gFTioeA9COcNy9DfgL1W60haN"}`))
+
+	require.Equal(t, "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9v3Jt8mx_TdM2ceTGoqwrh4yDFn0XsHvvV_D0DtwQxVrJEBMl0F2caAdgnpKlt4p7xBnx1HcO-SPo8FPp214HDw.eyJraWQiOiJ6VmhNaVBCUDlmUmYyc25FY1Q3Z0ZUaW9lQTlDT2NOeTlEZmdMMVc2MGhhTiJ9", token)
+}
+
+func TestPasetoV4Maker(t *testing.T) {
+	ring := newTestKeyRing(t)
+	maker := NewPasetoV4Maker(ring)
+
+	email := utils.RandomOwner()
+	token, payload, err := maker.CreateToken(email, utils.RandomString(6), uuid.New().String(), []string{string(rbac.RoleSeller)}, time.Minute)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(token, "v4.public."))
+
+	active, err := ring.Active()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(active.ID, "k4.pid."))
+
+	verified, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, email, verified.Email)
+	require.Equal(t, payload.UserID, verified.UserID)
+	require.True(t, verified.HasPermission(rbac.PermPropertyCreate))
+
+	// Tokens are verified with nothing but the public key
+	verifier := NewPasetoV4Verifier(ring)
+	_, err = verifier.VerifyToken(token)
+	require.NoError(t, err)
+	require.False(t, verified.IsImpersonation())
+}
+
+func TestPasetoV4MakerImpersonationToken(t *testing.T) {
+	maker := NewPasetoV4Maker(newTestKeyRing(t))
+
+	adminID := uuid.New().String()
+	userID := uuid.New().String()
+	token, _, err := maker.CreateImpersonationToken(adminID, utils.RandomOwner(), userID, uuid.New().String(), []string{string(rbac.RoleBuyer)}, time.Minute)
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.True(t, verified.IsImpersonation())
+	require.Equal(t, adminID, verified.ImpersonatorID)
+	require.Equal(t, userID, verified.UserID)
+	require.False(t, verified.HasPermission(rbac.PermUserAdmin))
+}
+
+func TestPasetoV4MakerOrganizationToken(t *testing.T) {
+	maker := NewPasetoV4Maker(newTestKeyRing(t))
+
+	organizationID := uuid.New().String()
+	token, _, err := maker.CreateOrganizationToken(utils.RandomOwner(), uuid.New().String(), uuid.New().String(), []string{string(rbac.RoleAgent)}, organizationID, string(rbac.OrgRoleManager), time.Minute)
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.True(t, verified.InOrganization(organizationID))
+	require.False(t, verified.InOrganization(uuid.New().String()))
+	require.Equal(t, string(rbac.OrgRoleManager), verified.OrganizationRole)
+	require.False(t, verified.IsImpersonation())
+}
+
+func TestPasetoV4MakerRejectsTampering(t *testing.T) {
+	ring := newTestKeyRing(t)
+	maker := NewPasetoV4Maker(ring)
+
+	token, _, err := maker.CreateToken(utils.RandomOwner(), utils.RandomString(6), uuid.New().String(), []string{string(rbac.RoleBuyer)}, time.Minute)
+	require.NoError(t, err)
+
+	// A token signed by another ring is not accepted even under the same claims
+	other, _, err := NewPasetoV4Maker(newTestKeyRing(t)).CreateToken(utils.RandomOwner(), utils.RandomString(6), uuid.New().String(), []string{string(rbac.RoleAdmin)}, time.Minute)
+	require.NoError(t, err)
+
+	parts := strings.Split(token, ".")
+	otherParts := strings.Split(other, ".")
+
+	testCases := map[string]string{
+		"v2 local token":    "v2.local." + parts[2],
+		"missing footer":    strings.Join(parts[:3], "."),
+		"swapped body":      strings.Join([]string{parts[0], parts[1], otherParts[2], parts[3]}, "."),
+		"unknown key id":    other,
+		"truncated payload": parts[0] + "." + parts[1] + "." + parts[2][:10] + "." + parts[3],
+	}
+
+	for name, tampered := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := maker.VerifyToken(tampered)
+			require.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestPasetoV4MakerExpiredToken(t *testing.T) {
+	maker := NewPasetoV4Maker(newTestKeyRing(t))
+
+	token, _, err := maker.CreateToken(utils.RandomOwner(), utils.RandomString(6), uuid.New().String(), nil, -time.Minute)
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(token)
+	require.ErrorIs(t, err, ErrExpiredToken)
+}
+
+func TestKeyRingRotation(t *testing.T) {
+	ring := newTestKeyRing(t)
+	maker := NewPasetoV4Maker(ring)
+
+	now := time.Now()
+	ring.now = func() time.Time { return now }
+
+	before, _, err := maker.CreateToken(utils.RandomOwner(), utils.RandomString(6), uuid.New().String(), nil, time.Hour)
+	require.NoError(t, err)
+
+	next, err := GenerateSigningKey()
+	require.NoError(t, err)
+	ring.Rotate(next, 30*time.Minute)
+
+	active, err := ring.Active()
+	require.NoError(t, err)
+	require.Equal(t, next.ID, active.ID)
+	require.Len(t, ring.PublicKeys(), 2)
+
+	// Tokens signed before the rotation stay valid while the old key is retained
+	_, err = maker.VerifyToken(before)
+	require.NoError(t, err)
+
+	after, _, err := maker.CreateToken(utils.RandomOwner(), utils.RandomString(6), uuid.New().String(), nil, time.Hour)
+	require.NoError(t, err)
+
+	// Once the retention ends the old key is no longer published or trusted
+	now = now.Add(31 * time.Minute)
+	_, err = maker.VerifyToken(before)
+	require.ErrorIs(t, err, ErrInvalidToken)
+	_, err = maker.VerifyToken(after)
+	require.NoError(t, err)
+	require.Len(t, ring.PublicKeys(), 1)
+}
+
+// publishKeys serves the ring's public keys the way the authentication service does
+func publishKeys(t *testing.T, ring *KeyRing, requests *atomic.Int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		type key struct {
+			KeyID     string     `json:"kid"`
+			PublicKey string     `json:"public_key"`
+			ExpiresAt *time.Time `json:"expires_at,omitempty"`
+		}
+		var keys []key
+		for _, k := range ring.PublicKeys() {
+			keys = append(keys, key{KeyID: k.ID, PublicKey: EncodePublicKey(k.Key), ExpiresAt: k.ExpiresAt})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRemoteKeySet(t *testing.T) {
+	ring := newTestKeyRing(t)
+	maker := NewPasetoV4Maker(ring)
+
+	var requests atomic.Int32
+	server := publishKeys(t, ring, &requests)
+
+	keySet := NewRemoteKeySet(server.URL, server.Client(), time.Hour)
+	now := time.Now()
+	keySet.now = func() time.Time { return now }
+	verifier := NewPasetoV4Verifier(keySet)
+
+	token, _, err := maker.CreateToken(utils.RandomOwner(), utils.RandomString(6), uuid.New().String(), nil, time.Hour)
+	require.NoError(t, err)
+
+	_, err = verifier.VerifyToken(token)
+	require.NoError(t, err)
+	_, err = verifier.VerifyToken(token)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, requests.Load())
+
+	// The auth service rotates; the new key ID is fetched once the minimum refresh interval passed
+	next, err := GenerateSigningKey()
+	require.NoError(t, err)
+	ring.Rotate(next, time.Hour)
+
+	rotated, _, err := maker.CreateToken(utils.RandomOwner(), utils.RandomString(6), uuid.New().String(), nil, time.Hour)
+	require.NoError(t, err)
+
+	_, err = verifier.VerifyToken(rotated)
+	require.ErrorIs(t, err, ErrInvalidToken)
+	require.EqualValues(t, 1, requests.Load())
+
+	now = now.Add(minKeyRefreshInterval)
+	_, err = verifier.VerifyToken(rotated)
+	require.NoError(t, err)
+	_, err = verifier.VerifyToken(token)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, requests.Load())
+}
+
+func TestKeyIDIsDerivedFromPublicKey(t *testing.T) {
+	key, err := GenerateSigningKey()
+	require.NoError(t, err)
+
+	publicKey := key.PrivateKey.Public().(ed25519.PublicKey)
+	decoded, err := DecodePublicKey(EncodePublicKey(publicKey))
+	require.NoError(t, err)
+	require.Equal(t, publicKey, decoded)
+	require.Equal(t, key.ID, KeyID(decoded))
+
+	other, err := GenerateSigningKey()
+	require.NoError(t, err)
+	require.NotEqual(t, key.ID, other.ID)
+}
+
+func TestPasetoV4MakerAPIKeyToken(t *testing.T) {
+	maker := NewPasetoV4Maker(newTestKeyRing(t))
+
+	apiKeyID := uuid.New().String()
+	organizationID := uuid.New().String()
+	scopes := []string{string(rbac.PermPropertyRead)}
+	token, _, err := maker.CreateAPIKeyToken(apiKeyID, utils.RandomOwner(), uuid.New().String(), []string{string(rbac.RoleAgent)}, scopes, organizationID, string(rbac.OrgRoleManager), time.Minute)
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.True(t, verified.IsAPIKey())
+	require.Equal(t, apiKeyID, verified.SessionID)
+	require.True(t, verified.InOrganization(organizationID))
+
+	// Only the key's scopes are granted, not every permission of the role
+	require.True(t, verified.HasPermission(rbac.PermPropertyRead))
+	require.False(t, verified.HasPermission(rbac.PermPropertyCreate))
+}
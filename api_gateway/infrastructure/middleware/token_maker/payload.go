@@ -2,20 +2,37 @@ package token_maker
 
 import (
 	"time"
+
+	"github.com/demola234/shared/rbac"
 )
 
 type Payload struct {
-	Email     string    `json:"email"`
-	UserID    string    `json:"user_id"`
+	Email     string   `json:"email"`
+	UserID    string   `json:"user_id"`
+	SessionID string   `json:"session_id"`
+	Roles     []string `json:"roles"`
+	Scopes    []string `json:"scopes"`
+	// ImpersonatorID is the admin acting as the user, empty for the user's own tokens
+	ImpersonatorID string `json:"impersonator_id,omitempty"`
+	// OrganizationID is the organization the session acts for and
+	// OrganizationRole the user's role in it, both empty outside one
+	OrganizationID   string `json:"organization_id,omitempty"`
+	OrganizationRole string `json:"organization_role,omitempty"`
+	// APIKeyID is the API key the token was exchanged for, empty for tokens
+	// from an interactive sign in. Such tokens use it as their session ID.
+	APIKeyID  string    `json:"api_key_id,omitempty"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expired_at"`
 }
 
-func NewPayload(username string, userID string, duration time.Duration) (*Payload, error) {
+func NewPayload(username string, userID string, sessionID string, roles []string, duration time.Duration) (*Payload, error) {
 
 	payload := &Payload{
 		Email:     username,
 		UserID:    userID,
+		SessionID: sessionID,
+		Roles:     roles,
+		Scopes:    rbac.ScopesForRoles(roles),
 		IssuedAt:  time.Now(),
 		ExpiredAt: time.Now().Add(duration),
 	}
@@ -23,6 +40,46 @@ func NewPayload(username string, userID string, duration time.Duration) (*Payloa
 	return payload, nil
 }
 
+// NewImpersonationPayload creates a payload for an admin acting as the user
+func NewImpersonationPayload(impersonatorID string, email string, userID string, sessionID string, roles []string, duration time.Duration) (*Payload, error) {
+	payload, err := NewPayload(email, userID, sessionID, roles, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.ImpersonatorID = impersonatorID
+
+	return payload, nil
+}
+
+// NewOrganizationPayload creates a payload for a user acting for an organization
+func NewOrganizationPayload(email string, userID string, sessionID string, roles []string, organizationID string, organizationRole string, duration time.Duration) (*Payload, error) {
+	payload, err := NewPayload(email, userID, sessionID, roles, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.OrganizationID = organizationID
+	payload.OrganizationRole = organizationRole
+
+	return payload, nil
+}
+
+// NewAPIKeyPayload creates a payload for an API key acting as the user who
+// created it. It carries the key's scopes rather than all those of the user's
+// roles, and the organization the key belongs to, if any.
+func NewAPIKeyPayload(apiKeyID string, email string, userID string, roles []string, scopes []string, organizationID string, organizationRole string, duration time.Duration) (*Payload, error) {
+	payload, err := NewOrganizationPayload(email, userID, apiKeyID, roles, organizationID, organizationRole, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.Scopes = scopes
+	payload.APIKeyID = apiKeyID
+
+	return payload, nil
+}
+
 func (payload *Payload) Valid() error {
 	if time.Now().After(payload.ExpiredAt) {
 		return ErrExpiredToken
@@ -30,3 +87,23 @@ func (payload *Payload) Valid() error {
 
 	return nil
 }
+
+// HasPermission reports whether the token grants the permission
+func (payload *Payload) HasPermission(perm rbac.Permission) bool {
+	return rbac.HasPermission(payload.Scopes, perm)
+}
+
+// IsImpersonation reports whether an admin is acting as the user
+func (payload *Payload) IsImpersonation() bool {
+	return payload.ImpersonatorID != ""
+}
+
+// IsAPIKey reports whether the token was exchanged for an API key
+func (payload *Payload) IsAPIKey() bool {
+	return payload.APIKeyID != ""
+}
+
+// InOrganization reports whether the token acts for the organization
+func (payload *Payload) InOrganization(organizationID string) bool {
+	return payload.OrganizationID != "" && payload.OrganizationID == organizationID
+}
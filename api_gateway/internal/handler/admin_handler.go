@@ -0,0 +1,389 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	errorResponse "github.com/demola234/api_gateway/infrastructure/error_response"
+	"github.com/demola234/api_gateway/infrastructure/grpc_clients"
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminHandler forwards support staff requests to the authentication
+// service's AdminService along with the caller's token, which it checks again.
+type AdminHandler struct {
+	AuthClient *grpc_clients.AuthenticationClient
+}
+
+func NewAdminHandler(authClient *grpc_clients.AuthenticationClient) *AdminHandler {
+	return &AdminHandler{AuthClient: authClient}
+}
+
+// ListUsers handles searching users by email, name, role, provider and account state
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	req := pb.ListUsersRequest{
+		Email:    c.Query("email"),
+		Name:     c.Query("name"),
+		Role:     c.Query("role"),
+		Provider: c.Query("provider"),
+	}
+
+	var err error
+	if req.IsActive, err = optionalBoolQuery(c, "is_active"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid is_active value"})
+		return
+	}
+	if req.Deleted, err = optionalBoolQuery(c, "deleted"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid deleted value"})
+		return
+	}
+	if req.Limit, err = int32Query(c, "limit"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit value"})
+		return
+	}
+	if req.Offset, err = int32Query(c, "offset"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset value"})
+		return
+	}
+
+	res, err := h.AuthClient.Admin.ListUsers(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetUser handles viewing a user's account
+func (h *AdminHandler) GetUser(c *gin.Context) {
+	res, err := h.AuthClient.Admin.GetUserDetails(forwardedContext(c), &pb.GetUserDetailsRequest{
+		UserId: c.Param("user_id"),
+	})
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListUserSessions handles listing a user's active sessions
+func (h *AdminHandler) ListUserSessions(c *gin.Context) {
+	res, err := h.AuthClient.Admin.ListUserSessions(forwardedContext(c), &pb.ListUserSessionsRequest{
+		UserId: c.Param("user_id"),
+	})
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListUserLoginHistory handles listing a user's most recent logins
+func (h *AdminHandler) ListUserLoginHistory(c *gin.Context) {
+	limit, err := int32Query(c, "limit")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit value"})
+		return
+	}
+
+	res, err := h.AuthClient.Admin.ListUserLoginHistory(forwardedContext(c), &pb.ListUserLoginHistoryRequest{
+		UserId: c.Param("user_id"),
+		Limit:  limit,
+	})
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ForceLogout handles ending every session of a user
+func (h *AdminHandler) ForceLogout(c *gin.Context) {
+	res, err := h.AuthClient.Admin.ForceLogout(forwardedContext(c), &pb.ForceLogoutRequest{
+		UserId: c.Param("user_id"),
+	})
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// LockUser handles locking a user's account
+func (h *AdminHandler) LockUser(c *gin.Context) {
+	var req pb.LockUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	req.UserId = c.Param("user_id")
+
+	res, err := h.AuthClient.Admin.LockUser(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// UnlockUser handles lifting a lock from a user's account
+func (h *AdminHandler) UnlockUser(c *gin.Context) {
+	res, err := h.AuthClient.Admin.UnlockUser(forwardedContext(c), &pb.UnlockUserRequest{
+		UserId: c.Param("user_id"),
+	})
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ChangeUserRole handles assigning a new role to a user
+func (h *AdminHandler) ChangeUserRole(c *gin.Context) {
+	var req pb.ChangeUserRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	req.UserId = c.Param("user_id")
+
+	res, err := h.AuthClient.Admin.ChangeUserRole(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// TriggerPasswordReset handles emailing a user a password reset code
+func (h *AdminHandler) TriggerPasswordReset(c *gin.Context) {
+	res, err := h.AuthClient.Admin.TriggerPasswordReset(forwardedContext(c), &pb.TriggerPasswordResetRequest{
+		UserId: c.Param("user_id"),
+	})
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteUser handles soft-deleting a user's account
+func (h *AdminHandler) DeleteUser(c *gin.Context) {
+	res, err := h.AuthClient.Admin.DeleteUser(forwardedContext(c), &pb.DeleteUserRequest{
+		UserId: c.Param("user_id"),
+	})
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RestoreUser handles restoring a soft-deleted user's account
+func (h *AdminHandler) RestoreUser(c *gin.Context) {
+	res, err := h.AuthClient.Admin.RestoreUser(forwardedContext(c), &pb.RestoreUserRequest{
+		UserId: c.Param("user_id"),
+	})
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ImpersonateUser handles issuing a short-lived token to act as a user
+func (h *AdminHandler) ImpersonateUser(c *gin.Context) {
+	var req pb.ImpersonateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	req.UserId = c.Param("user_id")
+
+	res, err := h.AuthClient.Admin.ImpersonateUser(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListAuthEvents handles searching the security audit log
+func (h *AdminHandler) ListAuthEvents(c *gin.Context) {
+	req := pb.ListAuthEventsRequest{
+		UserId:    c.Query("user_id"),
+		ActorId:   c.Query("actor_id"),
+		EventType: c.Query("event_type"),
+		Outcome:   c.Query("outcome"),
+		IpAddress: c.Query("ip_address"),
+	}
+
+	var err error
+	if req.Since, err = timestampQuery(c, "since"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid since value, expected RFC 3339"})
+		return
+	}
+	if req.Until, err = timestampQuery(c, "until"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid until value, expected RFC 3339"})
+		return
+	}
+	if req.Limit, err = int32Query(c, "limit"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit value"})
+		return
+	}
+	if req.Offset, err = int32Query(c, "offset"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset value"})
+		return
+	}
+
+	res, err := h.AuthClient.Admin.ListAuthEvents(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetUserErasureStatus handles following a deleted account through its erasure
+func (h *AdminHandler) GetUserErasureStatus(c *gin.Context) {
+	res, err := h.AuthClient.Admin.GetUserErasureStatus(forwardedContext(c), &pb.GetUserErasureStatusRequest{
+		UserId: c.Param("user_id"),
+	})
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListAgentVerifications handles listing the agent verification review queue
+func (h *AdminHandler) ListAgentVerifications(c *gin.Context) {
+	req := pb.ListAgentVerificationsRequest{
+		Status: c.Query("status"),
+	}
+
+	var err error
+	if req.Limit, err = int32Query(c, "limit"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit value"})
+		return
+	}
+	if req.Offset, err = int32Query(c, "offset"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset value"})
+		return
+	}
+
+	res, err := h.AuthClient.Admin.ListAgentVerifications(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetAgentVerification handles fetching an agent's verification and uploaded documents
+func (h *AdminHandler) GetAgentVerification(c *gin.Context) {
+	res, err := h.AuthClient.Admin.GetAgentVerificationDetails(forwardedContext(c), &pb.GetAgentVerificationDetailsRequest{
+		UserId: c.Param("user_id"),
+	})
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ReviewAgentVerification handles approving, rejecting or asking for more information on a verification
+func (h *AdminHandler) ReviewAgentVerification(c *gin.Context) {
+	var req pb.ReviewAgentVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	req.UserId = c.Param("user_id")
+
+	res, err := h.AuthClient.Admin.ReviewAgentVerification(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(adminHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func optionalBoolQuery(c *gin.Context, key string) (*bool, error) {
+	value, ok := c.GetQuery(key)
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func int32Query(c *gin.Context, key string) (int32, error) {
+	value := c.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 32)
+	return int32(parsed), err
+}
+
+func timestampQuery(c *gin.Context, key string) (*timestamppb.Timestamp, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(parsed), nil
+}
+
+func adminHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	errorResponse "github.com/demola234/api_gateway/infrastructure/error_response"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UploadAgentDocument handles uploading an ID, license or business registration scan for agent verification
+func (h *AuthHandler) UploadAgentDocument(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	document, err := c.FormFile("content")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read document file"})
+		return
+	}
+
+	documentData, err := document.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to open document file"})
+		return
+	}
+	defer documentData.Close()
+
+	fileBytes, err := io.ReadAll(documentData)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read document data"})
+		return
+	}
+
+	res, err := h.AuthClient.Client.UploadAgentDocument(forwardedContext(c), &pb.UploadAgentDocumentRequest{
+		UserId:       userID,
+		DocumentType: c.PostForm("document_type"),
+		Content:      fileBytes,
+	})
+	if err != nil {
+		c.JSON(agentVerificationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// SubmitAgentVerification handles sending the agent's license and business details for review
+func (h *AuthHandler) SubmitAgentVerification(c *gin.Context) {
+	var req pb.SubmitAgentVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	req.UserId = authPayload.(*token.Payload).UserID
+
+	res, err := h.AuthClient.Client.SubmitAgentVerification(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(agentVerificationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, res)
+}
+
+// GetAgentVerification handles checking the status of the agent's verification
+func (h *AuthHandler) GetAgentVerification(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	res, err := h.AuthClient.Client.GetAgentVerification(forwardedContext(c), &pb.GetAgentVerificationRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		c.JSON(agentVerificationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func agentVerificationHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
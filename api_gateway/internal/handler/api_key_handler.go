@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	errorResponse "github.com/demola234/api_gateway/infrastructure/error_response"
+	"github.com/demola234/api_gateway/infrastructure/grpc_clients"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// APIKeyHandler forwards API key management requests to the authentication
+// service's ApiKeyService on behalf of the signed in user.
+type APIKeyHandler struct {
+	AuthClient *grpc_clients.AuthenticationClient
+}
+
+func NewAPIKeyHandler(authClient *grpc_clients.AuthenticationClient) *APIKeyHandler {
+	return &APIKeyHandler{AuthClient: authClient}
+}
+
+// createAPIKeyRequest takes the expiry as an RFC 3339 time rather than a protobuf timestamp
+type createAPIKeyRequest struct {
+	OrganizationID string     `json:"organization_id"`
+	Name           string     `json:"name" binding:"required"`
+	Scopes         []string   `json:"scopes" binding:"required"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+}
+
+// CreateAPIKey handles creating an API key for the user or one of their organizations
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	payload, ok := apiKeyCaller(c)
+	if !ok {
+		return
+	}
+
+	var req createAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	pbReq := &pb.CreateApiKeyRequest{
+		UserId:         payload.UserID,
+		OrganizationId: req.OrganizationID,
+		Name:           req.Name,
+		Scopes:         req.Scopes,
+	}
+	if req.ExpiresAt != nil {
+		pbReq.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+
+	res, err := h.AuthClient.ApiKeys.CreateApiKey(forwardedContext(c), pbReq)
+	if err != nil {
+		c.JSON(apiKeyHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// ListAPIKeys handles listing the user's API keys, or an organization's with ?organization_id=
+func (h *APIKeyHandler) ListAPIKeys(c *gin.Context) {
+	payload, ok := apiKeyCaller(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.AuthClient.ApiKeys.ListApiKeys(forwardedContext(c), &pb.ListApiKeysRequest{
+		UserId:         payload.UserID,
+		OrganizationId: c.Query("organization_id"),
+	})
+	if err != nil {
+		c.JSON(apiKeyHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RevokeAPIKey handles stopping an API key from working
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	payload, ok := apiKeyCaller(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.AuthClient.ApiKeys.RevokeApiKey(forwardedContext(c), &pb.RevokeApiKeyRequest{
+		UserId:   payload.UserID,
+		ApiKeyId: c.Param("api_key_id"),
+	})
+	if err != nil {
+		c.JSON(apiKeyHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func apiKeyCaller(c *gin.Context) (*token.Payload, bool) {
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return nil, false
+	}
+	return authPayload.(*token.Payload), true
+}
+
+func apiKeyHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
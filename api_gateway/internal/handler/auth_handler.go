@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"context"
 	"io"
 	"net/http"
 	"strconv"
@@ -12,6 +11,8 @@ import (
 	pb "github.com/demola234/authentication/infrastructure/api/grpc"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AuthHandler struct {
@@ -29,7 +30,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		return
 	}
 
-	res, err := h.AuthClient.Client.Register(context.Background(), &req)
+	res, err := h.AuthClient.Client.Register(forwardedContext(c), &req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -45,8 +46,11 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	res, err := h.AuthClient.Client.Login(context.Background(), &req)
+	res, err := h.AuthClient.Client.Login(forwardedContext(c), &req)
 	if err != nil {
+		if lockedOut(c, err) {
+			return
+		}
 		c.JSON(http.StatusUnauthorized, errorResponse.ErrInvalidRequest)
 		return
 	}
@@ -54,6 +58,23 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
+// RefreshToken handles exchanging a refresh token for a new token pair
+func (h *AuthHandler) RefreshToken(c *gin.Context) {
+	var req pb.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	res, err := h.AuthClient.Client.RefreshToken(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
 func (h *AuthHandler) GetUser(c *gin.Context) {
 	// Get userID from authorization payload in context
 	authPayload, exists := c.Get("authorization_payload")
@@ -64,7 +85,7 @@ func (h *AuthHandler) GetUser(c *gin.Context) {
 
 	userID := authPayload.(*token.Payload).UserID
 
-	res, err := h.AuthClient.Client.GetUser(context.Background(), &pb.GetUserRequest{UserId: userID})
+	res, err := h.AuthClient.Client.GetUser(forwardedContext(c), &pb.GetUserRequest{UserId: userID})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -80,8 +101,11 @@ func (h *AuthHandler) VerifyUser(c *gin.Context) {
 		return
 	}
 
-	res, err := h.AuthClient.Client.VerifyUser(context.Background(), &req)
+	res, err := h.AuthClient.Client.VerifyUser(forwardedContext(c), &req)
 	if err != nil {
+		if lockedOut(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -96,7 +120,7 @@ func (h *AuthHandler) ResendOtp(c *gin.Context) {
 		return
 	}
 
-	res, err := h.AuthClient.Client.ResendOtp(context.Background(), &req)
+	res, err := h.AuthClient.Client.ResendOtp(forwardedContext(c), &req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -114,13 +138,15 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 		return
 	}
 
-	userID := authPayload.(*token.Payload)
+	payload := authPayload.(*token.Payload)
 
+	// Revoking the session makes the gateway reject the token right away
 	req := pb.LogOutRequest{
-		UserId: userID.UserID,
+		UserId:    payload.UserID,
+		SessionId: payload.SessionID,
 	}
 
-	res, err := h.AuthClient.Client.LogOut(context.Background(), &req)
+	res, err := h.AuthClient.Client.LogOut(forwardedContext(c), &req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -137,9 +163,9 @@ func (h *AuthHandler) OAuthLogin(c *gin.Context) {
 		return
 	}
 
-	res, err := h.AuthClient.Client.OAuthLogin(context.Background(), &req)
+	res, err := h.AuthClient.Client.OAuthLogin(forwardedContext(c), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(identityHTTPStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -153,9 +179,9 @@ func (h *AuthHandler) OAuthRegister(c *gin.Context) {
 		return
 	}
 
-	res, err := h.AuthClient.Client.OAuthRegister(context.Background(), &req)
+	res, err := h.AuthClient.Client.OAuthRegister(forwardedContext(c), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(identityHTTPStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -190,7 +216,7 @@ func (h *AuthHandler) UploadImage(c *gin.Context) {
 		return
 	}
 
-	res, err := h.AuthClient.Client.UploadImage(context.Background(), &pb.UploadImageRequest{
+	res, err := h.AuthClient.Client.UploadImage(forwardedContext(c), &pb.UploadImageRequest{
 		UserId:  userID,
 		Content: fileBytes,
 	})
@@ -211,7 +237,7 @@ func (h *AuthHandler) ForgotPassword(c *gin.Context) {
 		return
 	}
 
-	res, err := h.AuthClient.Client.ForgotPassword(context.Background(), &req)
+	res, err := h.AuthClient.Client.ForgotPassword(forwardedContext(c), &req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -228,8 +254,11 @@ func (h *AuthHandler) VerifyResetPassword(c *gin.Context) {
 		return
 	}
 
-	res, err := h.AuthClient.Client.VerifyResetPassword(context.Background(), &req)
+	res, err := h.AuthClient.Client.VerifyResetPassword(forwardedContext(c), &req)
 	if err != nil {
+		if lockedOut(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -245,7 +274,7 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 		return
 	}
 
-	res, err := h.AuthClient.Client.ResetPassword(context.Background(), &req)
+	res, err := h.AuthClient.Client.ResetPassword(forwardedContext(c), &req)
 	if err != nil {
 		if err.Error() == "You must verify the code before resetting your password" {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -280,7 +309,7 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 	}
 
 	// Create context with user ID for the gRPC service
-	ctx := context.Background()
+	ctx := forwardedContext(c)
 
 	// Call the gRPC service
 	res, err := h.AuthClient.Client.ChangePassword(ctx, &req)
@@ -312,7 +341,7 @@ func (h *AuthHandler) GetProfile(c *gin.Context) {
 	userID := authPayload.(*token.Payload).UserID
 
 	// Create context
-	ctx := context.Background()
+	ctx := forwardedContext(c)
 
 	// Call the gRPC service
 	res, err := h.AuthClient.Client.GetProfile(ctx, &pb.GetProfileRequest{
@@ -347,7 +376,7 @@ func (h *AuthHandler) UpdateProfile(c *gin.Context) {
 	}
 
 	// Create context
-	ctx := context.Background()
+	ctx := forwardedContext(c)
 
 	// Call the gRPC service
 	res, err := h.AuthClient.Client.UpdateProfile(ctx, &req)
@@ -370,7 +399,7 @@ func (h *AuthHandler) GetSessions(c *gin.Context) {
 	userID := authPayload.(*token.Payload).UserID
 
 	// Create context
-	ctx := context.Background()
+	ctx := forwardedContext(c)
 
 	// Call the gRPC service
 	res, err := h.AuthClient.Client.GetSessions(ctx, &pb.GetSessionsRequest{
@@ -402,7 +431,7 @@ func (h *AuthHandler) RevokeSession(c *gin.Context) {
 	}
 
 	// Create context
-	ctx := context.Background()
+	ctx := forwardedContext(c)
 
 	// Call the gRPC service
 	res, err := h.AuthClient.Client.RevokeSession(ctx, &pb.RevokeSessionRequest{
@@ -442,7 +471,7 @@ func (h *AuthHandler) DeactivateAccount(c *gin.Context) {
 	req.UserId = userID
 
 	// Create context
-	ctx := context.Background()
+	ctx := forwardedContext(c)
 
 	// Call the gRPC service
 	res, err := h.AuthClient.Client.DeactivateAccount(ctx, &req)
@@ -459,7 +488,7 @@ func (h *AuthHandler) DeactivateAccount(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
-// DeleteAccount handles permanently deleting a user account
+// DeleteAccount handles scheduling a user account for deletion
 func (h *AuthHandler) DeleteAccount(c *gin.Context) {
 	// Get user ID from authorization payload
 	authPayload, exists := c.Get("authorization_payload")
@@ -479,7 +508,7 @@ func (h *AuthHandler) DeleteAccount(c *gin.Context) {
 	req.UserId = userID
 
 	// Create context
-	ctx := context.Background()
+	ctx := forwardedContext(c)
 
 	// Call the gRPC service
 	res, err := h.AuthClient.Client.DeleteAccount(ctx, &req)
@@ -489,6 +518,10 @@ func (h *AuthHandler) DeleteAccount(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Password is incorrect"})
 			return
 		}
+		if status.Code(err) == codes.FailedPrecondition {
+			c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -514,7 +547,7 @@ func (h *AuthHandler) GetLoginHistory(c *gin.Context) {
 	}
 
 	// Create context
-	ctx := context.Background()
+	ctx := forwardedContext(c)
 
 	// Call the gRPC service
 	res, err := h.AuthClient.Client.GetLoginHistory(ctx, &pb.GetLoginHistoryRequest{
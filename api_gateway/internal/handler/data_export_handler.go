@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestDataExport handles queueing a ZIP of everything held about the user
+func (h *AuthHandler) RequestDataExport(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	res, err := h.AuthClient.Client.RequestDataExport(forwardedContext(c), &pb.RequestDataExportRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		c.JSON(dataExportHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, res)
+}
+
+// GetDataExport handles checking whether one of the user's data exports is ready
+func (h *AuthHandler) GetDataExport(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	res, err := h.AuthClient.Client.GetDataExport(forwardedContext(c), &pb.GetDataExportRequest{
+		ExportId: c.Param("export_id"),
+		UserId:   userID,
+	})
+	if err != nil {
+		c.JSON(dataExportHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DownloadDataExport handles streaming the ZIP of a ready export with the token from the emailed link
+func (h *AuthHandler) DownloadDataExport(c *gin.Context) {
+	// Stop the stream if the client goes away
+	ctx, cancel := context.WithCancel(forwardedContext(c))
+	defer cancel()
+
+	stream, err := h.AuthClient.Client.DownloadDataExport(ctx, &pb.DownloadDataExportRequest{
+		ExportId: c.Param("export_id"),
+		Token:    c.Query("token"),
+	})
+	if err != nil {
+		c.JSON(dataExportHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	// Errors such as an invalid token arrive with the first message, before
+	// anything has been written
+	first, err := stream.Recv()
+	if err != nil {
+		c.JSON(dataExportHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", first.FileName))
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, first.ContentType, first.Chunk)
+
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			// The status was already sent, so the client only sees a truncated file
+			_ = c.Error(err)
+			return
+		}
+		if _, err := c.Writer.Write(res.Chunk); err != nil {
+			return
+		}
+	}
+}
+
+func dataExportHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"net/http"
+
+	errorResponse "github.com/demola234/api_gateway/infrastructure/error_response"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestEmailChange handles sending a confirmation code to the email the user wants to switch to
+func (h *AuthHandler) RequestEmailChange(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	var req pb.RequestEmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	req.UserId = userID
+
+	res, err := h.AuthClient.Client.RequestEmailChange(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(emailChangeHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ConfirmEmailChange handles switching to the new email with the code sent to it
+func (h *AuthHandler) ConfirmEmailChange(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	var req pb.ConfirmEmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	req.UserId = userID
+
+	res, err := h.AuthClient.Client.ConfirmEmailChange(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(emailChangeHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RevertEmailChange handles restoring the previous email with the token from the link sent to it
+func (h *AuthHandler) RevertEmailChange(c *gin.Context) {
+	var req pb.RevertEmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	res, err := h.AuthClient.Client.RevertEmailChange(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(emailChangeHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func emailChangeHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"net/http"
+
+	errorResponse "github.com/demola234/api_gateway/infrastructure/error_response"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LinkIdentity handles linking an OAuth provider account to the user
+func (h *AuthHandler) LinkIdentity(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	var req pb.LinkIdentityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	req.UserId = userID
+
+	res, err := h.AuthClient.Client.LinkIdentity(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(identityHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListIdentities handles listing the OAuth provider accounts linked to the user
+func (h *AuthHandler) ListIdentities(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	res, err := h.AuthClient.Client.ListIdentities(forwardedContext(c), &pb.ListIdentitiesRequest{UserId: userID})
+	if err != nil {
+		c.JSON(identityHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// UnlinkIdentity handles removing an OAuth provider account from the user
+func (h *AuthHandler) UnlinkIdentity(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	identityID := c.Param("identity_id")
+	if identityID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "identity ID is required"})
+		return
+	}
+
+	res, err := h.AuthClient.Client.UnlinkIdentity(forwardedContext(c), &pb.UnlinkIdentityRequest{
+		IdentityId: identityID,
+		UserId:     userID,
+	})
+	if err != nil {
+		c.JSON(identityHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func identityHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
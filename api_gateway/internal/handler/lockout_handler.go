@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+
+	errorResponse "github.com/demola234/api_gateway/infrastructure/error_response"
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnlockAccount handles lifting a brute-force lockout from a user's account
+func (h *AuthHandler) UnlockAccount(c *gin.Context) {
+	var req pb.UnlockAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	res, err := h.AuthClient.Client.UnlockAccount(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// forwardedContext passes the caller's IP on to the services so failed
+// attempts can be tracked per IP, along with the access token of the signed in
+// user, if any, for the services to verify. The IP is only taken from
+// X-Forwarded-For when the request came through a trusted proxy.
+func forwardedContext(c *gin.Context) context.Context {
+	ctx := metadata.AppendToOutgoingContext(c.Request.Context(), "x-forwarded-for", c.ClientIP())
+	if accessToken := c.GetString("authorization_token"); accessToken != "" {
+		ctx = middleware.WithAccessToken(ctx, accessToken)
+	}
+	return ctx
+}
+
+// lockedOut writes a 429 response with a Retry-After header when the
+// authentication service rejected the call because of a lockout
+func lockedOut(c *gin.Context, err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return false
+	}
+
+	for _, detail := range st.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			seconds := math.Ceil(retryInfo.GetRetryDelay().AsDuration().Seconds())
+			c.Header("Retry-After", strconv.Itoa(int(seconds)))
+		}
+	}
+
+	c.JSON(http.StatusTooManyRequests, gin.H{"error": st.Message()})
+	return true
+}
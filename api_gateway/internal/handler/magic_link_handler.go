@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	errorResponse "github.com/demola234/api_gateway/infrastructure/error_response"
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestMagicLink handles emailing a passwordless sign-in link
+func (h *AuthHandler) RequestMagicLink(c *gin.Context) {
+	var req pb.RequestMagicLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	res, err := h.AuthClient.Client.RequestMagicLink(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(magicLinkHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ConsumeMagicLink handles signing in with the token from a magic link
+func (h *AuthHandler) ConsumeMagicLink(c *gin.Context) {
+	var req pb.ConsumeMagicLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	res, err := h.AuthClient.Client.ConsumeMagicLink(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(magicLinkHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func magicLinkHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	}
+	return http.StatusInternalServerError
+}
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"context"
 	"net/http"
 
 	errorResponse "github.com/demola234/api_gateway/infrastructure/error_response"
@@ -26,7 +25,7 @@ func (h *MessageHandler) GetMessages(c *gin.Context) {
 		return
 	}
 
-	res, err := h.MessageClient.Client.GetMessages(context.Background(), &req)
+	res, err := h.MessageClient.Client.GetMessages(forwardedContext(c), &req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -42,7 +41,7 @@ func (h *MessageHandler) SendMessage(c *gin.Context) {
 		return
 	}
 
-	res, err := h.MessageClient.Client.SendMessage(context.Background(), &req)
+	res, err := h.MessageClient.Client.SendMessage(forwardedContext(c), &req)
 
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -60,7 +59,7 @@ func (h *MessageHandler) GetConversationBetweenUser(c *gin.Context) {
 		return
 	}
 
-	res, err := h.MessageClient.Client.GetConversationBetweenUsers(context.Background(), &req)
+	res, err := h.MessageClient.Client.GetConversationBetweenUsers(forwardedContext(c), &req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -76,7 +75,7 @@ func (h *MessageHandler) GetConversationByID(c *gin.Context) {
 		return
 	}
 
-	res, err := h.MessageClient.Client.GetConversationBetweenUsers(context.Background(), &pb.GetConversationBetweenUsersRequest{User1Id: req.User1Id, User2Id: req.User2Id})
+	res, err := h.MessageClient.Client.GetConversationBetweenUsers(forwardedContext(c), &pb.GetConversationBetweenUsersRequest{User1Id: req.User1Id, User2Id: req.User2Id})
 
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"net/http"
+
+	errorResponse "github.com/demola234/api_gateway/infrastructure/error_response"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EnrollMfa handles starting TOTP enrollment for the user
+func (h *AuthHandler) EnrollMfa(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	res, err := h.AuthClient.Client.EnrollMfa(forwardedContext(c), &pb.EnrollMfaRequest{UserId: userID})
+	if err != nil {
+		c.JSON(mfaHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ConfirmMfa handles activating TOTP with the first code from the authenticator app
+func (h *AuthHandler) ConfirmMfa(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	var req pb.ConfirmMfaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	req.UserId = userID
+
+	res, err := h.AuthClient.Client.ConfirmMfa(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(mfaHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// VerifyMfa handles completing a login that requires a second factor
+func (h *AuthHandler) VerifyMfa(c *gin.Context) {
+	var req pb.VerifyMfaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	res, err := h.AuthClient.Client.VerifyMfa(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(mfaHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DisableMfa handles turning off MFA for the user
+func (h *AuthHandler) DisableMfa(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	var req pb.DisableMfaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	req.UserId = userID
+
+	res, err := h.AuthClient.Client.DisableMfa(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(mfaHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RegenerateRecoveryCodes handles replacing the user's MFA recovery codes
+func (h *AuthHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	// Get user ID from authorization payload
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return
+	}
+	userID := authPayload.(*token.Payload).UserID
+
+	var req pb.RegenerateRecoveryCodesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	req.UserId = userID
+
+	res, err := h.AuthClient.Client.RegenerateRecoveryCodes(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(mfaHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// mfaHTTPStatus maps the gRPC status returned by the MFA RPCs to an HTTP status
+func mfaHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	}
+	return http.StatusInternalServerError
+}
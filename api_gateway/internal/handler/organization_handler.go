@@ -0,0 +1,282 @@
+package handler
+
+import (
+	"net/http"
+
+	errorResponse "github.com/demola234/api_gateway/infrastructure/error_response"
+	"github.com/demola234/api_gateway/infrastructure/grpc_clients"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrganizationHandler forwards organization requests to the authentication
+// service's OrganizationService on behalf of the signed in user.
+type OrganizationHandler struct {
+	AuthClient *grpc_clients.AuthenticationClient
+}
+
+func NewOrganizationHandler(authClient *grpc_clients.AuthenticationClient) *OrganizationHandler {
+	return &OrganizationHandler{AuthClient: authClient}
+}
+
+// CreateOrganization handles creating an organization owned by the user
+func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
+	payload, ok := organizationCaller(c)
+	if !ok {
+		return
+	}
+
+	var req pb.CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+	req.UserId = payload.UserID
+
+	res, err := h.AuthClient.Organization.CreateOrganization(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// ListOrganizations handles listing the organizations the user is a member of
+func (h *OrganizationHandler) ListOrganizations(c *gin.Context) {
+	payload, ok := organizationCaller(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.AuthClient.Organization.ListOrganizations(forwardedContext(c), &pb.ListOrganizationsRequest{
+		UserId: payload.UserID,
+	})
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetOrganization handles viewing an organization and its members
+func (h *OrganizationHandler) GetOrganization(c *gin.Context) {
+	payload, ok := organizationCaller(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.AuthClient.Organization.GetOrganization(forwardedContext(c), &pb.GetOrganizationRequest{
+		UserId:         payload.UserID,
+		OrganizationId: c.Param("organization_id"),
+	})
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// InviteMember handles emailing an invitation to join the organization
+func (h *OrganizationHandler) InviteMember(c *gin.Context) {
+	payload, ok := organizationCaller(c)
+	if !ok {
+		return
+	}
+
+	var req pb.InviteOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+	req.UserId = payload.UserID
+	req.OrganizationId = c.Param("organization_id")
+
+	res, err := h.AuthClient.Organization.InviteOrganizationMember(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// ListInvitations handles listing the organization's unanswered invitations
+func (h *OrganizationHandler) ListInvitations(c *gin.Context) {
+	payload, ok := organizationCaller(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.AuthClient.Organization.ListOrganizationInvitations(forwardedContext(c), &pb.ListOrganizationInvitationsRequest{
+		UserId:         payload.UserID,
+		OrganizationId: c.Param("organization_id"),
+	})
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RevokeInvitation handles cancelling a pending invitation
+func (h *OrganizationHandler) RevokeInvitation(c *gin.Context) {
+	payload, ok := organizationCaller(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.AuthClient.Organization.RevokeOrganizationInvitation(forwardedContext(c), &pb.RevokeOrganizationInvitationRequest{
+		UserId:         payload.UserID,
+		OrganizationId: c.Param("organization_id"),
+		InvitationId:   c.Param("invitation_id"),
+	})
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// AcceptInvitation handles joining an organization with the token from an invitation email
+func (h *OrganizationHandler) AcceptInvitation(c *gin.Context) {
+	payload, ok := organizationCaller(c)
+	if !ok {
+		return
+	}
+
+	var req pb.AcceptOrganizationInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+	req.UserId = payload.UserID
+
+	res, err := h.AuthClient.Organization.AcceptOrganizationInvitation(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DeclineInvitation handles turning down an invitation with the token from its email
+func (h *OrganizationHandler) DeclineInvitation(c *gin.Context) {
+	var req pb.DeclineOrganizationInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+
+	res, err := h.AuthClient.Organization.DeclineOrganizationInvitation(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateMemberRole handles changing a member's role
+func (h *OrganizationHandler) UpdateMemberRole(c *gin.Context) {
+	payload, ok := organizationCaller(c)
+	if !ok {
+		return
+	}
+
+	var req pb.UpdateOrganizationMemberRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+	req.UserId = payload.UserID
+	req.OrganizationId = c.Param("organization_id")
+	req.MemberId = c.Param("user_id")
+
+	res, err := h.AuthClient.Organization.UpdateOrganizationMemberRole(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RemoveMember handles removing a member, or the user leaving when it is their own ID
+func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
+	payload, ok := organizationCaller(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.AuthClient.Organization.RemoveOrganizationMember(forwardedContext(c), &pb.RemoveOrganizationMemberRequest{
+		UserId:         payload.UserID,
+		OrganizationId: c.Param("organization_id"),
+		MemberId:       c.Param("user_id"),
+	})
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// SwitchOrganization handles choosing the organization the current session
+// acts for. An empty organization_id switches back to acting for none.
+func (h *OrganizationHandler) SwitchOrganization(c *gin.Context) {
+	payload, ok := organizationCaller(c)
+	if !ok {
+		return
+	}
+
+	var req pb.SwitchOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorResponse.ErrInvalidRequest)
+		return
+	}
+	req.UserId = payload.UserID
+	req.SessionId = payload.SessionID
+
+	res, err := h.AuthClient.Organization.SwitchOrganization(forwardedContext(c), &req)
+	if err != nil {
+		c.JSON(organizationHTTPStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// organizationCaller returns the verified token payload of the caller, or
+// writes a 401 response if there is none
+func organizationCaller(c *gin.Context) (*token.Payload, bool) {
+	authPayload, exists := c.Get("authorization_payload")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization payload not found"})
+		return nil, false
+	}
+	return authPayload.(*token.Payload), true
+}
+
+func organizationHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"context"
 	"net/http"
 	"strconv"
 
@@ -40,7 +39,7 @@ func (h *PropertyHandler) GetProperties(c *gin.Context) {
 	offsetInt32 := int32(offset)
 
 	// Call the gRPC client with the converted parameters
-	res, err := h.PropertyClient.Client.GetProperties(context.Background(), &pb.GetPropertiesRequest{
+	res, err := h.PropertyClient.Client.GetProperties(forwardedContext(c), &pb.GetPropertiesRequest{
 		Limit:  limitInt32,
 		Offset: offsetInt32,
 	})
@@ -79,7 +78,7 @@ func (h *PropertyHandler) GetPropertiesByOwner(c *gin.Context) {
 	offsetInt32 := int32(offset)
 
 	// Call the gRPC client with the converted parameters
-	res, err := h.PropertyClient.Client.GetPropertiesByOwner(context.Background(), &pb.GetPropertiesByOwnerRequest{
+	res, err := h.PropertyClient.Client.GetPropertiesByOwner(forwardedContext(c), &pb.GetPropertiesByOwnerRequest{
 		OwnerId: userID,
 		Limit:   limitInt32,
 		Offset:  offsetInt32,
@@ -92,10 +91,42 @@ func (h *PropertyHandler) GetPropertiesByOwner(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
+// GetPropertiesByOrganization lists the properties listed under an organization
+func (h *PropertyHandler) GetPropertiesByOrganization(c *gin.Context) {
+	// Get limit and offset from query parameters and convert them to int32
+	limitStr := c.Query("limit")
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit value"})
+		return
+	}
+	limitInt32 := int32(limit)
+
+	offsetStr := c.Query("offset")
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset value"})
+		return
+	}
+	offsetInt32 := int32(offset)
+
+	res, err := h.PropertyClient.Client.GetPropertiesByOrganization(forwardedContext(c), &pb.GetPropertiesByOrganizationRequest{
+		OrganizationId: c.Param("organization_id"),
+		Limit:          limitInt32,
+		Offset:         offsetInt32,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
 func (h *PropertyHandler) GetProperty(c *gin.Context) {
 	propertyID := c.Param("id")
 
-	res, err := h.PropertyClient.Client.GetPropertyByID(context.Background(), &pb.GetPropertyByIDRequest{Id: propertyID})
+	res, err := h.PropertyClient.Client.GetPropertyByID(forwardedContext(c), &pb.GetPropertyByIDRequest{Id: propertyID})
 
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -123,7 +154,7 @@ func (h *PropertyHandler) CreateProperty(c *gin.Context) {
 
 	req.OwnerId = userID
 
-	res, err := h.PropertyClient.Client.CreateProperty(context.Background(), &req)
+	res, err := h.PropertyClient.Client.CreateProperty(forwardedContext(c), &req)
 
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -151,7 +182,7 @@ func (h *PropertyHandler) UpdateProperty(c *gin.Context) {
 
 	req.OwnerId = userID
 	req.Id = propertyID
-	res, err := h.PropertyClient.Client.UpdateProperty(context.Background(), &req)
+	res, err := h.PropertyClient.Client.UpdateProperty(forwardedContext(c), &req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	"github.com/demola234/api_gateway/internal/handler"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterAdminRoutes registers the support staff routes. Every route needs
+// the user:admin permission.
+func RegisterAdminRoutes(rg *gin.RouterGroup, adminHandler *handler.AdminHandler, authMiddleware gin.HandlerFunc) {
+	adminRoutes := rg.Group("/admin", authMiddleware, middleware.RequirePermission(rbac.PermUserAdmin))
+
+	{
+		// User search and details
+		adminRoutes.GET("/users", adminHandler.ListUsers)
+		adminRoutes.GET("/users/:user_id", adminHandler.GetUser)
+		adminRoutes.GET("/users/:user_id/sessions", adminHandler.ListUserSessions)
+		adminRoutes.GET("/users/:user_id/login-history", adminHandler.ListUserLoginHistory)
+
+		// Account controls
+		adminRoutes.POST("/users/:user_id/logout", adminHandler.ForceLogout)
+		adminRoutes.POST("/users/:user_id/lock", adminHandler.LockUser)
+		adminRoutes.POST("/users/:user_id/unlock", adminHandler.UnlockUser)
+		adminRoutes.PUT("/users/:user_id/role", adminHandler.ChangeUserRole)
+		adminRoutes.POST("/users/:user_id/password-reset", adminHandler.TriggerPasswordReset)
+		adminRoutes.DELETE("/users/:user_id", adminHandler.DeleteUser)
+		adminRoutes.POST("/users/:user_id/restore", adminHandler.RestoreUser)
+		adminRoutes.GET("/users/:user_id/erasure", adminHandler.GetUserErasureStatus)
+
+		// Act as a user to see what they see; audited and time-boxed
+		adminRoutes.POST("/users/:user_id/impersonate", adminHandler.ImpersonateUser)
+
+		// Security audit log of logins and account changes
+		adminRoutes.GET("/auth-events", adminHandler.ListAuthEvents)
+
+		// Agent verification review queue
+		adminRoutes.GET("/agent-verifications", adminHandler.ListAgentVerifications)
+		adminRoutes.GET("/agent-verifications/:user_id", adminHandler.GetAgentVerification)
+		adminRoutes.POST("/agent-verifications/:user_id/review", adminHandler.ReviewAgentVerification)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	"github.com/demola234/api_gateway/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterAPIKeyRoutes registers the routes for managing the API keys used by
+// integrations. Keys are managed with the user's own session, never with
+// another API key.
+func RegisterAPIKeyRoutes(rg *gin.RouterGroup, apiKeyHandler *handler.APIKeyHandler, authMiddleware gin.HandlerFunc) {
+	apiKeyRoutes := rg.Group("/api-keys", authMiddleware)
+
+	{
+		apiKeyRoutes.POST("", middleware.BlockImpersonation(), apiKeyHandler.CreateAPIKey)
+		apiKeyRoutes.GET("", apiKeyHandler.ListAPIKeys)
+		apiKeyRoutes.DELETE("/:api_key_id", middleware.BlockImpersonation(), apiKeyHandler.RevokeAPIKey)
+	}
+}
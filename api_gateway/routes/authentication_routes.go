@@ -1,7 +1,9 @@
 package routes
 
 import (
+	"github.com/demola234/api_gateway/infrastructure/middleware"
 	"github.com/demola234/api_gateway/internal/handler"
+	"github.com/demola234/shared/rbac"
 
 	"github.com/gin-gonic/gin"
 )
@@ -15,15 +17,29 @@ func RegisterRoutes(rg *gin.RouterGroup, authHandler *handler.AuthHandler, authM
 		authRoutes.POST("/login", authHandler.Login)
 		authRoutes.POST("/verify", authHandler.VerifyUser)
 		authRoutes.POST("/resend-otp", authHandler.ResendOtp)
+		authRoutes.POST("/refresh-token", authHandler.RefreshToken)
 
 		// OAuth routes
 		authRoutes.POST("/register-oauth", authHandler.OAuthRegister)
 		authRoutes.POST("/login-oauth", authHandler.OAuthLogin)
 
+		// Passwordless sign-in
+		authRoutes.POST("/magic-link", authHandler.RequestMagicLink)
+		authRoutes.POST("/magic-link/consume", authHandler.ConsumeMagicLink)
+
 		// Password reset flow
 		authRoutes.POST("/forgot-password", authHandler.ForgotPassword)
 		authRoutes.POST("/verify-reset", authHandler.VerifyResetPassword)
 		authRoutes.POST("/reset-password", authHandler.ResetPassword)
+
+		// Undo an email change from the link sent to the previous address
+		authRoutes.POST("/account/email/revert", authHandler.RevertEmailChange)
+
+		// Download a data export with the token from the link emailed to the user
+		authRoutes.GET("/account/exports/:export_id/download", authHandler.DownloadDataExport)
+
+		// Second factor for logins that require MFA
+		authRoutes.POST("/mfa/verify", authHandler.VerifyMfa)
 	}
 
 	// Protected routes (require authentication)
@@ -38,11 +54,34 @@ func RegisterRoutes(rg *gin.RouterGroup, authHandler *handler.AuthHandler, authM
 		authRoutes.GET("/sessions", authMiddleware, authHandler.GetSessions)
 		authRoutes.DELETE("/sessions/:session_id", authMiddleware, authHandler.RevokeSession)
 
-		// Account management
-		authRoutes.POST("/change-password", authMiddleware, authHandler.ChangePassword)
+		// Account management; sensitive changes are blocked while an admin impersonates the user
+		authRoutes.POST("/change-password", authMiddleware, middleware.BlockImpersonation(), authHandler.ChangePassword)
 		authRoutes.POST("/upload-image", authMiddleware, authHandler.UploadImage)
-		authRoutes.POST("/account/deactivate", authMiddleware, authHandler.DeactivateAccount)
-		authRoutes.DELETE("/account", authMiddleware, authHandler.DeleteAccount)
+		authRoutes.POST("/account/deactivate", authMiddleware, middleware.BlockImpersonation(), authHandler.DeactivateAccount)
+		authRoutes.DELETE("/account", authMiddleware, middleware.BlockImpersonation(), authHandler.DeleteAccount)
 		authRoutes.GET("/account/login-history", authMiddleware, authHandler.GetLoginHistory)
+		authRoutes.POST("/account/email", authMiddleware, middleware.BlockImpersonation(), authHandler.RequestEmailChange)
+		authRoutes.POST("/account/email/confirm", authMiddleware, middleware.BlockImpersonation(), authHandler.ConfirmEmailChange)
+		authRoutes.POST("/account/exports", authMiddleware, middleware.BlockImpersonation(), authHandler.RequestDataExport)
+		authRoutes.GET("/account/exports/:export_id", authMiddleware, authHandler.GetDataExport)
+
+		// Linked OAuth identities
+		authRoutes.GET("/identities", authMiddleware, authHandler.ListIdentities)
+		authRoutes.POST("/identities", authMiddleware, middleware.BlockImpersonation(), authHandler.LinkIdentity)
+		authRoutes.DELETE("/identities/:identity_id", authMiddleware, middleware.BlockImpersonation(), authHandler.UnlinkIdentity)
+
+		// Multi-factor authentication
+		authRoutes.POST("/mfa/enroll", authMiddleware, middleware.BlockImpersonation(), authHandler.EnrollMfa)
+		authRoutes.POST("/mfa/confirm", authMiddleware, middleware.BlockImpersonation(), authHandler.ConfirmMfa)
+		authRoutes.POST("/mfa/disable", authMiddleware, middleware.BlockImpersonation(), authHandler.DisableMfa)
+		authRoutes.POST("/mfa/recovery-codes", authMiddleware, middleware.BlockImpersonation(), authHandler.RegenerateRecoveryCodes)
+
+		// Agent identity verification for the verified badge
+		authRoutes.POST("/agent-verification/documents", authMiddleware, middleware.BlockImpersonation(), authHandler.UploadAgentDocument)
+		authRoutes.POST("/agent-verification", authMiddleware, middleware.BlockImpersonation(), authHandler.SubmitAgentVerification)
+		authRoutes.GET("/agent-verification", authMiddleware, authHandler.GetAgentVerification)
+
+		// Administration
+		authRoutes.POST("/admin/unlock-account", authMiddleware, middleware.RequirePermission(rbac.PermUserAdmin), authHandler.UnlockAccount)
 	}
 }
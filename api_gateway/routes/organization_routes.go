@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	"github.com/demola234/api_gateway/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterOrganizationRoutes registers the routes for organizations, such as
+// agencies, and their members. Only declining an invitation works without
+// signing in, so an address without an account can turn it down. The read
+// routes go through readMiddleware, which also accepts API keys granting
+// organization:read; API keys cannot change organizations.
+func RegisterOrganizationRoutes(rg *gin.RouterGroup, organizationHandler *handler.OrganizationHandler, authMiddleware gin.HandlerFunc, readMiddleware gin.HandlerFunc) {
+	rg.POST("/organizations/invitations/decline", organizationHandler.DeclineInvitation)
+
+	organizationRoutes := rg.Group("/organizations")
+
+	{
+		organizationRoutes.POST("", authMiddleware, middleware.BlockImpersonation(), organizationHandler.CreateOrganization)
+		organizationRoutes.GET("", readMiddleware, organizationHandler.ListOrganizations)
+		organizationRoutes.GET("/:organization_id", readMiddleware, organizationHandler.GetOrganization)
+
+		// Choose the organization the current session acts for
+		organizationRoutes.POST("/switch", authMiddleware, organizationHandler.SwitchOrganization)
+
+		// Invitations
+		organizationRoutes.POST("/invitations/accept", authMiddleware, middleware.BlockImpersonation(), organizationHandler.AcceptInvitation)
+		organizationRoutes.POST("/:organization_id/invitations", authMiddleware, middleware.BlockImpersonation(), organizationHandler.InviteMember)
+		organizationRoutes.GET("/:organization_id/invitations", readMiddleware, organizationHandler.ListInvitations)
+		organizationRoutes.DELETE("/:organization_id/invitations/:invitation_id", authMiddleware, middleware.BlockImpersonation(), organizationHandler.RevokeInvitation)
+
+		// Members
+		organizationRoutes.PUT("/:organization_id/members/:user_id/role", authMiddleware, middleware.BlockImpersonation(), organizationHandler.UpdateMemberRole)
+		organizationRoutes.DELETE("/:organization_id/members/:user_id", authMiddleware, middleware.BlockImpersonation(), organizationHandler.RemoveMember)
+	}
+}
@@ -1,8 +1,9 @@
 package routes
 
 import (
+	"github.com/demola234/api_gateway/infrastructure/middleware"
 	"github.com/demola234/api_gateway/internal/handler"
-	// Import middleware package
+	"github.com/demola234/shared/rbac"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,8 +15,9 @@ func RegisterPropertyRoutes(rg *gin.RouterGroup, propertyHandler *handler.Proper
 
 		propertyRoutes.GET("/", authMiddleware, propertyHandler.GetProperties)
 		propertyRoutes.GET("/user", authMiddleware, propertyHandler.GetPropertiesByOwner)
-		propertyRoutes.GET("/:id", authMiddleware, propertyHandler.GetProperty)    // GET /properties/:id
-		propertyRoutes.POST("/", authMiddleware, propertyHandler.CreateProperty)   // POST /properties
-		propertyRoutes.PUT("/:id", authMiddleware, propertyHandler.UpdateProperty) // PUT /properties/:id
+		propertyRoutes.GET("/organization/:organization_id", authMiddleware, propertyHandler.GetPropertiesByOrganization)
+		propertyRoutes.GET("/:id", authMiddleware, propertyHandler.GetProperty)                                                           // GET /properties/:id
+		propertyRoutes.POST("/", authMiddleware, middleware.RequirePermission(rbac.PermPropertyCreate), propertyHandler.CreateProperty)   // POST /properties
+		propertyRoutes.PUT("/:id", authMiddleware, middleware.RequirePermission(rbac.PermPropertyUpdate), propertyHandler.UpdateProperty) // PUT /properties/:id
 	}
 }
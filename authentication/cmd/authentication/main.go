@@ -6,20 +6,33 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"net/netip"
 
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	"github.com/demola234/api_gateway/infrastructure/middleware/revocation"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
 	"github.com/demola234/authentication/config"
 	db "github.com/demola234/authentication/db/sqlc"
 	_ "github.com/demola234/authentication/docs/statik"
 	pb "github.com/demola234/authentication/infrastructure/api/grpc"
 	grpcHandler "github.com/demola234/authentication/infrastructure/api/user_handler"
+	"github.com/demola234/authentication/infrastructure/grpc_clients"
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/infrastructure/messaging/kafka"
+	"github.com/demola234/authentication/infrastructure/storage"
 	"github.com/demola234/authentication/internal/repository"
 	usercase "github.com/demola234/authentication/internal/usecase"
 
+	"github.com/demola234/authentication/pkg/geoip"
 	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/authentication/pkg/val"
+	"github.com/demola234/shared/events"
+	"github.com/demola234/shared/rbac"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	_ "github.com/lib/pq"
 	"github.com/rakyll/statik/fs"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -36,25 +49,208 @@ func main() {
 	}
 	defer conn.Close()
 
-	dbQueries := db.New(conn)
-	userRepo := repository.NewUserRepository(dbQueries)
+	store := db.NewStore(conn)
+
+	// New passwords are hashed with Argon2id at the configured cost; older hashes are upgraded on login
+	utils.SetPasswordHasher(utils.NewPasswordHasher(utils.Argon2Params{
+		Memory:      configs.PasswordArgon2Memory,
+		Iterations:  configs.PasswordArgon2Iterations,
+		Parallelism: configs.PasswordArgon2Parallelism,
+	}))
+
+	passwordPolicy := val.PasswordPolicy{
+		MinLength:           configs.PasswordMinLength,
+		MaxLength:           configs.PasswordMaxLength,
+		MinCharacterClasses: configs.PasswordMinCharacterClasses,
+		MaxRepeats:          configs.PasswordMaxRepeats,
+		CheckUserInfo:       configs.PasswordCheckUserInfo,
+		HistorySize:         configs.PasswordHistorySize,
+	}
+	if configs.BreachedPasswordsDir != "" {
+		passwordPolicy.Breached, err = val.NewBreachedPasswords(configs.BreachedPasswordsDir)
+		if err != nil {
+			log.Fatalf("cannot load breached passwords: %v", err)
+		}
+	}
+	val.SetPasswordPolicy(passwordPolicy)
+
+	// Login locations are only known when a GeoIP database is configured
+	if configs.GeoIPDatabasePath != "" {
+		geoDB, err := geoip.Open(configs.GeoIPDatabasePath)
+		if err != nil {
+			log.Fatalf("cannot load geoip database: %v", err)
+		}
+		geoip.SetDatabase(geoDB)
+	}
+
+	// Access tokens are signed with rotating Ed25519 keys stored in the database
+	keyRing := token.NewKeyRing()
+	tokenKeys, err := repository.NewTokenKeyManager(store, keyRing, configs.TokenSymmetricKey,
+		configs.TokenKeyRotationInterval, max(configs.TokenKeyRetention, configs.AccessTokenDuration))
+	if err != nil {
+		log.Fatalf("cannot create token key manager: %v", err)
+	}
+	if err := tokenKeys.Load(context.Background()); err != nil {
+		log.Fatalf("cannot load token signing keys: %v", err)
+	}
+	go tokenKeys.Run(context.Background())
+
+	userRepo := repository.NewUserRepository(store, keyRing, configs.AccessTokenDuration, configs.RefreshTokenDuration)
 	oAuthRepo := repository.NewOAuthRepository(&configs)
-	userUsecase := usercase.NewUserUsecase(userRepo, oAuthRepo)
 
-	server := grpcHandler.NewUserHandler(userUsecase)
+	emailSender, err := mailer.NewMailer(&configs)
+	if err != nil {
+		log.Fatalf("cannot create mailer: %v", err)
+	}
+
+	// Publish auth events such as lockouts for other services
+	kafkaProducer := kafka.NewKafkaProducer(configs.KafkaBrokers, configs.KafkaTopic)
+	defer kafkaProducer.Close()
+
+	userUsecase := usercase.NewUserUsecase(userRepo, oAuthRepo, emailSender, kafkaProducer)
 
-	go runGRPCServer(configs, server)
+	// Audit log events are kept for the configured retention
+	go usercase.RunAuthEventPruning(context.Background(), userRepo, configs.AuthEventRetention)
+
+	// Accounts are erased once their deletion grace period ends; the other
+	// services report back as they erase their copy of the user's data
+	go usercase.RunAccountDeletions(context.Background(), userRepo, kafkaProducer, events.ErasureServices)
+	erasureReports := kafka.NewKafkaConsumer(configs.KafkaBrokers, configs.ErasureReportsTopic, configs.ErasureReportsGroupID)
+	defer erasureReports.Close()
+	go func() {
+		if err := erasureReports.Consume(context.Background(), usercase.HandleErasureReports(userRepo)); err != nil {
+			log.Printf("erasure report consumer stopped: %v", err)
+		}
+	}()
+
+	// Data exports are built in the background from this service's records
+	// and the user's data in the property and messaging services
+	exportStorage, err := storage.NewFileStorage(configs.DataExportDir)
+	if err != nil {
+		log.Fatalf("cannot create data export storage: %v", err)
+	}
+	// Calls to the other services are signed with this service's own key
+	serviceCredentials, err := middleware.NewServiceCredentials("authentication", configs.ServiceSecretKey)
+	if err != nil {
+		log.Fatalf("invalid service secret key: %v", err)
+	}
+	propertyClient, err := grpc_clients.NewPropertyClient(configs.PropertyServiceAddress, serviceCredentials)
+	if err != nil {
+		log.Fatalf("cannot create property service client: %v", err)
+	}
+	defer propertyClient.Close()
+	messagingClient, err := grpc_clients.NewMessagingClient(configs.MessagingServiceAddress, serviceCredentials, token.NewPasetoV4Maker(keyRing))
+	if err != nil {
+		log.Fatalf("cannot create messaging service client: %v", err)
+	}
+	defer messagingClient.Close()
+	dataExportUsecase := usercase.NewDataExportUsecase(userRepo, emailSender, exportStorage, propertyClient, messagingClient)
+	go dataExportUsecase.Run(context.Background())
+
+	// Agents submit their license and ID documents for admins to review
+	agentVerificationUsecase := usercase.NewAgentVerificationUsecase(userRepo, kafkaProducer)
+
+	server := grpcHandler.NewUserHandler(userUsecase, dataExportUsecase, agentVerificationUsecase)
+
+	// The admin service is only served over gRPC, where every call needs the user:admin permission
+	adminUsecase := usercase.NewAdminUsecase(userRepo, emailSender, kafkaProducer)
+	adminServer := grpcHandler.NewAdminHandler(adminUsecase)
+
+	organizationUsecase := usercase.NewOrganizationUsecase(userRepo, emailSender)
+	organizationServer := grpcHandler.NewOrganizationHandler(organizationUsecase)
+
+	// Integrations exchange API keys for short-lived access tokens at the gateway
+	apiKeyUsecase := usercase.NewAPIKeyUsecase(userRepo)
+	apiKeyServer := grpcHandler.NewAPIKeyHandler(apiKeyUsecase)
+
+	// Calls come only from trusted services, with the user's access token
+	// verified against the key ring and rejected once its session is revoked
+	revocations := revocation.NewList(conn, configs.RevocationCacheSize)
+	go func() {
+		if err := revocations.Listen(context.Background(), configs.DBSource); err != nil {
+			log.Printf("cannot listen for session revocations: %v", err)
+		}
+	}()
+
+	// API keys may only read organizations, as on the gateway's routes
+	apiKeyScopes := map[string][]rbac.Permission{
+		pb.OrganizationService_ListOrganizations_FullMethodName:           {rbac.PermOrganizationRead},
+		pb.OrganizationService_GetOrganization_FullMethodName:             {rbac.PermOrganizationRead},
+		pb.OrganizationService_ListOrganizationInvitations_FullMethodName: {rbac.PermOrganizationRead},
+	}
+	serviceAuth, err := middleware.NewServiceAuthenticator(configs.TrustedServiceKeys, token.NewPasetoV4Verifier(keyRing), revocations, apiKeyScopes)
+	if err != nil {
+		log.Fatalf("invalid trusted service keys: %v", err)
+	}
+
+	go runGRPCServer(configs, server, adminServer, organizationServer, apiKeyServer, serviceAuth)
 	runGatewayServer(configs, server)
 }
 
-func runGRPCServer(configs config.Config, server pb.AuthServiceServer) {
+func runGRPCServer(configs config.Config, server pb.AuthServiceServer, adminServer pb.AdminServiceServer, organizationServer pb.OrganizationServiceServer, apiKeyServer pb.ApiKeyServiceServer, serviceAuth *middleware.ServiceAuthenticator) {
 	listener, err := net.Listen("tcp", configs.GRPCServerAddress)
 	if err != nil {
 		log.Fatalf("cannot start gRPC listener: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	permissionInterceptor := middleware.PermissionInterceptor(map[string]rbac.Permission{
+		pb.AdminService_ListUsers_FullMethodName:                   rbac.PermUserAdmin,
+		pb.AdminService_GetUserDetails_FullMethodName:              rbac.PermUserAdmin,
+		pb.AdminService_ListUserSessions_FullMethodName:            rbac.PermUserAdmin,
+		pb.AdminService_ListUserLoginHistory_FullMethodName:        rbac.PermUserAdmin,
+		pb.AdminService_ForceLogout_FullMethodName:                 rbac.PermUserAdmin,
+		pb.AdminService_LockUser_FullMethodName:                    rbac.PermUserAdmin,
+		pb.AdminService_UnlockUser_FullMethodName:                  rbac.PermUserAdmin,
+		pb.AdminService_ChangeUserRole_FullMethodName:              rbac.PermUserAdmin,
+		pb.AdminService_TriggerPasswordReset_FullMethodName:        rbac.PermUserAdmin,
+		pb.AdminService_DeleteUser_FullMethodName:                  rbac.PermUserAdmin,
+		pb.AdminService_RestoreUser_FullMethodName:                 rbac.PermUserAdmin,
+		pb.AdminService_ImpersonateUser_FullMethodName:             rbac.PermUserAdmin,
+		pb.AdminService_ListAuthEvents_FullMethodName:              rbac.PermUserAdmin,
+		pb.AdminService_GetUserErasureStatus_FullMethodName:        rbac.PermUserAdmin,
+		pb.AdminService_ListAgentVerifications_FullMethodName:      rbac.PermUserAdmin,
+		pb.AdminService_GetAgentVerificationDetails_FullMethodName: rbac.PermUserAdmin,
+		pb.AdminService_ReviewAgentVerification_FullMethodName:     rbac.PermUserAdmin,
+		pb.AuthService_UnlockAccount_FullMethodName:                rbac.PermUserAdmin,
+	})
+
+	// Sensitive changes to the account are refused to admins impersonating
+	// the user and to API keys, whichever way the call arrives
+	impersonationInterceptor := middleware.BlockImpersonationInterceptor(
+		pb.AuthService_ChangePassword_FullMethodName,
+		pb.AuthService_DeactivateAccount_FullMethodName,
+		pb.AuthService_DeleteAccount_FullMethodName,
+		pb.AuthService_RequestEmailChange_FullMethodName,
+		pb.AuthService_ConfirmEmailChange_FullMethodName,
+		pb.AuthService_RequestDataExport_FullMethodName,
+		pb.AuthService_LinkIdentity_FullMethodName,
+		pb.AuthService_UnlinkIdentity_FullMethodName,
+		pb.AuthService_EnrollMfa_FullMethodName,
+		pb.AuthService_ConfirmMfa_FullMethodName,
+		pb.AuthService_DisableMfa_FullMethodName,
+		pb.AuthService_RegenerateRecoveryCodes_FullMethodName,
+		pb.AuthService_UploadAgentDocument_FullMethodName,
+		pb.AuthService_SubmitAgentVerification_FullMethodName,
+		pb.ApiKeyService_CreateApiKey_FullMethodName,
+		pb.ApiKeyService_RevokeApiKey_FullMethodName,
+		pb.OrganizationService_CreateOrganization_FullMethodName,
+		pb.OrganizationService_AcceptOrganizationInvitation_FullMethodName,
+		pb.OrganizationService_InviteOrganizationMember_FullMethodName,
+		pb.OrganizationService_RevokeOrganizationInvitation_FullMethodName,
+		pb.OrganizationService_UpdateOrganizationMemberRole_FullMethodName,
+		pb.OrganizationService_RemoveOrganizationMember_FullMethodName,
+	)
+
+	// Only trusted services reach the handlers, which take the user from the
+	// access token forwarded with the call rather than from the request
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(serviceAuth.UnaryServerInterceptor(), permissionInterceptor, impersonationInterceptor),
+		grpc.StreamInterceptor(serviceAuth.StreamServerInterceptor()),
+	)
 	pb.RegisterAuthServiceServer(grpcServer, server)
+	pb.RegisterAdminServiceServer(grpcServer, adminServer)
+	pb.RegisterOrganizationServiceServer(grpcServer, organizationServer)
+	pb.RegisterApiKeyServiceServer(grpcServer, apiKeyServer)
 	reflection.Register(grpcServer)
 
 	log.Printf("gRPC server running at %s", configs.GRPCServerAddress)
@@ -76,17 +272,19 @@ func runGatewayServer(configs config.Config, server pb.AuthServiceServer) {
 		},
 	})
 
+	// Requests reach the API through the gateway; this port only serves what
+	// must be public without it, the token keys and the OAuth providers'
+	// callbacks. Neither takes a user, so they are served in process rather
+	// than as calls from a trusted service.
 	mux := runtime.NewServeMux(jsonOpt)
 	err := pb.RegisterAuthServiceHandlerServer(ctx, mux, server)
 	if err != nil {
 		log.Fatalf("cannot register gateway handler: %v", err)
 	}
 
-	// Register custom upload handler
-	mux.HandlePath("POST", "/api/v1/upload-image", utils.HandleFileUpload(ctx, server))
-
 	httpMux := http.NewServeMux()
-	httpMux.Handle("/", mux)
+	httpMux.Handle("GET /api/v1/token_keys", withPeer(mux))
+	httpMux.Handle("GET /api/v1/oauth/{provider}/callback", withPeer(mux))
 
 	statikFS, err := fs.New()
 	if err != nil {
@@ -94,12 +292,6 @@ func runGatewayServer(configs config.Config, server pb.AuthServiceServer) {
 	}
 	httpMux.Handle("/swagger/", http.StripPrefix("/swagger", http.FileServer(statikFS)))
 
-	// Create a test upload page
-	httpMux.HandleFunc("/test-upload", utils.ServeTestUploadPage)
-
-	// Add the debug endpoint
-	httpMux.HandleFunc("/debug-upload", utils.HandleDebugUpload)
-
 	listener, err := net.Listen("tcp", configs.HTTPServerAddress)
 	if err != nil {
 		log.Fatalf("cannot start HTTP listener: %v", err)
@@ -110,3 +302,15 @@ func runGatewayServer(configs config.Config, server pb.AuthServiceServer) {
 		log.Fatalf("cannot serve HTTP gateway: %v", err)
 	}
 }
+
+// withPeer records the client's address as the gRPC peer of calls served in
+// process, which is where handlers take the client IP from when a call did
+// not come through the gateway
+func withPeer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if addr, err := netip.ParseAddrPort(r.RemoteAddr); err == nil {
+			r = r.WithContext(peer.NewContext(r.Context(), &peer.Peer{Addr: net.TCPAddrFromAddrPort(addr)}))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "mfa_challenges" CASCADE;
+DROP TABLE IF EXISTS "mfa_recovery_codes" CASCADE;
+DROP TABLE IF EXISTS "user_mfa" CASCADE;
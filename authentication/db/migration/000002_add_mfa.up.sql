@@ -0,0 +1,55 @@
+CREATE TABLE "user_mfa" (
+    "user_id" UUID PRIMARY KEY,
+    "secret" VARCHAR NOT NULL,
+    "enabled" BOOLEAN NOT NULL DEFAULT false,
+    "confirmed_at" TIMESTAMP,
+    "last_used_step" BIGINT NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+
+CREATE TABLE "mfa_recovery_codes" (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL,
+    "code_hash" VARCHAR(64) NOT NULL,
+    "used_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+
+CREATE TABLE "mfa_challenges" (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL,
+    "attempts" INT NOT NULL DEFAULT 0,
+    "ip_address" VARCHAR(45),
+    "user_agent" VARCHAR(255),
+    "expires_at" TIMESTAMP NOT NULL,
+    "consumed_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_mfa_recovery_codes_user_code ON "mfa_recovery_codes"("user_id", "code_hash");
+CREATE INDEX idx_mfa_recovery_codes_user_id ON "mfa_recovery_codes"("user_id");
+CREATE INDEX idx_mfa_challenges_user_id ON "mfa_challenges"("user_id");
+CREATE INDEX idx_mfa_challenges_expires_at ON "mfa_challenges"("expires_at");
+
+CREATE TRIGGER update_user_mfa_updated_at
+    BEFORE UPDATE ON "user_mfa"
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON COLUMN "user_mfa"."user_id" IS 'User the TOTP factor belongs to';
+COMMENT ON COLUMN "user_mfa"."secret" IS 'Base32 encoded TOTP shared secret';
+COMMENT ON COLUMN "user_mfa"."enabled" IS 'Indicates if the factor has been confirmed and is enforced at login';
+COMMENT ON COLUMN "user_mfa"."confirmed_at" IS 'Timestamp of when enrollment was confirmed';
+COMMENT ON COLUMN "user_mfa"."last_used_step" IS 'Last accepted TOTP time step, used to reject replayed codes';
+
+COMMENT ON COLUMN "mfa_recovery_codes"."code_hash" IS 'SHA-256 hash of the normalised recovery code';
+COMMENT ON COLUMN "mfa_recovery_codes"."used_at" IS 'Timestamp of when the code was redeemed';
+
+COMMENT ON COLUMN "mfa_challenges"."id" IS 'Opaque challenge token handed to the client after the first factor';
+COMMENT ON COLUMN "mfa_challenges"."attempts" IS 'Number of failed second factor attempts';
+COMMENT ON COLUMN "mfa_challenges"."expires_at" IS 'Timestamp after which the challenge can no longer be completed';
+COMMENT ON COLUMN "mfa_challenges"."consumed_at" IS 'Timestamp of when the challenge was completed';
@@ -0,0 +1,27 @@
+CREATE TABLE "refresh_tokens" (
+    "id" UUID PRIMARY KEY,
+    "session_id" UUID NOT NULL,
+    "user_id" UUID NOT NULL,
+    "family_id" UUID NOT NULL,
+    "parent_id" UUID,
+    "token_hash" VARCHAR(64) UNIQUE NOT NULL,
+    "expires_at" TIMESTAMP NOT NULL,
+    "used_at" TIMESTAMP,
+    "revoked_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY ("session_id") REFERENCES "sessions" ("session_id") ON DELETE CASCADE,
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+
+CREATE INDEX idx_refresh_tokens_session_id ON "refresh_tokens"("session_id");
+CREATE INDEX idx_refresh_tokens_user_id ON "refresh_tokens"("user_id");
+CREATE INDEX idx_refresh_tokens_family_id ON "refresh_tokens"("family_id");
+CREATE INDEX idx_refresh_tokens_expires_at ON "refresh_tokens"("expires_at");
+
+-- Comments for refresh_tokens table
+COMMENT ON COLUMN "refresh_tokens"."session_id" IS 'Session the refresh token was issued for.';
+COMMENT ON COLUMN "refresh_tokens"."family_id" IS 'Groups every token rotated from the same login so the chain can be revoked together.';
+COMMENT ON COLUMN "refresh_tokens"."parent_id" IS 'The refresh token this one was rotated from.';
+COMMENT ON COLUMN "refresh_tokens"."token_hash" IS 'SHA-256 hash of the opaque refresh token.';
+COMMENT ON COLUMN "refresh_tokens"."used_at" IS 'Set when the token is exchanged; presenting it again is treated as reuse.';
+COMMENT ON COLUMN "refresh_tokens"."revoked_at" IS 'Set when the token family is revoked.';
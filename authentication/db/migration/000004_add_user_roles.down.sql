@@ -0,0 +1,4 @@
+ALTER TABLE "users" DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE "users" ALTER COLUMN "role" DROP DEFAULT;
+
+COMMENT ON COLUMN "users"."role" IS 'Role (admin, user, etc.)';
@@ -0,0 +1,12 @@
+-- Normalise existing free-form roles before constraining the column
+UPDATE "users"
+SET "role" = 'buyer'
+WHERE "role" IS NULL OR "role" NOT IN ('buyer', 'seller', 'agent', 'admin');
+
+ALTER TABLE "users" ALTER COLUMN "role" SET DEFAULT 'buyer';
+
+ALTER TABLE "users"
+ADD CONSTRAINT users_role_check
+CHECK ("role" IN ('buyer', 'seller', 'agent', 'admin'));
+
+COMMENT ON COLUMN "users"."role" IS 'Role (buyer, seller, agent, admin)';
@@ -0,0 +1,19 @@
+CREATE TABLE "auth_throttles" (
+    "scope" VARCHAR(32) NOT NULL,
+    "subject_type" VARCHAR(16) NOT NULL,
+    "subject" VARCHAR(255) NOT NULL,
+    "failed_attempts" INT NOT NULL DEFAULT 0,
+    "lockout_count" INT NOT NULL DEFAULT 0,
+    "locked_until" TIMESTAMP,
+    "last_failed_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY ("scope", "subject_type", "subject")
+);
+
+CREATE INDEX idx_auth_throttles_subject ON "auth_throttles"("subject_type", "subject");
+
+-- Comments for auth_throttles table
+COMMENT ON COLUMN "auth_throttles"."scope" IS 'Endpoint being protected, e.g. login or otp.';
+COMMENT ON COLUMN "auth_throttles"."subject_type" IS 'What the counter is keyed on: account (email) or ip.';
+COMMENT ON COLUMN "auth_throttles"."failed_attempts" IS 'Consecutive failures since the last lockout or success.';
+COMMENT ON COLUMN "auth_throttles"."lockout_count" IS 'Lockouts within the failure window; each one doubles the next lockout duration.';
+COMMENT ON COLUMN "auth_throttles"."locked_until" IS 'Attempts are rejected until this time.';
@@ -0,0 +1,19 @@
+ALTER TABLE "users" ADD COLUMN "provider" VARCHAR DEFAULT 'local';
+ALTER TABLE "users" ADD COLUMN "provider_id" VARCHAR UNIQUE;
+
+-- Only one provider fits in the old columns; keep the oldest identity of each user
+UPDATE "users" u
+SET "provider" = i."provider", "provider_id" = i."provider_user_id"
+FROM (
+    SELECT DISTINCT ON ("user_id") "user_id", "provider", "provider_user_id"
+    FROM "user_identities"
+    ORDER BY "user_id", "created_at"
+) i
+WHERE u."id" = i."user_id" AND u."password" IS NULL;
+
+COMMENT ON COLUMN "users"."provider" IS 'Authentication provider (local, google, github, etc.)';
+COMMENT ON COLUMN "users"."provider_id" IS 'User ID from OAuth provider (unique)';
+
+CREATE INDEX idx_users_provider_id ON "users"("provider_id") WHERE "provider_id" IS NOT NULL;
+
+DROP TABLE IF EXISTS "user_identities";
@@ -0,0 +1,30 @@
+CREATE TABLE "user_identities" (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL REFERENCES "users" ("id") ON DELETE CASCADE,
+    "provider" VARCHAR(32) NOT NULL,
+    "provider_user_id" VARCHAR(255) NOT NULL,
+    "email" VARCHAR,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "last_used_at" TIMESTAMP,
+    CONSTRAINT user_identities_provider_user_key UNIQUE ("provider", "provider_user_id"),
+    CONSTRAINT user_identities_user_provider_key UNIQUE ("user_id", "provider")
+);
+
+CREATE INDEX idx_user_identities_user_id ON "user_identities"("user_id");
+
+-- Comments for user_identities table
+COMMENT ON COLUMN "user_identities"."provider" IS 'OAuth provider the identity belongs to (google, apple, github, etc.)';
+COMMENT ON COLUMN "user_identities"."provider_user_id" IS 'Subject of the user at the provider; unique per provider.';
+COMMENT ON COLUMN "user_identities"."email" IS 'Email reported by the provider when the identity was linked.';
+COMMENT ON COLUMN "user_identities"."last_used_at" IS 'Last time the identity was used to sign in.';
+
+-- Move existing OAuth accounts over before dropping the single provider columns
+INSERT INTO "user_identities" ("id", "user_id", "provider", "provider_user_id", "email", "created_at")
+SELECT gen_random_uuid(), "id", "provider", "provider_id", "email", COALESCE("created_at", now())
+FROM "users"
+WHERE "provider" IS NOT NULL AND "provider" != 'local' AND "provider_id" IS NOT NULL;
+
+ALTER TABLE "users" DROP CONSTRAINT IF EXISTS users_password_or_provider_id_check;
+DROP INDEX IF EXISTS idx_users_provider_id;
+ALTER TABLE "users" DROP COLUMN "provider";
+ALTER TABLE "users" DROP COLUMN "provider_id";
@@ -0,0 +1,15 @@
+CREATE TABLE "oauth_states" (
+    "state_hash" VARCHAR(64) PRIMARY KEY,
+    "provider" VARCHAR(32) NOT NULL,
+    "code_verifier" VARCHAR(128) NOT NULL,
+    "nonce" VARCHAR(64) NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "expires_at" TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_oauth_states_expires_at ON "oauth_states"("expires_at");
+
+-- Comments for oauth_states table
+COMMENT ON COLUMN "oauth_states"."state_hash" IS 'SHA-256 hash of the state parameter sent to the provider.';
+COMMENT ON COLUMN "oauth_states"."code_verifier" IS 'PKCE code verifier; only its S256 challenge leaves the service.';
+COMMENT ON COLUMN "oauth_states"."nonce" IS 'Nonce the provider must echo back in its ID token.';
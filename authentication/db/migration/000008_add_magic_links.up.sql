@@ -0,0 +1,23 @@
+CREATE TABLE "magic_links" (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL,
+    "token_hash" VARCHAR(64) NOT NULL UNIQUE,
+    "expires_at" TIMESTAMP NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "requested_ip" VARCHAR(45) NOT NULL DEFAULT '',
+    "requested_user_agent" TEXT NOT NULL DEFAULT '',
+    "used_at" TIMESTAMP,
+    "used_ip" VARCHAR(45),
+    "used_user_agent" TEXT,
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+
+CREATE INDEX idx_magic_links_user_id ON "magic_links"("user_id");
+CREATE INDEX idx_magic_links_expires_at ON "magic_links"("expires_at");
+
+-- Comments for magic_links table
+COMMENT ON COLUMN "magic_links"."token_hash" IS 'SHA-256 hash of the token emailed to the user; the token itself is never stored.';
+COMMENT ON COLUMN "magic_links"."expires_at" IS 'The link cannot be used after this time. Requesting a new link expires the older ones.';
+COMMENT ON COLUMN "magic_links"."requested_ip" IS 'IP address the link was requested from.';
+COMMENT ON COLUMN "magic_links"."used_at" IS 'When the link was exchanged for a session; a link can only be used once.';
+COMMENT ON COLUMN "magic_links"."used_ip" IS 'IP address the link was used from.';
@@ -0,0 +1,17 @@
+CREATE TABLE "token_signing_keys" (
+    "id" VARCHAR(64) PRIMARY KEY,
+    "public_key" VARCHAR(64) NOT NULL,
+    "encrypted_private_key" BYTEA NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "expires_at" TIMESTAMP
+);
+
+-- Only one key signs new tokens at a time
+CREATE UNIQUE INDEX idx_token_signing_keys_active ON "token_signing_keys"(("expires_at" IS NULL)) WHERE "expires_at" IS NULL;
+CREATE INDEX idx_token_signing_keys_expires_at ON "token_signing_keys"("expires_at");
+
+-- Comments for token_signing_keys table
+COMMENT ON COLUMN "token_signing_keys"."id" IS 'PASERK k4.pid of the public key, sent as the kid in token footers.';
+COMMENT ON COLUMN "token_signing_keys"."public_key" IS 'PASERK k4.public encoding of the Ed25519 public key.';
+COMMENT ON COLUMN "token_signing_keys"."encrypted_private_key" IS 'Ed25519 private key sealed with TOKEN_SYMMETRIC_KEY.';
+COMMENT ON COLUMN "token_signing_keys"."expires_at" IS 'NULL for the active key; retired keys verify tokens until then.';
@@ -0,0 +1,25 @@
+CREATE TABLE "revoked_sessions" (
+    "session_id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL,
+    "reason" VARCHAR(32) NOT NULL,
+    "revoked_at" TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_revoked_sessions_revoked_at ON "revoked_sessions"("revoked_at");
+
+-- Services caching the revocation list listen on this channel to drop stale entries
+CREATE FUNCTION notify_session_revoked() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('session_revoked', NEW.session_id::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER revoked_sessions_notify
+AFTER INSERT OR UPDATE ON "revoked_sessions"
+FOR EACH ROW EXECUTE FUNCTION notify_session_revoked();
+
+-- Comments for revoked_sessions table
+COMMENT ON COLUMN "revoked_sessions"."session_id" IS 'Session whose access tokens are no longer accepted.';
+COMMENT ON COLUMN "revoked_sessions"."reason" IS 'Why the session was revoked, e.g. logged_out, deactivated or deleted.';
+COMMENT ON COLUMN "revoked_sessions"."revoked_at" IS 'Access tokens of the session issued up to this time are rejected.';
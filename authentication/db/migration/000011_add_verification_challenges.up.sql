@@ -0,0 +1,35 @@
+CREATE TABLE "verification_challenges" (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL,
+    "purpose" VARCHAR(32) NOT NULL,
+    "code_hash" VARCHAR(64) NOT NULL,
+    "salt" VARCHAR(32) NOT NULL,
+    "attempts" INT NOT NULL DEFAULT 0,
+    "max_attempts" INT NOT NULL,
+    "ip_address" VARCHAR(45),
+    "user_agent" VARCHAR(255),
+    "expires_at" TIMESTAMP NOT NULL,
+    "verified_at" TIMESTAMP,
+    "consumed_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE,
+    CHECK ("purpose" IN ('signup', 'password_reset', 'email_change', 'login'))
+);
+
+CREATE INDEX idx_verification_challenges_user_purpose ON "verification_challenges"("user_id", "purpose");
+CREATE INDEX idx_verification_challenges_expires_at ON "verification_challenges"("expires_at");
+
+-- Users who completed the old session OTP flow keep their verified status
+UPDATE "users" SET "email_verified" = true
+WHERE "id" IN (SELECT "user_id" FROM "sessions" WHERE "otp_verified" = true);
+
+-- Plaintext codes are no longer used
+UPDATE "sessions" SET "otp" = NULL, "otp_expires_at" = NULL, "otp_attempts" = 0;
+
+-- Comments for verification_challenges table
+COMMENT ON COLUMN "verification_challenges"."purpose" IS 'What the code proves: signup, password_reset, email_change or login.';
+COMMENT ON COLUMN "verification_challenges"."code_hash" IS 'Hex encoded SHA-256 of the salt and the code; the code itself is never stored.';
+COMMENT ON COLUMN "verification_challenges"."salt" IS 'Random per-challenge salt mixed into code_hash.';
+COMMENT ON COLUMN "verification_challenges"."attempts" IS 'Number of wrong codes entered for this challenge.';
+COMMENT ON COLUMN "verification_challenges"."verified_at" IS 'Timestamp of when the correct code was entered.';
+COMMENT ON COLUMN "verification_challenges"."consumed_at" IS 'Timestamp of when the challenge was used up or superseded by a newer one.';
@@ -0,0 +1,13 @@
+CREATE TABLE "password_history" (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL,
+    "password_hash" VARCHAR(255) NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+
+CREATE INDEX idx_password_history_user_id ON "password_history"("user_id", "created_at" DESC);
+
+-- Comments for password_history table
+COMMENT ON COLUMN "password_history"."password_hash" IS 'Hash of a password the user replaced, checked to stop it being reused.';
+COMMENT ON COLUMN "password_history"."created_at" IS 'Timestamp of when the password was replaced.';
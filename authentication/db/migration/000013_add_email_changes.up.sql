@@ -0,0 +1,23 @@
+CREATE TABLE "email_changes" (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL,
+    "old_email" VARCHAR NOT NULL,
+    "new_email" VARCHAR NOT NULL,
+    "old_email_verified" BOOLEAN NOT NULL DEFAULT false,
+    "requested_ip" VARCHAR(45),
+    "revert_token_hash" VARCHAR(64) UNIQUE,
+    "revert_expires_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "confirmed_at" TIMESTAMP,
+    "reverted_at" TIMESTAMP,
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+
+CREATE INDEX idx_email_changes_user_id ON "email_changes"("user_id");
+
+-- Comments for email_changes table
+COMMENT ON COLUMN "email_changes"."old_email_verified" IS 'Whether the old address was verified, restored if the change is reverted.';
+COMMENT ON COLUMN "email_changes"."revert_token_hash" IS 'SHA-256 of the token in the revert link sent to the old address once the change is confirmed.';
+COMMENT ON COLUMN "email_changes"."revert_expires_at" IS 'Timestamp after which the change can no longer be reverted from the old address.';
+COMMENT ON COLUMN "email_changes"."confirmed_at" IS 'Timestamp of when the code sent to the new address was entered and the email switched.';
+COMMENT ON COLUMN "email_changes"."reverted_at" IS 'Timestamp of when the old address undid the change.';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_users_created_at;
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "deleted_at";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "locked_reason";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "locked_at";
@@ -0,0 +1,10 @@
+ALTER TABLE "users" ADD COLUMN "locked_at" TIMESTAMP;
+ALTER TABLE "users" ADD COLUMN "locked_reason" VARCHAR(255);
+ALTER TABLE "users" ADD COLUMN "deleted_at" TIMESTAMP;
+
+CREATE INDEX idx_users_created_at ON "users"("created_at" DESC);
+
+-- Comments for the admin columns of the users table
+COMMENT ON COLUMN "users"."locked_at" IS 'Timestamp of when an administrator locked the account; locked accounts cannot sign in.';
+COMMENT ON COLUMN "users"."locked_reason" IS 'Why an administrator locked the account, shown to support staff.';
+COMMENT ON COLUMN "users"."deleted_at" IS 'Timestamp of when the account was soft-deleted; it can be restored until it is purged.';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_sessions_impersonator_id;
+
+ALTER TABLE "sessions" DROP CONSTRAINT IF EXISTS fk_sessions_impersonator_id;
+
+ALTER TABLE "sessions" DROP COLUMN IF EXISTS "impersonation_reason";
+ALTER TABLE "sessions" DROP COLUMN IF EXISTS "impersonator_id";
@@ -0,0 +1,14 @@
+ALTER TABLE "sessions" ADD COLUMN "impersonator_id" UUID;
+ALTER TABLE "sessions" ADD COLUMN "impersonation_reason" VARCHAR(255);
+
+ALTER TABLE "sessions"
+ADD CONSTRAINT fk_sessions_impersonator_id
+FOREIGN KEY ("impersonator_id")
+REFERENCES "users"("id")
+ON DELETE SET NULL;
+
+CREATE INDEX idx_sessions_impersonator_id ON "sessions"("impersonator_id") WHERE "impersonator_id" IS NOT NULL;
+
+-- Comments for the impersonation columns of the sessions table
+COMMENT ON COLUMN "sessions"."impersonator_id" IS 'The administrator acting as the user in this session; NULL for the user''s own sessions.';
+COMMENT ON COLUMN "sessions"."impersonation_reason" IS 'Why the administrator started the impersonation, shown to the user in their login history.';
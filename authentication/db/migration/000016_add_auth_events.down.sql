@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS auth_events_append_only ON "auth_events";
+DROP FUNCTION IF EXISTS reject_auth_event_update();
+
+DROP TABLE IF EXISTS "auth_events";
@@ -0,0 +1,37 @@
+CREATE TABLE "auth_events" (
+    "id" UUID PRIMARY KEY,
+    "event_type" VARCHAR(50) NOT NULL,
+    "outcome" VARCHAR(20) NOT NULL,
+    "actor_id" UUID,
+    "user_id" UUID,
+    "email" VARCHAR(255),
+    "session_id" UUID,
+    "ip_address" VARCHAR(45),
+    "user_agent" TEXT,
+    "metadata" JSONB NOT NULL DEFAULT '{}',
+    "created_at" TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_auth_events_user_id ON "auth_events"("user_id", "created_at" DESC);
+CREATE INDEX idx_auth_events_actor_id ON "auth_events"("actor_id", "created_at" DESC) WHERE "actor_id" IS NOT NULL;
+CREATE INDEX idx_auth_events_event_type ON "auth_events"("event_type", "created_at" DESC);
+CREATE INDEX idx_auth_events_created_at ON "auth_events"("created_at");
+
+-- The log is append-only; rows are only ever removed by retention pruning
+CREATE FUNCTION reject_auth_event_update() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'auth_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER auth_events_append_only
+BEFORE UPDATE ON "auth_events"
+FOR EACH ROW EXECUTE FUNCTION reject_auth_event_update();
+
+-- Comments for auth_events table
+COMMENT ON COLUMN "auth_events"."event_type" IS 'What happened, e.g. login, otp_verification, password_change or session_revoked.';
+COMMENT ON COLUMN "auth_events"."outcome" IS 'Whether the attempt succeeded: success or failure.';
+COMMENT ON COLUMN "auth_events"."actor_id" IS 'Who performed the action: the user themselves or an administrator; NULL when the caller is unknown.';
+COMMENT ON COLUMN "auth_events"."user_id" IS 'The account the event is about. Not a foreign key so events outlive deleted accounts.';
+COMMENT ON COLUMN "auth_events"."email" IS 'The email the attempt was made for, kept for failed logins to unknown accounts.';
+COMMENT ON COLUMN "auth_events"."metadata" IS 'Event specific details such as the login method or failure reason.';
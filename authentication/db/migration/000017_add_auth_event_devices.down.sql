@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_auth_events_login_devices;
+
+ALTER TABLE "auth_events" DROP COLUMN IF EXISTS "location";
+ALTER TABLE "auth_events" DROP COLUMN IF EXISTS "device_fingerprint";
+ALTER TABLE "auth_events" DROP COLUMN IF EXISTS "device";
@@ -0,0 +1,12 @@
+ALTER TABLE "auth_events" ADD COLUMN "device" VARCHAR(100);
+ALTER TABLE "auth_events" ADD COLUMN "device_fingerprint" VARCHAR(64);
+ALTER TABLE "auth_events" ADD COLUMN "location" VARCHAR(255);
+
+-- Supports the "seen this device before" check made on every successful login
+CREATE INDEX idx_auth_events_login_devices ON "auth_events"("user_id", "device_fingerprint")
+WHERE "event_type" = 'login' AND "outcome" = 'success';
+
+-- Comments for the device columns of the auth_events table
+COMMENT ON COLUMN "auth_events"."device" IS 'Browser and operating system parsed from the user agent, e.g. Chrome on macOS.';
+COMMENT ON COLUMN "auth_events"."device_fingerprint" IS 'Hash of the parsed device, used to recognise logins from a device the user has used before.';
+COMMENT ON COLUMN "auth_events"."location" IS 'Coarse location of the IP address from the GeoIP database, e.g. Lagos, Nigeria.';
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "account_erasure_services";
+DROP TABLE IF EXISTS "account_erasures";
+
+DROP INDEX IF EXISTS idx_users_deletion_scheduled_for;
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "deletion_scheduled_for";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "deletion_requested_at";
@@ -0,0 +1,31 @@
+ALTER TABLE "users" ADD COLUMN "deletion_requested_at" TIMESTAMP;
+ALTER TABLE "users" ADD COLUMN "deletion_scheduled_for" TIMESTAMP;
+
+CREATE INDEX idx_users_deletion_scheduled_for ON "users"("deletion_scheduled_for") WHERE "deletion_scheduled_for" IS NOT NULL;
+
+-- One row per erased account; it outlives the user so erasure can be tracked across services
+CREATE TABLE "account_erasures" (
+    "user_id" UUID PRIMARY KEY,
+    "requested_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "published_at" TIMESTAMP
+);
+
+CREATE INDEX idx_account_erasures_unpublished ON "account_erasures"("deleted_at") WHERE "published_at" IS NULL;
+
+CREATE TABLE "account_erasure_services" (
+    "user_id" UUID NOT NULL REFERENCES "account_erasures"("user_id") ON DELETE CASCADE,
+    "service" VARCHAR(50) NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'pending',
+    "items" BIGINT NOT NULL DEFAULT 0,
+    "detail" TEXT,
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY ("user_id", "service")
+);
+
+-- Comments for the account erasure columns
+COMMENT ON COLUMN "users"."deletion_requested_at" IS 'When the user asked for their account to be deleted.';
+COMMENT ON COLUMN "users"."deletion_scheduled_for" IS 'When the account will be erased; signing in before then cancels the deletion.';
+COMMENT ON COLUMN "account_erasures"."published_at" IS 'When the user.deleted event was published; NULL until it has been.';
+COMMENT ON COLUMN "account_erasure_services"."status" IS 'pending until the service reports completed or failed.';
+COMMENT ON COLUMN "account_erasure_services"."items" IS 'How many records the service removed or anonymized.';
@@ -0,0 +1,29 @@
+-- Data exports answer subject-access requests. Rows are kept until the
+-- download link expires, and removed with the account if it is erased first.
+CREATE TABLE "data_exports" (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'pending',
+    "file_name" VARCHAR(255),
+    "size_bytes" BIGINT NOT NULL DEFAULT 0,
+    "download_token_hash" VARCHAR(64),
+    "error" TEXT,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "started_at" TIMESTAMP,
+    "completed_at" TIMESTAMP,
+    "expires_at" TIMESTAMP
+);
+
+CREATE INDEX idx_data_exports_user_id ON "data_exports"("user_id", "created_at" DESC);
+CREATE INDEX idx_data_exports_unfinished ON "data_exports"("created_at") WHERE "status" IN ('pending', 'building');
+CREATE INDEX idx_data_exports_expires_at ON "data_exports"("expires_at");
+
+-- A user has at most one export being built at a time
+CREATE UNIQUE INDEX data_exports_unfinished_user_key ON "data_exports"("user_id") WHERE "status" IN ('pending', 'building');
+
+-- Comments for the data export columns
+COMMENT ON COLUMN "data_exports"."status" IS 'pending, building, ready or failed.';
+COMMENT ON COLUMN "data_exports"."file_name" IS 'Name of the ZIP file in the export storage once it is ready.';
+COMMENT ON COLUMN "data_exports"."download_token_hash" IS 'SHA-256 of the token in the emailed download link.';
+COMMENT ON COLUMN "data_exports"."started_at" IS 'When building started; a build that never finishes is picked up again.';
+COMMENT ON COLUMN "data_exports"."expires_at" IS 'When the download link stops working and the file is removed.';
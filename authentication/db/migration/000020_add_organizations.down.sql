@@ -0,0 +1,4 @@
+ALTER TABLE "sessions" DROP COLUMN IF EXISTS "organization_id";
+DROP TABLE IF EXISTS "organization_invitations";
+DROP TABLE IF EXISTS "organization_members";
+DROP TABLE IF EXISTS "organizations";
@@ -0,0 +1,46 @@
+-- Organizations group users, such as the agents of an agency. Members hold an
+-- organization role on top of their account role.
+CREATE TABLE "organizations" (
+    "id" UUID PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL,
+    "created_by" UUID REFERENCES "users" ("id") ON DELETE SET NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE TABLE "organization_members" (
+    "organization_id" UUID NOT NULL REFERENCES "organizations" ("id") ON DELETE CASCADE,
+    "user_id" UUID NOT NULL REFERENCES "users" ("id") ON DELETE CASCADE,
+    "role" VARCHAR(20) NOT NULL,
+    "joined_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY ("organization_id", "user_id")
+);
+
+CREATE INDEX idx_organization_members_user_id ON "organization_members"("user_id");
+
+CREATE TABLE "organization_invitations" (
+    "id" UUID PRIMARY KEY,
+    "organization_id" UUID NOT NULL REFERENCES "organizations" ("id") ON DELETE CASCADE,
+    "email" VARCHAR NOT NULL,
+    "role" VARCHAR(20) NOT NULL,
+    "token_hash" VARCHAR(64) NOT NULL UNIQUE,
+    "invited_by" UUID REFERENCES "users" ("id") ON DELETE SET NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'pending',
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "expires_at" TIMESTAMP NOT NULL,
+    "responded_at" TIMESTAMP
+);
+
+CREATE INDEX idx_organization_invitations_organization_id ON "organization_invitations"("organization_id", "created_at" DESC);
+
+-- An address has at most one pending invitation per organization
+CREATE UNIQUE INDEX organization_invitations_pending_email_key ON "organization_invitations"("organization_id", lower("email")) WHERE "status" = 'pending';
+
+-- The organization a session acts for; it is put in the session's access tokens
+ALTER TABLE "sessions" ADD COLUMN "organization_id" UUID REFERENCES "organizations" ("id") ON DELETE SET NULL;
+
+-- Comments for the organization columns
+COMMENT ON COLUMN "organization_members"."role" IS 'owner, manager or agent.';
+COMMENT ON COLUMN "organization_invitations"."token_hash" IS 'SHA-256 of the token in the emailed invitation link.';
+COMMENT ON COLUMN "organization_invitations"."status" IS 'pending, accepted, declined or revoked.';
+COMMENT ON COLUMN "sessions"."organization_id" IS 'Organization the session acts for, if any.';
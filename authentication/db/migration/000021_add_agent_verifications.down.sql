@@ -0,0 +1,3 @@
+ALTER TABLE "users" DROP COLUMN IF EXISTS "agent_verified_at";
+DROP TABLE IF EXISTS "agent_verification_documents";
+DROP TABLE IF EXISTS "agent_verifications";
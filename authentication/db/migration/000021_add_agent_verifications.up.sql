@@ -0,0 +1,38 @@
+-- Agents verify their identity and license before buyers see them as verified.
+-- Each agent has one verification that is resubmitted until it is approved.
+CREATE TABLE "agent_verifications" (
+    "user_id" UUID PRIMARY KEY REFERENCES "users" ("id") ON DELETE CASCADE,
+    "license_number" VARCHAR(100) NOT NULL,
+    "business_name" VARCHAR(255) NOT NULL,
+    "business_address" VARCHAR NOT NULL,
+    "business_phone" VARCHAR(50),
+    "registration_number" VARCHAR(100),
+    "status" VARCHAR(30) NOT NULL DEFAULT 'pending',
+    "review_note" TEXT,
+    "reviewed_by" UUID REFERENCES "users" ("id") ON DELETE SET NULL,
+    "reviewed_at" TIMESTAMP,
+    "submitted_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_agent_verifications_status ON "agent_verifications"("status", "submitted_at");
+
+CREATE TABLE "agent_verification_documents" (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL REFERENCES "users" ("id") ON DELETE CASCADE,
+    "document_type" VARCHAR(30) NOT NULL,
+    "url" VARCHAR NOT NULL,
+    "uploaded_at" TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_agent_verification_documents_user_id ON "agent_verification_documents"("user_id", "uploaded_at");
+
+-- Set while the user's latest verification is approved; shown as the verified agent badge
+ALTER TABLE "users" ADD COLUMN "agent_verified_at" TIMESTAMP;
+
+-- Comments for the agent verification columns
+COMMENT ON COLUMN "agent_verifications"."status" IS 'pending, approved, rejected or more_info_requested.';
+COMMENT ON COLUMN "agent_verifications"."review_note" IS 'Why the verification was rejected or what more is needed, shown to the agent.';
+COMMENT ON COLUMN "agent_verification_documents"."document_type" IS 'id_document, license or business_registration.';
+COMMENT ON COLUMN "agent_verification_documents"."url" IS 'Signed URL of the document, which is stored as an authenticated asset.';
+COMMENT ON COLUMN "users"."agent_verified_at" IS 'When the agent''s identity and license were approved.';
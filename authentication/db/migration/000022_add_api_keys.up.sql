@@ -0,0 +1,27 @@
+-- API keys let integrations such as partner portals and CRM sync jobs call the
+-- API without signing in. A key belongs to the user who created it, or to an
+-- organization when organization_id is set. Only a hash of the key is kept.
+CREATE TABLE "api_keys" (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL REFERENCES "users" ("id") ON DELETE CASCADE,
+    "organization_id" UUID REFERENCES "organizations" ("id") ON DELETE CASCADE,
+    "name" VARCHAR(100) NOT NULL,
+    "prefix" VARCHAR(16) NOT NULL,
+    "key_hash" VARCHAR(64) NOT NULL UNIQUE,
+    "scopes" TEXT[] NOT NULL,
+    "expires_at" TIMESTAMP,
+    "last_used_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "revoked_at" TIMESTAMP
+);
+
+CREATE INDEX idx_api_keys_user_id ON "api_keys"("user_id", "created_at" DESC);
+CREATE INDEX idx_api_keys_organization_id ON "api_keys"("organization_id", "created_at" DESC);
+
+-- Comments for api_keys table
+COMMENT ON COLUMN "api_keys"."user_id" IS 'User who created the key; the key acts as them.';
+COMMENT ON COLUMN "api_keys"."organization_id" IS 'Organization the key belongs to and acts for, if any.';
+COMMENT ON COLUMN "api_keys"."prefix" IS 'Start of the key, shown so it can be recognised.';
+COMMENT ON COLUMN "api_keys"."key_hash" IS 'SHA-256 of the full key.';
+COMMENT ON COLUMN "api_keys"."scopes" IS 'Permissions the key grants, limited to those of its user.';
+COMMENT ON COLUMN "api_keys"."last_used_at" IS 'When the key was last exchanged for an access token.';
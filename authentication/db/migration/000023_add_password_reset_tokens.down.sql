@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_verification_challenges_reset_token_hash;
+
+ALTER TABLE "verification_challenges" DROP COLUMN IF EXISTS "reset_token_hash";
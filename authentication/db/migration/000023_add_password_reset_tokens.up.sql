@@ -0,0 +1,6 @@
+-- A verified password reset code is exchanged for a token, which alone authorises the reset
+ALTER TABLE "verification_challenges" ADD COLUMN "reset_token_hash" VARCHAR(64);
+
+CREATE UNIQUE INDEX idx_verification_challenges_reset_token_hash ON "verification_challenges"("reset_token_hash");
+
+COMMENT ON COLUMN "verification_challenges"."reset_token_hash" IS 'SHA-256 of the single-use token issued once a password reset code is verified.';
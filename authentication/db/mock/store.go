@@ -6,7 +6,9 @@ package mockdb
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	db "github.com/demola234/authentication/db/sqlc"
 	gomock "github.com/golang/mock/gomock"
@@ -36,269 +38,2282 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AcceptOrganizationInvitationTx mocks base method.
+func (m *MockStore) AcceptOrganizationInvitationTx(arg0 context.Context, arg1 db.AcceptOrganizationInvitationTxParams) (db.OrganizationMembers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrganizationInvitationTx", arg0, arg1)
+	ret0, _ := ret[0].(db.OrganizationMembers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptOrganizationInvitationTx indicates an expected call of AcceptOrganizationInvitationTx.
+func (mr *MockStoreMockRecorder) AcceptOrganizationInvitationTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrganizationInvitationTx", reflect.TypeOf((*MockStore)(nil).AcceptOrganizationInvitationTx), arg0, arg1)
+}
+
+// AddOrganizationMember mocks base method.
+func (m *MockStore) AddOrganizationMember(arg0 context.Context, arg1 db.AddOrganizationMemberParams) (db.OrganizationMembers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrganizationMember", arg0, arg1)
+	ret0, _ := ret[0].(db.OrganizationMembers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrganizationMember indicates an expected call of AddOrganizationMember.
+func (mr *MockStoreMockRecorder) AddOrganizationMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrganizationMember", reflect.TypeOf((*MockStore)(nil).AddOrganizationMember), arg0, arg1)
+}
+
+// CancelUserDeletion mocks base method.
+func (m *MockStore) CancelUserDeletion(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUserDeletion", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelUserDeletion indicates an expected call of CancelUserDeletion.
+func (mr *MockStoreMockRecorder) CancelUserDeletion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserDeletion", reflect.TypeOf((*MockStore)(nil).CancelUserDeletion), arg0, arg1)
+}
+
 // ChangePassword mocks base method.
 func (m *MockStore) ChangePassword(arg0 context.Context, arg1 db.ChangePasswordParams) (db.Users, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1)
-	ret0, _ := ret[0].(db.Users)
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1)
+	ret0, _ := ret[0].(db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockStoreMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockStore)(nil).ChangePassword), arg0, arg1)
+}
+
+// CheckEmailExists mocks base method.
+func (m *MockStore) CheckEmailExists(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckEmailExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckEmailExists indicates an expected call of CheckEmailExists.
+func (mr *MockStoreMockRecorder) CheckEmailExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEmailExists", reflect.TypeOf((*MockStore)(nil).CheckEmailExists), arg0, arg1)
+}
+
+// ClaimDataExport mocks base method.
+func (m *MockStore) ClaimDataExport(arg0 context.Context, arg1 sql.NullTime) (db.DataExports, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDataExport", arg0, arg1)
+	ret0, _ := ret[0].(db.DataExports)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDataExport indicates an expected call of ClaimDataExport.
+func (mr *MockStoreMockRecorder) ClaimDataExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDataExport", reflect.TypeOf((*MockStore)(nil).ClaimDataExport), arg0, arg1)
+}
+
+// ClearSessionOrganization mocks base method.
+func (m *MockStore) ClearSessionOrganization(arg0 context.Context, arg1 db.ClearSessionOrganizationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearSessionOrganization", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearSessionOrganization indicates an expected call of ClearSessionOrganization.
+func (mr *MockStoreMockRecorder) ClearSessionOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSessionOrganization", reflect.TypeOf((*MockStore)(nil).ClearSessionOrganization), arg0, arg1)
+}
+
+// CompleteDataExport mocks base method.
+func (m *MockStore) CompleteDataExport(arg0 context.Context, arg1 db.CompleteDataExportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteDataExport", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteDataExport indicates an expected call of CompleteDataExport.
+func (mr *MockStoreMockRecorder) CompleteDataExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDataExport", reflect.TypeOf((*MockStore)(nil).CompleteDataExport), arg0, arg1)
+}
+
+// ConfirmEmailChange mocks base method.
+func (m *MockStore) ConfirmEmailChange(arg0 context.Context, arg1 db.ConfirmEmailChangeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChange", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmailChange indicates an expected call of ConfirmEmailChange.
+func (mr *MockStoreMockRecorder) ConfirmEmailChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChange", reflect.TypeOf((*MockStore)(nil).ConfirmEmailChange), arg0, arg1)
+}
+
+// ConfirmEmailChangeTx mocks base method.
+func (m *MockStore) ConfirmEmailChangeTx(arg0 context.Context, arg1 db.ConfirmEmailChangeTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChangeTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmEmailChangeTx indicates an expected call of ConfirmEmailChangeTx.
+func (mr *MockStoreMockRecorder) ConfirmEmailChangeTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChangeTx", reflect.TypeOf((*MockStore)(nil).ConfirmEmailChangeTx), arg0, arg1)
+}
+
+// ConsumeMfaChallenge mocks base method.
+func (m *MockStore) ConsumeMfaChallenge(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeMfaChallenge", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeMfaChallenge indicates an expected call of ConsumeMfaChallenge.
+func (mr *MockStoreMockRecorder) ConsumeMfaChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeMfaChallenge", reflect.TypeOf((*MockStore)(nil).ConsumeMfaChallenge), arg0, arg1)
+}
+
+// ConsumeOAuthState mocks base method.
+func (m *MockStore) ConsumeOAuthState(arg0 context.Context, arg1 string) (db.OauthStates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeOAuthState", arg0, arg1)
+	ret0, _ := ret[0].(db.OauthStates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeOAuthState indicates an expected call of ConsumeOAuthState.
+func (mr *MockStoreMockRecorder) ConsumeOAuthState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOAuthState", reflect.TypeOf((*MockStore)(nil).ConsumeOAuthState), arg0, arg1)
+}
+
+// ConsumeVerificationChallenge mocks base method.
+func (m *MockStore) ConsumeVerificationChallenge(arg0 context.Context, arg1 db.ConsumeVerificationChallengeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeVerificationChallenge", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeVerificationChallenge indicates an expected call of ConsumeVerificationChallenge.
+func (mr *MockStoreMockRecorder) ConsumeVerificationChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeVerificationChallenge", reflect.TypeOf((*MockStore)(nil).ConsumeVerificationChallenge), arg0, arg1)
+}
+
+// ConsumeVerificationChallengeByResetToken mocks base method.
+func (m *MockStore) ConsumeVerificationChallengeByResetToken(arg0 context.Context, arg1 sql.NullString) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeVerificationChallengeByResetToken", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeVerificationChallengeByResetToken indicates an expected call of ConsumeVerificationChallengeByResetToken.
+func (mr *MockStoreMockRecorder) ConsumeVerificationChallengeByResetToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeVerificationChallengeByResetToken", reflect.TypeOf((*MockStore)(nil).ConsumeVerificationChallengeByResetToken), arg0, arg1)
+}
+
+// CountActiveOrganizationAPIKeys mocks base method.
+func (m *MockStore) CountActiveOrganizationAPIKeys(arg0 context.Context, arg1 uuid.NullUUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveOrganizationAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveOrganizationAPIKeys indicates an expected call of CountActiveOrganizationAPIKeys.
+func (mr *MockStoreMockRecorder) CountActiveOrganizationAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveOrganizationAPIKeys", reflect.TypeOf((*MockStore)(nil).CountActiveOrganizationAPIKeys), arg0, arg1)
+}
+
+// CountActiveUserAPIKeys mocks base method.
+func (m *MockStore) CountActiveUserAPIKeys(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveUserAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveUserAPIKeys indicates an expected call of CountActiveUserAPIKeys.
+func (mr *MockStoreMockRecorder) CountActiveUserAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveUserAPIKeys", reflect.TypeOf((*MockStore)(nil).CountActiveUserAPIKeys), arg0, arg1)
+}
+
+// CountAgentVerifications mocks base method.
+func (m *MockStore) CountAgentVerifications(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAgentVerifications", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAgentVerifications indicates an expected call of CountAgentVerifications.
+func (mr *MockStoreMockRecorder) CountAgentVerifications(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAgentVerifications", reflect.TypeOf((*MockStore)(nil).CountAgentVerifications), arg0, arg1)
+}
+
+// CountAuthEvents mocks base method.
+func (m *MockStore) CountAuthEvents(arg0 context.Context, arg1 db.CountAuthEventsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAuthEvents", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAuthEvents indicates an expected call of CountAuthEvents.
+func (mr *MockStoreMockRecorder) CountAuthEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAuthEvents", reflect.TypeOf((*MockStore)(nil).CountAuthEvents), arg0, arg1)
+}
+
+// CountOrganizationOwners mocks base method.
+func (m *MockStore) CountOrganizationOwners(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOrganizationOwners", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOrganizationOwners indicates an expected call of CountOrganizationOwners.
+func (mr *MockStoreMockRecorder) CountOrganizationOwners(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOrganizationOwners", reflect.TypeOf((*MockStore)(nil).CountOrganizationOwners), arg0, arg1)
+}
+
+// CountUnusedRecoveryCodes mocks base method.
+func (m *MockStore) CountUnusedRecoveryCodes(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnusedRecoveryCodes", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnusedRecoveryCodes indicates an expected call of CountUnusedRecoveryCodes.
+func (mr *MockStoreMockRecorder) CountUnusedRecoveryCodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnusedRecoveryCodes", reflect.TypeOf((*MockStore)(nil).CountUnusedRecoveryCodes), arg0, arg1)
+}
+
+// CountUsers mocks base method.
+func (m *MockStore) CountUsers(arg0 context.Context, arg1 db.CountUsersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsers", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsers indicates an expected call of CountUsers.
+func (mr *MockStoreMockRecorder) CountUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsers", reflect.TypeOf((*MockStore)(nil).CountUsers), arg0, arg1)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockStore) CreateAPIKey(arg0 context.Context, arg1 db.CreateAPIKeyParams) (db.ApiKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(db.ApiKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockStoreMockRecorder) CreateAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockStore)(nil).CreateAPIKey), arg0, arg1)
+}
+
+// CreateAccountErasure mocks base method.
+func (m *MockStore) CreateAccountErasure(arg0 context.Context, arg1 db.CreateAccountErasureParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountErasure", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAccountErasure indicates an expected call of CreateAccountErasure.
+func (mr *MockStoreMockRecorder) CreateAccountErasure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountErasure", reflect.TypeOf((*MockStore)(nil).CreateAccountErasure), arg0, arg1)
+}
+
+// CreateAccountErasureService mocks base method.
+func (m *MockStore) CreateAccountErasureService(arg0 context.Context, arg1 db.CreateAccountErasureServiceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountErasureService", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAccountErasureService indicates an expected call of CreateAccountErasureService.
+func (mr *MockStoreMockRecorder) CreateAccountErasureService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountErasureService", reflect.TypeOf((*MockStore)(nil).CreateAccountErasureService), arg0, arg1)
+}
+
+// CreateAgentVerificationDocument mocks base method.
+func (m *MockStore) CreateAgentVerificationDocument(arg0 context.Context, arg1 db.CreateAgentVerificationDocumentParams) (db.AgentVerificationDocuments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAgentVerificationDocument", arg0, arg1)
+	ret0, _ := ret[0].(db.AgentVerificationDocuments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAgentVerificationDocument indicates an expected call of CreateAgentVerificationDocument.
+func (mr *MockStoreMockRecorder) CreateAgentVerificationDocument(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAgentVerificationDocument", reflect.TypeOf((*MockStore)(nil).CreateAgentVerificationDocument), arg0, arg1)
+}
+
+// CreateAuthEvent mocks base method.
+func (m *MockStore) CreateAuthEvent(arg0 context.Context, arg1 db.CreateAuthEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuthEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuthEvent indicates an expected call of CreateAuthEvent.
+func (mr *MockStoreMockRecorder) CreateAuthEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthEvent", reflect.TypeOf((*MockStore)(nil).CreateAuthEvent), arg0, arg1)
+}
+
+// CreateDataExport mocks base method.
+func (m *MockStore) CreateDataExport(arg0 context.Context, arg1 db.CreateDataExportParams) (db.DataExports, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataExport", arg0, arg1)
+	ret0, _ := ret[0].(db.DataExports)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDataExport indicates an expected call of CreateDataExport.
+func (mr *MockStoreMockRecorder) CreateDataExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataExport", reflect.TypeOf((*MockStore)(nil).CreateDataExport), arg0, arg1)
+}
+
+// CreateEmailChange mocks base method.
+func (m *MockStore) CreateEmailChange(arg0 context.Context, arg1 db.CreateEmailChangeParams) (db.EmailChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailChange", arg0, arg1)
+	ret0, _ := ret[0].(db.EmailChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEmailChange indicates an expected call of CreateEmailChange.
+func (mr *MockStoreMockRecorder) CreateEmailChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailChange", reflect.TypeOf((*MockStore)(nil).CreateEmailChange), arg0, arg1)
+}
+
+// CreateImpersonationSession mocks base method.
+func (m *MockStore) CreateImpersonationSession(arg0 context.Context, arg1 db.CreateImpersonationSessionParams) (db.Sessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImpersonationSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Sessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImpersonationSession indicates an expected call of CreateImpersonationSession.
+func (mr *MockStoreMockRecorder) CreateImpersonationSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImpersonationSession", reflect.TypeOf((*MockStore)(nil).CreateImpersonationSession), arg0, arg1)
+}
+
+// CreateLoginHistoryEntry mocks base method.
+func (m *MockStore) CreateLoginHistoryEntry(arg0 context.Context, arg1 db.CreateLoginHistoryEntryParams) (db.Sessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoginHistoryEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.Sessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoginHistoryEntry indicates an expected call of CreateLoginHistoryEntry.
+func (mr *MockStoreMockRecorder) CreateLoginHistoryEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoginHistoryEntry", reflect.TypeOf((*MockStore)(nil).CreateLoginHistoryEntry), arg0, arg1)
+}
+
+// CreateMagicLink mocks base method.
+func (m *MockStore) CreateMagicLink(arg0 context.Context, arg1 db.CreateMagicLinkParams) (db.MagicLinks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMagicLink", arg0, arg1)
+	ret0, _ := ret[0].(db.MagicLinks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMagicLink indicates an expected call of CreateMagicLink.
+func (mr *MockStoreMockRecorder) CreateMagicLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMagicLink", reflect.TypeOf((*MockStore)(nil).CreateMagicLink), arg0, arg1)
+}
+
+// CreateMfaChallenge mocks base method.
+func (m *MockStore) CreateMfaChallenge(arg0 context.Context, arg1 db.CreateMfaChallengeParams) (db.MfaChallenges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMfaChallenge", arg0, arg1)
+	ret0, _ := ret[0].(db.MfaChallenges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMfaChallenge indicates an expected call of CreateMfaChallenge.
+func (mr *MockStoreMockRecorder) CreateMfaChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMfaChallenge", reflect.TypeOf((*MockStore)(nil).CreateMfaChallenge), arg0, arg1)
+}
+
+// CreateOAuthState mocks base method.
+func (m *MockStore) CreateOAuthState(arg0 context.Context, arg1 db.CreateOAuthStateParams) (db.OauthStates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOAuthState", arg0, arg1)
+	ret0, _ := ret[0].(db.OauthStates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOAuthState indicates an expected call of CreateOAuthState.
+func (mr *MockStoreMockRecorder) CreateOAuthState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOAuthState", reflect.TypeOf((*MockStore)(nil).CreateOAuthState), arg0, arg1)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockStore) CreateOrganization(arg0 context.Context, arg1 db.CreateOrganizationParams) (db.Organizations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", arg0, arg1)
+	ret0, _ := ret[0].(db.Organizations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockStoreMockRecorder) CreateOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockStore)(nil).CreateOrganization), arg0, arg1)
+}
+
+// CreateOrganizationInvitation mocks base method.
+func (m *MockStore) CreateOrganizationInvitation(arg0 context.Context, arg1 db.CreateOrganizationInvitationParams) (db.OrganizationInvitations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationInvitation", arg0, arg1)
+	ret0, _ := ret[0].(db.OrganizationInvitations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationInvitation indicates an expected call of CreateOrganizationInvitation.
+func (mr *MockStoreMockRecorder) CreateOrganizationInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationInvitation", reflect.TypeOf((*MockStore)(nil).CreateOrganizationInvitation), arg0, arg1)
+}
+
+// CreateOrganizationTx mocks base method.
+func (m *MockStore) CreateOrganizationTx(arg0 context.Context, arg1 db.CreateOrganizationTxParams) (db.Organizations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Organizations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationTx indicates an expected call of CreateOrganizationTx.
+func (mr *MockStoreMockRecorder) CreateOrganizationTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationTx", reflect.TypeOf((*MockStore)(nil).CreateOrganizationTx), arg0, arg1)
+}
+
+// CreatePasswordHistory mocks base method.
+func (m *MockStore) CreatePasswordHistory(arg0 context.Context, arg1 db.CreatePasswordHistoryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordHistory indicates an expected call of CreatePasswordHistory.
+func (mr *MockStoreMockRecorder) CreatePasswordHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordHistory", reflect.TypeOf((*MockStore)(nil).CreatePasswordHistory), arg0, arg1)
+}
+
+// CreatePasswordReset mocks base method.
+func (m *MockStore) CreatePasswordReset(arg0 context.Context, arg1 db.CreatePasswordResetParams) (db.PasswordResets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(db.PasswordResets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockStoreMockRecorder) CreatePasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockStore)(nil).CreatePasswordReset), arg0, arg1)
+}
+
+// CreateRecoveryCode mocks base method.
+func (m *MockStore) CreateRecoveryCode(arg0 context.Context, arg1 db.CreateRecoveryCodeParams) (db.MfaRecoveryCodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(db.MfaRecoveryCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecoveryCode indicates an expected call of CreateRecoveryCode.
+func (mr *MockStoreMockRecorder) CreateRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryCode", reflect.TypeOf((*MockStore)(nil).CreateRecoveryCode), arg0, arg1)
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockStore) CreateRefreshToken(arg0 context.Context, arg1 db.CreateRefreshTokenParams) (db.RefreshTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", arg0, arg1)
+	ret0, _ := ret[0].(db.RefreshTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockStoreMockRecorder) CreateRefreshToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockStore)(nil).CreateRefreshToken), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Sessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Sessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+}
+
+// CreateTokenSigningKey mocks base method.
+func (m *MockStore) CreateTokenSigningKey(arg0 context.Context, arg1 db.CreateTokenSigningKeyParams) (db.TokenSigningKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTokenSigningKey", arg0, arg1)
+	ret0, _ := ret[0].(db.TokenSigningKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTokenSigningKey indicates an expected call of CreateTokenSigningKey.
+func (mr *MockStoreMockRecorder) CreateTokenSigningKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTokenSigningKey", reflect.TypeOf((*MockStore)(nil).CreateTokenSigningKey), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+}
+
+// CreateUserIdentity mocks base method.
+func (m *MockStore) CreateUserIdentity(arg0 context.Context, arg1 db.CreateUserIdentityParams) (db.UserIdentities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserIdentity", arg0, arg1)
+	ret0, _ := ret[0].(db.UserIdentities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserIdentity indicates an expected call of CreateUserIdentity.
+func (mr *MockStoreMockRecorder) CreateUserIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserIdentity", reflect.TypeOf((*MockStore)(nil).CreateUserIdentity), arg0, arg1)
+}
+
+// CreateUserWithIdentityTx mocks base method.
+func (m *MockStore) CreateUserWithIdentityTx(arg0 context.Context, arg1 db.CreateUserWithIdentityTxParams) (db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserWithIdentityTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserWithIdentityTx indicates an expected call of CreateUserWithIdentityTx.
+func (mr *MockStoreMockRecorder) CreateUserWithIdentityTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserWithIdentityTx", reflect.TypeOf((*MockStore)(nil).CreateUserWithIdentityTx), arg0, arg1)
+}
+
+// CreateVerificationChallenge mocks base method.
+func (m *MockStore) CreateVerificationChallenge(arg0 context.Context, arg1 db.CreateVerificationChallengeParams) (db.VerificationChallenges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerificationChallenge", arg0, arg1)
+	ret0, _ := ret[0].(db.VerificationChallenges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVerificationChallenge indicates an expected call of CreateVerificationChallenge.
+func (mr *MockStoreMockRecorder) CreateVerificationChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerificationChallenge", reflect.TypeOf((*MockStore)(nil).CreateVerificationChallenge), arg0, arg1)
+}
+
+// DeleteAuthThrottle mocks base method.
+func (m *MockStore) DeleteAuthThrottle(arg0 context.Context, arg1 db.DeleteAuthThrottleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuthThrottle", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuthThrottle indicates an expected call of DeleteAuthThrottle.
+func (mr *MockStoreMockRecorder) DeleteAuthThrottle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthThrottle", reflect.TypeOf((*MockStore)(nil).DeleteAuthThrottle), arg0, arg1)
+}
+
+// DeleteAuthThrottlesBySubject mocks base method.
+func (m *MockStore) DeleteAuthThrottlesBySubject(arg0 context.Context, arg1 db.DeleteAuthThrottlesBySubjectParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuthThrottlesBySubject", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuthThrottlesBySubject indicates an expected call of DeleteAuthThrottlesBySubject.
+func (mr *MockStoreMockRecorder) DeleteAuthThrottlesBySubject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthThrottlesBySubject", reflect.TypeOf((*MockStore)(nil).DeleteAuthThrottlesBySubject), arg0, arg1)
+}
+
+// DeleteExpiredDataExports mocks base method.
+func (m *MockStore) DeleteExpiredDataExports(arg0 context.Context, arg1 sql.NullTime) ([]db.DataExports, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredDataExports", arg0, arg1)
+	ret0, _ := ret[0].([]db.DataExports)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredDataExports indicates an expected call of DeleteExpiredDataExports.
+func (mr *MockStoreMockRecorder) DeleteExpiredDataExports(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredDataExports", reflect.TypeOf((*MockStore)(nil).DeleteExpiredDataExports), arg0, arg1)
+}
+
+// DeleteExpiredMagicLinks mocks base method.
+func (m *MockStore) DeleteExpiredMagicLinks(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredMagicLinks", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredMagicLinks indicates an expected call of DeleteExpiredMagicLinks.
+func (mr *MockStoreMockRecorder) DeleteExpiredMagicLinks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredMagicLinks", reflect.TypeOf((*MockStore)(nil).DeleteExpiredMagicLinks), arg0)
+}
+
+// DeleteExpiredMfaChallenges mocks base method.
+func (m *MockStore) DeleteExpiredMfaChallenges(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredMfaChallenges", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredMfaChallenges indicates an expected call of DeleteExpiredMfaChallenges.
+func (mr *MockStoreMockRecorder) DeleteExpiredMfaChallenges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredMfaChallenges", reflect.TypeOf((*MockStore)(nil).DeleteExpiredMfaChallenges), arg0)
+}
+
+// DeleteExpiredOAuthStates mocks base method.
+func (m *MockStore) DeleteExpiredOAuthStates(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredOAuthStates", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredOAuthStates indicates an expected call of DeleteExpiredOAuthStates.
+func (mr *MockStoreMockRecorder) DeleteExpiredOAuthStates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredOAuthStates", reflect.TypeOf((*MockStore)(nil).DeleteExpiredOAuthStates), arg0)
+}
+
+// DeleteExpiredPasswordResets mocks base method.
+func (m *MockStore) DeleteExpiredPasswordResets(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredPasswordResets", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredPasswordResets indicates an expected call of DeleteExpiredPasswordResets.
+func (mr *MockStoreMockRecorder) DeleteExpiredPasswordResets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredPasswordResets", reflect.TypeOf((*MockStore)(nil).DeleteExpiredPasswordResets), arg0)
+}
+
+// DeleteExpiredRefreshTokens mocks base method.
+func (m *MockStore) DeleteExpiredRefreshTokens(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredRefreshTokens", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredRefreshTokens indicates an expected call of DeleteExpiredRefreshTokens.
+func (mr *MockStoreMockRecorder) DeleteExpiredRefreshTokens(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredRefreshTokens", reflect.TypeOf((*MockStore)(nil).DeleteExpiredRefreshTokens), arg0)
+}
+
+// DeleteExpiredRevokedSessions mocks base method.
+func (m *MockStore) DeleteExpiredRevokedSessions(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredRevokedSessions", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredRevokedSessions indicates an expected call of DeleteExpiredRevokedSessions.
+func (mr *MockStoreMockRecorder) DeleteExpiredRevokedSessions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredRevokedSessions", reflect.TypeOf((*MockStore)(nil).DeleteExpiredRevokedSessions), arg0)
+}
+
+// DeleteExpiredTokenSigningKeys mocks base method.
+func (m *MockStore) DeleteExpiredTokenSigningKeys(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredTokenSigningKeys", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredTokenSigningKeys indicates an expected call of DeleteExpiredTokenSigningKeys.
+func (mr *MockStoreMockRecorder) DeleteExpiredTokenSigningKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredTokenSigningKeys", reflect.TypeOf((*MockStore)(nil).DeleteExpiredTokenSigningKeys), arg0)
+}
+
+// DeleteExpiredVerificationChallenges mocks base method.
+func (m *MockStore) DeleteExpiredVerificationChallenges(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredVerificationChallenges", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredVerificationChallenges indicates an expected call of DeleteExpiredVerificationChallenges.
+func (mr *MockStoreMockRecorder) DeleteExpiredVerificationChallenges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredVerificationChallenges", reflect.TypeOf((*MockStore)(nil).DeleteExpiredVerificationChallenges), arg0)
+}
+
+// DeleteOrganizationMember mocks base method.
+func (m *MockStore) DeleteOrganizationMember(arg0 context.Context, arg1 db.DeleteOrganizationMemberParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationMember", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganizationMember indicates an expected call of DeleteOrganizationMember.
+func (mr *MockStoreMockRecorder) DeleteOrganizationMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationMember", reflect.TypeOf((*MockStore)(nil).DeleteOrganizationMember), arg0, arg1)
+}
+
+// DeletePasswordResetsByUserId mocks base method.
+func (m *MockStore) DeletePasswordResetsByUserId(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePasswordResetsByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePasswordResetsByUserId indicates an expected call of DeletePasswordResetsByUserId.
+func (mr *MockStoreMockRecorder) DeletePasswordResetsByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePasswordResetsByUserId", reflect.TypeOf((*MockStore)(nil).DeletePasswordResetsByUserId), arg0, arg1)
+}
+
+// DeletePendingEmailChanges mocks base method.
+func (m *MockStore) DeletePendingEmailChanges(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingEmailChanges", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePendingEmailChanges indicates an expected call of DeletePendingEmailChanges.
+func (mr *MockStoreMockRecorder) DeletePendingEmailChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingEmailChanges", reflect.TypeOf((*MockStore)(nil).DeletePendingEmailChanges), arg0, arg1)
+}
+
+// DeleteRecoveryCodesByUserID mocks base method.
+func (m *MockStore) DeleteRecoveryCodesByUserID(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecoveryCodesByUserID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecoveryCodesByUserID indicates an expected call of DeleteRecoveryCodesByUserID.
+func (mr *MockStoreMockRecorder) DeleteRecoveryCodesByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecoveryCodesByUserID", reflect.TypeOf((*MockStore)(nil).DeleteRecoveryCodesByUserID), arg0, arg1)
+}
+
+// DeleteSession mocks base method.
+func (m *MockStore) DeleteSession(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockStoreMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockStore)(nil).DeleteSession), arg0, arg1)
+}
+
+// DeleteUser mocks base method.
+func (m *MockStore) DeleteUser(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockStoreMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), arg0, arg1)
+}
+
+// DeleteUserDueForDeletion mocks base method.
+func (m *MockStore) DeleteUserDueForDeletion(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserDueForDeletion", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserDueForDeletion indicates an expected call of DeleteUserDueForDeletion.
+func (mr *MockStoreMockRecorder) DeleteUserDueForDeletion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserDueForDeletion", reflect.TypeOf((*MockStore)(nil).DeleteUserDueForDeletion), arg0, arg1)
+}
+
+// DeleteUserIdentity mocks base method.
+func (m *MockStore) DeleteUserIdentity(arg0 context.Context, arg1 db.DeleteUserIdentityParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserIdentity", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserIdentity indicates an expected call of DeleteUserIdentity.
+func (mr *MockStoreMockRecorder) DeleteUserIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserIdentity", reflect.TypeOf((*MockStore)(nil).DeleteUserIdentity), arg0, arg1)
+}
+
+// DeleteUserMfa mocks base method.
+func (m *MockStore) DeleteUserMfa(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserMfa", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserMfa indicates an expected call of DeleteUserMfa.
+func (mr *MockStoreMockRecorder) DeleteUserMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserMfa", reflect.TypeOf((*MockStore)(nil).DeleteUserMfa), arg0, arg1)
+}
+
+// EnableUserMfa mocks base method.
+func (m *MockStore) EnableUserMfa(arg0 context.Context, arg1 uuid.UUID) (db.UserMfa, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableUserMfa", arg0, arg1)
+	ret0, _ := ret[0].(db.UserMfa)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableUserMfa indicates an expected call of EnableUserMfa.
+func (mr *MockStoreMockRecorder) EnableUserMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUserMfa", reflect.TypeOf((*MockStore)(nil).EnableUserMfa), arg0, arg1)
+}
+
+// EraseUserTx mocks base method.
+func (m *MockStore) EraseUserTx(arg0 context.Context, arg1 db.EraseUserTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUserTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseUserTx indicates an expected call of EraseUserTx.
+func (mr *MockStoreMockRecorder) EraseUserTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUserTx", reflect.TypeOf((*MockStore)(nil).EraseUserTx), arg0, arg1)
+}
+
+// ExpireMagicLinksByUserID mocks base method.
+func (m *MockStore) ExpireMagicLinksByUserID(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireMagicLinksByUserID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireMagicLinksByUserID indicates an expected call of ExpireMagicLinksByUserID.
+func (mr *MockStoreMockRecorder) ExpireMagicLinksByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireMagicLinksByUserID", reflect.TypeOf((*MockStore)(nil).ExpireMagicLinksByUserID), arg0, arg1)
+}
+
+// FailDataExport mocks base method.
+func (m *MockStore) FailDataExport(arg0 context.Context, arg1 db.FailDataExportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailDataExport", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailDataExport indicates an expected call of FailDataExport.
+func (mr *MockStoreMockRecorder) FailDataExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailDataExport", reflect.TypeOf((*MockStore)(nil).FailDataExport), arg0, arg1)
+}
+
+// GetAPIKey mocks base method.
+func (m *MockStore) GetAPIKey(arg0 context.Context, arg1 uuid.UUID) (db.ApiKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(db.ApiKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockStoreMockRecorder) GetAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockStore)(nil).GetAPIKey), arg0, arg1)
+}
+
+// GetAPIKeyByHash mocks base method.
+func (m *MockStore) GetAPIKeyByHash(arg0 context.Context, arg1 string) (db.ApiKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeyByHash", arg0, arg1)
+	ret0, _ := ret[0].(db.ApiKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeyByHash indicates an expected call of GetAPIKeyByHash.
+func (mr *MockStoreMockRecorder) GetAPIKeyByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeyByHash", reflect.TypeOf((*MockStore)(nil).GetAPIKeyByHash), arg0, arg1)
+}
+
+// GetAccountErasure mocks base method.
+func (m *MockStore) GetAccountErasure(arg0 context.Context, arg1 uuid.UUID) (db.AccountErasures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountErasure", arg0, arg1)
+	ret0, _ := ret[0].(db.AccountErasures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountErasure indicates an expected call of GetAccountErasure.
+func (mr *MockStoreMockRecorder) GetAccountErasure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountErasure", reflect.TypeOf((*MockStore)(nil).GetAccountErasure), arg0, arg1)
+}
+
+// GetActiveVerificationChallenge mocks base method.
+func (m *MockStore) GetActiveVerificationChallenge(arg0 context.Context, arg1 db.GetActiveVerificationChallengeParams) (db.VerificationChallenges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveVerificationChallenge", arg0, arg1)
+	ret0, _ := ret[0].(db.VerificationChallenges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveVerificationChallenge indicates an expected call of GetActiveVerificationChallenge.
+func (mr *MockStoreMockRecorder) GetActiveVerificationChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveVerificationChallenge", reflect.TypeOf((*MockStore)(nil).GetActiveVerificationChallenge), arg0, arg1)
+}
+
+// GetAgentVerification mocks base method.
+func (m *MockStore) GetAgentVerification(arg0 context.Context, arg1 uuid.UUID) (db.GetAgentVerificationRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentVerification", arg0, arg1)
+	ret0, _ := ret[0].(db.GetAgentVerificationRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgentVerification indicates an expected call of GetAgentVerification.
+func (mr *MockStoreMockRecorder) GetAgentVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentVerification", reflect.TypeOf((*MockStore)(nil).GetAgentVerification), arg0, arg1)
+}
+
+// GetAuthThrottle mocks base method.
+func (m *MockStore) GetAuthThrottle(arg0 context.Context, arg1 db.GetAuthThrottleParams) (db.AuthThrottles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthThrottle", arg0, arg1)
+	ret0, _ := ret[0].(db.AuthThrottles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthThrottle indicates an expected call of GetAuthThrottle.
+func (mr *MockStoreMockRecorder) GetAuthThrottle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthThrottle", reflect.TypeOf((*MockStore)(nil).GetAuthThrottle), arg0, arg1)
+}
+
+// GetDataExport mocks base method.
+func (m *MockStore) GetDataExport(arg0 context.Context, arg1 uuid.UUID) (db.DataExports, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataExport", arg0, arg1)
+	ret0, _ := ret[0].(db.DataExports)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataExport indicates an expected call of GetDataExport.
+func (mr *MockStoreMockRecorder) GetDataExport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataExport", reflect.TypeOf((*MockStore)(nil).GetDataExport), arg0, arg1)
+}
+
+// GetEmailChangeByRevertToken mocks base method.
+func (m *MockStore) GetEmailChangeByRevertToken(arg0 context.Context, arg1 sql.NullString) (db.EmailChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailChangeByRevertToken", arg0, arg1)
+	ret0, _ := ret[0].(db.EmailChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailChangeByRevertToken indicates an expected call of GetEmailChangeByRevertToken.
+func (mr *MockStoreMockRecorder) GetEmailChangeByRevertToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailChangeByRevertToken", reflect.TypeOf((*MockStore)(nil).GetEmailChangeByRevertToken), arg0, arg1)
+}
+
+// GetLoginFamiliarity mocks base method.
+func (m *MockStore) GetLoginFamiliarity(arg0 context.Context, arg1 db.GetLoginFamiliarityParams) (db.GetLoginFamiliarityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginFamiliarity", arg0, arg1)
+	ret0, _ := ret[0].(db.GetLoginFamiliarityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginFamiliarity indicates an expected call of GetLoginFamiliarity.
+func (mr *MockStoreMockRecorder) GetLoginFamiliarity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginFamiliarity", reflect.TypeOf((*MockStore)(nil).GetLoginFamiliarity), arg0, arg1)
+}
+
+// GetMagicLinkByHash mocks base method.
+func (m *MockStore) GetMagicLinkByHash(arg0 context.Context, arg1 string) (db.MagicLinks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMagicLinkByHash", arg0, arg1)
+	ret0, _ := ret[0].(db.MagicLinks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMagicLinkByHash indicates an expected call of GetMagicLinkByHash.
+func (mr *MockStoreMockRecorder) GetMagicLinkByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMagicLinkByHash", reflect.TypeOf((*MockStore)(nil).GetMagicLinkByHash), arg0, arg1)
+}
+
+// GetMfaChallenge mocks base method.
+func (m *MockStore) GetMfaChallenge(arg0 context.Context, arg1 uuid.UUID) (db.MfaChallenges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMfaChallenge", arg0, arg1)
+	ret0, _ := ret[0].(db.MfaChallenges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMfaChallenge indicates an expected call of GetMfaChallenge.
+func (mr *MockStoreMockRecorder) GetMfaChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMfaChallenge", reflect.TypeOf((*MockStore)(nil).GetMfaChallenge), arg0, arg1)
+}
+
+// GetOrganization mocks base method.
+func (m *MockStore) GetOrganization(arg0 context.Context, arg1 uuid.UUID) (db.Organizations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", arg0, arg1)
+	ret0, _ := ret[0].(db.Organizations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *MockStoreMockRecorder) GetOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockStore)(nil).GetOrganization), arg0, arg1)
+}
+
+// GetOrganizationInvitation mocks base method.
+func (m *MockStore) GetOrganizationInvitation(arg0 context.Context, arg1 uuid.UUID) (db.GetOrganizationInvitationRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationInvitation", arg0, arg1)
+	ret0, _ := ret[0].(db.GetOrganizationInvitationRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationInvitation indicates an expected call of GetOrganizationInvitation.
+func (mr *MockStoreMockRecorder) GetOrganizationInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationInvitation", reflect.TypeOf((*MockStore)(nil).GetOrganizationInvitation), arg0, arg1)
+}
+
+// GetOrganizationInvitationByToken mocks base method.
+func (m *MockStore) GetOrganizationInvitationByToken(arg0 context.Context, arg1 string) (db.GetOrganizationInvitationByTokenRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationInvitationByToken", arg0, arg1)
+	ret0, _ := ret[0].(db.GetOrganizationInvitationByTokenRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationInvitationByToken indicates an expected call of GetOrganizationInvitationByToken.
+func (mr *MockStoreMockRecorder) GetOrganizationInvitationByToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationInvitationByToken", reflect.TypeOf((*MockStore)(nil).GetOrganizationInvitationByToken), arg0, arg1)
+}
+
+// GetOrganizationMember mocks base method.
+func (m *MockStore) GetOrganizationMember(arg0 context.Context, arg1 db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMember", arg0, arg1)
+	ret0, _ := ret[0].(db.GetOrganizationMemberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMember indicates an expected call of GetOrganizationMember.
+func (mr *MockStoreMockRecorder) GetOrganizationMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMember", reflect.TypeOf((*MockStore)(nil).GetOrganizationMember), arg0, arg1)
+}
+
+// GetPasswordResetByToken mocks base method.
+func (m *MockStore) GetPasswordResetByToken(arg0 context.Context, arg1 string) (db.PasswordResets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordResetByToken", arg0, arg1)
+	ret0, _ := ret[0].(db.PasswordResets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPasswordResetByToken indicates an expected call of GetPasswordResetByToken.
+func (mr *MockStoreMockRecorder) GetPasswordResetByToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordResetByToken", reflect.TypeOf((*MockStore)(nil).GetPasswordResetByToken), arg0, arg1)
+}
+
+// GetPendingEmailChange mocks base method.
+func (m *MockStore) GetPendingEmailChange(arg0 context.Context, arg1 uuid.UUID) (db.EmailChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingEmailChange", arg0, arg1)
+	ret0, _ := ret[0].(db.EmailChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingEmailChange indicates an expected call of GetPendingEmailChange.
+func (mr *MockStoreMockRecorder) GetPendingEmailChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingEmailChange", reflect.TypeOf((*MockStore)(nil).GetPendingEmailChange), arg0, arg1)
+}
+
+// GetRefreshTokenByHash mocks base method.
+func (m *MockStore) GetRefreshTokenByHash(arg0 context.Context, arg1 string) (db.RefreshTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshTokenByHash", arg0, arg1)
+	ret0, _ := ret[0].(db.RefreshTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshTokenByHash indicates an expected call of GetRefreshTokenByHash.
+func (mr *MockStoreMockRecorder) GetRefreshTokenByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshTokenByHash", reflect.TypeOf((*MockStore)(nil).GetRefreshTokenByHash), arg0, arg1)
+}
+
+// GetSessionByID mocks base method.
+func (m *MockStore) GetSessionByID(arg0 context.Context, arg1 uuid.UUID) (db.Sessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByID", arg0, arg1)
+	ret0, _ := ret[0].(db.Sessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByID indicates an expected call of GetSessionByID.
+func (mr *MockStoreMockRecorder) GetSessionByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByID", reflect.TypeOf((*MockStore)(nil).GetSessionByID), arg0, arg1)
+}
+
+// GetSessionByUserID mocks base method.
+func (m *MockStore) GetSessionByUserID(arg0 context.Context, arg1 uuid.UUID) (db.Sessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByUserID", arg0, arg1)
+	ret0, _ := ret[0].(db.Sessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByUserID indicates an expected call of GetSessionByUserID.
+func (mr *MockStoreMockRecorder) GetSessionByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByUserID", reflect.TypeOf((*MockStore)(nil).GetSessionByUserID), arg0, arg1)
+}
+
+// GetSessionOrganization mocks base method.
+func (m *MockStore) GetSessionOrganization(arg0 context.Context, arg1 uuid.UUID) (db.GetSessionOrganizationRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionOrganization", arg0, arg1)
+	ret0, _ := ret[0].(db.GetSessionOrganizationRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionOrganization indicates an expected call of GetSessionOrganization.
+func (mr *MockStoreMockRecorder) GetSessionOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionOrganization", reflect.TypeOf((*MockStore)(nil).GetSessionOrganization), arg0, arg1)
+}
+
+// GetSessionsByUserID mocks base method.
+func (m *MockStore) GetSessionsByUserID(arg0 context.Context, arg1 uuid.UUID) ([]db.Sessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionsByUserID", arg0, arg1)
+	ret0, _ := ret[0].([]db.Sessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionsByUserID indicates an expected call of GetSessionsByUserID.
+func (mr *MockStoreMockRecorder) GetSessionsByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsByUserID", reflect.TypeOf((*MockStore)(nil).GetSessionsByUserID), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+}
+
+// GetUserIdentityByProvider mocks base method.
+func (m *MockStore) GetUserIdentityByProvider(arg0 context.Context, arg1 db.GetUserIdentityByProviderParams) (db.UserIdentities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentityByProvider", arg0, arg1)
+	ret0, _ := ret[0].(db.UserIdentities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdentityByProvider indicates an expected call of GetUserIdentityByProvider.
+func (mr *MockStoreMockRecorder) GetUserIdentityByProvider(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentityByProvider", reflect.TypeOf((*MockStore)(nil).GetUserIdentityByProvider), arg0, arg1)
+}
+
+// GetUserMfa mocks base method.
+func (m *MockStore) GetUserMfa(arg0 context.Context, arg1 uuid.UUID) (db.UserMfa, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserMfa", arg0, arg1)
+	ret0, _ := ret[0].(db.UserMfa)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserMfa indicates an expected call of GetUserMfa.
+func (mr *MockStoreMockRecorder) GetUserMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserMfa", reflect.TypeOf((*MockStore)(nil).GetUserMfa), arg0, arg1)
+}
+
+// GetVerificationChallengeByResetToken mocks base method.
+func (m *MockStore) GetVerificationChallengeByResetToken(arg0 context.Context, arg1 sql.NullString) (db.VerificationChallenges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerificationChallengeByResetToken", arg0, arg1)
+	ret0, _ := ret[0].(db.VerificationChallenges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationChallengeByResetToken indicates an expected call of GetVerificationChallengeByResetToken.
+func (mr *MockStoreMockRecorder) GetVerificationChallengeByResetToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationChallengeByResetToken", reflect.TypeOf((*MockStore)(nil).GetVerificationChallengeByResetToken), arg0, arg1)
+}
+
+// IncrementMfaChallengeAttempts mocks base method.
+func (m *MockStore) IncrementMfaChallengeAttempts(arg0 context.Context, arg1 uuid.UUID) (db.MfaChallenges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementMfaChallengeAttempts", arg0, arg1)
+	ret0, _ := ret[0].(db.MfaChallenges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementMfaChallengeAttempts indicates an expected call of IncrementMfaChallengeAttempts.
+func (mr *MockStoreMockRecorder) IncrementMfaChallengeAttempts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMfaChallengeAttempts", reflect.TypeOf((*MockStore)(nil).IncrementMfaChallengeAttempts), arg0, arg1)
+}
+
+// IncrementVerificationChallengeAttempts mocks base method.
+func (m *MockStore) IncrementVerificationChallengeAttempts(arg0 context.Context, arg1 uuid.UUID) (db.VerificationChallenges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementVerificationChallengeAttempts", arg0, arg1)
+	ret0, _ := ret[0].(db.VerificationChallenges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementVerificationChallengeAttempts indicates an expected call of IncrementVerificationChallengeAttempts.
+func (mr *MockStoreMockRecorder) IncrementVerificationChallengeAttempts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementVerificationChallengeAttempts", reflect.TypeOf((*MockStore)(nil).IncrementVerificationChallengeAttempts), arg0, arg1)
+}
+
+// InvalidatePasswordReset mocks base method.
+func (m *MockStore) InvalidatePasswordReset(arg0 context.Context, arg1 string) (db.PasswordResets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidatePasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(db.PasswordResets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvalidatePasswordReset indicates an expected call of InvalidatePasswordReset.
+func (mr *MockStoreMockRecorder) InvalidatePasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidatePasswordReset", reflect.TypeOf((*MockStore)(nil).InvalidatePasswordReset), arg0, arg1)
+}
+
+// InvalidateVerificationChallenges mocks base method.
+func (m *MockStore) InvalidateVerificationChallenges(arg0 context.Context, arg1 db.InvalidateVerificationChallengesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateVerificationChallenges", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateVerificationChallenges indicates an expected call of InvalidateVerificationChallenges.
+func (mr *MockStoreMockRecorder) InvalidateVerificationChallenges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateVerificationChallenges", reflect.TypeOf((*MockStore)(nil).InvalidateVerificationChallenges), arg0, arg1)
+}
+
+// ListAccountErasureServices mocks base method.
+func (m *MockStore) ListAccountErasureServices(arg0 context.Context, arg1 uuid.UUID) ([]db.AccountErasureServices, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountErasureServices", arg0, arg1)
+	ret0, _ := ret[0].([]db.AccountErasureServices)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountErasureServices indicates an expected call of ListAccountErasureServices.
+func (mr *MockStoreMockRecorder) ListAccountErasureServices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountErasureServices", reflect.TypeOf((*MockStore)(nil).ListAccountErasureServices), arg0, arg1)
+}
+
+// ListAgentVerificationDocuments mocks base method.
+func (m *MockStore) ListAgentVerificationDocuments(arg0 context.Context, arg1 uuid.UUID) ([]db.AgentVerificationDocuments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAgentVerificationDocuments", arg0, arg1)
+	ret0, _ := ret[0].([]db.AgentVerificationDocuments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAgentVerificationDocuments indicates an expected call of ListAgentVerificationDocuments.
+func (mr *MockStoreMockRecorder) ListAgentVerificationDocuments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAgentVerificationDocuments", reflect.TypeOf((*MockStore)(nil).ListAgentVerificationDocuments), arg0, arg1)
+}
+
+// ListAgentVerifications mocks base method.
+func (m *MockStore) ListAgentVerifications(arg0 context.Context, arg1 db.ListAgentVerificationsParams) ([]db.ListAgentVerificationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAgentVerifications", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListAgentVerificationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAgentVerifications indicates an expected call of ListAgentVerifications.
+func (mr *MockStoreMockRecorder) ListAgentVerifications(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAgentVerifications", reflect.TypeOf((*MockStore)(nil).ListAgentVerifications), arg0, arg1)
+}
+
+// ListAuthEvents mocks base method.
+func (m *MockStore) ListAuthEvents(arg0 context.Context, arg1 db.ListAuthEventsParams) ([]db.AuthEvents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuthEvents", arg0, arg1)
+	ret0, _ := ret[0].([]db.AuthEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuthEvents indicates an expected call of ListAuthEvents.
+func (mr *MockStoreMockRecorder) ListAuthEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthEvents", reflect.TypeOf((*MockStore)(nil).ListAuthEvents), arg0, arg1)
+}
+
+// ListLoginEvents mocks base method.
+func (m *MockStore) ListLoginEvents(arg0 context.Context, arg1 db.ListLoginEventsParams) ([]db.AuthEvents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoginEvents", arg0, arg1)
+	ret0, _ := ret[0].([]db.AuthEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoginEvents indicates an expected call of ListLoginEvents.
+func (mr *MockStoreMockRecorder) ListLoginEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoginEvents", reflect.TypeOf((*MockStore)(nil).ListLoginEvents), arg0, arg1)
+}
+
+// ListOrganizationAPIKeys mocks base method.
+func (m *MockStore) ListOrganizationAPIKeys(arg0 context.Context, arg1 uuid.NullUUID) ([]db.ApiKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].([]db.ApiKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationAPIKeys indicates an expected call of ListOrganizationAPIKeys.
+func (mr *MockStoreMockRecorder) ListOrganizationAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationAPIKeys", reflect.TypeOf((*MockStore)(nil).ListOrganizationAPIKeys), arg0, arg1)
+}
+
+// ListOrganizationMembers mocks base method.
+func (m *MockStore) ListOrganizationMembers(arg0 context.Context, arg1 uuid.UUID) ([]db.ListOrganizationMembersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationMembers", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListOrganizationMembersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationMembers indicates an expected call of ListOrganizationMembers.
+func (mr *MockStoreMockRecorder) ListOrganizationMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationMembers", reflect.TypeOf((*MockStore)(nil).ListOrganizationMembers), arg0, arg1)
+}
+
+// ListPasswordHistory mocks base method.
+func (m *MockStore) ListPasswordHistory(arg0 context.Context, arg1 db.ListPasswordHistoryParams) ([]db.PasswordHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPasswordHistory", arg0, arg1)
+	ret0, _ := ret[0].([]db.PasswordHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPasswordHistory indicates an expected call of ListPasswordHistory.
+func (mr *MockStoreMockRecorder) ListPasswordHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPasswordHistory", reflect.TypeOf((*MockStore)(nil).ListPasswordHistory), arg0, arg1)
+}
+
+// ListPendingOrganizationInvitations mocks base method.
+func (m *MockStore) ListPendingOrganizationInvitations(arg0 context.Context, arg1 uuid.UUID) ([]db.ListPendingOrganizationInvitationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingOrganizationInvitations", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListPendingOrganizationInvitationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOrganizationInvitations indicates an expected call of ListPendingOrganizationInvitations.
+func (mr *MockStoreMockRecorder) ListPendingOrganizationInvitations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOrganizationInvitations", reflect.TypeOf((*MockStore)(nil).ListPendingOrganizationInvitations), arg0, arg1)
+}
+
+// ListTokenSigningKeys mocks base method.
+func (m *MockStore) ListTokenSigningKeys(arg0 context.Context) ([]db.TokenSigningKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTokenSigningKeys", arg0)
+	ret0, _ := ret[0].([]db.TokenSigningKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTokenSigningKeys indicates an expected call of ListTokenSigningKeys.
+func (mr *MockStoreMockRecorder) ListTokenSigningKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokenSigningKeys", reflect.TypeOf((*MockStore)(nil).ListTokenSigningKeys), arg0)
+}
+
+// ListUnpublishedErasures mocks base method.
+func (m *MockStore) ListUnpublishedErasures(arg0 context.Context, arg1 int32) ([]db.AccountErasures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnpublishedErasures", arg0, arg1)
+	ret0, _ := ret[0].([]db.AccountErasures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnpublishedErasures indicates an expected call of ListUnpublishedErasures.
+func (mr *MockStoreMockRecorder) ListUnpublishedErasures(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnpublishedErasures", reflect.TypeOf((*MockStore)(nil).ListUnpublishedErasures), arg0, arg1)
+}
+
+// ListUserAPIKeys mocks base method.
+func (m *MockStore) ListUserAPIKeys(arg0 context.Context, arg1 uuid.UUID) ([]db.ApiKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].([]db.ApiKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserAPIKeys indicates an expected call of ListUserAPIKeys.
+func (mr *MockStoreMockRecorder) ListUserAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserAPIKeys", reflect.TypeOf((*MockStore)(nil).ListUserAPIKeys), arg0, arg1)
+}
+
+// ListUserIdentities mocks base method.
+func (m *MockStore) ListUserIdentities(arg0 context.Context, arg1 uuid.UUID) ([]db.UserIdentities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserIdentities", arg0, arg1)
+	ret0, _ := ret[0].([]db.UserIdentities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserIdentities indicates an expected call of ListUserIdentities.
+func (mr *MockStoreMockRecorder) ListUserIdentities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserIdentities", reflect.TypeOf((*MockStore)(nil).ListUserIdentities), arg0, arg1)
+}
+
+// ListUserOrganizations mocks base method.
+func (m *MockStore) ListUserOrganizations(arg0 context.Context, arg1 uuid.UUID) ([]db.ListUserOrganizationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserOrganizations", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListUserOrganizationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserOrganizations indicates an expected call of ListUserOrganizations.
+func (mr *MockStoreMockRecorder) ListUserOrganizations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserOrganizations", reflect.TypeOf((*MockStore)(nil).ListUserOrganizations), arg0, arg1)
+}
+
+// ListUsers mocks base method.
+func (m *MockStore) ListUsers(arg0 context.Context, arg1 db.ListUsersParams) ([]db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockStoreMockRecorder) ListUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockStore)(nil).ListUsers), arg0, arg1)
+}
+
+// ListUsersDueForDeletion mocks base method.
+func (m *MockStore) ListUsersDueForDeletion(arg0 context.Context, arg1 db.ListUsersDueForDeletionParams) ([]db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersDueForDeletion", arg0, arg1)
+	ret0, _ := ret[0].([]db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersDueForDeletion indicates an expected call of ListUsersDueForDeletion.
+func (mr *MockStoreMockRecorder) ListUsersDueForDeletion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersDueForDeletion", reflect.TypeOf((*MockStore)(nil).ListUsersDueForDeletion), arg0, arg1)
+}
+
+// LockAuthThrottle mocks base method.
+func (m *MockStore) LockAuthThrottle(arg0 context.Context, arg1 db.LockAuthThrottleParams) (db.AuthThrottles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAuthThrottle", arg0, arg1)
+	ret0, _ := ret[0].(db.AuthThrottles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAuthThrottle indicates an expected call of LockAuthThrottle.
+func (mr *MockStoreMockRecorder) LockAuthThrottle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAuthThrottle", reflect.TypeOf((*MockStore)(nil).LockAuthThrottle), arg0, arg1)
+}
+
+// LockUser mocks base method.
+func (m *MockStore) LockUser(arg0 context.Context, arg1 db.LockUserParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockUser indicates an expected call of LockUser.
+func (mr *MockStoreMockRecorder) LockUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUser", reflect.TypeOf((*MockStore)(nil).LockUser), arg0, arg1)
+}
+
+// MarkEmailChangeReverted mocks base method.
+func (m *MockStore) MarkEmailChangeReverted(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailChangeReverted", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkEmailChangeReverted indicates an expected call of MarkEmailChangeReverted.
+func (mr *MockStoreMockRecorder) MarkEmailChangeReverted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailChangeReverted", reflect.TypeOf((*MockStore)(nil).MarkEmailChangeReverted), arg0, arg1)
+}
+
+// MarkErasurePublished mocks base method.
+func (m *MockStore) MarkErasurePublished(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkErasurePublished", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkErasurePublished indicates an expected call of MarkErasurePublished.
+func (mr *MockStoreMockRecorder) MarkErasurePublished(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkErasurePublished", reflect.TypeOf((*MockStore)(nil).MarkErasurePublished), arg0, arg1)
+}
+
+// MarkMagicLinkUsed mocks base method.
+func (m *MockStore) MarkMagicLinkUsed(arg0 context.Context, arg1 db.MarkMagicLinkUsedParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMagicLinkUsed", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkMagicLinkUsed indicates an expected call of MarkMagicLinkUsed.
+func (mr *MockStoreMockRecorder) MarkMagicLinkUsed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMagicLinkUsed", reflect.TypeOf((*MockStore)(nil).MarkMagicLinkUsed), arg0, arg1)
+}
+
+// MarkRefreshTokenUsed mocks base method.
+func (m *MockStore) MarkRefreshTokenUsed(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRefreshTokenUsed", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRefreshTokenUsed indicates an expected call of MarkRefreshTokenUsed.
+func (mr *MockStoreMockRecorder) MarkRefreshTokenUsed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRefreshTokenUsed", reflect.TypeOf((*MockStore)(nil).MarkRefreshTokenUsed), arg0, arg1)
+}
+
+// MarkVerificationChallengeVerified mocks base method.
+func (m *MockStore) MarkVerificationChallengeVerified(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkVerificationChallengeVerified", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkVerificationChallengeVerified indicates an expected call of MarkVerificationChallengeVerified.
+func (mr *MockStoreMockRecorder) MarkVerificationChallengeVerified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkVerificationChallengeVerified", reflect.TypeOf((*MockStore)(nil).MarkVerificationChallengeVerified), arg0, arg1)
+}
+
+// PruneAuthEvents mocks base method.
+func (m *MockStore) PruneAuthEvents(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneAuthEvents", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneAuthEvents indicates an expected call of PruneAuthEvents.
+func (mr *MockStoreMockRecorder) PruneAuthEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneAuthEvents", reflect.TypeOf((*MockStore)(nil).PruneAuthEvents), arg0, arg1)
+}
+
+// RecordAuthFailure mocks base method.
+func (m *MockStore) RecordAuthFailure(arg0 context.Context, arg1 db.RecordAuthFailureParams) (db.AuthThrottles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAuthFailure", arg0, arg1)
+	ret0, _ := ret[0].(db.AuthThrottles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordAuthFailure indicates an expected call of RecordAuthFailure.
+func (mr *MockStoreMockRecorder) RecordAuthFailure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuthFailure", reflect.TypeOf((*MockStore)(nil).RecordAuthFailure), arg0, arg1)
+}
+
+// RemoveOrganizationMemberTx mocks base method.
+func (m *MockStore) RemoveOrganizationMemberTx(arg0 context.Context, arg1 db.DeleteOrganizationMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrganizationMemberTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrganizationMemberTx indicates an expected call of RemoveOrganizationMemberTx.
+func (mr *MockStoreMockRecorder) RemoveOrganizationMemberTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizationMemberTx", reflect.TypeOf((*MockStore)(nil).RemoveOrganizationMemberTx), arg0, arg1)
+}
+
+// ReplaceRecoveryCodesTx mocks base method.
+func (m *MockStore) ReplaceRecoveryCodesTx(arg0 context.Context, arg1 db.ReplaceRecoveryCodesTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecoveryCodesTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecoveryCodesTx indicates an expected call of ReplaceRecoveryCodesTx.
+func (mr *MockStoreMockRecorder) ReplaceRecoveryCodesTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecoveryCodesTx", reflect.TypeOf((*MockStore)(nil).ReplaceRecoveryCodesTx), arg0, arg1)
+}
+
+// RespondToOrganizationInvitation mocks base method.
+func (m *MockStore) RespondToOrganizationInvitation(arg0 context.Context, arg1 db.RespondToOrganizationInvitationParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondToOrganizationInvitation", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ChangePassword indicates an expected call of ChangePassword.
-func (mr *MockStoreMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomock.Call {
+// RespondToOrganizationInvitation indicates an expected call of RespondToOrganizationInvitation.
+func (mr *MockStoreMockRecorder) RespondToOrganizationInvitation(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockStore)(nil).ChangePassword), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondToOrganizationInvitation", reflect.TypeOf((*MockStore)(nil).RespondToOrganizationInvitation), arg0, arg1)
 }
 
-// CheckEmailExists mocks base method.
-func (m *MockStore) CheckEmailExists(arg0 context.Context, arg1 string) (bool, error) {
+// RestoreUser mocks base method.
+func (m *MockStore) RestoreUser(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckEmailExists", arg0, arg1)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "RestoreUser", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CheckEmailExists indicates an expected call of CheckEmailExists.
-func (mr *MockStoreMockRecorder) CheckEmailExists(arg0, arg1 interface{}) *gomock.Call {
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockStoreMockRecorder) RestoreUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEmailExists", reflect.TypeOf((*MockStore)(nil).CheckEmailExists), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockStore)(nil).RestoreUser), arg0, arg1)
 }
 
-// CreateLoginHistoryEntry mocks base method.
-func (m *MockStore) CreateLoginHistoryEntry(arg0 context.Context, arg1 db.CreateLoginHistoryEntryParams) (db.Sessions, error) {
+// RetireTokenSigningKeys mocks base method.
+func (m *MockStore) RetireTokenSigningKeys(arg0 context.Context, arg1 sql.NullTime) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLoginHistoryEntry", arg0, arg1)
-	ret0, _ := ret[0].(db.Sessions)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RetireTokenSigningKeys", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateLoginHistoryEntry indicates an expected call of CreateLoginHistoryEntry.
-func (mr *MockStoreMockRecorder) CreateLoginHistoryEntry(arg0, arg1 interface{}) *gomock.Call {
+// RetireTokenSigningKeys indicates an expected call of RetireTokenSigningKeys.
+func (mr *MockStoreMockRecorder) RetireTokenSigningKeys(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoginHistoryEntry", reflect.TypeOf((*MockStore)(nil).CreateLoginHistoryEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetireTokenSigningKeys", reflect.TypeOf((*MockStore)(nil).RetireTokenSigningKeys), arg0, arg1)
 }
 
-// CreatePasswordReset mocks base method.
-func (m *MockStore) CreatePasswordReset(arg0 context.Context, arg1 db.CreatePasswordResetParams) (db.PasswordResets, error) {
+// RevertEmailChangeTx mocks base method.
+func (m *MockStore) RevertEmailChangeTx(arg0 context.Context, arg1 db.RevertEmailChangeTxParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePasswordReset", arg0, arg1)
-	ret0, _ := ret[0].(db.PasswordResets)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RevertEmailChangeTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreatePasswordReset indicates an expected call of CreatePasswordReset.
-func (mr *MockStoreMockRecorder) CreatePasswordReset(arg0, arg1 interface{}) *gomock.Call {
+// RevertEmailChangeTx indicates an expected call of RevertEmailChangeTx.
+func (mr *MockStoreMockRecorder) RevertEmailChangeTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockStore)(nil).CreatePasswordReset), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertEmailChangeTx", reflect.TypeOf((*MockStore)(nil).RevertEmailChangeTx), arg0, arg1)
 }
 
-// CreateSession mocks base method.
-func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Sessions, error) {
+// ReviewAgentVerification mocks base method.
+func (m *MockStore) ReviewAgentVerification(arg0 context.Context, arg1 db.ReviewAgentVerificationParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Sessions)
+	ret := m.ctrl.Call(m, "ReviewAgentVerification", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+// ReviewAgentVerification indicates an expected call of ReviewAgentVerification.
+func (mr *MockStoreMockRecorder) ReviewAgentVerification(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewAgentVerification", reflect.TypeOf((*MockStore)(nil).ReviewAgentVerification), arg0, arg1)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.Users, error) {
+// ReviewAgentVerificationTx mocks base method.
+func (m *MockStore) ReviewAgentVerificationTx(arg0 context.Context, arg1 db.ReviewAgentVerificationTxParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
-	ret0, _ := ret[0].(db.Users)
+	ret := m.ctrl.Call(m, "ReviewAgentVerificationTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReviewAgentVerificationTx indicates an expected call of ReviewAgentVerificationTx.
+func (mr *MockStoreMockRecorder) ReviewAgentVerificationTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewAgentVerificationTx", reflect.TypeOf((*MockStore)(nil).ReviewAgentVerificationTx), arg0, arg1)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockStore) RevokeAPIKey(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockStoreMockRecorder) RevokeAPIKey(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockStore)(nil).RevokeAPIKey), arg0, arg1)
 }
 
-// DeleteExpiredPasswordResets mocks base method.
-func (m *MockStore) DeleteExpiredPasswordResets(arg0 context.Context) error {
+// RevokeAPIKeyTx mocks base method.
+func (m *MockStore) RevokeAPIKeyTx(arg0 context.Context, arg1 db.RevokeAPIKeyTxParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteExpiredPasswordResets", arg0)
+	ret := m.ctrl.Call(m, "RevokeAPIKeyTx", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteExpiredPasswordResets indicates an expected call of DeleteExpiredPasswordResets.
-func (mr *MockStoreMockRecorder) DeleteExpiredPasswordResets(arg0 interface{}) *gomock.Call {
+// RevokeAPIKeyTx indicates an expected call of RevokeAPIKeyTx.
+func (mr *MockStoreMockRecorder) RevokeAPIKeyTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredPasswordResets", reflect.TypeOf((*MockStore)(nil).DeleteExpiredPasswordResets), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKeyTx", reflect.TypeOf((*MockStore)(nil).RevokeAPIKeyTx), arg0, arg1)
 }
 
-// DeletePasswordResetsByUserId mocks base method.
-func (m *MockStore) DeletePasswordResetsByUserId(arg0 context.Context, arg1 uuid.UUID) error {
+// RevokePendingOrganizationInvitations mocks base method.
+func (m *MockStore) RevokePendingOrganizationInvitations(arg0 context.Context, arg1 db.RevokePendingOrganizationInvitationsParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePasswordResetsByUserId", arg0, arg1)
+	ret := m.ctrl.Call(m, "RevokePendingOrganizationInvitations", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeletePasswordResetsByUserId indicates an expected call of DeletePasswordResetsByUserId.
-func (mr *MockStoreMockRecorder) DeletePasswordResetsByUserId(arg0, arg1 interface{}) *gomock.Call {
+// RevokePendingOrganizationInvitations indicates an expected call of RevokePendingOrganizationInvitations.
+func (mr *MockStoreMockRecorder) RevokePendingOrganizationInvitations(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePasswordResetsByUserId", reflect.TypeOf((*MockStore)(nil).DeletePasswordResetsByUserId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePendingOrganizationInvitations", reflect.TypeOf((*MockStore)(nil).RevokePendingOrganizationInvitations), arg0, arg1)
 }
 
-// DeleteSession mocks base method.
-func (m *MockStore) DeleteSession(arg0 context.Context, arg1 uuid.UUID) error {
+// RevokeRefreshTokenFamily mocks base method.
+func (m *MockStore) RevokeRefreshTokenFamily(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
+	ret := m.ctrl.Call(m, "RevokeRefreshTokenFamily", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteSession indicates an expected call of DeleteSession.
-func (mr *MockStoreMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+// RevokeRefreshTokenFamily indicates an expected call of RevokeRefreshTokenFamily.
+func (mr *MockStoreMockRecorder) RevokeRefreshTokenFamily(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockStore)(nil).DeleteSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshTokenFamily", reflect.TypeOf((*MockStore)(nil).RevokeRefreshTokenFamily), arg0, arg1)
 }
 
-// DeleteUser mocks base method.
-func (m *MockStore) DeleteUser(arg0 context.Context, arg1 uuid.UUID) error {
+// RevokeRefreshTokensBySessionID mocks base method.
+func (m *MockStore) RevokeRefreshTokensBySessionID(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
+	ret := m.ctrl.Call(m, "RevokeRefreshTokensBySessionID", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockStoreMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+// RevokeRefreshTokensBySessionID indicates an expected call of RevokeRefreshTokensBySessionID.
+func (mr *MockStoreMockRecorder) RevokeRefreshTokensBySessionID(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshTokensBySessionID", reflect.TypeOf((*MockStore)(nil).RevokeRefreshTokensBySessionID), arg0, arg1)
 }
 
-// GetLoginHistory mocks base method.
-func (m *MockStore) GetLoginHistory(arg0 context.Context, arg1 db.GetLoginHistoryParams) ([]db.Sessions, error) {
+// RevokeRefreshTokensByUserID mocks base method.
+func (m *MockStore) RevokeRefreshTokensByUserID(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLoginHistory", arg0, arg1)
-	ret0, _ := ret[0].([]db.Sessions)
+	ret := m.ctrl.Call(m, "RevokeRefreshTokensByUserID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshTokensByUserID indicates an expected call of RevokeRefreshTokensByUserID.
+func (mr *MockStoreMockRecorder) RevokeRefreshTokensByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshTokensByUserID", reflect.TypeOf((*MockStore)(nil).RevokeRefreshTokensByUserID), arg0, arg1)
+}
+
+// RevokeSession mocks base method.
+func (m *MockStore) RevokeSession(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockStoreMockRecorder) RevokeSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockStore)(nil).RevokeSession), arg0, arg1)
+}
+
+// RevokeSessionTokens mocks base method.
+func (m *MockStore) RevokeSessionTokens(arg0 context.Context, arg1 db.RevokeSessionTokensParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessionTokens", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSessionTokens indicates an expected call of RevokeSessionTokens.
+func (mr *MockStoreMockRecorder) RevokeSessionTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessionTokens", reflect.TypeOf((*MockStore)(nil).RevokeSessionTokens), arg0, arg1)
+}
+
+// RevokeUserSessionTokens mocks base method.
+func (m *MockStore) RevokeUserSessionTokens(arg0 context.Context, arg1 db.RevokeUserSessionTokensParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSessionTokens", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserSessionTokens indicates an expected call of RevokeUserSessionTokens.
+func (mr *MockStoreMockRecorder) RevokeUserSessionTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessionTokens", reflect.TypeOf((*MockStore)(nil).RevokeUserSessionTokens), arg0, arg1)
+}
+
+// RotateRefreshTokenTx mocks base method.
+func (m *MockStore) RotateRefreshTokenTx(arg0 context.Context, arg1 db.RotateRefreshTokenTxParams) (db.RefreshTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshTokenTx", arg0, arg1)
+	ret0, _ := ret[0].(db.RefreshTokens)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLoginHistory indicates an expected call of GetLoginHistory.
-func (mr *MockStoreMockRecorder) GetLoginHistory(arg0, arg1 interface{}) *gomock.Call {
+// RotateRefreshTokenTx indicates an expected call of RotateRefreshTokenTx.
+func (mr *MockStoreMockRecorder) RotateRefreshTokenTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginHistory", reflect.TypeOf((*MockStore)(nil).GetLoginHistory), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshTokenTx", reflect.TypeOf((*MockStore)(nil).RotateRefreshTokenTx), arg0, arg1)
 }
 
-// GetPasswordResetByToken mocks base method.
-func (m *MockStore) GetPasswordResetByToken(arg0 context.Context, arg1 string) (db.PasswordResets, error) {
+// RotateTokenSigningKeyTx mocks base method.
+func (m *MockStore) RotateTokenSigningKeyTx(arg0 context.Context, arg1 db.RotateTokenSigningKeyTxParams) (db.TokenSigningKeys, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPasswordResetByToken", arg0, arg1)
-	ret0, _ := ret[0].(db.PasswordResets)
+	ret := m.ctrl.Call(m, "RotateTokenSigningKeyTx", arg0, arg1)
+	ret0, _ := ret[0].(db.TokenSigningKeys)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPasswordResetByToken indicates an expected call of GetPasswordResetByToken.
-func (mr *MockStoreMockRecorder) GetPasswordResetByToken(arg0, arg1 interface{}) *gomock.Call {
+// RotateTokenSigningKeyTx indicates an expected call of RotateTokenSigningKeyTx.
+func (mr *MockStoreMockRecorder) RotateTokenSigningKeyTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordResetByToken", reflect.TypeOf((*MockStore)(nil).GetPasswordResetByToken), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateTokenSigningKeyTx", reflect.TypeOf((*MockStore)(nil).RotateTokenSigningKeyTx), arg0, arg1)
 }
 
-// GetSessionByID mocks base method.
-func (m *MockStore) GetSessionByID(arg0 context.Context, arg1 uuid.UUID) (db.Sessions, error) {
+// ScheduleUserDeletion mocks base method.
+func (m *MockStore) ScheduleUserDeletion(arg0 context.Context, arg1 db.ScheduleUserDeletionParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSessionByID", arg0, arg1)
-	ret0, _ := ret[0].(db.Sessions)
+	ret := m.ctrl.Call(m, "ScheduleUserDeletion", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSessionByID indicates an expected call of GetSessionByID.
-func (mr *MockStoreMockRecorder) GetSessionByID(arg0, arg1 interface{}) *gomock.Call {
+// ScheduleUserDeletion indicates an expected call of ScheduleUserDeletion.
+func (mr *MockStoreMockRecorder) ScheduleUserDeletion(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByID", reflect.TypeOf((*MockStore)(nil).GetSessionByID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleUserDeletion", reflect.TypeOf((*MockStore)(nil).ScheduleUserDeletion), arg0, arg1)
 }
 
-// GetSessionByUserID mocks base method.
-func (m *MockStore) GetSessionByUserID(arg0 context.Context, arg1 uuid.UUID) (db.Sessions, error) {
+// SetSessionOrganization mocks base method.
+func (m *MockStore) SetSessionOrganization(arg0 context.Context, arg1 db.SetSessionOrganizationParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSessionByUserID", arg0, arg1)
-	ret0, _ := ret[0].(db.Sessions)
+	ret := m.ctrl.Call(m, "SetSessionOrganization", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSessionByUserID indicates an expected call of GetSessionByUserID.
-func (mr *MockStoreMockRecorder) GetSessionByUserID(arg0, arg1 interface{}) *gomock.Call {
+// SetSessionOrganization indicates an expected call of SetSessionOrganization.
+func (mr *MockStoreMockRecorder) SetSessionOrganization(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByUserID", reflect.TypeOf((*MockStore)(nil).GetSessionByUserID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSessionOrganization", reflect.TypeOf((*MockStore)(nil).SetSessionOrganization), arg0, arg1)
 }
 
-// GetSessionsByUserID mocks base method.
-func (m *MockStore) GetSessionsByUserID(arg0 context.Context, arg1 uuid.UUID) ([]db.Sessions, error) {
+// SetUserAgentVerified mocks base method.
+func (m *MockStore) SetUserAgentVerified(arg0 context.Context, arg1 db.SetUserAgentVerifiedParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSessionsByUserID", arg0, arg1)
-	ret0, _ := ret[0].([]db.Sessions)
+	ret := m.ctrl.Call(m, "SetUserAgentVerified", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserAgentVerified indicates an expected call of SetUserAgentVerified.
+func (mr *MockStoreMockRecorder) SetUserAgentVerified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserAgentVerified", reflect.TypeOf((*MockStore)(nil).SetUserAgentVerified), arg0, arg1)
+}
+
+// SetVerificationChallengeResetToken mocks base method.
+func (m *MockStore) SetVerificationChallengeResetToken(arg0 context.Context, arg1 db.SetVerificationChallengeResetTokenParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVerificationChallengeResetToken", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSessionsByUserID indicates an expected call of GetSessionsByUserID.
-func (mr *MockStoreMockRecorder) GetSessionsByUserID(arg0, arg1 interface{}) *gomock.Call {
+// SetVerificationChallengeResetToken indicates an expected call of SetVerificationChallengeResetToken.
+func (mr *MockStoreMockRecorder) SetVerificationChallengeResetToken(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsByUserID", reflect.TypeOf((*MockStore)(nil).GetSessionsByUserID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVerificationChallengeResetToken", reflect.TypeOf((*MockStore)(nil).SetVerificationChallengeResetToken), arg0, arg1)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.Users, error) {
+// SoftDeleteUser mocks base method.
+func (m *MockStore) SoftDeleteUser(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(db.Users)
+	ret := m.ctrl.Call(m, "SoftDeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+// SoftDeleteUser indicates an expected call of SoftDeleteUser.
+func (mr *MockStoreMockRecorder) SoftDeleteUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteUser", reflect.TypeOf((*MockStore)(nil).SoftDeleteUser), arg0, arg1)
 }
 
-// InvalidatePasswordReset mocks base method.
-func (m *MockStore) InvalidatePasswordReset(arg0 context.Context, arg1 string) (db.PasswordResets, error) {
+// SubmitAgentVerification mocks base method.
+func (m *MockStore) SubmitAgentVerification(arg0 context.Context, arg1 db.SubmitAgentVerificationParams) (db.AgentVerifications, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InvalidatePasswordReset", arg0, arg1)
-	ret0, _ := ret[0].(db.PasswordResets)
+	ret := m.ctrl.Call(m, "SubmitAgentVerification", arg0, arg1)
+	ret0, _ := ret[0].(db.AgentVerifications)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InvalidatePasswordReset indicates an expected call of InvalidatePasswordReset.
-func (mr *MockStoreMockRecorder) InvalidatePasswordReset(arg0, arg1 interface{}) *gomock.Call {
+// SubmitAgentVerification indicates an expected call of SubmitAgentVerification.
+func (mr *MockStoreMockRecorder) SubmitAgentVerification(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidatePasswordReset", reflect.TypeOf((*MockStore)(nil).InvalidatePasswordReset), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAgentVerification", reflect.TypeOf((*MockStore)(nil).SubmitAgentVerification), arg0, arg1)
 }
 
-// RevokeSession mocks base method.
-func (m *MockStore) RevokeSession(arg0 context.Context, arg1 uuid.UUID) error {
+// TouchAPIKey mocks base method.
+func (m *MockStore) TouchAPIKey(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevokeSession", arg0, arg1)
+	ret := m.ctrl.Call(m, "TouchAPIKey", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RevokeSession indicates an expected call of RevokeSession.
-func (mr *MockStoreMockRecorder) RevokeSession(arg0, arg1 interface{}) *gomock.Call {
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockStoreMockRecorder) TouchAPIKey(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockStore)(nil).RevokeSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockStore)(nil).TouchAPIKey), arg0, arg1)
+}
+
+// TouchUserIdentity mocks base method.
+func (m *MockStore) TouchUserIdentity(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchUserIdentity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchUserIdentity indicates an expected call of TouchUserIdentity.
+func (mr *MockStoreMockRecorder) TouchUserIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchUserIdentity", reflect.TypeOf((*MockStore)(nil).TouchUserIdentity), arg0, arg1)
+}
+
+// TrimPasswordHistory mocks base method.
+func (m *MockStore) TrimPasswordHistory(arg0 context.Context, arg1 db.TrimPasswordHistoryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrimPasswordHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrimPasswordHistory indicates an expected call of TrimPasswordHistory.
+func (mr *MockStoreMockRecorder) TrimPasswordHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrimPasswordHistory", reflect.TypeOf((*MockStore)(nil).TrimPasswordHistory), arg0, arg1)
+}
+
+// UnlockUser mocks base method.
+func (m *MockStore) UnlockUser(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockStoreMockRecorder) UnlockUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockStore)(nil).UnlockUser), arg0, arg1)
+}
+
+// UpdateAccountErasureService mocks base method.
+func (m *MockStore) UpdateAccountErasureService(arg0 context.Context, arg1 db.UpdateAccountErasureServiceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountErasureService", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountErasureService indicates an expected call of UpdateAccountErasureService.
+func (mr *MockStoreMockRecorder) UpdateAccountErasureService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountErasureService", reflect.TypeOf((*MockStore)(nil).UpdateAccountErasureService), arg0, arg1)
 }
 
 // UpdateEmailVerification mocks base method.
@@ -329,6 +2344,36 @@ func (mr *MockStoreMockRecorder) UpdateLastLogin(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastLogin", reflect.TypeOf((*MockStore)(nil).UpdateLastLogin), arg0, arg1)
 }
 
+// UpdateMfaLastUsedStep mocks base method.
+func (m *MockStore) UpdateMfaLastUsedStep(arg0 context.Context, arg1 db.UpdateMfaLastUsedStepParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMfaLastUsedStep", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMfaLastUsedStep indicates an expected call of UpdateMfaLastUsedStep.
+func (mr *MockStoreMockRecorder) UpdateMfaLastUsedStep(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMfaLastUsedStep", reflect.TypeOf((*MockStore)(nil).UpdateMfaLastUsedStep), arg0, arg1)
+}
+
+// UpdateOrganizationMemberRole mocks base method.
+func (m *MockStore) UpdateOrganizationMemberRole(arg0 context.Context, arg1 db.UpdateOrganizationMemberRoleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganizationMemberRole", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrganizationMemberRole indicates an expected call of UpdateOrganizationMemberRole.
+func (mr *MockStoreMockRecorder) UpdateOrganizationMemberRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationMemberRole", reflect.TypeOf((*MockStore)(nil).UpdateOrganizationMemberRole), arg0, arg1)
+}
+
 // UpdateSession mocks base method.
 func (m *MockStore) UpdateSession(arg0 context.Context, arg1 db.UpdateSessionParams) (db.Sessions, error) {
 	m.ctrl.T.Helper()
@@ -373,6 +2418,20 @@ func (mr *MockStoreMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), arg0, arg1)
 }
 
+// UpdateUserEmail mocks base method.
+func (m *MockStore) UpdateUserEmail(arg0 context.Context, arg1 db.UpdateUserEmailParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserEmail indicates an expected call of UpdateUserEmail.
+func (mr *MockStoreMockRecorder) UpdateUserEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmail", reflect.TypeOf((*MockStore)(nil).UpdateUserEmail), arg0, arg1)
+}
+
 // UpdateUserProfilePicture mocks base method.
 func (m *MockStore) UpdateUserProfilePicture(arg0 context.Context, arg1 db.UpdateUserProfilePictureParams) (db.Users, error) {
 	m.ctrl.T.Helper()
@@ -387,3 +2446,47 @@ func (mr *MockStoreMockRecorder) UpdateUserProfilePicture(arg0, arg1 interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProfilePicture", reflect.TypeOf((*MockStore)(nil).UpdateUserProfilePicture), arg0, arg1)
 }
+
+// UpdateUserRole mocks base method.
+func (m *MockStore) UpdateUserRole(arg0 context.Context, arg1 db.UpdateUserRoleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockStoreMockRecorder) UpdateUserRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockStore)(nil).UpdateUserRole), arg0, arg1)
+}
+
+// UpsertUserMfa mocks base method.
+func (m *MockStore) UpsertUserMfa(arg0 context.Context, arg1 db.UpsertUserMfaParams) (db.UserMfa, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUserMfa", arg0, arg1)
+	ret0, _ := ret[0].(db.UserMfa)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertUserMfa indicates an expected call of UpsertUserMfa.
+func (mr *MockStoreMockRecorder) UpsertUserMfa(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUserMfa", reflect.TypeOf((*MockStore)(nil).UpsertUserMfa), arg0, arg1)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockStore) UseRecoveryCode(arg0 context.Context, arg1 db.UseRecoveryCodeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockStoreMockRecorder) UseRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockStore)(nil).UseRecoveryCode), arg0, arg1)
+}
@@ -0,0 +1,79 @@
+-- name: ScheduleUserDeletion :execrows
+UPDATE users
+SET deletion_requested_at = now(),
+    deletion_scheduled_for = $2,
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NULL AND deletion_scheduled_for IS NULL;
+
+-- name: CancelUserDeletion :execrows
+UPDATE users
+SET deletion_requested_at = NULL,
+    deletion_scheduled_for = NULL,
+    updated_at = now()
+WHERE id = $1 AND deletion_scheduled_for IS NOT NULL;
+
+-- name: ListUsersDueForDeletion :many
+SELECT * FROM users
+WHERE deletion_scheduled_for <= $1
+ORDER BY deletion_scheduled_for
+LIMIT $2;
+
+-- name: DeleteUserDueForDeletion :execrows
+DELETE FROM users
+WHERE id = $1 AND deletion_scheduled_for <= now();
+
+-- name: CreateAccountErasure :exec
+INSERT INTO account_erasures (
+    user_id,
+    requested_at,
+    deleted_at
+) VALUES (
+    $1, $2, now()
+)
+ON CONFLICT (user_id) DO NOTHING;
+
+-- name: CreateAccountErasureService :exec
+INSERT INTO account_erasure_services (
+    user_id,
+    service
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (user_id, service) DO NOTHING;
+
+-- name: ListUnpublishedErasures :many
+SELECT * FROM account_erasures
+WHERE published_at IS NULL
+ORDER BY deleted_at
+LIMIT $1;
+
+-- name: MarkErasurePublished :exec
+UPDATE account_erasures
+SET published_at = now()
+WHERE user_id = $1;
+
+-- name: GetAccountErasure :one
+SELECT * FROM account_erasures
+WHERE user_id = $1;
+
+-- name: ListAccountErasureServices :many
+SELECT * FROM account_erasure_services
+WHERE user_id = $1
+ORDER BY service;
+
+-- name: UpdateAccountErasureService :execrows
+INSERT INTO account_erasure_services (
+    user_id,
+    service,
+    status,
+    items,
+    detail,
+    updated_at
+)
+SELECT sqlc.arg(user_id), sqlc.arg(service), sqlc.arg(status), sqlc.arg(items), sqlc.narg(detail), now()
+WHERE EXISTS (SELECT 1 FROM account_erasures WHERE account_erasures.user_id = sqlc.arg(user_id))
+ON CONFLICT (user_id, service) DO UPDATE
+SET status = EXCLUDED.status,
+    items = EXCLUDED.items,
+    detail = EXCLUDED.detail,
+    updated_at = now();
@@ -0,0 +1,61 @@
+-- name: ListUsers :many
+SELECT * FROM users
+WHERE (sqlc.narg(email)::text IS NULL OR email ILIKE '%' || sqlc.narg(email)::text || '%')
+  AND (sqlc.narg(name)::text IS NULL OR name ILIKE '%' || sqlc.narg(name)::text || '%')
+  AND (sqlc.narg(role)::text IS NULL OR role = sqlc.narg(role)::text)
+  AND (sqlc.narg(provider)::text IS NULL
+       OR provider = sqlc.narg(provider)::text
+       OR EXISTS (
+           SELECT 1 FROM user_identities
+           WHERE user_identities.user_id = users.id AND user_identities.provider = sqlc.narg(provider)::text
+       ))
+  AND (sqlc.narg(is_active)::boolean IS NULL OR is_active = sqlc.narg(is_active)::boolean)
+  AND (sqlc.narg(deleted)::boolean IS NULL OR (deleted_at IS NOT NULL) = sqlc.narg(deleted)::boolean)
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: CountUsers :one
+SELECT count(*) FROM users
+WHERE (sqlc.narg(email)::text IS NULL OR email ILIKE '%' || sqlc.narg(email)::text || '%')
+  AND (sqlc.narg(name)::text IS NULL OR name ILIKE '%' || sqlc.narg(name)::text || '%')
+  AND (sqlc.narg(role)::text IS NULL OR role = sqlc.narg(role)::text)
+  AND (sqlc.narg(provider)::text IS NULL
+       OR provider = sqlc.narg(provider)::text
+       OR EXISTS (
+           SELECT 1 FROM user_identities
+           WHERE user_identities.user_id = users.id AND user_identities.provider = sqlc.narg(provider)::text
+       ))
+  AND (sqlc.narg(is_active)::boolean IS NULL OR is_active = sqlc.narg(is_active)::boolean)
+  AND (sqlc.narg(deleted)::boolean IS NULL OR (deleted_at IS NOT NULL) = sqlc.narg(deleted)::boolean);
+
+-- name: LockUser :exec
+UPDATE users
+SET locked_at = now(),
+    locked_reason = $2,
+    updated_at = now()
+WHERE id = $1;
+
+-- name: UnlockUser :exec
+UPDATE users
+SET locked_at = NULL,
+    locked_reason = NULL,
+    updated_at = now()
+WHERE id = $1;
+
+-- name: UpdateUserRole :exec
+UPDATE users
+SET role = $2,
+    updated_at = now()
+WHERE id = $1;
+
+-- name: SoftDeleteUser :execrows
+UPDATE users
+SET deleted_at = now(),
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: RestoreUser :execrows
+UPDATE users
+SET deleted_at = NULL,
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NOT NULL;
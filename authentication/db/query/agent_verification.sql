@@ -0,0 +1,73 @@
+-- name: SubmitAgentVerification :one
+INSERT INTO agent_verifications (
+    user_id,
+    license_number,
+    business_name,
+    business_address,
+    business_phone,
+    registration_number
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (user_id) DO UPDATE
+SET license_number = EXCLUDED.license_number,
+    business_name = EXCLUDED.business_name,
+    business_address = EXCLUDED.business_address,
+    business_phone = EXCLUDED.business_phone,
+    registration_number = EXCLUDED.registration_number,
+    status = 'pending',
+    review_note = NULL,
+    reviewed_by = NULL,
+    reviewed_at = NULL,
+    submitted_at = now(),
+    updated_at = now()
+WHERE agent_verifications.status IN ('rejected', 'more_info_requested')
+RETURNING *;
+
+-- name: GetAgentVerification :one
+SELECT v.*, u.email, u.name
+FROM agent_verifications v
+JOIN users u ON u.id = v.user_id
+WHERE v.user_id = $1;
+
+-- name: ListAgentVerifications :many
+SELECT v.*, u.email, u.name
+FROM agent_verifications v
+JOIN users u ON u.id = v.user_id
+WHERE v.status = $1
+ORDER BY v.submitted_at, v.user_id
+LIMIT $2 OFFSET $3;
+
+-- name: CountAgentVerifications :one
+SELECT count(*) FROM agent_verifications
+WHERE status = $1;
+
+-- name: ReviewAgentVerification :execrows
+UPDATE agent_verifications
+SET status = $2,
+    review_note = $3,
+    reviewed_by = $4,
+    reviewed_at = now(),
+    updated_at = now()
+WHERE user_id = $1 AND status = 'pending';
+
+-- name: SetUserAgentVerified :exec
+UPDATE users
+SET agent_verified_at = CASE WHEN sqlc.arg(verified)::boolean THEN now() END,
+    updated_at = now()
+WHERE id = sqlc.arg(id);
+
+-- name: CreateAgentVerificationDocument :one
+INSERT INTO agent_verification_documents (
+    id,
+    user_id,
+    document_type,
+    url
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListAgentVerificationDocuments :many
+SELECT * FROM agent_verification_documents
+WHERE user_id = $1
+ORDER BY uploaded_at, id;
@@ -0,0 +1,54 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (
+    id,
+    user_id,
+    organization_id,
+    name,
+    prefix,
+    key_hash,
+    scopes,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetAPIKey :one
+SELECT * FROM api_keys
+WHERE id = $1;
+
+-- name: GetAPIKeyByHash :one
+SELECT * FROM api_keys
+WHERE key_hash = $1;
+
+-- name: ListUserAPIKeys :many
+SELECT * FROM api_keys
+WHERE user_id = $1 AND organization_id IS NULL
+ORDER BY created_at DESC;
+
+-- name: ListOrganizationAPIKeys :many
+SELECT * FROM api_keys
+WHERE organization_id = $1
+ORDER BY created_at DESC;
+
+-- name: CountActiveUserAPIKeys :one
+SELECT COUNT(*) FROM api_keys
+WHERE user_id = $1
+  AND organization_id IS NULL
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now());
+
+-- name: CountActiveOrganizationAPIKeys :one
+SELECT COUNT(*) FROM api_keys
+WHERE organization_id = $1
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now());
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_keys
+SET revoked_at = now()
+WHERE id = $1 AND revoked_at IS NULL;
+
+-- name: TouchAPIKey :exec
+UPDATE api_keys
+SET last_used_at = now()
+WHERE id = $1;
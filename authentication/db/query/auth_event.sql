@@ -0,0 +1,62 @@
+-- name: CreateAuthEvent :exec
+INSERT INTO auth_events (
+    id,
+    event_type,
+    outcome,
+    actor_id,
+    user_id,
+    email,
+    session_id,
+    ip_address,
+    user_agent,
+    device,
+    device_fingerprint,
+    location,
+    metadata,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, now()
+);
+
+-- name: ListAuthEvents :many
+SELECT * FROM auth_events
+WHERE (sqlc.narg(user_id)::uuid IS NULL OR user_id = sqlc.narg(user_id)::uuid)
+  AND (sqlc.narg(actor_id)::uuid IS NULL OR actor_id = sqlc.narg(actor_id)::uuid)
+  AND (sqlc.narg(event_type)::text IS NULL OR event_type = sqlc.narg(event_type)::text)
+  AND (sqlc.narg(outcome)::text IS NULL OR outcome = sqlc.narg(outcome)::text)
+  AND (sqlc.narg(ip_address)::text IS NULL OR ip_address = sqlc.narg(ip_address)::text)
+  AND (sqlc.narg(since)::timestamp IS NULL OR created_at >= sqlc.narg(since)::timestamp)
+  AND (sqlc.narg(until)::timestamp IS NULL OR created_at < sqlc.narg(until)::timestamp)
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: CountAuthEvents :one
+SELECT count(*) FROM auth_events
+WHERE (sqlc.narg(user_id)::uuid IS NULL OR user_id = sqlc.narg(user_id)::uuid)
+  AND (sqlc.narg(actor_id)::uuid IS NULL OR actor_id = sqlc.narg(actor_id)::uuid)
+  AND (sqlc.narg(event_type)::text IS NULL OR event_type = sqlc.narg(event_type)::text)
+  AND (sqlc.narg(outcome)::text IS NULL OR outcome = sqlc.narg(outcome)::text)
+  AND (sqlc.narg(ip_address)::text IS NULL OR ip_address = sqlc.narg(ip_address)::text)
+  AND (sqlc.narg(since)::timestamp IS NULL OR created_at >= sqlc.narg(since)::timestamp)
+  AND (sqlc.narg(until)::timestamp IS NULL OR created_at < sqlc.narg(until)::timestamp);
+
+-- name: ListLoginEvents :many
+SELECT * FROM auth_events
+WHERE user_id = sqlc.arg(user_id)
+  AND event_type = ANY(sqlc.arg(event_types)::text[])
+ORDER BY created_at DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: GetLoginFamiliarity :one
+SELECT
+    count(*) AS previous_logins,
+    count(*) FILTER (WHERE device_fingerprint = sqlc.arg(device_fingerprint)::text) AS device_logins,
+    count(*) FILTER (WHERE location = sqlc.arg(location)::text) AS location_logins
+FROM auth_events
+WHERE user_id = sqlc.arg(user_id)
+  AND event_type = 'login'
+  AND outcome = 'success';
+
+-- name: PruneAuthEvents :execrows
+DELETE FROM auth_events
+WHERE created_at < $1;
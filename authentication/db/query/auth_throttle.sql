@@ -0,0 +1,38 @@
+-- name: GetAuthThrottle :one
+SELECT * FROM auth_throttles
+WHERE scope = $1 AND subject_type = $2 AND subject = $3
+LIMIT 1;
+
+-- name: RecordAuthFailure :one
+INSERT INTO auth_throttles (
+    scope, subject_type, subject, failed_attempts, last_failed_at
+) VALUES (
+    sqlc.arg(scope), sqlc.arg(subject_type), sqlc.arg(subject), 1, now()
+)
+ON CONFLICT (scope, subject_type, subject) DO UPDATE
+SET failed_attempts = CASE
+        WHEN auth_throttles.last_failed_at < sqlc.arg(window_start) THEN 1
+        ELSE auth_throttles.failed_attempts + 1
+    END,
+    lockout_count = CASE
+        WHEN auth_throttles.last_failed_at < sqlc.arg(window_start) THEN 0
+        ELSE auth_throttles.lockout_count
+    END,
+    last_failed_at = now()
+RETURNING *;
+
+-- name: LockAuthThrottle :one
+UPDATE auth_throttles
+SET failed_attempts = 0,
+    lockout_count = lockout_count + 1,
+    locked_until = $4
+WHERE scope = $1 AND subject_type = $2 AND subject = $3
+RETURNING *;
+
+-- name: DeleteAuthThrottle :exec
+DELETE FROM auth_throttles
+WHERE scope = $1 AND subject_type = $2 AND subject = $3;
+
+-- name: DeleteAuthThrottlesBySubject :exec
+DELETE FROM auth_throttles
+WHERE subject_type = $1 AND subject = $2;
@@ -0,0 +1,51 @@
+-- name: CreateDataExport :one
+INSERT INTO data_exports (
+    id,
+    user_id
+) VALUES (
+    $1, $2
+)
+RETURNING *;
+
+-- name: GetDataExport :one
+SELECT * FROM data_exports
+WHERE id = $1;
+
+-- name: ClaimDataExport :one
+UPDATE data_exports
+SET status = 'building',
+    started_at = now()
+WHERE id = (
+    SELECT e.id FROM data_exports e
+    JOIN users u ON u.id = e.user_id
+    WHERE e.status = 'pending'
+       OR (e.status = 'building' AND e.started_at < sqlc.arg(stale_before))
+    ORDER BY e.created_at
+    LIMIT 1
+    FOR UPDATE OF e SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteDataExport :exec
+UPDATE data_exports
+SET status = 'ready',
+    file_name = $2,
+    size_bytes = $3,
+    download_token_hash = $4,
+    completed_at = now(),
+    expires_at = $5
+WHERE id = $1;
+
+-- name: FailDataExport :exec
+UPDATE data_exports
+SET status = 'failed',
+    error = $2,
+    completed_at = now(),
+    expires_at = $3
+WHERE id = $1;
+
+-- name: DeleteExpiredDataExports :many
+DELETE FROM data_exports
+WHERE expires_at <= $1
+   OR NOT EXISTS (SELECT 1 FROM users WHERE users.id = data_exports.user_id)
+RETURNING *;
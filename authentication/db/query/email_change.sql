@@ -0,0 +1,47 @@
+-- name: CreateEmailChange :one
+INSERT INTO email_changes (
+    id,
+    user_id,
+    old_email,
+    new_email,
+    old_email_verified,
+    requested_ip,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, now()
+) RETURNING *;
+
+-- name: DeletePendingEmailChanges :exec
+DELETE FROM email_changes
+WHERE user_id = $1 AND confirmed_at IS NULL;
+
+-- name: GetPendingEmailChange :one
+SELECT * FROM email_changes
+WHERE user_id = $1 AND confirmed_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ConfirmEmailChange :execrows
+UPDATE email_changes
+SET
+    confirmed_at = now(),
+    revert_token_hash = $2,
+    revert_expires_at = $3
+WHERE id = $1 AND confirmed_at IS NULL;
+
+-- name: GetEmailChangeByRevertToken :one
+SELECT * FROM email_changes
+WHERE revert_token_hash = $1;
+
+-- name: MarkEmailChangeReverted :execrows
+UPDATE email_changes
+SET reverted_at = now()
+WHERE id = $1 AND reverted_at IS NULL AND revert_expires_at > now();
+
+-- name: UpdateUserEmail :exec
+UPDATE users
+SET
+    email = $2,
+    email_verified = $3,
+    updated_at = now()
+WHERE id = $1;
@@ -0,0 +1,25 @@
+-- name: CreateMagicLink :one
+INSERT INTO magic_links (
+    id, user_id, token_hash, expires_at, requested_ip, requested_user_agent
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetMagicLinkByHash :one
+SELECT * FROM magic_links
+WHERE token_hash = $1
+LIMIT 1;
+
+-- name: MarkMagicLinkUsed :execrows
+UPDATE magic_links
+SET used_at = now(), used_ip = $2, used_user_agent = $3
+WHERE id = $1 AND used_at IS NULL AND expires_at > now();
+
+-- name: ExpireMagicLinksByUserID :exec
+UPDATE magic_links
+SET expires_at = now()
+WHERE user_id = $1 AND used_at IS NULL AND expires_at > now();
+
+-- name: DeleteExpiredMagicLinks :exec
+DELETE FROM magic_links
+WHERE expires_at < now() - INTERVAL '7 days';
@@ -0,0 +1,86 @@
+-- name: UpsertUserMfa :one
+INSERT INTO user_mfa (
+    user_id,
+    secret,
+    enabled,
+    confirmed_at,
+    last_used_step
+) VALUES (
+    $1, $2, false, NULL, 0
+)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    secret = EXCLUDED.secret,
+    enabled = false,
+    confirmed_at = NULL,
+    last_used_step = 0
+RETURNING *;
+
+-- name: GetUserMfa :one
+SELECT * FROM user_mfa
+WHERE user_id = $1
+LIMIT 1;
+
+-- name: EnableUserMfa :one
+UPDATE user_mfa
+SET
+    enabled = true,
+    confirmed_at = now()
+WHERE user_id = $1
+RETURNING *;
+
+-- name: UpdateMfaLastUsedStep :execrows
+UPDATE user_mfa
+SET last_used_step = $2
+WHERE user_id = $1 AND last_used_step < $2;
+
+-- name: DeleteUserMfa :exec
+DELETE FROM user_mfa
+WHERE user_id = $1;
+
+-- name: CreateRecoveryCode :one
+INSERT INTO mfa_recovery_codes (
+    id, user_id, code_hash
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: UseRecoveryCode :execrows
+UPDATE mfa_recovery_codes
+SET used_at = now()
+WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL;
+
+-- name: CountUnusedRecoveryCodes :one
+SELECT COUNT(*) FROM mfa_recovery_codes
+WHERE user_id = $1 AND used_at IS NULL;
+
+-- name: DeleteRecoveryCodesByUserID :exec
+DELETE FROM mfa_recovery_codes
+WHERE user_id = $1;
+
+-- name: CreateMfaChallenge :one
+INSERT INTO mfa_challenges (
+    id, user_id, ip_address, user_agent, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetMfaChallenge :one
+SELECT * FROM mfa_challenges
+WHERE id = $1
+LIMIT 1;
+
+-- name: IncrementMfaChallengeAttempts :one
+UPDATE mfa_challenges
+SET attempts = attempts + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: ConsumeMfaChallenge :execrows
+UPDATE mfa_challenges
+SET consumed_at = now()
+WHERE id = $1 AND consumed_at IS NULL AND expires_at > now();
+
+-- name: DeleteExpiredMfaChallenges :exec
+DELETE FROM mfa_challenges
+WHERE expires_at < now() - INTERVAL '1 day';
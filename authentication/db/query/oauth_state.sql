@@ -0,0 +1,20 @@
+-- name: CreateOAuthState :one
+INSERT INTO oauth_states (
+    state_hash,
+    provider,
+    code_verifier,
+    nonce,
+    created_at,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, now(), $5
+) RETURNING *;
+
+-- name: ConsumeOAuthState :one
+DELETE FROM oauth_states
+WHERE state_hash = $1
+RETURNING *;
+
+-- name: DeleteExpiredOAuthStates :exec
+DELETE FROM oauth_states
+WHERE expires_at < now();
@@ -0,0 +1,114 @@
+-- name: CreateOrganization :one
+INSERT INTO organizations (
+    id,
+    name,
+    created_by
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: GetOrganization :one
+SELECT * FROM organizations
+WHERE id = $1;
+
+-- name: AddOrganizationMember :one
+INSERT INTO organization_members (
+    organization_id,
+    user_id,
+    role
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: GetOrganizationMember :one
+SELECT m.*, u.email, u.name
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1 AND m.user_id = $2;
+
+-- name: ListOrganizationMembers :many
+SELECT m.*, u.email, u.name
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1
+ORDER BY m.joined_at;
+
+-- name: ListUserOrganizations :many
+SELECT o.*, m.role, m.joined_at
+FROM organizations o
+JOIN organization_members m ON m.organization_id = o.id
+WHERE m.user_id = $1
+ORDER BY o.name;
+
+-- name: CountOrganizationOwners :one
+SELECT count(*) FROM organization_members
+WHERE organization_id = $1 AND role = 'owner';
+
+-- name: UpdateOrganizationMemberRole :execrows
+UPDATE organization_members
+SET role = $3
+WHERE organization_id = $1 AND user_id = $2;
+
+-- name: DeleteOrganizationMember :execrows
+DELETE FROM organization_members
+WHERE organization_id = $1 AND user_id = $2;
+
+-- name: ClearSessionOrganization :exec
+UPDATE sessions
+SET organization_id = NULL
+WHERE user_id = $1 AND organization_id = $2;
+
+-- name: SetSessionOrganization :execrows
+UPDATE sessions
+SET organization_id = $3
+WHERE session_id = $1 AND user_id = $2;
+
+-- name: GetSessionOrganization :one
+SELECT m.organization_id, m.role
+FROM sessions s
+JOIN organization_members m ON m.organization_id = s.organization_id AND m.user_id = s.user_id
+WHERE s.session_id = $1;
+
+-- name: RevokePendingOrganizationInvitations :exec
+UPDATE organization_invitations
+SET status = 'revoked',
+    responded_at = now()
+WHERE organization_id = $1 AND lower(email) = lower(sqlc.arg(email)) AND status = 'pending';
+
+-- name: CreateOrganizationInvitation :one
+INSERT INTO organization_invitations (
+    id,
+    organization_id,
+    email,
+    role,
+    token_hash,
+    invited_by,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetOrganizationInvitation :one
+SELECT i.*, o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.id = $1;
+
+-- name: GetOrganizationInvitationByToken :one
+SELECT i.*, o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.token_hash = $1;
+
+-- name: ListPendingOrganizationInvitations :many
+SELECT i.*, o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.organization_id = $1 AND i.status = 'pending' AND i.expires_at > now()
+ORDER BY i.created_at DESC;
+
+-- name: RespondToOrganizationInvitation :execrows
+UPDATE organization_invitations
+SET status = $2,
+    responded_at = now()
+WHERE id = $1 AND status = 'pending' AND expires_at > now();
@@ -0,0 +1,25 @@
+-- name: CreatePasswordHistory :exec
+INSERT INTO password_history (
+    id,
+    user_id,
+    password_hash,
+    created_at
+) VALUES (
+    $1, $2, $3, now()
+);
+
+-- name: ListPasswordHistory :many
+SELECT * FROM password_history
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: TrimPasswordHistory :exec
+DELETE FROM password_history
+WHERE password_history.user_id = sqlc.arg(user_id)
+  AND password_history.id NOT IN (
+    SELECT kept.id FROM password_history AS kept
+    WHERE kept.user_id = sqlc.arg(user_id)
+    ORDER BY kept.created_at DESC
+    LIMIT sqlc.arg(keep)
+  );
@@ -0,0 +1,35 @@
+-- name: CreateRefreshToken :one
+INSERT INTO refresh_tokens (
+    id, session_id, user_id, family_id, parent_id, token_hash, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetRefreshTokenByHash :one
+SELECT * FROM refresh_tokens
+WHERE token_hash = $1
+LIMIT 1;
+
+-- name: MarkRefreshTokenUsed :execrows
+UPDATE refresh_tokens
+SET used_at = now()
+WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL;
+
+-- name: RevokeRefreshTokenFamily :exec
+UPDATE refresh_tokens
+SET revoked_at = now()
+WHERE family_id = $1 AND revoked_at IS NULL;
+
+-- name: RevokeRefreshTokensByUserID :exec
+UPDATE refresh_tokens
+SET revoked_at = now()
+WHERE user_id = $1 AND revoked_at IS NULL;
+
+-- name: DeleteExpiredRefreshTokens :exec
+DELETE FROM refresh_tokens
+WHERE expires_at < now() - INTERVAL '1 day';
+
+-- name: RevokeRefreshTokensBySessionID :exec
+UPDATE refresh_tokens
+SET revoked_at = now()
+WHERE session_id = $1 AND revoked_at IS NULL;
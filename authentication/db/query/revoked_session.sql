@@ -0,0 +1,30 @@
+-- name: RevokeSessionTokens :exec
+INSERT INTO revoked_sessions (
+    session_id,
+    user_id,
+    reason,
+    revoked_at
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (session_id) DO UPDATE
+SET reason = EXCLUDED.reason,
+    revoked_at = EXCLUDED.revoked_at;
+
+-- name: RevokeUserSessionTokens :exec
+-- Access tokens exchanged for an API key use the key's ID as their session,
+-- so the tokens of the user's keys are revoked along with their sessions
+INSERT INTO revoked_sessions (session_id, user_id, reason, revoked_at)
+SELECT s.session_id, s.user_id, sqlc.arg(reason)::VARCHAR, sqlc.arg(revoked_at)::TIMESTAMP
+FROM sessions s
+WHERE s.user_id = sqlc.arg(user_id)
+UNION ALL
+SELECT k.id, k.user_id, sqlc.arg(reason)::VARCHAR, sqlc.arg(revoked_at)::TIMESTAMP
+FROM api_keys k
+WHERE k.user_id = sqlc.arg(user_id) AND k.revoked_at IS NULL
+ON CONFLICT (session_id) DO UPDATE
+SET reason = EXCLUDED.reason,
+    revoked_at = EXCLUDED.revoked_at;
+
+-- name: DeleteExpiredRevokedSessions :exec
+DELETE FROM revoked_sessions
+WHERE revoked_at < now() - INTERVAL '7 days';
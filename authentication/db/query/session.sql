@@ -80,6 +80,23 @@ SET
 WHERE user_id = $13
 RETURNING *;
 
+-- name: CreateImpersonationSession :one
+INSERT INTO sessions (
+    session_id,
+    user_id,
+    token,
+    otp_verified,
+    expires_at,
+    last_activity,
+    ip_address,
+    user_agent,
+    is_active,
+    impersonator_id,
+    impersonation_reason
+) VALUES (
+    $1, $2, $3, true, $4, now(), $5, $6, true, $7, $8
+) RETURNING *;
+
 -- name: CreateLoginHistoryEntry :one
 INSERT INTO sessions (
     session_id, user_id, ip_address, user_agent
@@ -87,12 +104,6 @@ INSERT INTO sessions (
     $1, $2, $3, $4
 ) RETURNING *;
 
--- name: GetLoginHistory :many
-SELECT * FROM sessions
-WHERE user_id = $1
-ORDER BY created_at DESC
-LIMIT $2;
-
 -- name: GetSessionsByUserID :many
 SELECT * FROM sessions 
 WHERE user_id = $1 
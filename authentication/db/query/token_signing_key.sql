@@ -0,0 +1,23 @@
+-- name: CreateTokenSigningKey :one
+INSERT INTO token_signing_keys (
+    id,
+    public_key,
+    encrypted_private_key,
+    created_at
+) VALUES (
+    $1, $2, $3, now()
+) RETURNING *;
+
+-- name: ListTokenSigningKeys :many
+SELECT * FROM token_signing_keys
+WHERE expires_at IS NULL OR expires_at > now()
+ORDER BY created_at DESC;
+
+-- name: RetireTokenSigningKeys :exec
+UPDATE token_signing_keys
+SET expires_at = $1
+WHERE expires_at IS NULL;
+
+-- name: DeleteExpiredTokenSigningKeys :exec
+DELETE FROM token_signing_keys
+WHERE expires_at < now();
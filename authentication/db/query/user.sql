@@ -9,8 +9,6 @@ INSERT INTO users (
     bio,
     role,
     phone,
-    provider,
-    provider_id,
     email_verified,
     is_active,
     last_login,
@@ -26,11 +24,9 @@ INSERT INTO users (
     $7, -- bio
     $8, -- role
     $9, -- phone
-    $10, -- provider
-    $11, -- provider_id
-    $12, -- email_verified
-    $13, -- is_active
-    $14, -- last_login
+    $10, -- email_verified
+    $11, -- is_active
+    $12, -- last_login
     now(), -- created_at
     now()  -- updated_at
 ) RETURNING *;
@@ -60,14 +56,13 @@ UPDATE users
 SET
     name = COALESCE($1, name),
     username = COALESCE($2, username),
-    email = COALESCE($3, email),
-    password = COALESCE($4, password),
-    profile_picture = COALESCE($5, profile_picture),
-    bio = COALESCE($6, bio),
-    role = COALESCE($7, role),
-    phone = COALESCE($8, phone),
+    password = COALESCE($3, password),
+    profile_picture = COALESCE($4, profile_picture),
+    bio = COALESCE($5, bio),
+    role = COALESCE($6, role),
+    phone = COALESCE($7, phone),
     updated_at = now()
-WHERE id = $9
+WHERE id = $8
 RETURNING *;
 
 -- name: UpdateUserProfilePicture :one
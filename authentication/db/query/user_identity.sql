@@ -0,0 +1,31 @@
+-- name: CreateUserIdentity :one
+INSERT INTO user_identities (
+    id,
+    user_id,
+    provider,
+    provider_user_id,
+    email,
+    created_at,
+    last_used_at
+) VALUES (
+    $1, $2, $3, $4, $5, now(), now()
+) RETURNING *;
+
+-- name: GetUserIdentityByProvider :one
+SELECT * FROM user_identities
+WHERE provider = $1 AND provider_user_id = $2
+LIMIT 1;
+
+-- name: ListUserIdentities :many
+SELECT * FROM user_identities
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: TouchUserIdentity :exec
+UPDATE user_identities
+SET last_used_at = now()
+WHERE id = $1;
+
+-- name: DeleteUserIdentity :execrows
+DELETE FROM user_identities
+WHERE id = $1 AND user_id = $2;
@@ -0,0 +1,75 @@
+-- name: CreateVerificationChallenge :one
+INSERT INTO verification_challenges (
+    id,
+    user_id,
+    purpose,
+    code_hash,
+    salt,
+    max_attempts,
+    ip_address,
+    user_agent,
+    expires_at,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, now()
+) RETURNING *;
+
+-- name: GetActiveVerificationChallenge :one
+SELECT * FROM verification_challenges
+WHERE user_id = $1 AND purpose = $2 AND consumed_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: IncrementVerificationChallengeAttempts :one
+UPDATE verification_challenges
+SET attempts = attempts + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: MarkVerificationChallengeVerified :execrows
+UPDATE verification_challenges
+SET verified_at = now()
+WHERE id = $1
+  AND verified_at IS NULL
+  AND consumed_at IS NULL
+  AND attempts < max_attempts
+  AND expires_at > now();
+
+-- name: ConsumeVerificationChallenge :execrows
+UPDATE verification_challenges
+SET consumed_at = now()
+WHERE user_id = $1
+  AND purpose = $2
+  AND verified_at IS NOT NULL
+  AND consumed_at IS NULL
+  AND expires_at > now();
+
+-- name: SetVerificationChallengeResetToken :execrows
+UPDATE verification_challenges
+SET reset_token_hash = $2
+WHERE id = $1
+  AND verified_at IS NOT NULL
+  AND consumed_at IS NULL
+  AND expires_at > now();
+
+-- name: GetVerificationChallengeByResetToken :one
+SELECT * FROM verification_challenges
+WHERE reset_token_hash = $1
+  AND consumed_at IS NULL
+  AND expires_at > now();
+
+-- name: ConsumeVerificationChallengeByResetToken :execrows
+UPDATE verification_challenges
+SET consumed_at = now()
+WHERE reset_token_hash = $1
+  AND consumed_at IS NULL
+  AND expires_at > now();
+
+-- name: InvalidateVerificationChallenges :exec
+UPDATE verification_challenges
+SET consumed_at = now()
+WHERE user_id = $1 AND purpose = $2 AND consumed_at IS NULL;
+
+-- name: DeleteExpiredVerificationChallenges :exec
+DELETE FROM verification_challenges
+WHERE expires_at < now() - INTERVAL '1 day';
@@ -0,0 +1,300 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: account_erasure.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const cancelUserDeletion = `-- name: CancelUserDeletion :execrows
+UPDATE users
+SET deletion_requested_at = NULL,
+    deletion_scheduled_for = NULL,
+    updated_at = now()
+WHERE id = $1 AND deletion_scheduled_for IS NOT NULL
+`
+
+func (q *Queries) CancelUserDeletion(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, cancelUserDeletion, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createAccountErasure = `-- name: CreateAccountErasure :exec
+INSERT INTO account_erasures (
+    user_id,
+    requested_at,
+    deleted_at
+) VALUES (
+    $1, $2, now()
+)
+ON CONFLICT (user_id) DO NOTHING
+`
+
+type CreateAccountErasureParams struct {
+	UserID      uuid.UUID `json:"user_id"`
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+func (q *Queries) CreateAccountErasure(ctx context.Context, arg CreateAccountErasureParams) error {
+	_, err := q.db.ExecContext(ctx, createAccountErasure, arg.UserID, arg.RequestedAt)
+	return err
+}
+
+const createAccountErasureService = `-- name: CreateAccountErasureService :exec
+INSERT INTO account_erasure_services (
+    user_id,
+    service
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (user_id, service) DO NOTHING
+`
+
+type CreateAccountErasureServiceParams struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Service string    `json:"service"`
+}
+
+func (q *Queries) CreateAccountErasureService(ctx context.Context, arg CreateAccountErasureServiceParams) error {
+	_, err := q.db.ExecContext(ctx, createAccountErasureService, arg.UserID, arg.Service)
+	return err
+}
+
+const deleteUserDueForDeletion = `-- name: DeleteUserDueForDeletion :execrows
+DELETE FROM users
+WHERE id = $1 AND deletion_scheduled_for <= now()
+`
+
+func (q *Queries) DeleteUserDueForDeletion(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUserDueForDeletion, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getAccountErasure = `-- name: GetAccountErasure :one
+SELECT user_id, requested_at, deleted_at, published_at FROM account_erasures
+WHERE user_id = $1
+`
+
+func (q *Queries) GetAccountErasure(ctx context.Context, userID uuid.UUID) (AccountErasures, error) {
+	row := q.db.QueryRowContext(ctx, getAccountErasure, userID)
+	var i AccountErasures
+	err := row.Scan(
+		&i.UserID,
+		&i.RequestedAt,
+		&i.DeletedAt,
+		&i.PublishedAt,
+	)
+	return i, err
+}
+
+const listAccountErasureServices = `-- name: ListAccountErasureServices :many
+SELECT user_id, service, status, items, detail, updated_at FROM account_erasure_services
+WHERE user_id = $1
+ORDER BY service
+`
+
+func (q *Queries) ListAccountErasureServices(ctx context.Context, userID uuid.UUID) ([]AccountErasureServices, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountErasureServices, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountErasureServices{}
+	for rows.Next() {
+		var i AccountErasureServices
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Service,
+			&i.Status,
+			&i.Items,
+			&i.Detail,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnpublishedErasures = `-- name: ListUnpublishedErasures :many
+SELECT user_id, requested_at, deleted_at, published_at FROM account_erasures
+WHERE published_at IS NULL
+ORDER BY deleted_at
+LIMIT $1
+`
+
+func (q *Queries) ListUnpublishedErasures(ctx context.Context, limit int32) ([]AccountErasures, error) {
+	rows, err := q.db.QueryContext(ctx, listUnpublishedErasures, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountErasures{}
+	for rows.Next() {
+		var i AccountErasures
+		if err := rows.Scan(
+			&i.UserID,
+			&i.RequestedAt,
+			&i.DeletedAt,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersDueForDeletion = `-- name: ListUsersDueForDeletion :many
+SELECT id, name, username, profile_picture, bio, email, password, role, phone, email_verified, is_active, last_login, created_at, updated_at, locked_at, locked_reason, deleted_at, deletion_requested_at, deletion_scheduled_for, agent_verified_at FROM users
+WHERE deletion_scheduled_for <= $1
+ORDER BY deletion_scheduled_for
+LIMIT $2
+`
+
+type ListUsersDueForDeletionParams struct {
+	DeletionScheduledFor sql.NullTime `json:"deletion_scheduled_for"`
+	Limit                int32        `json:"limit"`
+}
+
+func (q *Queries) ListUsersDueForDeletion(ctx context.Context, arg ListUsersDueForDeletionParams) ([]Users, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersDueForDeletion, arg.DeletionScheduledFor, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Users{}
+	for rows.Next() {
+		var i Users
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Username,
+			&i.ProfilePicture,
+			&i.Bio,
+			&i.Email,
+			&i.Password,
+			&i.Role,
+			&i.Phone,
+			&i.EmailVerified,
+			&i.IsActive,
+			&i.LastLogin,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LockedAt,
+			&i.LockedReason,
+			&i.DeletedAt,
+			&i.DeletionRequestedAt,
+			&i.DeletionScheduledFor,
+			&i.AgentVerifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markErasurePublished = `-- name: MarkErasurePublished :exec
+UPDATE account_erasures
+SET published_at = now()
+WHERE user_id = $1
+`
+
+func (q *Queries) MarkErasurePublished(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, markErasurePublished, userID)
+	return err
+}
+
+const scheduleUserDeletion = `-- name: ScheduleUserDeletion :execrows
+UPDATE users
+SET deletion_requested_at = now(),
+    deletion_scheduled_for = $2,
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NULL AND deletion_scheduled_for IS NULL
+`
+
+type ScheduleUserDeletionParams struct {
+	ID                   uuid.UUID    `json:"id"`
+	DeletionScheduledFor sql.NullTime `json:"deletion_scheduled_for"`
+}
+
+func (q *Queries) ScheduleUserDeletion(ctx context.Context, arg ScheduleUserDeletionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, scheduleUserDeletion, arg.ID, arg.DeletionScheduledFor)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateAccountErasureService = `-- name: UpdateAccountErasureService :execrows
+INSERT INTO account_erasure_services (
+    user_id,
+    service,
+    status,
+    items,
+    detail,
+    updated_at
+)
+SELECT $1, $2, $3, $4, $5, now()
+WHERE EXISTS (SELECT 1 FROM account_erasures WHERE account_erasures.user_id = $1)
+ON CONFLICT (user_id, service) DO UPDATE
+SET status = EXCLUDED.status,
+    items = EXCLUDED.items,
+    detail = EXCLUDED.detail,
+    updated_at = now()
+`
+
+type UpdateAccountErasureServiceParams struct {
+	UserID  uuid.UUID      `json:"user_id"`
+	Service string         `json:"service"`
+	Status  string         `json:"status"`
+	Items   int64          `json:"items"`
+	Detail  sql.NullString `json:"detail"`
+}
+
+func (q *Queries) UpdateAccountErasureService(ctx context.Context, arg UpdateAccountErasureServiceParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateAccountErasureService,
+		arg.UserID,
+		arg.Service,
+		arg.Status,
+		arg.Items,
+		arg.Detail,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
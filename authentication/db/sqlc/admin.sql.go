@@ -0,0 +1,210 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: admin.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const countUsers = `-- name: CountUsers :one
+SELECT count(*) FROM users
+WHERE ($1::text IS NULL OR email ILIKE '%' || $1::text || '%')
+  AND ($2::text IS NULL OR name ILIKE '%' || $2::text || '%')
+  AND ($3::text IS NULL OR role = $3::text)
+  AND ($4::text IS NULL
+       OR provider = $4::text
+       OR EXISTS (
+           SELECT 1 FROM user_identities
+           WHERE user_identities.user_id = users.id AND user_identities.provider = $4::text
+       ))
+  AND ($5::boolean IS NULL OR is_active = $5::boolean)
+  AND ($6::boolean IS NULL OR (deleted_at IS NOT NULL) = $6::boolean)
+`
+
+type CountUsersParams struct {
+	Email    sql.NullString `json:"email"`
+	Name     sql.NullString `json:"name"`
+	Role     sql.NullString `json:"role"`
+	Provider sql.NullString `json:"provider"`
+	IsActive sql.NullBool   `json:"is_active"`
+	Deleted  sql.NullBool   `json:"deleted"`
+}
+
+func (q *Queries) CountUsers(ctx context.Context, arg CountUsersParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUsers,
+		arg.Email,
+		arg.Name,
+		arg.Role,
+		arg.Provider,
+		arg.IsActive,
+		arg.Deleted,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, name, username, profile_picture, bio, email, password, role, phone, email_verified, is_active, last_login, created_at, updated_at, locked_at, locked_reason, deleted_at, deletion_requested_at, deletion_scheduled_for, agent_verified_at FROM users
+WHERE ($1::text IS NULL OR email ILIKE '%' || $1::text || '%')
+  AND ($2::text IS NULL OR name ILIKE '%' || $2::text || '%')
+  AND ($3::text IS NULL OR role = $3::text)
+  AND ($4::text IS NULL
+       OR provider = $4::text
+       OR EXISTS (
+           SELECT 1 FROM user_identities
+           WHERE user_identities.user_id = users.id AND user_identities.provider = $4::text
+       ))
+  AND ($5::boolean IS NULL OR is_active = $5::boolean)
+  AND ($6::boolean IS NULL OR (deleted_at IS NOT NULL) = $6::boolean)
+ORDER BY created_at DESC, id
+LIMIT $8 OFFSET $7
+`
+
+type ListUsersParams struct {
+	Email     sql.NullString `json:"email"`
+	Name      sql.NullString `json:"name"`
+	Role      sql.NullString `json:"role"`
+	Provider  sql.NullString `json:"provider"`
+	IsActive  sql.NullBool   `json:"is_active"`
+	Deleted   sql.NullBool   `json:"deleted"`
+	RowOffset int32          `json:"row_offset"`
+	RowLimit  int32          `json:"row_limit"`
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]Users, error) {
+	rows, err := q.db.QueryContext(ctx, listUsers,
+		arg.Email,
+		arg.Name,
+		arg.Role,
+		arg.Provider,
+		arg.IsActive,
+		arg.Deleted,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Users{}
+	for rows.Next() {
+		var i Users
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Username,
+			&i.ProfilePicture,
+			&i.Bio,
+			&i.Email,
+			&i.Password,
+			&i.Role,
+			&i.Phone,
+			&i.EmailVerified,
+			&i.IsActive,
+			&i.LastLogin,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LockedAt,
+			&i.LockedReason,
+			&i.DeletedAt,
+			&i.DeletionRequestedAt,
+			&i.DeletionScheduledFor,
+			&i.AgentVerifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockUser = `-- name: LockUser :exec
+UPDATE users
+SET locked_at = now(),
+    locked_reason = $2,
+    updated_at = now()
+WHERE id = $1
+`
+
+type LockUserParams struct {
+	ID           uuid.UUID      `json:"id"`
+	LockedReason sql.NullString `json:"locked_reason"`
+}
+
+func (q *Queries) LockUser(ctx context.Context, arg LockUserParams) error {
+	_, err := q.db.ExecContext(ctx, lockUser, arg.ID, arg.LockedReason)
+	return err
+}
+
+const restoreUser = `-- name: RestoreUser :execrows
+UPDATE users
+SET deleted_at = NULL,
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) RestoreUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, restoreUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const softDeleteUser = `-- name: SoftDeleteUser :execrows
+UPDATE users
+SET deleted_at = now(),
+    updated_at = now()
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeleteUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, softDeleteUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const unlockUser = `-- name: UnlockUser :exec
+UPDATE users
+SET locked_at = NULL,
+    locked_reason = NULL,
+    updated_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) UnlockUser(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, unlockUser, id)
+	return err
+}
+
+const updateUserRole = `-- name: UpdateUserRole :exec
+UPDATE users
+SET role = $2,
+    updated_at = now()
+WHERE id = $1
+`
+
+type UpdateUserRoleParams struct {
+	ID   uuid.UUID      `json:"id"`
+	Role sql.NullString `json:"role"`
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserRole, arg.ID, arg.Role)
+	return err
+}
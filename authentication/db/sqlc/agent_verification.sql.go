@@ -0,0 +1,323 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: agent_verification.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countAgentVerifications = `-- name: CountAgentVerifications :one
+SELECT count(*) FROM agent_verifications
+WHERE status = $1
+`
+
+func (q *Queries) CountAgentVerifications(ctx context.Context, status string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAgentVerifications, status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAgentVerificationDocument = `-- name: CreateAgentVerificationDocument :one
+INSERT INTO agent_verification_documents (
+    id,
+    user_id,
+    document_type,
+    url
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, user_id, document_type, url, uploaded_at
+`
+
+type CreateAgentVerificationDocumentParams struct {
+	ID           uuid.UUID `json:"id"`
+	UserID       uuid.UUID `json:"user_id"`
+	DocumentType string    `json:"document_type"`
+	Url          string    `json:"url"`
+}
+
+func (q *Queries) CreateAgentVerificationDocument(ctx context.Context, arg CreateAgentVerificationDocumentParams) (AgentVerificationDocuments, error) {
+	row := q.db.QueryRowContext(ctx, createAgentVerificationDocument,
+		arg.ID,
+		arg.UserID,
+		arg.DocumentType,
+		arg.Url,
+	)
+	var i AgentVerificationDocuments
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.DocumentType,
+		&i.Url,
+		&i.UploadedAt,
+	)
+	return i, err
+}
+
+const getAgentVerification = `-- name: GetAgentVerification :one
+SELECT v.user_id, v.license_number, v.business_name, v.business_address, v.business_phone, v.registration_number, v.status, v.review_note, v.reviewed_by, v.reviewed_at, v.submitted_at, v.updated_at, u.email, u.name
+FROM agent_verifications v
+JOIN users u ON u.id = v.user_id
+WHERE v.user_id = $1
+`
+
+type GetAgentVerificationRow struct {
+	UserID             uuid.UUID      `json:"user_id"`
+	LicenseNumber      string         `json:"license_number"`
+	BusinessName       string         `json:"business_name"`
+	BusinessAddress    string         `json:"business_address"`
+	BusinessPhone      sql.NullString `json:"business_phone"`
+	RegistrationNumber sql.NullString `json:"registration_number"`
+	Status             string         `json:"status"`
+	ReviewNote         sql.NullString `json:"review_note"`
+	ReviewedBy         uuid.NullUUID  `json:"reviewed_by"`
+	ReviewedAt         sql.NullTime   `json:"reviewed_at"`
+	SubmittedAt        time.Time      `json:"submitted_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	Email              string         `json:"email"`
+	Name               string         `json:"name"`
+}
+
+func (q *Queries) GetAgentVerification(ctx context.Context, userID uuid.UUID) (GetAgentVerificationRow, error) {
+	row := q.db.QueryRowContext(ctx, getAgentVerification, userID)
+	var i GetAgentVerificationRow
+	err := row.Scan(
+		&i.UserID,
+		&i.LicenseNumber,
+		&i.BusinessName,
+		&i.BusinessAddress,
+		&i.BusinessPhone,
+		&i.RegistrationNumber,
+		&i.Status,
+		&i.ReviewNote,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.SubmittedAt,
+		&i.UpdatedAt,
+		&i.Email,
+		&i.Name,
+	)
+	return i, err
+}
+
+const listAgentVerificationDocuments = `-- name: ListAgentVerificationDocuments :many
+SELECT id, user_id, document_type, url, uploaded_at FROM agent_verification_documents
+WHERE user_id = $1
+ORDER BY uploaded_at, id
+`
+
+func (q *Queries) ListAgentVerificationDocuments(ctx context.Context, userID uuid.UUID) ([]AgentVerificationDocuments, error) {
+	rows, err := q.db.QueryContext(ctx, listAgentVerificationDocuments, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AgentVerificationDocuments{}
+	for rows.Next() {
+		var i AgentVerificationDocuments
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.DocumentType,
+			&i.Url,
+			&i.UploadedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAgentVerifications = `-- name: ListAgentVerifications :many
+SELECT v.user_id, v.license_number, v.business_name, v.business_address, v.business_phone, v.registration_number, v.status, v.review_note, v.reviewed_by, v.reviewed_at, v.submitted_at, v.updated_at, u.email, u.name
+FROM agent_verifications v
+JOIN users u ON u.id = v.user_id
+WHERE v.status = $1
+ORDER BY v.submitted_at, v.user_id
+LIMIT $2 OFFSET $3
+`
+
+type ListAgentVerificationsParams struct {
+	Status string `json:"status"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+type ListAgentVerificationsRow struct {
+	UserID             uuid.UUID      `json:"user_id"`
+	LicenseNumber      string         `json:"license_number"`
+	BusinessName       string         `json:"business_name"`
+	BusinessAddress    string         `json:"business_address"`
+	BusinessPhone      sql.NullString `json:"business_phone"`
+	RegistrationNumber sql.NullString `json:"registration_number"`
+	Status             string         `json:"status"`
+	ReviewNote         sql.NullString `json:"review_note"`
+	ReviewedBy         uuid.NullUUID  `json:"reviewed_by"`
+	ReviewedAt         sql.NullTime   `json:"reviewed_at"`
+	SubmittedAt        time.Time      `json:"submitted_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	Email              string         `json:"email"`
+	Name               string         `json:"name"`
+}
+
+func (q *Queries) ListAgentVerifications(ctx context.Context, arg ListAgentVerificationsParams) ([]ListAgentVerificationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAgentVerifications, arg.Status, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAgentVerificationsRow{}
+	for rows.Next() {
+		var i ListAgentVerificationsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.LicenseNumber,
+			&i.BusinessName,
+			&i.BusinessAddress,
+			&i.BusinessPhone,
+			&i.RegistrationNumber,
+			&i.Status,
+			&i.ReviewNote,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.SubmittedAt,
+			&i.UpdatedAt,
+			&i.Email,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewAgentVerification = `-- name: ReviewAgentVerification :execrows
+UPDATE agent_verifications
+SET status = $2,
+    review_note = $3,
+    reviewed_by = $4,
+    reviewed_at = now(),
+    updated_at = now()
+WHERE user_id = $1 AND status = 'pending'
+`
+
+type ReviewAgentVerificationParams struct {
+	UserID     uuid.UUID      `json:"user_id"`
+	Status     string         `json:"status"`
+	ReviewNote sql.NullString `json:"review_note"`
+	ReviewedBy uuid.NullUUID  `json:"reviewed_by"`
+}
+
+func (q *Queries) ReviewAgentVerification(ctx context.Context, arg ReviewAgentVerificationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, reviewAgentVerification,
+		arg.UserID,
+		arg.Status,
+		arg.ReviewNote,
+		arg.ReviewedBy,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setUserAgentVerified = `-- name: SetUserAgentVerified :exec
+UPDATE users
+SET agent_verified_at = CASE WHEN $1::boolean THEN now() END,
+    updated_at = now()
+WHERE id = $2
+`
+
+type SetUserAgentVerifiedParams struct {
+	Verified bool      `json:"verified"`
+	ID       uuid.UUID `json:"id"`
+}
+
+func (q *Queries) SetUserAgentVerified(ctx context.Context, arg SetUserAgentVerifiedParams) error {
+	_, err := q.db.ExecContext(ctx, setUserAgentVerified, arg.Verified, arg.ID)
+	return err
+}
+
+const submitAgentVerification = `-- name: SubmitAgentVerification :one
+INSERT INTO agent_verifications (
+    user_id,
+    license_number,
+    business_name,
+    business_address,
+    business_phone,
+    registration_number
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (user_id) DO UPDATE
+SET license_number = EXCLUDED.license_number,
+    business_name = EXCLUDED.business_name,
+    business_address = EXCLUDED.business_address,
+    business_phone = EXCLUDED.business_phone,
+    registration_number = EXCLUDED.registration_number,
+    status = 'pending',
+    review_note = NULL,
+    reviewed_by = NULL,
+    reviewed_at = NULL,
+    submitted_at = now(),
+    updated_at = now()
+WHERE agent_verifications.status IN ('rejected', 'more_info_requested')
+RETURNING user_id, license_number, business_name, business_address, business_phone, registration_number, status, review_note, reviewed_by, reviewed_at, submitted_at, updated_at
+`
+
+type SubmitAgentVerificationParams struct {
+	UserID             uuid.UUID      `json:"user_id"`
+	LicenseNumber      string         `json:"license_number"`
+	BusinessName       string         `json:"business_name"`
+	BusinessAddress    string         `json:"business_address"`
+	BusinessPhone      sql.NullString `json:"business_phone"`
+	RegistrationNumber sql.NullString `json:"registration_number"`
+}
+
+func (q *Queries) SubmitAgentVerification(ctx context.Context, arg SubmitAgentVerificationParams) (AgentVerifications, error) {
+	row := q.db.QueryRowContext(ctx, submitAgentVerification,
+		arg.UserID,
+		arg.LicenseNumber,
+		arg.BusinessName,
+		arg.BusinessAddress,
+		arg.BusinessPhone,
+		arg.RegistrationNumber,
+	)
+	var i AgentVerifications
+	err := row.Scan(
+		&i.UserID,
+		&i.LicenseNumber,
+		&i.BusinessName,
+		&i.BusinessAddress,
+		&i.BusinessPhone,
+		&i.RegistrationNumber,
+		&i.Status,
+		&i.ReviewNote,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.SubmittedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,252 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: api_key.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countActiveOrganizationAPIKeys = `-- name: CountActiveOrganizationAPIKeys :one
+SELECT COUNT(*) FROM api_keys
+WHERE organization_id = $1
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now())
+`
+
+func (q *Queries) CountActiveOrganizationAPIKeys(ctx context.Context, organizationID uuid.NullUUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveOrganizationAPIKeys, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countActiveUserAPIKeys = `-- name: CountActiveUserAPIKeys :one
+SELECT COUNT(*) FROM api_keys
+WHERE user_id = $1
+  AND organization_id IS NULL
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now())
+`
+
+func (q *Queries) CountActiveUserAPIKeys(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveUserAPIKeys, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAPIKey = `-- name: CreateAPIKey :one
+INSERT INTO api_keys (
+    id,
+    user_id,
+    organization_id,
+    name,
+    prefix,
+    key_hash,
+    scopes,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, user_id, organization_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at, revoked_at
+`
+
+type CreateAPIKeyParams struct {
+	ID             uuid.UUID     `json:"id"`
+	UserID         uuid.UUID     `json:"user_id"`
+	OrganizationID uuid.NullUUID `json:"organization_id"`
+	Name           string        `json:"name"`
+	Prefix         string        `json:"prefix"`
+	KeyHash        string        `json:"key_hash"`
+	Scopes         []string      `json:"scopes"`
+	ExpiresAt      sql.NullTime  `json:"expires_at"`
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKeys, error) {
+	row := q.db.QueryRowContext(ctx, createAPIKey,
+		arg.ID,
+		arg.UserID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Prefix,
+		arg.KeyHash,
+		pq.Array(arg.Scopes),
+		arg.ExpiresAt,
+	)
+	var i ApiKeys
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Prefix,
+		&i.KeyHash,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getAPIKey = `-- name: GetAPIKey :one
+SELECT id, user_id, organization_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at, revoked_at FROM api_keys
+WHERE id = $1
+`
+
+func (q *Queries) GetAPIKey(ctx context.Context, id uuid.UUID) (ApiKeys, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKey, id)
+	var i ApiKeys
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Prefix,
+		&i.KeyHash,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getAPIKeyByHash = `-- name: GetAPIKeyByHash :one
+SELECT id, user_id, organization_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at, revoked_at FROM api_keys
+WHERE key_hash = $1
+`
+
+func (q *Queries) GetAPIKeyByHash(ctx context.Context, keyHash string) (ApiKeys, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKeyByHash, keyHash)
+	var i ApiKeys
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Prefix,
+		&i.KeyHash,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const listOrganizationAPIKeys = `-- name: ListOrganizationAPIKeys :many
+SELECT id, user_id, organization_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at, revoked_at FROM api_keys
+WHERE organization_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListOrganizationAPIKeys(ctx context.Context, organizationID uuid.NullUUID) ([]ApiKeys, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationAPIKeys, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApiKeys{}
+	for rows.Next() {
+		var i ApiKeys
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Prefix,
+			&i.KeyHash,
+			pq.Array(&i.Scopes),
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.CreatedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserAPIKeys = `-- name: ListUserAPIKeys :many
+SELECT id, user_id, organization_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at, revoked_at FROM api_keys
+WHERE user_id = $1 AND organization_id IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListUserAPIKeys(ctx context.Context, userID uuid.UUID) ([]ApiKeys, error) {
+	rows, err := q.db.QueryContext(ctx, listUserAPIKeys, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApiKeys{}
+	for rows.Next() {
+		var i ApiKeys
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Prefix,
+			&i.KeyHash,
+			pq.Array(&i.Scopes),
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.CreatedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeAPIKey = `-- name: RevokeAPIKey :execrows
+UPDATE api_keys
+SET revoked_at = now()
+WHERE id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeAPIKey(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeAPIKey, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const touchAPIKey = `-- name: TouchAPIKey :exec
+UPDATE api_keys
+SET last_used_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, touchAPIKey, id)
+	return err
+}
@@ -0,0 +1,276 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: auth_event.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countAuthEvents = `-- name: CountAuthEvents :one
+SELECT count(*) FROM auth_events
+WHERE ($1::uuid IS NULL OR user_id = $1::uuid)
+  AND ($2::uuid IS NULL OR actor_id = $2::uuid)
+  AND ($3::text IS NULL OR event_type = $3::text)
+  AND ($4::text IS NULL OR outcome = $4::text)
+  AND ($5::text IS NULL OR ip_address = $5::text)
+  AND ($6::timestamp IS NULL OR created_at >= $6::timestamp)
+  AND ($7::timestamp IS NULL OR created_at < $7::timestamp)
+`
+
+type CountAuthEventsParams struct {
+	UserID    uuid.NullUUID  `json:"user_id"`
+	ActorID   uuid.NullUUID  `json:"actor_id"`
+	EventType sql.NullString `json:"event_type"`
+	Outcome   sql.NullString `json:"outcome"`
+	IpAddress sql.NullString `json:"ip_address"`
+	Since     sql.NullTime   `json:"since"`
+	Until     sql.NullTime   `json:"until"`
+}
+
+func (q *Queries) CountAuthEvents(ctx context.Context, arg CountAuthEventsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAuthEvents,
+		arg.UserID,
+		arg.ActorID,
+		arg.EventType,
+		arg.Outcome,
+		arg.IpAddress,
+		arg.Since,
+		arg.Until,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAuthEvent = `-- name: CreateAuthEvent :exec
+INSERT INTO auth_events (
+    id,
+    event_type,
+    outcome,
+    actor_id,
+    user_id,
+    email,
+    session_id,
+    ip_address,
+    user_agent,
+    device,
+    device_fingerprint,
+    location,
+    metadata,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, now()
+)
+`
+
+type CreateAuthEventParams struct {
+	ID                uuid.UUID       `json:"id"`
+	EventType         string          `json:"event_type"`
+	Outcome           string          `json:"outcome"`
+	ActorID           uuid.NullUUID   `json:"actor_id"`
+	UserID            uuid.NullUUID   `json:"user_id"`
+	Email             sql.NullString  `json:"email"`
+	SessionID         uuid.NullUUID   `json:"session_id"`
+	IpAddress         sql.NullString  `json:"ip_address"`
+	UserAgent         sql.NullString  `json:"user_agent"`
+	Device            sql.NullString  `json:"device"`
+	DeviceFingerprint sql.NullString  `json:"device_fingerprint"`
+	Location          sql.NullString  `json:"location"`
+	Metadata          json.RawMessage `json:"metadata"`
+}
+
+func (q *Queries) CreateAuthEvent(ctx context.Context, arg CreateAuthEventParams) error {
+	_, err := q.db.ExecContext(ctx, createAuthEvent,
+		arg.ID,
+		arg.EventType,
+		arg.Outcome,
+		arg.ActorID,
+		arg.UserID,
+		arg.Email,
+		arg.SessionID,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.Device,
+		arg.DeviceFingerprint,
+		arg.Location,
+		arg.Metadata,
+	)
+	return err
+}
+
+const getLoginFamiliarity = `-- name: GetLoginFamiliarity :one
+SELECT
+    count(*) AS previous_logins,
+    count(*) FILTER (WHERE device_fingerprint = $1::text) AS device_logins,
+    count(*) FILTER (WHERE location = $2::text) AS location_logins
+FROM auth_events
+WHERE user_id = $3
+  AND event_type = 'login'
+  AND outcome = 'success'
+`
+
+type GetLoginFamiliarityParams struct {
+	DeviceFingerprint string        `json:"device_fingerprint"`
+	Location          string        `json:"location"`
+	UserID            uuid.NullUUID `json:"user_id"`
+}
+
+type GetLoginFamiliarityRow struct {
+	PreviousLogins int64 `json:"previous_logins"`
+	DeviceLogins   int64 `json:"device_logins"`
+	LocationLogins int64 `json:"location_logins"`
+}
+
+func (q *Queries) GetLoginFamiliarity(ctx context.Context, arg GetLoginFamiliarityParams) (GetLoginFamiliarityRow, error) {
+	row := q.db.QueryRowContext(ctx, getLoginFamiliarity, arg.DeviceFingerprint, arg.Location, arg.UserID)
+	var i GetLoginFamiliarityRow
+	err := row.Scan(&i.PreviousLogins, &i.DeviceLogins, &i.LocationLogins)
+	return i, err
+}
+
+const listAuthEvents = `-- name: ListAuthEvents :many
+SELECT id, event_type, outcome, actor_id, user_id, email, session_id, ip_address, user_agent, metadata, created_at, device, device_fingerprint, location FROM auth_events
+WHERE ($1::uuid IS NULL OR user_id = $1::uuid)
+  AND ($2::uuid IS NULL OR actor_id = $2::uuid)
+  AND ($3::text IS NULL OR event_type = $3::text)
+  AND ($4::text IS NULL OR outcome = $4::text)
+  AND ($5::text IS NULL OR ip_address = $5::text)
+  AND ($6::timestamp IS NULL OR created_at >= $6::timestamp)
+  AND ($7::timestamp IS NULL OR created_at < $7::timestamp)
+ORDER BY created_at DESC, id
+LIMIT $9 OFFSET $8
+`
+
+type ListAuthEventsParams struct {
+	UserID    uuid.NullUUID  `json:"user_id"`
+	ActorID   uuid.NullUUID  `json:"actor_id"`
+	EventType sql.NullString `json:"event_type"`
+	Outcome   sql.NullString `json:"outcome"`
+	IpAddress sql.NullString `json:"ip_address"`
+	Since     sql.NullTime   `json:"since"`
+	Until     sql.NullTime   `json:"until"`
+	RowOffset int32          `json:"row_offset"`
+	RowLimit  int32          `json:"row_limit"`
+}
+
+func (q *Queries) ListAuthEvents(ctx context.Context, arg ListAuthEventsParams) ([]AuthEvents, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthEvents,
+		arg.UserID,
+		arg.ActorID,
+		arg.EventType,
+		arg.Outcome,
+		arg.IpAddress,
+		arg.Since,
+		arg.Until,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuthEvents{}
+	for rows.Next() {
+		var i AuthEvents
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.Outcome,
+			&i.ActorID,
+			&i.UserID,
+			&i.Email,
+			&i.SessionID,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Metadata,
+			&i.CreatedAt,
+			&i.Device,
+			&i.DeviceFingerprint,
+			&i.Location,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLoginEvents = `-- name: ListLoginEvents :many
+SELECT id, event_type, outcome, actor_id, user_id, email, session_id, ip_address, user_agent, metadata, created_at, device, device_fingerprint, location FROM auth_events
+WHERE user_id = $1
+  AND event_type = ANY($2::text[])
+ORDER BY created_at DESC
+LIMIT $3
+`
+
+type ListLoginEventsParams struct {
+	UserID     uuid.NullUUID `json:"user_id"`
+	EventTypes []string      `json:"event_types"`
+	RowLimit   int32         `json:"row_limit"`
+}
+
+func (q *Queries) ListLoginEvents(ctx context.Context, arg ListLoginEventsParams) ([]AuthEvents, error) {
+	rows, err := q.db.QueryContext(ctx, listLoginEvents, arg.UserID, pq.Array(arg.EventTypes), arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuthEvents{}
+	for rows.Next() {
+		var i AuthEvents
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.Outcome,
+			&i.ActorID,
+			&i.UserID,
+			&i.Email,
+			&i.SessionID,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Metadata,
+			&i.CreatedAt,
+			&i.Device,
+			&i.DeviceFingerprint,
+			&i.Location,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pruneAuthEvents = `-- name: PruneAuthEvents :execrows
+DELETE FROM auth_events
+WHERE created_at < $1
+`
+
+func (q *Queries) PruneAuthEvents(ctx context.Context, createdAt time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, pruneAuthEvents, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
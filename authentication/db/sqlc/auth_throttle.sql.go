@@ -0,0 +1,152 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: auth_throttle.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const deleteAuthThrottle = `-- name: DeleteAuthThrottle :exec
+DELETE FROM auth_throttles
+WHERE scope = $1 AND subject_type = $2 AND subject = $3
+`
+
+type DeleteAuthThrottleParams struct {
+	Scope       string `json:"scope"`
+	SubjectType string `json:"subject_type"`
+	Subject     string `json:"subject"`
+}
+
+func (q *Queries) DeleteAuthThrottle(ctx context.Context, arg DeleteAuthThrottleParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthThrottle, arg.Scope, arg.SubjectType, arg.Subject)
+	return err
+}
+
+const deleteAuthThrottlesBySubject = `-- name: DeleteAuthThrottlesBySubject :exec
+DELETE FROM auth_throttles
+WHERE subject_type = $1 AND subject = $2
+`
+
+type DeleteAuthThrottlesBySubjectParams struct {
+	SubjectType string `json:"subject_type"`
+	Subject     string `json:"subject"`
+}
+
+func (q *Queries) DeleteAuthThrottlesBySubject(ctx context.Context, arg DeleteAuthThrottlesBySubjectParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthThrottlesBySubject, arg.SubjectType, arg.Subject)
+	return err
+}
+
+const getAuthThrottle = `-- name: GetAuthThrottle :one
+SELECT scope, subject_type, subject, failed_attempts, lockout_count, locked_until, last_failed_at FROM auth_throttles
+WHERE scope = $1 AND subject_type = $2 AND subject = $3
+LIMIT 1
+`
+
+type GetAuthThrottleParams struct {
+	Scope       string `json:"scope"`
+	SubjectType string `json:"subject_type"`
+	Subject     string `json:"subject"`
+}
+
+func (q *Queries) GetAuthThrottle(ctx context.Context, arg GetAuthThrottleParams) (AuthThrottles, error) {
+	row := q.db.QueryRowContext(ctx, getAuthThrottle, arg.Scope, arg.SubjectType, arg.Subject)
+	var i AuthThrottles
+	err := row.Scan(
+		&i.Scope,
+		&i.SubjectType,
+		&i.Subject,
+		&i.FailedAttempts,
+		&i.LockoutCount,
+		&i.LockedUntil,
+		&i.LastFailedAt,
+	)
+	return i, err
+}
+
+const lockAuthThrottle = `-- name: LockAuthThrottle :one
+UPDATE auth_throttles
+SET failed_attempts = 0,
+    lockout_count = lockout_count + 1,
+    locked_until = $4
+WHERE scope = $1 AND subject_type = $2 AND subject = $3
+RETURNING scope, subject_type, subject, failed_attempts, lockout_count, locked_until, last_failed_at
+`
+
+type LockAuthThrottleParams struct {
+	Scope       string       `json:"scope"`
+	SubjectType string       `json:"subject_type"`
+	Subject     string       `json:"subject"`
+	LockedUntil sql.NullTime `json:"locked_until"`
+}
+
+func (q *Queries) LockAuthThrottle(ctx context.Context, arg LockAuthThrottleParams) (AuthThrottles, error) {
+	row := q.db.QueryRowContext(ctx, lockAuthThrottle,
+		arg.Scope,
+		arg.SubjectType,
+		arg.Subject,
+		arg.LockedUntil,
+	)
+	var i AuthThrottles
+	err := row.Scan(
+		&i.Scope,
+		&i.SubjectType,
+		&i.Subject,
+		&i.FailedAttempts,
+		&i.LockoutCount,
+		&i.LockedUntil,
+		&i.LastFailedAt,
+	)
+	return i, err
+}
+
+const recordAuthFailure = `-- name: RecordAuthFailure :one
+INSERT INTO auth_throttles (
+    scope, subject_type, subject, failed_attempts, last_failed_at
+) VALUES (
+    $1, $2, $3, 1, now()
+)
+ON CONFLICT (scope, subject_type, subject) DO UPDATE
+SET failed_attempts = CASE
+        WHEN auth_throttles.last_failed_at < $4 THEN 1
+        ELSE auth_throttles.failed_attempts + 1
+    END,
+    lockout_count = CASE
+        WHEN auth_throttles.last_failed_at < $4 THEN 0
+        ELSE auth_throttles.lockout_count
+    END,
+    last_failed_at = now()
+RETURNING scope, subject_type, subject, failed_attempts, lockout_count, locked_until, last_failed_at
+`
+
+type RecordAuthFailureParams struct {
+	Scope       string    `json:"scope"`
+	SubjectType string    `json:"subject_type"`
+	Subject     string    `json:"subject"`
+	WindowStart time.Time `json:"window_start"`
+}
+
+func (q *Queries) RecordAuthFailure(ctx context.Context, arg RecordAuthFailureParams) (AuthThrottles, error) {
+	row := q.db.QueryRowContext(ctx, recordAuthFailure,
+		arg.Scope,
+		arg.SubjectType,
+		arg.Subject,
+		arg.WindowStart,
+	)
+	var i AuthThrottles
+	err := row.Scan(
+		&i.Scope,
+		&i.SubjectType,
+		&i.Subject,
+		&i.FailedAttempts,
+		&i.LockoutCount,
+		&i.LockedUntil,
+		&i.LastFailedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,198 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: data_export.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const claimDataExport = `-- name: ClaimDataExport :one
+UPDATE data_exports
+SET status = 'building',
+    started_at = now()
+WHERE id = (
+    SELECT e.id FROM data_exports e
+    JOIN users u ON u.id = e.user_id
+    WHERE e.status = 'pending'
+       OR (e.status = 'building' AND e.started_at < $1)
+    ORDER BY e.created_at
+    LIMIT 1
+    FOR UPDATE OF e SKIP LOCKED
+)
+RETURNING id, user_id, status, file_name, size_bytes, download_token_hash, error, created_at, started_at, completed_at, expires_at
+`
+
+func (q *Queries) ClaimDataExport(ctx context.Context, staleBefore sql.NullTime) (DataExports, error) {
+	row := q.db.QueryRowContext(ctx, claimDataExport, staleBefore)
+	var i DataExports
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.FileName,
+		&i.SizeBytes,
+		&i.DownloadTokenHash,
+		&i.Error,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const completeDataExport = `-- name: CompleteDataExport :exec
+UPDATE data_exports
+SET status = 'ready',
+    file_name = $2,
+    size_bytes = $3,
+    download_token_hash = $4,
+    completed_at = now(),
+    expires_at = $5
+WHERE id = $1
+`
+
+type CompleteDataExportParams struct {
+	ID                uuid.UUID      `json:"id"`
+	FileName          sql.NullString `json:"file_name"`
+	SizeBytes         int64          `json:"size_bytes"`
+	DownloadTokenHash sql.NullString `json:"download_token_hash"`
+	ExpiresAt         sql.NullTime   `json:"expires_at"`
+}
+
+func (q *Queries) CompleteDataExport(ctx context.Context, arg CompleteDataExportParams) error {
+	_, err := q.db.ExecContext(ctx, completeDataExport,
+		arg.ID,
+		arg.FileName,
+		arg.SizeBytes,
+		arg.DownloadTokenHash,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createDataExport = `-- name: CreateDataExport :one
+INSERT INTO data_exports (
+    id,
+    user_id
+) VALUES (
+    $1, $2
+)
+RETURNING id, user_id, status, file_name, size_bytes, download_token_hash, error, created_at, started_at, completed_at, expires_at
+`
+
+type CreateDataExportParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) CreateDataExport(ctx context.Context, arg CreateDataExportParams) (DataExports, error) {
+	row := q.db.QueryRowContext(ctx, createDataExport, arg.ID, arg.UserID)
+	var i DataExports
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.FileName,
+		&i.SizeBytes,
+		&i.DownloadTokenHash,
+		&i.Error,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const deleteExpiredDataExports = `-- name: DeleteExpiredDataExports :many
+DELETE FROM data_exports
+WHERE expires_at <= $1
+   OR NOT EXISTS (SELECT 1 FROM users WHERE users.id = data_exports.user_id)
+RETURNING id, user_id, status, file_name, size_bytes, download_token_hash, error, created_at, started_at, completed_at, expires_at
+`
+
+func (q *Queries) DeleteExpiredDataExports(ctx context.Context, expiresAt sql.NullTime) ([]DataExports, error) {
+	rows, err := q.db.QueryContext(ctx, deleteExpiredDataExports, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DataExports{}
+	for rows.Next() {
+		var i DataExports
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Status,
+			&i.FileName,
+			&i.SizeBytes,
+			&i.DownloadTokenHash,
+			&i.Error,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const failDataExport = `-- name: FailDataExport :exec
+UPDATE data_exports
+SET status = 'failed',
+    error = $2,
+    completed_at = now(),
+    expires_at = $3
+WHERE id = $1
+`
+
+type FailDataExportParams struct {
+	ID        uuid.UUID      `json:"id"`
+	Error     sql.NullString `json:"error"`
+	ExpiresAt sql.NullTime   `json:"expires_at"`
+}
+
+func (q *Queries) FailDataExport(ctx context.Context, arg FailDataExportParams) error {
+	_, err := q.db.ExecContext(ctx, failDataExport, arg.ID, arg.Error, arg.ExpiresAt)
+	return err
+}
+
+const getDataExport = `-- name: GetDataExport :one
+SELECT id, user_id, status, file_name, size_bytes, download_token_hash, error, created_at, started_at, completed_at, expires_at FROM data_exports
+WHERE id = $1
+`
+
+func (q *Queries) GetDataExport(ctx context.Context, id uuid.UUID) (DataExports, error) {
+	row := q.db.QueryRowContext(ctx, getDataExport, id)
+	var i DataExports
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.FileName,
+		&i.SizeBytes,
+		&i.DownloadTokenHash,
+		&i.Error,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
@@ -0,0 +1,179 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: email_change.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const confirmEmailChange = `-- name: ConfirmEmailChange :execrows
+UPDATE email_changes
+SET
+    confirmed_at = now(),
+    revert_token_hash = $2,
+    revert_expires_at = $3
+WHERE id = $1 AND confirmed_at IS NULL
+`
+
+type ConfirmEmailChangeParams struct {
+	ID              uuid.UUID      `json:"id"`
+	RevertTokenHash sql.NullString `json:"revert_token_hash"`
+	RevertExpiresAt sql.NullTime   `json:"revert_expires_at"`
+}
+
+func (q *Queries) ConfirmEmailChange(ctx context.Context, arg ConfirmEmailChangeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, confirmEmailChange, arg.ID, arg.RevertTokenHash, arg.RevertExpiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createEmailChange = `-- name: CreateEmailChange :one
+INSERT INTO email_changes (
+    id,
+    user_id,
+    old_email,
+    new_email,
+    old_email_verified,
+    requested_ip,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, now()
+) RETURNING id, user_id, old_email, new_email, old_email_verified, requested_ip, revert_token_hash, revert_expires_at, created_at, confirmed_at, reverted_at
+`
+
+type CreateEmailChangeParams struct {
+	ID               uuid.UUID      `json:"id"`
+	UserID           uuid.UUID      `json:"user_id"`
+	OldEmail         string         `json:"old_email"`
+	NewEmail         string         `json:"new_email"`
+	OldEmailVerified bool           `json:"old_email_verified"`
+	RequestedIp      sql.NullString `json:"requested_ip"`
+}
+
+func (q *Queries) CreateEmailChange(ctx context.Context, arg CreateEmailChangeParams) (EmailChanges, error) {
+	row := q.db.QueryRowContext(ctx, createEmailChange,
+		arg.ID,
+		arg.UserID,
+		arg.OldEmail,
+		arg.NewEmail,
+		arg.OldEmailVerified,
+		arg.RequestedIp,
+	)
+	var i EmailChanges
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OldEmail,
+		&i.NewEmail,
+		&i.OldEmailVerified,
+		&i.RequestedIp,
+		&i.RevertTokenHash,
+		&i.RevertExpiresAt,
+		&i.CreatedAt,
+		&i.ConfirmedAt,
+		&i.RevertedAt,
+	)
+	return i, err
+}
+
+const deletePendingEmailChanges = `-- name: DeletePendingEmailChanges :exec
+DELETE FROM email_changes
+WHERE user_id = $1 AND confirmed_at IS NULL
+`
+
+func (q *Queries) DeletePendingEmailChanges(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deletePendingEmailChanges, userID)
+	return err
+}
+
+const getEmailChangeByRevertToken = `-- name: GetEmailChangeByRevertToken :one
+SELECT id, user_id, old_email, new_email, old_email_verified, requested_ip, revert_token_hash, revert_expires_at, created_at, confirmed_at, reverted_at FROM email_changes
+WHERE revert_token_hash = $1
+`
+
+func (q *Queries) GetEmailChangeByRevertToken(ctx context.Context, revertTokenHash sql.NullString) (EmailChanges, error) {
+	row := q.db.QueryRowContext(ctx, getEmailChangeByRevertToken, revertTokenHash)
+	var i EmailChanges
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OldEmail,
+		&i.NewEmail,
+		&i.OldEmailVerified,
+		&i.RequestedIp,
+		&i.RevertTokenHash,
+		&i.RevertExpiresAt,
+		&i.CreatedAt,
+		&i.ConfirmedAt,
+		&i.RevertedAt,
+	)
+	return i, err
+}
+
+const getPendingEmailChange = `-- name: GetPendingEmailChange :one
+SELECT id, user_id, old_email, new_email, old_email_verified, requested_ip, revert_token_hash, revert_expires_at, created_at, confirmed_at, reverted_at FROM email_changes
+WHERE user_id = $1 AND confirmed_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetPendingEmailChange(ctx context.Context, userID uuid.UUID) (EmailChanges, error) {
+	row := q.db.QueryRowContext(ctx, getPendingEmailChange, userID)
+	var i EmailChanges
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OldEmail,
+		&i.NewEmail,
+		&i.OldEmailVerified,
+		&i.RequestedIp,
+		&i.RevertTokenHash,
+		&i.RevertExpiresAt,
+		&i.CreatedAt,
+		&i.ConfirmedAt,
+		&i.RevertedAt,
+	)
+	return i, err
+}
+
+const markEmailChangeReverted = `-- name: MarkEmailChangeReverted :execrows
+UPDATE email_changes
+SET reverted_at = now()
+WHERE id = $1 AND reverted_at IS NULL AND revert_expires_at > now()
+`
+
+func (q *Queries) MarkEmailChangeReverted(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markEmailChangeReverted, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateUserEmail = `-- name: UpdateUserEmail :exec
+UPDATE users
+SET
+    email = $2,
+    email_verified = $3,
+    updated_at = now()
+WHERE id = $1
+`
+
+type UpdateUserEmailParams struct {
+	ID            uuid.UUID    `json:"id"`
+	Email         string       `json:"email"`
+	EmailVerified sql.NullBool `json:"email_verified"`
+}
+
+func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserEmail, arg.ID, arg.Email, arg.EmailVerified)
+	return err
+}
@@ -0,0 +1,121 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: magic_link.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createMagicLink = `-- name: CreateMagicLink :one
+INSERT INTO magic_links (
+    id, user_id, token_hash, expires_at, requested_ip, requested_user_agent
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, user_id, token_hash, expires_at, created_at, requested_ip, requested_user_agent, used_at, used_ip, used_user_agent
+`
+
+type CreateMagicLinkParams struct {
+	ID                 uuid.UUID `json:"id"`
+	UserID             uuid.UUID `json:"user_id"`
+	TokenHash          string    `json:"token_hash"`
+	ExpiresAt          time.Time `json:"expires_at"`
+	RequestedIp        string    `json:"requested_ip"`
+	RequestedUserAgent string    `json:"requested_user_agent"`
+}
+
+func (q *Queries) CreateMagicLink(ctx context.Context, arg CreateMagicLinkParams) (MagicLinks, error) {
+	row := q.db.QueryRowContext(ctx, createMagicLink,
+		arg.ID,
+		arg.UserID,
+		arg.TokenHash,
+		arg.ExpiresAt,
+		arg.RequestedIp,
+		arg.RequestedUserAgent,
+	)
+	var i MagicLinks
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.RequestedIp,
+		&i.RequestedUserAgent,
+		&i.UsedAt,
+		&i.UsedIp,
+		&i.UsedUserAgent,
+	)
+	return i, err
+}
+
+const deleteExpiredMagicLinks = `-- name: DeleteExpiredMagicLinks :exec
+DELETE FROM magic_links
+WHERE expires_at < now() - INTERVAL '7 days'
+`
+
+func (q *Queries) DeleteExpiredMagicLinks(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredMagicLinks)
+	return err
+}
+
+const expireMagicLinksByUserID = `-- name: ExpireMagicLinksByUserID :exec
+UPDATE magic_links
+SET expires_at = now()
+WHERE user_id = $1 AND used_at IS NULL AND expires_at > now()
+`
+
+func (q *Queries) ExpireMagicLinksByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, expireMagicLinksByUserID, userID)
+	return err
+}
+
+const getMagicLinkByHash = `-- name: GetMagicLinkByHash :one
+SELECT id, user_id, token_hash, expires_at, created_at, requested_ip, requested_user_agent, used_at, used_ip, used_user_agent FROM magic_links
+WHERE token_hash = $1
+LIMIT 1
+`
+
+func (q *Queries) GetMagicLinkByHash(ctx context.Context, tokenHash string) (MagicLinks, error) {
+	row := q.db.QueryRowContext(ctx, getMagicLinkByHash, tokenHash)
+	var i MagicLinks
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.RequestedIp,
+		&i.RequestedUserAgent,
+		&i.UsedAt,
+		&i.UsedIp,
+		&i.UsedUserAgent,
+	)
+	return i, err
+}
+
+const markMagicLinkUsed = `-- name: MarkMagicLinkUsed :execrows
+UPDATE magic_links
+SET used_at = now(), used_ip = $2, used_user_agent = $3
+WHERE id = $1 AND used_at IS NULL AND expires_at > now()
+`
+
+type MarkMagicLinkUsedParams struct {
+	ID            uuid.UUID      `json:"id"`
+	UsedIp        sql.NullString `json:"used_ip"`
+	UsedUserAgent sql.NullString `json:"used_user_agent"`
+}
+
+func (q *Queries) MarkMagicLinkUsed(ctx context.Context, arg MarkMagicLinkUsedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markMagicLinkUsed, arg.ID, arg.UsedIp, arg.UsedUserAgent)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,302 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: mfa.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const consumeMfaChallenge = `-- name: ConsumeMfaChallenge :execrows
+UPDATE mfa_challenges
+SET consumed_at = now()
+WHERE id = $1 AND consumed_at IS NULL AND expires_at > now()
+`
+
+func (q *Queries) ConsumeMfaChallenge(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, consumeMfaChallenge, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countUnusedRecoveryCodes = `-- name: CountUnusedRecoveryCodes :one
+SELECT COUNT(*) FROM mfa_recovery_codes
+WHERE user_id = $1 AND used_at IS NULL
+`
+
+func (q *Queries) CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnusedRecoveryCodes, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createMfaChallenge = `-- name: CreateMfaChallenge :one
+INSERT INTO mfa_challenges (
+    id, user_id, ip_address, user_agent, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, user_id, attempts, ip_address, user_agent, expires_at, consumed_at, created_at
+`
+
+type CreateMfaChallengeParams struct {
+	ID        uuid.UUID      `json:"id"`
+	UserID    uuid.UUID      `json:"user_id"`
+	IpAddress sql.NullString `json:"ip_address"`
+	UserAgent sql.NullString `json:"user_agent"`
+	ExpiresAt time.Time      `json:"expires_at"`
+}
+
+func (q *Queries) CreateMfaChallenge(ctx context.Context, arg CreateMfaChallengeParams) (MfaChallenges, error) {
+	row := q.db.QueryRowContext(ctx, createMfaChallenge,
+		arg.ID,
+		arg.UserID,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.ExpiresAt,
+	)
+	var i MfaChallenges
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Attempts,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.ExpiresAt,
+		&i.ConsumedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createRecoveryCode = `-- name: CreateRecoveryCode :one
+INSERT INTO mfa_recovery_codes (
+    id, user_id, code_hash
+) VALUES (
+    $1, $2, $3
+) RETURNING id, user_id, code_hash, used_at, created_at
+`
+
+type CreateRecoveryCodeParams struct {
+	ID       uuid.UUID `json:"id"`
+	UserID   uuid.UUID `json:"user_id"`
+	CodeHash string    `json:"code_hash"`
+}
+
+func (q *Queries) CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) (MfaRecoveryCodes, error) {
+	row := q.db.QueryRowContext(ctx, createRecoveryCode, arg.ID, arg.UserID, arg.CodeHash)
+	var i MfaRecoveryCodes
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CodeHash,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteExpiredMfaChallenges = `-- name: DeleteExpiredMfaChallenges :exec
+DELETE FROM mfa_challenges
+WHERE expires_at < now() - INTERVAL '1 day'
+`
+
+func (q *Queries) DeleteExpiredMfaChallenges(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredMfaChallenges)
+	return err
+}
+
+const deleteRecoveryCodesByUserID = `-- name: DeleteRecoveryCodesByUserID :exec
+DELETE FROM mfa_recovery_codes
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRecoveryCodesByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteRecoveryCodesByUserID, userID)
+	return err
+}
+
+const deleteUserMfa = `-- name: DeleteUserMfa :exec
+DELETE FROM user_mfa
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserMfa(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteUserMfa, userID)
+	return err
+}
+
+const enableUserMfa = `-- name: EnableUserMfa :one
+UPDATE user_mfa
+SET
+    enabled = true,
+    confirmed_at = now()
+WHERE user_id = $1
+RETURNING user_id, secret, enabled, confirmed_at, last_used_step, created_at, updated_at
+`
+
+func (q *Queries) EnableUserMfa(ctx context.Context, userID uuid.UUID) (UserMfa, error) {
+	row := q.db.QueryRowContext(ctx, enableUserMfa, userID)
+	var i UserMfa
+	err := row.Scan(
+		&i.UserID,
+		&i.Secret,
+		&i.Enabled,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getMfaChallenge = `-- name: GetMfaChallenge :one
+SELECT id, user_id, attempts, ip_address, user_agent, expires_at, consumed_at, created_at FROM mfa_challenges
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetMfaChallenge(ctx context.Context, id uuid.UUID) (MfaChallenges, error) {
+	row := q.db.QueryRowContext(ctx, getMfaChallenge, id)
+	var i MfaChallenges
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Attempts,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.ExpiresAt,
+		&i.ConsumedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUserMfa = `-- name: GetUserMfa :one
+SELECT user_id, secret, enabled, confirmed_at, last_used_step, created_at, updated_at FROM user_mfa
+WHERE user_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserMfa(ctx context.Context, userID uuid.UUID) (UserMfa, error) {
+	row := q.db.QueryRowContext(ctx, getUserMfa, userID)
+	var i UserMfa
+	err := row.Scan(
+		&i.UserID,
+		&i.Secret,
+		&i.Enabled,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const incrementMfaChallengeAttempts = `-- name: IncrementMfaChallengeAttempts :one
+UPDATE mfa_challenges
+SET attempts = attempts + 1
+WHERE id = $1
+RETURNING id, user_id, attempts, ip_address, user_agent, expires_at, consumed_at, created_at
+`
+
+func (q *Queries) IncrementMfaChallengeAttempts(ctx context.Context, id uuid.UUID) (MfaChallenges, error) {
+	row := q.db.QueryRowContext(ctx, incrementMfaChallengeAttempts, id)
+	var i MfaChallenges
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Attempts,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.ExpiresAt,
+		&i.ConsumedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateMfaLastUsedStep = `-- name: UpdateMfaLastUsedStep :execrows
+UPDATE user_mfa
+SET last_used_step = $2
+WHERE user_id = $1 AND last_used_step < $2
+`
+
+type UpdateMfaLastUsedStepParams struct {
+	UserID       uuid.UUID `json:"user_id"`
+	LastUsedStep int64     `json:"last_used_step"`
+}
+
+func (q *Queries) UpdateMfaLastUsedStep(ctx context.Context, arg UpdateMfaLastUsedStepParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateMfaLastUsedStep, arg.UserID, arg.LastUsedStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const upsertUserMfa = `-- name: UpsertUserMfa :one
+INSERT INTO user_mfa (
+    user_id,
+    secret,
+    enabled,
+    confirmed_at,
+    last_used_step
+) VALUES (
+    $1, $2, false, NULL, 0
+)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    secret = EXCLUDED.secret,
+    enabled = false,
+    confirmed_at = NULL,
+    last_used_step = 0
+RETURNING user_id, secret, enabled, confirmed_at, last_used_step, created_at, updated_at
+`
+
+type UpsertUserMfaParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Secret string    `json:"secret"`
+}
+
+func (q *Queries) UpsertUserMfa(ctx context.Context, arg UpsertUserMfaParams) (UserMfa, error) {
+	row := q.db.QueryRowContext(ctx, upsertUserMfa, arg.UserID, arg.Secret)
+	var i UserMfa
+	err := row.Scan(
+		&i.UserID,
+		&i.Secret,
+		&i.Enabled,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE mfa_recovery_codes
+SET used_at = now()
+WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+`
+
+type UseRecoveryCodeParams struct {
+	UserID   uuid.UUID `json:"user_id"`
+	CodeHash string    `json:"code_hash"`
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, useRecoveryCode, arg.UserID, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
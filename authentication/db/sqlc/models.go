@@ -6,12 +6,253 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
 )
 
+type AccountErasureServices struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Service string    `json:"service"`
+	// pending until the service reports completed or failed.
+	Status string `json:"status"`
+	// How many records the service removed or anonymized.
+	Items     int64          `json:"items"`
+	Detail    sql.NullString `json:"detail"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+type AccountErasures struct {
+	UserID      uuid.UUID `json:"user_id"`
+	RequestedAt time.Time `json:"requested_at"`
+	DeletedAt   time.Time `json:"deleted_at"`
+	// When the user.deleted event was published; NULL until it has been.
+	PublishedAt sql.NullTime `json:"published_at"`
+}
+
+type AgentVerificationDocuments struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// id_document, license or business_registration.
+	DocumentType string `json:"document_type"`
+	// Signed URL of the document, which is stored as an authenticated asset.
+	Url        string    `json:"url"`
+	UploadedAt time.Time `json:"uploaded_at"`
+}
+
+type AgentVerifications struct {
+	UserID             uuid.UUID      `json:"user_id"`
+	LicenseNumber      string         `json:"license_number"`
+	BusinessName       string         `json:"business_name"`
+	BusinessAddress    string         `json:"business_address"`
+	BusinessPhone      sql.NullString `json:"business_phone"`
+	RegistrationNumber sql.NullString `json:"registration_number"`
+	// pending, approved, rejected or more_info_requested.
+	Status string `json:"status"`
+	// Why the verification was rejected or what more is needed, shown to the agent.
+	ReviewNote  sql.NullString `json:"review_note"`
+	ReviewedBy  uuid.NullUUID  `json:"reviewed_by"`
+	ReviewedAt  sql.NullTime   `json:"reviewed_at"`
+	SubmittedAt time.Time      `json:"submitted_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+type ApiKeys struct {
+	ID uuid.UUID `json:"id"`
+	// User who created the key; the key acts as them.
+	UserID uuid.UUID `json:"user_id"`
+	// Organization the key belongs to and acts for, if any.
+	OrganizationID uuid.NullUUID `json:"organization_id"`
+	Name           string        `json:"name"`
+	// Start of the key, shown so it can be recognised.
+	Prefix string `json:"prefix"`
+	// SHA-256 of the full key.
+	KeyHash string `json:"key_hash"`
+	// Permissions the key grants, limited to those of its user.
+	Scopes    []string     `json:"scopes"`
+	ExpiresAt sql.NullTime `json:"expires_at"`
+	// When the key was last exchanged for an access token.
+	LastUsedAt sql.NullTime `json:"last_used_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+	RevokedAt  sql.NullTime `json:"revoked_at"`
+}
+
+type AuthEvents struct {
+	ID uuid.UUID `json:"id"`
+	// What happened, e.g. login, otp_verification, password_change or session_revoked.
+	EventType string `json:"event_type"`
+	// Whether the attempt succeeded: success or failure.
+	Outcome string `json:"outcome"`
+	// Who performed the action: the user themselves or an administrator; NULL when the caller is unknown.
+	ActorID uuid.NullUUID `json:"actor_id"`
+	// The account the event is about. Not a foreign key so events outlive deleted accounts.
+	UserID uuid.NullUUID `json:"user_id"`
+	// The email the attempt was made for, kept for failed logins to unknown accounts.
+	Email     sql.NullString `json:"email"`
+	SessionID uuid.NullUUID  `json:"session_id"`
+	IpAddress sql.NullString `json:"ip_address"`
+	UserAgent sql.NullString `json:"user_agent"`
+	// Event specific details such as the login method or failure reason.
+	Metadata  json.RawMessage `json:"metadata"`
+	CreatedAt time.Time       `json:"created_at"`
+	// Browser and operating system parsed from the user agent, e.g. Chrome on macOS.
+	Device sql.NullString `json:"device"`
+	// Hash of the parsed device, used to recognise logins from a device the user has used before.
+	DeviceFingerprint sql.NullString `json:"device_fingerprint"`
+	// Coarse location of the IP address from the GeoIP database, e.g. Lagos, Nigeria.
+	Location sql.NullString `json:"location"`
+}
+
+type AuthThrottles struct {
+	// Endpoint being protected, e.g. login or otp.
+	Scope string `json:"scope"`
+	// What the counter is keyed on: account (email) or ip.
+	SubjectType string `json:"subject_type"`
+	Subject     string `json:"subject"`
+	// Consecutive failures since the last lockout or success.
+	FailedAttempts int32 `json:"failed_attempts"`
+	// Lockouts within the failure window; each one doubles the next lockout duration.
+	LockoutCount int32 `json:"lockout_count"`
+	// Attempts are rejected until this time.
+	LockedUntil  sql.NullTime `json:"locked_until"`
+	LastFailedAt time.Time    `json:"last_failed_at"`
+}
+
+type DataExports struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// pending, building, ready or failed.
+	Status string `json:"status"`
+	// Name of the ZIP file in the export storage once it is ready.
+	FileName  sql.NullString `json:"file_name"`
+	SizeBytes int64          `json:"size_bytes"`
+	// SHA-256 of the token in the emailed download link.
+	DownloadTokenHash sql.NullString `json:"download_token_hash"`
+	Error             sql.NullString `json:"error"`
+	CreatedAt         time.Time      `json:"created_at"`
+	// When building started; a build that never finishes is picked up again.
+	StartedAt   sql.NullTime `json:"started_at"`
+	CompletedAt sql.NullTime `json:"completed_at"`
+	// When the download link stops working and the file is removed.
+	ExpiresAt sql.NullTime `json:"expires_at"`
+}
+
+type EmailChanges struct {
+	ID       uuid.UUID `json:"id"`
+	UserID   uuid.UUID `json:"user_id"`
+	OldEmail string    `json:"old_email"`
+	NewEmail string    `json:"new_email"`
+	// Whether the old address was verified, restored if the change is reverted.
+	OldEmailVerified bool           `json:"old_email_verified"`
+	RequestedIp      sql.NullString `json:"requested_ip"`
+	// SHA-256 of the token in the revert link sent to the old address once the change is confirmed.
+	RevertTokenHash sql.NullString `json:"revert_token_hash"`
+	// Timestamp after which the change can no longer be reverted from the old address.
+	RevertExpiresAt sql.NullTime `json:"revert_expires_at"`
+	CreatedAt       time.Time    `json:"created_at"`
+	// Timestamp of when the code sent to the new address was entered and the email switched.
+	ConfirmedAt sql.NullTime `json:"confirmed_at"`
+	// Timestamp of when the old address undid the change.
+	RevertedAt sql.NullTime `json:"reverted_at"`
+}
+
+type MagicLinks struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// SHA-256 hash of the token emailed to the user; the token itself is never stored.
+	TokenHash string `json:"token_hash"`
+	// The link cannot be used after this time. Requesting a new link expires the older ones.
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	// IP address the link was requested from.
+	RequestedIp        string `json:"requested_ip"`
+	RequestedUserAgent string `json:"requested_user_agent"`
+	// When the link was exchanged for a session; a link can only be used once.
+	UsedAt sql.NullTime `json:"used_at"`
+	// IP address the link was used from.
+	UsedIp        sql.NullString `json:"used_ip"`
+	UsedUserAgent sql.NullString `json:"used_user_agent"`
+}
+
+type MfaChallenges struct {
+	// Opaque challenge token handed to the client after the first factor
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// Number of failed second factor attempts
+	Attempts  int32          `json:"attempts"`
+	IpAddress sql.NullString `json:"ip_address"`
+	UserAgent sql.NullString `json:"user_agent"`
+	// Timestamp after which the challenge can no longer be completed
+	ExpiresAt time.Time `json:"expires_at"`
+	// Timestamp of when the challenge was completed
+	ConsumedAt sql.NullTime `json:"consumed_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type MfaRecoveryCodes struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// SHA-256 hash of the normalised recovery code
+	CodeHash string `json:"code_hash"`
+	// Timestamp of when the code was redeemed
+	UsedAt    sql.NullTime `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type OauthStates struct {
+	// SHA-256 hash of the state parameter sent to the provider.
+	StateHash string `json:"state_hash"`
+	Provider  string `json:"provider"`
+	// PKCE code verifier; only its S256 challenge leaves the service.
+	CodeVerifier string `json:"code_verifier"`
+	// Nonce the provider must echo back in its ID token.
+	Nonce     string    `json:"nonce"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type OrganizationInvitations struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Email          string    `json:"email"`
+	Role           string    `json:"role"`
+	// SHA-256 of the token in the emailed invitation link.
+	TokenHash string        `json:"token_hash"`
+	InvitedBy uuid.NullUUID `json:"invited_by"`
+	// pending, accepted, declined or revoked.
+	Status      string       `json:"status"`
+	CreatedAt   time.Time    `json:"created_at"`
+	ExpiresAt   time.Time    `json:"expires_at"`
+	RespondedAt sql.NullTime `json:"responded_at"`
+}
+
+type OrganizationMembers struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	// owner, manager or agent.
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type Organizations struct {
+	ID        uuid.UUID     `json:"id"`
+	Name      string        `json:"name"`
+	CreatedBy uuid.NullUUID `json:"created_by"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+type PasswordHistory struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// Hash of a password the user replaced, checked to stop it being reused.
+	PasswordHash string `json:"password_hash"`
+	// Timestamp of when the password was replaced.
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type PasswordResets struct {
 	ID        uuid.UUID    `json:"id"`
 	UserID    uuid.UUID    `json:"user_id"`
@@ -22,6 +263,35 @@ type PasswordResets struct {
 	Used      bool         `json:"used"`
 }
 
+type RefreshTokens struct {
+	ID uuid.UUID `json:"id"`
+	// Session the refresh token was issued for.
+	SessionID uuid.UUID `json:"session_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	// Groups every token rotated from the same login so the chain can be revoked together.
+	FamilyID uuid.UUID `json:"family_id"`
+	// The refresh token this one was rotated from.
+	ParentID uuid.NullUUID `json:"parent_id"`
+	// SHA-256 hash of the opaque refresh token.
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// Set when the token is exchanged; presenting it again is treated as reuse.
+	UsedAt sql.NullTime `json:"used_at"`
+	// Set when the token family is revoked.
+	RevokedAt sql.NullTime `json:"revoked_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type RevokedSessions struct {
+	// Session whose access tokens are no longer accepted.
+	SessionID uuid.UUID `json:"session_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	// Why the session was revoked, e.g. logged_out, deactivated or deleted.
+	Reason string `json:"reason"`
+	// Access tokens of the session issued up to this time are rejected.
+	RevokedAt time.Time `json:"revoked_at"`
+}
+
 type Sessions struct {
 	// Unique identifier for each session.
 	SessionID uuid.UUID `json:"session_id"`
@@ -53,6 +323,53 @@ type Sessions struct {
 	RevokedAt sql.NullTime `json:"revoked_at"`
 	// Stores additional device details if needed.
 	DeviceInfo pqtype.NullRawMessage `json:"device_info"`
+	// The administrator acting as the user in this session; NULL for the user's own sessions.
+	ImpersonatorID uuid.NullUUID `json:"impersonator_id"`
+	// Why the administrator started the impersonation, shown to the user in their login history.
+	ImpersonationReason sql.NullString `json:"impersonation_reason"`
+	// Organization the session acts for, if any.
+	OrganizationID uuid.NullUUID `json:"organization_id"`
+}
+
+type TokenSigningKeys struct {
+	// PASERK k4.pid of the public key, sent as the kid in token footers.
+	ID string `json:"id"`
+	// PASERK k4.public encoding of the Ed25519 public key.
+	PublicKey string `json:"public_key"`
+	// Ed25519 private key sealed with TOKEN_SYMMETRIC_KEY.
+	EncryptedPrivateKey []byte    `json:"encrypted_private_key"`
+	CreatedAt           time.Time `json:"created_at"`
+	// NULL for the active key; retired keys verify tokens until then.
+	ExpiresAt sql.NullTime `json:"expires_at"`
+}
+
+type UserIdentities struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// OAuth provider the identity belongs to (google, apple, github, etc.)
+	Provider string `json:"provider"`
+	// Subject of the user at the provider; unique per provider.
+	ProviderUserID string `json:"provider_user_id"`
+	// Email reported by the provider when the identity was linked.
+	Email     sql.NullString `json:"email"`
+	CreatedAt time.Time      `json:"created_at"`
+	// Last time the identity was used to sign in.
+	LastUsedAt sql.NullTime `json:"last_used_at"`
+}
+
+type UserMfa struct {
+	// User the TOTP factor belongs to
+	UserID uuid.UUID `json:"user_id"`
+	// Base32 encoded TOTP shared secret
+	Secret string `json:"secret"`
+	// Indicates if the factor has been confirmed and is enforced at login
+	Enabled bool `json:"enabled"`
+	// Timestamp of when enrollment was confirmed
+	ConfirmedAt sql.NullTime `json:"confirmed_at"`
+	// Last accepted TOTP time step, used to reject replayed codes
+	LastUsedStep int64     `json:"last_used_step"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
 }
 
 type Users struct {
@@ -70,14 +387,10 @@ type Users struct {
 	Email string `json:"email"`
 	// Hashed password (nullable for OAuth users)
 	Password sql.NullString `json:"password"`
-	// Role (admin, user, etc.)
+	// Role (buyer, seller, agent, admin)
 	Role sql.NullString `json:"role"`
 	// Contact number
 	Phone sql.NullString `json:"phone"`
-	// Authentication provider (local, google, github, etc.)
-	Provider sql.NullString `json:"provider"`
-	// User ID from OAuth provider (unique)
-	ProviderID sql.NullString `json:"provider_id"`
 	// Indicates if email is verified
 	EmailVerified sql.NullBool `json:"email_verified"`
 	// Indicates if user is active
@@ -88,4 +401,40 @@ type Users struct {
 	CreatedAt sql.NullTime `json:"created_at"`
 	// Timestamp of last update
 	UpdatedAt sql.NullTime `json:"updated_at"`
+	// Timestamp of when an administrator locked the account; locked accounts cannot sign in.
+	LockedAt sql.NullTime `json:"locked_at"`
+	// Why an administrator locked the account, shown to support staff.
+	LockedReason sql.NullString `json:"locked_reason"`
+	// Timestamp of when the account was soft-deleted; it can be restored until it is purged.
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	// When the user asked for their account to be deleted.
+	DeletionRequestedAt sql.NullTime `json:"deletion_requested_at"`
+	// When the account will be erased; signing in before then cancels the deletion.
+	DeletionScheduledFor sql.NullTime `json:"deletion_scheduled_for"`
+	// When the agent's identity and license were approved.
+	AgentVerifiedAt sql.NullTime `json:"agent_verified_at"`
+}
+
+type VerificationChallenges struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// What the code proves: signup, password_reset, email_change or login.
+	Purpose string `json:"purpose"`
+	// Hex encoded SHA-256 of the salt and the code; the code itself is never stored.
+	CodeHash string `json:"code_hash"`
+	// Random per-challenge salt mixed into code_hash.
+	Salt string `json:"salt"`
+	// Number of wrong codes entered for this challenge.
+	Attempts    int32          `json:"attempts"`
+	MaxAttempts int32          `json:"max_attempts"`
+	IpAddress   sql.NullString `json:"ip_address"`
+	UserAgent   sql.NullString `json:"user_agent"`
+	ExpiresAt   time.Time      `json:"expires_at"`
+	// Timestamp of when the correct code was entered.
+	VerifiedAt sql.NullTime `json:"verified_at"`
+	// Timestamp of when the challenge was used up or superseded by a newer one.
+	ConsumedAt sql.NullTime `json:"consumed_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+	// SHA-256 of the single-use token issued once a password reset code is verified.
+	ResetTokenHash sql.NullString `json:"reset_token_hash"`
 }
@@ -0,0 +1,82 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: oauth_state.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const consumeOAuthState = `-- name: ConsumeOAuthState :one
+DELETE FROM oauth_states
+WHERE state_hash = $1
+RETURNING state_hash, provider, code_verifier, nonce, created_at, expires_at
+`
+
+func (q *Queries) ConsumeOAuthState(ctx context.Context, stateHash string) (OauthStates, error) {
+	row := q.db.QueryRowContext(ctx, consumeOAuthState, stateHash)
+	var i OauthStates
+	err := row.Scan(
+		&i.StateHash,
+		&i.Provider,
+		&i.CodeVerifier,
+		&i.Nonce,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const createOAuthState = `-- name: CreateOAuthState :one
+INSERT INTO oauth_states (
+    state_hash,
+    provider,
+    code_verifier,
+    nonce,
+    created_at,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, now(), $5
+) RETURNING state_hash, provider, code_verifier, nonce, created_at, expires_at
+`
+
+type CreateOAuthStateParams struct {
+	StateHash    string    `json:"state_hash"`
+	Provider     string    `json:"provider"`
+	CodeVerifier string    `json:"code_verifier"`
+	Nonce        string    `json:"nonce"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreateOAuthState(ctx context.Context, arg CreateOAuthStateParams) (OauthStates, error) {
+	row := q.db.QueryRowContext(ctx, createOAuthState,
+		arg.StateHash,
+		arg.Provider,
+		arg.CodeVerifier,
+		arg.Nonce,
+		arg.ExpiresAt,
+	)
+	var i OauthStates
+	err := row.Scan(
+		&i.StateHash,
+		&i.Provider,
+		&i.CodeVerifier,
+		&i.Nonce,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const deleteExpiredOAuthStates = `-- name: DeleteExpiredOAuthStates :exec
+DELETE FROM oauth_states
+WHERE expires_at < now()
+`
+
+func (q *Queries) DeleteExpiredOAuthStates(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredOAuthStates)
+	return err
+}
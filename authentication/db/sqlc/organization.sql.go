@@ -0,0 +1,549 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: organization.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const addOrganizationMember = `-- name: AddOrganizationMember :one
+INSERT INTO organization_members (
+    organization_id,
+    user_id,
+    role
+) VALUES (
+    $1, $2, $3
+) RETURNING organization_id, user_id, role, joined_at
+`
+
+type AddOrganizationMemberParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Role           string    `json:"role"`
+}
+
+func (q *Queries) AddOrganizationMember(ctx context.Context, arg AddOrganizationMemberParams) (OrganizationMembers, error) {
+	row := q.db.QueryRowContext(ctx, addOrganizationMember, arg.OrganizationID, arg.UserID, arg.Role)
+	var i OrganizationMembers
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Role,
+		&i.JoinedAt,
+	)
+	return i, err
+}
+
+const clearSessionOrganization = `-- name: ClearSessionOrganization :exec
+UPDATE sessions
+SET organization_id = NULL
+WHERE user_id = $1 AND organization_id = $2
+`
+
+type ClearSessionOrganizationParams struct {
+	UserID         uuid.UUID     `json:"user_id"`
+	OrganizationID uuid.NullUUID `json:"organization_id"`
+}
+
+func (q *Queries) ClearSessionOrganization(ctx context.Context, arg ClearSessionOrganizationParams) error {
+	_, err := q.db.ExecContext(ctx, clearSessionOrganization, arg.UserID, arg.OrganizationID)
+	return err
+}
+
+const countOrganizationOwners = `-- name: CountOrganizationOwners :one
+SELECT count(*) FROM organization_members
+WHERE organization_id = $1 AND role = 'owner'
+`
+
+func (q *Queries) CountOrganizationOwners(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOrganizationOwners, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createOrganization = `-- name: CreateOrganization :one
+INSERT INTO organizations (
+    id,
+    name,
+    created_by
+) VALUES (
+    $1, $2, $3
+) RETURNING id, name, created_by, created_at, updated_at
+`
+
+type CreateOrganizationParams struct {
+	ID        uuid.UUID     `json:"id"`
+	Name      string        `json:"name"`
+	CreatedBy uuid.NullUUID `json:"created_by"`
+}
+
+func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organizations, error) {
+	row := q.db.QueryRowContext(ctx, createOrganization, arg.ID, arg.Name, arg.CreatedBy)
+	var i Organizations
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createOrganizationInvitation = `-- name: CreateOrganizationInvitation :one
+INSERT INTO organization_invitations (
+    id,
+    organization_id,
+    email,
+    role,
+    token_hash,
+    invited_by,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, organization_id, email, role, token_hash, invited_by, status, created_at, expires_at, responded_at
+`
+
+type CreateOrganizationInvitationParams struct {
+	ID             uuid.UUID     `json:"id"`
+	OrganizationID uuid.UUID     `json:"organization_id"`
+	Email          string        `json:"email"`
+	Role           string        `json:"role"`
+	TokenHash      string        `json:"token_hash"`
+	InvitedBy      uuid.NullUUID `json:"invited_by"`
+	ExpiresAt      time.Time     `json:"expires_at"`
+}
+
+func (q *Queries) CreateOrganizationInvitation(ctx context.Context, arg CreateOrganizationInvitationParams) (OrganizationInvitations, error) {
+	row := q.db.QueryRowContext(ctx, createOrganizationInvitation,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Email,
+		arg.Role,
+		arg.TokenHash,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+	)
+	var i OrganizationInvitations
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+	)
+	return i, err
+}
+
+const deleteOrganizationMember = `-- name: DeleteOrganizationMember :execrows
+DELETE FROM organization_members
+WHERE organization_id = $1 AND user_id = $2
+`
+
+type DeleteOrganizationMemberParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOrganizationMember, arg.OrganizationID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getOrganization = `-- name: GetOrganization :one
+SELECT id, name, created_by, created_at, updated_at FROM organizations
+WHERE id = $1
+`
+
+func (q *Queries) GetOrganization(ctx context.Context, id uuid.UUID) (Organizations, error) {
+	row := q.db.QueryRowContext(ctx, getOrganization, id)
+	var i Organizations
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOrganizationInvitation = `-- name: GetOrganizationInvitation :one
+SELECT i.id, i.organization_id, i.email, i.role, i.token_hash, i.invited_by, i.status, i.created_at, i.expires_at, i.responded_at, o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.id = $1
+`
+
+type GetOrganizationInvitationRow struct {
+	ID               uuid.UUID     `json:"id"`
+	OrganizationID   uuid.UUID     `json:"organization_id"`
+	Email            string        `json:"email"`
+	Role             string        `json:"role"`
+	TokenHash        string        `json:"token_hash"`
+	InvitedBy        uuid.NullUUID `json:"invited_by"`
+	Status           string        `json:"status"`
+	CreatedAt        time.Time     `json:"created_at"`
+	ExpiresAt        time.Time     `json:"expires_at"`
+	RespondedAt      sql.NullTime  `json:"responded_at"`
+	OrganizationName string        `json:"organization_name"`
+}
+
+func (q *Queries) GetOrganizationInvitation(ctx context.Context, id uuid.UUID) (GetOrganizationInvitationRow, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationInvitation, id)
+	var i GetOrganizationInvitationRow
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.OrganizationName,
+	)
+	return i, err
+}
+
+const getOrganizationInvitationByToken = `-- name: GetOrganizationInvitationByToken :one
+SELECT i.id, i.organization_id, i.email, i.role, i.token_hash, i.invited_by, i.status, i.created_at, i.expires_at, i.responded_at, o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.token_hash = $1
+`
+
+type GetOrganizationInvitationByTokenRow struct {
+	ID               uuid.UUID     `json:"id"`
+	OrganizationID   uuid.UUID     `json:"organization_id"`
+	Email            string        `json:"email"`
+	Role             string        `json:"role"`
+	TokenHash        string        `json:"token_hash"`
+	InvitedBy        uuid.NullUUID `json:"invited_by"`
+	Status           string        `json:"status"`
+	CreatedAt        time.Time     `json:"created_at"`
+	ExpiresAt        time.Time     `json:"expires_at"`
+	RespondedAt      sql.NullTime  `json:"responded_at"`
+	OrganizationName string        `json:"organization_name"`
+}
+
+func (q *Queries) GetOrganizationInvitationByToken(ctx context.Context, tokenHash string) (GetOrganizationInvitationByTokenRow, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationInvitationByToken, tokenHash)
+	var i GetOrganizationInvitationByTokenRow
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.OrganizationName,
+	)
+	return i, err
+}
+
+const getOrganizationMember = `-- name: GetOrganizationMember :one
+SELECT m.organization_id, m.user_id, m.role, m.joined_at, u.email, u.name
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1 AND m.user_id = $2
+`
+
+type GetOrganizationMemberParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+}
+
+type GetOrganizationMemberRow struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Role           string    `json:"role"`
+	JoinedAt       time.Time `json:"joined_at"`
+	Email          string    `json:"email"`
+	Name           string    `json:"name"`
+}
+
+func (q *Queries) GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (GetOrganizationMemberRow, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationMember, arg.OrganizationID, arg.UserID)
+	var i GetOrganizationMemberRow
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Role,
+		&i.JoinedAt,
+		&i.Email,
+		&i.Name,
+	)
+	return i, err
+}
+
+const getSessionOrganization = `-- name: GetSessionOrganization :one
+SELECT m.organization_id, m.role
+FROM sessions s
+JOIN organization_members m ON m.organization_id = s.organization_id AND m.user_id = s.user_id
+WHERE s.session_id = $1
+`
+
+type GetSessionOrganizationRow struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Role           string    `json:"role"`
+}
+
+func (q *Queries) GetSessionOrganization(ctx context.Context, sessionID uuid.UUID) (GetSessionOrganizationRow, error) {
+	row := q.db.QueryRowContext(ctx, getSessionOrganization, sessionID)
+	var i GetSessionOrganizationRow
+	err := row.Scan(&i.OrganizationID, &i.Role)
+	return i, err
+}
+
+const listOrganizationMembers = `-- name: ListOrganizationMembers :many
+SELECT m.organization_id, m.user_id, m.role, m.joined_at, u.email, u.name
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1
+ORDER BY m.joined_at
+`
+
+type ListOrganizationMembersRow struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Role           string    `json:"role"`
+	JoinedAt       time.Time `json:"joined_at"`
+	Email          string    `json:"email"`
+	Name           string    `json:"name"`
+}
+
+func (q *Queries) ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationMembers, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOrganizationMembersRow{}
+	for rows.Next() {
+		var i ListOrganizationMembersRow
+		if err := rows.Scan(
+			&i.OrganizationID,
+			&i.UserID,
+			&i.Role,
+			&i.JoinedAt,
+			&i.Email,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingOrganizationInvitations = `-- name: ListPendingOrganizationInvitations :many
+SELECT i.id, i.organization_id, i.email, i.role, i.token_hash, i.invited_by, i.status, i.created_at, i.expires_at, i.responded_at, o.name AS organization_name
+FROM organization_invitations i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.organization_id = $1 AND i.status = 'pending' AND i.expires_at > now()
+ORDER BY i.created_at DESC
+`
+
+type ListPendingOrganizationInvitationsRow struct {
+	ID               uuid.UUID     `json:"id"`
+	OrganizationID   uuid.UUID     `json:"organization_id"`
+	Email            string        `json:"email"`
+	Role             string        `json:"role"`
+	TokenHash        string        `json:"token_hash"`
+	InvitedBy        uuid.NullUUID `json:"invited_by"`
+	Status           string        `json:"status"`
+	CreatedAt        time.Time     `json:"created_at"`
+	ExpiresAt        time.Time     `json:"expires_at"`
+	RespondedAt      sql.NullTime  `json:"responded_at"`
+	OrganizationName string        `json:"organization_name"`
+}
+
+func (q *Queries) ListPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID) ([]ListPendingOrganizationInvitationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingOrganizationInvitations, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPendingOrganizationInvitationsRow{}
+	for rows.Next() {
+		var i ListPendingOrganizationInvitationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Email,
+			&i.Role,
+			&i.TokenHash,
+			&i.InvitedBy,
+			&i.Status,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.RespondedAt,
+			&i.OrganizationName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserOrganizations = `-- name: ListUserOrganizations :many
+SELECT o.id, o.name, o.created_by, o.created_at, o.updated_at, m.role, m.joined_at
+FROM organizations o
+JOIN organization_members m ON m.organization_id = o.id
+WHERE m.user_id = $1
+ORDER BY o.name
+`
+
+type ListUserOrganizationsRow struct {
+	ID        uuid.UUID     `json:"id"`
+	Name      string        `json:"name"`
+	CreatedBy uuid.NullUUID `json:"created_by"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+	Role      string        `json:"role"`
+	JoinedAt  time.Time     `json:"joined_at"`
+}
+
+func (q *Queries) ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]ListUserOrganizationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserOrganizations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserOrganizationsRow{}
+	for rows.Next() {
+		var i ListUserOrganizationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Role,
+			&i.JoinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const respondToOrganizationInvitation = `-- name: RespondToOrganizationInvitation :execrows
+UPDATE organization_invitations
+SET status = $2,
+    responded_at = now()
+WHERE id = $1 AND status = 'pending' AND expires_at > now()
+`
+
+type RespondToOrganizationInvitationParams struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+}
+
+func (q *Queries) RespondToOrganizationInvitation(ctx context.Context, arg RespondToOrganizationInvitationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, respondToOrganizationInvitation, arg.ID, arg.Status)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokePendingOrganizationInvitations = `-- name: RevokePendingOrganizationInvitations :exec
+UPDATE organization_invitations
+SET status = 'revoked',
+    responded_at = now()
+WHERE organization_id = $1 AND lower(email) = lower($2) AND status = 'pending'
+`
+
+type RevokePendingOrganizationInvitationsParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Email          string    `json:"email"`
+}
+
+func (q *Queries) RevokePendingOrganizationInvitations(ctx context.Context, arg RevokePendingOrganizationInvitationsParams) error {
+	_, err := q.db.ExecContext(ctx, revokePendingOrganizationInvitations, arg.OrganizationID, arg.Email)
+	return err
+}
+
+const setSessionOrganization = `-- name: SetSessionOrganization :execrows
+UPDATE sessions
+SET organization_id = $3
+WHERE session_id = $1 AND user_id = $2
+`
+
+type SetSessionOrganizationParams struct {
+	SessionID      uuid.UUID     `json:"session_id"`
+	UserID         uuid.UUID     `json:"user_id"`
+	OrganizationID uuid.NullUUID `json:"organization_id"`
+}
+
+func (q *Queries) SetSessionOrganization(ctx context.Context, arg SetSessionOrganizationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setSessionOrganization, arg.SessionID, arg.UserID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateOrganizationMemberRole = `-- name: UpdateOrganizationMemberRole :execrows
+UPDATE organization_members
+SET role = $3
+WHERE organization_id = $1 AND user_id = $2
+`
+
+type UpdateOrganizationMemberRoleParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Role           string    `json:"role"`
+}
+
+func (q *Queries) UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateOrganizationMemberRole, arg.OrganizationID, arg.UserID, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
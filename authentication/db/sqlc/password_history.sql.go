@@ -0,0 +1,95 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: password_history.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createPasswordHistory = `-- name: CreatePasswordHistory :exec
+INSERT INTO password_history (
+    id,
+    user_id,
+    password_hash,
+    created_at
+) VALUES (
+    $1, $2, $3, now()
+)
+`
+
+type CreatePasswordHistoryParams struct {
+	ID           uuid.UUID `json:"id"`
+	UserID       uuid.UUID `json:"user_id"`
+	PasswordHash string    `json:"password_hash"`
+}
+
+func (q *Queries) CreatePasswordHistory(ctx context.Context, arg CreatePasswordHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, createPasswordHistory, arg.ID, arg.UserID, arg.PasswordHash)
+	return err
+}
+
+const listPasswordHistory = `-- name: ListPasswordHistory :many
+SELECT id, user_id, password_hash, created_at FROM password_history
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type ListPasswordHistoryParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Limit  int32     `json:"limit"`
+}
+
+func (q *Queries) ListPasswordHistory(ctx context.Context, arg ListPasswordHistoryParams) ([]PasswordHistory, error) {
+	rows, err := q.db.QueryContext(ctx, listPasswordHistory, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PasswordHistory{}
+	for rows.Next() {
+		var i PasswordHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.PasswordHash,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trimPasswordHistory = `-- name: TrimPasswordHistory :exec
+DELETE FROM password_history
+WHERE password_history.user_id = $1
+  AND password_history.id NOT IN (
+    SELECT kept.id FROM password_history AS kept
+    WHERE kept.user_id = $1
+    ORDER BY kept.created_at DESC
+    LIMIT $2
+  )
+`
+
+type TrimPasswordHistoryParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Keep   int32     `json:"keep"`
+}
+
+func (q *Queries) TrimPasswordHistory(ctx context.Context, arg TrimPasswordHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, trimPasswordHistory, arg.UserID, arg.Keep)
+	return err
+}
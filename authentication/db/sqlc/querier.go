@@ -6,35 +6,170 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	AddOrganizationMember(ctx context.Context, arg AddOrganizationMemberParams) (OrganizationMembers, error)
+	CancelUserDeletion(ctx context.Context, id uuid.UUID) (int64, error)
 	ChangePassword(ctx context.Context, arg ChangePasswordParams) (Users, error)
 	CheckEmailExists(ctx context.Context, email string) (bool, error)
+	ClaimDataExport(ctx context.Context, staleBefore sql.NullTime) (DataExports, error)
+	ClearSessionOrganization(ctx context.Context, arg ClearSessionOrganizationParams) error
+	CompleteDataExport(ctx context.Context, arg CompleteDataExportParams) error
+	ConfirmEmailChange(ctx context.Context, arg ConfirmEmailChangeParams) (int64, error)
+	ConsumeMfaChallenge(ctx context.Context, id uuid.UUID) (int64, error)
+	ConsumeOAuthState(ctx context.Context, stateHash string) (OauthStates, error)
+	ConsumeVerificationChallenge(ctx context.Context, arg ConsumeVerificationChallengeParams) (int64, error)
+	ConsumeVerificationChallengeByResetToken(ctx context.Context, resetTokenHash sql.NullString) (int64, error)
+	CountActiveOrganizationAPIKeys(ctx context.Context, organizationID uuid.NullUUID) (int64, error)
+	CountActiveUserAPIKeys(ctx context.Context, userID uuid.UUID) (int64, error)
+	CountAgentVerifications(ctx context.Context, status string) (int64, error)
+	CountAuthEvents(ctx context.Context, arg CountAuthEventsParams) (int64, error)
+	CountOrganizationOwners(ctx context.Context, organizationID uuid.UUID) (int64, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+	CountUsers(ctx context.Context, arg CountUsersParams) (int64, error)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKeys, error)
+	CreateAccountErasure(ctx context.Context, arg CreateAccountErasureParams) error
+	CreateAccountErasureService(ctx context.Context, arg CreateAccountErasureServiceParams) error
+	CreateAgentVerificationDocument(ctx context.Context, arg CreateAgentVerificationDocumentParams) (AgentVerificationDocuments, error)
+	CreateAuthEvent(ctx context.Context, arg CreateAuthEventParams) error
+	CreateDataExport(ctx context.Context, arg CreateDataExportParams) (DataExports, error)
+	CreateEmailChange(ctx context.Context, arg CreateEmailChangeParams) (EmailChanges, error)
+	CreateImpersonationSession(ctx context.Context, arg CreateImpersonationSessionParams) (Sessions, error)
 	CreateLoginHistoryEntry(ctx context.Context, arg CreateLoginHistoryEntryParams) (Sessions, error)
+	CreateMagicLink(ctx context.Context, arg CreateMagicLinkParams) (MagicLinks, error)
+	CreateMfaChallenge(ctx context.Context, arg CreateMfaChallengeParams) (MfaChallenges, error)
+	CreateOAuthState(ctx context.Context, arg CreateOAuthStateParams) (OauthStates, error)
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organizations, error)
+	CreateOrganizationInvitation(ctx context.Context, arg CreateOrganizationInvitationParams) (OrganizationInvitations, error)
+	CreatePasswordHistory(ctx context.Context, arg CreatePasswordHistoryParams) error
 	CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordResets, error)
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) (MfaRecoveryCodes, error)
+	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshTokens, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Sessions, error)
+	CreateTokenSigningKey(ctx context.Context, arg CreateTokenSigningKeyParams) (TokenSigningKeys, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (Users, error)
+	CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) (UserIdentities, error)
+	CreateVerificationChallenge(ctx context.Context, arg CreateVerificationChallengeParams) (VerificationChallenges, error)
+	DeleteAuthThrottle(ctx context.Context, arg DeleteAuthThrottleParams) error
+	DeleteAuthThrottlesBySubject(ctx context.Context, arg DeleteAuthThrottlesBySubjectParams) error
+	DeleteExpiredDataExports(ctx context.Context, expiresAt sql.NullTime) ([]DataExports, error)
+	DeleteExpiredMagicLinks(ctx context.Context) error
+	DeleteExpiredMfaChallenges(ctx context.Context) error
+	DeleteExpiredOAuthStates(ctx context.Context) error
 	DeleteExpiredPasswordResets(ctx context.Context) error
+	DeleteExpiredRefreshTokens(ctx context.Context) error
+	DeleteExpiredRevokedSessions(ctx context.Context) error
+	DeleteExpiredTokenSigningKeys(ctx context.Context) error
+	DeleteExpiredVerificationChallenges(ctx context.Context) error
+	DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) (int64, error)
 	DeletePasswordResetsByUserId(ctx context.Context, userID uuid.UUID) error
+	DeletePendingEmailChanges(ctx context.Context, userID uuid.UUID) error
+	DeleteRecoveryCodesByUserID(ctx context.Context, userID uuid.UUID) error
 	DeleteSession(ctx context.Context, sessionID uuid.UUID) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
-	GetLoginHistory(ctx context.Context, arg GetLoginHistoryParams) ([]Sessions, error)
+	DeleteUserDueForDeletion(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteUserIdentity(ctx context.Context, arg DeleteUserIdentityParams) (int64, error)
+	DeleteUserMfa(ctx context.Context, userID uuid.UUID) error
+	EnableUserMfa(ctx context.Context, userID uuid.UUID) (UserMfa, error)
+	ExpireMagicLinksByUserID(ctx context.Context, userID uuid.UUID) error
+	FailDataExport(ctx context.Context, arg FailDataExportParams) error
+	GetAPIKey(ctx context.Context, id uuid.UUID) (ApiKeys, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (ApiKeys, error)
+	GetAccountErasure(ctx context.Context, userID uuid.UUID) (AccountErasures, error)
+	GetActiveVerificationChallenge(ctx context.Context, arg GetActiveVerificationChallengeParams) (VerificationChallenges, error)
+	GetAgentVerification(ctx context.Context, userID uuid.UUID) (GetAgentVerificationRow, error)
+	GetAuthThrottle(ctx context.Context, arg GetAuthThrottleParams) (AuthThrottles, error)
+	GetDataExport(ctx context.Context, id uuid.UUID) (DataExports, error)
+	GetEmailChangeByRevertToken(ctx context.Context, revertTokenHash sql.NullString) (EmailChanges, error)
+	GetLoginFamiliarity(ctx context.Context, arg GetLoginFamiliarityParams) (GetLoginFamiliarityRow, error)
+	GetMagicLinkByHash(ctx context.Context, tokenHash string) (MagicLinks, error)
+	GetMfaChallenge(ctx context.Context, id uuid.UUID) (MfaChallenges, error)
+	GetOrganization(ctx context.Context, id uuid.UUID) (Organizations, error)
+	GetOrganizationInvitation(ctx context.Context, id uuid.UUID) (GetOrganizationInvitationRow, error)
+	GetOrganizationInvitationByToken(ctx context.Context, tokenHash string) (GetOrganizationInvitationByTokenRow, error)
+	GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (GetOrganizationMemberRow, error)
 	GetPasswordResetByToken(ctx context.Context, token string) (PasswordResets, error)
+	GetPendingEmailChange(ctx context.Context, userID uuid.UUID) (EmailChanges, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (RefreshTokens, error)
 	GetSessionByID(ctx context.Context, sessionID uuid.UUID) (Sessions, error)
 	GetSessionByUserID(ctx context.Context, userID uuid.UUID) (Sessions, error)
+	GetSessionOrganization(ctx context.Context, sessionID uuid.UUID) (GetSessionOrganizationRow, error)
 	GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]Sessions, error)
 	GetUser(ctx context.Context, email string) (Users, error)
+	GetUserIdentityByProvider(ctx context.Context, arg GetUserIdentityByProviderParams) (UserIdentities, error)
+	GetUserMfa(ctx context.Context, userID uuid.UUID) (UserMfa, error)
+	GetVerificationChallengeByResetToken(ctx context.Context, resetTokenHash sql.NullString) (VerificationChallenges, error)
+	IncrementMfaChallengeAttempts(ctx context.Context, id uuid.UUID) (MfaChallenges, error)
+	IncrementVerificationChallengeAttempts(ctx context.Context, id uuid.UUID) (VerificationChallenges, error)
 	InvalidatePasswordReset(ctx context.Context, token string) (PasswordResets, error)
+	InvalidateVerificationChallenges(ctx context.Context, arg InvalidateVerificationChallengesParams) error
+	ListAccountErasureServices(ctx context.Context, userID uuid.UUID) ([]AccountErasureServices, error)
+	ListAgentVerificationDocuments(ctx context.Context, userID uuid.UUID) ([]AgentVerificationDocuments, error)
+	ListAgentVerifications(ctx context.Context, arg ListAgentVerificationsParams) ([]ListAgentVerificationsRow, error)
+	ListAuthEvents(ctx context.Context, arg ListAuthEventsParams) ([]AuthEvents, error)
+	ListLoginEvents(ctx context.Context, arg ListLoginEventsParams) ([]AuthEvents, error)
+	ListOrganizationAPIKeys(ctx context.Context, organizationID uuid.NullUUID) ([]ApiKeys, error)
+	ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationMembersRow, error)
+	ListPasswordHistory(ctx context.Context, arg ListPasswordHistoryParams) ([]PasswordHistory, error)
+	ListPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID) ([]ListPendingOrganizationInvitationsRow, error)
+	ListTokenSigningKeys(ctx context.Context) ([]TokenSigningKeys, error)
+	ListUnpublishedErasures(ctx context.Context, limit int32) ([]AccountErasures, error)
+	ListUserAPIKeys(ctx context.Context, userID uuid.UUID) ([]ApiKeys, error)
+	ListUserIdentities(ctx context.Context, userID uuid.UUID) ([]UserIdentities, error)
+	ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]ListUserOrganizationsRow, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]Users, error)
+	ListUsersDueForDeletion(ctx context.Context, arg ListUsersDueForDeletionParams) ([]Users, error)
+	LockAuthThrottle(ctx context.Context, arg LockAuthThrottleParams) (AuthThrottles, error)
+	LockUser(ctx context.Context, arg LockUserParams) error
+	MarkEmailChangeReverted(ctx context.Context, id uuid.UUID) (int64, error)
+	MarkErasurePublished(ctx context.Context, userID uuid.UUID) error
+	MarkMagicLinkUsed(ctx context.Context, arg MarkMagicLinkUsedParams) (int64, error)
+	MarkRefreshTokenUsed(ctx context.Context, id uuid.UUID) (int64, error)
+	MarkVerificationChallengeVerified(ctx context.Context, id uuid.UUID) (int64, error)
+	PruneAuthEvents(ctx context.Context, createdAt time.Time) (int64, error)
+	RecordAuthFailure(ctx context.Context, arg RecordAuthFailureParams) (AuthThrottles, error)
+	RespondToOrganizationInvitation(ctx context.Context, arg RespondToOrganizationInvitationParams) (int64, error)
+	RestoreUser(ctx context.Context, id uuid.UUID) (int64, error)
+	RetireTokenSigningKeys(ctx context.Context, expiresAt sql.NullTime) error
+	ReviewAgentVerification(ctx context.Context, arg ReviewAgentVerificationParams) (int64, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) (int64, error)
+	RevokePendingOrganizationInvitations(ctx context.Context, arg RevokePendingOrganizationInvitationsParams) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeRefreshTokensBySessionID(ctx context.Context, sessionID uuid.UUID) error
+	RevokeRefreshTokensByUserID(ctx context.Context, userID uuid.UUID) error
 	RevokeSession(ctx context.Context, userID uuid.UUID) error
+	RevokeSessionTokens(ctx context.Context, arg RevokeSessionTokensParams) error
+	// Access tokens exchanged for an API key use the key's ID as their session,
+	// so the tokens of the user's keys are revoked along with their sessions
+	RevokeUserSessionTokens(ctx context.Context, arg RevokeUserSessionTokensParams) error
+	ScheduleUserDeletion(ctx context.Context, arg ScheduleUserDeletionParams) (int64, error)
+	SetSessionOrganization(ctx context.Context, arg SetSessionOrganizationParams) (int64, error)
+	SetUserAgentVerified(ctx context.Context, arg SetUserAgentVerifiedParams) error
+	SetVerificationChallengeResetToken(ctx context.Context, arg SetVerificationChallengeResetTokenParams) (int64, error)
+	SoftDeleteUser(ctx context.Context, id uuid.UUID) (int64, error)
+	SubmitAgentVerification(ctx context.Context, arg SubmitAgentVerificationParams) (AgentVerifications, error)
+	TouchAPIKey(ctx context.Context, id uuid.UUID) error
+	TouchUserIdentity(ctx context.Context, id uuid.UUID) error
+	TrimPasswordHistory(ctx context.Context, arg TrimPasswordHistoryParams) error
+	UnlockUser(ctx context.Context, id uuid.UUID) error
+	UpdateAccountErasureService(ctx context.Context, arg UpdateAccountErasureServiceParams) (int64, error)
 	UpdateEmailVerification(ctx context.Context, id uuid.UUID) error
 	UpdateLastLogin(ctx context.Context, id uuid.UUID) error
+	UpdateMfaLastUsedStep(ctx context.Context, arg UpdateMfaLastUsedStepParams) (int64, error)
+	UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (int64, error)
 	UpdateSession(ctx context.Context, arg UpdateSessionParams) (Sessions, error)
 	UpdateSessionActivity(ctx context.Context, arg UpdateSessionActivityParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (Users, error)
+	UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) error
 	UpdateUserProfilePicture(ctx context.Context, arg UpdateUserProfilePictureParams) (Users, error)
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) error
+	UpsertUserMfa(ctx context.Context, arg UpsertUserMfaParams) (UserMfa, error)
+	UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
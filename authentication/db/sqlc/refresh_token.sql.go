@@ -0,0 +1,138 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: refresh_token.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createRefreshToken = `-- name: CreateRefreshToken :one
+INSERT INTO refresh_tokens (
+    id, session_id, user_id, family_id, parent_id, token_hash, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, session_id, user_id, family_id, parent_id, token_hash, expires_at, used_at, revoked_at, created_at
+`
+
+type CreateRefreshTokenParams struct {
+	ID        uuid.UUID     `json:"id"`
+	SessionID uuid.UUID     `json:"session_id"`
+	UserID    uuid.UUID     `json:"user_id"`
+	FamilyID  uuid.UUID     `json:"family_id"`
+	ParentID  uuid.NullUUID `json:"parent_id"`
+	TokenHash string        `json:"token_hash"`
+	ExpiresAt time.Time     `json:"expires_at"`
+}
+
+func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshTokens, error) {
+	row := q.db.QueryRowContext(ctx, createRefreshToken,
+		arg.ID,
+		arg.SessionID,
+		arg.UserID,
+		arg.FamilyID,
+		arg.ParentID,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	var i RefreshTokens
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.UserID,
+		&i.FamilyID,
+		&i.ParentID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteExpiredRefreshTokens = `-- name: DeleteExpiredRefreshTokens :exec
+DELETE FROM refresh_tokens
+WHERE expires_at < now() - INTERVAL '1 day'
+`
+
+func (q *Queries) DeleteExpiredRefreshTokens(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredRefreshTokens)
+	return err
+}
+
+const getRefreshTokenByHash = `-- name: GetRefreshTokenByHash :one
+SELECT id, session_id, user_id, family_id, parent_id, token_hash, expires_at, used_at, revoked_at, created_at FROM refresh_tokens
+WHERE token_hash = $1
+LIMIT 1
+`
+
+func (q *Queries) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (RefreshTokens, error) {
+	row := q.db.QueryRowContext(ctx, getRefreshTokenByHash, tokenHash)
+	var i RefreshTokens
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.UserID,
+		&i.FamilyID,
+		&i.ParentID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const markRefreshTokenUsed = `-- name: MarkRefreshTokenUsed :execrows
+UPDATE refresh_tokens
+SET used_at = now()
+WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL
+`
+
+func (q *Queries) MarkRefreshTokenUsed(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markRefreshTokenUsed, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeRefreshTokenFamily = `-- name: RevokeRefreshTokenFamily :exec
+UPDATE refresh_tokens
+SET revoked_at = now()
+WHERE family_id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, revokeRefreshTokenFamily, familyID)
+	return err
+}
+
+const revokeRefreshTokensBySessionID = `-- name: RevokeRefreshTokensBySessionID :exec
+UPDATE refresh_tokens
+SET revoked_at = now()
+WHERE session_id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeRefreshTokensBySessionID(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, revokeRefreshTokensBySessionID, sessionID)
+	return err
+}
+
+const revokeRefreshTokensByUserID = `-- name: RevokeRefreshTokensByUserID :exec
+UPDATE refresh_tokens
+SET revoked_at = now()
+WHERE user_id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeRefreshTokensByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, revokeRefreshTokensByUserID, userID)
+	return err
+}
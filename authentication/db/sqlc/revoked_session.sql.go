@@ -0,0 +1,80 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: revoked_session.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteExpiredRevokedSessions = `-- name: DeleteExpiredRevokedSessions :exec
+DELETE FROM revoked_sessions
+WHERE revoked_at < now() - INTERVAL '7 days'
+`
+
+func (q *Queries) DeleteExpiredRevokedSessions(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredRevokedSessions)
+	return err
+}
+
+const revokeSessionTokens = `-- name: RevokeSessionTokens :exec
+INSERT INTO revoked_sessions (
+    session_id,
+    user_id,
+    reason,
+    revoked_at
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (session_id) DO UPDATE
+SET reason = EXCLUDED.reason,
+    revoked_at = EXCLUDED.revoked_at
+`
+
+type RevokeSessionTokensParams struct {
+	SessionID uuid.UUID `json:"session_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Reason    string    `json:"reason"`
+	RevokedAt time.Time `json:"revoked_at"`
+}
+
+func (q *Queries) RevokeSessionTokens(ctx context.Context, arg RevokeSessionTokensParams) error {
+	_, err := q.db.ExecContext(ctx, revokeSessionTokens,
+		arg.SessionID,
+		arg.UserID,
+		arg.Reason,
+		arg.RevokedAt,
+	)
+	return err
+}
+
+const revokeUserSessionTokens = `-- name: RevokeUserSessionTokens :exec
+INSERT INTO revoked_sessions (session_id, user_id, reason, revoked_at)
+SELECT s.session_id, s.user_id, $1::VARCHAR, $2::TIMESTAMP
+FROM sessions s
+WHERE s.user_id = $3
+UNION ALL
+SELECT k.id, k.user_id, $1::VARCHAR, $2::TIMESTAMP
+FROM api_keys k
+WHERE k.user_id = $3 AND k.revoked_at IS NULL
+ON CONFLICT (session_id) DO UPDATE
+SET reason = EXCLUDED.reason,
+    revoked_at = EXCLUDED.revoked_at
+`
+
+type RevokeUserSessionTokensParams struct {
+	Reason    string    `json:"reason"`
+	RevokedAt time.Time `json:"revoked_at"`
+	UserID    uuid.UUID `json:"user_id"`
+}
+
+// Access tokens exchanged for an API key use the key's ID as their session,
+// so the tokens of the user's keys are revoked along with their sessions
+func (q *Queries) RevokeUserSessionTokens(ctx context.Context, arg RevokeUserSessionTokensParams) error {
+	_, err := q.db.ExecContext(ctx, revokeUserSessionTokens, arg.Reason, arg.RevokedAt, arg.UserID)
+	return err
+}
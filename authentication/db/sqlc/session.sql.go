@@ -14,12 +14,76 @@ import (
 	"github.com/sqlc-dev/pqtype"
 )
 
+const createImpersonationSession = `-- name: CreateImpersonationSession :one
+INSERT INTO sessions (
+    session_id,
+    user_id,
+    token,
+    otp_verified,
+    expires_at,
+    last_activity,
+    ip_address,
+    user_agent,
+    is_active,
+    impersonator_id,
+    impersonation_reason
+) VALUES (
+    $1, $2, $3, true, $4, now(), $5, $6, true, $7, $8
+) RETURNING session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info, impersonator_id, impersonation_reason, organization_id
+`
+
+type CreateImpersonationSessionParams struct {
+	SessionID           uuid.UUID      `json:"session_id"`
+	UserID              uuid.UUID      `json:"user_id"`
+	Token               string         `json:"token"`
+	ExpiresAt           time.Time      `json:"expires_at"`
+	IpAddress           sql.NullString `json:"ip_address"`
+	UserAgent           sql.NullString `json:"user_agent"`
+	ImpersonatorID      uuid.NullUUID  `json:"impersonator_id"`
+	ImpersonationReason sql.NullString `json:"impersonation_reason"`
+}
+
+func (q *Queries) CreateImpersonationSession(ctx context.Context, arg CreateImpersonationSessionParams) (Sessions, error) {
+	row := q.db.QueryRowContext(ctx, createImpersonationSession,
+		arg.SessionID,
+		arg.UserID,
+		arg.Token,
+		arg.ExpiresAt,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.ImpersonatorID,
+		arg.ImpersonationReason,
+	)
+	var i Sessions
+	err := row.Scan(
+		&i.SessionID,
+		&i.UserID,
+		&i.Token,
+		&i.Otp,
+		&i.OtpExpiresAt,
+		&i.OtpAttempts,
+		&i.OtpVerified,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastActivity,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.IsActive,
+		&i.RevokedAt,
+		&i.DeviceInfo,
+		&i.ImpersonatorID,
+		&i.ImpersonationReason,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
 const createLoginHistoryEntry = `-- name: CreateLoginHistoryEntry :one
 INSERT INTO sessions (
     session_id, user_id, ip_address, user_agent
 ) VALUES (
     $1, $2, $3, $4
-) RETURNING session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info
+) RETURNING session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info, impersonator_id, impersonation_reason, organization_id
 `
 
 type CreateLoginHistoryEntryParams struct {
@@ -53,6 +117,9 @@ func (q *Queries) CreateLoginHistoryEntry(ctx context.Context, arg CreateLoginHi
 		&i.IsActive,
 		&i.RevokedAt,
 		&i.DeviceInfo,
+		&i.ImpersonatorID,
+		&i.ImpersonationReason,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -90,7 +157,7 @@ INSERT INTO sessions (
     $12, -- is_active
     $13, -- revoked_at
     $14 -- device_info
-) RETURNING session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info
+) RETURNING session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info, impersonator_id, impersonation_reason, organization_id
 `
 
 type CreateSessionParams struct {
@@ -144,6 +211,9 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 		&i.IsActive,
 		&i.RevokedAt,
 		&i.DeviceInfo,
+		&i.ImpersonatorID,
+		&i.ImpersonationReason,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -158,59 +228,8 @@ func (q *Queries) DeleteSession(ctx context.Context, sessionID uuid.UUID) error
 	return err
 }
 
-const getLoginHistory = `-- name: GetLoginHistory :many
-SELECT session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info FROM sessions
-WHERE user_id = $1
-ORDER BY created_at DESC
-LIMIT $2
-`
-
-type GetLoginHistoryParams struct {
-	UserID uuid.UUID `json:"user_id"`
-	Limit  int32     `json:"limit"`
-}
-
-func (q *Queries) GetLoginHistory(ctx context.Context, arg GetLoginHistoryParams) ([]Sessions, error) {
-	rows, err := q.db.QueryContext(ctx, getLoginHistory, arg.UserID, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Sessions{}
-	for rows.Next() {
-		var i Sessions
-		if err := rows.Scan(
-			&i.SessionID,
-			&i.UserID,
-			&i.Token,
-			&i.Otp,
-			&i.OtpExpiresAt,
-			&i.OtpAttempts,
-			&i.OtpVerified,
-			&i.CreatedAt,
-			&i.ExpiresAt,
-			&i.LastActivity,
-			&i.IpAddress,
-			&i.UserAgent,
-			&i.IsActive,
-			&i.RevokedAt,
-			&i.DeviceInfo,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const getSessionByID = `-- name: GetSessionByID :one
-SELECT session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info FROM sessions
+SELECT session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info, impersonator_id, impersonation_reason, organization_id FROM sessions
 WHERE session_id = $1
 ORDER BY created_at DESC
 LIMIT 1
@@ -235,12 +254,15 @@ func (q *Queries) GetSessionByID(ctx context.Context, sessionID uuid.UUID) (Sess
 		&i.IsActive,
 		&i.RevokedAt,
 		&i.DeviceInfo,
+		&i.ImpersonatorID,
+		&i.ImpersonationReason,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
 const getSessionByUserID = `-- name: GetSessionByUserID :one
-SELECT session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info FROM sessions
+SELECT session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info, impersonator_id, impersonation_reason, organization_id FROM sessions
 WHERE user_id = $1
 LIMIT 1
 `
@@ -264,12 +286,15 @@ func (q *Queries) GetSessionByUserID(ctx context.Context, userID uuid.UUID) (Ses
 		&i.IsActive,
 		&i.RevokedAt,
 		&i.DeviceInfo,
+		&i.ImpersonatorID,
+		&i.ImpersonationReason,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
 const getSessionsByUserID = `-- name: GetSessionsByUserID :many
-SELECT session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info FROM sessions 
+SELECT session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info, impersonator_id, impersonation_reason, organization_id FROM sessions 
 WHERE user_id = $1 
 ORDER BY created_at DESC
 `
@@ -299,6 +324,9 @@ func (q *Queries) GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]
 			&i.IsActive,
 			&i.RevokedAt,
 			&i.DeviceInfo,
+			&i.ImpersonatorID,
+			&i.ImpersonationReason,
+			&i.OrganizationID,
 		); err != nil {
 			return nil, err
 		}
@@ -342,7 +370,7 @@ SET
     otp_verified = COALESCE($11, otp_verified),
     device_info = COALESCE($12, device_info)
 WHERE user_id = $13
-RETURNING session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info
+RETURNING session_id, user_id, token, otp, otp_expires_at, otp_attempts, otp_verified, created_at, expires_at, last_activity, ip_address, user_agent, is_active, revoked_at, device_info, impersonator_id, impersonation_reason, organization_id
 `
 
 type UpdateSessionParams struct {
@@ -394,6 +422,9 @@ func (q *Queries) UpdateSession(ctx context.Context, arg UpdateSessionParams) (S
 		&i.IsActive,
 		&i.RevokedAt,
 		&i.DeviceInfo,
+		&i.ImpersonatorID,
+		&i.ImpersonationReason,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -1,12 +1,50 @@
 package db
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 )
 
 type Store interface {
 	// Methods from the Querier interface (generated by sqlc)
 	Querier
+
+	// ReplaceRecoveryCodesTx swaps a user's MFA recovery codes in a single transaction.
+	ReplaceRecoveryCodesTx(ctx context.Context, arg ReplaceRecoveryCodesTxParams) error
+
+	// RotateRefreshTokenTx exchanges a refresh token for its successor in a single transaction.
+	RotateRefreshTokenTx(ctx context.Context, arg RotateRefreshTokenTxParams) (RefreshTokens, error)
+
+	// CreateUserWithIdentityTx creates an OAuth user and their first linked identity in a single transaction.
+	CreateUserWithIdentityTx(ctx context.Context, arg CreateUserWithIdentityTxParams) (Users, error)
+
+	// RotateTokenSigningKeyTx retires the active token signing key and stores its successor in a single transaction.
+	RotateTokenSigningKeyTx(ctx context.Context, arg RotateTokenSigningKeyTxParams) (TokenSigningKeys, error)
+
+	// ConfirmEmailChangeTx switches a user to their new email and records the change as confirmed in a single transaction.
+	ConfirmEmailChangeTx(ctx context.Context, arg ConfirmEmailChangeTxParams) error
+
+	// RevertEmailChangeTx restores a user's previous email and records the change as reverted in a single transaction.
+	RevertEmailChangeTx(ctx context.Context, arg RevertEmailChangeTxParams) error
+
+	// EraseUserTx deletes a user and records the erasure other services must carry out in a single transaction.
+	EraseUserTx(ctx context.Context, arg EraseUserTxParams) error
+
+	// CreateOrganizationTx creates an organization and makes its creator a member in a single transaction.
+	CreateOrganizationTx(ctx context.Context, arg CreateOrganizationTxParams) (Organizations, error)
+
+	// AcceptOrganizationInvitationTx marks an invitation accepted and adds the invited user as a member in a single transaction.
+	AcceptOrganizationInvitationTx(ctx context.Context, arg AcceptOrganizationInvitationTxParams) (OrganizationMembers, error)
+
+	// RemoveOrganizationMemberTx removes a member and clears the organization from their sessions in a single transaction.
+	RemoveOrganizationMemberTx(ctx context.Context, arg DeleteOrganizationMemberParams) error
+
+	// ReviewAgentVerificationTx records an admin's decision on a verification and updates the user's verified agent badge in a single transaction.
+	ReviewAgentVerificationTx(ctx context.Context, arg ReviewAgentVerificationTxParams) error
+
+	// RevokeAPIKeyTx revokes an API key and the access tokens exchanged for it in a single transaction.
+	RevokeAPIKeyTx(ctx context.Context, arg RevokeAPIKeyTxParams) error
 }
 
 // SQLStore implements the Store interface and provides transaction support.
@@ -22,3 +60,22 @@ func NewStore(db *sql.DB) Store {
 		Queries: New(db), // Assumes New is an sqlc-generated constructor for Queries
 	}
 }
+
+// execTx executes a function within a database transaction.
+func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	q := New(tx)
+	err = fn(q)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,97 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: token_signing_key.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createTokenSigningKey = `-- name: CreateTokenSigningKey :one
+INSERT INTO token_signing_keys (
+    id,
+    public_key,
+    encrypted_private_key,
+    created_at
+) VALUES (
+    $1, $2, $3, now()
+) RETURNING id, public_key, encrypted_private_key, created_at, expires_at
+`
+
+type CreateTokenSigningKeyParams struct {
+	ID                  string `json:"id"`
+	PublicKey           string `json:"public_key"`
+	EncryptedPrivateKey []byte `json:"encrypted_private_key"`
+}
+
+func (q *Queries) CreateTokenSigningKey(ctx context.Context, arg CreateTokenSigningKeyParams) (TokenSigningKeys, error) {
+	row := q.db.QueryRowContext(ctx, createTokenSigningKey, arg.ID, arg.PublicKey, arg.EncryptedPrivateKey)
+	var i TokenSigningKeys
+	err := row.Scan(
+		&i.ID,
+		&i.PublicKey,
+		&i.EncryptedPrivateKey,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const deleteExpiredTokenSigningKeys = `-- name: DeleteExpiredTokenSigningKeys :exec
+DELETE FROM token_signing_keys
+WHERE expires_at < now()
+`
+
+func (q *Queries) DeleteExpiredTokenSigningKeys(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredTokenSigningKeys)
+	return err
+}
+
+const listTokenSigningKeys = `-- name: ListTokenSigningKeys :many
+SELECT id, public_key, encrypted_private_key, created_at, expires_at FROM token_signing_keys
+WHERE expires_at IS NULL OR expires_at > now()
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListTokenSigningKeys(ctx context.Context) ([]TokenSigningKeys, error) {
+	rows, err := q.db.QueryContext(ctx, listTokenSigningKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TokenSigningKeys{}
+	for rows.Next() {
+		var i TokenSigningKeys
+		if err := rows.Scan(
+			&i.ID,
+			&i.PublicKey,
+			&i.EncryptedPrivateKey,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const retireTokenSigningKeys = `-- name: RetireTokenSigningKeys :exec
+UPDATE token_signing_keys
+SET expires_at = $1
+WHERE expires_at IS NULL
+`
+
+func (q *Queries) RetireTokenSigningKeys(ctx context.Context, expiresAt sql.NullTime) error {
+	_, err := q.db.ExecContext(ctx, retireTokenSigningKeys, expiresAt)
+	return err
+}
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+// ReviewAgentVerificationTxParams contains the input parameters of a verification review.
+type ReviewAgentVerificationTxParams struct {
+	Review   ReviewAgentVerificationParams
+	Approved bool
+}
+
+// ReviewAgentVerificationTx records the decision on a pending verification and
+// gives or takes away the user's verified agent badge with it. It returns
+// sql.ErrNoRows if the verification is not pending.
+func (store *SQLStore) ReviewAgentVerificationTx(ctx context.Context, arg ReviewAgentVerificationTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		rows, err := q.ReviewAgentVerification(ctx, arg.Review)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+
+		return q.SetUserAgentVerified(ctx, SetUserAgentVerifiedParams{
+			Verified: arg.Approved,
+			ID:       arg.Review.UserID,
+		})
+	})
+}
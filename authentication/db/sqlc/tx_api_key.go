@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// RevokeAPIKeyTxParams contains the input parameters of a revoked API key.
+type RevokeAPIKeyTxParams struct {
+	Key    ApiKeys
+	Reason string
+}
+
+// RevokeAPIKeyTx revokes an API key and adds it to the session revocation list,
+// since access tokens exchanged for a key use its ID as their session, so the
+// tokens already handed out stop working too. It returns sql.ErrNoRows if the
+// key was already revoked.
+func (store *SQLStore) RevokeAPIKeyTx(ctx context.Context, arg RevokeAPIKeyTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		rows, err := q.RevokeAPIKey(ctx, arg.Key.ID)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+
+		return q.RevokeSessionTokens(ctx, RevokeSessionTokensParams{
+			SessionID: arg.Key.ID,
+			UserID:    arg.Key.UserID,
+			Reason:    arg.Reason,
+			RevokedAt: time.Now().UTC(),
+		})
+	})
+}
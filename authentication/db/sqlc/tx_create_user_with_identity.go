@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+)
+
+// CreateUserWithIdentityTxParams contains the input parameters of an OAuth sign up.
+type CreateUserWithIdentityTxParams struct {
+	User     CreateUserParams
+	Identity CreateUserIdentityParams
+}
+
+// CreateUserWithIdentityTx creates a user together with the provider identity
+// they signed up with, so an OAuth account can never exist without a way to sign in.
+func (store *SQLStore) CreateUserWithIdentityTx(ctx context.Context, arg CreateUserWithIdentityTxParams) (Users, error) {
+	var user Users
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		user, err = q.CreateUser(ctx, arg.User)
+		if err != nil {
+			return err
+		}
+
+		arg.Identity.UserID = user.ID
+		_, err = q.CreateUserIdentity(ctx, arg.Identity)
+		return err
+	})
+
+	return user, err
+}
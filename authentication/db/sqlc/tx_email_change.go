@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConfirmEmailChangeTxParams contains the input parameters of an email change confirmation.
+type ConfirmEmailChangeTxParams struct {
+	ChangeID        uuid.UUID
+	UserID          uuid.UUID
+	NewEmail        string
+	RevertTokenHash string
+	RevertExpiresAt time.Time
+}
+
+// ConfirmEmailChangeTx switches a user to their new, now verified, email and
+// records how the change can be reverted. It returns sql.ErrNoRows if the change
+// was already confirmed; the unique email constraint fails it if the address
+// was taken in the meantime.
+func (store *SQLStore) ConfirmEmailChangeTx(ctx context.Context, arg ConfirmEmailChangeTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		rows, err := q.ConfirmEmailChange(ctx, ConfirmEmailChangeParams{
+			ID:              arg.ChangeID,
+			RevertTokenHash: sql.NullString{String: arg.RevertTokenHash, Valid: true},
+			RevertExpiresAt: sql.NullTime{Time: arg.RevertExpiresAt, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+
+		return q.UpdateUserEmail(ctx, UpdateUserEmailParams{
+			ID:            arg.UserID,
+			Email:         arg.NewEmail,
+			EmailVerified: sql.NullBool{Bool: true, Valid: true},
+		})
+	})
+}
+
+// RevertEmailChangeTxParams contains the input parameters of an email change revert.
+type RevertEmailChangeTxParams struct {
+	ChangeID         uuid.UUID
+	UserID           uuid.UUID
+	OldEmail         string
+	OldEmailVerified bool
+}
+
+// RevertEmailChangeTx puts a user's previous email back. It returns
+// sql.ErrNoRows if the change was already reverted or the revert window has passed.
+func (store *SQLStore) RevertEmailChangeTx(ctx context.Context, arg RevertEmailChangeTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		rows, err := q.MarkEmailChangeReverted(ctx, arg.ChangeID)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+
+		return q.UpdateUserEmail(ctx, UpdateUserEmailParams{
+			ID:            arg.UserID,
+			Email:         arg.OldEmail,
+			EmailVerified: sql.NullBool{Bool: arg.OldEmailVerified, Valid: true},
+		})
+	})
+}
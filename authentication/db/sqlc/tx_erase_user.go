@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EraseUserTxParams contains the input parameters of an account erasure.
+type EraseUserTxParams struct {
+	UserID      uuid.UUID
+	RequestedAt time.Time
+	// Services are the other services expected to report erasing their copy of the user's data
+	Services []string
+}
+
+// EraseUserTx deletes a user and records the erasure other services still
+// have to carry out, so the user.deleted event can be published afterwards
+// even if publishing fails the first time. It returns sql.ErrNoRows if the
+// deletion was cancelled or is not due yet.
+func (store *SQLStore) EraseUserTx(ctx context.Context, arg EraseUserTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		rows, err := q.DeleteUserDueForDeletion(ctx, arg.UserID)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+
+		err = q.CreateAccountErasure(ctx, CreateAccountErasureParams{
+			UserID:      arg.UserID,
+			RequestedAt: arg.RequestedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, service := range arg.Services {
+			err := q.CreateAccountErasureService(ctx, CreateAccountErasureServiceParams{
+				UserID:  arg.UserID,
+				Service: service,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+// CreateOrganizationTxParams contains the input parameters of a new organization.
+type CreateOrganizationTxParams struct {
+	Organization CreateOrganizationParams
+	OwnerRole    string
+}
+
+// CreateOrganizationTx creates an organization with its creator as its first
+// member, so an organization never exists without someone to manage it.
+func (store *SQLStore) CreateOrganizationTx(ctx context.Context, arg CreateOrganizationTxParams) (Organizations, error) {
+	var organization Organizations
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		organization, err = q.CreateOrganization(ctx, arg.Organization)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.AddOrganizationMember(ctx, AddOrganizationMemberParams{
+			OrganizationID: organization.ID,
+			UserID:         arg.Organization.CreatedBy.UUID,
+			Role:           arg.OwnerRole,
+		})
+		return err
+	})
+
+	return organization, err
+}
+
+// AcceptOrganizationInvitationTxParams contains the input parameters of an accepted invitation.
+type AcceptOrganizationInvitationTxParams struct {
+	InvitationID   uuid.UUID
+	Status         string
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Role           string
+}
+
+// AcceptOrganizationInvitationTx marks an invitation accepted and adds the user
+// to the organization. It returns sql.ErrNoRows if the invitation is no longer
+// pending; the members primary key fails it if the user already is a member.
+func (store *SQLStore) AcceptOrganizationInvitationTx(ctx context.Context, arg AcceptOrganizationInvitationTxParams) (OrganizationMembers, error) {
+	var member OrganizationMembers
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		rows, err := q.RespondToOrganizationInvitation(ctx, RespondToOrganizationInvitationParams{
+			ID:     arg.InvitationID,
+			Status: arg.Status,
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+
+		member, err = q.AddOrganizationMember(ctx, AddOrganizationMemberParams{
+			OrganizationID: arg.OrganizationID,
+			UserID:         arg.UserID,
+			Role:           arg.Role,
+		})
+		return err
+	})
+
+	return member, err
+}
+
+// RemoveOrganizationMemberTx removes a user from an organization and stops
+// their sessions acting for it. It returns sql.ErrNoRows if they were not a member.
+func (store *SQLStore) RemoveOrganizationMemberTx(ctx context.Context, arg DeleteOrganizationMemberParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		rows, err := q.DeleteOrganizationMember(ctx, arg)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+
+		return q.ClearSessionOrganization(ctx, ClearSessionOrganizationParams{
+			UserID:         arg.UserID,
+			OrganizationID: uuid.NullUUID{UUID: arg.OrganizationID, Valid: true},
+		})
+	})
+}
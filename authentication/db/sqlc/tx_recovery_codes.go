@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ReplaceRecoveryCodesTxParams contains the input parameters of the recovery code swap.
+type ReplaceRecoveryCodesTxParams struct {
+	UserID     uuid.UUID
+	CodeHashes []string
+}
+
+// ReplaceRecoveryCodesTx removes every existing recovery code of the user and
+// stores the new set, so a user never ends up with a partially rotated list.
+func (store *SQLStore) ReplaceRecoveryCodesTx(ctx context.Context, arg ReplaceRecoveryCodesTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		if err := q.DeleteRecoveryCodesByUserID(ctx, arg.UserID); err != nil {
+			return err
+		}
+
+		for _, codeHash := range arg.CodeHashes {
+			_, err := q.CreateRecoveryCode(ctx, CreateRecoveryCodeParams{
+				ID:       uuid.New(),
+				UserID:   arg.UserID,
+				CodeHash: codeHash,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
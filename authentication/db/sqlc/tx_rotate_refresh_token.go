@@ -0,0 +1,38 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrRefreshTokenAlreadyUsed is returned when the token being rotated was exchanged concurrently.
+var ErrRefreshTokenAlreadyUsed = errors.New("refresh token already used")
+
+// RotateRefreshTokenTxParams contains the input parameters of the refresh token rotation.
+type RotateRefreshTokenTxParams struct {
+	CurrentID uuid.UUID
+	Next      CreateRefreshTokenParams
+}
+
+// RotateRefreshTokenTx marks the presented refresh token as used and stores its
+// successor. If the token was already used no successor is created.
+func (store *SQLStore) RotateRefreshTokenTx(ctx context.Context, arg RotateRefreshTokenTxParams) (RefreshTokens, error) {
+	var next RefreshTokens
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		rows, err := q.MarkRefreshTokenUsed(ctx, arg.CurrentID)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return ErrRefreshTokenAlreadyUsed
+		}
+
+		next, err = q.CreateRefreshToken(ctx, arg.Next)
+		return err
+	})
+
+	return next, err
+}
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// RotateTokenSigningKeyTxParams contains the input parameters of the signing key rotation.
+type RotateTokenSigningKeyTxParams struct {
+	RetireAt time.Time
+	Next     CreateTokenSigningKeyParams
+}
+
+// RotateTokenSigningKeyTx retires the active signing key and stores its successor.
+// Retired keys keep verifying tokens until RetireAt. If another instance rotated
+// at the same time the unique active key index makes the transaction fail.
+func (store *SQLStore) RotateTokenSigningKeyTx(ctx context.Context, arg RotateTokenSigningKeyTxParams) (TokenSigningKeys, error) {
+	var next TokenSigningKeys
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.RetireTokenSigningKeys(ctx, sql.NullTime{Time: arg.RetireAt, Valid: true})
+		if err != nil {
+			return err
+		}
+
+		next, err = q.CreateTokenSigningKey(ctx, arg.Next)
+		return err
+	})
+
+	return next, err
+}
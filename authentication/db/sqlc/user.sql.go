@@ -18,7 +18,7 @@ UPDATE users
 SET password = $2,
     updated_at = now()
 WHERE id = $1
-RETURNING id, name, username, profile_picture, bio, email, password, role, phone, provider, provider_id, email_verified, is_active, last_login, created_at, updated_at
+RETURNING id, name, username, profile_picture, bio, email, password, role, phone, email_verified, is_active, last_login, created_at, updated_at, locked_at, locked_reason, deleted_at, deletion_requested_at, deletion_scheduled_for, agent_verified_at
 `
 
 type ChangePasswordParams struct {
@@ -39,13 +39,17 @@ func (q *Queries) ChangePassword(ctx context.Context, arg ChangePasswordParams)
 		&i.Password,
 		&i.Role,
 		&i.Phone,
-		&i.Provider,
-		&i.ProviderID,
 		&i.EmailVerified,
 		&i.IsActive,
 		&i.LastLogin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedAt,
+		&i.LockedReason,
+		&i.DeletedAt,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.AgentVerifiedAt,
 	)
 	return i, err
 }
@@ -116,8 +120,6 @@ INSERT INTO users (
     bio,
     role,
     phone,
-    provider,
-    provider_id,
     email_verified,
     is_active,
     last_login,
@@ -133,14 +135,12 @@ INSERT INTO users (
     $7, -- bio
     $8, -- role
     $9, -- phone
-    $10, -- provider
-    $11, -- provider_id
-    $12, -- email_verified
-    $13, -- is_active
-    $14, -- last_login
+    $10, -- email_verified
+    $11, -- is_active
+    $12, -- last_login
     now(), -- created_at
     now()  -- updated_at
-) RETURNING id, name, username, profile_picture, bio, email, password, role, phone, provider, provider_id, email_verified, is_active, last_login, created_at, updated_at
+) RETURNING id, name, username, profile_picture, bio, email, password, role, phone, email_verified, is_active, last_login, created_at, updated_at, locked_at, locked_reason, deleted_at, deletion_requested_at, deletion_scheduled_for, agent_verified_at
 `
 
 type CreateUserParams struct {
@@ -153,8 +153,6 @@ type CreateUserParams struct {
 	Bio            sql.NullString `json:"bio"`
 	Role           sql.NullString `json:"role"`
 	Phone          sql.NullString `json:"phone"`
-	Provider       sql.NullString `json:"provider"`
-	ProviderID     sql.NullString `json:"provider_id"`
 	EmailVerified  sql.NullBool   `json:"email_verified"`
 	IsActive       sql.NullBool   `json:"is_active"`
 	LastLogin      sql.NullTime   `json:"last_login"`
@@ -171,8 +169,6 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (Users,
 		arg.Bio,
 		arg.Role,
 		arg.Phone,
-		arg.Provider,
-		arg.ProviderID,
 		arg.EmailVerified,
 		arg.IsActive,
 		arg.LastLogin,
@@ -188,13 +184,17 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (Users,
 		&i.Password,
 		&i.Role,
 		&i.Phone,
-		&i.Provider,
-		&i.ProviderID,
 		&i.EmailVerified,
 		&i.IsActive,
 		&i.LastLogin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedAt,
+		&i.LockedReason,
+		&i.DeletedAt,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.AgentVerifiedAt,
 	)
 	return i, err
 }
@@ -251,7 +251,7 @@ func (q *Queries) GetPasswordResetByToken(ctx context.Context, token string) (Pa
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, name, username, profile_picture, bio, email, password, role, phone, provider, provider_id, email_verified, is_active, last_login, created_at, updated_at FROM users
+SELECT id, name, username, profile_picture, bio, email, password, role, phone, email_verified, is_active, last_login, created_at, updated_at, locked_at, locked_reason, deleted_at, deletion_requested_at, deletion_scheduled_for, agent_verified_at FROM users
 WHERE email = $1 OR id::text = $1 OR username = $1
 LIMIT 1
 `
@@ -269,13 +269,17 @@ func (q *Queries) GetUser(ctx context.Context, email string) (Users, error) {
 		&i.Password,
 		&i.Role,
 		&i.Phone,
-		&i.Provider,
-		&i.ProviderID,
 		&i.EmailVerified,
 		&i.IsActive,
 		&i.LastLogin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedAt,
+		&i.LockedReason,
+		&i.DeletedAt,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.AgentVerifiedAt,
 	)
 	return i, err
 }
@@ -329,21 +333,19 @@ UPDATE users
 SET
     name = COALESCE($1, name),
     username = COALESCE($2, username),
-    email = COALESCE($3, email),
-    password = COALESCE($4, password),
-    profile_picture = COALESCE($5, profile_picture),
-    bio = COALESCE($6, bio),
-    role = COALESCE($7, role),
-    phone = COALESCE($8, phone),
+    password = COALESCE($3, password),
+    profile_picture = COALESCE($4, profile_picture),
+    bio = COALESCE($5, bio),
+    role = COALESCE($6, role),
+    phone = COALESCE($7, phone),
     updated_at = now()
-WHERE id = $9
-RETURNING id, name, username, profile_picture, bio, email, password, role, phone, provider, provider_id, email_verified, is_active, last_login, created_at, updated_at
+WHERE id = $8
+RETURNING id, name, username, profile_picture, bio, email, password, role, phone, email_verified, is_active, last_login, created_at, updated_at, locked_at, locked_reason, deleted_at, deletion_requested_at, deletion_scheduled_for, agent_verified_at
 `
 
 type UpdateUserParams struct {
 	Name           string         `json:"name"`
 	Username       string         `json:"username"`
-	Email          string         `json:"email"`
 	Password       sql.NullString `json:"password"`
 	ProfilePicture sql.NullString `json:"profile_picture"`
 	Bio            sql.NullString `json:"bio"`
@@ -356,7 +358,6 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (Users,
 	row := q.db.QueryRowContext(ctx, updateUser,
 		arg.Name,
 		arg.Username,
-		arg.Email,
 		arg.Password,
 		arg.ProfilePicture,
 		arg.Bio,
@@ -375,13 +376,17 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (Users,
 		&i.Password,
 		&i.Role,
 		&i.Phone,
-		&i.Provider,
-		&i.ProviderID,
 		&i.EmailVerified,
 		&i.IsActive,
 		&i.LastLogin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedAt,
+		&i.LockedReason,
+		&i.DeletedAt,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.AgentVerifiedAt,
 	)
 	return i, err
 }
@@ -391,7 +396,7 @@ UPDATE users
 SET profile_picture = $2,
     updated_at = now()
     WHERE id = $1
-    RETURNING id, name, username, profile_picture, bio, email, password, role, phone, provider, provider_id, email_verified, is_active, last_login, created_at, updated_at
+    RETURNING id, name, username, profile_picture, bio, email, password, role, phone, email_verified, is_active, last_login, created_at, updated_at, locked_at, locked_reason, deleted_at, deletion_requested_at, deletion_scheduled_for, agent_verified_at
 `
 
 type UpdateUserProfilePictureParams struct {
@@ -412,13 +417,17 @@ func (q *Queries) UpdateUserProfilePicture(ctx context.Context, arg UpdateUserPr
 		&i.Password,
 		&i.Role,
 		&i.Phone,
-		&i.Provider,
-		&i.ProviderID,
 		&i.EmailVerified,
 		&i.IsActive,
 		&i.LastLogin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedAt,
+		&i.LockedReason,
+		&i.DeletedAt,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.AgentVerifiedAt,
 	)
 	return i, err
 }
@@ -0,0 +1,148 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: user_identity.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const createUserIdentity = `-- name: CreateUserIdentity :one
+INSERT INTO user_identities (
+    id,
+    user_id,
+    provider,
+    provider_user_id,
+    email,
+    created_at,
+    last_used_at
+) VALUES (
+    $1, $2, $3, $4, $5, now(), now()
+) RETURNING id, user_id, provider, provider_user_id, email, created_at, last_used_at
+`
+
+type CreateUserIdentityParams struct {
+	ID             uuid.UUID      `json:"id"`
+	UserID         uuid.UUID      `json:"user_id"`
+	Provider       string         `json:"provider"`
+	ProviderUserID string         `json:"provider_user_id"`
+	Email          sql.NullString `json:"email"`
+}
+
+func (q *Queries) CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) (UserIdentities, error) {
+	row := q.db.QueryRowContext(ctx, createUserIdentity,
+		arg.ID,
+		arg.UserID,
+		arg.Provider,
+		arg.ProviderUserID,
+		arg.Email,
+	)
+	var i UserIdentities
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.ProviderUserID,
+		&i.Email,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const deleteUserIdentity = `-- name: DeleteUserIdentity :execrows
+DELETE FROM user_identities
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteUserIdentityParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteUserIdentity(ctx context.Context, arg DeleteUserIdentityParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUserIdentity, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getUserIdentityByProvider = `-- name: GetUserIdentityByProvider :one
+SELECT id, user_id, provider, provider_user_id, email, created_at, last_used_at FROM user_identities
+WHERE provider = $1 AND provider_user_id = $2
+LIMIT 1
+`
+
+type GetUserIdentityByProviderParams struct {
+	Provider       string `json:"provider"`
+	ProviderUserID string `json:"provider_user_id"`
+}
+
+func (q *Queries) GetUserIdentityByProvider(ctx context.Context, arg GetUserIdentityByProviderParams) (UserIdentities, error) {
+	row := q.db.QueryRowContext(ctx, getUserIdentityByProvider, arg.Provider, arg.ProviderUserID)
+	var i UserIdentities
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.ProviderUserID,
+		&i.Email,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const listUserIdentities = `-- name: ListUserIdentities :many
+SELECT id, user_id, provider, provider_user_id, email, created_at, last_used_at FROM user_identities
+WHERE user_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListUserIdentities(ctx context.Context, userID uuid.UUID) ([]UserIdentities, error) {
+	rows, err := q.db.QueryContext(ctx, listUserIdentities, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserIdentities{}
+	for rows.Next() {
+		var i UserIdentities
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Provider,
+			&i.ProviderUserID,
+			&i.Email,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchUserIdentity = `-- name: TouchUserIdentity :exec
+UPDATE user_identities
+SET last_used_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) TouchUserIdentity(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, touchUserIdentity, id)
+	return err
+}
@@ -21,9 +21,7 @@ func createRandomUser(t *testing.T) Users {
 		Name:           utils.RandomOwner(),
 		Email:          utils.RandomEmail(),
 		Password:       sql.NullString{String: hashPassword, Valid: true},
-		Provider:       sql.NullString{String: utils.RandomProvider(), Valid: true},
 		ProfilePicture: sql.NullString{String: utils.RandomProfilePicture(), Valid: true},
-		ProviderID:     sql.NullString{String: utils.RandomString(32), Valid: true},
 		Username:       utils.RandomOwner(),
 		Bio:            sql.NullString{String: utils.RandomBio(), Valid: true},
 		Role:           sql.NullString{String: utils.RandomRole(), Valid: true},
@@ -36,9 +34,7 @@ func createRandomUser(t *testing.T) Users {
 	require.Equal(t, arg.Name, user.Name)
 	require.Equal(t, arg.Email, user.Email)
 	require.Equal(t, arg.Password, user.Password)
-	require.Equal(t, arg.Provider, user.Provider)
 	require.Equal(t, arg.ProfilePicture, user.ProfilePicture)
-	require.Equal(t, arg.ProviderID, user.ProviderID)
 	require.Equal(t, arg.Username, user.Username)
 	require.Equal(t, arg.Bio, user.Bio)
 	require.Equal(t, arg.Role, user.Role)
@@ -96,10 +92,8 @@ func TestCheckEmailExists(t *testing.T) {
 
 func TestUpdateUser(t *testing.T) {
 	user := createRandomUser(t)
-	newEmail := utils.RandomEmail()
 	arg := UpdateUserParams{
 		Name:  user.Name,
-		Email: newEmail,
 		Role:  sql.NullString{String: utils.RandomRole(), Valid: true},
 		Phone: sql.NullString{String: utils.RandomPhoneNumber(), Valid: true},
 		ID:    user.ID,
@@ -110,12 +104,29 @@ func TestUpdateUser(t *testing.T) {
 	require.NotEmpty(t, updatedUser)
 	require.Equal(t, arg.ID, updatedUser.ID)
 	require.Equal(t, arg.Name, updatedUser.Name)
-	require.Equal(t, arg.Email, updatedUser.Email)
+	require.Equal(t, user.Email, updatedUser.Email)
 	require.Equal(t, arg.Role, updatedUser.Role)
 	require.WithinDuration(t, user.CreatedAt.Time, updatedUser.CreatedAt.Time, time.Second)
 	require.WithinDuration(t, time.Now(), updatedUser.UpdatedAt.Time, time.Second)
 }
 
+func TestUpdateUserEmail(t *testing.T) {
+	user := createRandomUser(t)
+	arg := UpdateUserEmailParams{
+		ID:            user.ID,
+		Email:         utils.RandomEmail(),
+		EmailVerified: sql.NullBool{Bool: true, Valid: true},
+	}
+
+	err := testQueries.UpdateUserEmail(context.Background(), arg)
+	require.NoError(t, err)
+
+	updatedUser, err := testQueries.GetUser(context.Background(), arg.Email)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, updatedUser.ID)
+	require.Equal(t, arg.EmailVerified, updatedUser.EmailVerified)
+}
+
 func TestDeleteUser(t *testing.T) {
 	user := createRandomUser(t)
 	err := testQueries.DeleteUser(context.Background(), user.ID)
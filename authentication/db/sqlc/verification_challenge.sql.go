@@ -0,0 +1,272 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: verification_challenge.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const consumeVerificationChallenge = `-- name: ConsumeVerificationChallenge :execrows
+UPDATE verification_challenges
+SET consumed_at = now()
+WHERE user_id = $1
+  AND purpose = $2
+  AND verified_at IS NOT NULL
+  AND consumed_at IS NULL
+  AND expires_at > now()
+`
+
+type ConsumeVerificationChallengeParams struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Purpose string    `json:"purpose"`
+}
+
+func (q *Queries) ConsumeVerificationChallenge(ctx context.Context, arg ConsumeVerificationChallengeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, consumeVerificationChallenge, arg.UserID, arg.Purpose)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const consumeVerificationChallengeByResetToken = `-- name: ConsumeVerificationChallengeByResetToken :execrows
+UPDATE verification_challenges
+SET consumed_at = now()
+WHERE reset_token_hash = $1
+  AND consumed_at IS NULL
+  AND expires_at > now()
+`
+
+func (q *Queries) ConsumeVerificationChallengeByResetToken(ctx context.Context, resetTokenHash sql.NullString) (int64, error) {
+	result, err := q.db.ExecContext(ctx, consumeVerificationChallengeByResetToken, resetTokenHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createVerificationChallenge = `-- name: CreateVerificationChallenge :one
+INSERT INTO verification_challenges (
+    id,
+    user_id,
+    purpose,
+    code_hash,
+    salt,
+    max_attempts,
+    ip_address,
+    user_agent,
+    expires_at,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, now()
+) RETURNING id, user_id, purpose, code_hash, salt, attempts, max_attempts, ip_address, user_agent, expires_at, verified_at, consumed_at, created_at, reset_token_hash
+`
+
+type CreateVerificationChallengeParams struct {
+	ID          uuid.UUID      `json:"id"`
+	UserID      uuid.UUID      `json:"user_id"`
+	Purpose     string         `json:"purpose"`
+	CodeHash    string         `json:"code_hash"`
+	Salt        string         `json:"salt"`
+	MaxAttempts int32          `json:"max_attempts"`
+	IpAddress   sql.NullString `json:"ip_address"`
+	UserAgent   sql.NullString `json:"user_agent"`
+	ExpiresAt   time.Time      `json:"expires_at"`
+}
+
+func (q *Queries) CreateVerificationChallenge(ctx context.Context, arg CreateVerificationChallengeParams) (VerificationChallenges, error) {
+	row := q.db.QueryRowContext(ctx, createVerificationChallenge,
+		arg.ID,
+		arg.UserID,
+		arg.Purpose,
+		arg.CodeHash,
+		arg.Salt,
+		arg.MaxAttempts,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.ExpiresAt,
+	)
+	var i VerificationChallenges
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Purpose,
+		&i.CodeHash,
+		&i.Salt,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.ExpiresAt,
+		&i.VerifiedAt,
+		&i.ConsumedAt,
+		&i.CreatedAt,
+		&i.ResetTokenHash,
+	)
+	return i, err
+}
+
+const deleteExpiredVerificationChallenges = `-- name: DeleteExpiredVerificationChallenges :exec
+DELETE FROM verification_challenges
+WHERE expires_at < now() - INTERVAL '1 day'
+`
+
+func (q *Queries) DeleteExpiredVerificationChallenges(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredVerificationChallenges)
+	return err
+}
+
+const getActiveVerificationChallenge = `-- name: GetActiveVerificationChallenge :one
+SELECT id, user_id, purpose, code_hash, salt, attempts, max_attempts, ip_address, user_agent, expires_at, verified_at, consumed_at, created_at, reset_token_hash FROM verification_challenges
+WHERE user_id = $1 AND purpose = $2 AND consumed_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetActiveVerificationChallengeParams struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Purpose string    `json:"purpose"`
+}
+
+func (q *Queries) GetActiveVerificationChallenge(ctx context.Context, arg GetActiveVerificationChallengeParams) (VerificationChallenges, error) {
+	row := q.db.QueryRowContext(ctx, getActiveVerificationChallenge, arg.UserID, arg.Purpose)
+	var i VerificationChallenges
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Purpose,
+		&i.CodeHash,
+		&i.Salt,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.ExpiresAt,
+		&i.VerifiedAt,
+		&i.ConsumedAt,
+		&i.CreatedAt,
+		&i.ResetTokenHash,
+	)
+	return i, err
+}
+
+const getVerificationChallengeByResetToken = `-- name: GetVerificationChallengeByResetToken :one
+SELECT id, user_id, purpose, code_hash, salt, attempts, max_attempts, ip_address, user_agent, expires_at, verified_at, consumed_at, created_at, reset_token_hash FROM verification_challenges
+WHERE reset_token_hash = $1
+  AND consumed_at IS NULL
+  AND expires_at > now()
+`
+
+func (q *Queries) GetVerificationChallengeByResetToken(ctx context.Context, resetTokenHash sql.NullString) (VerificationChallenges, error) {
+	row := q.db.QueryRowContext(ctx, getVerificationChallengeByResetToken, resetTokenHash)
+	var i VerificationChallenges
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Purpose,
+		&i.CodeHash,
+		&i.Salt,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.ExpiresAt,
+		&i.VerifiedAt,
+		&i.ConsumedAt,
+		&i.CreatedAt,
+		&i.ResetTokenHash,
+	)
+	return i, err
+}
+
+const incrementVerificationChallengeAttempts = `-- name: IncrementVerificationChallengeAttempts :one
+UPDATE verification_challenges
+SET attempts = attempts + 1
+WHERE id = $1
+RETURNING id, user_id, purpose, code_hash, salt, attempts, max_attempts, ip_address, user_agent, expires_at, verified_at, consumed_at, created_at, reset_token_hash
+`
+
+func (q *Queries) IncrementVerificationChallengeAttempts(ctx context.Context, id uuid.UUID) (VerificationChallenges, error) {
+	row := q.db.QueryRowContext(ctx, incrementVerificationChallengeAttempts, id)
+	var i VerificationChallenges
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Purpose,
+		&i.CodeHash,
+		&i.Salt,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.ExpiresAt,
+		&i.VerifiedAt,
+		&i.ConsumedAt,
+		&i.CreatedAt,
+		&i.ResetTokenHash,
+	)
+	return i, err
+}
+
+const invalidateVerificationChallenges = `-- name: InvalidateVerificationChallenges :exec
+UPDATE verification_challenges
+SET consumed_at = now()
+WHERE user_id = $1 AND purpose = $2 AND consumed_at IS NULL
+`
+
+type InvalidateVerificationChallengesParams struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Purpose string    `json:"purpose"`
+}
+
+func (q *Queries) InvalidateVerificationChallenges(ctx context.Context, arg InvalidateVerificationChallengesParams) error {
+	_, err := q.db.ExecContext(ctx, invalidateVerificationChallenges, arg.UserID, arg.Purpose)
+	return err
+}
+
+const markVerificationChallengeVerified = `-- name: MarkVerificationChallengeVerified :execrows
+UPDATE verification_challenges
+SET verified_at = now()
+WHERE id = $1
+  AND verified_at IS NULL
+  AND consumed_at IS NULL
+  AND attempts < max_attempts
+  AND expires_at > now()
+`
+
+func (q *Queries) MarkVerificationChallengeVerified(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markVerificationChallengeVerified, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setVerificationChallengeResetToken = `-- name: SetVerificationChallengeResetToken :execrows
+UPDATE verification_challenges
+SET reset_token_hash = $2
+WHERE id = $1
+  AND verified_at IS NOT NULL
+  AND consumed_at IS NULL
+  AND expires_at > now()
+`
+
+type SetVerificationChallengeResetTokenParams struct {
+	ID             uuid.UUID      `json:"id"`
+	ResetTokenHash sql.NullString `json:"reset_token_hash"`
+}
+
+func (q *Queries) SetVerificationChallengeResetToken(ctx context.Context, arg SetVerificationChallengeResetTokenParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setVerificationChallengeResetToken, arg.ID, arg.ResetTokenHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,2488 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: admin.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AdminUser is a user as support staff see them.
+type AdminUser struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	User                 *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	IsActive             bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsLocked             bool                   `protobuf:"varint,3,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
+	LockedReason         string                 `protobuf:"bytes,4,opt,name=locked_reason,json=lockedReason,proto3" json:"locked_reason,omitempty"`
+	LockedAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at,omitempty"`
+	DeletedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	LastLogin            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
+	DeletionScheduledFor *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deletion_scheduled_for,json=deletionScheduledFor,proto3" json:"deletion_scheduled_for,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AdminUser) Reset() {
+	*x = AdminUser{}
+	mi := &file_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUser) ProtoMessage() {}
+
+func (x *AdminUser) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUser.ProtoReflect.Descriptor instead.
+func (*AdminUser) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AdminUser) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *AdminUser) GetIsLocked() bool {
+	if x != nil {
+		return x.IsLocked
+	}
+	return false
+}
+
+func (x *AdminUser) GetLockedReason() string {
+	if x != nil {
+		return x.LockedReason
+	}
+	return ""
+}
+
+func (x *AdminUser) GetLockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedAt
+	}
+	return nil
+}
+
+func (x *AdminUser) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *AdminUser) GetLastLogin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLogin
+	}
+	return nil
+}
+
+func (x *AdminUser) GetDeletionScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletionScheduledFor
+	}
+	return nil
+}
+
+// ListUsers RPC messages.
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Provider      string                 `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Deleted       *bool                  `protobuf:"varint,6,opt,name=deleted,proto3,oneof" json:"deleted,omitempty"`
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListUsersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetDeleted() bool {
+	if x != nil && x.Deleted != nil {
+		return *x.Deleted
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*AdminUser           `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListUsersResponse) GetUsers() []*AdminUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetUserDetails RPC messages.
+type GetUserDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDetailsRequest) Reset() {
+	*x = GetUserDetailsRequest{}
+	mi := &file_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailsRequest) ProtoMessage() {}
+
+func (x *GetUserDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserDetailsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *AdminUser             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDetailsResponse) Reset() {
+	*x = GetUserDetailsResponse{}
+	mi := &file_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailsResponse) ProtoMessage() {}
+
+func (x *GetUserDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUserDetailsResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ListUserSessions RPC messages.
+type ListUserSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSessionsRequest) Reset() {
+	*x = ListUserSessionsRequest{}
+	mi := &file_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSessionsRequest) ProtoMessage() {}
+
+func (x *ListUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListUserSessionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListUserSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionInfo         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSessionsResponse) Reset() {
+	*x = ListUserSessionsResponse{}
+	mi := &file_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSessionsResponse) ProtoMessage() {}
+
+func (x *ListUserSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListUserSessionsResponse) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// ListUserLoginHistory RPC messages.
+type ListUserLoginHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserLoginHistoryRequest) Reset() {
+	*x = ListUserLoginHistoryRequest{}
+	mi := &file_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserLoginHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserLoginHistoryRequest) ProtoMessage() {}
+
+func (x *ListUserLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListUserLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListUserLoginHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserLoginHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListUserLoginHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*LoginHistoryEntry   `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserLoginHistoryResponse) Reset() {
+	*x = ListUserLoginHistoryResponse{}
+	mi := &file_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserLoginHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserLoginHistoryResponse) ProtoMessage() {}
+
+func (x *ListUserLoginHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserLoginHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListUserLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListUserLoginHistoryResponse) GetHistory() []*LoginHistoryEntry {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// ForceLogout RPC messages.
+type ForceLogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutRequest) Reset() {
+	*x = ForceLogoutRequest{}
+	mi := &file_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutRequest) ProtoMessage() {}
+
+func (x *ForceLogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutRequest.ProtoReflect.Descriptor instead.
+func (*ForceLogoutRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ForceLogoutRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ForceLogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutResponse) Reset() {
+	*x = ForceLogoutResponse{}
+	mi := &file_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutResponse) ProtoMessage() {}
+
+func (x *ForceLogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutResponse.ProtoReflect.Descriptor instead.
+func (*ForceLogoutResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ForceLogoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// LockUser RPC messages.
+type LockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockUserRequest) Reset() {
+	*x = LockUserRequest{}
+	mi := &file_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockUserRequest) ProtoMessage() {}
+
+func (x *LockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockUserRequest.ProtoReflect.Descriptor instead.
+func (*LockUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LockUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type LockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockUserResponse) Reset() {
+	*x = LockUserResponse{}
+	mi := &file_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockUserResponse) ProtoMessage() {}
+
+func (x *LockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockUserResponse.ProtoReflect.Descriptor instead.
+func (*LockUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LockUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UnlockUser RPC messages.
+type UnlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRequest) Reset() {
+	*x = UnlockUserRequest{}
+	mi := &file_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRequest) ProtoMessage() {}
+
+func (x *UnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UnlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserResponse) Reset() {
+	*x = UnlockUserResponse{}
+	mi := &file_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserResponse) ProtoMessage() {}
+
+func (x *UnlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UnlockUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ChangeUserRole RPC messages.
+type ChangeUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserRoleRequest) Reset() {
+	*x = ChangeUserRoleRequest{}
+	mi := &file_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserRoleRequest) ProtoMessage() {}
+
+func (x *ChangeUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChangeUserRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangeUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ChangeUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *AdminUser             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserRoleResponse) Reset() {
+	*x = ChangeUserRoleResponse{}
+	mi := &file_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserRoleResponse) ProtoMessage() {}
+
+func (x *ChangeUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*ChangeUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChangeUserRoleResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// TriggerPasswordReset RPC messages.
+type TriggerPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerPasswordResetRequest) Reset() {
+	*x = TriggerPasswordResetRequest{}
+	mi := &file_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerPasswordResetRequest) ProtoMessage() {}
+
+func (x *TriggerPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*TriggerPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TriggerPasswordResetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type TriggerPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerPasswordResetResponse) Reset() {
+	*x = TriggerPasswordResetResponse{}
+	mi := &file_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerPasswordResetResponse) ProtoMessage() {}
+
+func (x *TriggerPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*TriggerPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TriggerPasswordResetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// DeleteUser RPC messages.
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RestoreUser RPC messages.
+type RestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RestoreUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserResponse) Reset() {
+	*x = RestoreUserResponse{}
+	mi := &file_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserResponse) ProtoMessage() {}
+
+func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RestoreUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ImpersonateUser RPC messages.
+type ImpersonateUserRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImpersonateUserRequest) Reset() {
+	*x = ImpersonateUserRequest{}
+	mi := &file_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateUserRequest) ProtoMessage() {}
+
+func (x *ImpersonateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateUserRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImpersonateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImpersonateUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImpersonateUserRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type ImpersonateUserResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken          string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	AccessTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3" json:"access_token_expires_at,omitempty"`
+	SessionId            string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	User                 *AdminUser             `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ImpersonateUserResponse) Reset() {
+	*x = ImpersonateUserResponse{}
+	mi := &file_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateUserResponse) ProtoMessage() {}
+
+func (x *ImpersonateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateUserResponse.ProtoReflect.Descriptor instead.
+func (*ImpersonateUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImpersonateUserResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ImpersonateUserResponse) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccessTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *ImpersonateUserResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ImpersonateUserResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// AuthEvent is an entry of the security audit log.
+type AuthEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventType string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Outcome   string                 `protobuf:"bytes,3,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// Who performed the action; empty when the caller did not prove who they are
+	ActorId string `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// The account the event is about; empty when no account matched the email
+	UserId    string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email     string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	SessionId string                 `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	IpAddress string                 `protobuf:"bytes,8,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent string                 `protobuf:"bytes,9,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Metadata  map[string]string      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Browser and operating system parsed from the user agent
+	Device string `protobuf:"bytes,12,opt,name=device,proto3" json:"device,omitempty"`
+	// Coarse location of the IP address, empty without a GeoIP database
+	Location      string `protobuf:"bytes,13,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthEvent) Reset() {
+	*x = AuthEvent{}
+	mi := &file_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthEvent) ProtoMessage() {}
+
+func (x *AuthEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthEvent.ProtoReflect.Descriptor instead.
+func (*AuthEvent) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AuthEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuthEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *AuthEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuthEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuthEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AuthEvent) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AuthEvent) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuthEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuthEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AuthEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuthEvent) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *AuthEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+// ListAuthEvents RPC messages.
+type ListAuthEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Outcome       string                 `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3" json:"until,omitempty"`
+	Limit         int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,9,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthEventsRequest) Reset() {
+	*x = ListAuthEventsRequest{}
+	mi := &file_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthEventsRequest) ProtoMessage() {}
+
+func (x *ListAuthEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthEventsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListAuthEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAuthEventsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuthEventsRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ListAuthEventsRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ListAuthEventsRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ListAuthEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuthEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListAuthEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuthEventsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListAuthEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuthEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthEventsResponse) Reset() {
+	*x = ListAuthEventsResponse{}
+	mi := &file_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthEventsResponse) ProtoMessage() {}
+
+func (x *ListAuthEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthEventsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListAuthEventsResponse) GetEvents() []*AuthEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuthEventsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetUserErasureStatus RPC messages.
+type GetUserErasureStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserErasureStatusRequest) Reset() {
+	*x = GetUserErasureStatusRequest{}
+	mi := &file_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserErasureStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserErasureStatusRequest) ProtoMessage() {}
+
+func (x *GetUserErasureStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserErasureStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetUserErasureStatusRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUserErasureStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ServiceErasure is how far one service has got erasing a deleted user's data.
+type ServiceErasure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Items         int64                  `protobuf:"varint,3,opt,name=items,proto3" json:"items,omitempty"`
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceErasure) Reset() {
+	*x = ServiceErasure{}
+	mi := &file_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceErasure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceErasure) ProtoMessage() {}
+
+func (x *ServiceErasure) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceErasure.ProtoReflect.Descriptor instead.
+func (*ServiceErasure) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ServiceErasure) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ServiceErasure) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ServiceErasure) GetItems() int64 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *ServiceErasure) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ServiceErasure) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetUserErasureStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ScheduledFor  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Services      []*ServiceErasure      `protobuf:"bytes,6,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserErasureStatusResponse) Reset() {
+	*x = GetUserErasureStatusResponse{}
+	mi := &file_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserErasureStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserErasureStatusResponse) ProtoMessage() {}
+
+func (x *GetUserErasureStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserErasureStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetUserErasureStatusResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetUserErasureStatusResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserErasureStatusResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GetUserErasureStatusResponse) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *GetUserErasureStatusResponse) GetScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return nil
+}
+
+func (x *GetUserErasureStatusResponse) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *GetUserErasureStatusResponse) GetServices() []*ServiceErasure {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// ListAgentVerifications RPC messages.
+type ListAgentVerificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentVerificationsRequest) Reset() {
+	*x = ListAgentVerificationsRequest{}
+	mi := &file_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentVerificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentVerificationsRequest) ProtoMessage() {}
+
+func (x *ListAgentVerificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentVerificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentVerificationsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListAgentVerificationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListAgentVerificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAgentVerificationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListAgentVerificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verifications []*AgentVerification   `protobuf:"bytes,1,rep,name=verifications,proto3" json:"verifications,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentVerificationsResponse) Reset() {
+	*x = ListAgentVerificationsResponse{}
+	mi := &file_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentVerificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentVerificationsResponse) ProtoMessage() {}
+
+func (x *ListAgentVerificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentVerificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentVerificationsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListAgentVerificationsResponse) GetVerifications() []*AgentVerification {
+	if x != nil {
+		return x.Verifications
+	}
+	return nil
+}
+
+func (x *ListAgentVerificationsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetAgentVerificationDetails RPC messages.
+type GetAgentVerificationDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentVerificationDetailsRequest) Reset() {
+	*x = GetAgentVerificationDetailsRequest{}
+	mi := &file_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentVerificationDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentVerificationDetailsRequest) ProtoMessage() {}
+
+func (x *GetAgentVerificationDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentVerificationDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentVerificationDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetAgentVerificationDetailsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetAgentVerificationDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verification  *AgentVerification     `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentVerificationDetailsResponse) Reset() {
+	*x = GetAgentVerificationDetailsResponse{}
+	mi := &file_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentVerificationDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentVerificationDetailsResponse) ProtoMessage() {}
+
+func (x *GetAgentVerificationDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentVerificationDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentVerificationDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetAgentVerificationDetailsResponse) GetVerification() *AgentVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+// ReviewAgentVerification RPC messages.
+type ReviewAgentVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Decision      string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAgentVerificationRequest) Reset() {
+	*x = ReviewAgentVerificationRequest{}
+	mi := &file_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAgentVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAgentVerificationRequest) ProtoMessage() {}
+
+func (x *ReviewAgentVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAgentVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ReviewAgentVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReviewAgentVerificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewAgentVerificationRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *ReviewAgentVerificationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReviewAgentVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verification  *AgentVerification     `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAgentVerificationResponse) Reset() {
+	*x = ReviewAgentVerificationResponse{}
+	mi := &file_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAgentVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAgentVerificationResponse) ProtoMessage() {}
+
+func (x *ReviewAgentVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAgentVerificationResponse.ProtoReflect.Descriptor instead.
+func (*ReviewAgentVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReviewAgentVerificationResponse) GetVerification() *AgentVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+var File_admin_proto protoreflect.FileDescriptor
+
+const file_admin_proto_rawDesc = "" +
+	"\n" +
+	"\vadmin.proto\x12\x02pb\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\n" +
+	"user.proto\"\x89\x03\n" +
+	"\tAdminUser\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12\x1b\n" +
+	"\tis_locked\x18\x03 \x01(\bR\bisLocked\x12#\n" +
+	"\rlocked_reason\x18\x04 \x01(\tR\flockedReason\x127\n" +
+	"\tlocked_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\blockedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x129\n" +
+	"\n" +
+	"last_login\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tlastLogin\x12P\n" +
+	"\x16deletion_scheduled_for\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x14deletionScheduledFor\"\xee\x04\n" +
+	"\x10ListUsersRequest\x12D\n" +
+	"\x05email\x18\x01 \x01(\tB.\x92A+2)Only users whose email contains this textR\x05email\x12A\n" +
+	"\x04name\x18\x02 \x01(\tB-\x92A*2(Only users whose name contains this textR\x04name\x122\n" +
+	"\x04role\x18\x03 \x01(\tB\x1e\x92A\x1b2\x19Only users with this roleR\x04role\x12X\n" +
+	"\bprovider\x18\x04 \x01(\tB<\x92A927Only users who signed up with, or linked, this providerR\bprovider\x12N\n" +
+	"\tis_active\x18\x05 \x01(\bB,\x92A)2'Only active, or only deactivated, usersH\x00R\bisActive\x88\x01\x01\x12L\n" +
+	"\adeleted\x18\x06 \x01(\bB-\x92A*2(Only deleted, or only not deleted, usersH\x01R\adeleted\x88\x01\x01\x12L\n" +
+	"\x05limit\x18\a \x01(\x05B6\x92A321Number of users to return (default: 20, max: 100)R\x05limit\x12=\n" +
+	"\x06offset\x18\b \x01(\x05B%\x92A\"2 Number of matching users to skipR\x06offsetB\f\n" +
+	"\n" +
+	"_is_activeB\n" +
+	"\n" +
+	"\b_deleted\"N\n" +
+	"\x11ListUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.pb.AdminUserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"I\n" +
+	"\x15GetUserDetailsRequest\x120\n" +
+	"\auser_id\x18\x01 \x01(\tB\x17\x92A\x142\x12The ID of the userR\x06userId\";\n" +
+	"\x16GetUserDetailsResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.pb.AdminUserR\x04user\"K\n" +
+	"\x17ListUserSessionsRequest\x120\n" +
+	"\auser_id\x18\x01 \x01(\tB\x17\x92A\x142\x12The ID of the userR\x06userId\"G\n" +
+	"\x18ListUserSessionsResponse\x12+\n" +
+	"\bsessions\x18\x01 \x03(\v2\x0f.pb.SessionInfoR\bsessions\"\xad\x01\n" +
+	"\x1bListUserLoginHistoryRequest\x120\n" +
+	"\auser_id\x18\x01 \x01(\tB\x17\x92A\x142\x12The ID of the userR\x06userId\x12\\\n" +
+	"\x05limit\x18\x02 \x01(\x05BF\x92AC2ANumber of login history entries to return (default: 20, max: 100)R\x05limit\"O\n" +
+	"\x1cListUserLoginHistoryResponse\x12/\n" +
+	"\ahistory\x18\x01 \x03(\v2\x15.pb.LoginHistoryEntryR\ahistory\"R\n" +
+	"\x12ForceLogoutRequest\x12<\n" +
+	"\auser_id\x18\x01 \x01(\tB#\x92A 2\x1eThe ID of the user to sign outR\x06userId\"/\n" +
+	"\x13ForceLogoutResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x9b\x01\n" +
+	"\x0fLockUserRequest\x128\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1f\x92A\x1c2\x1aThe ID of the user to lockR\x06userId\x12N\n" +
+	"\x06reason\x18\x02 \x01(\tB6\x92A321Why the account is locked, shown to support staffR\x06reason\",\n" +
+	"\x10LockUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"O\n" +
+	"\x11UnlockUserRequest\x12:\n" +
+	"\auser_id\x18\x01 \x01(\tB!\x92A\x1e2\x1cThe ID of the user to unlockR\x06userId\".\n" +
+	"\x12UnlockUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x8f\x01\n" +
+	"\x15ChangeUserRoleRequest\x120\n" +
+	"\auser_id\x18\x01 \x01(\tB\x17\x92A\x142\x12The ID of the userR\x06userId\x12D\n" +
+	"\x04role\x18\x02 \x01(\tB0\x92A-2+The new role: buyer, seller, agent or adminR\x04role\";\n" +
+	"\x16ChangeUserRoleResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.pb.AdminUserR\x04user\"O\n" +
+	"\x1bTriggerPasswordResetRequest\x120\n" +
+	"\auser_id\x18\x01 \x01(\tB\x17\x92A\x142\x12The ID of the userR\x06userId\"8\n" +
+	"\x1cTriggerPasswordResetResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"O\n" +
+	"\x11DeleteUserRequest\x12:\n" +
+	"\auser_id\x18\x01 \x01(\tB!\x92A\x1e2\x1cThe ID of the user to deleteR\x06userId\".\n" +
+	"\x12DeleteUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"Q\n" +
+	"\x12RestoreUserRequest\x12;\n" +
+	"\auser_id\x18\x01 \x01(\tB\"\x92A\x1f2\x1dThe ID of the user to restoreR\x06userId\"/\n" +
+	"\x13RestoreUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xa4\x02\n" +
+	"\x16ImpersonateUserRequest\x12:\n" +
+	"\auser_id\x18\x01 \x01(\tB!\x92A\x1e2\x1cThe ID of the user to act asR\x06userId\x12_\n" +
+	"\x06reason\x18\x02 \x01(\tBG\x92AD2BWhy the user is impersonated, shown to them in their login historyR\x06reason\x12m\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05BB\x92A?2=How long the access token is valid for (default: 15, max: 60)R\x0fdurationMinutes\"\xd1\x01\n" +
+	"\x17ImpersonateUserResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12Q\n" +
+	"\x17access_token_expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12!\n" +
+	"\x04user\x18\x04 \x01(\v2\r.pb.AdminUserR\x04user\"\xe0\x03\n" +
+	"\tAuthEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x18\n" +
+	"\aoutcome\x18\x03 \x01(\tR\aoutcome\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x06 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\b \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\t \x01(\tR\tuserAgent\x127\n" +
+	"\bmetadata\x18\n" +
+	" \x03(\v2\x1b.pb.AuthEvent.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06device\x18\f \x01(\tR\x06device\x12\x1a\n" +
+	"\blocation\x18\r \x01(\tR\blocation\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xce\x05\n" +
+	"\x15ListAuthEventsRequest\x129\n" +
+	"\auser_id\x18\x01 \x01(\tB \x92A\x1d2\x1bOnly events about this userR\x06userId\x12S\n" +
+	"\bactor_id\x18\x02 \x01(\tB8\x92A523Only events performed by this user or administratorR\aactorId\x12[\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tB<\x92A927Only events of this type, e.g. login or password_changeR\teventType\x12D\n" +
+	"\aoutcome\x18\x04 \x01(\tB*\x92A'2%Only successful or only failed eventsR\aoutcome\x12D\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tB%\x92A\"2 Only events from this IP addressR\tipAddress\x12X\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB&\x92A#2!Only events at or after this timeR\x05since\x12S\n" +
+	"\x05until\x18\a \x01(\v2\x1a.google.protobuf.TimestampB!\x92A\x1e2\x1cOnly events before this timeR\x05until\x12M\n" +
+	"\x05limit\x18\b \x01(\x05B7\x92A422Number of events to return (default: 20, max: 100)R\x05limit\x12>\n" +
+	"\x06offset\x18\t \x01(\x05B&\x92A#2!Number of matching events to skipR\x06offset\"U\n" +
+	"\x16ListAuthEventsResponse\x12%\n" +
+	"\x06events\x18\x01 \x03(\v2\r.pb.AuthEventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"W\n" +
+	"\x1bGetUserErasureStatusRequest\x128\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1f\x92A\x1c2\x1aThe ID of the deleted userR\x06userId\"\xab\x01\n" +
+	"\x0eServiceErasure\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05items\x18\x03 \x01(\x03R\x05items\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb8\x02\n" +
+	"\x1cGetUserErasureStatusResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12=\n" +
+	"\frequested_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12?\n" +
+	"\rscheduled_for\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fscheduledFor\x129\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12.\n" +
+	"\bservices\x18\x06 \x03(\v2\x12.pb.ServiceErasureR\bservices\"\xf8\x01\n" +
+	"\x1dListAgentVerificationsRequest\x12i\n" +
+	"\x06status\x18\x01 \x01(\tBQ\x92AN2LOne of pending, approved, rejected or more_info_requested (default: pending)R\x06status\x12T\n" +
+	"\x05limit\x18\x02 \x01(\x05B>\x92A;29Number of verifications to return (default: 20, max: 100)R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"s\n" +
+	"\x1eListAgentVerificationsResponse\x12;\n" +
+	"\rverifications\x18\x01 \x03(\v2\x15.pb.AgentVerificationR\rverifications\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"W\n" +
+	"\"GetAgentVerificationDetailsRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\tB\x18\x92A\x152\x13The agent's user IDR\x06userId\"`\n" +
+	"#GetAgentVerificationDetailsResponse\x129\n" +
+	"\fverification\x18\x01 \x01(\v2\x15.pb.AgentVerificationR\fverification\"\xe9\x01\n" +
+	"\x1eReviewAgentVerificationRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\tB\x18\x92A\x152\x13The agent's user IDR\x06userId\x12L\n" +
+	"\bdecision\x18\x02 \x01(\tB0\x92A-2+One of approve, reject or request_more_infoR\bdecision\x12F\n" +
+	"\x04note\x18\x03 \x01(\tB2\x92A/2-Shown to the agent; required unless approvingR\x04note\"\\\n" +
+	"\x1fReviewAgentVerificationResponse\x129\n" +
+	"\fverification\x18\x01 \x01(\v2\x15.pb.AgentVerificationR\fverification2\xe3\x1b\n" +
+	"\fAdminService\x12\xad\x01\n" +
+	"\tListUsers\x12\x14.pb.ListUsersRequest\x1a\x15.pb.ListUsersResponse\"s\x92Ap\n" +
+	"\x05Admin\x12\n" +
+	"List users\x1a[Use this API to search users by email, name, role, provider and account state, newest first\x12\xb3\x01\n" +
+	"\x0eGetUserDetails\x12\x19.pb.GetUserDetailsRequest\x1a\x1a.pb.GetUserDetailsResponse\"j\x92Ag\n" +
+	"\x05Admin\x12\x10Get user details\x1aLUse this API to view a user's account, including locked and deleted accounts\x12\x9c\x01\n" +
+	"\x10ListUserSessions\x12\x1b.pb.ListUserSessionsRequest\x1a\x1c.pb.ListUserSessionsResponse\"M\x92AJ\n" +
+	"\x05Admin\x12\x12List user sessions\x1a-Use this API to list a user's active sessions\x12\xb0\x01\n" +
+	"\x14ListUserLoginHistory\x12\x1f.pb.ListUserLoginHistoryRequest\x1a .pb.ListUserLoginHistoryResponse\"U\x92AR\n" +
+	"\x05Admin\x12\x17List user login history\x1a0Use this API to list a user's most recent logins\x12\x85\x01\n" +
+	"\vForceLogout\x12\x16.pb.ForceLogoutRequest\x1a\x17.pb.ForceLogoutResponse\"E\x92AB\n" +
+	"\x05Admin\x12\fForce logout\x1a+Use this API to end every session of a user\x12\xab\x01\n" +
+	"\bLockUser\x12\x13.pb.LockUserRequest\x1a\x14.pb.LockUserResponse\"t\x92Aq\n" +
+	"\x05Admin\x12\tLock user\x1a]Use this API to stop a user from signing in until they are unlocked. Their sessions are ended\x12\xa8\x01\n" +
+	"\n" +
+	"UnlockUser\x12\x15.pb.UnlockUserRequest\x1a\x16.pb.UnlockUserResponse\"k\x92Ah\n" +
+	"\x05Admin\x12\vUnlock user\x1aRUse this API to lift an administrator lock and any brute-force lockout from a user\x12\xd8\x01\n" +
+	"\x0eChangeUserRole\x12\x19.pb.ChangeUserRoleRequest\x1a\x1a.pb.ChangeUserRoleResponse\"\x8e\x01\x92A\x8a\x01\n" +
+	"\x05Admin\x12\x10Change user role\x1aoUse this API to assign a role to a user. Their sessions are ended so the new role applies on their next sign in\x12\xb1\x01\n" +
+	"\x14TriggerPasswordReset\x12\x1f.pb.TriggerPasswordResetRequest\x1a .pb.TriggerPasswordResetResponse\"V\x92AS\n" +
+	"\x05Admin\x12\x16Trigger password reset\x1a2Use this API to email a user a password reset code\x12\xab\x01\n" +
+	"\n" +
+	"DeleteUser\x12\x15.pb.DeleteUserRequest\x1a\x16.pb.DeleteUserResponse\"n\x92Ak\n" +
+	"\x05Admin\x12\vDelete user\x1aUUse this API to soft-delete a user. Their data is kept so the account can be restored\x12\x85\x01\n" +
+	"\vRestoreUser\x12\x16.pb.RestoreUserRequest\x1a\x17.pb.RestoreUserResponse\"E\x92AB\n" +
+	"\x05Admin\x12\fRestore user\x1a+Use this API to restore a soft-deleted user\x12\xb1\x02\n" +
+	"\x0fImpersonateUser\x12\x1a.pb.ImpersonateUserRequest\x1a\x1b.pb.ImpersonateUserResponse\"\xe4\x01\x92A\xe0\x01\n" +
+	"\x05Admin\x12\x10Impersonate user\x1a\xc4\x01Use this API to get a short-lived access token to act as a user. Requests made with it are audited, sensitive account changes are blocked and the user sees the impersonation in their login history\x12\x86\x02\n" +
+	"\x0eListAuthEvents\x12\x19.pb.ListAuthEventsRequest\x1a\x1a.pb.ListAuthEventsResponse\"\xbc\x01\x92A\xb8\x01\n" +
+	"\x05Admin\x12\x10List auth events\x1a\x9c\x01Use this API to search the security audit log of logins, OTP verifications, password changes, session revocations, deactivations and deletions, newest first\x12\xf0\x01\n" +
+	"\x14GetUserErasureStatus\x12\x1f.pb.GetUserErasureStatusRequest\x1a .pb.GetUserErasureStatusResponse\"\x94\x01\x92A\x90\x01\n" +
+	"\x05Admin\x12\x17Get user erasure status\x1anUse this API to follow a deleted account through its grace period and the erasure of its data in every service\x12\x9f\x02\n" +
+	"\x16ListAgentVerifications\x12!.pb.ListAgentVerificationsRequest\x1a\".pb.ListAgentVerificationsResponse\"\xbd\x01\x92A\xb9\x01\n" +
+	"\x05Admin\x12\x18List agent verifications\x1a\x95\x01Use this API to work through the agent verification review queue, oldest submission first. Lists pending verifications unless another status is given\x12\xfb\x01\n" +
+	"\x1bGetAgentVerificationDetails\x12&.pb.GetAgentVerificationDetailsRequest\x1a'.pb.GetAgentVerificationDetailsResponse\"\x8a\x01\x92A\x86\x01\n" +
+	"\x05Admin\x12\x1eGet agent verification details\x1a]Use this API to view an agent's verification with signed links to the documents they uploaded\x12\xa4\x02\n" +
+	"\x17ReviewAgentVerification\x12\".pb.ReviewAgentVerificationRequest\x1a#.pb.ReviewAgentVerificationResponse\"\xbf\x01\x92A\xbb\x01\n" +
+	"\x05Admin\x12\x19Review agent verification\x1a\x96\x01Use this API to approve or reject a pending agent verification, or ask the agent for more information. Approving it gives the agent the verified badgeB0Z.github.com/demola234/realio_go_microservice/pbb\x06proto3"
+
+var (
+	file_admin_proto_rawDescOnce sync.Once
+	file_admin_proto_rawDescData []byte
+)
+
+func file_admin_proto_rawDescGZIP() []byte {
+	file_admin_proto_rawDescOnce.Do(func() {
+		file_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)))
+	})
+	return file_admin_proto_rawDescData
+}
+
+var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_admin_proto_goTypes = []any{
+	(*AdminUser)(nil),                           // 0: pb.AdminUser
+	(*ListUsersRequest)(nil),                    // 1: pb.ListUsersRequest
+	(*ListUsersResponse)(nil),                   // 2: pb.ListUsersResponse
+	(*GetUserDetailsRequest)(nil),               // 3: pb.GetUserDetailsRequest
+	(*GetUserDetailsResponse)(nil),              // 4: pb.GetUserDetailsResponse
+	(*ListUserSessionsRequest)(nil),             // 5: pb.ListUserSessionsRequest
+	(*ListUserSessionsResponse)(nil),            // 6: pb.ListUserSessionsResponse
+	(*ListUserLoginHistoryRequest)(nil),         // 7: pb.ListUserLoginHistoryRequest
+	(*ListUserLoginHistoryResponse)(nil),        // 8: pb.ListUserLoginHistoryResponse
+	(*ForceLogoutRequest)(nil),                  // 9: pb.ForceLogoutRequest
+	(*ForceLogoutResponse)(nil),                 // 10: pb.ForceLogoutResponse
+	(*LockUserRequest)(nil),                     // 11: pb.LockUserRequest
+	(*LockUserResponse)(nil),                    // 12: pb.LockUserResponse
+	(*UnlockUserRequest)(nil),                   // 13: pb.UnlockUserRequest
+	(*UnlockUserResponse)(nil),                  // 14: pb.UnlockUserResponse
+	(*ChangeUserRoleRequest)(nil),               // 15: pb.ChangeUserRoleRequest
+	(*ChangeUserRoleResponse)(nil),              // 16: pb.ChangeUserRoleResponse
+	(*TriggerPasswordResetRequest)(nil),         // 17: pb.TriggerPasswordResetRequest
+	(*TriggerPasswordResetResponse)(nil),        // 18: pb.TriggerPasswordResetResponse
+	(*DeleteUserRequest)(nil),                   // 19: pb.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                  // 20: pb.DeleteUserResponse
+	(*RestoreUserRequest)(nil),                  // 21: pb.RestoreUserRequest
+	(*RestoreUserResponse)(nil),                 // 22: pb.RestoreUserResponse
+	(*ImpersonateUserRequest)(nil),              // 23: pb.ImpersonateUserRequest
+	(*ImpersonateUserResponse)(nil),             // 24: pb.ImpersonateUserResponse
+	(*AuthEvent)(nil),                           // 25: pb.AuthEvent
+	(*ListAuthEventsRequest)(nil),               // 26: pb.ListAuthEventsRequest
+	(*ListAuthEventsResponse)(nil),              // 27: pb.ListAuthEventsResponse
+	(*GetUserErasureStatusRequest)(nil),         // 28: pb.GetUserErasureStatusRequest
+	(*ServiceErasure)(nil),                      // 29: pb.ServiceErasure
+	(*GetUserErasureStatusResponse)(nil),        // 30: pb.GetUserErasureStatusResponse
+	(*ListAgentVerificationsRequest)(nil),       // 31: pb.ListAgentVerificationsRequest
+	(*ListAgentVerificationsResponse)(nil),      // 32: pb.ListAgentVerificationsResponse
+	(*GetAgentVerificationDetailsRequest)(nil),  // 33: pb.GetAgentVerificationDetailsRequest
+	(*GetAgentVerificationDetailsResponse)(nil), // 34: pb.GetAgentVerificationDetailsResponse
+	(*ReviewAgentVerificationRequest)(nil),      // 35: pb.ReviewAgentVerificationRequest
+	(*ReviewAgentVerificationResponse)(nil),     // 36: pb.ReviewAgentVerificationResponse
+	nil,                                         // 37: pb.AuthEvent.MetadataEntry
+	(*User)(nil),                                // 38: pb.User
+	(*timestamppb.Timestamp)(nil),               // 39: google.protobuf.Timestamp
+	(*SessionInfo)(nil),                         // 40: pb.SessionInfo
+	(*LoginHistoryEntry)(nil),                   // 41: pb.LoginHistoryEntry
+	(*AgentVerification)(nil),                   // 42: pb.AgentVerification
+}
+var file_admin_proto_depIdxs = []int32{
+	38, // 0: pb.AdminUser.user:type_name -> pb.User
+	39, // 1: pb.AdminUser.locked_at:type_name -> google.protobuf.Timestamp
+	39, // 2: pb.AdminUser.deleted_at:type_name -> google.protobuf.Timestamp
+	39, // 3: pb.AdminUser.last_login:type_name -> google.protobuf.Timestamp
+	39, // 4: pb.AdminUser.deletion_scheduled_for:type_name -> google.protobuf.Timestamp
+	0,  // 5: pb.ListUsersResponse.users:type_name -> pb.AdminUser
+	0,  // 6: pb.GetUserDetailsResponse.user:type_name -> pb.AdminUser
+	40, // 7: pb.ListUserSessionsResponse.sessions:type_name -> pb.SessionInfo
+	41, // 8: pb.ListUserLoginHistoryResponse.history:type_name -> pb.LoginHistoryEntry
+	0,  // 9: pb.ChangeUserRoleResponse.user:type_name -> pb.AdminUser
+	39, // 10: pb.ImpersonateUserResponse.access_token_expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: pb.ImpersonateUserResponse.user:type_name -> pb.AdminUser
+	37, // 12: pb.AuthEvent.metadata:type_name -> pb.AuthEvent.MetadataEntry
+	39, // 13: pb.AuthEvent.created_at:type_name -> google.protobuf.Timestamp
+	39, // 14: pb.ListAuthEventsRequest.since:type_name -> google.protobuf.Timestamp
+	39, // 15: pb.ListAuthEventsRequest.until:type_name -> google.protobuf.Timestamp
+	25, // 16: pb.ListAuthEventsResponse.events:type_name -> pb.AuthEvent
+	39, // 17: pb.ServiceErasure.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 18: pb.GetUserErasureStatusResponse.requested_at:type_name -> google.protobuf.Timestamp
+	39, // 19: pb.GetUserErasureStatusResponse.scheduled_for:type_name -> google.protobuf.Timestamp
+	39, // 20: pb.GetUserErasureStatusResponse.deleted_at:type_name -> google.protobuf.Timestamp
+	29, // 21: pb.GetUserErasureStatusResponse.services:type_name -> pb.ServiceErasure
+	42, // 22: pb.ListAgentVerificationsResponse.verifications:type_name -> pb.AgentVerification
+	42, // 23: pb.GetAgentVerificationDetailsResponse.verification:type_name -> pb.AgentVerification
+	42, // 24: pb.ReviewAgentVerificationResponse.verification:type_name -> pb.AgentVerification
+	1,  // 25: pb.AdminService.ListUsers:input_type -> pb.ListUsersRequest
+	3,  // 26: pb.AdminService.GetUserDetails:input_type -> pb.GetUserDetailsRequest
+	5,  // 27: pb.AdminService.ListUserSessions:input_type -> pb.ListUserSessionsRequest
+	7,  // 28: pb.AdminService.ListUserLoginHistory:input_type -> pb.ListUserLoginHistoryRequest
+	9,  // 29: pb.AdminService.ForceLogout:input_type -> pb.ForceLogoutRequest
+	11, // 30: pb.AdminService.LockUser:input_type -> pb.LockUserRequest
+	13, // 31: pb.AdminService.UnlockUser:input_type -> pb.UnlockUserRequest
+	15, // 32: pb.AdminService.ChangeUserRole:input_type -> pb.ChangeUserRoleRequest
+	17, // 33: pb.AdminService.TriggerPasswordReset:input_type -> pb.TriggerPasswordResetRequest
+	19, // 34: pb.AdminService.DeleteUser:input_type -> pb.DeleteUserRequest
+	21, // 35: pb.AdminService.RestoreUser:input_type -> pb.RestoreUserRequest
+	23, // 36: pb.AdminService.ImpersonateUser:input_type -> pb.ImpersonateUserRequest
+	26, // 37: pb.AdminService.ListAuthEvents:input_type -> pb.ListAuthEventsRequest
+	28, // 38: pb.AdminService.GetUserErasureStatus:input_type -> pb.GetUserErasureStatusRequest
+	31, // 39: pb.AdminService.ListAgentVerifications:input_type -> pb.ListAgentVerificationsRequest
+	33, // 40: pb.AdminService.GetAgentVerificationDetails:input_type -> pb.GetAgentVerificationDetailsRequest
+	35, // 41: pb.AdminService.ReviewAgentVerification:input_type -> pb.ReviewAgentVerificationRequest
+	2,  // 42: pb.AdminService.ListUsers:output_type -> pb.ListUsersResponse
+	4,  // 43: pb.AdminService.GetUserDetails:output_type -> pb.GetUserDetailsResponse
+	6,  // 44: pb.AdminService.ListUserSessions:output_type -> pb.ListUserSessionsResponse
+	8,  // 45: pb.AdminService.ListUserLoginHistory:output_type -> pb.ListUserLoginHistoryResponse
+	10, // 46: pb.AdminService.ForceLogout:output_type -> pb.ForceLogoutResponse
+	12, // 47: pb.AdminService.LockUser:output_type -> pb.LockUserResponse
+	14, // 48: pb.AdminService.UnlockUser:output_type -> pb.UnlockUserResponse
+	16, // 49: pb.AdminService.ChangeUserRole:output_type -> pb.ChangeUserRoleResponse
+	18, // 50: pb.AdminService.TriggerPasswordReset:output_type -> pb.TriggerPasswordResetResponse
+	20, // 51: pb.AdminService.DeleteUser:output_type -> pb.DeleteUserResponse
+	22, // 52: pb.AdminService.RestoreUser:output_type -> pb.RestoreUserResponse
+	24, // 53: pb.AdminService.ImpersonateUser:output_type -> pb.ImpersonateUserResponse
+	27, // 54: pb.AdminService.ListAuthEvents:output_type -> pb.ListAuthEventsResponse
+	30, // 55: pb.AdminService.GetUserErasureStatus:output_type -> pb.GetUserErasureStatusResponse
+	32, // 56: pb.AdminService.ListAgentVerifications:output_type -> pb.ListAgentVerificationsResponse
+	34, // 57: pb.AdminService.GetAgentVerificationDetails:output_type -> pb.GetAgentVerificationDetailsResponse
+	36, // 58: pb.AdminService.ReviewAgentVerification:output_type -> pb.ReviewAgentVerificationResponse
+	42, // [42:59] is the sub-list for method output_type
+	25, // [25:42] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_admin_proto_init() }
+func file_admin_proto_init() {
+	if File_admin_proto != nil {
+		return
+	}
+	file_user_proto_init()
+	file_admin_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   38,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_proto_goTypes,
+		DependencyIndexes: file_admin_proto_depIdxs,
+		MessageInfos:      file_admin_proto_msgTypes,
+	}.Build()
+	File_admin_proto = out.File
+	file_admin_proto_goTypes = nil
+	file_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,1666 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: organization.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Organization groups users, such as the agents of an agency.
+type Organization struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The requesting user's role in the organization
+	Role          string `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_organization_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Organization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type OrganizationMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationMember) Reset() {
+	*x = OrganizationMember{}
+	mi := &file_organization_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationMember) ProtoMessage() {}
+
+func (x *OrganizationMember) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationMember.ProtoReflect.Descriptor instead.
+func (*OrganizationMember) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrganizationMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+type OrganizationInvitation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId   string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,3,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	Email            string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Role             string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	InvitedBy        string                 `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OrganizationInvitation) Reset() {
+	*x = OrganizationInvitation{}
+	mi := &file_organization_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationInvitation) ProtoMessage() {}
+
+func (x *OrganizationInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationInvitation.ProtoReflect.Descriptor instead.
+func (*OrganizationInvitation) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrganizationInvitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrganizationInvitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CreateOrganization RPC messages.
+type CreateOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_organization_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateOrganizationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationResponse) Reset() {
+	*x = CreateOrganizationResponse{}
+	mi := &file_organization_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// ListOrganizations RPC messages.
+type ListOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsRequest) Reset() {
+	*x = ListOrganizationsRequest{}
+	mi := &file_organization_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListOrganizationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListOrganizationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsResponse) Reset() {
+	*x = ListOrganizationsResponse{}
+	mi := &file_organization_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListOrganizationsResponse) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+// GetOrganization RPC messages.
+type GetOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationRequest) Reset() {
+	*x = GetOrganizationRequest{}
+	mi := &file_organization_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationRequest) ProtoMessage() {}
+
+func (x *GetOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetOrganizationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Members       []*OrganizationMember  `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationResponse) Reset() {
+	*x = GetOrganizationResponse{}
+	mi := &file_organization_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationResponse) ProtoMessage() {}
+
+func (x *GetOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *GetOrganizationResponse) GetMembers() []*OrganizationMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// InviteOrganizationMember RPC messages.
+type InviteOrganizationMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Email          string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InviteOrganizationMemberRequest) Reset() {
+	*x = InviteOrganizationMemberRequest{}
+	mi := &file_organization_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *InviteOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InviteOrganizationMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InviteOrganizationMemberRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *InviteOrganizationMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteOrganizationMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type InviteOrganizationMemberResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Invitation    *OrganizationInvitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteOrganizationMemberResponse) Reset() {
+	*x = InviteOrganizationMemberResponse{}
+	mi := &file_organization_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteOrganizationMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteOrganizationMemberResponse) ProtoMessage() {}
+
+func (x *InviteOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*InviteOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InviteOrganizationMemberResponse) GetInvitation() *OrganizationInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// ListOrganizationInvitations RPC messages.
+type ListOrganizationInvitationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListOrganizationInvitationsRequest) Reset() {
+	*x = ListOrganizationInvitationsRequest{}
+	mi := &file_organization_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationInvitationsRequest) ProtoMessage() {}
+
+func (x *ListOrganizationInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListOrganizationInvitationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListOrganizationInvitationsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListOrganizationInvitationsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Invitations   []*OrganizationInvitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationInvitationsResponse) Reset() {
+	*x = ListOrganizationInvitationsResponse{}
+	mi := &file_organization_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationInvitationsResponse) ProtoMessage() {}
+
+func (x *ListOrganizationInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListOrganizationInvitationsResponse) GetInvitations() []*OrganizationInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+// RevokeOrganizationInvitation RPC messages.
+type RevokeOrganizationInvitationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	InvitationId   string                 `protobuf:"bytes,3,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevokeOrganizationInvitationRequest) Reset() {
+	*x = RevokeOrganizationInvitationRequest{}
+	mi := &file_organization_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOrganizationInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOrganizationInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeOrganizationInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOrganizationInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOrganizationInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RevokeOrganizationInvitationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeOrganizationInvitationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RevokeOrganizationInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type RevokeOrganizationInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOrganizationInvitationResponse) Reset() {
+	*x = RevokeOrganizationInvitationResponse{}
+	mi := &file_organization_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOrganizationInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOrganizationInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeOrganizationInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOrganizationInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeOrganizationInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokeOrganizationInvitationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// AcceptOrganizationInvitation RPC messages.
+type AcceptOrganizationInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOrganizationInvitationRequest) Reset() {
+	*x = AcceptOrganizationInvitationRequest{}
+	mi := &file_organization_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrganizationInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrganizationInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptOrganizationInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrganizationInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOrganizationInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AcceptOrganizationInvitationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptOrganizationInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptOrganizationInvitationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Member         *OrganizationMember    `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AcceptOrganizationInvitationResponse) Reset() {
+	*x = AcceptOrganizationInvitationResponse{}
+	mi := &file_organization_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrganizationInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrganizationInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptOrganizationInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrganizationInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptOrganizationInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AcceptOrganizationInvitationResponse) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AcceptOrganizationInvitationResponse) GetMember() *OrganizationMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// DeclineOrganizationInvitation RPC messages.
+type DeclineOrganizationInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineOrganizationInvitationRequest) Reset() {
+	*x = DeclineOrganizationInvitationRequest{}
+	mi := &file_organization_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineOrganizationInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineOrganizationInvitationRequest) ProtoMessage() {}
+
+func (x *DeclineOrganizationInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineOrganizationInvitationRequest.ProtoReflect.Descriptor instead.
+func (*DeclineOrganizationInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeclineOrganizationInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DeclineOrganizationInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineOrganizationInvitationResponse) Reset() {
+	*x = DeclineOrganizationInvitationResponse{}
+	mi := &file_organization_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineOrganizationInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineOrganizationInvitationResponse) ProtoMessage() {}
+
+func (x *DeclineOrganizationInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineOrganizationInvitationResponse.ProtoReflect.Descriptor instead.
+func (*DeclineOrganizationInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeclineOrganizationInvitationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UpdateOrganizationMemberRole RPC messages.
+type UpdateOrganizationMemberRoleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	MemberId       string                 `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) Reset() {
+	*x = UpdateOrganizationMemberRoleRequest{}
+	mi := &file_organization_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateOrganizationMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationMemberRoleResponse) Reset() {
+	*x = UpdateOrganizationMemberRoleResponse{}
+	mi := &file_organization_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationMemberRoleResponse) ProtoMessage() {}
+
+func (x *UpdateOrganizationMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateOrganizationMemberRoleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RemoveOrganizationMember RPC messages.
+type RemoveOrganizationMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	MemberId       string                 `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveOrganizationMemberRequest) Reset() {
+	*x = RemoveOrganizationMemberRequest{}
+	mi := &file_organization_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *RemoveOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveOrganizationMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveOrganizationMemberRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RemoveOrganizationMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type RemoveOrganizationMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrganizationMemberResponse) Reset() {
+	*x = RemoveOrganizationMemberResponse{}
+	mi := &file_organization_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrganizationMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrganizationMemberResponse) ProtoMessage() {}
+
+func (x *RemoveOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveOrganizationMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// SwitchOrganization RPC messages.
+type SwitchOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId      string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SwitchOrganizationRequest) Reset() {
+	*x = SwitchOrganizationRequest{}
+	mi := &file_organization_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchOrganizationRequest) ProtoMessage() {}
+
+func (x *SwitchOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SwitchOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SwitchOrganizationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SwitchOrganizationRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SwitchOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type SwitchOrganizationResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken          string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	AccessTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3" json:"access_token_expires_at,omitempty"`
+	OrganizationId       string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SwitchOrganizationResponse) Reset() {
+	*x = SwitchOrganizationResponse{}
+	mi := &file_organization_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchOrganizationResponse) ProtoMessage() {}
+
+func (x *SwitchOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*SwitchOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SwitchOrganizationResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *SwitchOrganizationResponse) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccessTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *SwitchOrganizationResponse) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+var File_organization_proto protoreflect.FileDescriptor
+
+const file_organization_proto_rawDesc = "" +
+	"\n" +
+	"\x12organization.proto\x12\x02pb\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x01\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\"\xa4\x01\n" +
+	"\x12OrganizationMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x127\n" +
+	"\tjoined_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\xd5\x02\n" +
+	"\x16OrganizationInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12+\n" +
+	"\x11organization_name\x18\x03 \x01(\tR\x10organizationName\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x06 \x01(\tR\tinvitedBy\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"z\n" +
+	"\x19CreateOrganizationRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x120\n" +
+	"\x04name\x18\x02 \x01(\tB\x1c\x92A\x192\x17The organization's nameR\x04name\"R\n" +
+	"\x1aCreateOrganizationResponse\x124\n" +
+	"\forganization\x18\x01 \x01(\v2\x10.pb.OrganizationR\forganization\"G\n" +
+	"\x18ListOrganizationsRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"S\n" +
+	"\x19ListOrganizationsResponse\x126\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x10.pb.OrganizationR\rorganizations\"n\n" +
+	"\x16GetOrganizationRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"\x81\x01\n" +
+	"\x17GetOrganizationResponse\x124\n" +
+	"\forganization\x18\x01 \x01(\v2\x10.pb.OrganizationR\forganization\x120\n" +
+	"\amembers\x18\x02 \x03(\v2\x16.pb.OrganizationMemberR\amembers\"\x9e\x02\n" +
+	"\x1fInviteOrganizationMemberRequest\x12G\n" +
+	"\auser_id\x18\x01 \x01(\tB.\x92A+2)The ID of the user sending the invitationR\x06userId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12@\n" +
+	"\x05email\x18\x03 \x01(\tB*\x92A'2%The address to send the invitation toR\x05email\x12G\n" +
+	"\x04role\x18\x04 \x01(\tB3\x92A02.The role to join with: owner, manager or agentR\x04role\"^\n" +
+	" InviteOrganizationMemberResponse\x12:\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x1a.pb.OrganizationInvitationR\n" +
+	"invitation\"z\n" +
+	"\"ListOrganizationInvitationsRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"c\n" +
+	"#ListOrganizationInvitationsResponse\x12<\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x1a.pb.OrganizationInvitationR\vinvitations\"\xa0\x01\n" +
+	"#RevokeOrganizationInvitationRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rinvitation_id\x18\x03 \x01(\tR\finvitationId\"@\n" +
+	"$RevokeOrganizationInvitationResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x92\x01\n" +
+	"#AcceptOrganizationInvitationRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x12>\n" +
+	"\x05token\x18\x02 \x01(\tB(\x92A%2#The token from the invitation emailR\x05token\"\x7f\n" +
+	"$AcceptOrganizationInvitationResponse\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12.\n" +
+	"\x06member\x18\x02 \x01(\v2\x16.pb.OrganizationMemberR\x06member\"f\n" +
+	"$DeclineOrganizationInvitationRequest\x12>\n" +
+	"\x05token\x18\x01 \x01(\tB(\x92A%2#The token from the invitation emailR\x05token\"A\n" +
+	"%DeclineOrganizationInvitationResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x8b\x02\n" +
+	"#UpdateOrganizationMemberRoleRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12N\n" +
+	"\tmember_id\x18\x03 \x01(\tB1\x92A.2,The user ID of the member whose role changesR\bmemberId\x12>\n" +
+	"\x04role\x18\x04 \x01(\tB*\x92A'2%The new role: owner, manager or agentR\x04role\"@\n" +
+	"$UpdateOrganizationMemberRoleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xbe\x01\n" +
+	"\x1fRemoveOrganizationMemberRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12E\n" +
+	"\tmember_id\x18\x03 \x01(\tB(\x92A%2#The user ID of the member to removeR\bmemberId\"<\n" +
+	" RemoveOrganizationMemberResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x89\x02\n" +
+	"\x19SwitchOrganizationRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x12]\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tB>\x92A;29The session of the access token the request was made withR\tsessionId\x12`\n" +
+	"\x0forganization_id\x18\x03 \x01(\tB7\x92A422The organization to act for; empty to act for noneR\x0eorganizationId\"\xbb\x01\n" +
+	"\x1aSwitchOrganizationResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12Q\n" +
+	"\x17access_token_expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId2\xb8\x14\n" +
+	"\x13OrganizationService\x12\xbf\x01\n" +
+	"\x12CreateOrganization\x12\x1d.pb.CreateOrganizationRequest\x1a\x1e.pb.CreateOrganizationResponse\"j\x92Ag\n" +
+	"\fOrganization\x12\x13Create organization\x1aBUse this API to create an organization. The user becomes its owner\x12\xd0\x01\n" +
+	"\x11ListOrganizations\x12\x1c.pb.ListOrganizationsRequest\x1a\x1d.pb.ListOrganizationsResponse\"~\x92A{\n" +
+	"\fOrganization\x12\x12List organizations\x1aWUse this API to list the organizations the user is a member of, with their role in each\x12\xbf\x01\n" +
+	"\x0fGetOrganization\x12\x1a.pb.GetOrganizationRequest\x1a\x1b.pb.GetOrganizationResponse\"s\x92Ap\n" +
+	"\fOrganization\x12\x10Get organization\x1aNUse this API to view an organization and its members. Only members can view it\x12\x89\x02\n" +
+	"\x18InviteOrganizationMember\x12#.pb.InviteOrganizationMemberRequest\x1a$.pb.InviteOrganizationMemberResponse\"\xa1\x01\x92A\x9d\x01\n" +
+	"\fOrganization\x12\x1aInvite organization member\x1aqUse this API to email an invitation to join the organization. Owners can invite any role and managers only agents\x12\xe0\x01\n" +
+	"\x1bListOrganizationInvitations\x12&.pb.ListOrganizationInvitationsRequest\x1a'.pb.ListOrganizationInvitationsResponse\"p\x92Am\n" +
+	"\fOrganization\x12\x1dList organization invitations\x1a>Use this API to list the organization's unanswered invitations\x12\xe3\x01\n" +
+	"\x1cRevokeOrganizationInvitation\x12'.pb.RevokeOrganizationInvitationRequest\x1a(.pb.RevokeOrganizationInvitationResponse\"p\x92Am\n" +
+	"\fOrganization\x12\x1eRevoke organization invitation\x1a=Use this API to stop a pending invitation's link from working\x12\x87\x02\n" +
+	"\x1cAcceptOrganizationInvitation\x12'.pb.AcceptOrganizationInvitationRequest\x1a(.pb.AcceptOrganizationInvitationResponse\"\x93\x01\x92A\x8f\x01\n" +
+	"\fOrganization\x12\x1eAccept organization invitation\x1a_Use this API to join an organization with the token from an invitation sent to the user's email\x12\xef\x01\n" +
+	"\x1dDeclineOrganizationInvitation\x12(.pb.DeclineOrganizationInvitationRequest\x1a).pb.DeclineOrganizationInvitationResponse\"y\x92Av\n" +
+	"\fOrganization\x12\x1fDecline organization invitation\x1aEUse this API to turn down an invitation with the token from its email\x12\xf6\x01\n" +
+	"\x1cUpdateOrganizationMemberRole\x12'.pb.UpdateOrganizationMemberRoleRequest\x1a(.pb.UpdateOrganizationMemberRoleResponse\"\x82\x01\x92A\x7f\n" +
+	"\fOrganization\x12\x1fUpdate organization member role\x1aNUse this API to change a member's role. The organization always keeps an owner\x12\xfa\x01\n" +
+	"\x18RemoveOrganizationMember\x12#.pb.RemoveOrganizationMemberRequest\x1a$.pb.RemoveOrganizationMemberResponse\"\x92\x01\x92A\x8e\x01\n" +
+	"\fOrganization\x12\x1aRemove organization member\x1abUse this API to remove a member from the organization, or to leave it by passing the user's own ID\x12\x82\x02\n" +
+	"\x12SwitchOrganization\x12\x1d.pb.SwitchOrganizationRequest\x1a\x1e.pb.SwitchOrganizationResponse\"\xac\x01\x92A\xa8\x01\n" +
+	"\fOrganization\x12\x13Switch organization\x1a\x82\x01Use this API to make the current session act for one of the user's organizations, or for none, and get an access token carrying itB0Z.github.com/demola234/realio_go_microservice/pbb\x06proto3"
+
+var (
+	file_organization_proto_rawDescOnce sync.Once
+	file_organization_proto_rawDescData []byte
+)
+
+func file_organization_proto_rawDescGZIP() []byte {
+	file_organization_proto_rawDescOnce.Do(func() {
+		file_organization_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_organization_proto_rawDesc), len(file_organization_proto_rawDesc)))
+	})
+	return file_organization_proto_rawDescData
+}
+
+var file_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_organization_proto_goTypes = []any{
+	(*Organization)(nil),                          // 0: pb.Organization
+	(*OrganizationMember)(nil),                    // 1: pb.OrganizationMember
+	(*OrganizationInvitation)(nil),                // 2: pb.OrganizationInvitation
+	(*CreateOrganizationRequest)(nil),             // 3: pb.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),            // 4: pb.CreateOrganizationResponse
+	(*ListOrganizationsRequest)(nil),              // 5: pb.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),             // 6: pb.ListOrganizationsResponse
+	(*GetOrganizationRequest)(nil),                // 7: pb.GetOrganizationRequest
+	(*GetOrganizationResponse)(nil),               // 8: pb.GetOrganizationResponse
+	(*InviteOrganizationMemberRequest)(nil),       // 9: pb.InviteOrganizationMemberRequest
+	(*InviteOrganizationMemberResponse)(nil),      // 10: pb.InviteOrganizationMemberResponse
+	(*ListOrganizationInvitationsRequest)(nil),    // 11: pb.ListOrganizationInvitationsRequest
+	(*ListOrganizationInvitationsResponse)(nil),   // 12: pb.ListOrganizationInvitationsResponse
+	(*RevokeOrganizationInvitationRequest)(nil),   // 13: pb.RevokeOrganizationInvitationRequest
+	(*RevokeOrganizationInvitationResponse)(nil),  // 14: pb.RevokeOrganizationInvitationResponse
+	(*AcceptOrganizationInvitationRequest)(nil),   // 15: pb.AcceptOrganizationInvitationRequest
+	(*AcceptOrganizationInvitationResponse)(nil),  // 16: pb.AcceptOrganizationInvitationResponse
+	(*DeclineOrganizationInvitationRequest)(nil),  // 17: pb.DeclineOrganizationInvitationRequest
+	(*DeclineOrganizationInvitationResponse)(nil), // 18: pb.DeclineOrganizationInvitationResponse
+	(*UpdateOrganizationMemberRoleRequest)(nil),   // 19: pb.UpdateOrganizationMemberRoleRequest
+	(*UpdateOrganizationMemberRoleResponse)(nil),  // 20: pb.UpdateOrganizationMemberRoleResponse
+	(*RemoveOrganizationMemberRequest)(nil),       // 21: pb.RemoveOrganizationMemberRequest
+	(*RemoveOrganizationMemberResponse)(nil),      // 22: pb.RemoveOrganizationMemberResponse
+	(*SwitchOrganizationRequest)(nil),             // 23: pb.SwitchOrganizationRequest
+	(*SwitchOrganizationResponse)(nil),            // 24: pb.SwitchOrganizationResponse
+	(*timestamppb.Timestamp)(nil),                 // 25: google.protobuf.Timestamp
+}
+var file_organization_proto_depIdxs = []int32{
+	25, // 0: pb.Organization.created_at:type_name -> google.protobuf.Timestamp
+	25, // 1: pb.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 2: pb.OrganizationMember.joined_at:type_name -> google.protobuf.Timestamp
+	25, // 3: pb.OrganizationInvitation.created_at:type_name -> google.protobuf.Timestamp
+	25, // 4: pb.OrganizationInvitation.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: pb.CreateOrganizationResponse.organization:type_name -> pb.Organization
+	0,  // 6: pb.ListOrganizationsResponse.organizations:type_name -> pb.Organization
+	0,  // 7: pb.GetOrganizationResponse.organization:type_name -> pb.Organization
+	1,  // 8: pb.GetOrganizationResponse.members:type_name -> pb.OrganizationMember
+	2,  // 9: pb.InviteOrganizationMemberResponse.invitation:type_name -> pb.OrganizationInvitation
+	2,  // 10: pb.ListOrganizationInvitationsResponse.invitations:type_name -> pb.OrganizationInvitation
+	1,  // 11: pb.AcceptOrganizationInvitationResponse.member:type_name -> pb.OrganizationMember
+	25, // 12: pb.SwitchOrganizationResponse.access_token_expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 13: pb.OrganizationService.CreateOrganization:input_type -> pb.CreateOrganizationRequest
+	5,  // 14: pb.OrganizationService.ListOrganizations:input_type -> pb.ListOrganizationsRequest
+	7,  // 15: pb.OrganizationService.GetOrganization:input_type -> pb.GetOrganizationRequest
+	9,  // 16: pb.OrganizationService.InviteOrganizationMember:input_type -> pb.InviteOrganizationMemberRequest
+	11, // 17: pb.OrganizationService.ListOrganizationInvitations:input_type -> pb.ListOrganizationInvitationsRequest
+	13, // 18: pb.OrganizationService.RevokeOrganizationInvitation:input_type -> pb.RevokeOrganizationInvitationRequest
+	15, // 19: pb.OrganizationService.AcceptOrganizationInvitation:input_type -> pb.AcceptOrganizationInvitationRequest
+	17, // 20: pb.OrganizationService.DeclineOrganizationInvitation:input_type -> pb.DeclineOrganizationInvitationRequest
+	19, // 21: pb.OrganizationService.UpdateOrganizationMemberRole:input_type -> pb.UpdateOrganizationMemberRoleRequest
+	21, // 22: pb.OrganizationService.RemoveOrganizationMember:input_type -> pb.RemoveOrganizationMemberRequest
+	23, // 23: pb.OrganizationService.SwitchOrganization:input_type -> pb.SwitchOrganizationRequest
+	4,  // 24: pb.OrganizationService.CreateOrganization:output_type -> pb.CreateOrganizationResponse
+	6,  // 25: pb.OrganizationService.ListOrganizations:output_type -> pb.ListOrganizationsResponse
+	8,  // 26: pb.OrganizationService.GetOrganization:output_type -> pb.GetOrganizationResponse
+	10, // 27: pb.OrganizationService.InviteOrganizationMember:output_type -> pb.InviteOrganizationMemberResponse
+	12, // 28: pb.OrganizationService.ListOrganizationInvitations:output_type -> pb.ListOrganizationInvitationsResponse
+	14, // 29: pb.OrganizationService.RevokeOrganizationInvitation:output_type -> pb.RevokeOrganizationInvitationResponse
+	16, // 30: pb.OrganizationService.AcceptOrganizationInvitation:output_type -> pb.AcceptOrganizationInvitationResponse
+	18, // 31: pb.OrganizationService.DeclineOrganizationInvitation:output_type -> pb.DeclineOrganizationInvitationResponse
+	20, // 32: pb.OrganizationService.UpdateOrganizationMemberRole:output_type -> pb.UpdateOrganizationMemberRoleResponse
+	22, // 33: pb.OrganizationService.RemoveOrganizationMember:output_type -> pb.RemoveOrganizationMemberResponse
+	24, // 34: pb.OrganizationService.SwitchOrganization:output_type -> pb.SwitchOrganizationResponse
+	24, // [24:35] is the sub-list for method output_type
+	13, // [13:24] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_organization_proto_init() }
+func file_organization_proto_init() {
+	if File_organization_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_organization_proto_rawDesc), len(file_organization_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_organization_proto_goTypes,
+		DependencyIndexes: file_organization_proto_depIdxs,
+		MessageInfos:      file_organization_proto_msgTypes,
+	}.Build()
+	File_organization_proto = out.File
+	file_organization_proto_goTypes = nil
+	file_organization_proto_depIdxs = nil
+}
@@ -26,16 +26,18 @@ const (
 
 // User entity with core user details.
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
-	Phone         string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
-	IsVerified    bool                   `protobuf:"varint,7,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email      string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FullName   string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Password   string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Role       string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Phone      string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	IsVerified bool                   `protobuf:"varint,7,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Whether the user is an agent whose identity and license have been approved
+	VerifiedAgent bool `protobuf:"varint,10,opt,name=verified_agent,json=verifiedAgent,proto3" json:"verified_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,13 +135,22 @@ func (x *User) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetVerifiedAgent() bool {
+	if x != nil {
+		return x.VerifiedAgent
+	}
+	return false
+}
+
 // Session entity containing token information.
 type Session struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Token                 string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RefreshTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Session) Reset() {
@@ -186,6 +197,20 @@ func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Session) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *Session) GetRefreshTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshTokenExpiresAt
+	}
+	return nil
+}
+
 // Login RPC messages.
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -243,6 +268,9 @@ type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Session       *Session               `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,3,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,4,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=mfa_expires_at,json=mfaExpiresAt,proto3" json:"mfa_expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -291,6 +319,124 @@ func (x *LoginResponse) GetSession() *Session {
 	return nil
 }
 
+func (x *LoginResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetMfaExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MfaExpiresAt
+	}
+	return nil
+}
+
+// RefreshToken RPC messages.
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Session       *Session               `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefreshTokenResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RefreshTokenResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
 // Register RPC messages.
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -305,7 +451,7 @@ type RegisterRequest struct {
 
 func (x *RegisterRequest) Reset() {
 	*x = RegisterRequest{}
-	mi := &file_user_proto_msgTypes[4]
+	mi := &file_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +463,7 @@ func (x *RegisterRequest) String() string {
 func (*RegisterRequest) ProtoMessage() {}
 
 func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[4]
+	mi := &file_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +476,7 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{4}
+	return file_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RegisterRequest) GetEmail() string {
@@ -377,7 +523,7 @@ type RegisterResponse struct {
 
 func (x *RegisterResponse) Reset() {
 	*x = RegisterResponse{}
-	mi := &file_user_proto_msgTypes[5]
+	mi := &file_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -389,7 +535,7 @@ func (x *RegisterResponse) String() string {
 func (*RegisterResponse) ProtoMessage() {}
 
 func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[5]
+	mi := &file_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +548,7 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
 func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{5}
+	return file_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RegisterResponse) GetUser() *User {
@@ -423,7 +569,7 @@ type VerifyUserRequest struct {
 
 func (x *VerifyUserRequest) Reset() {
 	*x = VerifyUserRequest{}
-	mi := &file_user_proto_msgTypes[6]
+	mi := &file_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -435,7 +581,7 @@ func (x *VerifyUserRequest) String() string {
 func (*VerifyUserRequest) ProtoMessage() {}
 
 func (x *VerifyUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[6]
+	mi := &file_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,7 +594,7 @@ func (x *VerifyUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyUserRequest.ProtoReflect.Descriptor instead.
 func (*VerifyUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{6}
+	return file_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *VerifyUserRequest) GetEmail() string {
@@ -475,7 +621,7 @@ type VerifyUserResponse struct {
 
 func (x *VerifyUserResponse) Reset() {
 	*x = VerifyUserResponse{}
-	mi := &file_user_proto_msgTypes[7]
+	mi := &file_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -487,7 +633,7 @@ func (x *VerifyUserResponse) String() string {
 func (*VerifyUserResponse) ProtoMessage() {}
 
 func (x *VerifyUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[7]
+	mi := &file_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +646,7 @@ func (x *VerifyUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyUserResponse.ProtoReflect.Descriptor instead.
 func (*VerifyUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{7}
+	return file_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *VerifyUserResponse) GetValid() bool {
@@ -527,7 +673,7 @@ type ResendOtpRequest struct {
 
 func (x *ResendOtpRequest) Reset() {
 	*x = ResendOtpRequest{}
-	mi := &file_user_proto_msgTypes[8]
+	mi := &file_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +685,7 @@ func (x *ResendOtpRequest) String() string {
 func (*ResendOtpRequest) ProtoMessage() {}
 
 func (x *ResendOtpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[8]
+	mi := &file_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +698,7 @@ func (x *ResendOtpRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResendOtpRequest.ProtoReflect.Descriptor instead.
 func (*ResendOtpRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{8}
+	return file_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ResendOtpRequest) GetEmail() string {
@@ -571,7 +717,7 @@ type ResendOtpResponse struct {
 
 func (x *ResendOtpResponse) Reset() {
 	*x = ResendOtpResponse{}
-	mi := &file_user_proto_msgTypes[9]
+	mi := &file_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -583,7 +729,7 @@ func (x *ResendOtpResponse) String() string {
 func (*ResendOtpResponse) ProtoMessage() {}
 
 func (x *ResendOtpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[9]
+	mi := &file_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,7 +742,7 @@ func (x *ResendOtpResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResendOtpResponse.ProtoReflect.Descriptor instead.
 func (*ResendOtpResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{9}
+	return file_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ResendOtpResponse) GetMessage() string {
@@ -616,7 +762,7 @@ type GetUserRequest struct {
 
 func (x *GetUserRequest) Reset() {
 	*x = GetUserRequest{}
-	mi := &file_user_proto_msgTypes[10]
+	mi := &file_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -628,7 +774,7 @@ func (x *GetUserRequest) String() string {
 func (*GetUserRequest) ProtoMessage() {}
 
 func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[10]
+	mi := &file_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +787,7 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{10}
+	return file_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetUserRequest) GetUserId() string {
@@ -660,7 +806,7 @@ type GetUserResponse struct {
 
 func (x *GetUserResponse) Reset() {
 	*x = GetUserResponse{}
-	mi := &file_user_proto_msgTypes[11]
+	mi := &file_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -672,7 +818,7 @@ func (x *GetUserResponse) String() string {
 func (*GetUserResponse) ProtoMessage() {}
 
 func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[11]
+	mi := &file_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,7 +831,7 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{11}
+	return file_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetUserResponse) GetUser() *User {
@@ -699,13 +845,14 @@ func (x *GetUserResponse) GetUser() *User {
 type LogOutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LogOutRequest) Reset() {
 	*x = LogOutRequest{}
-	mi := &file_user_proto_msgTypes[12]
+	mi := &file_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -717,7 +864,7 @@ func (x *LogOutRequest) String() string {
 func (*LogOutRequest) ProtoMessage() {}
 
 func (x *LogOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[12]
+	mi := &file_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +877,7 @@ func (x *LogOutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogOutRequest.ProtoReflect.Descriptor instead.
 func (*LogOutRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{12}
+	return file_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *LogOutRequest) GetUserId() string {
@@ -740,6 +887,13 @@ func (x *LogOutRequest) GetUserId() string {
 	return ""
 }
 
+func (x *LogOutRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 type LogOutResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -749,7 +903,7 @@ type LogOutResponse struct {
 
 func (x *LogOutResponse) Reset() {
 	*x = LogOutResponse{}
-	mi := &file_user_proto_msgTypes[13]
+	mi := &file_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -761,7 +915,7 @@ func (x *LogOutResponse) String() string {
 func (*LogOutResponse) ProtoMessage() {}
 
 func (x *LogOutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[13]
+	mi := &file_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,7 +928,7 @@ func (x *LogOutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogOutResponse.ProtoReflect.Descriptor instead.
 func (*LogOutResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{13}
+	return file_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *LogOutResponse) GetMessage() string {
@@ -795,7 +949,7 @@ type OAuthLoginRequest struct {
 
 func (x *OAuthLoginRequest) Reset() {
 	*x = OAuthLoginRequest{}
-	mi := &file_user_proto_msgTypes[14]
+	mi := &file_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -807,7 +961,7 @@ func (x *OAuthLoginRequest) String() string {
 func (*OAuthLoginRequest) ProtoMessage() {}
 
 func (x *OAuthLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[14]
+	mi := &file_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +974,7 @@ func (x *OAuthLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthLoginRequest.ProtoReflect.Descriptor instead.
 func (*OAuthLoginRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{14}
+	return file_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *OAuthLoginRequest) GetProvider() string {
@@ -841,13 +995,16 @@ type OAuthLoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Session       *Session               `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,3,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,4,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=mfa_expires_at,json=mfaExpiresAt,proto3" json:"mfa_expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OAuthLoginResponse) Reset() {
 	*x = OAuthLoginResponse{}
-	mi := &file_user_proto_msgTypes[15]
+	mi := &file_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +1016,7 @@ func (x *OAuthLoginResponse) String() string {
 func (*OAuthLoginResponse) ProtoMessage() {}
 
 func (x *OAuthLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[15]
+	mi := &file_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +1029,7 @@ func (x *OAuthLoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthLoginResponse.ProtoReflect.Descriptor instead.
 func (*OAuthLoginResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{15}
+	return file_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *OAuthLoginResponse) GetUser() *User {
@@ -889,6 +1046,27 @@ func (x *OAuthLoginResponse) GetSession() *Session {
 	return nil
 }
 
+func (x *OAuthLoginResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *OAuthLoginResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *OAuthLoginResponse) GetMfaExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MfaExpiresAt
+	}
+	return nil
+}
+
 // OAuth Register messages (same as Login)
 type OAuthRegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -900,7 +1078,7 @@ type OAuthRegisterRequest struct {
 
 func (x *OAuthRegisterRequest) Reset() {
 	*x = OAuthRegisterRequest{}
-	mi := &file_user_proto_msgTypes[16]
+	mi := &file_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -912,7 +1090,7 @@ func (x *OAuthRegisterRequest) String() string {
 func (*OAuthRegisterRequest) ProtoMessage() {}
 
 func (x *OAuthRegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[16]
+	mi := &file_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,7 +1103,7 @@ func (x *OAuthRegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthRegisterRequest.ProtoReflect.Descriptor instead.
 func (*OAuthRegisterRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{16}
+	return file_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *OAuthRegisterRequest) GetProvider() string {
@@ -952,7 +1130,7 @@ type OAuthRegisterResponse struct {
 
 func (x *OAuthRegisterResponse) Reset() {
 	*x = OAuthRegisterResponse{}
-	mi := &file_user_proto_msgTypes[17]
+	mi := &file_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -964,7 +1142,7 @@ func (x *OAuthRegisterResponse) String() string {
 func (*OAuthRegisterResponse) ProtoMessage() {}
 
 func (x *OAuthRegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[17]
+	mi := &file_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,7 +1155,7 @@ func (x *OAuthRegisterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthRegisterResponse.ProtoReflect.Descriptor instead.
 func (*OAuthRegisterResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{17}
+	return file_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *OAuthRegisterResponse) GetUser() *User {
@@ -994,29 +1172,29 @@ func (x *OAuthRegisterResponse) GetSession() *Session {
 	return nil
 }
 
-type UploadImageRequest struct {
+// Authorization code flow messages.
+type StartOAuthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadImageRequest) Reset() {
-	*x = UploadImageRequest{}
-	mi := &file_user_proto_msgTypes[18]
+func (x *StartOAuthRequest) Reset() {
+	*x = StartOAuthRequest{}
+	mi := &file_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadImageRequest) String() string {
+func (x *StartOAuthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadImageRequest) ProtoMessage() {}
+func (*StartOAuthRequest) ProtoMessage() {}
 
-func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[18]
+func (x *StartOAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,49 +1205,42 @@ func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
-func (*UploadImageRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use StartOAuthRequest.ProtoReflect.Descriptor instead.
+func (*StartOAuthRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UploadImageRequest) GetUserId() string {
+func (x *StartOAuthRequest) GetProvider() string {
 	if x != nil {
-		return x.UserId
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *UploadImageRequest) GetContent() []byte {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-type UploadImageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StartOAuthResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	State            string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UploadImageResponse) Reset() {
-	*x = UploadImageResponse{}
-	mi := &file_user_proto_msgTypes[19]
+func (x *StartOAuthResponse) Reset() {
+	*x = StartOAuthResponse{}
+	mi := &file_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadImageResponse) String() string {
+func (x *StartOAuthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadImageResponse) ProtoMessage() {}
+func (*StartOAuthResponse) ProtoMessage() {}
 
-func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[19]
+func (x *StartOAuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,54 +1251,58 @@ func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
-func (*UploadImageResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use StartOAuthResponse.ProtoReflect.Descriptor instead.
+func (*StartOAuthResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UploadImageResponse) GetMessage() string {
+func (x *StartOAuthResponse) GetAuthorizationUrl() string {
 	if x != nil {
-		return x.Message
+		return x.AuthorizationUrl
 	}
 	return ""
 }
 
-func (x *UploadImageResponse) GetImageUrl() string {
+func (x *StartOAuthResponse) GetState() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.State
 	}
 	return ""
 }
 
-func (x *UploadImageResponse) GetUserId() string {
+func (x *StartOAuthResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-type ForgotPasswordRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type OAuthCallbackRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Provider         string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code             string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State            string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Error            string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorDescription string                 `protobuf:"bytes,5,opt,name=error_description,json=errorDescription,proto3" json:"error_description,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ForgotPasswordRequest) Reset() {
-	*x = ForgotPasswordRequest{}
-	mi := &file_user_proto_msgTypes[20]
+func (x *OAuthCallbackRequest) Reset() {
+	*x = OAuthCallbackRequest{}
+	mi := &file_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForgotPasswordRequest) String() string {
+func (x *OAuthCallbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForgotPasswordRequest) ProtoMessage() {}
+func (*OAuthCallbackRequest) ProtoMessage() {}
 
-func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[20]
+func (x *OAuthCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,85 +1313,72 @@ func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use OAuthCallbackRequest.ProtoReflect.Descriptor instead.
+func (*OAuthCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ForgotPasswordRequest) GetEmail() string {
+func (x *OAuthCallbackRequest) GetProvider() string {
 	if x != nil {
-		return x.Email
+		return x.Provider
 	}
 	return ""
 }
 
-type ForgotPasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ForgotPasswordResponse) Reset() {
-	*x = ForgotPasswordResponse{}
-	mi := &file_user_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ForgotPasswordResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OAuthCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
 }
 
-func (*ForgotPasswordResponse) ProtoMessage() {}
-
-func (x *ForgotPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[21]
+func (x *OAuthCallbackRequest) GetState() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ForgotPasswordResponse.ProtoReflect.Descriptor instead.
-func (*ForgotPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{21}
+func (x *OAuthCallbackRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *ForgotPasswordResponse) GetMessage() string {
+func (x *OAuthCallbackRequest) GetErrorDescription() string {
 	if x != nil {
-		return x.Message
+		return x.ErrorDescription
 	}
 	return ""
 }
 
-type VerifyResetPasswordRequest struct {
+type OAuthCallbackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Otp           string                 `protobuf:"bytes,2,opt,name=otp,proto3" json:"otp,omitempty"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Session       *Session               `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,3,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,4,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=mfa_expires_at,json=mfaExpiresAt,proto3" json:"mfa_expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyResetPasswordRequest) Reset() {
-	*x = VerifyResetPasswordRequest{}
-	mi := &file_user_proto_msgTypes[22]
+func (x *OAuthCallbackResponse) Reset() {
+	*x = OAuthCallbackResponse{}
+	mi := &file_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyResetPasswordRequest) String() string {
+func (x *OAuthCallbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyResetPasswordRequest) ProtoMessage() {}
+func (*OAuthCallbackResponse) ProtoMessage() {}
 
-func (x *VerifyResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[22]
+func (x *OAuthCallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,99 +1389,68 @@ func (x *VerifyResetPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyResetPasswordRequest.ProtoReflect.Descriptor instead.
-func (*VerifyResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use OAuthCallbackResponse.ProtoReflect.Descriptor instead.
+func (*OAuthCallbackResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *VerifyResetPasswordRequest) GetEmail() string {
+func (x *OAuthCallbackResponse) GetUser() *User {
 	if x != nil {
-		return x.Email
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-func (x *VerifyResetPasswordRequest) GetOtp() string {
+func (x *OAuthCallbackResponse) GetSession() *Session {
 	if x != nil {
-		return x.Otp
+		return x.Session
 	}
-	return ""
-}
-
-type VerifyResetPasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Valid         bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *VerifyResetPasswordResponse) Reset() {
-	*x = VerifyResetPasswordResponse{}
-	mi := &file_user_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *VerifyResetPasswordResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*VerifyResetPasswordResponse) ProtoMessage() {}
-
-func (x *VerifyResetPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[23]
+func (x *OAuthCallbackResponse) GetMfaRequired() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MfaRequired
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use VerifyResetPasswordResponse.ProtoReflect.Descriptor instead.
-func (*VerifyResetPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{23}
+	return false
 }
 
-func (x *VerifyResetPasswordResponse) GetMessage() string {
+func (x *OAuthCallbackResponse) GetMfaToken() string {
 	if x != nil {
-		return x.Message
+		return x.MfaToken
 	}
 	return ""
 }
 
-func (x *VerifyResetPasswordResponse) GetValid() bool {
+func (x *OAuthCallbackResponse) GetMfaExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Valid
+		return x.MfaExpiresAt
 	}
-	return false
+	return nil
 }
 
-type ResetPasswordRequest struct {
+// Magic link RPC messages.
+type RequestMagicLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPasswordRequest) Reset() {
-	*x = ResetPasswordRequest{}
+func (x *RequestMagicLinkRequest) Reset() {
+	*x = RequestMagicLinkRequest{}
 	mi := &file_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPasswordRequest) String() string {
+func (x *RequestMagicLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordRequest) ProtoMessage() {}
+func (*RequestMagicLinkRequest) ProtoMessage() {}
 
-func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *RequestMagicLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1331,46 +1462,39 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ResetPasswordRequest) GetEmail() string {
+func (x *RequestMagicLinkRequest) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-func (x *ResetPasswordRequest) GetNewPassword() string {
-	if x != nil {
-		return x.NewPassword
-	}
-	return ""
-}
-
-type ResetPasswordResponse struct {
+type RequestMagicLinkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPasswordResponse) Reset() {
-	*x = ResetPasswordResponse{}
+func (x *RequestMagicLinkResponse) Reset() {
+	*x = RequestMagicLinkResponse{}
 	mi := &file_user_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPasswordResponse) String() string {
+func (x *RequestMagicLinkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordResponse) ProtoMessage() {}
+func (*RequestMagicLinkResponse) ProtoMessage() {}
 
-func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+func (x *RequestMagicLinkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1382,42 +1506,39 @@ func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
-func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestMagicLinkResponse.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ResetPasswordResponse) GetMessage() string {
+func (x *RequestMagicLinkResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// ChangePassword RPC messages.
-type ChangePasswordRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
-	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
-	UserId          string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ConsumeMagicLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePasswordRequest) Reset() {
-	*x = ChangePasswordRequest{}
+func (x *ConsumeMagicLinkRequest) Reset() {
+	*x = ConsumeMagicLinkRequest{}
 	mi := &file_user_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordRequest) String() string {
+func (x *ConsumeMagicLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordRequest) ProtoMessage() {}
+func (*ConsumeMagicLinkRequest) ProtoMessage() {}
 
-func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *ConsumeMagicLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1429,53 +1550,43 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
-func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsumeMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeMagicLinkRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ChangePasswordRequest) GetCurrentPassword() string {
-	if x != nil {
-		return x.CurrentPassword
-	}
-	return ""
-}
-
-func (x *ChangePasswordRequest) GetNewPassword() string {
-	if x != nil {
-		return x.NewPassword
-	}
-	return ""
-}
-
-func (x *ChangePasswordRequest) GetUserId() string {
+func (x *ConsumeMagicLinkRequest) GetToken() string {
 	if x != nil {
-		return x.UserId
+		return x.Token
 	}
 	return ""
 }
 
-type ChangePasswordResponse struct {
+type ConsumeMagicLinkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Session       *Session               `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,3,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,4,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=mfa_expires_at,json=mfaExpiresAt,proto3" json:"mfa_expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePasswordResponse) Reset() {
-	*x = ChangePasswordResponse{}
+func (x *ConsumeMagicLinkResponse) Reset() {
+	*x = ConsumeMagicLinkResponse{}
 	mi := &file_user_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordResponse) String() string {
+func (x *ConsumeMagicLinkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordResponse) ProtoMessage() {}
+func (*ConsumeMagicLinkResponse) ProtoMessage() {}
 
-func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+func (x *ConsumeMagicLinkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1487,40 +1598,71 @@ func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
-func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsumeMagicLinkResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeMagicLinkResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ChangePasswordResponse) GetMessage() string {
+func (x *ConsumeMagicLinkResponse) GetUser() *User {
 	if x != nil {
-		return x.Message
+		return x.User
+	}
+	return nil
+}
+
+func (x *ConsumeMagicLinkResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *ConsumeMagicLinkResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *ConsumeMagicLinkResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
 	}
 	return ""
 }
 
-// GetProfile RPC messages.
-type GetProfileRequest struct {
+func (x *ConsumeMagicLinkResponse) GetMfaExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MfaExpiresAt
+	}
+	return nil
+}
+
+// Token key RPC messages.
+type TokenKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kid           string                 `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileRequest) Reset() {
-	*x = GetProfileRequest{}
+func (x *TokenKey) Reset() {
+	*x = TokenKey{}
 	mi := &file_user_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileRequest) String() string {
+func (x *TokenKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileRequest) ProtoMessage() {}
+func (*TokenKey) ProtoMessage() {}
 
-func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *TokenKey) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1532,44 +1674,59 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TokenKey.ProtoReflect.Descriptor instead.
+func (*TokenKey) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetProfileRequest) GetUserId() string {
+func (x *TokenKey) GetKid() string {
 	if x != nil {
-		return x.UserId
+		return x.Kid
 	}
 	return ""
 }
 
-type ProfileDetails struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bio           string                 `protobuf:"bytes,1,opt,name=bio,proto3" json:"bio,omitempty"`
-	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	Website       string                 `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
-	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
-	Preferences   map[string]string      `protobuf:"bytes,5,rep,name=preferences,proto3" json:"preferences,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (x *TokenKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *TokenKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TokenKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GetTokenKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileDetails) Reset() {
-	*x = ProfileDetails{}
+func (x *GetTokenKeysRequest) Reset() {
+	*x = GetTokenKeysRequest{}
 	mi := &file_user_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileDetails) String() string {
+func (x *GetTokenKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileDetails) ProtoMessage() {}
+func (*GetTokenKeysRequest) ProtoMessage() {}
 
-func (x *ProfileDetails) ProtoReflect() protoreflect.Message {
+func (x *GetTokenKeysRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1581,75 +1738,32 @@ func (x *ProfileDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileDetails.ProtoReflect.Descriptor instead.
-func (*ProfileDetails) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTokenKeysRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenKeysRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ProfileDetails) GetBio() string {
-	if x != nil {
-		return x.Bio
-	}
-	return ""
-}
-
-func (x *ProfileDetails) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
-}
-
-func (x *ProfileDetails) GetWebsite() string {
-	if x != nil {
-		return x.Website
-	}
-	return ""
-}
-
-func (x *ProfileDetails) GetJoinedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.JoinedAt
-	}
-	return nil
-}
-
-func (x *ProfileDetails) GetPreferences() map[string]string {
-	if x != nil {
-		return x.Preferences
-	}
-	return nil
-}
-
-func (x *ProfileDetails) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type GetProfileResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	User           *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	ProfileDetails *ProfileDetails        `protobuf:"bytes,2,opt,name=profile_details,json=profileDetails,proto3" json:"profile_details,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetTokenKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*TokenKey            `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileResponse) Reset() {
-	*x = GetProfileResponse{}
+func (x *GetTokenKeysResponse) Reset() {
+	*x = GetTokenKeysResponse{}
 	mi := &file_user_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileResponse) String() string {
+func (x *GetTokenKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileResponse) ProtoMessage() {}
+func (*GetTokenKeysResponse) ProtoMessage() {}
 
-func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTokenKeysResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1661,52 +1775,44 @@ func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
-func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTokenKeysResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenKeysResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetProfileResponse) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-func (x *GetProfileResponse) GetProfileDetails() *ProfileDetails {
+func (x *GetTokenKeysResponse) GetKeys() []*TokenKey {
 	if x != nil {
-		return x.ProfileDetails
+		return x.Keys
 	}
 	return nil
 }
 
-// UpdateProfile RPC messages.
-type UpdateProfileRequest struct {
+// Identity RPC messages.
+type Identity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullName      string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	Website       string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
-	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IdentityId    string                 `protobuf:"bytes,1,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileRequest) Reset() {
-	*x = UpdateProfileRequest{}
+func (x *Identity) Reset() {
+	*x = Identity{}
 	mi := &file_user_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileRequest) String() string {
+func (x *Identity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileRequest) ProtoMessage() {}
+func (*Identity) ProtoMessage() {}
 
-func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *Identity) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1718,75 +1824,69 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdateProfileRequest) GetFullName() string {
-	if x != nil {
-		return x.FullName
-	}
-	return ""
-}
-
-func (x *UpdateProfileRequest) GetBio() string {
+func (x *Identity) GetIdentityId() string {
 	if x != nil {
-		return x.Bio
+		return x.IdentityId
 	}
 	return ""
 }
 
-func (x *UpdateProfileRequest) GetPhone() string {
+func (x *Identity) GetProvider() string {
 	if x != nil {
-		return x.Phone
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *UpdateProfileRequest) GetLocation() string {
+func (x *Identity) GetEmail() string {
 	if x != nil {
-		return x.Location
+		return x.Email
 	}
 	return ""
 }
 
-func (x *UpdateProfileRequest) GetWebsite() string {
+func (x *Identity) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Website
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateProfileRequest) GetUserId() string {
+func (x *Identity) GetLastUsedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.LastUsedAt
 	}
-	return ""
+	return nil
 }
 
-type UpdateProfileResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	User           *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	ProfileDetails *ProfileDetails        `protobuf:"bytes,2,opt,name=profile_details,json=profileDetails,proto3" json:"profile_details,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type LinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileResponse) Reset() {
-	*x = UpdateProfileResponse{}
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
 	mi := &file_user_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileResponse) String() string {
+func (x *LinkIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileResponse) ProtoMessage() {}
+func (*LinkIdentityRequest) ProtoMessage() {}
 
-func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1798,47 +1898,53 @@ func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UpdateProfileResponse) GetUser() *User {
+func (x *LinkIdentityRequest) GetUserId() string {
 	if x != nil {
-		return x.User
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateProfileResponse) GetProfileDetails() *ProfileDetails {
+func (x *LinkIdentityRequest) GetProvider() string {
 	if x != nil {
-		return x.ProfileDetails
+		return x.Provider
 	}
-	return nil
+	return ""
 }
 
-// GetSessions RPC messages.
-type GetSessionsRequest struct {
+func (x *LinkIdentityRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type LinkIdentityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Identity      *Identity              `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSessionsRequest) Reset() {
-	*x = GetSessionsRequest{}
+func (x *LinkIdentityResponse) Reset() {
+	*x = LinkIdentityResponse{}
 	mi := &file_user_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSessionsRequest) String() string {
+func (x *LinkIdentityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionsRequest) ProtoMessage() {}
+func (*LinkIdentityResponse) ProtoMessage() {}
 
-func (x *GetSessionsRequest) ProtoReflect() protoreflect.Message {
+func (x *LinkIdentityResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1850,44 +1956,39 @@ func (x *GetSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionsRequest.ProtoReflect.Descriptor instead.
-func (*GetSessionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*LinkIdentityResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetSessionsRequest) GetUserId() string {
+func (x *LinkIdentityResponse) GetIdentity() *Identity {
 	if x != nil {
-		return x.UserId
+		return x.Identity
 	}
-	return ""
+	return nil
 }
 
-type SessionInfo struct {
+type ListIdentitiesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	DeviceInfo    string                 `protobuf:"bytes,2,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
-	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	LastActivity  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
-	IsCurrent     bool                   `protobuf:"varint,6,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionInfo) Reset() {
-	*x = SessionInfo{}
+func (x *ListIdentitiesRequest) Reset() {
+	*x = ListIdentitiesRequest{}
 	mi := &file_user_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionInfo) String() string {
+func (x *ListIdentitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionInfo) ProtoMessage() {}
+func (*ListIdentitiesRequest) ProtoMessage() {}
 
-func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+func (x *ListIdentitiesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1899,75 +2000,85 @@ func (x *SessionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
-func (*SessionInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SessionInfo) GetSessionId() string {
+func (x *ListIdentitiesRequest) GetUserId() string {
 	if x != nil {
-		return x.SessionId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *SessionInfo) GetDeviceInfo() string {
-	if x != nil {
-		return x.DeviceInfo
-	}
-	return ""
+type ListIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*Identity            `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionInfo) GetIpAddress() string {
-	if x != nil {
-		return x.IpAddress
-	}
-	return ""
+func (x *ListIdentitiesResponse) Reset() {
+	*x = ListIdentitiesResponse{}
+	mi := &file_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionInfo) GetUserAgent() string {
-	if x != nil {
-		return x.UserAgent
-	}
-	return ""
+func (x *ListIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SessionInfo) GetLastActivity() *timestamppb.Timestamp {
+func (*ListIdentitiesResponse) ProtoMessage() {}
+
+func (x *ListIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[35]
 	if x != nil {
-		return x.LastActivity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SessionInfo) GetIsCurrent() bool {
+// Deprecated: Use ListIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListIdentitiesResponse) GetIdentities() []*Identity {
 	if x != nil {
-		return x.IsCurrent
+		return x.Identities
 	}
-	return false
+	return nil
 }
 
-type GetSessionsResponse struct {
+type UnlinkIdentityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sessions      []*SessionInfo         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	IdentityId    string                 `protobuf:"bytes,1,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSessionsResponse) Reset() {
-	*x = GetSessionsResponse{}
-	mi := &file_user_proto_msgTypes[35]
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	mi := &file_user_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSessionsResponse) String() string {
+func (x *UnlinkIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionsResponse) ProtoMessage() {}
+func (*UnlinkIdentityRequest) ProtoMessage() {}
 
-func (x *GetSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[35]
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,93 +2089,3037 @@ func (x *GetSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionsResponse.ProtoReflect.Descriptor instead.
-func (*GetSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UnlinkIdentityRequest) GetIdentityId() string {
+	if x != nil {
+		return x.IdentityId
+	}
+	return ""
+}
+
+func (x *UnlinkIdentityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnlinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityResponse) Reset() {
+	*x = UnlinkIdentityResponse{}
+	mi := &file_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityResponse) ProtoMessage() {}
+
+func (x *UnlinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UnlinkIdentityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UploadImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageRequest) Reset() {
+	*x = UploadImageRequest{}
+	mi := &file_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageRequest) ProtoMessage() {}
+
+func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UploadImageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UploadImageRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageResponse) Reset() {
+	*x = UploadImageResponse{}
+	mi := &file_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageResponse) ProtoMessage() {}
+
+func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadImageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UploadImageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UploadImageResponse) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UploadImageResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ForgotPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgotPasswordRequest) Reset() {
+	*x = ForgotPasswordRequest{}
+	mi := &file_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgotPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgotPasswordRequest) ProtoMessage() {}
+
+func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ForgotPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ForgotPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgotPasswordResponse) Reset() {
+	*x = ForgotPasswordResponse{}
+	mi := &file_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgotPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgotPasswordResponse) ProtoMessage() {}
+
+func (x *ForgotPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgotPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ForgotPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ForgotPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VerifyResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Otp           string                 `protobuf:"bytes,2,opt,name=otp,proto3" json:"otp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyResetPasswordRequest) Reset() {
+	*x = VerifyResetPasswordRequest{}
+	mi := &file_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyResetPasswordRequest) ProtoMessage() {}
+
+func (x *VerifyResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*VerifyResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *VerifyResetPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *VerifyResetPasswordRequest) GetOtp() string {
+	if x != nil {
+		return x.Otp
+	}
+	return ""
+}
+
+type VerifyResetPasswordResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Valid   bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Single-use token to pass to ResetPassword, set only when valid is true.
+	ResetToken    string `protobuf:"bytes,3,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyResetPasswordResponse) Reset() {
+	*x = VerifyResetPasswordResponse{}
+	mi := &file_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyResetPasswordResponse) ProtoMessage() {}
+
+func (x *VerifyResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*VerifyResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VerifyResetPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyResetPasswordResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyResetPasswordResponse) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetToken    string                 `protobuf:"bytes,3,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ResetPasswordRequest) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ResetPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ChangePassword RPC messages.
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	UserId          string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ChangePasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetProfile RPC messages.
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ProfileDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bio           string                 `protobuf:"bytes,1,opt,name=bio,proto3" json:"bio,omitempty"`
+	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Website       string                 `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	Preferences   map[string]string      `protobuf:"bytes,5,rep,name=preferences,proto3" json:"preferences,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileDetails) Reset() {
+	*x = ProfileDetails{}
+	mi := &file_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileDetails) ProtoMessage() {}
+
+func (x *ProfileDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileDetails.ProtoReflect.Descriptor instead.
+func (*ProfileDetails) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ProfileDetails) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *ProfileDetails) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ProfileDetails) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *ProfileDetails) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *ProfileDetails) GetPreferences() map[string]string {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *ProfileDetails) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetProfileResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ProfileDetails *ProfileDetails        `protobuf:"bytes,2,opt,name=profile_details,json=profileDetails,proto3" json:"profile_details,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetProfileResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetProfileResponse) GetProfileDetails() *ProfileDetails {
+	if x != nil {
+		return x.ProfileDetails
+	}
+	return nil
+}
+
+// UpdateProfile RPC messages.
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullName      string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Website       string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateProfileRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ProfileDetails *ProfileDetails        `protobuf:"bytes,2,opt,name=profile_details,json=profileDetails,proto3" json:"profile_details,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateProfileResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UpdateProfileResponse) GetProfileDetails() *ProfileDetails {
+	if x != nil {
+		return x.ProfileDetails
+	}
+	return nil
+}
+
+// GetSessions RPC messages.
+type GetSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionsRequest) Reset() {
+	*x = GetSessionsRequest{}
+	mi := &file_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionsRequest) ProtoMessage() {}
+
+func (x *GetSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetSessionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SessionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	DeviceInfo    string                 `protobuf:"bytes,2,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	LastActivity  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
+	IsCurrent     bool                   `protobuf:"varint,6,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	mi := &file_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SessionInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetDeviceInfo() string {
+	if x != nil {
+		return x.DeviceInfo
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetLastActivity() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivity
+	}
+	return nil
+}
+
+func (x *SessionInfo) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+type GetSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionInfo         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionsResponse) Reset() {
+	*x = GetSessionsResponse{}
+	mi := &file_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionsResponse) ProtoMessage() {}
+
+func (x *GetSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetSessionsResponse) GetSessions() []*SessionInfo {
 	if x != nil {
-		return x.Sessions
+		return x.Sessions
+	}
+	return nil
+}
+
+// RevokeSession RPC messages.
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RevokeSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RevokeSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// DeactivateAccount RPC messages.
+type DeactivateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountRequest) Reset() {
+	*x = DeactivateAccountRequest{}
+	mi := &file_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountRequest) ProtoMessage() {}
+
+func (x *DeactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeactivateAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DeactivateAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeactivateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountResponse) Reset() {
+	*x = DeactivateAccountResponse{}
+	mi := &file_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountResponse) ProtoMessage() {}
+
+func (x *DeactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeactivateAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// DeleteAccount RPC messages.
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Message              string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	DeletionScheduledFor *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deletion_scheduled_for,json=deletionScheduledFor,proto3" json:"deletion_scheduled_for,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteAccountResponse) GetDeletionScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletionScheduledFor
+	}
+	return nil
+}
+
+// GetLoginHistory RPC messages.
+type LoginHistoryEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	IpAddress string                 `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Location  string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	LoginTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=login_time,json=loginTime,proto3" json:"login_time,omitempty"`
+	// Set when support staff signed in as the user
+	Impersonated        bool   `protobuf:"varint,5,opt,name=impersonated,proto3" json:"impersonated,omitempty"`
+	ImpersonationReason string `protobuf:"bytes,6,opt,name=impersonation_reason,json=impersonationReason,proto3" json:"impersonation_reason,omitempty"`
+	// False for failed login attempts
+	Success bool `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	// Browser and operating system, e.g. "Chrome on macOS"
+	Device        string `protobuf:"bytes,8,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginHistoryEntry) Reset() {
+	*x = LoginHistoryEntry{}
+	mi := &file_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginHistoryEntry) ProtoMessage() {}
+
+func (x *LoginHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginHistoryEntry.ProtoReflect.Descriptor instead.
+func (*LoginHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LoginHistoryEntry) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *LoginHistoryEntry) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LoginHistoryEntry) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *LoginHistoryEntry) GetLoginTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoginTime
+	}
+	return nil
+}
+
+func (x *LoginHistoryEntry) GetImpersonated() bool {
+	if x != nil {
+		return x.Impersonated
+	}
+	return false
+}
+
+func (x *LoginHistoryEntry) GetImpersonationReason() string {
+	if x != nil {
+		return x.ImpersonationReason
+	}
+	return ""
+}
+
+func (x *LoginHistoryEntry) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LoginHistoryEntry) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type GetLoginHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoginHistoryRequest) Reset() {
+	*x = GetLoginHistoryRequest{}
+	mi := &file_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginHistoryRequest) ProtoMessage() {}
+
+func (x *GetLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetLoginHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetLoginHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetLoginHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*LoginHistoryEntry   `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoginHistoryResponse) Reset() {
+	*x = GetLoginHistoryResponse{}
+	mi := &file_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginHistoryResponse) ProtoMessage() {}
+
+func (x *GetLoginHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetLoginHistoryResponse) GetHistory() []*LoginHistoryEntry {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// EnrollMfa RPC messages.
+type EnrollMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollMfaRequest) Reset() {
+	*x = EnrollMfaRequest{}
+	mi := &file_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollMfaRequest) ProtoMessage() {}
+
+func (x *EnrollMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollMfaRequest.ProtoReflect.Descriptor instead.
+func (*EnrollMfaRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *EnrollMfaRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type EnrollMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUrl    string                 `protobuf:"bytes,2,opt,name=otpauth_url,json=otpauthUrl,proto3" json:"otpauth_url,omitempty"`
+	QrCode        string                 `protobuf:"bytes,3,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollMfaResponse) Reset() {
+	*x = EnrollMfaResponse{}
+	mi := &file_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollMfaResponse) ProtoMessage() {}
+
+func (x *EnrollMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollMfaResponse.ProtoReflect.Descriptor instead.
+func (*EnrollMfaResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *EnrollMfaResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollMfaResponse) GetOtpauthUrl() string {
+	if x != nil {
+		return x.OtpauthUrl
+	}
+	return ""
+}
+
+func (x *EnrollMfaResponse) GetQrCode() string {
+	if x != nil {
+		return x.QrCode
+	}
+	return ""
+}
+
+// ConfirmMfa RPC messages.
+type ConfirmMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmMfaRequest) Reset() {
+	*x = ConfirmMfaRequest{}
+	mi := &file_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmMfaRequest) ProtoMessage() {}
+
+func (x *ConfirmMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmMfaRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmMfaRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ConfirmMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ConfirmMfaRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ConfirmMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	RecoveryCodes []string               `protobuf:"bytes,2,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmMfaResponse) Reset() {
+	*x = ConfirmMfaResponse{}
+	mi := &file_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmMfaResponse) ProtoMessage() {}
+
+func (x *ConfirmMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmMfaResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmMfaResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ConfirmMfaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfirmMfaResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// VerifyMfa RPC messages.
+type VerifyMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMfaRequest) Reset() {
+	*x = VerifyMfaRequest{}
+	mi := &file_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMfaRequest) ProtoMessage() {}
+
+func (x *VerifyMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMfaRequest.ProtoReflect.Descriptor instead.
+func (*VerifyMfaRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *VerifyMfaRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Session       *Session               `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMfaResponse) Reset() {
+	*x = VerifyMfaResponse{}
+	mi := &file_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMfaResponse) ProtoMessage() {}
+
+func (x *VerifyMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMfaResponse.ProtoReflect.Descriptor instead.
+func (*VerifyMfaResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *VerifyMfaResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *VerifyMfaResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// DisableMfa RPC messages.
+type DisableMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableMfaRequest) Reset() {
+	*x = DisableMfaRequest{}
+	mi := &file_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableMfaRequest) ProtoMessage() {}
+
+func (x *DisableMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableMfaRequest.ProtoReflect.Descriptor instead.
+func (*DisableMfaRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DisableMfaRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DisableMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DisableMfaRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DisableMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableMfaResponse) Reset() {
+	*x = DisableMfaResponse{}
+	mi := &file_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableMfaResponse) ProtoMessage() {}
+
+func (x *DisableMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableMfaResponse.ProtoReflect.Descriptor instead.
+func (*DisableMfaResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DisableMfaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RegenerateRecoveryCodes RPC messages.
+type RegenerateRecoveryCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	mi := &file_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesResponse) Reset() {
+	*x = RegenerateRecoveryCodesResponse{}
+	mi := &file_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type UnlockAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountRequest) Reset() {
+	*x = UnlockAccountRequest{}
+	mi := &file_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountRequest) ProtoMessage() {}
+
+func (x *UnlockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UnlockAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnlockAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountResponse) Reset() {
+	*x = UnlockAccountResponse{}
+	mi := &file_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountResponse) ProtoMessage() {}
+
+func (x *UnlockAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UnlockAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RequestEmailChange RPC messages.
+type RequestEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewEmail      string                 `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeRequest) Reset() {
+	*x = RequestEmailChangeRequest{}
+	mi := &file_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RequestEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RequestEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeResponse) Reset() {
+	*x = RequestEmailChangeResponse{}
+	mi := &file_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeResponse) ProtoMessage() {}
+
+func (x *RequestEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RequestEmailChangeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ConfirmEmailChange RPC messages.
+type ConfirmEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	mi := &file_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ConfirmEmailChangeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ConfirmEmailChangeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ConfirmEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeResponse) Reset() {
+	*x = ConfirmEmailChangeResponse{}
+	mi := &file_user_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ConfirmEmailChangeResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// RevertEmailChange RPC messages.
+type RevertEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertEmailChangeRequest) Reset() {
+	*x = RevertEmailChangeRequest{}
+	mi := &file_user_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertEmailChangeRequest) ProtoMessage() {}
+
+func (x *RevertEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RevertEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RevertEmailChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevertEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertEmailChangeResponse) Reset() {
+	*x = RevertEmailChangeResponse{}
+	mi := &file_user_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertEmailChangeResponse) ProtoMessage() {}
+
+func (x *RevertEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*RevertEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RevertEmailChangeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Data export of everything held about a user.
+type DataExport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// One of pending, building, ready or failed
+	Status      string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// When the download link stops working, set once the export is ready or failed
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SizeBytes int64                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Why the export failed
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExport) Reset() {
+	*x = DataExport{}
+	mi := &file_user_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExport) ProtoMessage() {}
+
+func (x *DataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExport.ProtoReflect.Descriptor instead.
+func (*DataExport) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DataExport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataExport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataExport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DataExport) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *DataExport) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DataExport) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *DataExport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// RequestDataExport RPC messages.
+type RequestDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataExportRequest) Reset() {
+	*x = RequestDataExportRequest{}
+	mi := &file_user_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportRequest) ProtoMessage() {}
+
+func (x *RequestDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportRequest.ProtoReflect.Descriptor instead.
+func (*RequestDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RequestDataExportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RequestDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *DataExport            `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataExportResponse) Reset() {
+	*x = RequestDataExportResponse{}
+	mi := &file_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportResponse) ProtoMessage() {}
+
+func (x *RequestDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportResponse.ProtoReflect.Descriptor instead.
+func (*RequestDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RequestDataExportResponse) GetExport() *DataExport {
+	if x != nil {
+		return x.Export
 	}
 	return nil
 }
 
-// RevokeSession RPC messages.
-type RevokeSessionRequest struct {
+// GetDataExport RPC messages.
+type GetDataExportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ExportId      string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeSessionRequest) Reset() {
-	*x = RevokeSessionRequest{}
-	mi := &file_user_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	mi := &file_user_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetDataExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *GetDataExportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *DataExport            `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportResponse) Reset() {
+	*x = GetDataExportResponse{}
+	mi := &file_user_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportResponse) ProtoMessage() {}
+
+func (x *GetDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetDataExportResponse) GetExport() *DataExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+// DownloadDataExport RPC messages.
+type DownloadDataExportRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ExportId string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	// The token from the link emailed when the export was ready
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDataExportRequest) Reset() {
+	*x = DownloadDataExportRequest{}
+	mi := &file_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportRequest) ProtoMessage() {}
+
+func (x *DownloadDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDataExportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DownloadDataExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *DownloadDataExportRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DownloadDataExportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The first message carries the file name and content type
+	FileName      string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Chunk         []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDataExportResponse) Reset() {
+	*x = DownloadDataExportResponse{}
+	mi := &file_user_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportResponse) ProtoMessage() {}
+
+func (x *DownloadDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDataExportResponse.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DownloadDataExportResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DownloadDataExportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DownloadDataExportResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Agent verification of an agent's identity, license and business.
+type AgentVerification struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email              string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	LicenseNumber      string                 `protobuf:"bytes,4,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	BusinessName       string                 `protobuf:"bytes,5,opt,name=business_name,json=businessName,proto3" json:"business_name,omitempty"`
+	BusinessAddress    string                 `protobuf:"bytes,6,opt,name=business_address,json=businessAddress,proto3" json:"business_address,omitempty"`
+	BusinessPhone      string                 `protobuf:"bytes,7,opt,name=business_phone,json=businessPhone,proto3" json:"business_phone,omitempty"`
+	RegistrationNumber string                 `protobuf:"bytes,8,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	// One of pending, approved, rejected or more_info_requested
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// Why the verification was rejected or what more is needed
+	ReviewNote    string                 `protobuf:"bytes,10,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	Documents     []*AgentDocument       `protobuf:"bytes,13,rep,name=documents,proto3" json:"documents,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,14,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentVerification) Reset() {
+	*x = AgentVerification{}
+	mi := &file_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentVerification) ProtoMessage() {}
+
+func (x *AgentVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentVerification.ProtoReflect.Descriptor instead.
+func (*AgentVerification) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AgentVerification) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AgentVerification) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AgentVerification) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentVerification) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *AgentVerification) GetBusinessName() string {
+	if x != nil {
+		return x.BusinessName
+	}
+	return ""
+}
+
+func (x *AgentVerification) GetBusinessAddress() string {
+	if x != nil {
+		return x.BusinessAddress
+	}
+	return ""
+}
+
+func (x *AgentVerification) GetBusinessPhone() string {
+	if x != nil {
+		return x.BusinessPhone
+	}
+	return ""
+}
+
+func (x *AgentVerification) GetRegistrationNumber() string {
+	if x != nil {
+		return x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *AgentVerification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *RevokeSessionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AgentVerification) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
 }
 
-func (*RevokeSessionRequest) ProtoMessage() {}
-
-func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[36]
+func (x *AgentVerification) GetSubmittedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SubmittedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
-func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{36}
+func (x *AgentVerification) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
 }
 
-func (x *RevokeSessionRequest) GetSessionId() string {
+func (x *AgentVerification) GetDocuments() []*AgentDocument {
 	if x != nil {
-		return x.SessionId
+		return x.Documents
 	}
-	return ""
+	return nil
 }
 
-func (x *RevokeSessionRequest) GetUserId() string {
+func (x *AgentVerification) GetReviewedBy() string {
 	if x != nil {
-		return x.UserId
+		return x.ReviewedBy
 	}
 	return ""
 }
 
-type RevokeSessionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+// AgentDocument is an uploaded document supporting an agent verification.
+type AgentDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// One of id_document, license or business_registration
+	DocumentType string `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	// Signed link to the privately stored document
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	UploadedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeSessionResponse) Reset() {
-	*x = RevokeSessionResponse{}
-	mi := &file_user_proto_msgTypes[37]
+func (x *AgentDocument) Reset() {
+	*x = AgentDocument{}
+	mi := &file_user_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeSessionResponse) String() string {
+func (x *AgentDocument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeSessionResponse) ProtoMessage() {}
+func (*AgentDocument) ProtoMessage() {}
 
-func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[37]
+func (x *AgentDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2075,42 +5130,64 @@ func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
-func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use AgentDocument.ProtoReflect.Descriptor instead.
+func (*AgentDocument) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *RevokeSessionResponse) GetMessage() string {
+func (x *AgentDocument) GetId() string {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
 	return ""
 }
 
-// DeactivateAccount RPC messages.
-type DeactivateAccountRequest struct {
+func (x *AgentDocument) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *AgentDocument) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AgentDocument) GetUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+// UploadAgentDocument RPC messages.
+type UploadAgentDocumentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DocumentType  string                 `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateAccountRequest) Reset() {
-	*x = DeactivateAccountRequest{}
-	mi := &file_user_proto_msgTypes[38]
+func (x *UploadAgentDocumentRequest) Reset() {
+	*x = UploadAgentDocumentRequest{}
+	mi := &file_user_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateAccountRequest) String() string {
+func (x *UploadAgentDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateAccountRequest) ProtoMessage() {}
+func (*UploadAgentDocumentRequest) ProtoMessage() {}
 
-func (x *DeactivateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[38]
+func (x *UploadAgentDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2121,47 +5198,54 @@ func (x *DeactivateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeactivateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use UploadAgentDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAgentDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *DeactivateAccountRequest) GetPassword() string {
+func (x *UploadAgentDocumentRequest) GetUserId() string {
 	if x != nil {
-		return x.Password
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeactivateAccountRequest) GetUserId() string {
+func (x *UploadAgentDocumentRequest) GetDocumentType() string {
 	if x != nil {
-		return x.UserId
+		return x.DocumentType
 	}
 	return ""
 }
 
-type DeactivateAccountResponse struct {
+func (x *UploadAgentDocumentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadAgentDocumentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Document      *AgentDocument         `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateAccountResponse) Reset() {
-	*x = DeactivateAccountResponse{}
-	mi := &file_user_proto_msgTypes[39]
+func (x *UploadAgentDocumentResponse) Reset() {
+	*x = UploadAgentDocumentResponse{}
+	mi := &file_user_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateAccountResponse) String() string {
+func (x *UploadAgentDocumentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateAccountResponse) ProtoMessage() {}
+func (*UploadAgentDocumentResponse) ProtoMessage() {}
 
-func (x *DeactivateAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[39]
+func (x *UploadAgentDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,42 +5256,46 @@ func (x *DeactivateAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateAccountResponse.ProtoReflect.Descriptor instead.
-func (*DeactivateAccountResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UploadAgentDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAgentDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *DeactivateAccountResponse) GetMessage() string {
+func (x *UploadAgentDocumentResponse) GetDocument() *AgentDocument {
 	if x != nil {
-		return x.Message
+		return x.Document
 	}
-	return ""
+	return nil
 }
 
-// DeleteAccount RPC messages.
-type DeleteAccountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SubmitAgentVerification RPC messages.
+type SubmitAgentVerificationRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LicenseNumber      string                 `protobuf:"bytes,2,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	BusinessName       string                 `protobuf:"bytes,3,opt,name=business_name,json=businessName,proto3" json:"business_name,omitempty"`
+	BusinessAddress    string                 `protobuf:"bytes,4,opt,name=business_address,json=businessAddress,proto3" json:"business_address,omitempty"`
+	BusinessPhone      string                 `protobuf:"bytes,5,opt,name=business_phone,json=businessPhone,proto3" json:"business_phone,omitempty"`
+	RegistrationNumber string                 `protobuf:"bytes,6,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *DeleteAccountRequest) Reset() {
-	*x = DeleteAccountRequest{}
-	mi := &file_user_proto_msgTypes[40]
+func (x *SubmitAgentVerificationRequest) Reset() {
+	*x = SubmitAgentVerificationRequest{}
+	mi := &file_user_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAccountRequest) String() string {
+func (x *SubmitAgentVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountRequest) ProtoMessage() {}
+func (*SubmitAgentVerificationRequest) ProtoMessage() {}
 
-func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[40]
+func (x *SubmitAgentVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,94 +5306,75 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use SubmitAgentVerificationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAgentVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *DeleteAccountRequest) GetPassword() string {
+func (x *SubmitAgentVerificationRequest) GetUserId() string {
 	if x != nil {
-		return x.Password
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteAccountRequest) GetUserId() string {
+func (x *SubmitAgentVerificationRequest) GetLicenseNumber() string {
 	if x != nil {
-		return x.UserId
+		return x.LicenseNumber
 	}
 	return ""
 }
 
-type DeleteAccountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteAccountResponse) Reset() {
-	*x = DeleteAccountResponse{}
-	mi := &file_user_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteAccountResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SubmitAgentVerificationRequest) GetBusinessName() string {
+	if x != nil {
+		return x.BusinessName
+	}
+	return ""
 }
 
-func (*DeleteAccountResponse) ProtoMessage() {}
-
-func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[41]
+func (x *SubmitAgentVerificationRequest) GetBusinessAddress() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BusinessAddress
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{41}
+func (x *SubmitAgentVerificationRequest) GetBusinessPhone() string {
+	if x != nil {
+		return x.BusinessPhone
+	}
+	return ""
 }
 
-func (x *DeleteAccountResponse) GetMessage() string {
+func (x *SubmitAgentVerificationRequest) GetRegistrationNumber() string {
 	if x != nil {
-		return x.Message
+		return x.RegistrationNumber
 	}
 	return ""
 }
 
-// GetLoginHistory RPC messages.
-type LoginHistoryEntry struct {
+type SubmitAgentVerificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IpAddress     string                 `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	UserAgent     string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Verification  *AgentVerification     `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginHistoryEntry) Reset() {
-	*x = LoginHistoryEntry{}
-	mi := &file_user_proto_msgTypes[42]
+func (x *SubmitAgentVerificationResponse) Reset() {
+	*x = SubmitAgentVerificationResponse{}
+	mi := &file_user_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginHistoryEntry) String() string {
+func (x *SubmitAgentVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginHistoryEntry) ProtoMessage() {}
+func (*SubmitAgentVerificationResponse) ProtoMessage() {}
 
-func (x *LoginHistoryEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[42]
+func (x *SubmitAgentVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,55 +5385,41 @@ func (x *LoginHistoryEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginHistoryEntry.ProtoReflect.Descriptor instead.
-func (*LoginHistoryEntry) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *LoginHistoryEntry) GetIpAddress() string {
-	if x != nil {
-		return x.IpAddress
-	}
-	return ""
-}
-
-func (x *LoginHistoryEntry) GetUserAgent() string {
-	if x != nil {
-		return x.UserAgent
-	}
-	return ""
+// Deprecated: Use SubmitAgentVerificationResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAgentVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *LoginHistoryEntry) GetLocation() string {
+func (x *SubmitAgentVerificationResponse) GetVerification() *AgentVerification {
 	if x != nil {
-		return x.Location
+		return x.Verification
 	}
-	return ""
+	return nil
 }
 
-type GetLoginHistoryRequest struct {
+// GetAgentVerification RPC messages.
+type GetAgentVerificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoginHistoryRequest) Reset() {
-	*x = GetLoginHistoryRequest{}
-	mi := &file_user_proto_msgTypes[43]
+func (x *GetAgentVerificationRequest) Reset() {
+	*x = GetAgentVerificationRequest{}
+	mi := &file_user_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoginHistoryRequest) String() string {
+func (x *GetAgentVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoginHistoryRequest) ProtoMessage() {}
+func (*GetAgentVerificationRequest) ProtoMessage() {}
 
-func (x *GetLoginHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[43]
+func (x *GetAgentVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2375,47 +5430,40 @@ func (x *GetLoginHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoginHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetLoginHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *GetLoginHistoryRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use GetAgentVerificationRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetLoginHistoryRequest) GetUserId() string {
+func (x *GetAgentVerificationRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetLoginHistoryResponse struct {
+type GetAgentVerificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	History       []*LoginHistoryEntry   `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	Verification  *AgentVerification     `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoginHistoryResponse) Reset() {
-	*x = GetLoginHistoryResponse{}
-	mi := &file_user_proto_msgTypes[44]
+func (x *GetAgentVerificationResponse) Reset() {
+	*x = GetAgentVerificationResponse{}
+	mi := &file_user_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoginHistoryResponse) String() string {
+func (x *GetAgentVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoginHistoryResponse) ProtoMessage() {}
+func (*GetAgentVerificationResponse) ProtoMessage() {}
 
-func (x *GetLoginHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[44]
+func (x *GetAgentVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,14 +5474,14 @@ func (x *GetLoginHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoginHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetLoginHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetAgentVerificationResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GetLoginHistoryResponse) GetHistory() []*LoginHistoryEntry {
+func (x *GetAgentVerificationResponse) GetVerification() *AgentVerification {
 	if x != nil {
-		return x.History
+		return x.Verification
 	}
 	return nil
 }
@@ -2443,7 +5491,7 @@ var File_user_proto protoreflect.FileDescriptor
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"user.proto\x12\x02pb\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x02\n" +
+	"user.proto\x12\x02pb\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x02\n" +
 	"\x04User\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
@@ -2456,16 +5504,28 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
 	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"Z\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0everified_agent\x18\n" +
+	" \x01(\bR\rverifiedAgent\"\xd4\x01\n" +
 	"\aSession\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
 	"\n" +
-	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"@\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12S\n" +
+	"\x18refresh_token_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x15refreshTokenExpiresAt\"@\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"T\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xd6\x01\n" +
 	"\rLoginResponse\x12\x1c\n" +
 	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12%\n" +
+	"\asession\x18\x02 \x01(\v2\v.pb.SessionR\asession\x12!\n" +
+	"\fmfa_required\x18\x03 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x04 \x01(\tR\bmfaToken\x12@\n" +
+	"\x0emfa_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fmfaExpiresAt\"x\n" +
+	"\x13RefreshTokenRequest\x12a\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB<\x92A927The refresh token returned by the last login or refreshR\frefreshToken\"[\n" +
+	"\x14RefreshTokenResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12%\n" +
 	"\asession\x18\x02 \x01(\v2\v.pb.SessionR\asession\"\x8a\x01\n" +
 	"\x0fRegisterRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
@@ -2488,23 +5548,97 @@ const file_user_proto_rawDesc = "" +
 	"\x0eGetUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"/\n" +
 	"\x0fGetUserResponse\x12\x1c\n" +
-	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\"(\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\"u\n" +
 	"\rLogOutRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"*\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12K\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tB,\x92A)2'The session the access token belongs toR\tsessionId\"*\n" +
 	"\x0eLogOutResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"E\n" +
 	"\x11OAuthLoginRequest\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"Y\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xdb\x01\n" +
 	"\x12OAuthLoginResponse\x12\x1c\n" +
 	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12%\n" +
-	"\asession\x18\x02 \x01(\v2\v.pb.SessionR\asession\"H\n" +
+	"\asession\x18\x02 \x01(\v2\v.pb.SessionR\asession\x12!\n" +
+	"\fmfa_required\x18\x03 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x04 \x01(\tR\bmfaToken\x12@\n" +
+	"\x0emfa_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fmfaExpiresAt\"H\n" +
 	"\x14OAuthRegisterRequest\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"\\\n" +
 	"\x15OAuthRegisterResponse\x12\x1c\n" +
 	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12%\n" +
-	"\asession\x18\x02 \x01(\v2\v.pb.SessionR\asession\"{\n" +
+	"\asession\x18\x02 \x01(\v2\v.pb.SessionR\asession\"b\n" +
+	"\x11StartOAuthRequest\x12M\n" +
+	"\bprovider\x18\x01 \x01(\tB1\x92A.2,The OAuth provider, e.g. github or microsoftR\bprovider\"\x92\x01\n" +
+	"\x12StartOAuthResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x9f\x01\n" +
+	"\x14OAuthCallbackRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12+\n" +
+	"\x11error_description\x18\x05 \x01(\tR\x10errorDescription\"\xde\x01\n" +
+	"\x15OAuthCallbackResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12%\n" +
+	"\asession\x18\x02 \x01(\v2\v.pb.SessionR\asession\x12!\n" +
+	"\fmfa_required\x18\x03 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x04 \x01(\tR\bmfaToken\x12@\n" +
+	"\x0emfa_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fmfaExpiresAt\"s\n" +
+	"\x17RequestMagicLinkRequest\x12X\n" +
+	"\x05email\x18\x01 \x01(\tBB\x92A?2)Email address associated with the accountJ\x12\"user@example.com\"R\x05email\"4\n" +
+	"\x18RequestMagicLinkResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"S\n" +
+	"\x17ConsumeMagicLinkRequest\x128\n" +
+	"\x05token\x18\x01 \x01(\tB\"\x92A\x1f2\x1dThe token from the magic linkR\x05token\"\xe1\x01\n" +
+	"\x18ConsumeMagicLinkResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12%\n" +
+	"\asession\x18\x02 \x01(\v2\v.pb.SessionR\asession\x12!\n" +
+	"\fmfa_required\x18\x03 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x04 \x01(\tR\bmfaToken\x12@\n" +
+	"\x0emfa_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fmfaExpiresAt\"\xb1\x01\n" +
+	"\bTokenKey\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x15\n" +
+	"\x13GetTokenKeysRequest\"8\n" +
+	"\x14GetTokenKeysResponse\x12 \n" +
+	"\x04keys\x18\x01 \x03(\v2\f.pb.TokenKeyR\x04keys\"\xd6\x01\n" +
+	"\bIdentity\x12\x1f\n" +
+	"\videntity_id\x18\x01 \x01(\tR\n" +
+	"identityId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\xcd\x01\n" +
+	"\x13LinkIdentityRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x12I\n" +
+	"\bprovider\x18\x02 \x01(\tB-\x92A*2(The OAuth provider, e.g. google or appleR\bprovider\x12>\n" +
+	"\x05token\x18\x03 \x01(\tB(\x92A%2#The ID token issued by the providerR\x05token\"@\n" +
+	"\x14LinkIdentityResponse\x12(\n" +
+	"\bidentity\x18\x01 \x01(\v2\f.pb.IdentityR\bidentity\"D\n" +
+	"\x15ListIdentitiesRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"F\n" +
+	"\x16ListIdentitiesResponse\x12,\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\f.pb.IdentityR\n" +
+	"identities\"\x8c\x01\n" +
+	"\x15UnlinkIdentityRequest\x12F\n" +
+	"\videntity_id\x18\x01 \x01(\tB%\x92A\"2 The ID of the identity to unlinkR\n" +
+	"identityId\x12+\n" +
+	"\auser_id\x18\x02 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"2\n" +
+	"\x16UnlinkIdentityResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"{\n" +
 	"\x12UploadImageRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12L\n" +
 	"\acontent\x18\x02 \x01(\fB2\x92A/2$The binary content of the image file\xa2\x02\x06binaryR\acontent\"e\n" +
@@ -2518,13 +5652,16 @@ const file_user_proto_rawDesc = "" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"\xbe\x01\n" +
 	"\x1aVerifyResetPasswordRequest\x12X\n" +
 	"\x05email\x18\x01 \x01(\tBB\x92A?2)Email address associated with the accountJ\x12\"user@example.com\"R\x05email\x12F\n" +
-	"\x03otp\x18\x02 \x01(\tB4\x92A12%6-digit OTP received via email or SMSJ\b\"123456\"R\x03otp\"M\n" +
+	"\x03otp\x18\x02 \x01(\tB4\x92A12%6-digit OTP received via email or SMSJ\b\"123456\"R\x03otp\"n\n" +
 	"\x1bVerifyResetPasswordResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
-	"\x05valid\x18\x02 \x01(\bR\x05valid\"\xdc\x01\n" +
-	"\x14ResetPasswordRequest\x12X\n" +
-	"\x05email\x18\x01 \x01(\tBB\x92A?2)Email address associated with the accountJ\x12\"user@example.com\"R\x05email\x12j\n" +
-	"\fnew_password\x18\x02 \x01(\tBG\x92AD2-New password that meets password requirementsJ\x13\"NewSecureP@ssw0rd\"R\vnewPassword\"1\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\x12\x1f\n" +
+	"\vreset_token\x18\x03 \x01(\tR\n" +
+	"resetToken\"\x97\x02\n" +
+	"\x14ResetPasswordRequest\x12\x85\x01\n" +
+	"\vreset_token\x18\x03 \x01(\tBd\x92Aa20Single-use token returned by VerifyResetPasswordJ-\"3q2-7wE5XxWk0rZ9lVfYbA1cTn8HsJpLmDuGiOeQyRw\"R\n" +
+	"resetToken\x12j\n" +
+	"\fnew_password\x18\x02 \x01(\tBG\x92AD2-New password that meets password requirementsJ\x13\"NewSecureP@ssw0rd\"R\vnewPasswordJ\x04\b\x01\x10\x02R\x05email\"1\n" +
 	"\x15ResetPasswordResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"\xd2\x01\n" +
 	"\x15ChangePasswordRequest\x12K\n" +
@@ -2587,20 +5724,147 @@ const file_user_proto_rawDesc = "" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"\x8d\x01\n" +
 	"\x14DeleteAccountRequest\x12H\n" +
 	"\bpassword\x18\x01 \x01(\tB,\x92A)2'The user's password to confirm deletionR\bpassword\x12+\n" +
-	"\auser_id\x18\x02 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"1\n" +
+	"\auser_id\x18\x02 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"\x83\x01\n" +
 	"\x15DeleteAccountResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"m\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12P\n" +
+	"\x16deletion_scheduled_for\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x14deletionScheduledFor\"\xb1\x02\n" +
 	"\x11LoginHistoryEntry\x12\x1d\n" +
 	"\n" +
 	"ip_address\x18\x01 \x01(\tR\tipAddress\x12\x1d\n" +
 	"\n" +
 	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1a\n" +
-	"\blocation\x18\x03 \x01(\tR\blocation\"\x99\x01\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x129\n" +
+	"\n" +
+	"login_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tloginTime\x12\"\n" +
+	"\fimpersonated\x18\x05 \x01(\bR\fimpersonated\x121\n" +
+	"\x14impersonation_reason\x18\x06 \x01(\tR\x13impersonationReason\x12\x18\n" +
+	"\asuccess\x18\a \x01(\bR\asuccess\x12\x16\n" +
+	"\x06device\x18\b \x01(\tR\x06device\"\x99\x01\n" +
 	"\x16GetLoginHistoryRequest\x12R\n" +
 	"\x05limit\x18\x01 \x01(\x05B<\x92A927Number of login history entries to return (default: 10)R\x05limit\x12+\n" +
 	"\auser_id\x18\x06 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"J\n" +
 	"\x17GetLoginHistoryResponse\x12/\n" +
-	"\ahistory\x18\x01 \x03(\v2\x15.pb.LoginHistoryEntryR\ahistory2\xcc\x1c\n" +
+	"\ahistory\x18\x01 \x03(\v2\x15.pb.LoginHistoryEntryR\ahistory\"?\n" +
+	"\x10EnrollMfaRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"e\n" +
+	"\x11EnrollMfaResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_url\x18\x02 \x01(\tR\n" +
+	"otpauthUrl\x12\x17\n" +
+	"\aqr_code\x18\x03 \x01(\tR\x06qrCode\"\x86\x01\n" +
+	"\x11ConfirmMfaRequest\x12D\n" +
+	"\x04code\x18\x01 \x01(\tB0\x92A-2+The 6-digit code from the authenticator appR\x04code\x12+\n" +
+	"\auser_id\x18\x02 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"U\n" +
+	"\x12ConfirmMfaResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12%\n" +
+	"\x0erecovery_codes\x18\x02 \x03(\tR\rrecoveryCodes\"\x96\x01\n" +
+	"\x10VerifyMfaRequest\x12A\n" +
+	"\tmfa_token\x18\x01 \x01(\tB$\x92A!2\x1fThe MFA token returned by loginR\bmfaToken\x12?\n" +
+	"\x04code\x18\x02 \x01(\tB+\x92A(2&A 6-digit TOTP code or a recovery codeR\x04code\"X\n" +
+	"\x11VerifyMfaResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12%\n" +
+	"\asession\x18\x02 \x01(\v2\v.pb.SessionR\asession\"\xcd\x01\n" +
+	"\x11DisableMfaRequest\x12J\n" +
+	"\bpassword\x18\x01 \x01(\tB.\x92A+2)The user's password to confirm the changeR\bpassword\x12?\n" +
+	"\x04code\x18\x02 \x01(\tB+\x92A(2&A 6-digit TOTP code or a recovery codeR\x04code\x12+\n" +
+	"\auser_id\x18\x03 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\".\n" +
+	"\x12DisableMfaResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x93\x01\n" +
+	"\x1eRegenerateRecoveryCodesRequest\x12D\n" +
+	"\x04code\x18\x01 \x01(\tB0\x92A-2+The 6-digit code from the authenticator appR\x04code\x12+\n" +
+	"\auser_id\x18\x02 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"H\n" +
+	"\x1fRegenerateRecoveryCodesResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"R\n" +
+	"\x14UnlockAccountRequest\x12:\n" +
+	"\auser_id\x18\x01 \x01(\tB!\x92A\x1e2\x1cThe ID of the user to unlockR\x06userId\"1\n" +
+	"\x15UnlockAccountResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x8a\x01\n" +
+	"\x19RequestEmailChangeRequest\x12@\n" +
+	"\tnew_email\x18\x01 \x01(\tB#\x92A 2\x1eThe email address to switch toR\bnewEmail\x12+\n" +
+	"\auser_id\x18\x02 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"6\n" +
+	"\x1aRequestEmailChangeResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x91\x01\n" +
+	"\x19ConfirmEmailChangeRequest\x12G\n" +
+	"\x04code\x18\x01 \x01(\tB3\x92A02.The 6-digit code sent to the new email addressR\x04code\x12+\n" +
+	"\auser_id\x18\x02 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\":\n" +
+	"\x1aConfirmEmailChangeResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\"q\n" +
+	"\x18RevertEmailChangeRequest\x12U\n" +
+	"\x05token\x18\x01 \x01(\tB?\x92A<2:The token from the link sent to the previous email addressR\x05token\"5\n" +
+	"\x19RevertEmailChangeResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x9e\x02\n" +
+	"\n" +
+	"DataExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x03R\tsizeBytes\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"G\n" +
+	"\x18RequestDataExportRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"C\n" +
+	"\x19RequestDataExportResponse\x12&\n" +
+	"\x06export\x18\x01 \x01(\v2\x0e.pb.DataExportR\x06export\"\x80\x01\n" +
+	"\x14GetDataExportRequest\x12;\n" +
+	"\texport_id\x18\x01 \x01(\tB\x1e\x92A\x1b2\x19The ID of the data exportR\bexportId\x12+\n" +
+	"\auser_id\x18\x02 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"?\n" +
+	"\x15GetDataExportResponse\x12&\n" +
+	"\x06export\x18\x01 \x01(\v2\x0e.pb.DataExportR\x06export\"N\n" +
+	"\x19DownloadDataExportRequest\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\tR\bexportId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"r\n" +
+	"\x1aDownloadDataExportResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\"\xac\x04\n" +
+	"\x11AgentVerification\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\x0elicense_number\x18\x04 \x01(\tR\rlicenseNumber\x12#\n" +
+	"\rbusiness_name\x18\x05 \x01(\tR\fbusinessName\x12)\n" +
+	"\x10business_address\x18\x06 \x01(\tR\x0fbusinessAddress\x12%\n" +
+	"\x0ebusiness_phone\x18\a \x01(\tR\rbusinessPhone\x12/\n" +
+	"\x13registration_number\x18\b \x01(\tR\x12registrationNumber\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1f\n" +
+	"\vreview_note\x18\n" +
+	" \x01(\tR\n" +
+	"reviewNote\x12=\n" +
+	"\fsubmitted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12;\n" +
+	"\vreviewed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12/\n" +
+	"\tdocuments\x18\r \x03(\v2\x11.pb.AgentDocumentR\tdocuments\x12\x1f\n" +
+	"\vreviewed_by\x18\x0e \x01(\tR\n" +
+	"reviewedBy\"\x93\x01\n" +
+	"\rAgentDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rdocument_type\x18\x02 \x01(\tR\fdocumentType\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12;\n" +
+	"\vuploaded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"uploadedAt\"\xf5\x01\n" +
+	"\x1aUploadAgentDocumentRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x12^\n" +
+	"\rdocument_type\x18\x02 \x01(\tB9\x92A624One of id_document, license or business_registrationR\fdocumentType\x12J\n" +
+	"\acontent\x18\x03 \x01(\fB0\x92A-2\"The binary content of the document\xa2\x02\x06binaryR\acontent\"L\n" +
+	"\x1bUploadAgentDocumentResponse\x12-\n" +
+	"\bdocument\x18\x01 \x01(\v2\x11.pb.AgentDocumentR\bdocument\"\x89\x03\n" +
+	"\x1eSubmitAgentVerificationRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\x12R\n" +
+	"\x0elicense_number\x18\x02 \x01(\tB+\x92A(2&The agent's real estate license numberR\rlicenseNumber\x12#\n" +
+	"\rbusiness_name\x18\x03 \x01(\tR\fbusinessName\x12)\n" +
+	"\x10business_address\x18\x04 \x01(\tR\x0fbusinessAddress\x12%\n" +
+	"\x0ebusiness_phone\x18\x05 \x01(\tR\rbusinessPhone\x12o\n" +
+	"\x13registration_number\x18\x06 \x01(\tB>\x92A;29The business's company registration number, if it has oneR\x12registrationNumber\"\\\n" +
+	"\x1fSubmitAgentVerificationResponse\x129\n" +
+	"\fverification\x18\x01 \x01(\v2\x15.pb.AgentVerificationR\fverification\"J\n" +
+	"\x1bGetAgentVerificationRequest\x12+\n" +
+	"\auser_id\x18\x01 \x01(\tB\x12\x92A\x0f2\rThe user's IDR\x06userId\"Y\n" +
+	"\x1cGetAgentVerificationResponse\x129\n" +
+	"\fverification\x18\x01 \x01(\v2\x15.pb.AgentVerificationR\fverification2\xdbG\n" +
 	"\vAuthService\x12\x9e\x01\n" +
 	"\x05Login\x12\x10.pb.LoginRequest\x1a\x11.pb.LoginResponse\"p\x92AU\n" +
 	"\x0eAuthentication\x12\fLogin a user\x1a3User this API to login and generate an access tokenb\x00\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/login\x12\xa2\x01\n" +
@@ -2613,7 +5877,9 @@ const file_user_proto_rawDesc = "" +
 	"\x04User\x12\fUpload Image\x1a\x1fUse this API to upload an image2\x13multipart/form-data\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/upload-image\x12\xa9\x01\n" +
 	"\tResendOtp\x12\x14.pb.ResendOtpRequest\x1a\x15.pb.ResendOtpResponse\"o\x92AO\n" +
 	"\x0eAuthentication\x12\n" +
-	"Resend OTP\x1a/User this API to resend OTP to the user's emailb\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/resend-otp\x12\x9b\x01\n" +
+	"Resend OTP\x1a/User this API to resend OTP to the user's emailb\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/resend-otp\x12\xdc\x01\n" +
+	"\fRefreshToken\x12\x17.pb.RefreshTokenRequest\x1a\x18.pb.RefreshTokenResponse\"\x98\x01\x92Au\n" +
+	"\x0eAuthentication\x12\x14Refresh access token\x1aKUse this API to exchange a refresh token for a new access and refresh tokenb\x00\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/refresh-token\x12\x9b\x01\n" +
 	"\aGetUser\x12\x12.pb.GetUserRequest\x1a\x13.pb.GetUserResponse\"g\x92AF\n" +
 	"\x04User\x12\x10Get user details\x1a,User this API to get user details by user ID\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/user/{user_id}\x12\xa3\x01\n" +
 	"\x06LogOut\x12\x11.pb.LogOutRequest\x1a\x12.pb.LogOutResponse\"r\x92AV\n" +
@@ -2622,7 +5888,24 @@ const file_user_proto_rawDesc = "" +
 	"OAuthLogin\x12\x15.pb.OAuthLoginRequest\x1a\x16.pb.OAuthLoginResponse\"e\x92AD\n" +
 	"\x05OAuth\x12\vOAuth login\x1a,User this API to login using OAuth providersb\x00\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/oauth/login\x12\xb4\x01\n" +
 	"\rOAuthRegister\x12\x18.pb.OAuthRegisterRequest\x1a\x19.pb.OAuthRegisterResponse\"n\x92AJ\n" +
-	"\x05OAuth\x12\x0eOAuth register\x1a/User this API to register using OAuth providersb\x00\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/oauth/register\x12\xcb\x01\n" +
+	"\x05OAuth\x12\x0eOAuth register\x1a/User this API to register using OAuth providersb\x00\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/oauth/register\x12\xf7\x01\n" +
+	"\n" +
+	"StartOAuth\x12\x15.pb.StartOAuthRequest\x1a\x16.pb.StartOAuthResponse\"\xb9\x01\x92A\x8b\x01\n" +
+	"\x05OAuth\x12\x19Start OAuth authorization\x1aeUse this API to start the authorization code flow with PKCE at a provider such as github or microsoftb\x00\x82\xd3\xe4\x93\x02$\x12\"/api/v1/oauth/{provider}/authorize\x12\xfe\x01\n" +
+	"\rOAuthCallback\x12\x18.pb.OAuthCallbackRequest\x1a\x19.pb.OAuthCallbackResponse\"\xb7\x01\x92A\x8a\x01\n" +
+	"\x05OAuth\x12\x0eOAuth callback\x1aoThe provider redirects here after the user signs in; the code is exchanged and the user signed in or registeredb\x00\x82\xd3\xe4\x93\x02#\x12!/api/v1/oauth/{provider}/callback\x12\x8a\x02\n" +
+	"\x10RequestMagicLink\x12\x1b.pb.RequestMagicLinkRequest\x1a\x1c.pb.RequestMagicLinkResponse\"\xba\x01\x92A\x99\x01\n" +
+	"\x0eAuthentication\x12\x12Request magic link\x1aqUse this API to email a single-use sign-in link. The response is the same whether or not the email has an accountb\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/magic_link\x12\xe7\x01\n" +
+	"\x10ConsumeMagicLink\x12\x1b.pb.ConsumeMagicLinkRequest\x1a\x1c.pb.ConsumeMagicLinkResponse\"\x97\x01\x92Ao\n" +
+	"\x0eAuthentication\x12\x17Sign in with magic link\x1aBUse this API to exchange the token from a magic link for a sessionb\x00\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/magic_link/consume\x12\xc8\x01\n" +
+	"\fGetTokenKeys\x12\x17.pb.GetTokenKeysRequest\x1a\x18.pb.GetTokenKeysResponse\"\x84\x01\x92Ag\n" +
+	"\x0eAuthentication\x12\x0eGet token keys\x1aCUse this API to fetch the public keys access tokens are signed withb\x00\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/token_keys\x12\xbf\x01\n" +
+	"\fLinkIdentity\x12\x17.pb.LinkIdentityRequest\x1a\x18.pb.LinkIdentityResponse\"|\x92A\\\n" +
+	"\x05OAuth\x12\rLink identity\x1aDUse this API to link an OAuth provider account to the signed in user\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/identities\x12\xc3\x01\n" +
+	"\x0eListIdentities\x12\x19.pb.ListIdentitiesRequest\x1a\x1a.pb.ListIdentitiesResponse\"z\x92A]\n" +
+	"\x05OAuth\x12\x0fList identities\x1aCUse this API to list the OAuth provider accounts linked to the user\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/identities\x12\xcd\x01\n" +
+	"\x0eUnlinkIdentity\x12\x19.pb.UnlinkIdentityRequest\x1a\x1a.pb.UnlinkIdentityResponse\"\x83\x01\x92AX\n" +
+	"\x05OAuth\x12\x0fUnlink identity\x1a>Use this API to unlink an OAuth provider account from the user\x82\xd3\xe4\x93\x02\"* /api/v1/identities/{identity_id}\x12\xcb\x01\n" +
 	"\x0eForgotPassword\x12\x19.pb.ForgotPasswordRequest\x1a\x1a.pb.ForgotPasswordResponse\"\x81\x01\x92A\\\n" +
 	"\x0eAuthentication\x12\x1aRequest password reset OTP\x1a,Use this API to request a password reset OTPb\x00\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/forgot-password\x12\xdb\x01\n" +
 	"\x13VerifyResetPassword\x12\x1e.pb.VerifyResetPasswordRequest\x1a\x1f.pb.VerifyResetPasswordResponse\"\x82\x01\x92A`\n" +
@@ -2641,11 +5924,41 @@ const file_user_proto_rawDesc = "" +
 	"\rRevokeSession\x12\x18.pb.RevokeSessionRequest\x1a\x19.pb.RevokeSessionResponse\"s\x92AK\n" +
 	"\x0eAuthentication\x12\x0eRevoke session\x1a)Use this API to revoke a specific session\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/sessions/{session_id}\x12\xcb\x01\n" +
 	"\x11DeactivateAccount\x12\x1c.pb.DeactivateAccountRequest\x1a\x1d.pb.DeactivateAccountResponse\"y\x92AQ\n" +
-	"\x04User\x12\x12Deactivate account\x1a5Use this API to temporarily deactivate a user account\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/account/deactivate\x12\xb3\x01\n" +
-	"\rDeleteAccount\x12\x18.pb.DeleteAccountRequest\x1a\x19.pb.DeleteAccountResponse\"m\x92AI\n" +
-	"\x04User\x12\x0eDelete account\x1a1Use this API to permanently delete a user account\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/account/delete\x12\xcb\x01\n" +
+	"\x04User\x12\x12Deactivate account\x1a5Use this API to temporarily deactivate a user account\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/account/deactivate\x12\xa7\x02\n" +
+	"\rDeleteAccount\x12\x18.pb.DeleteAccountRequest\x1a\x19.pb.DeleteAccountResponse\"\xe0\x01\x92A\xbb\x01\n" +
+	"\x04User\x12\x0eDelete account\x1a\xa2\x01Use this API to schedule a user account for deletion. Signing in within 30 days cancels it; after that the account and the user's listings and messages are erased\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/account/delete\x12\xcb\x01\n" +
 	"\x0fGetLoginHistory\x12\x1a.pb.GetLoginHistoryRequest\x1a\x1b.pb.GetLoginHistoryResponse\"\x7f\x92AW\n" +
-	"\x04User\x12\x11Get login history\x1a<Use this API to get the login history for the user's account\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/account/login-historyB\xbd\x03\x92A\x89\x03\x12\x87\x01\n" +
+	"\x04User\x12\x11Get login history\x1a<Use this API to get the login history for the user's account\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/account/login-history\x12\xb6\x01\n" +
+	"\tEnrollMfa\x12\x14.pb.EnrollMfaRequest\x1a\x15.pb.EnrollMfaResponse\"|\x92A\\\n" +
+	"\x03MFA\x12\rEnroll in MFA\x1aFUse this API to start TOTP enrollment and receive a secret and QR code\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/mfa/enroll\x12\xcc\x01\n" +
+	"\n" +
+	"ConfirmMfa\x12\x15.pb.ConfirmMfaRequest\x1a\x16.pb.ConfirmMfaResponse\"\x8e\x01\x92Am\n" +
+	"\x03MFA\x12\x16Confirm MFA enrollment\x1aNUse this API to confirm TOTP enrollment with a code from the authenticator app\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/mfa/confirm\x12\xb7\x01\n" +
+	"\tVerifyMfa\x12\x14.pb.VerifyMfaRequest\x1a\x15.pb.VerifyMfaResponse\"}\x92A]\n" +
+	"\x03MFA\x12\x14Verify MFA challenge\x1a>Use this API to complete a login that requires a second factorb\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/mfa/verify\x12\xa5\x01\n" +
+	"\n" +
+	"DisableMfa\x12\x15.pb.DisableMfaRequest\x1a\x16.pb.DisableMfaResponse\"h\x92AG\n" +
+	"\x03MFA\x12\vDisable MFA\x1a3Use this API to turn off MFA for the user's account\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/mfa/disable\x12\xe3\x01\n" +
+	"\x17RegenerateRecoveryCodes\x12\".pb.RegenerateRecoveryCodesRequest\x1a#.pb.RegenerateRecoveryCodesResponse\"\x7f\x92AW\n" +
+	"\x03MFA\x12\x19Regenerate recovery codes\x1a5Use this API to replace the user's MFA recovery codes\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/mfa/recovery-codes\x12D\n" +
+	"\rUnlockAccount\x12\x18.pb.UnlockAccountRequest\x1a\x19.pb.UnlockAccountResponse\x12\xeb\x01\n" +
+	"\x12RequestEmailChange\x12\x1d.pb.RequestEmailChangeRequest\x1a\x1e.pb.RequestEmailChangeResponse\"\x95\x01\x92Ar\n" +
+	"\x04User\x12\x14Request email change\x1aTUse this API to email a confirmation code to the address the user wants to switch to\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/account/email\x12\x97\x02\n" +
+	"\x12ConfirmEmailChange\x12\x1d.pb.ConfirmEmailChangeRequest\x1a\x1e.pb.ConfirmEmailChangeResponse\"\xc1\x01\x92A\x95\x01\n" +
+	"\x04User\x12\x14Confirm email change\x1awUse this API to switch to the new email with the code sent to it. The old address is sent a link that undoes the change\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/account/email/confirm\x12\xfa\x01\n" +
+	"\x11RevertEmailChange\x12\x1c.pb.RevertEmailChangeRequest\x1a\x1d.pb.RevertEmailChangeResponse\"\xa7\x01\x92A}\n" +
+	"\x04User\x12\x13Revert email change\x1a^Use this API to restore the previous email with the link sent to it and sign out every sessionb\x00\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/account/email/revert\x12\x84\x02\n" +
+	"\x11RequestDataExport\x12\x1c.pb.RequestDataExportRequest\x1a\x1d.pb.RequestDataExportResponse\"\xb1\x01\x92A\x8b\x01\n" +
+	"\x04User\x12\x13Request data export\x1anUse this API to request a ZIP of all the data held about the user. A download link is emailed once it is ready\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/account/exports\x12\xcc\x01\n" +
+	"\rGetDataExport\x12\x18.pb.GetDataExportRequest\x1a\x19.pb.GetDataExportResponse\"\x85\x01\x92AW\n" +
+	"\x04User\x12\x0fGet data export\x1a>Use this API to check whether a requested data export is ready\x82\xd3\xe4\x93\x02%\x12#/api/v1/account/exports/{export_id}\x12U\n" +
+	"\x12DownloadDataExport\x12\x1d.pb.DownloadDataExportRequest\x1a\x1e.pb.DownloadDataExportResponse0\x01\x12\xd6\x02\n" +
+	"\x13UploadAgentDocument\x12\x1e.pb.UploadAgentDocumentRequest\x1a\x1f.pb.UploadAgentDocumentResponse\"\xfd\x01\x92A\xca\x01\n" +
+	"\x04User\x12\"Upload agent verification document\x1a\x88\x01Use this API to upload an ID document, license or business registration supporting an agent verification. Documents are stored privately2\x13multipart/form-data\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/agent-verification/documents\x12\x84\x03\n" +
+	"\x17SubmitAgentVerification\x12\".pb.SubmitAgentVerificationRequest\x1a#.pb.SubmitAgentVerificationResponse\"\x9f\x02\x92A\xf6\x01\n" +
+	"\x04User\x12\x19Submit agent verification\x1a\xd2\x01Use this API to send an agent's license and business details, with the documents uploaded, for review. An ID document is required. It can be submitted again after it is rejected or more information is requested\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/agent-verification\x12\xfc\x01\n" +
+	"\x14GetAgentVerification\x12\x1f.pb.GetAgentVerificationRequest\x1a .pb.GetAgentVerificationResponse\"\xa0\x01\x92A{\n" +
+	"\x04User\x12\x16Get agent verification\x1a[Use this API to check the status of the agent's verification and any note from the reviewer\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/agent-verificationB\x9c\x04\x92A\xe8\x03\x12\x87\x01\n" +
 	"\x15Realio-Authentication\"i\n" +
 	"\x15Realio-Authentication\x123https://github.com/demola234/realio_go_microservice\x1a\x1bademolakolawole45@gmail.com2\x031.0Z`\n" +
 	"^\n" +
@@ -2655,7 +5968,9 @@ const file_user_proto_rawDesc = "" +
 	"\x06bearer\x12\x00j5\n" +
 	"\x0eAuthentication\x12#APIs related to user authenticationj'\n" +
 	"\x04User\x12\x1fAPIs related to user managementj-\n" +
-	"\x05OAuth\x12$APIs related to OAuth authenticationZ.github.com/demola234/realio_go_microservice/pbb\x06proto3"
+	"\x05OAuth\x12$APIs related to OAuth authenticationj2\n" +
+	"\x03MFA\x12+APIs related to multi-factor authenticationj)\n" +
+	"\x05Admin\x12 APIs reserved for administratorsZ.github.com/demola234/realio_go_microservice/pbb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -2669,123 +5984,260 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
 var file_user_proto_goTypes = []any{
-	(*User)(nil),                        // 0: pb.User
-	(*Session)(nil),                     // 1: pb.Session
-	(*LoginRequest)(nil),                // 2: pb.LoginRequest
-	(*LoginResponse)(nil),               // 3: pb.LoginResponse
-	(*RegisterRequest)(nil),             // 4: pb.RegisterRequest
-	(*RegisterResponse)(nil),            // 5: pb.RegisterResponse
-	(*VerifyUserRequest)(nil),           // 6: pb.VerifyUserRequest
-	(*VerifyUserResponse)(nil),          // 7: pb.VerifyUserResponse
-	(*ResendOtpRequest)(nil),            // 8: pb.ResendOtpRequest
-	(*ResendOtpResponse)(nil),           // 9: pb.ResendOtpResponse
-	(*GetUserRequest)(nil),              // 10: pb.GetUserRequest
-	(*GetUserResponse)(nil),             // 11: pb.GetUserResponse
-	(*LogOutRequest)(nil),               // 12: pb.LogOutRequest
-	(*LogOutResponse)(nil),              // 13: pb.LogOutResponse
-	(*OAuthLoginRequest)(nil),           // 14: pb.OAuthLoginRequest
-	(*OAuthLoginResponse)(nil),          // 15: pb.OAuthLoginResponse
-	(*OAuthRegisterRequest)(nil),        // 16: pb.OAuthRegisterRequest
-	(*OAuthRegisterResponse)(nil),       // 17: pb.OAuthRegisterResponse
-	(*UploadImageRequest)(nil),          // 18: pb.UploadImageRequest
-	(*UploadImageResponse)(nil),         // 19: pb.UploadImageResponse
-	(*ForgotPasswordRequest)(nil),       // 20: pb.ForgotPasswordRequest
-	(*ForgotPasswordResponse)(nil),      // 21: pb.ForgotPasswordResponse
-	(*VerifyResetPasswordRequest)(nil),  // 22: pb.VerifyResetPasswordRequest
-	(*VerifyResetPasswordResponse)(nil), // 23: pb.VerifyResetPasswordResponse
-	(*ResetPasswordRequest)(nil),        // 24: pb.ResetPasswordRequest
-	(*ResetPasswordResponse)(nil),       // 25: pb.ResetPasswordResponse
-	(*ChangePasswordRequest)(nil),       // 26: pb.ChangePasswordRequest
-	(*ChangePasswordResponse)(nil),      // 27: pb.ChangePasswordResponse
-	(*GetProfileRequest)(nil),           // 28: pb.GetProfileRequest
-	(*ProfileDetails)(nil),              // 29: pb.ProfileDetails
-	(*GetProfileResponse)(nil),          // 30: pb.GetProfileResponse
-	(*UpdateProfileRequest)(nil),        // 31: pb.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),       // 32: pb.UpdateProfileResponse
-	(*GetSessionsRequest)(nil),          // 33: pb.GetSessionsRequest
-	(*SessionInfo)(nil),                 // 34: pb.SessionInfo
-	(*GetSessionsResponse)(nil),         // 35: pb.GetSessionsResponse
-	(*RevokeSessionRequest)(nil),        // 36: pb.RevokeSessionRequest
-	(*RevokeSessionResponse)(nil),       // 37: pb.RevokeSessionResponse
-	(*DeactivateAccountRequest)(nil),    // 38: pb.DeactivateAccountRequest
-	(*DeactivateAccountResponse)(nil),   // 39: pb.DeactivateAccountResponse
-	(*DeleteAccountRequest)(nil),        // 40: pb.DeleteAccountRequest
-	(*DeleteAccountResponse)(nil),       // 41: pb.DeleteAccountResponse
-	(*LoginHistoryEntry)(nil),           // 42: pb.LoginHistoryEntry
-	(*GetLoginHistoryRequest)(nil),      // 43: pb.GetLoginHistoryRequest
-	(*GetLoginHistoryResponse)(nil),     // 44: pb.GetLoginHistoryResponse
-	nil,                                 // 45: pb.ProfileDetails.PreferencesEntry
-	(*timestamppb.Timestamp)(nil),       // 46: google.protobuf.Timestamp
+	(*User)(nil),                            // 0: pb.User
+	(*Session)(nil),                         // 1: pb.Session
+	(*LoginRequest)(nil),                    // 2: pb.LoginRequest
+	(*LoginResponse)(nil),                   // 3: pb.LoginResponse
+	(*RefreshTokenRequest)(nil),             // 4: pb.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),            // 5: pb.RefreshTokenResponse
+	(*RegisterRequest)(nil),                 // 6: pb.RegisterRequest
+	(*RegisterResponse)(nil),                // 7: pb.RegisterResponse
+	(*VerifyUserRequest)(nil),               // 8: pb.VerifyUserRequest
+	(*VerifyUserResponse)(nil),              // 9: pb.VerifyUserResponse
+	(*ResendOtpRequest)(nil),                // 10: pb.ResendOtpRequest
+	(*ResendOtpResponse)(nil),               // 11: pb.ResendOtpResponse
+	(*GetUserRequest)(nil),                  // 12: pb.GetUserRequest
+	(*GetUserResponse)(nil),                 // 13: pb.GetUserResponse
+	(*LogOutRequest)(nil),                   // 14: pb.LogOutRequest
+	(*LogOutResponse)(nil),                  // 15: pb.LogOutResponse
+	(*OAuthLoginRequest)(nil),               // 16: pb.OAuthLoginRequest
+	(*OAuthLoginResponse)(nil),              // 17: pb.OAuthLoginResponse
+	(*OAuthRegisterRequest)(nil),            // 18: pb.OAuthRegisterRequest
+	(*OAuthRegisterResponse)(nil),           // 19: pb.OAuthRegisterResponse
+	(*StartOAuthRequest)(nil),               // 20: pb.StartOAuthRequest
+	(*StartOAuthResponse)(nil),              // 21: pb.StartOAuthResponse
+	(*OAuthCallbackRequest)(nil),            // 22: pb.OAuthCallbackRequest
+	(*OAuthCallbackResponse)(nil),           // 23: pb.OAuthCallbackResponse
+	(*RequestMagicLinkRequest)(nil),         // 24: pb.RequestMagicLinkRequest
+	(*RequestMagicLinkResponse)(nil),        // 25: pb.RequestMagicLinkResponse
+	(*ConsumeMagicLinkRequest)(nil),         // 26: pb.ConsumeMagicLinkRequest
+	(*ConsumeMagicLinkResponse)(nil),        // 27: pb.ConsumeMagicLinkResponse
+	(*TokenKey)(nil),                        // 28: pb.TokenKey
+	(*GetTokenKeysRequest)(nil),             // 29: pb.GetTokenKeysRequest
+	(*GetTokenKeysResponse)(nil),            // 30: pb.GetTokenKeysResponse
+	(*Identity)(nil),                        // 31: pb.Identity
+	(*LinkIdentityRequest)(nil),             // 32: pb.LinkIdentityRequest
+	(*LinkIdentityResponse)(nil),            // 33: pb.LinkIdentityResponse
+	(*ListIdentitiesRequest)(nil),           // 34: pb.ListIdentitiesRequest
+	(*ListIdentitiesResponse)(nil),          // 35: pb.ListIdentitiesResponse
+	(*UnlinkIdentityRequest)(nil),           // 36: pb.UnlinkIdentityRequest
+	(*UnlinkIdentityResponse)(nil),          // 37: pb.UnlinkIdentityResponse
+	(*UploadImageRequest)(nil),              // 38: pb.UploadImageRequest
+	(*UploadImageResponse)(nil),             // 39: pb.UploadImageResponse
+	(*ForgotPasswordRequest)(nil),           // 40: pb.ForgotPasswordRequest
+	(*ForgotPasswordResponse)(nil),          // 41: pb.ForgotPasswordResponse
+	(*VerifyResetPasswordRequest)(nil),      // 42: pb.VerifyResetPasswordRequest
+	(*VerifyResetPasswordResponse)(nil),     // 43: pb.VerifyResetPasswordResponse
+	(*ResetPasswordRequest)(nil),            // 44: pb.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),           // 45: pb.ResetPasswordResponse
+	(*ChangePasswordRequest)(nil),           // 46: pb.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),          // 47: pb.ChangePasswordResponse
+	(*GetProfileRequest)(nil),               // 48: pb.GetProfileRequest
+	(*ProfileDetails)(nil),                  // 49: pb.ProfileDetails
+	(*GetProfileResponse)(nil),              // 50: pb.GetProfileResponse
+	(*UpdateProfileRequest)(nil),            // 51: pb.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),           // 52: pb.UpdateProfileResponse
+	(*GetSessionsRequest)(nil),              // 53: pb.GetSessionsRequest
+	(*SessionInfo)(nil),                     // 54: pb.SessionInfo
+	(*GetSessionsResponse)(nil),             // 55: pb.GetSessionsResponse
+	(*RevokeSessionRequest)(nil),            // 56: pb.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),           // 57: pb.RevokeSessionResponse
+	(*DeactivateAccountRequest)(nil),        // 58: pb.DeactivateAccountRequest
+	(*DeactivateAccountResponse)(nil),       // 59: pb.DeactivateAccountResponse
+	(*DeleteAccountRequest)(nil),            // 60: pb.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),           // 61: pb.DeleteAccountResponse
+	(*LoginHistoryEntry)(nil),               // 62: pb.LoginHistoryEntry
+	(*GetLoginHistoryRequest)(nil),          // 63: pb.GetLoginHistoryRequest
+	(*GetLoginHistoryResponse)(nil),         // 64: pb.GetLoginHistoryResponse
+	(*EnrollMfaRequest)(nil),                // 65: pb.EnrollMfaRequest
+	(*EnrollMfaResponse)(nil),               // 66: pb.EnrollMfaResponse
+	(*ConfirmMfaRequest)(nil),               // 67: pb.ConfirmMfaRequest
+	(*ConfirmMfaResponse)(nil),              // 68: pb.ConfirmMfaResponse
+	(*VerifyMfaRequest)(nil),                // 69: pb.VerifyMfaRequest
+	(*VerifyMfaResponse)(nil),               // 70: pb.VerifyMfaResponse
+	(*DisableMfaRequest)(nil),               // 71: pb.DisableMfaRequest
+	(*DisableMfaResponse)(nil),              // 72: pb.DisableMfaResponse
+	(*RegenerateRecoveryCodesRequest)(nil),  // 73: pb.RegenerateRecoveryCodesRequest
+	(*RegenerateRecoveryCodesResponse)(nil), // 74: pb.RegenerateRecoveryCodesResponse
+	(*UnlockAccountRequest)(nil),            // 75: pb.UnlockAccountRequest
+	(*UnlockAccountResponse)(nil),           // 76: pb.UnlockAccountResponse
+	(*RequestEmailChangeRequest)(nil),       // 77: pb.RequestEmailChangeRequest
+	(*RequestEmailChangeResponse)(nil),      // 78: pb.RequestEmailChangeResponse
+	(*ConfirmEmailChangeRequest)(nil),       // 79: pb.ConfirmEmailChangeRequest
+	(*ConfirmEmailChangeResponse)(nil),      // 80: pb.ConfirmEmailChangeResponse
+	(*RevertEmailChangeRequest)(nil),        // 81: pb.RevertEmailChangeRequest
+	(*RevertEmailChangeResponse)(nil),       // 82: pb.RevertEmailChangeResponse
+	(*DataExport)(nil),                      // 83: pb.DataExport
+	(*RequestDataExportRequest)(nil),        // 84: pb.RequestDataExportRequest
+	(*RequestDataExportResponse)(nil),       // 85: pb.RequestDataExportResponse
+	(*GetDataExportRequest)(nil),            // 86: pb.GetDataExportRequest
+	(*GetDataExportResponse)(nil),           // 87: pb.GetDataExportResponse
+	(*DownloadDataExportRequest)(nil),       // 88: pb.DownloadDataExportRequest
+	(*DownloadDataExportResponse)(nil),      // 89: pb.DownloadDataExportResponse
+	(*AgentVerification)(nil),               // 90: pb.AgentVerification
+	(*AgentDocument)(nil),                   // 91: pb.AgentDocument
+	(*UploadAgentDocumentRequest)(nil),      // 92: pb.UploadAgentDocumentRequest
+	(*UploadAgentDocumentResponse)(nil),     // 93: pb.UploadAgentDocumentResponse
+	(*SubmitAgentVerificationRequest)(nil),  // 94: pb.SubmitAgentVerificationRequest
+	(*SubmitAgentVerificationResponse)(nil), // 95: pb.SubmitAgentVerificationResponse
+	(*GetAgentVerificationRequest)(nil),     // 96: pb.GetAgentVerificationRequest
+	(*GetAgentVerificationResponse)(nil),    // 97: pb.GetAgentVerificationResponse
+	nil,                                     // 98: pb.ProfileDetails.PreferencesEntry
+	(*timestamppb.Timestamp)(nil),           // 99: google.protobuf.Timestamp
 }
 var file_user_proto_depIdxs = []int32{
-	46, // 0: pb.User.updated_at:type_name -> google.protobuf.Timestamp
-	46, // 1: pb.User.created_at:type_name -> google.protobuf.Timestamp
-	46, // 2: pb.Session.expires_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: pb.LoginResponse.user:type_name -> pb.User
-	1,  // 4: pb.LoginResponse.session:type_name -> pb.Session
-	0,  // 5: pb.RegisterResponse.user:type_name -> pb.User
-	1,  // 6: pb.VerifyUserResponse.session:type_name -> pb.Session
-	0,  // 7: pb.GetUserResponse.user:type_name -> pb.User
-	0,  // 8: pb.OAuthLoginResponse.user:type_name -> pb.User
-	1,  // 9: pb.OAuthLoginResponse.session:type_name -> pb.Session
-	0,  // 10: pb.OAuthRegisterResponse.user:type_name -> pb.User
-	1,  // 11: pb.OAuthRegisterResponse.session:type_name -> pb.Session
-	46, // 12: pb.ProfileDetails.joined_at:type_name -> google.protobuf.Timestamp
-	45, // 13: pb.ProfileDetails.preferences:type_name -> pb.ProfileDetails.PreferencesEntry
-	0,  // 14: pb.GetProfileResponse.user:type_name -> pb.User
-	29, // 15: pb.GetProfileResponse.profile_details:type_name -> pb.ProfileDetails
-	0,  // 16: pb.UpdateProfileResponse.user:type_name -> pb.User
-	29, // 17: pb.UpdateProfileResponse.profile_details:type_name -> pb.ProfileDetails
-	46, // 18: pb.SessionInfo.last_activity:type_name -> google.protobuf.Timestamp
-	34, // 19: pb.GetSessionsResponse.sessions:type_name -> pb.SessionInfo
-	42, // 20: pb.GetLoginHistoryResponse.history:type_name -> pb.LoginHistoryEntry
-	2,  // 21: pb.AuthService.Login:input_type -> pb.LoginRequest
-	4,  // 22: pb.AuthService.Register:input_type -> pb.RegisterRequest
-	6,  // 23: pb.AuthService.VerifyUser:input_type -> pb.VerifyUserRequest
-	18, // 24: pb.AuthService.UploadImage:input_type -> pb.UploadImageRequest
-	8,  // 25: pb.AuthService.ResendOtp:input_type -> pb.ResendOtpRequest
-	10, // 26: pb.AuthService.GetUser:input_type -> pb.GetUserRequest
-	12, // 27: pb.AuthService.LogOut:input_type -> pb.LogOutRequest
-	14, // 28: pb.AuthService.OAuthLogin:input_type -> pb.OAuthLoginRequest
-	16, // 29: pb.AuthService.OAuthRegister:input_type -> pb.OAuthRegisterRequest
-	20, // 30: pb.AuthService.ForgotPassword:input_type -> pb.ForgotPasswordRequest
-	22, // 31: pb.AuthService.VerifyResetPassword:input_type -> pb.VerifyResetPasswordRequest
-	24, // 32: pb.AuthService.ResetPassword:input_type -> pb.ResetPasswordRequest
-	26, // 33: pb.AuthService.ChangePassword:input_type -> pb.ChangePasswordRequest
-	28, // 34: pb.AuthService.GetProfile:input_type -> pb.GetProfileRequest
-	31, // 35: pb.AuthService.UpdateProfile:input_type -> pb.UpdateProfileRequest
-	33, // 36: pb.AuthService.GetSessions:input_type -> pb.GetSessionsRequest
-	36, // 37: pb.AuthService.RevokeSession:input_type -> pb.RevokeSessionRequest
-	38, // 38: pb.AuthService.DeactivateAccount:input_type -> pb.DeactivateAccountRequest
-	40, // 39: pb.AuthService.DeleteAccount:input_type -> pb.DeleteAccountRequest
-	43, // 40: pb.AuthService.GetLoginHistory:input_type -> pb.GetLoginHistoryRequest
-	3,  // 41: pb.AuthService.Login:output_type -> pb.LoginResponse
-	5,  // 42: pb.AuthService.Register:output_type -> pb.RegisterResponse
-	7,  // 43: pb.AuthService.VerifyUser:output_type -> pb.VerifyUserResponse
-	19, // 44: pb.AuthService.UploadImage:output_type -> pb.UploadImageResponse
-	9,  // 45: pb.AuthService.ResendOtp:output_type -> pb.ResendOtpResponse
-	11, // 46: pb.AuthService.GetUser:output_type -> pb.GetUserResponse
-	13, // 47: pb.AuthService.LogOut:output_type -> pb.LogOutResponse
-	15, // 48: pb.AuthService.OAuthLogin:output_type -> pb.OAuthLoginResponse
-	17, // 49: pb.AuthService.OAuthRegister:output_type -> pb.OAuthRegisterResponse
-	21, // 50: pb.AuthService.ForgotPassword:output_type -> pb.ForgotPasswordResponse
-	23, // 51: pb.AuthService.VerifyResetPassword:output_type -> pb.VerifyResetPasswordResponse
-	25, // 52: pb.AuthService.ResetPassword:output_type -> pb.ResetPasswordResponse
-	27, // 53: pb.AuthService.ChangePassword:output_type -> pb.ChangePasswordResponse
-	30, // 54: pb.AuthService.GetProfile:output_type -> pb.GetProfileResponse
-	32, // 55: pb.AuthService.UpdateProfile:output_type -> pb.UpdateProfileResponse
-	35, // 56: pb.AuthService.GetSessions:output_type -> pb.GetSessionsResponse
-	37, // 57: pb.AuthService.RevokeSession:output_type -> pb.RevokeSessionResponse
-	39, // 58: pb.AuthService.DeactivateAccount:output_type -> pb.DeactivateAccountResponse
-	41, // 59: pb.AuthService.DeleteAccount:output_type -> pb.DeleteAccountResponse
-	44, // 60: pb.AuthService.GetLoginHistory:output_type -> pb.GetLoginHistoryResponse
-	41, // [41:61] is the sub-list for method output_type
-	21, // [21:41] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	99,  // 0: pb.User.updated_at:type_name -> google.protobuf.Timestamp
+	99,  // 1: pb.User.created_at:type_name -> google.protobuf.Timestamp
+	99,  // 2: pb.Session.expires_at:type_name -> google.protobuf.Timestamp
+	99,  // 3: pb.Session.refresh_token_expires_at:type_name -> google.protobuf.Timestamp
+	0,   // 4: pb.LoginResponse.user:type_name -> pb.User
+	1,   // 5: pb.LoginResponse.session:type_name -> pb.Session
+	99,  // 6: pb.LoginResponse.mfa_expires_at:type_name -> google.protobuf.Timestamp
+	0,   // 7: pb.RefreshTokenResponse.user:type_name -> pb.User
+	1,   // 8: pb.RefreshTokenResponse.session:type_name -> pb.Session
+	0,   // 9: pb.RegisterResponse.user:type_name -> pb.User
+	1,   // 10: pb.VerifyUserResponse.session:type_name -> pb.Session
+	0,   // 11: pb.GetUserResponse.user:type_name -> pb.User
+	0,   // 12: pb.OAuthLoginResponse.user:type_name -> pb.User
+	1,   // 13: pb.OAuthLoginResponse.session:type_name -> pb.Session
+	99,  // 14: pb.OAuthLoginResponse.mfa_expires_at:type_name -> google.protobuf.Timestamp
+	0,   // 15: pb.OAuthRegisterResponse.user:type_name -> pb.User
+	1,   // 16: pb.OAuthRegisterResponse.session:type_name -> pb.Session
+	99,  // 17: pb.StartOAuthResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,   // 18: pb.OAuthCallbackResponse.user:type_name -> pb.User
+	1,   // 19: pb.OAuthCallbackResponse.session:type_name -> pb.Session
+	99,  // 20: pb.OAuthCallbackResponse.mfa_expires_at:type_name -> google.protobuf.Timestamp
+	0,   // 21: pb.ConsumeMagicLinkResponse.user:type_name -> pb.User
+	1,   // 22: pb.ConsumeMagicLinkResponse.session:type_name -> pb.Session
+	99,  // 23: pb.ConsumeMagicLinkResponse.mfa_expires_at:type_name -> google.protobuf.Timestamp
+	99,  // 24: pb.TokenKey.created_at:type_name -> google.protobuf.Timestamp
+	99,  // 25: pb.TokenKey.expires_at:type_name -> google.protobuf.Timestamp
+	28,  // 26: pb.GetTokenKeysResponse.keys:type_name -> pb.TokenKey
+	99,  // 27: pb.Identity.created_at:type_name -> google.protobuf.Timestamp
+	99,  // 28: pb.Identity.last_used_at:type_name -> google.protobuf.Timestamp
+	31,  // 29: pb.LinkIdentityResponse.identity:type_name -> pb.Identity
+	31,  // 30: pb.ListIdentitiesResponse.identities:type_name -> pb.Identity
+	99,  // 31: pb.ProfileDetails.joined_at:type_name -> google.protobuf.Timestamp
+	98,  // 32: pb.ProfileDetails.preferences:type_name -> pb.ProfileDetails.PreferencesEntry
+	0,   // 33: pb.GetProfileResponse.user:type_name -> pb.User
+	49,  // 34: pb.GetProfileResponse.profile_details:type_name -> pb.ProfileDetails
+	0,   // 35: pb.UpdateProfileResponse.user:type_name -> pb.User
+	49,  // 36: pb.UpdateProfileResponse.profile_details:type_name -> pb.ProfileDetails
+	99,  // 37: pb.SessionInfo.last_activity:type_name -> google.protobuf.Timestamp
+	54,  // 38: pb.GetSessionsResponse.sessions:type_name -> pb.SessionInfo
+	99,  // 39: pb.DeleteAccountResponse.deletion_scheduled_for:type_name -> google.protobuf.Timestamp
+	99,  // 40: pb.LoginHistoryEntry.login_time:type_name -> google.protobuf.Timestamp
+	62,  // 41: pb.GetLoginHistoryResponse.history:type_name -> pb.LoginHistoryEntry
+	0,   // 42: pb.VerifyMfaResponse.user:type_name -> pb.User
+	1,   // 43: pb.VerifyMfaResponse.session:type_name -> pb.Session
+	0,   // 44: pb.ConfirmEmailChangeResponse.user:type_name -> pb.User
+	99,  // 45: pb.DataExport.created_at:type_name -> google.protobuf.Timestamp
+	99,  // 46: pb.DataExport.completed_at:type_name -> google.protobuf.Timestamp
+	99,  // 47: pb.DataExport.expires_at:type_name -> google.protobuf.Timestamp
+	83,  // 48: pb.RequestDataExportResponse.export:type_name -> pb.DataExport
+	83,  // 49: pb.GetDataExportResponse.export:type_name -> pb.DataExport
+	99,  // 50: pb.AgentVerification.submitted_at:type_name -> google.protobuf.Timestamp
+	99,  // 51: pb.AgentVerification.reviewed_at:type_name -> google.protobuf.Timestamp
+	91,  // 52: pb.AgentVerification.documents:type_name -> pb.AgentDocument
+	99,  // 53: pb.AgentDocument.uploaded_at:type_name -> google.protobuf.Timestamp
+	91,  // 54: pb.UploadAgentDocumentResponse.document:type_name -> pb.AgentDocument
+	90,  // 55: pb.SubmitAgentVerificationResponse.verification:type_name -> pb.AgentVerification
+	90,  // 56: pb.GetAgentVerificationResponse.verification:type_name -> pb.AgentVerification
+	2,   // 57: pb.AuthService.Login:input_type -> pb.LoginRequest
+	6,   // 58: pb.AuthService.Register:input_type -> pb.RegisterRequest
+	8,   // 59: pb.AuthService.VerifyUser:input_type -> pb.VerifyUserRequest
+	38,  // 60: pb.AuthService.UploadImage:input_type -> pb.UploadImageRequest
+	10,  // 61: pb.AuthService.ResendOtp:input_type -> pb.ResendOtpRequest
+	4,   // 62: pb.AuthService.RefreshToken:input_type -> pb.RefreshTokenRequest
+	12,  // 63: pb.AuthService.GetUser:input_type -> pb.GetUserRequest
+	14,  // 64: pb.AuthService.LogOut:input_type -> pb.LogOutRequest
+	16,  // 65: pb.AuthService.OAuthLogin:input_type -> pb.OAuthLoginRequest
+	18,  // 66: pb.AuthService.OAuthRegister:input_type -> pb.OAuthRegisterRequest
+	20,  // 67: pb.AuthService.StartOAuth:input_type -> pb.StartOAuthRequest
+	22,  // 68: pb.AuthService.OAuthCallback:input_type -> pb.OAuthCallbackRequest
+	24,  // 69: pb.AuthService.RequestMagicLink:input_type -> pb.RequestMagicLinkRequest
+	26,  // 70: pb.AuthService.ConsumeMagicLink:input_type -> pb.ConsumeMagicLinkRequest
+	29,  // 71: pb.AuthService.GetTokenKeys:input_type -> pb.GetTokenKeysRequest
+	32,  // 72: pb.AuthService.LinkIdentity:input_type -> pb.LinkIdentityRequest
+	34,  // 73: pb.AuthService.ListIdentities:input_type -> pb.ListIdentitiesRequest
+	36,  // 74: pb.AuthService.UnlinkIdentity:input_type -> pb.UnlinkIdentityRequest
+	40,  // 75: pb.AuthService.ForgotPassword:input_type -> pb.ForgotPasswordRequest
+	42,  // 76: pb.AuthService.VerifyResetPassword:input_type -> pb.VerifyResetPasswordRequest
+	44,  // 77: pb.AuthService.ResetPassword:input_type -> pb.ResetPasswordRequest
+	46,  // 78: pb.AuthService.ChangePassword:input_type -> pb.ChangePasswordRequest
+	48,  // 79: pb.AuthService.GetProfile:input_type -> pb.GetProfileRequest
+	51,  // 80: pb.AuthService.UpdateProfile:input_type -> pb.UpdateProfileRequest
+	53,  // 81: pb.AuthService.GetSessions:input_type -> pb.GetSessionsRequest
+	56,  // 82: pb.AuthService.RevokeSession:input_type -> pb.RevokeSessionRequest
+	58,  // 83: pb.AuthService.DeactivateAccount:input_type -> pb.DeactivateAccountRequest
+	60,  // 84: pb.AuthService.DeleteAccount:input_type -> pb.DeleteAccountRequest
+	63,  // 85: pb.AuthService.GetLoginHistory:input_type -> pb.GetLoginHistoryRequest
+	65,  // 86: pb.AuthService.EnrollMfa:input_type -> pb.EnrollMfaRequest
+	67,  // 87: pb.AuthService.ConfirmMfa:input_type -> pb.ConfirmMfaRequest
+	69,  // 88: pb.AuthService.VerifyMfa:input_type -> pb.VerifyMfaRequest
+	71,  // 89: pb.AuthService.DisableMfa:input_type -> pb.DisableMfaRequest
+	73,  // 90: pb.AuthService.RegenerateRecoveryCodes:input_type -> pb.RegenerateRecoveryCodesRequest
+	75,  // 91: pb.AuthService.UnlockAccount:input_type -> pb.UnlockAccountRequest
+	77,  // 92: pb.AuthService.RequestEmailChange:input_type -> pb.RequestEmailChangeRequest
+	79,  // 93: pb.AuthService.ConfirmEmailChange:input_type -> pb.ConfirmEmailChangeRequest
+	81,  // 94: pb.AuthService.RevertEmailChange:input_type -> pb.RevertEmailChangeRequest
+	84,  // 95: pb.AuthService.RequestDataExport:input_type -> pb.RequestDataExportRequest
+	86,  // 96: pb.AuthService.GetDataExport:input_type -> pb.GetDataExportRequest
+	88,  // 97: pb.AuthService.DownloadDataExport:input_type -> pb.DownloadDataExportRequest
+	92,  // 98: pb.AuthService.UploadAgentDocument:input_type -> pb.UploadAgentDocumentRequest
+	94,  // 99: pb.AuthService.SubmitAgentVerification:input_type -> pb.SubmitAgentVerificationRequest
+	96,  // 100: pb.AuthService.GetAgentVerification:input_type -> pb.GetAgentVerificationRequest
+	3,   // 101: pb.AuthService.Login:output_type -> pb.LoginResponse
+	7,   // 102: pb.AuthService.Register:output_type -> pb.RegisterResponse
+	9,   // 103: pb.AuthService.VerifyUser:output_type -> pb.VerifyUserResponse
+	39,  // 104: pb.AuthService.UploadImage:output_type -> pb.UploadImageResponse
+	11,  // 105: pb.AuthService.ResendOtp:output_type -> pb.ResendOtpResponse
+	5,   // 106: pb.AuthService.RefreshToken:output_type -> pb.RefreshTokenResponse
+	13,  // 107: pb.AuthService.GetUser:output_type -> pb.GetUserResponse
+	15,  // 108: pb.AuthService.LogOut:output_type -> pb.LogOutResponse
+	17,  // 109: pb.AuthService.OAuthLogin:output_type -> pb.OAuthLoginResponse
+	19,  // 110: pb.AuthService.OAuthRegister:output_type -> pb.OAuthRegisterResponse
+	21,  // 111: pb.AuthService.StartOAuth:output_type -> pb.StartOAuthResponse
+	23,  // 112: pb.AuthService.OAuthCallback:output_type -> pb.OAuthCallbackResponse
+	25,  // 113: pb.AuthService.RequestMagicLink:output_type -> pb.RequestMagicLinkResponse
+	27,  // 114: pb.AuthService.ConsumeMagicLink:output_type -> pb.ConsumeMagicLinkResponse
+	30,  // 115: pb.AuthService.GetTokenKeys:output_type -> pb.GetTokenKeysResponse
+	33,  // 116: pb.AuthService.LinkIdentity:output_type -> pb.LinkIdentityResponse
+	35,  // 117: pb.AuthService.ListIdentities:output_type -> pb.ListIdentitiesResponse
+	37,  // 118: pb.AuthService.UnlinkIdentity:output_type -> pb.UnlinkIdentityResponse
+	41,  // 119: pb.AuthService.ForgotPassword:output_type -> pb.ForgotPasswordResponse
+	43,  // 120: pb.AuthService.VerifyResetPassword:output_type -> pb.VerifyResetPasswordResponse
+	45,  // 121: pb.AuthService.ResetPassword:output_type -> pb.ResetPasswordResponse
+	47,  // 122: pb.AuthService.ChangePassword:output_type -> pb.ChangePasswordResponse
+	50,  // 123: pb.AuthService.GetProfile:output_type -> pb.GetProfileResponse
+	52,  // 124: pb.AuthService.UpdateProfile:output_type -> pb.UpdateProfileResponse
+	55,  // 125: pb.AuthService.GetSessions:output_type -> pb.GetSessionsResponse
+	57,  // 126: pb.AuthService.RevokeSession:output_type -> pb.RevokeSessionResponse
+	59,  // 127: pb.AuthService.DeactivateAccount:output_type -> pb.DeactivateAccountResponse
+	61,  // 128: pb.AuthService.DeleteAccount:output_type -> pb.DeleteAccountResponse
+	64,  // 129: pb.AuthService.GetLoginHistory:output_type -> pb.GetLoginHistoryResponse
+	66,  // 130: pb.AuthService.EnrollMfa:output_type -> pb.EnrollMfaResponse
+	68,  // 131: pb.AuthService.ConfirmMfa:output_type -> pb.ConfirmMfaResponse
+	70,  // 132: pb.AuthService.VerifyMfa:output_type -> pb.VerifyMfaResponse
+	72,  // 133: pb.AuthService.DisableMfa:output_type -> pb.DisableMfaResponse
+	74,  // 134: pb.AuthService.RegenerateRecoveryCodes:output_type -> pb.RegenerateRecoveryCodesResponse
+	76,  // 135: pb.AuthService.UnlockAccount:output_type -> pb.UnlockAccountResponse
+	78,  // 136: pb.AuthService.RequestEmailChange:output_type -> pb.RequestEmailChangeResponse
+	80,  // 137: pb.AuthService.ConfirmEmailChange:output_type -> pb.ConfirmEmailChangeResponse
+	82,  // 138: pb.AuthService.RevertEmailChange:output_type -> pb.RevertEmailChangeResponse
+	85,  // 139: pb.AuthService.RequestDataExport:output_type -> pb.RequestDataExportResponse
+	87,  // 140: pb.AuthService.GetDataExport:output_type -> pb.GetDataExportResponse
+	89,  // 141: pb.AuthService.DownloadDataExport:output_type -> pb.DownloadDataExportResponse
+	93,  // 142: pb.AuthService.UploadAgentDocument:output_type -> pb.UploadAgentDocumentResponse
+	95,  // 143: pb.AuthService.SubmitAgentVerification:output_type -> pb.SubmitAgentVerificationResponse
+	97,  // 144: pb.AuthService.GetAgentVerification:output_type -> pb.GetAgentVerificationResponse
+	101, // [101:145] is the sub-list for method output_type
+	57,  // [57:101] is the sub-list for method input_type
+	57,  // [57:57] is the sub-list for extension type_name
+	57,  // [57:57] is the sub-list for extension extendee
+	0,   // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -2799,7 +6251,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   46,
+			NumMessages:   99,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
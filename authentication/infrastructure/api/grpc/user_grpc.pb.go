@@ -19,26 +19,50 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_Login_FullMethodName               = "/pb.AuthService/Login"
-	AuthService_Register_FullMethodName            = "/pb.AuthService/Register"
-	AuthService_VerifyUser_FullMethodName          = "/pb.AuthService/VerifyUser"
-	AuthService_UploadImage_FullMethodName         = "/pb.AuthService/UploadImage"
-	AuthService_ResendOtp_FullMethodName           = "/pb.AuthService/ResendOtp"
-	AuthService_GetUser_FullMethodName             = "/pb.AuthService/GetUser"
-	AuthService_LogOut_FullMethodName              = "/pb.AuthService/LogOut"
-	AuthService_OAuthLogin_FullMethodName          = "/pb.AuthService/OAuthLogin"
-	AuthService_OAuthRegister_FullMethodName       = "/pb.AuthService/OAuthRegister"
-	AuthService_ForgotPassword_FullMethodName      = "/pb.AuthService/ForgotPassword"
-	AuthService_VerifyResetPassword_FullMethodName = "/pb.AuthService/VerifyResetPassword"
-	AuthService_ResetPassword_FullMethodName       = "/pb.AuthService/ResetPassword"
-	AuthService_ChangePassword_FullMethodName      = "/pb.AuthService/ChangePassword"
-	AuthService_GetProfile_FullMethodName          = "/pb.AuthService/GetProfile"
-	AuthService_UpdateProfile_FullMethodName       = "/pb.AuthService/UpdateProfile"
-	AuthService_GetSessions_FullMethodName         = "/pb.AuthService/GetSessions"
-	AuthService_RevokeSession_FullMethodName       = "/pb.AuthService/RevokeSession"
-	AuthService_DeactivateAccount_FullMethodName   = "/pb.AuthService/DeactivateAccount"
-	AuthService_DeleteAccount_FullMethodName       = "/pb.AuthService/DeleteAccount"
-	AuthService_GetLoginHistory_FullMethodName     = "/pb.AuthService/GetLoginHistory"
+	AuthService_Login_FullMethodName                   = "/pb.AuthService/Login"
+	AuthService_Register_FullMethodName                = "/pb.AuthService/Register"
+	AuthService_VerifyUser_FullMethodName              = "/pb.AuthService/VerifyUser"
+	AuthService_UploadImage_FullMethodName             = "/pb.AuthService/UploadImage"
+	AuthService_ResendOtp_FullMethodName               = "/pb.AuthService/ResendOtp"
+	AuthService_RefreshToken_FullMethodName            = "/pb.AuthService/RefreshToken"
+	AuthService_GetUser_FullMethodName                 = "/pb.AuthService/GetUser"
+	AuthService_LogOut_FullMethodName                  = "/pb.AuthService/LogOut"
+	AuthService_OAuthLogin_FullMethodName              = "/pb.AuthService/OAuthLogin"
+	AuthService_OAuthRegister_FullMethodName           = "/pb.AuthService/OAuthRegister"
+	AuthService_StartOAuth_FullMethodName              = "/pb.AuthService/StartOAuth"
+	AuthService_OAuthCallback_FullMethodName           = "/pb.AuthService/OAuthCallback"
+	AuthService_RequestMagicLink_FullMethodName        = "/pb.AuthService/RequestMagicLink"
+	AuthService_ConsumeMagicLink_FullMethodName        = "/pb.AuthService/ConsumeMagicLink"
+	AuthService_GetTokenKeys_FullMethodName            = "/pb.AuthService/GetTokenKeys"
+	AuthService_LinkIdentity_FullMethodName            = "/pb.AuthService/LinkIdentity"
+	AuthService_ListIdentities_FullMethodName          = "/pb.AuthService/ListIdentities"
+	AuthService_UnlinkIdentity_FullMethodName          = "/pb.AuthService/UnlinkIdentity"
+	AuthService_ForgotPassword_FullMethodName          = "/pb.AuthService/ForgotPassword"
+	AuthService_VerifyResetPassword_FullMethodName     = "/pb.AuthService/VerifyResetPassword"
+	AuthService_ResetPassword_FullMethodName           = "/pb.AuthService/ResetPassword"
+	AuthService_ChangePassword_FullMethodName          = "/pb.AuthService/ChangePassword"
+	AuthService_GetProfile_FullMethodName              = "/pb.AuthService/GetProfile"
+	AuthService_UpdateProfile_FullMethodName           = "/pb.AuthService/UpdateProfile"
+	AuthService_GetSessions_FullMethodName             = "/pb.AuthService/GetSessions"
+	AuthService_RevokeSession_FullMethodName           = "/pb.AuthService/RevokeSession"
+	AuthService_DeactivateAccount_FullMethodName       = "/pb.AuthService/DeactivateAccount"
+	AuthService_DeleteAccount_FullMethodName           = "/pb.AuthService/DeleteAccount"
+	AuthService_GetLoginHistory_FullMethodName         = "/pb.AuthService/GetLoginHistory"
+	AuthService_EnrollMfa_FullMethodName               = "/pb.AuthService/EnrollMfa"
+	AuthService_ConfirmMfa_FullMethodName              = "/pb.AuthService/ConfirmMfa"
+	AuthService_VerifyMfa_FullMethodName               = "/pb.AuthService/VerifyMfa"
+	AuthService_DisableMfa_FullMethodName              = "/pb.AuthService/DisableMfa"
+	AuthService_RegenerateRecoveryCodes_FullMethodName = "/pb.AuthService/RegenerateRecoveryCodes"
+	AuthService_UnlockAccount_FullMethodName           = "/pb.AuthService/UnlockAccount"
+	AuthService_RequestEmailChange_FullMethodName      = "/pb.AuthService/RequestEmailChange"
+	AuthService_ConfirmEmailChange_FullMethodName      = "/pb.AuthService/ConfirmEmailChange"
+	AuthService_RevertEmailChange_FullMethodName       = "/pb.AuthService/RevertEmailChange"
+	AuthService_RequestDataExport_FullMethodName       = "/pb.AuthService/RequestDataExport"
+	AuthService_GetDataExport_FullMethodName           = "/pb.AuthService/GetDataExport"
+	AuthService_DownloadDataExport_FullMethodName      = "/pb.AuthService/DownloadDataExport"
+	AuthService_UploadAgentDocument_FullMethodName     = "/pb.AuthService/UploadAgentDocument"
+	AuthService_SubmitAgentVerification_FullMethodName = "/pb.AuthService/SubmitAgentVerification"
+	AuthService_GetAgentVerification_FullMethodName    = "/pb.AuthService/GetAgentVerification"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -52,10 +76,19 @@ type AuthServiceClient interface {
 	VerifyUser(ctx context.Context, in *VerifyUserRequest, opts ...grpc.CallOption) (*VerifyUserResponse, error)
 	UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error)
 	ResendOtp(ctx context.Context, in *ResendOtpRequest, opts ...grpc.CallOption) (*ResendOtpResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	LogOut(ctx context.Context, in *LogOutRequest, opts ...grpc.CallOption) (*LogOutResponse, error)
 	OAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error)
 	OAuthRegister(ctx context.Context, in *OAuthRegisterRequest, opts ...grpc.CallOption) (*OAuthRegisterResponse, error)
+	StartOAuth(ctx context.Context, in *StartOAuthRequest, opts ...grpc.CallOption) (*StartOAuthResponse, error)
+	OAuthCallback(ctx context.Context, in *OAuthCallbackRequest, opts ...grpc.CallOption) (*OAuthCallbackResponse, error)
+	RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...grpc.CallOption) (*RequestMagicLinkResponse, error)
+	ConsumeMagicLink(ctx context.Context, in *ConsumeMagicLinkRequest, opts ...grpc.CallOption) (*ConsumeMagicLinkResponse, error)
+	GetTokenKeys(ctx context.Context, in *GetTokenKeysRequest, opts ...grpc.CallOption) (*GetTokenKeysResponse, error)
+	LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkIdentityResponse, error)
+	ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error)
+	UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*UnlinkIdentityResponse, error)
 	ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*ForgotPasswordResponse, error)
 	VerifyResetPassword(ctx context.Context, in *VerifyResetPasswordRequest, opts ...grpc.CallOption) (*VerifyResetPasswordResponse, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
@@ -68,6 +101,25 @@ type AuthServiceClient interface {
 	DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error)
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
 	GetLoginHistory(ctx context.Context, in *GetLoginHistoryRequest, opts ...grpc.CallOption) (*GetLoginHistoryResponse, error)
+	EnrollMfa(ctx context.Context, in *EnrollMfaRequest, opts ...grpc.CallOption) (*EnrollMfaResponse, error)
+	ConfirmMfa(ctx context.Context, in *ConfirmMfaRequest, opts ...grpc.CallOption) (*ConfirmMfaResponse, error)
+	VerifyMfa(ctx context.Context, in *VerifyMfaRequest, opts ...grpc.CallOption) (*VerifyMfaResponse, error)
+	DisableMfa(ctx context.Context, in *DisableMfaRequest, opts ...grpc.CallOption) (*DisableMfaResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error)
+	// UnlockAccount lifts a brute-force lockout from a user's account. It is
+	// gRPC only, so every call goes through the user:admin permission check.
+	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error)
+	RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error)
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmailChangeResponse, error)
+	RevertEmailChange(ctx context.Context, in *RevertEmailChangeRequest, opts ...grpc.CallOption) (*RevertEmailChangeResponse, error)
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error)
+	GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error)
+	// DownloadDataExport streams the ZIP of a ready export in chunks. It is
+	// authorized by the token of the emailed link rather than an access token.
+	DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDataExportResponse], error)
+	UploadAgentDocument(ctx context.Context, in *UploadAgentDocumentRequest, opts ...grpc.CallOption) (*UploadAgentDocumentResponse, error)
+	SubmitAgentVerification(ctx context.Context, in *SubmitAgentVerificationRequest, opts ...grpc.CallOption) (*SubmitAgentVerificationResponse, error)
+	GetAgentVerification(ctx context.Context, in *GetAgentVerificationRequest, opts ...grpc.CallOption) (*GetAgentVerificationResponse, error)
 }
 
 type authServiceClient struct {
@@ -128,6 +180,16 @@ func (c *authServiceClient) ResendOtp(ctx context.Context, in *ResendOtpRequest,
 	return out, nil
 }
 
+func (c *authServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserResponse)
@@ -168,6 +230,86 @@ func (c *authServiceClient) OAuthRegister(ctx context.Context, in *OAuthRegister
 	return out, nil
 }
 
+func (c *authServiceClient) StartOAuth(ctx context.Context, in *StartOAuthRequest, opts ...grpc.CallOption) (*StartOAuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartOAuthResponse)
+	err := c.cc.Invoke(ctx, AuthService_StartOAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) OAuthCallback(ctx context.Context, in *OAuthCallbackRequest, opts ...grpc.CallOption) (*OAuthCallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthCallbackResponse)
+	err := c.cc.Invoke(ctx, AuthService_OAuthCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...grpc.CallOption) (*RequestMagicLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestMagicLinkResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestMagicLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConsumeMagicLink(ctx context.Context, in *ConsumeMagicLinkRequest, opts ...grpc.CallOption) (*ConsumeMagicLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumeMagicLinkResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConsumeMagicLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetTokenKeys(ctx context.Context, in *GetTokenKeysRequest, opts ...grpc.CallOption) (*GetTokenKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTokenKeysResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetTokenKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkIdentityResponse)
+	err := c.cc.Invoke(ctx, AuthService_LinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIdentitiesResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*UnlinkIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkIdentityResponse)
+	err := c.cc.Invoke(ctx, AuthService_UnlinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*ForgotPasswordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ForgotPasswordResponse)
@@ -278,6 +420,165 @@ func (c *authServiceClient) GetLoginHistory(ctx context.Context, in *GetLoginHis
 	return out, nil
 }
 
+func (c *authServiceClient) EnrollMfa(ctx context.Context, in *EnrollMfaRequest, opts ...grpc.CallOption) (*EnrollMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_EnrollMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmMfa(ctx context.Context, in *ConfirmMfaRequest, opts ...grpc.CallOption) (*ConfirmMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyMfa(ctx context.Context, in *VerifyMfaRequest, opts ...grpc.CallOption) (*VerifyMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DisableMfa(ctx context.Context, in *DisableMfaRequest, opts ...grpc.CallOption) (*DisableMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_DisableMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, AuthService_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_UnlockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevertEmailChange(ctx context.Context, in *RevertEmailChangeRequest, opts ...grpc.CallOption) (*RevertEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevertEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDataExportResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataExportResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDataExportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AuthService_ServiceDesc.Streams[0], AuthService_DownloadDataExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadDataExportRequest, DownloadDataExportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_DownloadDataExportClient = grpc.ServerStreamingClient[DownloadDataExportResponse]
+
+func (c *authServiceClient) UploadAgentDocument(ctx context.Context, in *UploadAgentDocumentRequest, opts ...grpc.CallOption) (*UploadAgentDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadAgentDocumentResponse)
+	err := c.cc.Invoke(ctx, AuthService_UploadAgentDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SubmitAgentVerification(ctx context.Context, in *SubmitAgentVerificationRequest, opts ...grpc.CallOption) (*SubmitAgentVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitAgentVerificationResponse)
+	err := c.cc.Invoke(ctx, AuthService_SubmitAgentVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetAgentVerification(ctx context.Context, in *GetAgentVerificationRequest, opts ...grpc.CallOption) (*GetAgentVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAgentVerificationResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetAgentVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -289,10 +590,19 @@ type AuthServiceServer interface {
 	VerifyUser(context.Context, *VerifyUserRequest) (*VerifyUserResponse, error)
 	UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error)
 	ResendOtp(context.Context, *ResendOtpRequest) (*ResendOtpResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	LogOut(context.Context, *LogOutRequest) (*LogOutResponse, error)
 	OAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error)
 	OAuthRegister(context.Context, *OAuthRegisterRequest) (*OAuthRegisterResponse, error)
+	StartOAuth(context.Context, *StartOAuthRequest) (*StartOAuthResponse, error)
+	OAuthCallback(context.Context, *OAuthCallbackRequest) (*OAuthCallbackResponse, error)
+	RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*RequestMagicLinkResponse, error)
+	ConsumeMagicLink(context.Context, *ConsumeMagicLinkRequest) (*ConsumeMagicLinkResponse, error)
+	GetTokenKeys(context.Context, *GetTokenKeysRequest) (*GetTokenKeysResponse, error)
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityResponse, error)
+	ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error)
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*UnlinkIdentityResponse, error)
 	ForgotPassword(context.Context, *ForgotPasswordRequest) (*ForgotPasswordResponse, error)
 	VerifyResetPassword(context.Context, *VerifyResetPasswordRequest) (*VerifyResetPasswordResponse, error)
 	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
@@ -305,6 +615,25 @@ type AuthServiceServer interface {
 	DeactivateAccount(context.Context, *DeactivateAccountRequest) (*DeactivateAccountResponse, error)
 	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
 	GetLoginHistory(context.Context, *GetLoginHistoryRequest) (*GetLoginHistoryResponse, error)
+	EnrollMfa(context.Context, *EnrollMfaRequest) (*EnrollMfaResponse, error)
+	ConfirmMfa(context.Context, *ConfirmMfaRequest) (*ConfirmMfaResponse, error)
+	VerifyMfa(context.Context, *VerifyMfaRequest) (*VerifyMfaResponse, error)
+	DisableMfa(context.Context, *DisableMfaRequest) (*DisableMfaResponse, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error)
+	// UnlockAccount lifts a brute-force lockout from a user's account. It is
+	// gRPC only, so every call goes through the user:admin permission check.
+	UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error)
+	RequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error)
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error)
+	RevertEmailChange(context.Context, *RevertEmailChangeRequest) (*RevertEmailChangeResponse, error)
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error)
+	GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error)
+	// DownloadDataExport streams the ZIP of a ready export in chunks. It is
+	// authorized by the token of the emailed link rather than an access token.
+	DownloadDataExport(*DownloadDataExportRequest, grpc.ServerStreamingServer[DownloadDataExportResponse]) error
+	UploadAgentDocument(context.Context, *UploadAgentDocumentRequest) (*UploadAgentDocumentResponse, error)
+	SubmitAgentVerification(context.Context, *SubmitAgentVerificationRequest) (*SubmitAgentVerificationResponse, error)
+	GetAgentVerification(context.Context, *GetAgentVerificationRequest) (*GetAgentVerificationResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -330,6 +659,9 @@ func (UnimplementedAuthServiceServer) UploadImage(context.Context, *UploadImageR
 func (UnimplementedAuthServiceServer) ResendOtp(context.Context, *ResendOtpRequest) (*ResendOtpResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendOtp not implemented")
 }
+func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
 func (UnimplementedAuthServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
@@ -342,6 +674,30 @@ func (UnimplementedAuthServiceServer) OAuthLogin(context.Context, *OAuthLoginReq
 func (UnimplementedAuthServiceServer) OAuthRegister(context.Context, *OAuthRegisterRequest) (*OAuthRegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OAuthRegister not implemented")
 }
+func (UnimplementedAuthServiceServer) StartOAuth(context.Context, *StartOAuthRequest) (*StartOAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOAuth not implemented")
+}
+func (UnimplementedAuthServiceServer) OAuthCallback(context.Context, *OAuthCallbackRequest) (*OAuthCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OAuthCallback not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*RequestMagicLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestMagicLink not implemented")
+}
+func (UnimplementedAuthServiceServer) ConsumeMagicLink(context.Context, *ConsumeMagicLinkRequest) (*ConsumeMagicLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumeMagicLink not implemented")
+}
+func (UnimplementedAuthServiceServer) GetTokenKeys(context.Context, *GetTokenKeysRequest) (*GetTokenKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenKeys not implemented")
+}
+func (UnimplementedAuthServiceServer) LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkIdentity not implemented")
+}
+func (UnimplementedAuthServiceServer) ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIdentities not implemented")
+}
+func (UnimplementedAuthServiceServer) UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*UnlinkIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkIdentity not implemented")
+}
 func (UnimplementedAuthServiceServer) ForgotPassword(context.Context, *ForgotPasswordRequest) (*ForgotPasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForgotPassword not implemented")
 }
@@ -375,6 +731,51 @@ func (UnimplementedAuthServiceServer) DeleteAccount(context.Context, *DeleteAcco
 func (UnimplementedAuthServiceServer) GetLoginHistory(context.Context, *GetLoginHistoryRequest) (*GetLoginHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoginHistory not implemented")
 }
+func (UnimplementedAuthServiceServer) EnrollMfa(context.Context, *EnrollMfaRequest) (*EnrollMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmMfa(context.Context, *ConfirmMfaRequest) (*ConfirmMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifyMfa(context.Context, *VerifyMfaRequest) (*VerifyMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) DisableMfa(context.Context, *DisableMfaRequest) (*DisableMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedAuthServiceServer) UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) RevertEmailChange(context.Context, *RevertEmailChangeRequest) (*RevertEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedAuthServiceServer) GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (UnimplementedAuthServiceServer) DownloadDataExport(*DownloadDataExportRequest, grpc.ServerStreamingServer[DownloadDataExportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadDataExport not implemented")
+}
+func (UnimplementedAuthServiceServer) UploadAgentDocument(context.Context, *UploadAgentDocumentRequest) (*UploadAgentDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadAgentDocument not implemented")
+}
+func (UnimplementedAuthServiceServer) SubmitAgentVerification(context.Context, *SubmitAgentVerificationRequest) (*SubmitAgentVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAgentVerification not implemented")
+}
+func (UnimplementedAuthServiceServer) GetAgentVerification(context.Context, *GetAgentVerificationRequest) (*GetAgentVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentVerification not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -486,6 +887,24 @@ func _AuthService_ResendOtp_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
@@ -558,161 +977,305 @@ func _AuthService_OAuthRegister_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_ForgotPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ForgotPasswordRequest)
+func _AuthService_StartOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOAuthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).ForgotPassword(ctx, in)
+		return srv.(AuthServiceServer).StartOAuth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_ForgotPassword_FullMethodName,
+		FullMethod: AuthService_StartOAuth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).ForgotPassword(ctx, req.(*ForgotPasswordRequest))
+		return srv.(AuthServiceServer).StartOAuth(ctx, req.(*StartOAuthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_VerifyResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyResetPasswordRequest)
+func _AuthService_OAuthCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthCallbackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).VerifyResetPassword(ctx, in)
+		return srv.(AuthServiceServer).OAuthCallback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_VerifyResetPassword_FullMethodName,
+		FullMethod: AuthService_OAuthCallback_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).VerifyResetPassword(ctx, req.(*VerifyResetPasswordRequest))
+		return srv.(AuthServiceServer).OAuthCallback(ctx, req.(*OAuthCallbackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPasswordRequest)
+func _AuthService_RequestMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestMagicLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).ResetPassword(ctx, in)
+		return srv.(AuthServiceServer).RequestMagicLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_ResetPassword_FullMethodName,
+		FullMethod: AuthService_RequestMagicLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+		return srv.(AuthServiceServer).RequestMagicLink(ctx, req.(*RequestMagicLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
+func _AuthService_ConsumeMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeMagicLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).ChangePassword(ctx, in)
+		return srv.(AuthServiceServer).ConsumeMagicLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_ChangePassword_FullMethodName,
+		FullMethod: AuthService_ConsumeMagicLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+		return srv.(AuthServiceServer).ConsumeMagicLink(ctx, req.(*ConsumeMagicLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileRequest)
+func _AuthService_GetTokenKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).GetProfile(ctx, in)
+		return srv.(AuthServiceServer).GetTokenKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_GetProfile_FullMethodName,
+		FullMethod: AuthService_GetTokenKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+		return srv.(AuthServiceServer).GetTokenKeys(ctx, req.(*GetTokenKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProfileRequest)
+func _AuthService_LinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).UpdateProfile(ctx, in)
+		return srv.(AuthServiceServer).LinkIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_UpdateProfile_FullMethodName,
+		FullMethod: AuthService_LinkIdentity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+		return srv.(AuthServiceServer).LinkIdentity(ctx, req.(*LinkIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSessionsRequest)
+func _AuthService_ListIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentitiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).GetSessions(ctx, in)
+		return srv.(AuthServiceServer).ListIdentities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_GetSessions_FullMethodName,
+		FullMethod: AuthService_ListIdentities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).GetSessions(ctx, req.(*GetSessionsRequest))
+		return srv.(AuthServiceServer).ListIdentities(ctx, req.(*ListIdentitiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevokeSessionRequest)
+func _AuthService_UnlinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).RevokeSession(ctx, in)
+		return srv.(AuthServiceServer).UnlinkIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_RevokeSession_FullMethodName,
+		FullMethod: AuthService_UnlinkIdentity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+		return srv.(AuthServiceServer).UnlinkIdentity(ctx, req.(*UnlinkIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_DeactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeactivateAccountRequest)
+func _AuthService_ForgotPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgotPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).DeactivateAccount(ctx, in)
+		return srv.(AuthServiceServer).ForgotPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_DeactivateAccount_FullMethodName,
+		FullMethod: AuthService_ForgotPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ForgotPassword(ctx, req.(*ForgotPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyResetPassword(ctx, req.(*VerifyResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetSessions(ctx, req.(*GetSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeactivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeactivateAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(AuthServiceServer).DeactivateAccount(ctx, req.(*DeactivateAccountRequest))
@@ -756,6 +1319,269 @@ func _AuthService_GetLoginHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_EnrollMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EnrollMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EnrollMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EnrollMfa(ctx, req.(*EnrollMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmMfa(ctx, req.(*ConfirmMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyMfa(ctx, req.(*VerifyMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DisableMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DisableMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DisableMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DisableMfa(ctx, req.(*DisableMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UnlockAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UnlockAccount(ctx, req.(*UnlockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestEmailChange(ctx, req.(*RequestEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmEmailChange(ctx, req.(*ConfirmEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevertEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevertEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevertEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevertEmailChange(ctx, req.(*RevertEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetDataExport(ctx, req.(*GetDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DownloadDataExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDataExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuthServiceServer).DownloadDataExport(m, &grpc.GenericServerStream[DownloadDataExportRequest, DownloadDataExportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_DownloadDataExportServer = grpc.ServerStreamingServer[DownloadDataExportResponse]
+
+func _AuthService_UploadAgentDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAgentDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UploadAgentDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UploadAgentDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UploadAgentDocument(ctx, req.(*UploadAgentDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SubmitAgentVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAgentVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SubmitAgentVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SubmitAgentVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SubmitAgentVerification(ctx, req.(*SubmitAgentVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetAgentVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetAgentVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetAgentVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetAgentVerification(ctx, req.(*GetAgentVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -783,6 +1609,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResendOtp",
 			Handler:    _AuthService_ResendOtp_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AuthService_RefreshToken_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _AuthService_GetUser_Handler,
@@ -799,6 +1629,38 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OAuthRegister",
 			Handler:    _AuthService_OAuthRegister_Handler,
 		},
+		{
+			MethodName: "StartOAuth",
+			Handler:    _AuthService_StartOAuth_Handler,
+		},
+		{
+			MethodName: "OAuthCallback",
+			Handler:    _AuthService_OAuthCallback_Handler,
+		},
+		{
+			MethodName: "RequestMagicLink",
+			Handler:    _AuthService_RequestMagicLink_Handler,
+		},
+		{
+			MethodName: "ConsumeMagicLink",
+			Handler:    _AuthService_ConsumeMagicLink_Handler,
+		},
+		{
+			MethodName: "GetTokenKeys",
+			Handler:    _AuthService_GetTokenKeys_Handler,
+		},
+		{
+			MethodName: "LinkIdentity",
+			Handler:    _AuthService_LinkIdentity_Handler,
+		},
+		{
+			MethodName: "ListIdentities",
+			Handler:    _AuthService_ListIdentities_Handler,
+		},
+		{
+			MethodName: "UnlinkIdentity",
+			Handler:    _AuthService_UnlinkIdentity_Handler,
+		},
 		{
 			MethodName: "ForgotPassword",
 			Handler:    _AuthService_ForgotPassword_Handler,
@@ -843,7 +1705,69 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLoginHistory",
 			Handler:    _AuthService_GetLoginHistory_Handler,
 		},
+		{
+			MethodName: "EnrollMfa",
+			Handler:    _AuthService_EnrollMfa_Handler,
+		},
+		{
+			MethodName: "ConfirmMfa",
+			Handler:    _AuthService_ConfirmMfa_Handler,
+		},
+		{
+			MethodName: "VerifyMfa",
+			Handler:    _AuthService_VerifyMfa_Handler,
+		},
+		{
+			MethodName: "DisableMfa",
+			Handler:    _AuthService_DisableMfa_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _AuthService_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "UnlockAccount",
+			Handler:    _AuthService_UnlockAccount_Handler,
+		},
+		{
+			MethodName: "RequestEmailChange",
+			Handler:    _AuthService_RequestEmailChange_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _AuthService_ConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "RevertEmailChange",
+			Handler:    _AuthService_RevertEmailChange_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _AuthService_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _AuthService_GetDataExport_Handler,
+		},
+		{
+			MethodName: "UploadAgentDocument",
+			Handler:    _AuthService_UploadAgentDocument_Handler,
+		},
+		{
+			MethodName: "SubmitAgentVerification",
+			Handler:    _AuthService_SubmitAgentVerification_Handler,
+		},
+		{
+			MethodName: "GetAgentVerification",
+			Handler:    _AuthService_GetAgentVerification_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadDataExport",
+			Handler:       _AuthService_DownloadDataExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
 }
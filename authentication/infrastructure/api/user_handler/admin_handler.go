@@ -0,0 +1,443 @@
+package user_handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/usecase"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminHandler serves the AdminService. Every RPC is gated by the
+// user:admin permission interceptor, which stores the caller's token payload.
+type AdminHandler struct {
+	adminUsecase usecase.AdminUsecase
+	pb.UnimplementedAdminServiceServer
+}
+
+// NewAdminHandler creates a new AdminHandler.
+func NewAdminHandler(adminUsecase usecase.AdminUsecase) *AdminHandler {
+	return &AdminHandler{
+		adminUsecase: adminUsecase,
+	}
+}
+
+// ListUsers handles searching users
+func (h *AdminHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	users, total, err := h.adminUsecase.ListUsers(ctx, entity.UserFilter{
+		Email:    req.Email,
+		Name:     req.Name,
+		Role:     req.Role,
+		Provider: req.Provider,
+		IsActive: req.IsActive,
+		Deleted:  req.Deleted,
+		Limit:    int(req.Limit),
+		Offset:   int(req.Offset),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+
+	adminUsers := make([]*pb.AdminUser, 0, len(users))
+	for _, user := range users {
+		adminUsers = append(adminUsers, toPbAdminUser(user))
+	}
+
+	return &pb.ListUsersResponse{
+		Users: adminUsers,
+		Total: total,
+	}, nil
+}
+
+// GetUserDetails handles viewing a user's account
+func (h *AdminHandler) GetUserDetails(ctx context.Context, req *pb.GetUserDetailsRequest) (*pb.GetUserDetailsResponse, error) {
+	user, err := h.adminUsecase.GetUser(ctx, req.UserId)
+	if err != nil {
+		return nil, adminError(err, "failed to get user")
+	}
+
+	return &pb.GetUserDetailsResponse{
+		User: toPbAdminUser(user),
+	}, nil
+}
+
+// ListUserSessions handles listing a user's active sessions
+func (h *AdminHandler) ListUserSessions(ctx context.Context, req *pb.ListUserSessionsRequest) (*pb.ListUserSessionsResponse, error) {
+	sessions, err := h.adminUsecase.ListUserSessions(ctx, req.UserId)
+	if err != nil {
+		return nil, adminError(err, "failed to get sessions")
+	}
+
+	sessionInfos := make([]*pb.SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		var deviceInfo string
+		if session.DeviceInfo != nil && session.DeviceInfo.Valid {
+			deviceInfo = string(session.DeviceInfo.RawMessage)
+		}
+
+		sessionInfos = append(sessionInfos, &pb.SessionInfo{
+			SessionId:    session.SessionID.String(),
+			DeviceInfo:   deviceInfo,
+			IpAddress:    session.IpAddress,
+			UserAgent:    session.UserAgent,
+			LastActivity: timestamppb.New(session.LastActivity),
+		})
+	}
+
+	return &pb.ListUserSessionsResponse{
+		Sessions: sessionInfos,
+	}, nil
+}
+
+// ListUserLoginHistory handles listing a user's most recent logins
+func (h *AdminHandler) ListUserLoginHistory(ctx context.Context, req *pb.ListUserLoginHistoryRequest) (*pb.ListUserLoginHistoryResponse, error) {
+	history, err := h.adminUsecase.ListUserLoginHistory(ctx, req.UserId, int(req.Limit))
+	if err != nil {
+		return nil, adminError(err, "failed to get login history")
+	}
+
+	historyEntries := make([]*pb.LoginHistoryEntry, 0, len(history))
+	for _, entry := range history {
+		historyEntries = append(historyEntries, toPbLoginHistoryEntry(entry))
+	}
+
+	return &pb.ListUserLoginHistoryResponse{
+		History: historyEntries,
+	}, nil
+}
+
+// ForceLogout handles ending every session of a user
+func (h *AdminHandler) ForceLogout(ctx context.Context, req *pb.ForceLogoutRequest) (*pb.ForceLogoutResponse, error) {
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.adminUsecase.ForceLogout(ctx, actorID, req.UserId); err != nil {
+		return nil, adminError(err, "failed to log out user")
+	}
+
+	return &pb.ForceLogoutResponse{
+		Message: "User logged out of all sessions",
+	}, nil
+}
+
+// LockUser handles locking a user's account
+func (h *AdminHandler) LockUser(ctx context.Context, req *pb.LockUserRequest) (*pb.LockUserResponse, error) {
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+
+	if err := h.adminUsecase.LockUser(ctx, actorID, req.UserId, req.Reason); err != nil {
+		return nil, adminError(err, "failed to lock user")
+	}
+
+	return &pb.LockUserResponse{
+		Message: "User locked successfully",
+	}, nil
+}
+
+// UnlockUser handles lifting a lock from a user's account
+func (h *AdminHandler) UnlockUser(ctx context.Context, req *pb.UnlockUserRequest) (*pb.UnlockUserResponse, error) {
+	if err := h.adminUsecase.UnlockUser(ctx, req.UserId); err != nil {
+		return nil, adminError(err, "failed to unlock user")
+	}
+
+	return &pb.UnlockUserResponse{
+		Message: "User unlocked successfully",
+	}, nil
+}
+
+// ChangeUserRole handles assigning a new role to a user
+func (h *AdminHandler) ChangeUserRole(ctx context.Context, req *pb.ChangeUserRoleRequest) (*pb.ChangeUserRoleResponse, error) {
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.adminUsecase.ChangeUserRole(ctx, actorID, req.UserId, req.Role)
+	if err != nil {
+		return nil, adminError(err, "failed to change role")
+	}
+
+	return &pb.ChangeUserRoleResponse{
+		User: toPbAdminUser(user),
+	}, nil
+}
+
+// TriggerPasswordReset handles emailing a user a password reset code
+func (h *AdminHandler) TriggerPasswordReset(ctx context.Context, req *pb.TriggerPasswordResetRequest) (*pb.TriggerPasswordResetResponse, error) {
+	if err := h.adminUsecase.TriggerPasswordReset(ctx, req.UserId); err != nil {
+		return nil, adminError(err, "failed to trigger password reset")
+	}
+
+	return &pb.TriggerPasswordResetResponse{
+		Message: "Password reset code sent to the user",
+	}, nil
+}
+
+// DeleteUser handles soft-deleting a user's account
+func (h *AdminHandler) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.adminUsecase.DeleteUser(ctx, actorID, req.UserId); err != nil {
+		return nil, adminError(err, "failed to delete user")
+	}
+
+	return &pb.DeleteUserResponse{
+		Message: "User deleted successfully",
+	}, nil
+}
+
+// RestoreUser handles restoring a soft-deleted user's account
+func (h *AdminHandler) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserResponse, error) {
+	if err := h.adminUsecase.RestoreUser(ctx, req.UserId); err != nil {
+		return nil, adminError(err, "failed to restore user")
+	}
+
+	return &pb.RestoreUserResponse{
+		Message: "User restored successfully",
+	}, nil
+}
+
+// ImpersonateUser handles issuing a short-lived token to act as a user
+func (h *AdminHandler) ImpersonateUser(ctx context.Context, req *pb.ImpersonateUserRequest) (*pb.ImpersonateUserResponse, error) {
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	impersonation, err := h.adminUsecase.ImpersonateUser(ctx, actorID, req.UserId, req.Reason, duration)
+	if err != nil {
+		return nil, adminError(err, "failed to impersonate user")
+	}
+
+	return &pb.ImpersonateUserResponse{
+		AccessToken:          impersonation.AccessToken,
+		AccessTokenExpiresAt: timestamppb.New(impersonation.ExpiresAt),
+		SessionId:            impersonation.SessionID.String(),
+		User:                 toPbAdminUser(impersonation.User),
+	}, nil
+}
+
+// ListAuthEvents handles searching the security audit log
+func (h *AdminHandler) ListAuthEvents(ctx context.Context, req *pb.ListAuthEventsRequest) (*pb.ListAuthEventsResponse, error) {
+	filter := entity.AuthEventFilter{
+		EventType: req.EventType,
+		Outcome:   req.Outcome,
+		IPAddress: req.IpAddress,
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+	}
+
+	var err error
+	if filter.UserID, err = optionalUUID(req.UserId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	if filter.ActorID, err = optionalUUID(req.ActorId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid actor_id: %v", err)
+	}
+	if req.Since != nil {
+		since := req.Since.AsTime()
+		filter.Since = &since
+	}
+	if req.Until != nil {
+		until := req.Until.AsTime()
+		filter.Until = &until
+	}
+
+	events, total, err := h.adminUsecase.ListAuthEvents(ctx, filter)
+	if err != nil {
+		return nil, adminError(err, "failed to list auth events")
+	}
+
+	pbEvents := make([]*pb.AuthEvent, 0, len(events))
+	for _, event := range events {
+		pbEvents = append(pbEvents, toPbAuthEvent(event))
+	}
+
+	return &pb.ListAuthEventsResponse{
+		Events: pbEvents,
+		Total:  total,
+	}, nil
+}
+
+// GetUserErasureStatus handles reporting how far a deleted account's erasure has got
+func (h *AdminHandler) GetUserErasureStatus(ctx context.Context, req *pb.GetUserErasureStatusRequest) (*pb.GetUserErasureStatusResponse, error) {
+	erasure, err := h.adminUsecase.GetErasureStatus(ctx, req.UserId)
+	if err != nil {
+		return nil, adminError(err, "failed to get erasure status")
+	}
+
+	services := make([]*pb.ServiceErasure, 0, len(erasure.Services))
+	for _, service := range erasure.Services {
+		services = append(services, &pb.ServiceErasure{
+			Service:   service.Service,
+			Status:    service.Status,
+			Items:     service.Items,
+			Detail:    service.Detail,
+			UpdatedAt: timestamppb.New(service.UpdatedAt),
+		})
+	}
+
+	return &pb.GetUserErasureStatusResponse{
+		UserId:       erasure.UserID.String(),
+		State:        erasure.State(),
+		RequestedAt:  timestamppb.New(erasure.RequestedAt),
+		ScheduledFor: optionalTimestamp(erasure.ScheduledFor),
+		DeletedAt:    optionalTimestamp(erasure.DeletedAt),
+		Services:     services,
+	}, nil
+}
+
+// ListAgentVerifications handles listing the agent verification review queue
+func (h *AdminHandler) ListAgentVerifications(ctx context.Context, req *pb.ListAgentVerificationsRequest) (*pb.ListAgentVerificationsResponse, error) {
+	verifications, total, err := h.adminUsecase.ListAgentVerifications(ctx, entity.AgentVerificationFilter{
+		Status: req.Status,
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	})
+	if err != nil {
+		return nil, agentVerificationError(err, "failed to list agent verifications")
+	}
+
+	pbVerifications := make([]*pb.AgentVerification, 0, len(verifications))
+	for _, verification := range verifications {
+		pbVerifications = append(pbVerifications, toPbAgentVerification(verification))
+	}
+
+	return &pb.ListAgentVerificationsResponse{
+		Verifications: pbVerifications,
+		Total:         total,
+	}, nil
+}
+
+// GetAgentVerificationDetails handles fetching an agent's verification and documents
+func (h *AdminHandler) GetAgentVerificationDetails(ctx context.Context, req *pb.GetAgentVerificationDetailsRequest) (*pb.GetAgentVerificationDetailsResponse, error) {
+	verification, err := h.adminUsecase.GetAgentVerification(ctx, req.UserId)
+	if err != nil {
+		return nil, agentVerificationError(err, "failed to get agent verification")
+	}
+
+	return &pb.GetAgentVerificationDetailsResponse{
+		Verification: toPbAgentVerification(verification),
+	}, nil
+}
+
+// ReviewAgentVerification handles approving, rejecting or asking for more
+// information on a pending agent verification
+func (h *AdminHandler) ReviewAgentVerification(ctx context.Context, req *pb.ReviewAgentVerificationRequest) (*pb.ReviewAgentVerificationResponse, error) {
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := h.adminUsecase.ReviewAgentVerification(ctx, actorID, req.UserId, req.Decision, req.Note)
+	if err != nil {
+		return nil, agentVerificationError(err, "failed to review agent verification")
+	}
+
+	return &pb.ReviewAgentVerificationResponse{
+		Verification: toPbAgentVerification(verification),
+	}, nil
+}
+
+// adminError maps admin usecase errors to gRPC statuses, falling back to
+// Internal with msg for anything unexpected.
+func adminError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrUserNotFound), errors.Is(err, entity.ErrErasureNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, entity.ErrInvalidUserID), errors.Is(err, rbac.ErrInvalidRole):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, entity.ErrImpersonationNotAllowed):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, entity.ErrAdminSelfAction),
+		errors.Is(err, entity.ErrAccountDeleted),
+		errors.Is(err, entity.ErrUserNotDeleted):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+func toPbAdminUser(user *entity.User) *pb.AdminUser {
+	adminUser := &pb.AdminUser{
+		User:                 toPbUser(user),
+		IsActive:             user.IsActive,
+		IsLocked:             user.LockedAt != nil,
+		LockedReason:         user.LockedReason,
+		LockedAt:             optionalTimestamp(user.LockedAt),
+		DeletedAt:            optionalTimestamp(user.DeletedAt),
+		DeletionScheduledFor: optionalTimestamp(user.DeletionScheduledFor),
+	}
+	if !user.LastLogin.IsZero() {
+		adminUser.LastLogin = timestamppb.New(user.LastLogin)
+	}
+	return adminUser
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toPbAuthEvent(event *entity.AuthEvent) *pb.AuthEvent {
+	return &pb.AuthEvent{
+		Id:        event.ID.String(),
+		EventType: event.EventType,
+		Outcome:   event.Outcome,
+		ActorId:   uuidString(event.ActorID),
+		UserId:    uuidString(event.UserID),
+		Email:     event.Email,
+		SessionId: uuidString(event.SessionID),
+		IpAddress: event.IPAddress,
+		UserAgent: event.UserAgent,
+		Device:    event.Device,
+		Location:  event.Location,
+		Metadata:  event.Metadata,
+		CreatedAt: timestamppb.New(event.CreatedAt),
+	}
+}
+
+func optionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,135 @@
+package user_handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UploadAgentDocument handles storing a document that supports the agent's verification
+func (h *UserHandler) UploadAgentDocument(ctx context.Context, req *pb.UploadAgentDocumentRequest) (*pb.UploadAgentDocumentResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Content) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "content is required")
+	}
+
+	document, err := h.agentVerificationUsecase.UploadAgentDocument(ctx, userID, req.DocumentType, bytes.NewReader(req.Content))
+	if err != nil {
+		return nil, agentVerificationError(err, "failed to upload agent document")
+	}
+
+	return &pb.UploadAgentDocumentResponse{
+		Document: toPbAgentDocument(document),
+	}, nil
+}
+
+// SubmitAgentVerification handles sending the agent's details for review
+func (h *UserHandler) SubmitAgentVerification(ctx context.Context, req *pb.SubmitAgentVerificationRequest) (*pb.SubmitAgentVerificationResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := h.agentVerificationUsecase.SubmitAgentVerification(ctx, userID, &entity.AgentVerification{
+		LicenseNumber:      req.LicenseNumber,
+		BusinessName:       req.BusinessName,
+		BusinessAddress:    req.BusinessAddress,
+		BusinessPhone:      req.BusinessPhone,
+		RegistrationNumber: req.RegistrationNumber,
+	})
+	if err != nil {
+		return nil, agentVerificationError(err, "failed to submit agent verification")
+	}
+
+	return &pb.SubmitAgentVerificationResponse{
+		Verification: toPbAgentVerification(verification),
+	}, nil
+}
+
+// GetAgentVerification handles checking the status of the agent's verification
+func (h *UserHandler) GetAgentVerification(ctx context.Context, req *pb.GetAgentVerificationRequest) (*pb.GetAgentVerificationResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := h.agentVerificationUsecase.GetAgentVerification(ctx, userID)
+	if err != nil {
+		return nil, agentVerificationError(err, "failed to get agent verification")
+	}
+
+	return &pb.GetAgentVerificationResponse{
+		Verification: toPbAgentVerification(verification),
+	}, nil
+}
+
+// agentVerificationError maps agent verification errors to gRPC statuses,
+// falling back to Internal with msg for anything unexpected.
+func agentVerificationError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidAgentVerification),
+		errors.Is(err, entity.ErrInvalidAgentDocumentType),
+		errors.Is(err, entity.ErrInvalidAgentVerificationReview),
+		errors.Is(err, entity.ErrAgentReviewNoteRequired),
+		errors.Is(err, entity.ErrInvalidAgentVerificationStatus),
+		errors.Is(err, entity.ErrInvalidUserID):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrAgentVerificationNotFound),
+		errors.Is(err, entity.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrNotAnAgent):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrAgentVerificationInReview),
+		errors.Is(err, entity.ErrAgentAlreadyVerified),
+		errors.Is(err, entity.ErrAgentVerificationNotPending),
+		errors.Is(err, entity.ErrAdminSelfAction),
+		errors.Is(err, entity.ErrAgentDocumentRequired),
+		errors.Is(err, entity.ErrTooManyAgentDocuments):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+func toPbAgentVerification(verification *entity.AgentVerification) *pb.AgentVerification {
+	result := &pb.AgentVerification{
+		UserId:             verification.UserID.String(),
+		Email:              verification.Email,
+		Name:               verification.FullName,
+		LicenseNumber:      verification.LicenseNumber,
+		BusinessName:       verification.BusinessName,
+		BusinessAddress:    verification.BusinessAddress,
+		BusinessPhone:      verification.BusinessPhone,
+		RegistrationNumber: verification.RegistrationNumber,
+		Status:             verification.Status,
+		ReviewNote:         verification.ReviewNote,
+		SubmittedAt:        timestamppb.New(verification.SubmittedAt),
+		ReviewedAt:         optionalTimestamp(verification.ReviewedAt),
+	}
+	if verification.ReviewedBy != nil {
+		result.ReviewedBy = verification.ReviewedBy.String()
+	}
+	for _, document := range verification.Documents {
+		result.Documents = append(result.Documents, toPbAgentDocument(document))
+	}
+	return result
+}
+
+func toPbAgentDocument(document *entity.AgentDocument) *pb.AgentDocument {
+	return &pb.AgentDocument{
+		Id:           document.ID.String(),
+		DocumentType: document.DocumentType,
+		Url:          document.URL,
+		UploadedAt:   timestamppb.New(document.UploadedAt),
+	}
+}
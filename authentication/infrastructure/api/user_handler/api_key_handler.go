@@ -0,0 +1,151 @@
+package user_handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// APIKeyHandler serves the ApiKeyService. The API gateway fills in the
+// user_id of every request from the caller's verified token.
+type APIKeyHandler struct {
+	apiKeyUsecase usecase.APIKeyUsecase
+	pb.UnimplementedApiKeyServiceServer
+}
+
+// NewAPIKeyHandler creates a new APIKeyHandler.
+func NewAPIKeyHandler(apiKeyUsecase usecase.APIKeyUsecase) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyUsecase: apiKeyUsecase,
+	}
+}
+
+// CreateApiKey handles creating an API key for the user or one of their organizations
+func (h *APIKeyHandler) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+
+	apiKey, key, err := h.apiKeyUsecase.CreateAPIKey(ctx, userID, req.OrganizationId, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		return nil, apiKeyError(err, "failed to create api key")
+	}
+
+	return &pb.CreateApiKeyResponse{
+		ApiKey: toPbApiKey(apiKey),
+		Key:    key,
+	}, nil
+}
+
+// ListApiKeys handles listing the API keys of the user or one of their organizations
+func (h *APIKeyHandler) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := h.apiKeyUsecase.ListAPIKeys(ctx, userID, req.OrganizationId)
+	if err != nil {
+		return nil, apiKeyError(err, "failed to list api keys")
+	}
+
+	pbApiKeys := make([]*pb.ApiKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		pbApiKeys = append(pbApiKeys, toPbApiKey(apiKey))
+	}
+
+	return &pb.ListApiKeysResponse{
+		ApiKeys: pbApiKeys,
+	}, nil
+}
+
+// RevokeApiKey handles stopping an API key from working
+func (h *APIKeyHandler) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.apiKeyUsecase.RevokeAPIKey(ctx, userID, req.ApiKeyId)
+	if err != nil {
+		return nil, apiKeyError(err, "failed to revoke api key")
+	}
+
+	return &pb.RevokeApiKeyResponse{
+		Message: "API key revoked",
+	}, nil
+}
+
+// AuthenticateApiKey handles exchanging an API key for a short-lived access token
+func (h *APIKeyHandler) AuthenticateApiKey(ctx context.Context, req *pb.AuthenticateApiKeyRequest) (*pb.AuthenticateApiKeyResponse, error) {
+	accessToken, expiresAt, err := h.apiKeyUsecase.AuthenticateAPIKey(ctx, req.ApiKey)
+	if err != nil {
+		return nil, apiKeyError(err, "failed to authenticate api key")
+	}
+
+	return &pb.AuthenticateApiKeyResponse{
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+func apiKeyError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidAPIKeyName),
+		errors.Is(err, entity.ErrInvalidAPIKeyScope),
+		errors.Is(err, entity.ErrInvalidAPIKeyExpiry),
+		errors.Is(err, entity.ErrInvalidUserID):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrInvalidAPIKey):
+		return status.Errorf(codes.Unauthenticated, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrAPIKeyNotFound),
+		errors.Is(err, entity.ErrOrganizationNotFound),
+		errors.Is(err, entity.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrNotOrganizationMember),
+		errors.Is(err, entity.ErrOrganizationRoleForbidden):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrTooManyAPIKeys):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+func toPbApiKey(apiKey *entity.APIKey) *pb.ApiKey {
+	pbApiKey := &pb.ApiKey{
+		Id:        apiKey.ID.String(),
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		CreatedBy: apiKey.UserID.String(),
+		Scopes:    apiKey.Scopes,
+		CreatedAt: timestamppb.New(apiKey.CreatedAt),
+	}
+	if apiKey.OrganizationID != nil {
+		pbApiKey.OrganizationId = apiKey.OrganizationID.String()
+	}
+	if apiKey.ExpiresAt != nil {
+		pbApiKey.ExpiresAt = timestamppb.New(*apiKey.ExpiresAt)
+	}
+	if apiKey.LastUsedAt != nil {
+		pbApiKey.LastUsedAt = timestamppb.New(*apiKey.LastUsedAt)
+	}
+	if apiKey.RevokedAt != nil {
+		pbApiKey.RevokedAt = timestamppb.New(*apiKey.RevokedAt)
+	}
+	return pbApiKey
+}
@@ -0,0 +1,122 @@
+package user_handler
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// dataExportChunkSize is how much of the ZIP each download message carries
+const dataExportChunkSize = 64 * 1024
+
+// RequestDataExport handles queueing an export of everything held about the user
+func (h *UserHandler) RequestDataExport(ctx context.Context, req *pb.RequestDataExportRequest) (*pb.RequestDataExportResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := h.dataExportUsecase.RequestDataExport(ctx, userID)
+	if err != nil {
+		return nil, dataExportError(err, "failed to request data export")
+	}
+
+	return &pb.RequestDataExportResponse{
+		Export: toPbDataExport(export),
+	}, nil
+}
+
+// GetDataExport handles checking the status of one of the user's data exports
+func (h *UserHandler) GetDataExport(ctx context.Context, req *pb.GetDataExportRequest) (*pb.GetDataExportResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ExportId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "export_id is required")
+	}
+
+	export, err := h.dataExportUsecase.GetDataExport(ctx, userID, req.ExportId)
+	if err != nil {
+		return nil, dataExportError(err, "failed to get data export")
+	}
+
+	return &pb.GetDataExportResponse{
+		Export: toPbDataExport(export),
+	}, nil
+}
+
+// DownloadDataExport handles streaming the ZIP of a ready export with the token from the emailed link
+func (h *UserHandler) DownloadDataExport(req *pb.DownloadDataExportRequest, stream pb.AuthService_DownloadDataExportServer) error {
+	if req.ExportId == "" || req.Token == "" {
+		return status.Errorf(codes.InvalidArgument, "export_id and token are required")
+	}
+
+	export, file, err := h.dataExportUsecase.OpenDataExport(stream.Context(), req.ExportId, req.Token)
+	if err != nil {
+		return dataExportError(err, "failed to download data export")
+	}
+	defer file.Close()
+
+	resp := &pb.DownloadDataExportResponse{
+		FileName:    "realio-data-export-" + export.CreatedAt.UTC().Format("2006-01-02") + ".zip",
+		ContentType: "application/zip",
+	}
+	buf := make([]byte, dataExportChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			resp.Chunk = buf[:n]
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+			resp = &pb.DownloadDataExportResponse{}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to read data export: %v", err)
+		}
+	}
+}
+
+// dataExportError maps data export domain errors to gRPC status codes
+func dataExportError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrDataExportInProgress):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrDataExportNotFound),
+		errors.Is(err, entity.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrDataExportLinkInvalid):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+func toPbDataExport(export *entity.DataExport) *pb.DataExport {
+	result := &pb.DataExport{
+		Id:        export.ID.String(),
+		Status:    export.Status,
+		CreatedAt: timestamppb.New(export.CreatedAt),
+		SizeBytes: export.SizeBytes,
+		Error:     export.Error,
+	}
+	if export.CompletedAt != nil {
+		result.CompletedAt = timestamppb.New(*export.CompletedAt)
+	}
+	if export.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*export.ExpiresAt)
+	}
+
+	return result
+}
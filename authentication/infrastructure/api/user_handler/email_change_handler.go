@@ -0,0 +1,90 @@
+package user_handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/val"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestEmailChange handles sending a confirmation code to the user's new email
+func (h *UserHandler) RequestEmailChange(ctx context.Context, req *pb.RequestEmailChangeRequest) (*pb.RequestEmailChangeResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := val.ValidateEmail(req.NewEmail); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email: %v", err)
+	}
+
+	err = h.userUsecase.RequestEmailChange(ctx, userID, req.NewEmail)
+	if err != nil {
+		return nil, emailChangeError(err, "failed to request email change")
+	}
+
+	return &pb.RequestEmailChangeResponse{
+		Message: "A confirmation code has been sent to your new email address",
+	}, nil
+}
+
+// ConfirmEmailChange handles switching the user to their new email with the code sent to it
+func (h *UserHandler) ConfirmEmailChange(ctx context.Context, req *pb.ConfirmEmailChangeRequest) (*pb.ConfirmEmailChangeResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code is required")
+	}
+
+	user, err := h.userUsecase.ConfirmEmailChange(ctx, userID, req.Code)
+	if err != nil {
+		return nil, emailChangeError(err, "failed to confirm email change")
+	}
+
+	return &pb.ConfirmEmailChangeResponse{
+		User: toPbUser(user),
+	}, nil
+}
+
+// RevertEmailChange handles restoring the previous email from the link sent to it
+func (h *UserHandler) RevertEmailChange(ctx context.Context, req *pb.RevertEmailChangeRequest) (*pb.RevertEmailChangeResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	if err := h.userUsecase.RevertEmailChange(ctx, req.Token); err != nil {
+		return nil, emailChangeError(err, "failed to revert email change")
+	}
+
+	return &pb.RevertEmailChangeResponse{
+		Message: "Your previous email address has been restored and all sessions signed out",
+	}, nil
+}
+
+// emailChangeError maps email change domain errors to gRPC status codes
+func emailChangeError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrEmailUnchanged),
+		errors.Is(err, entity.ErrVerificationCodeInvalid):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrEmailTaken):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrEmailChangeNotFound),
+		errors.Is(err, entity.ErrVerificationNotFound),
+		errors.Is(err, entity.ErrVerificationCodeExpired):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrVerificationAttemptsExceeded):
+		return status.Errorf(codes.ResourceExhausted, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrEmailChangeRevertInvalid):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
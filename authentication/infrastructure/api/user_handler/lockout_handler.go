@@ -0,0 +1,59 @@
+package user_handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// UnlockAccount handles lifting a brute-force lockout from a user's account
+func (h *UserHandler) UnlockAccount(ctx context.Context, req *pb.UnlockAccountRequest) (*pb.UnlockAccountResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user id is required")
+	}
+
+	if err := h.userUsecase.UnlockAccount(ctx, req.UserId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unlock account: %v", err)
+	}
+
+	return &pb.UnlockAccountResponse{
+		Message: "Account unlocked successfully",
+	}, nil
+}
+
+// lockoutError converts a lockout into a ResourceExhausted status carrying a
+// RetryInfo detail. It returns nil for any other error.
+func lockoutError(err error) error {
+	if !errors.Is(err, entity.ErrTooManyAttempts) {
+		return nil
+	}
+
+	st := status.New(codes.ResourceExhausted, err.Error())
+
+	var lockErr *entity.LockoutError
+	if errors.As(err, &lockErr) {
+		if detailed, detailErr := st.WithDetails(&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(lockErr.RetryAfter()),
+		}); detailErr == nil {
+			st = detailed
+		}
+	}
+
+	return st.Err()
+}
+
+// accountStatusError converts a sign in to an account an administrator locked
+// or deleted into a PermissionDenied status. It returns nil for any other error.
+func accountStatusError(err error) error {
+	if errors.Is(err, entity.ErrAccountLocked) || errors.Is(err, entity.ErrAccountDeleted) {
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package user_handler
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RequestMagicLink handles emailing a passwordless sign-in link
+func (h *UserHandler) RequestMagicLink(ctx context.Context, req *pb.RequestMagicLinkRequest) (*pb.RequestMagicLinkResponse, error) {
+	if req.Email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+
+	// Other failures are only logged so the response never reveals whether the
+	// email has an account; lockouts apply to every email alike
+	if err := h.userUsecase.RequestMagicLink(ctx, req.Email); err != nil {
+		if lockErr := lockoutError(err); lockErr != nil {
+			return nil, lockErr
+		}
+		log.Printf("failed to send magic link: %v", err)
+	}
+
+	return &pb.RequestMagicLinkResponse{
+		Message: "If your email exists in our system, you will receive a sign-in link shortly",
+	}, nil
+}
+
+// ConsumeMagicLink handles signing in with the token from a magic link
+func (h *UserHandler) ConsumeMagicLink(ctx context.Context, req *pb.ConsumeMagicLinkRequest) (*pb.ConsumeMagicLinkResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	user, session, challenge, err := h.userUsecase.ConsumeMagicLink(ctx, req.Token)
+	if err != nil {
+		if errors.Is(err, entity.ErrMagicLinkInvalid) || errors.Is(err, entity.ErrMagicLinkUsed) {
+			return nil, status.Errorf(codes.Unauthenticated, "failed to sign in: %v", err)
+		}
+		if statusErr := accountStatusError(err); statusErr != nil {
+			return nil, statusErr
+		}
+		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
+	}
+
+	// Hold back the session until the second factor is verified
+	if challenge != nil {
+		return &pb.ConsumeMagicLinkResponse{
+			MfaRequired:  true,
+			MfaToken:     challenge.ID.String(),
+			MfaExpiresAt: timestamppb.New(challenge.ExpiresAt),
+		}, nil
+	}
+
+	tokens, err := h.userUsecase.IssueTokens(ctx, user, session.SessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token")
+	}
+
+	return &pb.ConsumeMagicLinkResponse{
+		User:    toPbUser(user),
+		Session: toPbSession(tokens),
+	}, nil
+}
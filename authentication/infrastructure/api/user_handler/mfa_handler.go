@@ -0,0 +1,144 @@
+package user_handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EnrollMfa handles starting TOTP enrollment for the user
+func (h *UserHandler) EnrollMfa(ctx context.Context, req *pb.EnrollMfaRequest) (*pb.EnrollMfaResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := h.userUsecase.EnrollMFA(ctx, userID)
+	if err != nil {
+		return nil, mfaError(err, "failed to enroll mfa")
+	}
+
+	return &pb.EnrollMfaResponse{
+		Secret:     enrollment.Secret,
+		OtpauthUrl: enrollment.OtpauthURL,
+		QrCode:     enrollment.QRCode,
+	}, nil
+}
+
+// ConfirmMfa handles activating TOTP after the first valid code
+func (h *UserHandler) ConfirmMfa(ctx context.Context, req *pb.ConfirmMfaRequest) (*pb.ConfirmMfaResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code is required")
+	}
+
+	recoveryCodes, err := h.userUsecase.ConfirmMFA(ctx, userID, req.Code)
+	if err != nil {
+		return nil, mfaError(err, "failed to confirm mfa")
+	}
+
+	return &pb.ConfirmMfaResponse{
+		Message:       "MFA enabled successfully",
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// VerifyMfa handles completing a login with a second factor
+func (h *UserHandler) VerifyMfa(ctx context.Context, req *pb.VerifyMfaRequest) (*pb.VerifyMfaResponse, error) {
+	if req.MfaToken == "" || req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mfa token and code are required")
+	}
+
+	user, session, err := h.userUsecase.VerifyMFA(ctx, req.MfaToken, req.Code)
+	if err != nil {
+		return nil, mfaError(err, "failed to verify mfa")
+	}
+
+	tokens, err := h.userUsecase.IssueTokens(ctx, user, session.SessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token")
+	}
+
+	return &pb.VerifyMfaResponse{
+		User: &pb.User{
+			Email:         user.Email,
+			FullName:      user.FullName,
+			UserId:        user.ID.String(),
+			Role:          user.Role,
+			Phone:         user.Phone,
+			IsVerified:    user.EmailVerified,
+			UpdatedAt:     timestamppb.New(user.UpdatedAt),
+			CreatedAt:     timestamppb.New(user.CreatedAt),
+			VerifiedAgent: user.IsVerifiedAgent(),
+		},
+		Session: toPbSession(tokens),
+	}, nil
+}
+
+// DisableMfa handles turning off MFA for the user
+func (h *UserHandler) DisableMfa(ctx context.Context, req *pb.DisableMfaRequest) (*pb.DisableMfaResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Password == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "password is required")
+	}
+
+	err = h.userUsecase.DisableMFA(ctx, userID, req.Password, req.Code)
+	if err != nil {
+		return nil, mfaError(err, "failed to disable mfa")
+	}
+
+	return &pb.DisableMfaResponse{
+		Message: "MFA disabled successfully",
+	}, nil
+}
+
+// RegenerateRecoveryCodes handles replacing the user's recovery codes
+func (h *UserHandler) RegenerateRecoveryCodes(ctx context.Context, req *pb.RegenerateRecoveryCodesRequest) (*pb.RegenerateRecoveryCodesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code is required")
+	}
+
+	recoveryCodes, err := h.userUsecase.RegenerateRecoveryCodes(ctx, userID, req.Code)
+	if err != nil {
+		return nil, mfaError(err, "failed to regenerate recovery codes")
+	}
+
+	return &pb.RegenerateRecoveryCodesResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// mfaError maps MFA domain errors to gRPC status codes
+func mfaError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidMFACode),
+		errors.Is(err, entity.ErrMFAChallengeInvalid):
+		return status.Errorf(codes.Unauthenticated, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrMFAAttemptsExceeded):
+		return status.Errorf(codes.ResourceExhausted, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrMFANotEnrolled),
+		errors.Is(err, entity.ErrMFANotEnabled),
+		errors.Is(err, entity.ErrMFAAlreadyEnabled):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
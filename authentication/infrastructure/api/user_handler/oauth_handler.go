@@ -0,0 +1,239 @@
+package user_handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OAuthLogin handles signing in with a provider ID token
+func (h *UserHandler) OAuthLogin(ctx context.Context, req *pb.OAuthLoginRequest) (*pb.OAuthLoginResponse, error) {
+	if req.Provider == "" || req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "provider and token are required")
+	}
+
+	user, session, challenge, err := h.userUsecase.LoginWithOAuth(ctx, req.Provider, req.Token)
+	if err != nil {
+		return nil, identityError(err, "failed to login")
+	}
+
+	// Hold back the session until the second factor is verified
+	if challenge != nil {
+		return &pb.OAuthLoginResponse{
+			MfaRequired:  true,
+			MfaToken:     challenge.ID.String(),
+			MfaExpiresAt: timestamppb.New(challenge.ExpiresAt),
+		}, nil
+	}
+
+	tokens, err := h.userUsecase.IssueTokens(ctx, user, session.SessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token")
+	}
+
+	return &pb.OAuthLoginResponse{
+		User:    toPbUser(user),
+		Session: toPbSession(tokens),
+	}, nil
+}
+
+// OAuthRegister handles signing up with a provider ID token
+func (h *UserHandler) OAuthRegister(ctx context.Context, req *pb.OAuthRegisterRequest) (*pb.OAuthRegisterResponse, error) {
+	if req.Provider == "" || req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "provider and token are required")
+	}
+
+	user, session, err := h.userUsecase.RegisterWithOAuth(ctx, req.Provider, req.Token)
+	if err != nil {
+		return nil, identityError(err, "failed to register")
+	}
+
+	tokens, err := h.userUsecase.IssueTokens(ctx, user, session.SessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token")
+	}
+
+	return &pb.OAuthRegisterResponse{
+		User:    toPbUser(user),
+		Session: toPbSession(tokens),
+	}, nil
+}
+
+// StartOAuth handles starting the authorization code flow at a provider
+func (h *UserHandler) StartOAuth(ctx context.Context, req *pb.StartOAuthRequest) (*pb.StartOAuthResponse, error) {
+	if req.Provider == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "provider is required")
+	}
+
+	authorization, err := h.userUsecase.StartOAuth(ctx, req.Provider)
+	if err != nil {
+		return nil, identityError(err, "failed to start oauth")
+	}
+
+	return &pb.StartOAuthResponse{
+		AuthorizationUrl: authorization.URL,
+		State:            authorization.State,
+		ExpiresAt:        timestamppb.New(authorization.ExpiresAt),
+	}, nil
+}
+
+// OAuthCallback handles the provider redirecting back after the user signed in
+func (h *UserHandler) OAuthCallback(ctx context.Context, req *pb.OAuthCallbackRequest) (*pb.OAuthCallbackResponse, error) {
+	// The user declined, or the provider could not complete the request
+	if req.Error != "" {
+		return nil, status.Errorf(codes.PermissionDenied, "%v: %s %s", entity.ErrOAuthAuthorizationDenied, req.Error, req.ErrorDescription)
+	}
+
+	if req.Code == "" || req.State == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code and state are required")
+	}
+
+	user, session, challenge, err := h.userUsecase.CompleteOAuth(ctx, req.Provider, req.Code, req.State)
+	if err != nil {
+		return nil, identityError(err, "failed to complete oauth")
+	}
+
+	// Hold back the session until the second factor is verified
+	if challenge != nil {
+		return &pb.OAuthCallbackResponse{
+			MfaRequired:  true,
+			MfaToken:     challenge.ID.String(),
+			MfaExpiresAt: timestamppb.New(challenge.ExpiresAt),
+		}, nil
+	}
+
+	tokens, err := h.userUsecase.IssueTokens(ctx, user, session.SessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token")
+	}
+
+	return &pb.OAuthCallbackResponse{
+		User:    toPbUser(user),
+		Session: toPbSession(tokens),
+	}, nil
+}
+
+// LinkIdentity handles linking a provider account to the user
+func (h *UserHandler) LinkIdentity(ctx context.Context, req *pb.LinkIdentityRequest) (*pb.LinkIdentityResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Provider == "" || req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "provider and token are required")
+	}
+
+	identity, err := h.userUsecase.LinkIdentity(ctx, userID, req.Provider, req.Token)
+	if err != nil {
+		return nil, identityError(err, "failed to link identity")
+	}
+
+	return &pb.LinkIdentityResponse{
+		Identity: toPbIdentity(identity),
+	}, nil
+}
+
+// ListIdentities handles listing the provider accounts linked to the user
+func (h *UserHandler) ListIdentities(ctx context.Context, req *pb.ListIdentitiesRequest) (*pb.ListIdentitiesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := h.userUsecase.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, identityError(err, "failed to list identities")
+	}
+
+	pbIdentities := make([]*pb.Identity, 0, len(identities))
+	for _, identity := range identities {
+		pbIdentities = append(pbIdentities, toPbIdentity(identity))
+	}
+
+	return &pb.ListIdentitiesResponse{
+		Identities: pbIdentities,
+	}, nil
+}
+
+// UnlinkIdentity handles removing a provider account from the user
+func (h *UserHandler) UnlinkIdentity(ctx context.Context, req *pb.UnlinkIdentityRequest) (*pb.UnlinkIdentityResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IdentityId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "identity id is required")
+	}
+
+	if err := h.userUsecase.UnlinkIdentity(ctx, userID, req.IdentityId); err != nil {
+		return nil, identityError(err, "failed to unlink identity")
+	}
+
+	return &pb.UnlinkIdentityResponse{
+		Message: "Identity unlinked successfully",
+	}, nil
+}
+
+func toPbUser(user *entity.User) *pb.User {
+	return &pb.User{
+		Email:         user.Email,
+		FullName:      user.FullName,
+		UserId:        user.ID.String(),
+		Role:          user.Role,
+		Phone:         user.Phone,
+		IsVerified:    user.EmailVerified,
+		UpdatedAt:     timestamppb.New(user.UpdatedAt),
+		CreatedAt:     timestamppb.New(user.CreatedAt),
+		VerifiedAgent: user.IsVerifiedAgent(),
+	}
+}
+
+func toPbIdentity(identity *entity.UserIdentity) *pb.Identity {
+	pbIdentity := &pb.Identity{
+		IdentityId: identity.ID.String(),
+		Provider:   identity.Provider,
+		Email:      identity.Email,
+		CreatedAt:  timestamppb.New(identity.CreatedAt),
+	}
+	if identity.LastUsedAt != nil {
+		pbIdentity.LastUsedAt = timestamppb.New(*identity.LastUsedAt)
+	}
+	return pbIdentity
+}
+
+func identityError(err error, msg string) error {
+	if statusErr := accountStatusError(err); statusErr != nil {
+		return statusErr
+	}
+
+	switch {
+	case errors.Is(err, entity.ErrInvalidToken),
+		errors.Is(err, entity.ErrTokenExpired),
+		errors.Is(err, entity.ErrAccountNotLinked),
+		errors.Is(err, entity.ErrOAuthStateInvalid):
+		return status.Errorf(codes.Unauthenticated, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrProviderInvalid),
+		errors.Is(err, entity.ErrProviderFlowInvalid),
+		errors.Is(err, entity.ErrOAuthEmailMissing):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrIdentityNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, usecase.ErrUserAlreadyExists),
+		errors.Is(err, entity.ErrEmailAlreadyInUse),
+		errors.Is(err, entity.ErrIdentityAlreadyLinked),
+		errors.Is(err, entity.ErrProviderAlreadyLinked):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrLastSignInMethod):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
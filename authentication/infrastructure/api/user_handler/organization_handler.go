@@ -0,0 +1,308 @@
+package user_handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/usecase"
+	"github.com/demola234/authentication/pkg/val"
+	"github.com/demola234/shared/rbac"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OrganizationHandler serves the OrganizationService. The API gateway fills
+// in the user_id of every request from the caller's verified token.
+type OrganizationHandler struct {
+	organizationUsecase usecase.OrganizationUsecase
+	pb.UnimplementedOrganizationServiceServer
+}
+
+// NewOrganizationHandler creates a new OrganizationHandler.
+func NewOrganizationHandler(organizationUsecase usecase.OrganizationUsecase) *OrganizationHandler {
+	return &OrganizationHandler{
+		organizationUsecase: organizationUsecase,
+	}
+}
+
+// CreateOrganization handles creating an organization owned by the user
+func (h *OrganizationHandler) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := h.organizationUsecase.CreateOrganization(ctx, userID, req.Name)
+	if err != nil {
+		return nil, organizationError(err, "failed to create organization")
+	}
+
+	return &pb.CreateOrganizationResponse{
+		Organization: toPbOrganization(organization),
+	}, nil
+}
+
+// ListOrganizations handles listing the organizations the user is a member of
+func (h *OrganizationHandler) ListOrganizations(ctx context.Context, req *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organizations, err := h.organizationUsecase.ListOrganizations(ctx, userID)
+	if err != nil {
+		return nil, organizationError(err, "failed to list organizations")
+	}
+
+	pbOrganizations := make([]*pb.Organization, 0, len(organizations))
+	for _, organization := range organizations {
+		pbOrganizations = append(pbOrganizations, toPbOrganization(organization))
+	}
+
+	return &pb.ListOrganizationsResponse{
+		Organizations: pbOrganizations,
+	}, nil
+}
+
+// GetOrganization handles viewing an organization and its members
+func (h *OrganizationHandler) GetOrganization(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.GetOrganizationResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organization, members, err := h.organizationUsecase.GetOrganization(ctx, userID, req.OrganizationId)
+	if err != nil {
+		return nil, organizationError(err, "failed to get organization")
+	}
+
+	pbMembers := make([]*pb.OrganizationMember, 0, len(members))
+	for _, member := range members {
+		pbMembers = append(pbMembers, toPbOrganizationMember(member))
+	}
+
+	return &pb.GetOrganizationResponse{
+		Organization: toPbOrganization(organization),
+		Members:      pbMembers,
+	}, nil
+}
+
+// InviteOrganizationMember handles emailing an invitation to join the organization
+func (h *OrganizationHandler) InviteOrganizationMember(ctx context.Context, req *pb.InviteOrganizationMemberRequest) (*pb.InviteOrganizationMemberResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := val.ValidateEmail(strings.TrimSpace(req.Email)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email: %v", err)
+	}
+
+	invitation, err := h.organizationUsecase.InviteMember(ctx, userID, req.OrganizationId, req.Email, req.Role)
+	if err != nil {
+		return nil, organizationError(err, "failed to invite organization member")
+	}
+
+	return &pb.InviteOrganizationMemberResponse{
+		Invitation: toPbOrganizationInvitation(invitation),
+	}, nil
+}
+
+// ListOrganizationInvitations handles listing the organization's unanswered invitations
+func (h *OrganizationHandler) ListOrganizationInvitations(ctx context.Context, req *pb.ListOrganizationInvitationsRequest) (*pb.ListOrganizationInvitationsResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := h.organizationUsecase.ListInvitations(ctx, userID, req.OrganizationId)
+	if err != nil {
+		return nil, organizationError(err, "failed to list organization invitations")
+	}
+
+	pbInvitations := make([]*pb.OrganizationInvitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		pbInvitations = append(pbInvitations, toPbOrganizationInvitation(invitation))
+	}
+
+	return &pb.ListOrganizationInvitationsResponse{
+		Invitations: pbInvitations,
+	}, nil
+}
+
+// RevokeOrganizationInvitation handles cancelling a pending invitation
+func (h *OrganizationHandler) RevokeOrganizationInvitation(ctx context.Context, req *pb.RevokeOrganizationInvitationRequest) (*pb.RevokeOrganizationInvitationResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.organizationUsecase.RevokeInvitation(ctx, userID, req.OrganizationId, req.InvitationId)
+	if err != nil {
+		return nil, organizationError(err, "failed to revoke organization invitation")
+	}
+
+	return &pb.RevokeOrganizationInvitationResponse{
+		Message: "The invitation has been revoked",
+	}, nil
+}
+
+// AcceptOrganizationInvitation handles joining an organization with an invitation token
+func (h *OrganizationHandler) AcceptOrganizationInvitation(ctx context.Context, req *pb.AcceptOrganizationInvitationRequest) (*pb.AcceptOrganizationInvitationResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	member, err := h.organizationUsecase.AcceptInvitation(ctx, userID, req.Token)
+	if err != nil {
+		return nil, organizationError(err, "failed to accept organization invitation")
+	}
+
+	return &pb.AcceptOrganizationInvitationResponse{
+		OrganizationId: member.OrganizationID.String(),
+		Member:         toPbOrganizationMember(member),
+	}, nil
+}
+
+// DeclineOrganizationInvitation handles turning down an invitation with its token
+func (h *OrganizationHandler) DeclineOrganizationInvitation(ctx context.Context, req *pb.DeclineOrganizationInvitationRequest) (*pb.DeclineOrganizationInvitationResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	if err := h.organizationUsecase.DeclineInvitation(ctx, req.Token); err != nil {
+		return nil, organizationError(err, "failed to decline organization invitation")
+	}
+
+	return &pb.DeclineOrganizationInvitationResponse{
+		Message: "The invitation has been declined",
+	}, nil
+}
+
+// UpdateOrganizationMemberRole handles changing a member's role
+func (h *OrganizationHandler) UpdateOrganizationMemberRole(ctx context.Context, req *pb.UpdateOrganizationMemberRoleRequest) (*pb.UpdateOrganizationMemberRoleResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.organizationUsecase.UpdateMemberRole(ctx, userID, req.OrganizationId, req.MemberId, req.Role)
+	if err != nil {
+		return nil, organizationError(err, "failed to update organization member role")
+	}
+
+	return &pb.UpdateOrganizationMemberRoleResponse{
+		Message: "The member's role has been updated",
+	}, nil
+}
+
+// RemoveOrganizationMember handles removing a member, or the user leaving the organization
+func (h *OrganizationHandler) RemoveOrganizationMember(ctx context.Context, req *pb.RemoveOrganizationMemberRequest) (*pb.RemoveOrganizationMemberResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.organizationUsecase.RemoveMember(ctx, userID, req.OrganizationId, req.MemberId)
+	if err != nil {
+		return nil, organizationError(err, "failed to remove organization member")
+	}
+
+	return &pb.RemoveOrganizationMemberResponse{
+		Message: "The member has been removed",
+	}, nil
+}
+
+// SwitchOrganization handles choosing the organization the session acts for
+func (h *OrganizationHandler) SwitchOrganization(ctx context.Context, req *pb.SwitchOrganizationRequest) (*pb.SwitchOrganizationResponse, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := h.organizationUsecase.SwitchOrganization(ctx, caller.UserID, caller.SessionID, req.OrganizationId)
+	if err != nil {
+		return nil, organizationError(err, "failed to switch organization")
+	}
+
+	return &pb.SwitchOrganizationResponse{
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: timestamppb.New(expiresAt),
+		OrganizationId:       req.OrganizationId,
+	}, nil
+}
+
+func organizationError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidOrganizationName),
+		errors.Is(err, entity.ErrInvalidUserID),
+		errors.Is(err, rbac.ErrInvalidOrgRole):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrOrganizationNotFound),
+		errors.Is(err, entity.ErrInvitationNotFound),
+		errors.Is(err, entity.ErrSessionNotFound),
+		errors.Is(err, entity.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrNotOrganizationMember),
+		errors.Is(err, entity.ErrOrganizationRoleForbidden),
+		errors.Is(err, entity.ErrInvitationEmailMismatch):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrAlreadyOrganizationMember):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrLastOrganizationOwner),
+		errors.Is(err, entity.ErrInvitationInvalid):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+func toPbOrganization(organization *entity.Organization) *pb.Organization {
+	pbOrganization := &pb.Organization{
+		Id:        organization.ID.String(),
+		Name:      organization.Name,
+		CreatedAt: timestamppb.New(organization.CreatedAt),
+		UpdatedAt: timestamppb.New(organization.UpdatedAt),
+		Role:      organization.Role,
+	}
+	if organization.CreatedBy != nil {
+		pbOrganization.CreatedBy = organization.CreatedBy.String()
+	}
+	return pbOrganization
+}
+
+func toPbOrganizationMember(member *entity.OrganizationMember) *pb.OrganizationMember {
+	return &pb.OrganizationMember{
+		UserId:   member.UserID.String(),
+		Email:    member.Email,
+		Name:     member.FullName,
+		Role:     member.Role,
+		JoinedAt: timestamppb.New(member.JoinedAt),
+	}
+}
+
+func toPbOrganizationInvitation(invitation *entity.OrganizationInvitation) *pb.OrganizationInvitation {
+	pbInvitation := &pb.OrganizationInvitation{
+		Id:               invitation.ID.String(),
+		OrganizationId:   invitation.OrganizationID.String(),
+		OrganizationName: invitation.OrganizationName,
+		Email:            invitation.Email,
+		Role:             invitation.Role,
+		Status:           invitation.Status,
+		CreatedAt:        timestamppb.New(invitation.CreatedAt),
+		ExpiresAt:        timestamppb.New(invitation.ExpiresAt),
+	}
+	if invitation.InvitedBy != nil {
+		pbInvitation.InvitedBy = invitation.InvitedBy.String()
+	}
+	return pbInvitation
+}
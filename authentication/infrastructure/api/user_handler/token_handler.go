@@ -0,0 +1,55 @@
+package user_handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RefreshToken handles exchanging a refresh token for a new token pair
+func (h *UserHandler) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "refresh token is required")
+	}
+
+	user, tokens, err := h.userUsecase.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidRefreshToken) || errors.Is(err, entity.ErrRefreshTokenReused) || errors.Is(err, entity.ErrDeletionPending) {
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+		if statusErr := accountStatusError(err); statusErr != nil {
+			return nil, statusErr
+		}
+		return nil, status.Errorf(codes.Internal, "failed to refresh token: %v", err)
+	}
+
+	return &pb.RefreshTokenResponse{
+		User: &pb.User{
+			Email:         user.Email,
+			FullName:      user.FullName,
+			UserId:        user.ID.String(),
+			Role:          user.Role,
+			Phone:         user.Phone,
+			UpdatedAt:     timestamppb.New(user.UpdatedAt),
+			CreatedAt:     timestamppb.New(user.CreatedAt),
+			VerifiedAgent: user.IsVerifiedAgent(),
+		},
+		Session: toPbSession(tokens),
+	}, nil
+}
+
+// toPbSession converts a token pair to its proto representation
+func toPbSession(tokens *entity.TokenPair) *pb.Session {
+	return &pb.Session{
+		Token:                 tokens.AccessToken,
+		ExpiresAt:             timestamppb.New(tokens.AccessTokenExpiresAt),
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshTokenExpiresAt),
+	}
+}
@@ -0,0 +1,35 @@
+package user_handler
+
+import (
+	"context"
+
+	pb "github.com/demola234/authentication/infrastructure/api/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetTokenKeys publishes the public keys access tokens are signed with, so other
+// services can verify tokens without holding the signing key
+func (h *UserHandler) GetTokenKeys(ctx context.Context, req *pb.GetTokenKeysRequest) (*pb.GetTokenKeysResponse, error) {
+	keys, err := h.userUsecase.GetTokenKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get token keys: %v", err)
+	}
+
+	pbKeys := make([]*pb.TokenKey, 0, len(keys))
+	for _, key := range keys {
+		pbKey := &pb.TokenKey{
+			Kid:       key.KeyID,
+			PublicKey: key.PublicKey,
+			CreatedAt: timestamppb.New(key.CreatedAt),
+		}
+		if key.ExpiresAt != nil {
+			pbKey.ExpiresAt = timestamppb.New(*key.ExpiresAt)
+		}
+		pbKeys = append(pbKeys, pbKey)
+	}
+
+	return &pb.GetTokenKeysResponse{Keys: pbKeys}, nil
+}
@@ -3,13 +3,15 @@ package user_handler
 import (
 	"bytes"
 	"context"
-	"fmt"
+	"errors"
 	"strings"
-	"time"
 
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
 	pb "github.com/demola234/authentication/infrastructure/api/grpc"
 	"github.com/demola234/authentication/internal/domain/entity"
 	"github.com/demola234/authentication/internal/usecase"
+	"github.com/demola234/authentication/pkg/val"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,112 +19,107 @@ import (
 )
 
 type UserHandler struct {
-	userUsecase usecase.UserUsecase
+	userUsecase              usecase.UserUsecase
+	dataExportUsecase        usecase.DataExportUsecase
+	agentVerificationUsecase usecase.AgentVerificationUsecase
 	pb.UnimplementedAuthServiceServer
 }
 
 // NewUserHandler creates a new instance of UserHandler
-func NewUserHandler(userUsecase usecase.UserUsecase) *UserHandler {
+func NewUserHandler(userUsecase usecase.UserUsecase, dataExportUsecase usecase.DataExportUsecase, agentVerificationUsecase usecase.AgentVerificationUsecase) *UserHandler {
 
-	return &UserHandler{userUsecase: userUsecase}
+	return &UserHandler{userUsecase: userUsecase, dataExportUsecase: dataExportUsecase, agentVerificationUsecase: agentVerificationUsecase}
 }
 
 func (h *UserHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
 	user, _, err := h.userUsecase.RegisterUser(ctx, req.FullName, req.Password, req.Email, req.Role, req.Phone)
 	if err != nil {
+		if passwordErr := passwordPolicyError(err); passwordErr != nil {
+			return nil, passwordErr
+		}
 		return nil, status.Errorf(400, err.Error())
 	}
 
 	return &pb.RegisterResponse{
 		User: &pb.User{
-			Email:     user.Email,
-			FullName:  user.FullName,
-			UserId:    user.ID.String(),
-			Role:      user.Role,
-			Phone:     user.Phone,
-			UpdatedAt: timestamppb.New(user.UpdatedAt),
-			CreatedAt: timestamppb.New(user.CreatedAt),
+			Email:         user.Email,
+			FullName:      user.FullName,
+			UserId:        user.ID.String(),
+			Role:          user.Role,
+			Phone:         user.Phone,
+			UpdatedAt:     timestamppb.New(user.UpdatedAt),
+			CreatedAt:     timestamppb.New(user.CreatedAt),
+			VerifiedAgent: user.IsVerifiedAgent(),
 		},
 	}, nil
 }
 
 func (h *UserHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
-	user, err := h.userUsecase.LoginUser(ctx, req.Password, req.Email)
+	user, session, challenge, err := h.userUsecase.LoginUser(ctx, req.Password, req.Email)
 	if err != nil {
+		if lockErr := lockoutError(err); lockErr != nil {
+			return nil, lockErr
+		}
+		if statusErr := accountStatusError(err); statusErr != nil {
+			return nil, statusErr
+		}
 		return nil, status.Errorf(401, "invalid credentials %d", err)
 	}
 
-	token, err := h.userUsecase.GenerateToken(ctx, user.Email, user.ID.String())
-	if err != nil {
-		return nil, status.Errorf(500, "failed to generate token")
+	// Hold back the session until the second factor is verified
+	if challenge != nil {
+		return &pb.LoginResponse{
+			MfaRequired:  true,
+			MfaToken:     challenge.ID.String(),
+			MfaExpiresAt: timestamppb.New(challenge.ExpiresAt),
+		}, nil
 	}
 
-	session, err := h.userUsecase.GetSession(ctx, user.ID.String())
+	tokens, err := h.userUsecase.IssueTokens(ctx, user, session.SessionID)
 	if err != nil {
-		return nil, status.Errorf(500, "failed to get session")
+		return nil, status.Errorf(500, "failed to generate token")
 	}
 
 	return &pb.LoginResponse{
 		User: &pb.User{
-			Email:      user.Email,
-			FullName:   user.FullName,
-			UserId:     user.ID.String(),
-			Role:       user.Role,
-			Phone:      user.Phone,
-			IsVerified: session.OTPVerified,
-			UpdatedAt:  timestamppb.New(user.UpdatedAt),
-			CreatedAt:  timestamppb.New(user.CreatedAt),
-		},
-		Session: &pb.Session{
-			Token:     token,
-			ExpiresAt: timestamppb.New(time.Now().Add(time.Hour * 24)),
+			Email:         user.Email,
+			FullName:      user.FullName,
+			UserId:        user.ID.String(),
+			Role:          user.Role,
+			Phone:         user.Phone,
+			IsVerified:    user.EmailVerified,
+			UpdatedAt:     timestamppb.New(user.UpdatedAt),
+			CreatedAt:     timestamppb.New(user.CreatedAt),
+			VerifiedAgent: user.IsVerifiedAgent(),
 		},
+		Session: toPbSession(tokens),
 	}, nil
 }
 
 func (h *UserHandler) VerifyUser(ctx context.Context, req *pb.VerifyUserRequest) (*pb.VerifyUserResponse, error) {
-	// Check if user is already verified
-	valid, err := h.userUsecase.VerifyOtp(ctx, req.Email, req.Otp)
-	if err != nil {
-		return nil, status.Errorf(401, "invalid credentials %d", err)
-	}
-
-	if !valid {
-		return &pb.VerifyUserResponse{
-			Valid: false,
-		}, status.Errorf(401, "invalid credentials %d", err)
-	}
-
-	// Get User Info and Check if otp is valid
-	user, err := h.userUsecase.GetUser(ctx, req.Email)
+	user, session, err := h.userUsecase.VerifyOtp(ctx, req.Email, req.Otp)
 	if err != nil {
+		if lockErr := lockoutError(err); lockErr != nil {
+			return nil, lockErr
+		}
 		return nil, status.Errorf(401, "invalid credentials %d", err)
 	}
 
-	token, err := h.userUsecase.GenerateToken(ctx, user.Email, user.ID.String())
+	tokens, err := h.userUsecase.IssueTokens(ctx, user, session.SessionID)
 	if err != nil {
 		return nil, status.Errorf(500, "failed to generate token")
 	}
 
 	return &pb.VerifyUserResponse{
-		Valid: valid,
-		Session: &pb.Session{
-			Token:     token,
-			ExpiresAt: timestamppb.New(time.Now().Add(time.Hour * 24)),
-		},
+		Valid:   true,
+		Session: toPbSession(tokens),
 	}, nil
 
 }
 
 func (h *UserHandler) ResendOtp(ctx context.Context, req *pb.ResendOtpRequest) (*pb.ResendOtpResponse, error) {
-	// Get User Info and Check if otp is valid
-	_, err := h.userUsecase.GetUser(ctx, req.Email)
-	if err != nil {
-		return nil, status.Errorf(401, "invalid credentials %d", err)
-	}
-
-	// Generate OTP
-	err = h.userUsecase.ResendOtp(ctx, req.Email)
+	// Generate OTP for the user registered with the email
+	err := h.userUsecase.ResendOtp(ctx, req.Email)
 	if err != nil {
 		return nil, status.Errorf(401, "invalid credentials %d", err)
 	}
@@ -133,26 +130,37 @@ func (h *UserHandler) ResendOtp(ctx context.Context, req *pb.ResendOtpRequest) (
 
 }
 func (h *UserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
-	user, err := h.userUsecase.GetUser(ctx, req.UserId)
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.userUsecase.GetUser(ctx, userID)
 	if err != nil {
 		return nil, status.Errorf(401, "invalid credentials %d", err)
 	}
 
 	return &pb.GetUserResponse{
 		User: &pb.User{
-			Email:     user.Email,
-			FullName:  user.FullName,
-			UserId:    user.ID.String(),
-			Role:      user.Role,
-			Phone:     user.Phone,
-			UpdatedAt: timestamppb.New(user.UpdatedAt),
-			CreatedAt: timestamppb.New(user.CreatedAt),
+			Email:         user.Email,
+			FullName:      user.FullName,
+			UserId:        user.ID.String(),
+			Role:          user.Role,
+			Phone:         user.Phone,
+			UpdatedAt:     timestamppb.New(user.UpdatedAt),
+			CreatedAt:     timestamppb.New(user.CreatedAt),
+			VerifiedAgent: user.IsVerifiedAgent(),
 		},
 	}, nil
 }
 
 func (h *UserHandler) LogOut(ctx context.Context, req *pb.LogOutRequest) (*pb.LogOutResponse, error) {
-	err := h.userUsecase.LogOut(ctx, req.UserId)
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.userUsecase.LogOut(ctx, caller.UserID, caller.SessionID)
 	if err != nil {
 		return nil, status.Errorf(401, "invalid credentials %d", err)
 	}
@@ -163,7 +171,12 @@ func (h *UserHandler) LogOut(ctx context.Context, req *pb.LogOutRequest) (*pb.Lo
 }
 
 func (h *UserHandler) UploadImage(ctx context.Context, req *pb.UploadImageRequest) (*pb.UploadImageResponse, error) {
-	user, err := h.userUsecase.GetUser(ctx, req.UserId)
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.userUsecase.GetUser(ctx, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials: %v", err)
 	}
@@ -179,7 +192,7 @@ func (h *UserHandler) UploadImage(ctx context.Context, req *pb.UploadImageReques
 	return &pb.UploadImageResponse{
 		Message:  "Image uploaded successfully",
 		ImageUrl: imageUrl,
-		UserId:   req.UserId,
+		UserId:   userID,
 	}, nil
 }
 
@@ -220,8 +233,12 @@ func (h *UserHandler) VerifyResetPassword(ctx context.Context, req *pb.VerifyRes
 	}
 
 	// Call the usecase
-	err := h.userUsecase.VerifyResetPassword(ctx, req.Email, req.Otp)
+	resetToken, err := h.userUsecase.VerifyResetPassword(ctx, req.Email, req.Otp)
 	if err != nil {
+		if lockErr := lockoutError(err); lockErr != nil {
+			return nil, lockErr
+		}
+
 		// Return error with appropriate status code
 		errMsg := strings.ToLower(err.Error())
 
@@ -232,21 +249,21 @@ func (h *UserHandler) VerifyResetPassword(ctx context.Context, req *pb.VerifyRes
 			}, nil
 		}
 
-		if strings.Contains(errMsg, "maximum otp attempts exceeded") {
+		if errors.Is(err, entity.ErrVerificationAttemptsExceeded) {
 			return &pb.VerifyResetPasswordResponse{
 				Message: "Too many failed attempts, please request a new code",
 				Valid:   false,
 			}, nil
 		}
 
-		if strings.Contains(errMsg, "otp has expired") {
+		if errors.Is(err, entity.ErrVerificationCodeExpired) || errors.Is(err, entity.ErrVerificationNotFound) {
 			return &pb.VerifyResetPasswordResponse{
 				Message: "Verification code has expired, please request a new one",
 				Valid:   false,
 			}, nil
 		}
 
-		if strings.Contains(errMsg, "invalid otp") {
+		if errors.Is(err, entity.ErrVerificationCodeInvalid) {
 			return &pb.VerifyResetPasswordResponse{
 				Message: "Invalid verification code",
 				Valid:   false,
@@ -262,16 +279,17 @@ func (h *UserHandler) VerifyResetPassword(ctx context.Context, req *pb.VerifyRes
 
 	// Return success
 	return &pb.VerifyResetPasswordResponse{
-		Message: "Verification successful, you may now reset your password",
-		Valid:   true,
+		Message:    "Verification successful, you may now reset your password",
+		Valid:      true,
+		ResetToken: resetToken,
 	}, nil
 }
 
-// ResetPassword sets a new password after OTP verification
+// ResetPassword sets a new password using the token issued by VerifyResetPassword
 func (h *UserHandler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
 	// Validate inputs
-	if req.Email == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	if req.ResetToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reset token is required")
 	}
 
 	if req.NewPassword == "" {
@@ -279,13 +297,17 @@ func (h *UserHandler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRe
 	}
 
 	// Call the usecase
-	err := h.userUsecase.ResetPassword(ctx, req.Email, req.NewPassword)
+	err := h.userUsecase.ResetPassword(ctx, req.ResetToken, req.NewPassword)
 	if err != nil {
+		if passwordErr := passwordPolicyError(err); passwordErr != nil {
+			return nil, passwordErr
+		}
+
 		// Categorize errors for appropriate status codes
 		errMsg := strings.ToLower(err.Error())
 
-		if strings.Contains(errMsg, "otp not verified") {
-			return nil, status.Errorf(codes.FailedPrecondition, "You must verify the code before resetting your password")
+		if errors.Is(err, entity.ErrResetTokenInvalid) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Reset token is invalid or has expired, please verify your code again")
 		}
 
 		if strings.Contains(errMsg, "invalid password") {
@@ -302,22 +324,39 @@ func (h *UserHandler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRe
 	}, nil
 }
 
+// passwordPolicyError maps a rejected new password to InvalidArgument with a
+// message the user can act on, or returns nil for any other error
+func passwordPolicyError(err error) error {
+	if val.IsPasswordPolicyViolation(err) || errors.Is(err, entity.ErrPasswordReused) {
+		return status.Errorf(codes.InvalidArgument, "%s", strings.TrimPrefix(err.Error(), "invalid password: "))
+	}
+	if errors.Is(err, val.ErrPasswordBreachCheckFail) {
+		return status.Errorf(codes.Unavailable, "failed to check password, please try again")
+	}
+	return nil
+}
+
 // Helper function to convert user entity to proto
 func convertUserToProto(user *entity.User) *pb.User {
 	return &pb.User{
-		UserId:     user.ID.String(),
-		Email:      user.Email,
-		FullName:   user.FullName,
-		Role:       user.Role,
-		Phone:      user.Phone,
-		IsVerified: user.EmailVerified,
-		CreatedAt:  timestamppb.New(user.CreatedAt),
-		UpdatedAt:  timestamppb.New(user.UpdatedAt),
+		UserId:        user.ID.String(),
+		Email:         user.Email,
+		FullName:      user.FullName,
+		Role:          user.Role,
+		Phone:         user.Phone,
+		IsVerified:    user.EmailVerified,
+		CreatedAt:     timestamppb.New(user.CreatedAt),
+		UpdatedAt:     timestamppb.New(user.UpdatedAt),
+		VerifiedAgent: user.IsVerifiedAgent(),
 	}
 }
 
 // ChangePassword handles changing the user's password
 func (h *UserHandler) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Validate request
 	if req.CurrentPassword == "" || req.NewPassword == "" {
@@ -325,8 +364,12 @@ func (h *UserHandler) ChangePassword(ctx context.Context, req *pb.ChangePassword
 	}
 
 	// Call usecase
-	err := h.userUsecase.ChangePassword(ctx, req.CurrentPassword, req.NewPassword, req.UserId)
+	err = h.userUsecase.ChangePassword(ctx, req.CurrentPassword, req.NewPassword, userID)
 	if err != nil {
+		if passwordErr := passwordPolicyError(err); passwordErr != nil {
+			return nil, passwordErr
+		}
+
 		// Check for specific error types
 		if err.Error() == "current password is incorrect" {
 			return nil, status.Errorf(codes.InvalidArgument, "current password is incorrect")
@@ -344,9 +387,13 @@ func (h *UserHandler) ChangePassword(ctx context.Context, req *pb.ChangePassword
 
 // GetProfile handles getting the user's profile
 func (h *UserHandler) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.GetProfileResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Call usecase
-	profile, err := h.userUsecase.GetUserProfile(ctx, req.UserId)
+	profile, err := h.userUsecase.GetUserProfile(ctx, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get profile: %v", err)
 	}
@@ -370,9 +417,13 @@ func (h *UserHandler) GetProfile(ctx context.Context, req *pb.GetProfileRequest)
 
 // UpdateProfile handles updating the user's profile
 func (h *UserHandler) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get current profile
-	currentProfile, err := h.userUsecase.GetUserProfile(ctx, req.UserId)
+	currentProfile, err := h.userUsecase.GetUserProfile(ctx, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current profile: %v", err)
 	}
@@ -395,7 +446,7 @@ func (h *UserHandler) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRe
 	}
 
 	// Call usecase
-	updatedProfile, err := h.userUsecase.UpdateUserProfile(ctx, currentProfile, req.UserId)
+	updatedProfile, err := h.userUsecase.UpdateUserProfile(ctx, currentProfile, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update profile: %v", err)
 	}
@@ -419,9 +470,13 @@ func (h *UserHandler) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRe
 
 // GetSessions handles getting all active sessions for the user
 func (h *UserHandler) GetSessions(ctx context.Context, req *pb.GetSessionsRequest) (*pb.GetSessionsResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Call usecase
-	sessions, err := h.userUsecase.GetSessions(ctx, req.UserId)
+	sessions, err := h.userUsecase.GetSessions(ctx, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get sessions: %v", err)
 	}
@@ -436,9 +491,15 @@ func (h *UserHandler) GetSessions(ctx context.Context, req *pb.GetSessionsReques
 	var sessionInfos []*pb.SessionInfo
 	for _, session := range sessions {
 		isCurrent := session.SessionID.String() == currentSessionID
+
+		var deviceInfo string
+		if session.DeviceInfo != nil && session.DeviceInfo.Valid {
+			deviceInfo = string(session.DeviceInfo.RawMessage)
+		}
+
 		sessionInfos = append(sessionInfos, &pb.SessionInfo{
 			SessionId:    session.SessionID.String(),
-			DeviceInfo:   fmt.Sprintf("%s", session.DeviceInfo),
+			DeviceInfo:   deviceInfo,
 			IpAddress:    session.IpAddress,
 			UserAgent:    session.UserAgent,
 			LastActivity: timestamppb.New(session.LastActivity),
@@ -453,6 +514,10 @@ func (h *UserHandler) GetSessions(ctx context.Context, req *pb.GetSessionsReques
 
 // RevokeSession handles revoking a specific session
 func (h *UserHandler) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Validate request
 	if req.SessionId == "" {
@@ -460,7 +525,7 @@ func (h *UserHandler) RevokeSession(ctx context.Context, req *pb.RevokeSessionRe
 	}
 
 	// Call usecase
-	err := h.userUsecase.RevokeSession(ctx, req.SessionId, req.UserId)
+	err = h.userUsecase.RevokeSession(ctx, req.SessionId, userID)
 	if err != nil {
 		// Check for specific errors
 		if err.Error() == "unauthorized: session does not belong to the user" {
@@ -476,6 +541,10 @@ func (h *UserHandler) RevokeSession(ctx context.Context, req *pb.RevokeSessionRe
 
 // DeactivateAccount handles temporarily deactivating a user account
 func (h *UserHandler) DeactivateAccount(ctx context.Context, req *pb.DeactivateAccountRequest) (*pb.DeactivateAccountResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Validate request
 	if req.Password == "" {
@@ -483,7 +552,7 @@ func (h *UserHandler) DeactivateAccount(ctx context.Context, req *pb.DeactivateA
 	}
 
 	// Call usecase
-	err := h.userUsecase.DeactivateAccount(ctx, req.Password, req.UserId)
+	err = h.userUsecase.DeactivateAccount(ctx, req.Password, userID)
 	if err != nil {
 		// Check for specific errors
 		if err.Error() == "password is incorrect" {
@@ -497,8 +566,12 @@ func (h *UserHandler) DeactivateAccount(ctx context.Context, req *pb.DeactivateA
 	}, nil
 }
 
-// DeleteAccount handles permanently deleting a user account
+// DeleteAccount handles scheduling a user account for deletion
 func (h *UserHandler) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Validate request
 	if req.Password == "" {
@@ -506,22 +579,30 @@ func (h *UserHandler) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRe
 	}
 
 	// Call usecase
-	err := h.userUsecase.DeleteAccount(ctx, req.Password, req.UserId)
+	scheduledFor, err := h.userUsecase.DeleteAccount(ctx, req.Password, userID)
 	if err != nil {
 		// Check for specific errors
 		if err.Error() == "password is incorrect" {
 			return nil, status.Errorf(codes.InvalidArgument, "password is incorrect")
 		}
+		if errors.Is(err, entity.ErrDeletionAlreadyScheduled) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to delete account: %v", err)
 	}
 
 	return &pb.DeleteAccountResponse{
-		Message: "Account deleted successfully",
+		Message:              "Account scheduled for deletion. Sign in before then to keep it",
+		DeletionScheduledFor: timestamppb.New(scheduledFor),
 	}, nil
 }
 
 // GetLoginHistory handles getting the login history for the user
 func (h *UserHandler) GetLoginHistory(ctx context.Context, req *pb.GetLoginHistoryRequest) (*pb.GetLoginHistoryResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Set default limit if not provided
 	limit := int(req.Limit)
@@ -530,7 +611,7 @@ func (h *UserHandler) GetLoginHistory(ctx context.Context, req *pb.GetLoginHisto
 	}
 
 	// Call usecase
-	history, err := h.userUsecase.GetLoginHistory(ctx, req.UserId, limit)
+	history, err := h.userUsecase.GetLoginHistory(ctx, userID, limit)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get login history: %v", err)
 	}
@@ -538,14 +619,47 @@ func (h *UserHandler) GetLoginHistory(ctx context.Context, req *pb.GetLoginHisto
 	// Convert entity to proto
 	var historyEntries []*pb.LoginHistoryEntry
 	for _, entry := range history {
-		historyEntries = append(historyEntries, &pb.LoginHistoryEntry{
-			IpAddress: entry.IpAddress,
-			UserAgent: entry.UserAgent,
-			Location:  entry.Location,
-		})
+		historyEntries = append(historyEntries, toPbLoginHistoryEntry(entry))
 	}
 
 	return &pb.GetLoginHistoryResponse{
 		History: historyEntries,
 	}, nil
 }
+
+// toPbLoginHistoryEntry converts a login to proto, flagging logins made by
+// support staff impersonating the user.
+func toPbLoginHistoryEntry(entry *entity.LoginHistoryEntry) *pb.LoginHistoryEntry {
+	historyEntry := &pb.LoginHistoryEntry{
+		IpAddress:           entry.IpAddress,
+		UserAgent:           entry.UserAgent,
+		Location:            entry.Location,
+		Device:              entry.Device,
+		Impersonated:        entry.ImpersonatorID != nil,
+		ImpersonationReason: entry.ImpersonationReason,
+		Success:             entry.Success,
+	}
+	if !entry.Timestamp.IsZero() {
+		historyEntry.LoginTime = timestamppb.New(entry.Timestamp)
+	}
+	return historyEntry
+}
+
+// callerFromContext returns the user whose access token came with the call.
+// Handlers take the user from here, never from user_id fields in the request.
+func callerFromContext(ctx context.Context) (*token.Payload, error) {
+	payload, ok := middleware.PayloadFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing caller identity")
+	}
+	return payload, nil
+}
+
+// callerID returns the ID of the user the call is made for
+func callerID(ctx context.Context) (string, error) {
+	payload, err := callerFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return payload.UserID, nil
+}
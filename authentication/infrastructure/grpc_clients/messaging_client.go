@@ -0,0 +1,80 @@
+package grpc_clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	"github.com/demola234/authentication/internal/domain/entity"
+	pb "github.com/demola234/messaging/infrastructure/api/grpc"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// exportTokenDuration bounds the token an export reads the user's messages with
+const exportTokenDuration = 5 * time.Minute
+
+// MessagingClient reads a user's conversations and messages from the
+// messaging service
+type MessagingClient struct {
+	client     pb.MessagingServiceClient
+	conn       *grpc.ClientConn
+	tokenMaker token.Maker
+}
+
+// NewMessagingClient creates a client for the messaging service at address. The
+// connection is made on first use, so the service does not have to be up yet.
+// tokenMaker issues the short-lived tokens exports read a user's messages with.
+func NewMessagingClient(address string, credentials *middleware.ServiceCredentials, tokenMaker token.Maker) (*MessagingClient, error) {
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(credentials.UnaryClientInterceptor()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create messaging service client: %w", err)
+	}
+
+	return &MessagingClient{client: pb.NewMessagingServiceClient(conn), conn: conn, tokenMaker: tokenMaker}, nil
+}
+
+// ExportUserData implements repository.ExportSource with the user's
+// conversations and every message in them, deleted ones included
+func (c *MessagingClient) ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.ExportFile, error) {
+	// The messaging service only shows a user's conversations to that user
+	accessToken, _, err := c.tokenMaker.CreateToken("", userID.String(), "", nil, exportTokenDuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export token: %w", err)
+	}
+	ctx = middleware.WithAccessToken(ctx, accessToken)
+
+	conversations, err := c.client.GetConversations(ctx, &pb.GetConversationsRequest{UserId: userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversations: %w", err)
+	}
+
+	messages := &pb.GetMessagesResponse{}
+	for _, conversation := range conversations.Conversations {
+		res, err := c.client.GetMessages(ctx, &pb.GetMessagesRequest{
+			ConversationId: conversation.Id,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list messages of conversation %s: %w", conversation.Id, err)
+		}
+		messages.Messages = append(messages.Messages, res.Messages...)
+	}
+
+	return []*entity.ExportFile{
+		{Name: "conversations.json", Content: conversations},
+		{Name: "messages.json", Content: messages},
+	}, nil
+}
+
+// Close closes the connection to the messaging service
+func (c *MessagingClient) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,64 @@
+package grpc_clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	"github.com/demola234/authentication/internal/domain/entity"
+	pb "github.com/demola234/property/infrastructure/api/grpc"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// propertyPageSize is how many listings are fetched per call
+const propertyPageSize = 100
+
+// PropertyClient reads a user's listings from the property service
+type PropertyClient struct {
+	client pb.PropertyServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewPropertyClient creates a client for the property service at address. The
+// connection is made on first use, so the service does not have to be up yet.
+func NewPropertyClient(address string, credentials *middleware.ServiceCredentials) (*PropertyClient, error) {
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(credentials.UnaryClientInterceptor()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create property service client: %w", err)
+	}
+
+	return &PropertyClient{client: pb.NewPropertyServiceClient(conn), conn: conn}, nil
+}
+
+// ExportUserData implements repository.ExportSource with every listing the user owns
+func (c *PropertyClient) ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.ExportFile, error) {
+	all := &pb.GetPropertiesByOwnerResponse{}
+	for offset := int32(0); ; offset += propertyPageSize {
+		res, err := c.client.GetPropertiesByOwner(ctx, &pb.GetPropertiesByOwnerRequest{
+			OwnerId: userID.String(),
+			Limit:   propertyPageSize,
+			Offset:  offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list properties: %w", err)
+		}
+
+		all.Properties = append(all.Properties, res.Properties...)
+		if len(res.Properties) < propertyPageSize {
+			break
+		}
+	}
+
+	return []*entity.ExportFile{{Name: "properties.json", Content: all}}, nil
+}
+
+// Close closes the connection to the property service
+func (c *PropertyClient) Close() error {
+	return c.conn.Close()
+}
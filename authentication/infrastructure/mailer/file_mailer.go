@@ -0,0 +1,54 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// FileMailer writes every email to an .eml file in a directory so it can be
+// opened in a mail client during local development
+type FileMailer struct {
+	renderer *Renderer
+	dir      string
+}
+
+// NewFileMailer creates a mailer that writes emails to dir, creating it if needed
+func NewFileMailer(dir string, from string, appURL string) (repository.Mailer, error) {
+	renderer, err := NewRenderer(from, appURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	return &FileMailer{renderer: renderer, dir: dir}, nil
+}
+
+// Send implements repository.Mailer
+func (m *FileMailer) Send(ctx context.Context, email *entity.Email) error {
+	msg, err := m.renderer.Render(email)
+	if err != nil {
+		return err
+	}
+
+	body, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s-%s.eml", time.Now().UTC().Format("20060102T150405"), email.Template, uuid.NewString())
+	if err := os.WriteFile(filepath.Join(m.dir, name), body, 0o644); err != nil {
+		return fmt.Errorf("failed to write email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package mailer
+
+import (
+	"fmt"
+
+	"github.com/demola234/authentication/config"
+	"github.com/demola234/authentication/internal/domain/repository"
+)
+
+const (
+	DriverSMTP   = "smtp"
+	DriverFile   = "file"
+	DriverMemory = "memory"
+)
+
+// NewMailer builds the mailer selected by MAIL_DRIVER
+func NewMailer(cfg *config.Config) (repository.Mailer, error) {
+	switch cfg.MailDriver {
+	case DriverSMTP:
+		return NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom, cfg.AppURL)
+	case DriverFile:
+		return NewFileMailer(cfg.MailFileDir, cfg.MailFrom, cfg.AppURL)
+	case DriverMemory:
+		return NewMemoryMailer(), nil
+	default:
+		return nil, fmt.Errorf("unsupported mail driver %q", cfg.MailDriver)
+	}
+}
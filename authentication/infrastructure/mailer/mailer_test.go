@@ -0,0 +1,137 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderAllTemplates(t *testing.T) {
+	renderer, err := NewRenderer("Realio <no-reply@realio.local>", "https://app.realio.test")
+	require.NoError(t, err)
+
+	for template := range subjects {
+		msg, err := renderer.Render(&entity.Email{
+			To:       "user@example.com",
+			Template: template,
+			Data: map[string]any{
+				"Name":             "Test User",
+				"Code":             "123456",
+				"ExpiresIn":        "10m0s",
+				"IPAddress":        "127.0.0.1",
+				"UserAgent":        "test-agent",
+				"Device":           "Chrome on macOS",
+				"Location":         "Lagos, Nigeria",
+				"Time":             "Mon, 02 Jan 2006 15:04:05 UTC",
+				"Token":            "magic-token",
+				"NewEmail":         "new@example.com",
+				"DeletionDate":     "Wed, 01 Feb 2006 15:04:05 UTC",
+				"ExportID":         "7b0c3a52-8f1e-4a5e-9d3c-2f6b1e0a9c41",
+				"InviterName":      "Test User",
+				"Role":             "agent",
+				"OrganizationName": "Lagos Homes",
+			},
+		})
+		require.NoError(t, err, template)
+		require.Equal(t, subjects[template], msg.Subject)
+		require.Contains(t, msg.TextBody, "Test User")
+		require.Contains(t, msg.HTMLBody, "Test User")
+	}
+}
+
+func TestRenderEscapesHTML(t *testing.T) {
+	renderer, err := NewRenderer("Realio <no-reply@realio.local>", "https://app.realio.test")
+	require.NoError(t, err)
+
+	msg, err := renderer.Render(&entity.Email{
+		To:       "user@example.com",
+		Template: entity.EmailTemplateVerification,
+		Data:     map[string]any{"Name": "<script>", "Code": "123456", "ExpiresIn": "5m0s"},
+	})
+	require.NoError(t, err)
+	require.NotContains(t, msg.HTMLBody, "<script>")
+	require.Contains(t, msg.TextBody, "<script>")
+}
+
+func TestRenderMagicLink(t *testing.T) {
+	renderer, err := NewRenderer("Realio <no-reply@realio.local>", "https://app.realio.test/")
+	require.NoError(t, err)
+
+	msg, err := renderer.Render(&entity.Email{
+		To:       "user@example.com",
+		Template: entity.EmailTemplateMagicLink,
+		Data:     map[string]any{"Name": "Test User", "Token": "abc_123-xyz", "ExpiresIn": "15m0s", "IPAddress": "127.0.0.1"},
+	})
+	require.NoError(t, err)
+	require.Contains(t, msg.TextBody, "https://app.realio.test/auth/magic-link?token=abc_123-xyz")
+	require.Contains(t, msg.HTMLBody, `href="https://app.realio.test/auth/magic-link?token=abc_123-xyz"`)
+}
+
+func TestRenderEmailChanged(t *testing.T) {
+	renderer, err := NewRenderer("Realio <no-reply@realio.local>", "https://app.realio.test")
+	require.NoError(t, err)
+
+	msg, err := renderer.Render(&entity.Email{
+		To:       "old@example.com",
+		Template: entity.EmailTemplateEmailChanged,
+		Data:     map[string]any{"Name": "Test User", "NewEmail": "new@example.com", "Token": "revert_123", "ExpiresIn": "168h0m0s"},
+	})
+	require.NoError(t, err)
+	require.Contains(t, msg.TextBody, "new@example.com")
+	require.Contains(t, msg.TextBody, "https://app.realio.test/account/email-change/revert?token=revert_123")
+	require.Contains(t, msg.HTMLBody, `href="https://app.realio.test/account/email-change/revert?token=revert_123"`)
+}
+
+func TestRenderUnknownTemplate(t *testing.T) {
+	renderer, err := NewRenderer("Realio <no-reply@realio.local>", "https://app.realio.test")
+	require.NoError(t, err)
+
+	_, err = renderer.Render(&entity.Email{To: "user@example.com", Template: "nope"})
+	require.ErrorIs(t, err, entity.ErrUnknownEmailTemplate)
+}
+
+func TestMemoryMailer(t *testing.T) {
+	m := NewMemoryMailer()
+	require.Nil(t, m.Last())
+
+	err := m.Send(context.Background(), &entity.Email{
+		To:       "user@example.com",
+		Template: entity.EmailTemplatePasswordReset,
+		Data:     map[string]any{"Name": "Test User", "Code": "654321", "ExpiresIn": "10m0s", "IPAddress": "127.0.0.1"},
+	})
+	require.NoError(t, err)
+	require.Len(t, m.Messages(), 1)
+	require.Contains(t, m.Last().TextBody, "654321")
+}
+
+func TestFileMailer(t *testing.T) {
+	dir := t.TempDir()
+
+	m, err := NewFileMailer(dir, "Realio <no-reply@realio.local>", "https://app.realio.test")
+	require.NoError(t, err)
+
+	err = m.Send(context.Background(), &entity.Email{
+		To:       "user@example.com",
+		Template: entity.EmailTemplateVerification,
+		Data:     map[string]any{"Name": "Test User", "Code": "123456", "ExpiresIn": "5m0s"},
+	})
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	raw, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+
+	body := string(raw)
+	require.Contains(t, body, "To: user@example.com")
+	require.Contains(t, body, "multipart/alternative")
+	require.True(t, strings.Contains(body, "text/plain") && strings.Contains(body, "text/html"))
+	require.Contains(t, body, "123456")
+}
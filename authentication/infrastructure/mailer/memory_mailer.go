@@ -0,0 +1,61 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+)
+
+// MemoryMailer renders emails and keeps them in memory. It is intended for tests.
+type MemoryMailer struct {
+	renderer *Renderer
+	mu       sync.Mutex
+	messages []*Message
+}
+
+// NewMemoryMailer creates an empty in-memory mailer
+func NewMemoryMailer() *MemoryMailer {
+	renderer, err := NewRenderer("Realio <no-reply@realio.local>", "http://localhost:3000")
+	if err != nil {
+		// The templates are embedded at build time so this can only fail on a broken build
+		panic(err)
+	}
+
+	return &MemoryMailer{renderer: renderer}
+}
+
+// Send implements repository.Mailer
+func (m *MemoryMailer) Send(ctx context.Context, email *entity.Email) error {
+	msg, err := m.renderer.Render(email)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+
+	return nil
+}
+
+// Messages returns a copy of every message sent so far
+func (m *MemoryMailer) Messages() []*Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	messages := make([]*Message, len(m.messages))
+	copy(messages, m.messages)
+	return messages
+}
+
+// Last returns the most recently sent message, or nil if nothing was sent
+func (m *MemoryMailer) Last() *Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.messages) == 0 {
+		return nil
+	}
+	return m.messages[len(m.messages)-1]
+}
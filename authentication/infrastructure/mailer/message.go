@@ -0,0 +1,57 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"time"
+)
+
+// Bytes encodes the message as a multipart/alternative MIME document with a
+// plain text part followed by the HTML part.
+func (m *Message) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	fmt.Fprintf(&buf, "From: %s\r\n", m.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", m.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", writer.Boundary())
+
+	parts := []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=utf-8", m.TextBody},
+		{"text/html; charset=utf-8", m.HTMLBody},
+	}
+
+	for _, part := range parts {
+		w, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create mime part: %w", err)
+		}
+
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return nil, fmt.Errorf("failed to write mime part: %w", err)
+		}
+		if err := qp.Close(); err != nil {
+			return nil, fmt.Errorf("failed to write mime part: %w", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close mime writer: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,91 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"maps"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+)
+
+//go:embed templates/*.html templates/*.txt
+var templateFS embed.FS
+
+var subjects = map[entity.EmailTemplate]string{
+	entity.EmailTemplateVerification:    "Verify your Realio account",
+	entity.EmailTemplatePasswordReset:   "Reset your Realio password",
+	entity.EmailTemplateNewLogin:        "New sign-in to your Realio account",
+	entity.EmailTemplateAccountDeletion: "Your Realio account is scheduled for deletion",
+	entity.EmailTemplateMagicLink:       "Your Realio sign-in link",
+	entity.EmailTemplateEmailChangeCode: "Confirm your new Realio email address",
+	entity.EmailTemplateEmailChanged:    "Your Realio email address was changed",
+	entity.EmailTemplateDataExport:      "Your Realio data export is ready",
+	entity.EmailTemplateOrgInvitation:   "You have been invited to join an organization on Realio",
+}
+
+// Message is a fully rendered email ready to be delivered
+type Message struct {
+	From     string
+	To       string
+	Subject  string
+	HTMLBody string
+	TextBody string
+}
+
+// Renderer turns an entity.Email into a Message using the embedded templates.
+// Every template can link back to the web app through {{.AppURL}}.
+type Renderer struct {
+	from   string
+	appURL string
+	html   *htmltemplate.Template
+	text   *texttemplate.Template
+}
+
+// NewRenderer parses the embedded HTML and text templates
+func NewRenderer(from string, appURL string) (*Renderer, error) {
+	html, err := htmltemplate.ParseFS(templateFS, "templates/*.html")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse html templates: %w", err)
+	}
+
+	text, err := texttemplate.ParseFS(templateFS, "templates/*.txt")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse text templates: %w", err)
+	}
+
+	return &Renderer{from: from, appURL: strings.TrimSuffix(appURL, "/"), html: html, text: text}, nil
+}
+
+// Render renders both the HTML and plain text parts of an email
+func (r *Renderer) Render(email *entity.Email) (*Message, error) {
+	subject, ok := subjects[email.Template]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", entity.ErrUnknownEmailTemplate, email.Template)
+	}
+
+	data := make(map[string]any, len(email.Data)+1)
+	maps.Copy(data, email.Data)
+	data["AppURL"] = r.appURL
+
+	var html bytes.Buffer
+	if err := r.html.ExecuteTemplate(&html, string(email.Template)+".html", data); err != nil {
+		return nil, fmt.Errorf("failed to render html body: %w", err)
+	}
+
+	var text bytes.Buffer
+	if err := r.text.ExecuteTemplate(&text, string(email.Template)+".txt", data); err != nil {
+		return nil, fmt.Errorf("failed to render text body: %w", err)
+	}
+
+	return &Message{
+		From:     r.from,
+		To:       email.To,
+		Subject:  subject,
+		HTMLBody: html.String(),
+		TextBody: text.String(),
+	}, nil
+}
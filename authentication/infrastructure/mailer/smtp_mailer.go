@@ -0,0 +1,72 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/domain/repository"
+)
+
+// SMTPMailer delivers emails through an SMTP relay
+type SMTPMailer struct {
+	renderer *Renderer
+	addr     string
+	host     string
+	auth     smtp.Auth
+	from     string
+}
+
+// NewSMTPMailer creates a mailer that sends through the given SMTP server. When
+// username is empty the relay is used without authentication.
+func NewSMTPMailer(host string, port int, username, password, from, appURL string) (repository.Mailer, error) {
+	renderer, err := NewRenderer(from, appURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPMailer{
+		renderer: renderer,
+		addr:     net.JoinHostPort(host, strconv.Itoa(port)),
+		host:     host,
+		auth:     auth,
+		from:     from,
+	}, nil
+}
+
+// Send implements repository.Mailer
+func (m *SMTPMailer) Send(ctx context.Context, email *entity.Email) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	msg, err := m.renderer.Render(email)
+	if err != nil {
+		return err
+	}
+
+	body, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	sender, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	if err := smtp.SendMail(m.addr, m.auth, sender.Address, []string{email.To}, body); err != nil {
+		return fmt.Errorf("failed to send email to %s: %w", email.To, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	// consumerMaxAttempts is how many times a message is handled before it is skipped
+	consumerMaxAttempts = 5
+	consumerRetryDelay  = 2 * time.Second
+)
+
+// MessageHandler processes a single message. Returning an error has the
+// message retried.
+type MessageHandler func(ctx context.Context, key, value []byte) error
+
+type KafkaConsumer struct {
+	reader *kafka.Reader
+}
+
+func NewKafkaConsumer(brokers []string, topic string, groupID string) *KafkaConsumer {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: brokers,
+		Topic:   topic,
+		GroupID: groupID,
+	})
+	return &KafkaConsumer{reader: reader}
+}
+
+// Consume hands every message to handle until ctx is cancelled. A message is
+// committed once it was handled, or after consumerMaxAttempts failures so one
+// bad message cannot hold up the rest of the partition.
+func (c *KafkaConsumer) Consume(ctx context.Context, handle MessageHandler) error {
+	for {
+		msg, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		}
+
+		for attempt := 1; attempt <= consumerMaxAttempts; attempt++ {
+			err = handle(ctx, msg.Key, msg.Value)
+			if err == nil || ctx.Err() != nil {
+				break
+			}
+			log.Printf("failed to handle %s message (attempt %d): %v", msg.Key, attempt, err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(consumerRetryDelay * time.Duration(attempt)):
+			}
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err := c.reader.CommitMessages(ctx, msg); err != nil {
+			log.Printf("failed to commit message: %v", err)
+		}
+	}
+}
+
+func (c *KafkaConsumer) Close() {
+	c.reader.Close()
+}
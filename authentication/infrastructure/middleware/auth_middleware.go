@@ -19,7 +19,7 @@ const (
 	authorizationPayloadKey = "authorization_payload"
 )
 
-func authMiddleWare(tokenMaker token.Maker) gin.HandlerFunc {
+func authMiddleWare(tokenVerifier token.Verifier) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 
 		authorizationHeader := ctx.GetHeader(authorizationHeader)
@@ -44,7 +44,7 @@ func authMiddleWare(tokenMaker token.Maker) gin.HandlerFunc {
 		}
 
 		accessToken := stringSplit[1]
-		payload, err := tokenMaker.VerifyToken(accessToken)
+		payload, err := tokenVerifier.VerifyToken(accessToken)
 		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, interfaces.ErrorResponse(err, http.StatusUnauthorized))
 			return
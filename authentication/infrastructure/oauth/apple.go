@@ -0,0 +1,50 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/jwks"
+)
+
+var appleIssuers = []string{"https://appleid.apple.com"}
+
+// AppleProvider verifies Sign in with Apple ID tokens locally against Apple's cached signing keys
+type AppleProvider struct {
+	clientID string
+	keys     *jwks.Cache
+}
+
+// NewAppleProvider creates a provider for ID tokens issued to clientID
+func NewAppleProvider(clientID, jwksURL string, cacheTTL time.Duration, client *http.Client) *AppleProvider {
+	return &AppleProvider{
+		clientID: clientID,
+		keys:     jwks.NewCache(jwks.NewHTTPKeySource(jwksURL, client), cacheTTL),
+	}
+}
+
+// Name implements Provider
+func (p *AppleProvider) Name() string {
+	return "apple"
+}
+
+// VerifyToken implements TokenVerifier
+func (p *AppleProvider) VerifyToken(ctx context.Context, token string) (*entity.OAuthUserInfo, error) {
+	claims, err := p.keys.VerifyIDToken(ctx, token, jwks.VerifyOptions{
+		Issuers:  appleIssuers,
+		Audience: p.clientID,
+		Leeway:   idTokenLeeway,
+	})
+	if err != nil {
+		return nil, idTokenError(err)
+	}
+
+	return &entity.OAuthUserInfo{
+		ID:            claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Provider:      p.Name(),
+	}, nil
+}
@@ -0,0 +1,136 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+)
+
+// FacebookProvider validates Facebook access tokens through the Graph API
+type FacebookProvider struct {
+	appID     string
+	appSecret string
+	graphURL  string
+	client    *http.Client
+}
+
+// NewFacebookProvider creates a provider for access tokens issued to appID
+func NewFacebookProvider(appID, appSecret string, client *http.Client) *FacebookProvider {
+	return &FacebookProvider{
+		appID:     appID,
+		appSecret: appSecret,
+		graphURL:  "https://graph.facebook.com",
+		client:    client,
+	}
+}
+
+// Name implements Provider
+func (p *FacebookProvider) Name() string {
+	return "facebook"
+}
+
+// VerifyToken implements TokenVerifier
+func (p *FacebookProvider) VerifyToken(ctx context.Context, token string) (*entity.OAuthUserInfo, error) {
+	url := fmt.Sprintf("%s/debug_token?input_token=%s&access_token=%s|%s",
+		p.graphURL, token, p.appID, p.appSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to validate token: %s", resp.Status)
+	}
+
+	var facebookResult struct {
+		Data struct {
+			AppID     string `json:"app_id"`
+			ExpiresAt int64  `json:"expires_at"`
+			IsValid   bool   `json:"is_valid"`
+			Scopes    []struct {
+				Scope     string `json:"scope"`
+				Valid     bool   `json:"valid"`
+				ErrorName string `json:"error_name"`
+			} `json:"scopes"`
+			UserID string `json:"user_id"`
+		} `json:"data"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&facebookResult); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if !facebookResult.Data.IsValid {
+		return nil, entity.ErrInvalidToken
+	}
+
+	if facebookResult.Data.AppID != p.appID {
+		return nil, entity.ErrInvalidToken
+	}
+
+	// Check expiration
+	if facebookResult.Data.ExpiresAt != 0 && time.Now().Unix() > facebookResult.Data.ExpiresAt {
+		return nil, entity.ErrTokenExpired
+	}
+
+	userInfo, err := p.getUserInfo(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	userInfo.ID = facebookResult.Data.UserID
+	userInfo.Provider = p.Name()
+
+	return userInfo, nil
+}
+
+func (p *FacebookProvider) getUserInfo(ctx context.Context, token string) (*entity.OAuthUserInfo, error) {
+	url := fmt.Sprintf("%s/me?fields=id,name,email,picture&access_token=%s", p.graphURL, token)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	var userInfo struct {
+		ID      string `json:"id"`
+		Name    string `json:"name"`
+		Email   string `json:"email"`
+		Picture struct {
+			Data struct {
+				URL string `json:"url"`
+			} `json:"data"`
+		} `json:"picture"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
+		return nil, fmt.Errorf("failed to decode user info: %w", err)
+	}
+
+	return &entity.OAuthUserInfo{
+		ID:      userInfo.ID,
+		Name:    userInfo.Name,
+		Email:   userInfo.Email,
+		Picture: userInfo.Picture.Data.URL,
+	}, nil
+}
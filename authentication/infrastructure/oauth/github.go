@@ -0,0 +1,137 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+)
+
+// GitHubProvider signs users in with GitHub's authorization code flow. GitHub
+// is not an OpenID provider, so the profile and verified email are read from
+// its REST API with the access token.
+type GitHubProvider struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	authURL      string
+	tokenURL     string
+	apiURL       string
+	client       *http.Client
+}
+
+// NewGitHubProvider creates a provider for the GitHub OAuth app clientID whose
+// callback URL is redirectURL
+func NewGitHubProvider(clientID, clientSecret, redirectURL string, client *http.Client) *GitHubProvider {
+	return &GitHubProvider{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		authURL:      "https://github.com/login/oauth/authorize",
+		tokenURL:     "https://github.com/login/oauth/access_token",
+		apiURL:       "https://api.github.com",
+		client:       client,
+	}
+}
+
+// Name implements Provider
+func (p *GitHubProvider) Name() string {
+	return "github"
+}
+
+// AuthCodeURL implements CodeProvider
+func (p *GitHubProvider) AuthCodeURL(state *entity.OAuthState) string {
+	params := url.Values{
+		"client_id":             {p.clientID},
+		"redirect_uri":          {p.redirectURL},
+		"scope":                 {"read:user user:email"},
+		"state":                 {state.State},
+		"code_challenge":        {state.CodeChallenge()},
+		"code_challenge_method": {"S256"},
+		"allow_signup":          {"true"},
+	}
+	return p.authURL + "?" + params.Encode()
+}
+
+// Exchange implements CodeProvider
+func (p *GitHubProvider) Exchange(ctx context.Context, code string, state *entity.OAuthState) (*entity.OAuthUserInfo, error) {
+	token, err := exchangeCode(ctx, p.client, p.tokenURL, url.Values{
+		"client_id":     {p.clientID},
+		"client_secret": {p.clientSecret},
+		"code":          {code},
+		"redirect_uri":  {p.redirectURL},
+		"code_verifier": {state.CodeVerifier},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var user struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		Email     string `json:"email"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := p.get(ctx, "/user", token.AccessToken, &user); err != nil {
+		return nil, err
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := p.get(ctx, "/user/emails", token.AccessToken, &emails); err != nil {
+		return nil, err
+	}
+
+	userInfo := &entity.OAuthUserInfo{
+		ID:       strconv.FormatInt(user.ID, 10),
+		Name:     user.Name,
+		Email:    user.Email,
+		Picture:  user.AvatarURL,
+		Provider: p.Name(),
+	}
+	if userInfo.Name == "" {
+		userInfo.Name = user.Login
+	}
+
+	// Prefer the primary address, but only ever report a verified one as verified
+	for _, email := range emails {
+		if email.Verified && (email.Primary || !userInfo.EmailVerified) {
+			userInfo.Email = email.Email
+			userInfo.EmailVerified = true
+		}
+	}
+
+	return userInfo, nil
+}
+
+func (p *GitHubProvider) get(ctx context.Context, path, accessToken string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch github %s: %s", path, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode github %s: %w", path, err)
+	}
+	return nil
+}
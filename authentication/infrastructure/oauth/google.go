@@ -0,0 +1,52 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/jwks"
+)
+
+var googleIssuers = []string{"accounts.google.com", "https://accounts.google.com"}
+
+// GoogleProvider verifies Google ID tokens locally against Google's cached signing keys
+type GoogleProvider struct {
+	clientID string
+	keys     *jwks.Cache
+}
+
+// NewGoogleProvider creates a provider for ID tokens issued to clientID
+func NewGoogleProvider(clientID, jwksURL string, cacheTTL time.Duration, client *http.Client) *GoogleProvider {
+	return &GoogleProvider{
+		clientID: clientID,
+		keys:     jwks.NewCache(jwks.NewHTTPKeySource(jwksURL, client), cacheTTL),
+	}
+}
+
+// Name implements Provider
+func (p *GoogleProvider) Name() string {
+	return "google"
+}
+
+// VerifyToken implements TokenVerifier
+func (p *GoogleProvider) VerifyToken(ctx context.Context, token string) (*entity.OAuthUserInfo, error) {
+	claims, err := p.keys.VerifyIDToken(ctx, token, jwks.VerifyOptions{
+		Issuers:  googleIssuers,
+		Audience: p.clientID,
+		Leeway:   idTokenLeeway,
+	})
+	if err != nil {
+		return nil, idTokenError(err)
+	}
+
+	return &entity.OAuthUserInfo{
+		Email:         claims.Email,
+		Name:          claims.Name,
+		Picture:       claims.Picture,
+		ID:            claims.Subject,
+		EmailVerified: claims.EmailVerified,
+		Provider:      p.Name(),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/jwks"
+)
+
+const microsoftAuthority = "https://login.microsoftonline.com"
+
+// MicrosoftProvider signs users in with the Microsoft identity platform's
+// OpenID Connect authorization code flow.
+type MicrosoftProvider struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	tenant       string
+	authURL      string
+	tokenURL     string
+	keys         *jwks.Cache
+	client       *http.Client
+}
+
+// NewMicrosoftProvider creates a provider for the app registration clientID
+// whose callback URL is redirectURL. tenant is a tenant ID, or "common",
+// "organizations" or "consumers" for multi-tenant apps.
+func NewMicrosoftProvider(clientID, clientSecret, redirectURL, tenant string, cacheTTL time.Duration, client *http.Client) *MicrosoftProvider {
+	authority := microsoftAuthority + "/" + tenant
+
+	return &MicrosoftProvider{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		tenant:       tenant,
+		authURL:      authority + "/oauth2/v2.0/authorize",
+		tokenURL:     authority + "/oauth2/v2.0/token",
+		keys:         jwks.NewCache(jwks.NewHTTPKeySource(authority+"/discovery/v2.0/keys", client), cacheTTL),
+		client:       client,
+	}
+}
+
+// Name implements Provider
+func (p *MicrosoftProvider) Name() string {
+	return "microsoft"
+}
+
+// AuthCodeURL implements CodeProvider
+func (p *MicrosoftProvider) AuthCodeURL(state *entity.OAuthState) string {
+	params := url.Values{
+		"client_id":             {p.clientID},
+		"response_type":         {"code"},
+		"response_mode":         {"query"},
+		"redirect_uri":          {p.redirectURL},
+		"scope":                 {"openid email profile"},
+		"state":                 {state.State},
+		"nonce":                 {state.Nonce},
+		"code_challenge":        {state.CodeChallenge()},
+		"code_challenge_method": {"S256"},
+	}
+	return p.authURL + "?" + params.Encode()
+}
+
+// Exchange implements CodeProvider
+func (p *MicrosoftProvider) Exchange(ctx context.Context, code string, state *entity.OAuthState) (*entity.OAuthUserInfo, error) {
+	token, err := exchangeCode(ctx, p.client, p.tokenURL, url.Values{
+		"client_id":     {p.clientID},
+		"client_secret": {p.clientSecret},
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.redirectURL},
+		"code_verifier": {state.CodeVerifier},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := p.keys.VerifyIDToken(ctx, token.IDToken, jwks.VerifyOptions{
+		IssuerFunc: p.validIssuer,
+		Audience:   p.clientID,
+		Leeway:     idTokenLeeway,
+	})
+	if err != nil {
+		return nil, idTokenError(err)
+	}
+
+	// The nonce ties the ID token to the flow this state started
+	if claims.Nonce != state.Nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", entity.ErrInvalidToken)
+	}
+
+	return &entity.OAuthUserInfo{
+		ID:    claims.Subject,
+		Email: claims.Email,
+		Name:  claims.Name,
+		// Microsoft does not verify the email claim, so it must not be trusted as verified
+		EmailVerified: false,
+		Provider:      p.Name(),
+	}, nil
+}
+
+// validIssuer accepts the configured tenant's issuer, or any tenant's issuer
+// for multi-tenant apps
+func (p *MicrosoftProvider) validIssuer(issuer string) bool {
+	switch p.tenant {
+	case "common", "organizations", "consumers":
+		tenantID, ok := strings.CutPrefix(issuer, microsoftAuthority+"/")
+		if !ok {
+			return false
+		}
+		tenantID, ok = strings.CutSuffix(tenantID, "/v2.0")
+		return ok && tenantID != "" && !strings.Contains(tenantID, "/")
+	default:
+		return issuer == microsoftAuthority+"/"+p.tenant+"/v2.0"
+	}
+}
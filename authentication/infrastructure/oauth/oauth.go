@@ -0,0 +1,100 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/demola234/authentication/config"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/jwks"
+)
+
+const (
+	// idTokenLeeway allows for clock skew between us and the identity provider
+	idTokenLeeway = time.Minute
+
+	httpTimeout = 10 * time.Second
+)
+
+// NewRegistryFromConfig registers every provider the service ships with.
+// Adding a provider means implementing TokenVerifier or CodeProvider and
+// registering it here.
+func NewRegistryFromConfig(cfg *config.Config) *Registry {
+	client := &http.Client{Timeout: httpTimeout}
+
+	return NewRegistry(
+		NewGoogleProvider(cfg.GoogleClientID, cfg.GoogleJWKSURL, cfg.JWKSCacheTTL, client),
+		NewAppleProvider(cfg.AppleClientID, cfg.AppleJWKSURL, cfg.JWKSCacheTTL, client),
+		NewFacebookProvider(cfg.FacebookAppID, cfg.FacebookAppSecret, client),
+		NewGitHubProvider(cfg.GitHubClientID, cfg.GitHubClientSecret, CallbackURL(cfg.OAuthCallbackBaseURL, "github"), client),
+		NewMicrosoftProvider(cfg.MicrosoftClientID, cfg.MicrosoftClientSecret, CallbackURL(cfg.OAuthCallbackBaseURL, "microsoft"), cfg.MicrosoftTenant, cfg.JWKSCacheTTL, client),
+	)
+}
+
+// CallbackURL is where a provider sends the user back to after the
+// authorization code flow; it must be registered with the provider as is.
+func CallbackURL(baseURL, provider string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/api/v1/oauth/" + provider + "/callback"
+}
+
+// tokenResponse is the token endpoint response of RFC 6749 section 5
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// exchangeCode posts an authorization code grant to a token endpoint
+func exchangeCode(ctx context.Context, client *http.Client, tokenURL string, form url.Values) (*tokenResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var token tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	// A rejected code is the client's fault, e.g. reused, expired or a wrong verifier
+	if token.Error != "" {
+		return nil, fmt.Errorf("%w: %s %s", entity.ErrInvalidToken, token.Error, token.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to exchange code: %s", resp.Status)
+	}
+
+	return &token, nil
+}
+
+// idTokenError maps ID token verification failures onto the domain token errors
+func idTokenError(err error) error {
+	switch {
+	case errors.Is(err, jwks.ErrTokenExpired):
+		return entity.ErrTokenExpired
+	case errors.Is(err, jwks.ErrMalformedToken),
+		errors.Is(err, jwks.ErrInvalidSignature),
+		errors.Is(err, jwks.ErrInvalidIssuer),
+		errors.Is(err, jwks.ErrInvalidAudience),
+		errors.Is(err, jwks.ErrTokenNotYetValid),
+		errors.Is(err, jwks.ErrKeyNotFound):
+		return fmt.Errorf("%w: %v", entity.ErrInvalidToken, err)
+	}
+	return fmt.Errorf("failed to verify id token: %w", err)
+}
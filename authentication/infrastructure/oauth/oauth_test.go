@@ -0,0 +1,192 @@
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/jwks"
+	"github.com/stretchr/testify/require"
+)
+
+func testState() *entity.OAuthState {
+	return &entity.OAuthState{
+		State:        "test-state",
+		Provider:     "github",
+		CodeVerifier: "dBjftJeZ4CVP-mJ92K9ZIbzpgxtoWHB2mcZEV4Ky4ec",
+		Nonce:        "test-nonce",
+		ExpiresAt:    time.Now().Add(10 * time.Minute),
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry(
+		NewGoogleProvider("client-id", "http://127.0.0.1/keys", time.Hour, http.DefaultClient),
+		NewGitHubProvider("client-id", "secret", "http://localhost/callback", http.DefaultClient),
+	)
+
+	_, err := registry.TokenVerifier("Google")
+	require.NoError(t, err)
+
+	_, err = registry.CodeProvider("github")
+	require.NoError(t, err)
+
+	// GitHub has no tokens a client could hand us to verify
+	_, err = registry.TokenVerifier("github")
+	require.ErrorIs(t, err, entity.ErrProviderFlowInvalid)
+
+	_, err = registry.CodeProvider("twitter")
+	require.ErrorIs(t, err, entity.ErrProviderInvalid)
+}
+
+func TestCodeChallenge(t *testing.T) {
+	// BASE64URL(SHA256(verifier)) without padding
+	require.Equal(t, "oAIeZO5d4XDmDrpooynT_NW6JfhUJOxa5LI5NCzZeJU", testState().CodeChallenge())
+}
+
+func TestGitHubExchange(t *testing.T) {
+	state := testState()
+	verifier := state.CodeVerifier
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("code") != "auth-code" || r.PostForm.Get("code_verifier") != verifier {
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "bad_verification_code"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "gho_test", "token_type": "bearer"})
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer gho_test", r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 42, "login": "octocat", "email": "public@example.com"})
+	})
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]any{
+			{"email": "old@example.com", "primary": false, "verified": true},
+			{"email": "octocat@example.com", "primary": true, "verified": true},
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	provider := NewGitHubProvider("client-id", "secret", "http://localhost/callback", server.Client())
+	provider.tokenURL = server.URL + "/login/oauth/access_token"
+	provider.apiURL = server.URL
+
+	authURL, err := url.Parse(provider.AuthCodeURL(state))
+	require.NoError(t, err)
+	require.Equal(t, state.CodeChallenge(), authURL.Query().Get("code_challenge"))
+	require.Equal(t, "S256", authURL.Query().Get("code_challenge_method"))
+	require.Equal(t, "http://localhost/callback", authURL.Query().Get("redirect_uri"))
+
+	info, err := provider.Exchange(context.Background(), "auth-code", state)
+	require.NoError(t, err)
+	require.Equal(t, "42", info.ID)
+	require.Equal(t, "octocat", info.Name)
+	require.Equal(t, "octocat@example.com", info.Email)
+	require.True(t, info.EmailVerified)
+	require.Equal(t, "github", info.Provider)
+
+	// A code redeemed without the right verifier is rejected
+	state.CodeVerifier = "someone-elses-verifier"
+	_, err = provider.Exchange(context.Background(), "auth-code", state)
+	require.ErrorIs(t, err, entity.ErrInvalidToken)
+}
+
+func TestMicrosoftExchange(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	state := testState()
+	state.Provider = "microsoft"
+	tenantID := "9188040d-6c67-4c5b-b112-36a304b66dad"
+
+	var idTokenNonce string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "ms-key",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, state.CodeVerifier, r.PostForm.Get("code_verifier"))
+		require.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+
+		idToken := signTestIDToken(t, key, "ms-key", map[string]any{
+			"iss":   microsoftAuthority + "/" + tenantID + "/v2.0",
+			"aud":   "client-id",
+			"sub":   "ms-user-1",
+			"email": "user@contoso.com",
+			"name":  "Contoso User",
+			"nonce": idTokenNonce,
+			"iat":   time.Now().Unix(),
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		})
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "at", "id_token": idToken})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	provider := NewMicrosoftProvider("client-id", "secret", "http://localhost/callback", "common", time.Hour, server.Client())
+	provider.tokenURL = server.URL + "/token"
+	provider.keys = jwks.NewCache(jwks.NewHTTPKeySource(server.URL+"/keys", server.Client()), time.Hour)
+
+	authURL, err := url.Parse(provider.AuthCodeURL(state))
+	require.NoError(t, err)
+	require.Equal(t, state.Nonce, authURL.Query().Get("nonce"))
+
+	idTokenNonce = state.Nonce
+	info, err := provider.Exchange(context.Background(), "auth-code", state)
+	require.NoError(t, err)
+	require.Equal(t, "ms-user-1", info.ID)
+	require.Equal(t, "user@contoso.com", info.Email)
+	require.False(t, info.EmailVerified)
+
+	// An ID token minted for another flow is rejected
+	idTokenNonce = "replayed-nonce"
+	_, err = provider.Exchange(context.Background(), "auth-code", state)
+	require.ErrorIs(t, err, entity.ErrInvalidToken)
+}
+
+func TestMicrosoftIssuer(t *testing.T) {
+	multiTenant := NewMicrosoftProvider("client-id", "secret", "", "common", time.Hour, http.DefaultClient)
+	require.True(t, multiTenant.validIssuer(microsoftAuthority+"/9188040d-6c67-4c5b-b112-36a304b66dad/v2.0"))
+	require.False(t, multiTenant.validIssuer("https://evil.example.com/tenant/v2.0"))
+	require.False(t, multiTenant.validIssuer(microsoftAuthority+"/a/b/v2.0"))
+
+	singleTenant := NewMicrosoftProvider("client-id", "secret", "", "my-tenant", time.Hour, http.DefaultClient)
+	require.True(t, singleTenant.validIssuer(microsoftAuthority+"/my-tenant/v2.0"))
+	require.False(t, singleTenant.validIssuer(microsoftAuthority+"/other-tenant/v2.0"))
+}
+
+func signTestIDToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": kid})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
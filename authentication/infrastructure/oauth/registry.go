@@ -0,0 +1,107 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+)
+
+// Provider is an identity provider that can be plugged into a Registry. A
+// provider supports one or both sign-in flows by also implementing
+// TokenVerifier and/or CodeProvider.
+type Provider interface {
+	// Name is the lower case name clients use to pick the provider, e.g. "github"
+	Name() string
+}
+
+// TokenVerifier validates a token the client obtained from the provider
+// itself, such as an ID token returned by a mobile SDK.
+type TokenVerifier interface {
+	Provider
+	VerifyToken(ctx context.Context, token string) (*entity.OAuthUserInfo, error)
+}
+
+// CodeProvider runs the server-side authorization code flow with PKCE.
+type CodeProvider interface {
+	Provider
+	// AuthCodeURL returns the URL that starts the flow at the provider
+	AuthCodeURL(state *entity.OAuthState) string
+	// Exchange redeems the authorization code returned to the callback
+	Exchange(ctx context.Context, code string, state *entity.OAuthState) (*entity.OAuthUserInfo, error)
+}
+
+// Registry holds the configured identity providers by name
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[string]Provider
+}
+
+// NewRegistry creates a registry holding the given providers
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{providers: make(map[string]Provider, len(providers))}
+	for _, provider := range providers {
+		r.Register(provider)
+	}
+	return r
+}
+
+// Register adds a provider, replacing any provider registered under the same name
+func (r *Registry) Register(provider Provider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.providers[strings.ToLower(provider.Name())] = provider
+}
+
+// Names lists the registered providers
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	return names
+}
+
+// TokenVerifier returns the named provider if it can verify client obtained tokens
+func (r *Registry) TokenVerifier(name string) (TokenVerifier, error) {
+	provider, err := r.get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, ok := provider.(TokenVerifier)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s only supports the authorization code flow", entity.ErrProviderFlowInvalid, provider.Name())
+	}
+	return verifier, nil
+}
+
+// CodeProvider returns the named provider if it supports the authorization code flow
+func (r *Registry) CodeProvider(name string) (CodeProvider, error) {
+	provider, err := r.get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	codeProvider, ok := provider.(CodeProvider)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s does not support the authorization code flow", entity.ErrProviderFlowInvalid, provider.Name())
+	}
+	return codeProvider, nil
+}
+
+func (r *Registry) get(name string) (Provider, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	provider, ok := r.providers[strings.ToLower(name)]
+	if !ok {
+		return nil, entity.ErrProviderInvalid
+	}
+	return provider, nil
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/demola234/authentication/internal/domain/repository"
+)
+
+// FileStorage keeps data export files in a local directory
+type FileStorage struct {
+	dir string
+}
+
+// NewFileStorage creates a storage that keeps files in dir, creating it if needed
+func NewFileStorage(dir string) (repository.ExportStorage, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	return &FileStorage{dir: dir}, nil
+}
+
+// Save implements repository.ExportStorage
+func (s *FileStorage) Save(ctx context.Context, name string, data []byte) error {
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a partial file is never served
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to store export file: %w", err)
+	}
+
+	return nil
+}
+
+// Open implements repository.ExportStorage
+func (s *FileStorage) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	path, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open export file: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete implements repository.ExportStorage
+func (s *FileStorage) Delete(ctx context.Context, name string) error {
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete export file: %w", err)
+	}
+
+	return nil
+}
+
+// path keeps every name inside the storage directory
+func (s *FileStorage) path(name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid export file name %q", name)
+	}
+	return filepath.Join(s.dir, name), nil
+}
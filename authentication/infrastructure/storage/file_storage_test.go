@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStorage(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "exports")
+	storage, err := NewFileStorage(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, storage.Save(ctx, "export.zip", []byte("zip")))
+
+	file, err := storage.Open(ctx, "export.zip")
+	require.NoError(t, err)
+	data, err := io.ReadAll(file)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	require.Equal(t, "zip", string(data))
+
+	require.NoError(t, storage.Delete(ctx, "export.zip"))
+	_, err = os.Stat(filepath.Join(dir, "export.zip"))
+	require.True(t, os.IsNotExist(err))
+
+	// Deleting a file that is already gone is not an error
+	require.NoError(t, storage.Delete(ctx, "export.zip"))
+}
+
+func TestFileStorageRejectsPaths(t *testing.T) {
+	storage, err := NewFileStorage(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, name := range []string{"", "..", "../export.zip", "nested/export.zip"} {
+		require.Error(t, storage.Save(ctx, name, []byte("zip")), name)
+		_, err := storage.Open(ctx, name)
+		require.Error(t, err, name)
+	}
+}
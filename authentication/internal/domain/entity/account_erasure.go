@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDeletionAlreadyScheduled = errors.New("account deletion is already scheduled")
+	ErrDeletionPending          = errors.New("account is scheduled for deletion, sign in to keep it")
+	ErrErasureNotFound          = errors.New("no deletion is scheduled or recorded for this user")
+)
+
+// ErasurePending is the status of a service that has not reported back yet
+const ErasurePending = "pending"
+
+// States of an account deletion as shown to administrators
+const (
+	ErasureStateScheduled  = "scheduled"
+	ErasureStateInProgress = "in_progress"
+	ErasureStateCompleted  = "completed"
+)
+
+// AccountErasure tracks a deleted account until every service holding its
+// data has reported erasing it. ScheduledFor is only set while the deletion is
+// still in its grace period; the other times are zero until it ends.
+type AccountErasure struct {
+	UserID       uuid.UUID
+	RequestedAt  time.Time
+	ScheduledFor *time.Time
+	DeletedAt    *time.Time
+	PublishedAt  *time.Time
+	Services     []*AccountErasureService
+}
+
+// AccountErasureService is one service's progress erasing a deleted account
+type AccountErasureService struct {
+	Service   string
+	Status    string
+	Items     int64
+	Detail    string
+	UpdatedAt time.Time
+}
+
+// State summarises the erasure: scheduled during the grace period, in progress
+// until every service has reported and completed afterwards.
+func (e *AccountErasure) State() string {
+	if e.DeletedAt == nil {
+		return ErasureStateScheduled
+	}
+	for _, service := range e.Services {
+		if service.Status == ErasurePending {
+			return ErasureStateInProgress
+		}
+	}
+	return ErasureStateCompleted
+}
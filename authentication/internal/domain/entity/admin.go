@@ -0,0 +1,22 @@
+package entity
+
+import "errors"
+
+var (
+	ErrAdminSelfAction = errors.New("administrators cannot perform this action on their own account")
+	ErrUserNotDeleted  = errors.New("user is not deleted")
+	ErrInvalidUserID   = errors.New("invalid user ID format")
+)
+
+// UserFilter narrows down the users listed to administrators. Empty fields
+// and nil pointers do not filter.
+type UserFilter struct {
+	Email    string
+	Name     string
+	Role     string
+	Provider string
+	IsActive *bool
+	Deleted  *bool
+	Limit    int
+	Offset   int
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAgentVerificationNotFound      = errors.New("agent verification not found")
+	ErrNotAnAgent                     = errors.New("only agents can be verified")
+	ErrAgentVerificationInReview      = errors.New("agent verification is already being reviewed")
+	ErrAgentAlreadyVerified           = errors.New("agent is already verified")
+	ErrAgentVerificationNotPending    = errors.New("agent verification is not waiting for review")
+	ErrInvalidAgentVerification       = errors.New("license number, business name and business address are required")
+	ErrInvalidAgentDocumentType       = errors.New("invalid agent verification document type")
+	ErrAgentDocumentRequired          = errors.New("an ID document must be uploaded before submitting")
+	ErrTooManyAgentDocuments          = errors.New("too many agent verification documents")
+	ErrInvalidAgentVerificationReview = errors.New("decision must be approve, reject or request_more_info")
+	ErrAgentReviewNoteRequired        = errors.New("a note is required when rejecting or asking for more information")
+	ErrInvalidAgentVerificationStatus = errors.New("invalid agent verification status")
+)
+
+// Agent verification statuses
+const (
+	AgentVerificationPending           = "pending"
+	AgentVerificationApproved          = "approved"
+	AgentVerificationRejected          = "rejected"
+	AgentVerificationMoreInfoRequested = "more_info_requested"
+)
+
+// Agent verification document types
+const (
+	AgentDocumentID                   = "id_document"
+	AgentDocumentLicense              = "license"
+	AgentDocumentBusinessRegistration = "business_registration"
+)
+
+// Decisions an administrator can make on a pending verification
+const (
+	AgentReviewApprove         = "approve"
+	AgentReviewReject          = "reject"
+	AgentReviewRequestMoreInfo = "request_more_info"
+)
+
+// AgentVerification is an agent's request to have their identity and license
+// checked. An agent has one, which they resubmit after it is rejected or more
+// information is requested, until an administrator approves it.
+type AgentVerification struct {
+	UserID             uuid.UUID        `json:"user_id"`
+	Email              string           `json:"email"`
+	FullName           string           `json:"name"`
+	LicenseNumber      string           `json:"license_number"`
+	BusinessName       string           `json:"business_name"`
+	BusinessAddress    string           `json:"business_address"`
+	BusinessPhone      string           `json:"business_phone,omitempty"`
+	RegistrationNumber string           `json:"registration_number,omitempty"`
+	Status             string           `json:"status"`
+	ReviewNote         string           `json:"review_note,omitempty"`
+	ReviewedBy         *uuid.UUID       `json:"reviewed_by,omitempty"`
+	ReviewedAt         *time.Time       `json:"reviewed_at,omitempty"`
+	SubmittedAt        time.Time        `json:"submitted_at"`
+	UpdatedAt          time.Time        `json:"updated_at"`
+	Documents          []*AgentDocument `json:"documents,omitempty"`
+}
+
+// CanResubmit reports whether the agent may change and submit the
+// verification again
+func (v *AgentVerification) CanResubmit() bool {
+	return v.Status == AgentVerificationRejected || v.Status == AgentVerificationMoreInfoRequested
+}
+
+// AgentDocument is an uploaded scan supporting an agent verification, such as
+// a government ID. It is stored as an authenticated asset and only its signed
+// URL is kept.
+type AgentDocument struct {
+	ID           uuid.UUID `json:"id"`
+	UserID       uuid.UUID `json:"user_id"`
+	DocumentType string    `json:"document_type"`
+	URL          string    `json:"url"`
+	UploadedAt   time.Time `json:"uploaded_at"`
+}
+
+// AgentVerificationFilter selects one page of the verifications in a status
+type AgentVerificationFilter struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+// AgentVerificationChangedEvent is published whenever an agent verification
+// changes status, so other services can show or hide the verified badge.
+type AgentVerificationChangedEvent struct {
+	UserID         uuid.UUID  `json:"user_id"`
+	Status         string     `json:"status"`
+	PreviousStatus string     `json:"previous_status,omitempty"`
+	VerifiedAgent  bool       `json:"verified_agent"`
+	ReviewedBy     *uuid.UUID `json:"reviewed_by,omitempty"`
+	Note           string     `json:"note,omitempty"`
+	OccurredAt     time.Time  `json:"occurred_at"`
+}
+
+// ValidAgentVerificationStatus reports whether status is a verification status
+func ValidAgentVerificationStatus(status string) bool {
+	switch status {
+	case AgentVerificationPending, AgentVerificationApproved, AgentVerificationRejected, AgentVerificationMoreInfoRequested:
+		return true
+	}
+	return false
+}
+
+// ValidAgentDocumentType reports whether documentType is one agents can upload
+func ValidAgentDocumentType(documentType string) bool {
+	switch documentType {
+	case AgentDocumentID, AgentDocumentLicense, AgentDocumentBusinessRegistration:
+		return true
+	}
+	return false
+}
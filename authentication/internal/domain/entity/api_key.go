@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAPIKeyNotFound      = errors.New("api key not found")
+	ErrInvalidAPIKey       = errors.New("api key is invalid, expired or revoked")
+	ErrInvalidAPIKeyName   = errors.New("invalid api key name")
+	ErrInvalidAPIKeyScope  = errors.New("api key scopes must be permissions you hold")
+	ErrInvalidAPIKeyExpiry = errors.New("api key expiry must be in the future")
+	ErrTooManyAPIKeys      = errors.New("too many active api keys")
+)
+
+// APIKey lets an integration, such as a partner portal or CRM sync job, call
+// the API without signing in. It acts as the user who created it with only
+// its scopes, and for its organization when it belongs to one. Only the hash
+// of the key is stored; the prefix is kept so the key can be recognised.
+type APIKey struct {
+	ID             uuid.UUID  `json:"id"`
+	UserID         uuid.UUID  `json:"user_id"`
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+	Name           string     `json:"name"`
+	Prefix         string     `json:"prefix"`
+	KeyHash        string     `json:"-"`
+	Scopes         []string   `json:"scopes"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt     *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+}
+
+// IsUsable reports whether the key is neither revoked nor expired
+func (k *APIKey) IsUsable(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of authentication events kept in the security audit log
+const (
+	AuthEventLogin              = "login"
+	AuthEventOTPVerification    = "otp_verification"
+	AuthEventPasswordChange     = "password_change"
+	AuthEventSessionRevoked     = "session_revoked"
+	AuthEventAccountDeactivated = "account_deactivated"
+	AuthEventAccountDeleted     = "account_deleted"
+	// Deletions the user asks for are scheduled and can be cancelled by signing in
+	AuthEventAccountDeletionScheduled = "account_deletion_scheduled"
+	AuthEventAccountDeletionCancelled = "account_deletion_cancelled"
+	AuthEventImpersonation            = "impersonation"
+	// Passwordless sign-in links emailed to a user and used to sign in
+	AuthEventMagicLinkRequested = "magic_link_requested"
+	AuthEventMagicLinkUsed      = "magic_link_used"
+	// Copies of all of a user's data requested and downloaded by the user
+	AuthEventDataExportRequested  = "data_export_requested"
+	AuthEventDataExportDownloaded = "data_export_downloaded"
+	// Changes to the organizations a user belongs to
+	AuthEventOrganizationJoined = "organization_joined"
+	AuthEventOrganizationLeft   = "organization_left"
+	// Agents submitting their identity and license and administrators reviewing them
+	AuthEventAgentVerificationSubmitted = "agent_verification_submitted"
+	AuthEventAgentVerificationReviewed  = "agent_verification_reviewed"
+	// API keys created and revoked for integrations
+	AuthEventAPIKeyCreated = "api_key_created"
+	AuthEventAPIKeyRevoked = "api_key_revoked"
+)
+
+// Outcomes of an authentication event
+const (
+	AuthEventSuccess = "success"
+	AuthEventFailure = "failure"
+)
+
+// LoginEventTypes are the events shown to users as their login history.
+var LoginEventTypes = []string{AuthEventLogin, AuthEventImpersonation}
+
+// AuthEvent is an entry of the append-only security audit log. ActorID is who
+// performed the action and UserID the account it was performed on; they differ
+// when an administrator acts on a user. ActorID is nil when the caller did not
+// prove who they are, such as a failed login, and UserID is nil too when no
+// account matched the email that was tried.
+type AuthEvent struct {
+	ID        uuid.UUID
+	EventType string
+	Outcome   string
+	ActorID   *uuid.UUID
+	UserID    *uuid.UUID
+	Email     string
+	SessionID *uuid.UUID
+	IPAddress string
+	UserAgent string
+	// Device, DeviceFingerprint and Location are derived from the user agent
+	// and IP address when the event is recorded
+	Device            string
+	DeviceFingerprint string
+	Location          string
+	Metadata          map[string]string
+	CreatedAt         time.Time
+}
+
+// LoginFamiliarity counts a user's earlier successful logins, in total and
+// from a given device and location, to tell whether a new login is unusual.
+type LoginFamiliarity struct {
+	PreviousLogins int64
+	DeviceLogins   int64
+	LocationLogins int64
+}
+
+// AuthEventFilter narrows down the audit log shown to administrators. Empty
+// fields and nil pointers do not filter.
+type AuthEventFilter struct {
+	UserID    *uuid.UUID
+	ActorID   *uuid.UUID
+	EventType string
+	Outcome   string
+	IPAddress string
+	Since     *time.Time
+	Until     *time.Time
+	Limit     int
+	Offset    int
+}
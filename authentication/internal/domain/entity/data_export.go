@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDataExportNotFound    = errors.New("data export not found")
+	ErrDataExportInProgress  = errors.New("a data export is already being prepared")
+	ErrDataExportLinkInvalid = errors.New("data export download link is invalid or has expired")
+)
+
+// Data export statuses
+const (
+	DataExportPending  = "pending"
+	DataExportBuilding = "building"
+	DataExportReady    = "ready"
+	DataExportFailed   = "failed"
+)
+
+// DataExport is a ZIP of everything the services hold about a user, built in
+// the background. Once ready it can be downloaded with the emailed link until
+// ExpiresAt; only the hash of the link's token is stored.
+type DataExport struct {
+	ID                uuid.UUID  `json:"id"`
+	UserID            uuid.UUID  `json:"user_id"`
+	Status            string     `json:"status"`
+	FileName          string     `json:"-"`
+	SizeBytes         int64      `json:"size_bytes"`
+	DownloadTokenHash string     `json:"-"`
+	Error             string     `json:"error,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	StartedAt         *time.Time `json:"started_at,omitempty"`
+	CompletedAt       *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt         *time.Time `json:"expires_at,omitempty"`
+}
+
+// ExportFile is one JSON file of a data export. Content is marshalled as is.
+type ExportFile struct {
+	Name    string
+	Content any
+}
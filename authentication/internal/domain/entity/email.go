@@ -0,0 +1,29 @@
+package entity
+
+import "errors"
+
+var (
+	ErrUnknownEmailTemplate = errors.New("unknown email template")
+)
+
+// EmailTemplate identifies which transactional email to render
+type EmailTemplate string
+
+const (
+	EmailTemplateVerification    EmailTemplate = "verification"
+	EmailTemplatePasswordReset   EmailTemplate = "password_reset"
+	EmailTemplateNewLogin        EmailTemplate = "new_login"
+	EmailTemplateAccountDeletion EmailTemplate = "account_deletion"
+	EmailTemplateMagicLink       EmailTemplate = "magic_link"
+	EmailTemplateEmailChangeCode EmailTemplate = "email_change_code"
+	EmailTemplateEmailChanged    EmailTemplate = "email_changed"
+	EmailTemplateDataExport      EmailTemplate = "data_export"
+	EmailTemplateOrgInvitation   EmailTemplate = "organization_invitation"
+)
+
+// Email is a transactional email to be rendered from a template and delivered to a single recipient
+type Email struct {
+	To       string         `json:"to"`
+	Template EmailTemplate  `json:"template"`
+	Data     map[string]any `json:"data"`
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmailTaken               = errors.New("email is already in use")
+	ErrEmailUnchanged           = errors.New("new email is the same as the current one")
+	ErrEmailChangeNotFound      = errors.New("no pending email change")
+	ErrEmailChangeRevertInvalid = errors.New("email change revert link is invalid or has expired")
+)
+
+// EmailChange is a request to move a user to a new email address. Once
+// confirmed, the old address can revert it until RevertExpiresAt. Only the
+// hash of the revert token is stored.
+type EmailChange struct {
+	ID               uuid.UUID  `json:"id"`
+	UserID           uuid.UUID  `json:"user_id"`
+	OldEmail         string     `json:"old_email"`
+	NewEmail         string     `json:"new_email"`
+	OldEmailVerified bool       `json:"old_email_verified"`
+	RequestedIP      string     `json:"requested_ip,omitempty"`
+	RevertTokenHash  string     `json:"-"`
+	RevertExpiresAt  *time.Time `json:"revert_expires_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	ConfirmedAt      *time.Time `json:"confirmed_at,omitempty"`
+	RevertedAt       *time.Time `json:"reverted_at,omitempty"`
+}
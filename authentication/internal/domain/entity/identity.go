@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrIdentityNotFound      = errors.New("identity not found")
+	ErrIdentityAlreadyLinked = errors.New("identity is already linked to another account")
+	ErrProviderAlreadyLinked = errors.New("a different account from this provider is already linked")
+	ErrAccountNotLinked      = errors.New("no account is linked to this identity")
+	ErrEmailAlreadyInUse     = errors.New("an account with this email already exists; sign in and link the provider instead")
+	ErrLastSignInMethod      = errors.New("cannot unlink the only way to sign in to this account")
+	ErrOAuthEmailMissing     = errors.New("the provider did not share an email address")
+)
+
+// UserIdentity links a user to an account at an OAuth provider. A user can
+// hold one identity per provider.
+type UserIdentity struct {
+	ID             uuid.UUID  `json:"id"`
+	UserID         uuid.UUID  `json:"user_id"`
+	Provider       string     `json:"provider"`
+	ProviderUserID string     `json:"provider_user_id"`
+	Email          string     `json:"email"`
+	CreatedAt      time.Time  `json:"created_at"`
+	LastUsedAt     *time.Time `json:"last_used_at"`
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrImpersonationNotAllowed = errors.New("administrators cannot be impersonated")
+
+// Impersonation is a time-boxed session in which an administrator sees the
+// app as another user. It has no refresh token and cannot be extended.
+type Impersonation struct {
+	SessionID      uuid.UUID
+	ImpersonatorID uuid.UUID
+	User           *User
+	Reason         string
+	AccessToken    string
+	ExpiresAt      time.Time
+}
+
+// UserImpersonatedEvent is published when an administrator starts
+// impersonating a user.
+type UserImpersonatedEvent struct {
+	SessionID      uuid.UUID `json:"session_id"`
+	ImpersonatorID uuid.UUID `json:"impersonator_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Reason         string    `json:"reason"`
+	IPAddress      string    `json:"ip_address"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMagicLinkInvalid = errors.New("magic link is invalid or has expired")
+	ErrMagicLinkUsed    = errors.New("magic link has already been used")
+)
+
+// MagicLink is a single-use sign-in link emailed to a user. Only the hash of
+// its token is stored.
+type MagicLink struct {
+	ID                 uuid.UUID  `json:"id"`
+	UserID             uuid.UUID  `json:"user_id"`
+	TokenHash          string     `json:"-"`
+	ExpiresAt          time.Time  `json:"expires_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+	RequestedIP        string     `json:"requested_ip"`
+	RequestedUserAgent string     `json:"requested_user_agent"`
+	UsedAt             *time.Time `json:"used_at,omitempty"`
+	UsedIP             string     `json:"used_ip,omitempty"`
+	UsedUserAgent      string     `json:"used_user_agent,omitempty"`
+}
+
+// MagicLinkEvent is published whenever a magic link is requested, used or replayed
+type MagicLinkEvent struct {
+	Type        string    `json:"type"`
+	MagicLinkID uuid.UUID `json:"magic_link_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	IPAddress   string    `json:"ip_address"`
+	UserAgent   string    `json:"user_agent"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMFANotEnrolled      = errors.New("mfa is not enrolled")
+	ErrMFAAlreadyEnabled   = errors.New("mfa is already enabled")
+	ErrMFANotEnabled       = errors.New("mfa is not enabled")
+	ErrInvalidMFACode      = errors.New("invalid mfa code")
+	ErrMFAChallengeInvalid = errors.New("mfa challenge is invalid or has expired")
+	ErrMFAAttemptsExceeded = errors.New("mfa attempts exceeded")
+)
+
+// UserMFA represents the TOTP factor registered for a user
+type UserMFA struct {
+	UserID       uuid.UUID  `json:"user_id"`
+	Secret       string     `json:"-"`
+	Enabled      bool       `json:"enabled"`
+	ConfirmedAt  *time.Time `json:"confirmed_at,omitempty"`
+	LastUsedStep int64      `json:"last_used_step"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// MFAEnrollment is returned to the user when a new TOTP secret is generated
+type MFAEnrollment struct {
+	Secret     string `json:"secret"`
+	OtpauthURL string `json:"otpauth_url"`
+	QRCode     string `json:"qr_code"`
+}
+
+// MFAChallenge is issued after a successful password check when the user has MFA enabled
+type MFAChallenge struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	Attempts   int        `json:"attempts"`
+	IpAddress  string     `json:"ip_address,omitempty"`
+	UserAgent  string     `json:"user_agent,omitempty"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	ConsumedAt *time.Time `json:"consumed_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
@@ -1,13 +1,19 @@
 package entity
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
+	"time"
 )
 
 var (
-	ErrInvalidToken    = errors.New("invalid token")
-	ErrTokenExpired    = errors.New("token expired")
-	ErrProviderInvalid = errors.New("invalid oauth provider")
+	ErrInvalidToken             = errors.New("invalid token")
+	ErrTokenExpired             = errors.New("token expired")
+	ErrProviderInvalid          = errors.New("invalid oauth provider")
+	ErrProviderFlowInvalid      = errors.New("oauth provider does not support this sign-in flow")
+	ErrOAuthStateInvalid        = errors.New("oauth state is invalid or has expired")
+	ErrOAuthAuthorizationDenied = errors.New("oauth authorization was denied")
 )
 
 // OAuthUserInfo represents the user information from OAuth providers
@@ -19,3 +25,32 @@ type OAuthUserInfo struct {
 	EmailVerified bool   `json:"email_verified"`
 	Provider      string `json:"provider"`
 }
+
+// OAuthState carries an authorization code flow from its start to the
+// provider's callback. Only a hash of State is stored.
+type OAuthState struct {
+	State        string    `json:"-"`
+	Provider     string    `json:"provider"`
+	CodeVerifier string    `json:"-"`
+	Nonce        string    `json:"-"`
+	CreatedAt    time.Time `json:"created_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// CodeChallenge returns the S256 PKCE challenge of the code verifier
+func (s *OAuthState) CodeChallenge() string {
+	sum := sha256.Sum256([]byte(s.CodeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// IsExpired reports whether the flow took too long to come back
+func (s *OAuthState) IsExpired(now time.Time) bool {
+	return now.After(s.ExpiresAt)
+}
+
+// OAuthAuthorization is where to send the user to sign in with a provider
+type OAuthAuthorization struct {
+	URL       string    `json:"url"`
+	State     string    `json:"state"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOrganizationNotFound      = errors.New("organization not found")
+	ErrInvalidOrganizationName   = errors.New("invalid organization name")
+	ErrNotOrganizationMember     = errors.New("user is not a member of the organization")
+	ErrOrganizationRoleForbidden = errors.New("your organization role does not allow this")
+	ErrAlreadyOrganizationMember = errors.New("user is already a member of the organization")
+	ErrLastOrganizationOwner     = errors.New("an organization must keep at least one owner")
+	ErrInvitationNotFound        = errors.New("invitation not found")
+	ErrInvitationInvalid         = errors.New("invitation is invalid or has expired")
+	ErrInvitationEmailMismatch   = errors.New("invitation was sent to a different email address")
+	ErrSessionNotFound           = errors.New("session not found")
+)
+
+// Organization invitation statuses
+const (
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+	InvitationDeclined = "declined"
+	InvitationRevoked  = "revoked"
+)
+
+// Organization groups users, such as the agents working for an agency, so
+// listings can be attributed to it as well as to the agent.
+type Organization struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	// Role is the requesting user's role in the organization, set when listing
+	// their organizations
+	Role string `json:"role,omitempty"`
+}
+
+// OrganizationMember is a user's membership of an organization
+type OrganizationMember struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Email          string    `json:"email"`
+	FullName       string    `json:"name"`
+	Role           string    `json:"role"`
+	JoinedAt       time.Time `json:"joined_at"`
+}
+
+// OrganizationInvitation asks the owner of an email address to join an
+// organization with a role. Only the hash of the emailed token is stored.
+type OrganizationInvitation struct {
+	ID               uuid.UUID  `json:"id"`
+	OrganizationID   uuid.UUID  `json:"organization_id"`
+	OrganizationName string     `json:"organization_name"`
+	Email            string     `json:"email"`
+	Role             string     `json:"role"`
+	TokenHash        string     `json:"-"`
+	InvitedBy        *uuid.UUID `json:"invited_by,omitempty"`
+	Status           string     `json:"status"`
+	CreatedAt        time.Time  `json:"created_at"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	RespondedAt      *time.Time `json:"responded_at,omitempty"`
+}
+
+// IsPending reports whether the invitation can still be accepted or declined
+func (i *OrganizationInvitation) IsPending() bool {
+	return i.Status == InvitationPending && time.Now().Before(i.ExpiresAt)
+}
@@ -0,0 +1,6 @@
+package entity
+
+import "errors"
+
+// ErrPasswordReused is returned when a new password matches one of the user's recent passwords
+var ErrPasswordReused = errors.New("password was used recently, choose another one")
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("refresh token is invalid or has expired")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected")
+)
+
+// RefreshToken represents a stored (hashed) refresh token issued for a session
+type RefreshToken struct {
+	ID        uuid.UUID  `json:"id"`
+	SessionID uuid.UUID  `json:"session_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	FamilyID  uuid.UUID  `json:"family_id"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TokenPair is the access and refresh token handed to a client
+type TokenPair struct {
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
@@ -24,12 +24,19 @@ type Session struct {
 	IsActive     bool                   `json:"is_active"`
 	RevokedAt    *time.Time             `json:"revoked_at,omitempty"`
 	DeviceInfo   *pqtype.NullRawMessage `json:"device_info,omitempty"`
+	// ImpersonatorID is set when an administrator is acting as the user in this session
+	ImpersonatorID      *uuid.UUID `json:"impersonator_id,omitempty"`
+	ImpersonationReason string     `json:"impersonation_reason,omitempty"`
 }
 
-type UpdateOtp struct {
-	Otp          string    `json:"otp"`
-	OtpExpiresAt time.Time `json:"otp_expires_at"`
-	OtpAttempts  int       `json:"otp_attempts"`
-	Email        string    `json:"email"`
-	OTPVerified  bool      `json:"otp_verified"`
-}
+// Reasons a session's access tokens were revoked
+const (
+	SessionRevokedLogout      = "logged_out"
+	SessionRevokedByUser      = "revoked"
+	SessionRevokedDeactivated = "deactivated"
+	SessionRevokedDeleted     = "deleted"
+	SessionRevokedTokenReuse  = "refresh_token_reused"
+	SessionRevokedEmailRevert = "email_change_reverted"
+	SessionRevokedByAdmin     = "revoked_by_admin"
+	SessionRevokedAPIKey      = "api_key_revoked"
+)
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTooManyAttempts = errors.New("too many failed attempts")
+)
+
+const (
+	ThrottleScopeLogin = "login"
+	ThrottleScopeOTP   = "otp"
+	// Magic link requests count until a link is used, since each one sends an email
+	ThrottleScopeMagicLink = "magic_link"
+
+	ThrottleSubjectAccount = "account"
+	ThrottleSubjectIP      = "ip"
+)
+
+// ThrottleKey identifies a failure counter, e.g. failed logins for an email or OTP failures from an IP
+type ThrottleKey struct {
+	Scope       string `json:"scope"`
+	SubjectType string `json:"subject_type"`
+	Subject     string `json:"subject"`
+}
+
+// AuthThrottle tracks consecutive failed attempts against a ThrottleKey
+type AuthThrottle struct {
+	ThrottleKey
+	FailedAttempts int        `json:"failed_attempts"`
+	LockoutCount   int        `json:"lockout_count"`
+	LockedUntil    *time.Time `json:"locked_until,omitempty"`
+	LastFailedAt   time.Time  `json:"last_failed_at"`
+}
+
+// IsLocked reports whether attempts are currently rejected
+func (t *AuthThrottle) IsLocked(now time.Time) bool {
+	return t.LockedUntil != nil && now.Before(*t.LockedUntil)
+}
+
+// LockoutError is returned while an account or IP is locked out. It wraps ErrTooManyAttempts.
+type LockoutError struct {
+	Until time.Time
+}
+
+func (e *LockoutError) Error() string {
+	return fmt.Sprintf("%s, try again after %s", ErrTooManyAttempts, e.Until.UTC().Format(time.RFC3339))
+}
+
+func (e *LockoutError) Unwrap() error {
+	return ErrTooManyAttempts
+}
+
+// RetryAfter returns how long the caller has to wait before trying again
+func (e *LockoutError) RetryAfter() time.Duration {
+	retryAfter := time.Until(e.Until)
+	if retryAfter < 0 {
+		return 0
+	}
+	return retryAfter
+}
+
+// AccountLockedEvent is published when a failure counter crosses its threshold
+type AccountLockedEvent struct {
+	Scope          string     `json:"scope"`
+	SubjectType    string     `json:"subject_type"`
+	Subject        string     `json:"subject"`
+	UserID         *uuid.UUID `json:"user_id,omitempty"`
+	FailedAttempts int        `json:"failed_attempts"`
+	LockoutCount   int        `json:"lockout_count"`
+	LockedUntil    time.Time  `json:"locked_until"`
+	IPAddress      string     `json:"ip_address"`
+	OccurredAt     time.Time  `json:"occurred_at"`
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// TokenKey is a public key that access tokens are signed with. Other services
+// fetch these to verify tokens without holding the private key.
+type TokenKey struct {
+	KeyID     string     `json:"kid"`
+	PublicKey string     `json:"public_key"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
@@ -1,30 +1,61 @@
 package entity
 
 import (
+	"errors"
 	"time"
 
-	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/shared/rbac"
+
 	"github.com/google/uuid"
 )
 
+var (
+	ErrUserNotFound   = errors.New("user not found")
+	ErrAccountLocked  = errors.New("account has been locked, contact support")
+	ErrAccountDeleted = errors.New("account has been deleted")
+)
+
 // User entity based on the users table schema
 type User struct {
-	ID             uuid.UUID          `json:"id"`
-	FullName       string             `json:"name"`
-	Email          string             `json:"email"`
-	Bio            string             `json:"bio"`
-	Provider       utils.ProviderType `json:"provider"`
-	Username       string             `json:"username"`
-	ProviderID     string             `json:"provider_id"`
-	ProfilePicture string             `json:"profile_picture"`
-	Password       string             `json:"password"`
-	Role           string             `json:"role"`
-	Phone          string             `json:"phone"`
-	EmailVerified  bool               `json:"email_verified"`
-	IsActive       bool               `json:"is_active"`
-	LastLogin      time.Time          `json:"last_login"`
-	CreatedAt      time.Time          `json:"created_at"`
-	UpdatedAt      time.Time          `json:"updated_at"`
+	ID             uuid.UUID  `json:"id"`
+	FullName       string     `json:"name"`
+	Email          string     `json:"email"`
+	Bio            string     `json:"bio"`
+	Username       string     `json:"username"`
+	ProfilePicture string     `json:"profile_picture"`
+	Password       string     `json:"password"`
+	Role           string     `json:"role"`
+	Phone          string     `json:"phone"`
+	EmailVerified  bool       `json:"email_verified"`
+	IsActive       bool       `json:"is_active"`
+	LastLogin      time.Time  `json:"last_login"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	LockedAt       *time.Time `json:"locked_at,omitempty"`
+	LockedReason   string     `json:"locked_reason,omitempty"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+	// Set while the user's own request to delete their account is in its grace period
+	DeletionRequestedAt  *time.Time `json:"deletion_requested_at,omitempty"`
+	DeletionScheduledFor *time.Time `json:"deletion_scheduled_for,omitempty"`
+	// Set while the user's agent verification is approved
+	AgentVerifiedAt *time.Time `json:"agent_verified_at,omitempty"`
+}
+
+// CanSignIn returns an error if the account was locked or deleted by an administrator
+func (u *User) CanSignIn() error {
+	if u.DeletedAt != nil {
+		return ErrAccountDeleted
+	}
+	if u.LockedAt != nil {
+		return ErrAccountLocked
+	}
+	return nil
+}
+
+// IsVerifiedAgent reports whether the user is an agent whose identity and
+// license have been approved
+func (u *User) IsVerifiedAgent() bool {
+	return u.AgentVerifiedAt != nil && u.Role == string(rbac.RoleAgent)
 }
 
 // UserProfile represents a user profile with additional details
@@ -43,6 +74,10 @@ type LoginHistoryEntry struct {
 	Timestamp time.Time
 	IpAddress string
 	UserAgent string
+	Device    string
 	Location  string
 	Success   bool
+	// ImpersonatorID is set when the entry is an administrator signing in as the user
+	ImpersonatorID      *uuid.UUID
+	ImpersonationReason string
 }
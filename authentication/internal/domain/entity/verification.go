@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// What a verification code proves
+const (
+	VerificationPurposeSignup        = "signup"
+	VerificationPurposePasswordReset = "password_reset"
+	VerificationPurposeEmailChange   = "email_change"
+	VerificationPurposeLogin         = "login"
+)
+
+var (
+	ErrVerificationCodeInvalid      = errors.New("invalid otp")
+	ErrVerificationCodeExpired      = errors.New("otp has expired")
+	ErrVerificationAttemptsExceeded = errors.New("maximum otp attempts exceeded")
+	ErrVerificationNotFound         = errors.New("no pending otp, request a new one")
+	ErrResetTokenInvalid            = errors.New("password reset token is invalid or has expired")
+)
+
+// VerificationChallenge is a one-time code emailed to a user for a single
+// purpose. Only a salted hash of the code is stored.
+type VerificationChallenge struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Purpose     string     `json:"purpose"`
+	CodeHash    string     `json:"-"`
+	Salt        string     `json:"-"`
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"max_attempts"`
+	IpAddress   string     `json:"ip_address,omitempty"`
+	UserAgent   string     `json:"user_agent,omitempty"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	VerifiedAt  *time.Time `json:"verified_at,omitempty"`
+	ConsumedAt  *time.Time `json:"consumed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
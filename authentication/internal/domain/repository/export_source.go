@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"io"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// ExportSource supplies another service's share of a user's data export.
+type ExportSource interface {
+	// ExportUserData returns the files the service contributes to the export.
+	ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.ExportFile, error)
+}
+
+// ExportStorage keeps the files of finished data exports until they expire.
+type ExportStorage interface {
+	// Save stores data under name, replacing any file with the same name.
+	Save(ctx context.Context, name string, data []byte) error
+
+	// Open returns a reader for a stored file.
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+
+	// Delete removes a stored file; a missing file is not an error.
+	Delete(ctx context.Context, name string) error
+}
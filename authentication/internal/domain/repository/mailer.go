@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/demola234/authentication/internal/domain/entity"
+)
+
+// Mailer defines the contract for delivering transactional emails.
+type Mailer interface {
+	// Send renders the email's template and delivers it to the recipient.
+	Send(ctx context.Context, email *domain.Email) error
+}
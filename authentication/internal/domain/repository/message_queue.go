@@ -0,0 +1,9 @@
+package repository
+
+import "context"
+
+// MessageQueue defines the contract for publishing domain events to other services.
+type MessageQueue interface {
+	// PublishMessage publishes a single event keyed by its event type.
+	PublishMessage(ctx context.Context, key, value []byte) error
+}
@@ -16,4 +16,8 @@ type OAuthRepository interface {
 	ValidateAppleToken(ctx context.Context, token string) (*domain.OAuthUserInfo, error)
 	// ValidateProviderToken validates an OAuth token for a specific provider.
 	ValidateProviderToken(ctx context.Context, provider, token string) (*domain.OAuthUserInfo, error)
+	// AuthCodeURL returns the URL that starts the authorization code flow at a provider.
+	AuthCodeURL(provider string, state *domain.OAuthState) (string, error)
+	// ExchangeCode redeems an authorization code, proving possession of the state's PKCE verifier.
+	ExchangeCode(ctx context.Context, provider, code string, state *domain.OAuthState) (*domain.OAuthUserInfo, error)
 }
\ No newline at end of file
@@ -21,8 +21,8 @@ type UserRepository interface {
 	// UpdatePassword updates the password for an existing user.
 	UpdatePassword(ctx context.Context, email string, newPassword string) error
 
-	// CreateToken generates a new access token for a user.
-	CreateToken(ctx context.Context, email string, userID string) (string, error)
+	// CreateToken generates a new access token bound to a session and carrying the user's role and the organization the session acts for, and returns its expiry.
+	CreateToken(ctx context.Context, email string, userID string, sessionID string, role string) (string, time.Time, error)
 
 	// GetUserByID retrieves a user by their ID.
 	GetUserByID(ctx context.Context, id string) (*entity.User, error)
@@ -39,12 +39,6 @@ type UserRepository interface {
 	// DeleteSession deletes a user session by its ID.
 	DeleteSession(ctx context.Context, id string) error
 
-	// UpdateOtp updates the OTP for a user session.
-	UpdateOtp(ctx context.Context, session *entity.UpdateOtp) error
-
-	// GetOtp retrieves the OTP for a user session.
-	GetOtp(ctx context.Context, id string) (*entity.UpdateOtp, error)
-
 	// UpdateUser updates a user's information.
 	UpdateUser(ctx context.Context, user *entity.User) error
 
@@ -75,6 +69,337 @@ type UserRepository interface {
 	// GetUserByProviderID retrieves a user by their provider ID.
 	DeleteUser(ctx context.Context, userID uuid.UUID) error
 
-	// GetUserByProviderID retrieves a user by their provider ID.
+	// GetLoginHistory returns a user's most recent logins from the security audit log.
 	GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.LoginHistoryEntry, error)
+
+	// SaveUserMFA stores a pending TOTP secret for a user, replacing any previous one.
+	SaveUserMFA(ctx context.Context, userID uuid.UUID, secret string) (*entity.UserMFA, error)
+
+	// GetUserMFA retrieves the TOTP factor registered for a user.
+	GetUserMFA(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error)
+
+	// EnableUserMFA marks a user's TOTP factor as confirmed.
+	EnableUserMFA(ctx context.Context, userID uuid.UUID) error
+
+	// UpdateMFALastUsedStep records the last accepted TOTP time step, returning false if it was already used.
+	UpdateMFALastUsedStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+
+	// DeleteUserMFA removes a user's TOTP factor and recovery codes.
+	DeleteUserMFA(ctx context.Context, userID uuid.UUID) error
+
+	// ReplaceRecoveryCodes replaces all recovery codes of a user with the given hashes.
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+
+	// UseRecoveryCode redeems an unused recovery code, returning false if none matched.
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+
+	// CreateMFAChallenge stores a pending second factor challenge.
+	CreateMFAChallenge(ctx context.Context, challenge *entity.MFAChallenge) error
+
+	// GetMFAChallenge retrieves a second factor challenge by its ID.
+	GetMFAChallenge(ctx context.Context, challengeID uuid.UUID) (*entity.MFAChallenge, error)
+
+	// IncrementMFAChallengeAttempts records a failed attempt against a challenge.
+	IncrementMFAChallengeAttempts(ctx context.Context, challengeID uuid.UUID) error
+
+	// ConsumeMFAChallenge marks a challenge as completed, returning false if it was already used or expired.
+	ConsumeMFAChallenge(ctx context.Context, challengeID uuid.UUID) (bool, error)
+
+	// CreateRefreshToken stores a new refresh token; its expiry is set from the configured refresh token duration.
+	CreateRefreshToken(ctx context.Context, refreshToken *entity.RefreshToken) error
+
+	// GetRefreshTokenByHash retrieves a refresh token by the hash of its value.
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error)
+
+	// RotateRefreshToken marks a refresh token as used and stores its successor.
+	RotateRefreshToken(ctx context.Context, currentID uuid.UUID, next *entity.RefreshToken) error
+
+	// RevokeRefreshTokenFamily revokes every refresh token rotated from the same login.
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error
+
+	// GetAuthThrottle fetches the failure counter for a key, or nil if it has none.
+	GetAuthThrottle(ctx context.Context, key entity.ThrottleKey) (*entity.AuthThrottle, error)
+
+	// RecordAuthFailure increments the failure counter for a key, restarting it if
+	// the last failure happened before windowStart.
+	RecordAuthFailure(ctx context.Context, key entity.ThrottleKey, windowStart time.Time) (*entity.AuthThrottle, error)
+
+	// LockAuthThrottle locks a key until the given time and resets its failure count.
+	LockAuthThrottle(ctx context.Context, key entity.ThrottleKey, until time.Time) (*entity.AuthThrottle, error)
+
+	// ResetAuthThrottle clears the failure counter for a key.
+	ResetAuthThrottle(ctx context.Context, key entity.ThrottleKey) error
+
+	// UnlockAccount clears every failure counter and lockout held against an email.
+	UnlockAccount(ctx context.Context, email string) error
+
+	// CreateOAuthUser creates a user who signed up through a provider together with that identity.
+	CreateOAuthUser(ctx context.Context, user *entity.User, identity *entity.UserIdentity) error
+
+	// CreateUserIdentity links a provider identity to an existing user.
+	CreateUserIdentity(ctx context.Context, identity *entity.UserIdentity) error
+
+	// GetUserIdentity finds an identity by provider and the user's ID at that provider.
+	GetUserIdentity(ctx context.Context, provider, providerUserID string) (*entity.UserIdentity, error)
+
+	// ListUserIdentities returns every identity linked to a user.
+	ListUserIdentities(ctx context.Context, userID uuid.UUID) ([]*entity.UserIdentity, error)
+
+	// TouchUserIdentity records that an identity was just used to sign in.
+	TouchUserIdentity(ctx context.Context, identityID uuid.UUID) error
+
+	// DeleteUserIdentity unlinks one of a user's identities.
+	DeleteUserIdentity(ctx context.Context, userID uuid.UUID, identityID uuid.UUID) error
+
+	// CreateOAuthState stores a pending authorization code flow.
+	CreateOAuthState(ctx context.Context, state *entity.OAuthState) error
+
+	// ConsumeOAuthState removes and returns a pending flow by its state, or entity.ErrOAuthStateInvalid.
+	ConsumeOAuthState(ctx context.Context, state string) (*entity.OAuthState, error)
+
+	// CreateMagicLink stores a new sign-in link and expires the user's older unused ones.
+	CreateMagicLink(ctx context.Context, link *entity.MagicLink) error
+
+	// GetMagicLinkByHash retrieves a magic link by the hash of its token, or entity.ErrMagicLinkInvalid.
+	GetMagicLinkByHash(ctx context.Context, tokenHash string) (*entity.MagicLink, error)
+
+	// MarkMagicLinkUsed records where a link was used from. A link that was
+	// already used, or has expired in the meantime, yields entity.ErrMagicLinkUsed.
+	MarkMagicLinkUsed(ctx context.Context, linkID uuid.UUID, ipAddress, userAgent string) error
+
+	// RevokeSessionTokens adds a session to the revocation list so its access
+	// tokens are rejected before they expire, and revokes its refresh tokens.
+	RevokeSessionTokens(ctx context.Context, userID, sessionID uuid.UUID, reason string) error
+
+	// RevokeUserSessionTokens adds every session of a user to the revocation list,
+	// including those of the access tokens exchanged for their API keys.
+	RevokeUserSessionTokens(ctx context.Context, userID uuid.UUID, reason string) error
+
+	// CreateVerificationChallenge stores a new verification code and consumes the
+	// user's older pending challenges for the same purpose.
+	CreateVerificationChallenge(ctx context.Context, challenge *entity.VerificationChallenge) error
+
+	// GetActiveVerificationChallenge retrieves the latest unconsumed challenge of a
+	// user for a purpose, or entity.ErrVerificationNotFound.
+	GetActiveVerificationChallenge(ctx context.Context, userID uuid.UUID, purpose string) (*entity.VerificationChallenge, error)
+
+	// IncrementVerificationChallengeAttempts records a wrong code and returns the new attempt count.
+	IncrementVerificationChallengeAttempts(ctx context.Context, challengeID uuid.UUID) (int, error)
+
+	// MarkVerificationChallengeVerified records that the correct code was entered,
+	// returning false if the challenge was already verified, used up or expired.
+	MarkVerificationChallengeVerified(ctx context.Context, challengeID uuid.UUID) (bool, error)
+
+	// ConsumeVerificationChallenge uses up the verified challenge of a user for a purpose, returning false if there is none.
+	ConsumeVerificationChallenge(ctx context.Context, userID uuid.UUID, purpose string) (bool, error)
+	// SetVerificationChallengeResetToken stores the hash of the token a verified challenge is exchanged for, returning false if it is no longer pending.
+	SetVerificationChallengeResetToken(ctx context.Context, challengeID uuid.UUID, tokenHash string) (bool, error)
+	// GetVerificationChallengeByResetToken retrieves the pending challenge a reset token was issued for, or entity.ErrVerificationNotFound.
+	GetVerificationChallengeByResetToken(ctx context.Context, tokenHash string) (*entity.VerificationChallenge, error)
+	// ConsumeVerificationChallengeByResetToken uses up the challenge a reset token was issued for, returning false if it was already used or has expired.
+	ConsumeVerificationChallengeByResetToken(ctx context.Context, tokenHash string) (bool, error)
+
+	// MarkEmailVerified records that a user proved they own their email address.
+	MarkEmailVerified(ctx context.Context, userID uuid.UUID) error
+
+	// AddPasswordHistory records a password hash the user is replacing, keeping only the most recent keep entries.
+	AddPasswordHistory(ctx context.Context, userID uuid.UUID, passwordHash string, keep int) error
+
+	// ListPasswordHistory returns the hashes of the user's most recently replaced passwords, newest first.
+	ListPasswordHistory(ctx context.Context, userID uuid.UUID, limit int) ([]string, error)
+
+	// ListTokenKeys returns the public keys that access tokens are verified with.
+	ListTokenKeys(ctx context.Context) ([]*entity.TokenKey, error)
+
+	// CreateEmailChange stores a pending email change, replacing any other pending change of the user.
+	CreateEmailChange(ctx context.Context, change *entity.EmailChange) error
+
+	// GetPendingEmailChange retrieves the unconfirmed email change of a user, or entity.ErrEmailChangeNotFound.
+	GetPendingEmailChange(ctx context.Context, userID uuid.UUID) (*entity.EmailChange, error)
+
+	// ConfirmEmailChange moves the user to the new email and stores the revert token hash,
+	// returning entity.ErrEmailTaken if the address was claimed in the meantime.
+	ConfirmEmailChange(ctx context.Context, change *entity.EmailChange) error
+
+	// GetEmailChangeByRevertToken retrieves a confirmed email change by the hash of its revert token,
+	// or entity.ErrEmailChangeRevertInvalid.
+	GetEmailChangeByRevertToken(ctx context.Context, tokenHash string) (*entity.EmailChange, error)
+
+	// RevertEmailChange restores the user's previous email and its verification status,
+	// returning entity.ErrEmailChangeRevertInvalid if the change can no longer be reverted.
+	RevertEmailChange(ctx context.Context, change *entity.EmailChange) error
+
+	// ListUsers returns one page of the users matching filter, newest first.
+	ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error)
+
+	// CountUsers returns how many users match filter, ignoring its limit and offset.
+	CountUsers(ctx context.Context, filter entity.UserFilter) (int64, error)
+
+	// LockUser stops a user from signing in until they are unlocked.
+	LockUser(ctx context.Context, userID uuid.UUID, reason string) error
+
+	// UnlockUser lets a user locked by an administrator sign in again.
+	UnlockUser(ctx context.Context, userID uuid.UUID) error
+
+	// UpdateUserRole changes the role a user's tokens are issued with.
+	UpdateUserRole(ctx context.Context, userID uuid.UUID, role string) error
+
+	// SoftDeleteUser marks a user deleted while keeping their data, returning false if they already were.
+	SoftDeleteUser(ctx context.Context, userID uuid.UUID) (bool, error)
+
+	// RestoreUser undoes a soft delete, returning false if the user was not deleted.
+	RestoreUser(ctx context.Context, userID uuid.UUID) (bool, error)
+
+	// CreateImpersonationToken generates an access token for an administrator acting as a user, and returns its expiry.
+	CreateImpersonationToken(ctx context.Context, impersonatorID string, email string, userID string, sessionID string, role string, duration time.Duration) (string, time.Time, error)
+
+	// CreateImpersonationSession stores the session an administrator acts as a user in.
+	CreateImpersonationSession(ctx context.Context, session *entity.Session) error
+
+	// CreateAuthEvent appends an event to the security audit log.
+	CreateAuthEvent(ctx context.Context, event *entity.AuthEvent) error
+
+	// ListAuthEvents returns one page of the audit log matching filter, newest first.
+	ListAuthEvents(ctx context.Context, filter entity.AuthEventFilter) ([]*entity.AuthEvent, error)
+
+	// CountAuthEvents returns how many audit log events match filter, ignoring its limit and offset.
+	CountAuthEvents(ctx context.Context, filter entity.AuthEventFilter) (int64, error)
+
+	// PruneAuthEvents deletes the audit log events older than before, returning how many were removed.
+	PruneAuthEvents(ctx context.Context, before time.Time) (int64, error)
+
+	// GetLoginFamiliarity counts the user's earlier successful logins from the device and location.
+	GetLoginFamiliarity(ctx context.Context, userID uuid.UUID, deviceFingerprint, location string) (*entity.LoginFamiliarity, error)
+
+	// ScheduleUserDeletion marks a user's account for erasure at scheduledFor, returning false if a deletion is already scheduled or the user was deleted.
+	ScheduleUserDeletion(ctx context.Context, userID uuid.UUID, scheduledFor time.Time) (bool, error)
+
+	// CancelUserDeletion clears a scheduled deletion, returning false if none was scheduled.
+	CancelUserDeletion(ctx context.Context, userID uuid.UUID) (bool, error)
+
+	// ListUsersDueForDeletion returns up to limit users whose deletion grace period ended before now.
+	ListUsersDueForDeletion(ctx context.Context, now time.Time, limit int) ([]*entity.User, error)
+
+	// EraseUser deletes the user and records the erasure the given services still have to report on, returning false if the deletion was cancelled meanwhile.
+	EraseUser(ctx context.Context, user *entity.User, services []string) (bool, error)
+
+	// ListUnpublishedErasures returns up to limit erasures whose user.deleted event has not been published.
+	ListUnpublishedErasures(ctx context.Context, limit int) ([]*entity.AccountErasure, error)
+
+	// MarkErasurePublished records that the user.deleted event of an erasure was published.
+	MarkErasurePublished(ctx context.Context, userID uuid.UUID) error
+
+	// GetAccountErasure returns the erasure of a deleted user with each service's progress.
+	GetAccountErasure(ctx context.Context, userID uuid.UUID) (*entity.AccountErasure, error)
+
+	// UpdateAccountErasureService stores a service's report on erasing a user, returning false if no erasure is recorded for the user.
+	UpdateAccountErasureService(ctx context.Context, userID uuid.UUID, service *entity.AccountErasureService) (bool, error)
+
+	// CreateDataExport stores a pending data export, or returns entity.ErrDataExportInProgress if the user already has one.
+	CreateDataExport(ctx context.Context, export *entity.DataExport) error
+
+	// GetDataExport retrieves a data export by ID, or entity.ErrDataExportNotFound.
+	GetDataExport(ctx context.Context, exportID uuid.UUID) (*entity.DataExport, error)
+
+	// ClaimDataExport marks the oldest pending export as building and returns it, or nil if there is none. Builds started before staleBefore are claimed again.
+	ClaimDataExport(ctx context.Context, staleBefore time.Time) (*entity.DataExport, error)
+
+	// CompleteDataExport stores the file, size, download token hash and expiry of a built export.
+	CompleteDataExport(ctx context.Context, export *entity.DataExport) error
+
+	// FailDataExport records why an export could not be built.
+	FailDataExport(ctx context.Context, exportID uuid.UUID, reason string, expiresAt time.Time) error
+
+	// DeleteExpiredDataExports removes the exports that expired before now or whose user was erased and returns them.
+	DeleteExpiredDataExports(ctx context.Context, now time.Time) ([]*entity.DataExport, error)
+
+	// CreateOrganization stores an organization with its creator as a member holding ownerRole.
+	CreateOrganization(ctx context.Context, organization *entity.Organization, ownerRole string) error
+
+	// GetOrganization retrieves an organization by ID, or entity.ErrOrganizationNotFound.
+	GetOrganization(ctx context.Context, organizationID uuid.UUID) (*entity.Organization, error)
+
+	// ListUserOrganizations returns the organizations a user is a member of, each with the user's role in it.
+	ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]*entity.Organization, error)
+
+	// GetOrganizationMember retrieves a user's membership of an organization, or entity.ErrNotOrganizationMember.
+	GetOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID) (*entity.OrganizationMember, error)
+
+	// ListOrganizationMembers returns the members of an organization.
+	ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]*entity.OrganizationMember, error)
+
+	// CountOrganizationOwners returns how many members own an organization.
+	CountOrganizationOwners(ctx context.Context, organizationID uuid.UUID) (int64, error)
+
+	// UpdateOrganizationMemberRole changes a member's role, or returns entity.ErrNotOrganizationMember.
+	UpdateOrganizationMemberRole(ctx context.Context, organizationID, userID uuid.UUID, role string) error
+
+	// RemoveOrganizationMember removes a user from an organization and stops their sessions acting for it.
+	RemoveOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID) error
+
+	// CreateOrganizationInvitation stores a pending invitation, revoking any still pending for the same address.
+	CreateOrganizationInvitation(ctx context.Context, invitation *entity.OrganizationInvitation) error
+
+	// GetOrganizationInvitation retrieves an invitation by ID, or entity.ErrInvitationNotFound.
+	GetOrganizationInvitation(ctx context.Context, invitationID uuid.UUID) (*entity.OrganizationInvitation, error)
+
+	// GetOrganizationInvitationByToken retrieves the invitation whose emailed token hashes to tokenHash, or entity.ErrInvitationNotFound.
+	GetOrganizationInvitationByToken(ctx context.Context, tokenHash string) (*entity.OrganizationInvitation, error)
+
+	// ListPendingOrganizationInvitations returns the invitations of an organization that have not been answered.
+	ListPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID) ([]*entity.OrganizationInvitation, error)
+
+	// AcceptOrganizationInvitation marks an invitation accepted and adds the user to its organization with the invited role.
+	AcceptOrganizationInvitation(ctx context.Context, invitation *entity.OrganizationInvitation, userID uuid.UUID) (*entity.OrganizationMember, error)
+
+	// RespondToOrganizationInvitation records that a pending invitation was declined or revoked, or returns entity.ErrInvitationInvalid.
+	RespondToOrganizationInvitation(ctx context.Context, invitationID uuid.UUID, status string) error
+
+	// SetSessionOrganization makes one of the user's sessions act for an organization, or for none when organizationID is nil.
+	SetSessionOrganization(ctx context.Context, sessionID, userID uuid.UUID, organizationID *uuid.UUID) error
+
+	// SubmitAgentVerification stores an agent's verification details as pending review, or returns entity.ErrAgentVerificationInReview if one is pending or approved.
+	SubmitAgentVerification(ctx context.Context, verification *entity.AgentVerification) error
+
+	// GetAgentVerification retrieves an agent's verification, or entity.ErrAgentVerificationNotFound.
+	GetAgentVerification(ctx context.Context, userID uuid.UUID) (*entity.AgentVerification, error)
+
+	// ListAgentVerifications returns one page of the verifications in a status, oldest submission first.
+	ListAgentVerifications(ctx context.Context, filter entity.AgentVerificationFilter) ([]*entity.AgentVerification, error)
+
+	// CountAgentVerifications returns how many verifications are in a status.
+	CountAgentVerifications(ctx context.Context, status string) (int64, error)
+
+	// ReviewAgentVerification records the decision on a pending verification and updates the verified agent badge, or returns entity.ErrAgentVerificationNotPending.
+	ReviewAgentVerification(ctx context.Context, userID uuid.UUID, status string, note string, reviewerID uuid.UUID) error
+
+	// UploadAgentDocument uploads a verification document to private storage and records it.
+	UploadAgentDocument(ctx context.Context, content io.Reader, document *entity.AgentDocument) error
+
+	// ListAgentDocuments returns the verification documents an agent uploaded.
+	ListAgentDocuments(ctx context.Context, userID uuid.UUID) ([]*entity.AgentDocument, error)
+
+	// CreateAPIKey stores a new API key, of which only the hash is kept.
+	CreateAPIKey(ctx context.Context, key *entity.APIKey) error
+
+	// GetAPIKey retrieves an API key by its ID, or entity.ErrAPIKeyNotFound.
+	GetAPIKey(ctx context.Context, keyID uuid.UUID) (*entity.APIKey, error)
+
+	// GetAPIKeyByHash retrieves an API key by the hash of the key, or entity.ErrAPIKeyNotFound.
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error)
+
+	// ListAPIKeys returns a user's own API keys, or an organization's when organizationID is set, newest first.
+	ListAPIKeys(ctx context.Context, userID uuid.UUID, organizationID *uuid.UUID) ([]*entity.APIKey, error)
+
+	// CountActiveAPIKeys returns how many unrevoked, unexpired keys a user, or an organization when organizationID is set, has.
+	CountActiveAPIKeys(ctx context.Context, userID uuid.UUID, organizationID *uuid.UUID) (int64, error)
+
+	// RevokeAPIKey revokes an API key and the access tokens exchanged for it, or returns entity.ErrAPIKeyNotFound if it was already revoked.
+	RevokeAPIKey(ctx context.Context, key *entity.APIKey) error
+
+	// TouchAPIKey records that an API key was just used.
+	TouchAPIKey(ctx context.Context, keyID uuid.UUID) error
+
+	// CreateAPIKeyToken generates a short-lived access token for an API key acting as its user, and returns its expiry.
+	CreateAPIKeyToken(ctx context.Context, key *entity.APIKey, user *entity.User, organizationRole string, duration time.Duration) (string, time.Time, error)
 }
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleUserDeletion marks a user's account for erasure at scheduledFor,
+// returning false if a deletion is already scheduled or the user was deleted.
+func (r *UserRepository) ScheduleUserDeletion(ctx context.Context, userID uuid.UUID, scheduledFor time.Time) (bool, error) {
+	rows, err := r.store.ScheduleUserDeletion(ctx, db.ScheduleUserDeletionParams{
+		ID:                   userID,
+		DeletionScheduledFor: sql.NullTime{Time: scheduledFor, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to schedule account deletion: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// CancelUserDeletion clears a scheduled deletion, returning false if none was scheduled.
+func (r *UserRepository) CancelUserDeletion(ctx context.Context, userID uuid.UUID) (bool, error) {
+	rows, err := r.store.CancelUserDeletion(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel account deletion: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// ListUsersDueForDeletion returns up to limit users whose deletion grace period ended before now.
+func (r *UserRepository) ListUsersDueForDeletion(ctx context.Context, now time.Time, limit int) ([]*entity.User, error) {
+	users, err := r.store.ListUsersDueForDeletion(ctx, db.ListUsersDueForDeletionParams{
+		DeletionScheduledFor: sql.NullTime{Time: now, Valid: true},
+		Limit:                int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users due for deletion: %w", err)
+	}
+
+	result := make([]*entity.User, 0, len(users))
+	for _, user := range users {
+		result = append(result, mapUser(user))
+	}
+
+	return result, nil
+}
+
+// EraseUser deletes the user and records the erasure the given services still
+// have to report on. It returns false if the deletion was cancelled meanwhile.
+func (r *UserRepository) EraseUser(ctx context.Context, user *entity.User, services []string) (bool, error) {
+	requestedAt := time.Now()
+	if user.DeletionRequestedAt != nil {
+		requestedAt = *user.DeletionRequestedAt
+	}
+
+	err := r.store.EraseUserTx(ctx, db.EraseUserTxParams{
+		UserID:      user.ID,
+		RequestedAt: requestedAt,
+		Services:    services,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to erase user: %w", err)
+	}
+
+	return true, nil
+}
+
+// ListUnpublishedErasures returns up to limit erasures whose user.deleted event has not been published.
+func (r *UserRepository) ListUnpublishedErasures(ctx context.Context, limit int) ([]*entity.AccountErasure, error) {
+	erasures, err := r.store.ListUnpublishedErasures(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unpublished erasures: %w", err)
+	}
+
+	result := make([]*entity.AccountErasure, 0, len(erasures))
+	for _, erasure := range erasures {
+		result = append(result, mapAccountErasure(erasure))
+	}
+
+	return result, nil
+}
+
+// MarkErasurePublished records that the user.deleted event of an erasure was published.
+func (r *UserRepository) MarkErasurePublished(ctx context.Context, userID uuid.UUID) error {
+	if err := r.store.MarkErasurePublished(ctx, userID); err != nil {
+		return fmt.Errorf("failed to mark erasure published: %w", err)
+	}
+
+	return nil
+}
+
+// GetAccountErasure returns the erasure of a deleted user with each service's progress.
+func (r *UserRepository) GetAccountErasure(ctx context.Context, userID uuid.UUID) (*entity.AccountErasure, error) {
+	erasure, err := r.store.GetAccountErasure(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrErasureNotFound
+		}
+		return nil, fmt.Errorf("failed to get account erasure: %w", err)
+	}
+
+	services, err := r.store.ListAccountErasureServices(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account erasure services: %w", err)
+	}
+
+	result := mapAccountErasure(erasure)
+	for _, service := range services {
+		result.Services = append(result.Services, &entity.AccountErasureService{
+			Service:   service.Service,
+			Status:    service.Status,
+			Items:     service.Items,
+			Detail:    service.Detail.String,
+			UpdatedAt: service.UpdatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// UpdateAccountErasureService stores a service's report on erasing a user,
+// returning false if no erasure is recorded for the user.
+func (r *UserRepository) UpdateAccountErasureService(ctx context.Context, userID uuid.UUID, service *entity.AccountErasureService) (bool, error) {
+	rows, err := r.store.UpdateAccountErasureService(ctx, db.UpdateAccountErasureServiceParams{
+		UserID:  userID,
+		Service: service.Service,
+		Status:  service.Status,
+		Items:   service.Items,
+		Detail:  nullString(service.Detail),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to update account erasure: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+func mapAccountErasure(erasure db.AccountErasures) *entity.AccountErasure {
+	deletedAt := erasure.DeletedAt
+	result := &entity.AccountErasure{
+		UserID:      erasure.UserID,
+		RequestedAt: erasure.RequestedAt,
+		DeletedAt:   &deletedAt,
+	}
+	if erasure.PublishedAt.Valid {
+		result.PublishedAt = &erasure.PublishedAt.Time
+	}
+
+	return result
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEraseUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	requestedAt := time.Now().Add(-30 * 24 * time.Hour)
+	user := &entity.User{ID: uuid.New(), DeletionRequestedAt: &requestedAt}
+	services := []string{"property", "messaging"}
+
+	store.EXPECT().
+		EraseUserTx(gomock.Any(), db.EraseUserTxParams{
+			UserID:      user.ID,
+			RequestedAt: requestedAt,
+			Services:    services,
+		}).
+		Return(nil)
+
+	erased, err := repo.EraseUser(context.Background(), user, services)
+	require.NoError(t, err)
+	require.True(t, erased)
+}
+
+func TestEraseUserCancelledMeanwhile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	store.EXPECT().
+		EraseUserTx(gomock.Any(), gomock.Any()).
+		Return(sql.ErrNoRows)
+
+	erased, err := repo.EraseUser(context.Background(), &entity.User{ID: uuid.New()}, nil)
+	require.NoError(t, err)
+	require.False(t, erased)
+}
+
+func TestGetAccountErasure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	userID := uuid.New()
+	deletedAt := time.Now()
+
+	store.EXPECT().
+		GetAccountErasure(gomock.Any(), userID).
+		Return(db.AccountErasures{UserID: userID, RequestedAt: deletedAt.Add(-time.Hour), DeletedAt: deletedAt}, nil)
+	store.EXPECT().
+		ListAccountErasureServices(gomock.Any(), userID).
+		Return([]db.AccountErasureServices{
+			{UserID: userID, Service: "messaging", Status: entity.ErasurePending},
+			{UserID: userID, Service: "property", Status: "failed", Detail: sql.NullString{String: "failed to delete listings", Valid: true}},
+		}, nil)
+
+	erasure, err := repo.GetAccountErasure(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, userID, erasure.UserID)
+	require.Nil(t, erasure.PublishedAt)
+	require.Len(t, erasure.Services, 2)
+	require.Equal(t, "failed to delete listings", erasure.Services[1].Detail)
+	require.Equal(t, entity.ErasureStateInProgress, erasure.State())
+}
+
+func TestGetAccountErasureNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	store.EXPECT().
+		GetAccountErasure(gomock.Any(), gomock.Any()).
+		Return(db.AccountErasures{}, sql.ErrNoRows)
+
+	_, err := repo.GetAccountErasure(context.Background(), uuid.New())
+	require.ErrorIs(t, err, entity.ErrErasureNotFound)
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// ListUsers returns one page of the users matching filter, newest first.
+func (r *UserRepository) ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error) {
+	users, err := r.store.ListUsers(ctx, db.ListUsersParams{
+		Email:     nullString(filter.Email),
+		Name:      nullString(filter.Name),
+		Role:      nullString(filter.Role),
+		Provider:  nullString(filter.Provider),
+		IsActive:  nullBool(filter.IsActive),
+		Deleted:   nullBool(filter.Deleted),
+		RowLimit:  int32(filter.Limit),
+		RowOffset: int32(filter.Offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	result := make([]*entity.User, 0, len(users))
+	for _, user := range users {
+		result = append(result, mapUser(user))
+	}
+
+	return result, nil
+}
+
+// CountUsers returns how many users match filter, ignoring its limit and offset.
+func (r *UserRepository) CountUsers(ctx context.Context, filter entity.UserFilter) (int64, error) {
+	count, err := r.store.CountUsers(ctx, db.CountUsersParams{
+		Email:    nullString(filter.Email),
+		Name:     nullString(filter.Name),
+		Role:     nullString(filter.Role),
+		Provider: nullString(filter.Provider),
+		IsActive: nullBool(filter.IsActive),
+		Deleted:  nullBool(filter.Deleted),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	return count, nil
+}
+
+// LockUser stops a user from signing in until they are unlocked.
+func (r *UserRepository) LockUser(ctx context.Context, userID uuid.UUID, reason string) error {
+	err := r.store.LockUser(ctx, db.LockUserParams{
+		ID:           userID,
+		LockedReason: nullString(reason),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to lock user: %w", err)
+	}
+
+	return nil
+}
+
+// UnlockUser lets a user locked by an administrator sign in again.
+func (r *UserRepository) UnlockUser(ctx context.Context, userID uuid.UUID) error {
+	if err := r.store.UnlockUser(ctx, userID); err != nil {
+		return fmt.Errorf("failed to unlock user: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateUserRole changes the role a user's tokens are issued with.
+func (r *UserRepository) UpdateUserRole(ctx context.Context, userID uuid.UUID, role string) error {
+	err := r.store.UpdateUserRole(ctx, db.UpdateUserRoleParams{
+		ID:   userID,
+		Role: nullString(role),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update user role: %w", err)
+	}
+
+	return nil
+}
+
+// SoftDeleteUser marks a user deleted while keeping their data, returning
+// false if they already were.
+func (r *UserRepository) SoftDeleteUser(ctx context.Context, userID uuid.UUID) (bool, error) {
+	rows, err := r.store.SoftDeleteUser(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// RestoreUser undoes a soft delete, returning false if the user was not deleted.
+func (r *UserRepository) RestoreUser(ctx context.Context, userID uuid.UUID) (bool, error) {
+	rows, err := r.store.RestoreUser(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to restore user: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func nullBool(value *bool) sql.NullBool {
+	if value == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *value, Valid: true}
+}
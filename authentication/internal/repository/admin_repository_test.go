@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	active := true
+	lockedAt := time.Now()
+	row := db.Users{
+		ID:           uuid.New(),
+		Name:         "Locked User",
+		Email:        "locked@example.com",
+		Role:         sql.NullString{String: "buyer", Valid: true},
+		IsActive:     sql.NullBool{Bool: true, Valid: true},
+		LockedAt:     sql.NullTime{Time: lockedAt, Valid: true},
+		LockedReason: sql.NullString{String: "fraud", Valid: true},
+	}
+
+	store.EXPECT().
+		ListUsers(gomock.Any(), db.ListUsersParams{
+			Email:     sql.NullString{String: "locked", Valid: true},
+			Role:      sql.NullString{String: "buyer", Valid: true},
+			IsActive:  sql.NullBool{Bool: true, Valid: true},
+			RowLimit:  20,
+			RowOffset: 40,
+		}).
+		Return([]db.Users{row}, nil)
+
+	users, err := repo.ListUsers(context.Background(), entity.UserFilter{
+		Email:    "locked",
+		Role:     "buyer",
+		IsActive: &active,
+		Limit:    20,
+		Offset:   40,
+	})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, row.ID, users[0].ID)
+	require.True(t, users[0].IsActive)
+	require.Equal(t, "fraud", users[0].LockedReason)
+	require.NotNil(t, users[0].LockedAt)
+	require.Nil(t, users[0].DeletedAt)
+	require.ErrorIs(t, users[0].CanSignIn(), entity.ErrAccountLocked)
+}
+
+func TestSoftDeleteUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	userID := uuid.New()
+
+	gomock.InOrder(
+		store.EXPECT().SoftDeleteUser(gomock.Any(), userID).Return(int64(1), nil),
+		store.EXPECT().SoftDeleteUser(gomock.Any(), userID).Return(int64(0), nil),
+	)
+
+	deleted, err := repo.SoftDeleteUser(context.Background(), userID)
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	// A user who is already deleted is left untouched
+	deleted, err = repo.SoftDeleteUser(context.Background(), userID)
+	require.NoError(t, err)
+	require.False(t, deleted)
+}
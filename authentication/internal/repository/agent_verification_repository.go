@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/cloudinary/cloudinary-go"
+	"github.com/cloudinary/cloudinary-go/api"
+	"github.com/cloudinary/cloudinary-go/api/uploader"
+	"github.com/demola234/authentication/config"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// agentDocumentFolder is the Cloudinary folder agent verification documents are kept in
+const agentDocumentFolder = "agent-verifications"
+
+// SubmitAgentVerification stores an agent's verification details as pending
+// review. It returns entity.ErrAgentVerificationInReview if the agent already
+// has one that is pending or approved.
+func (r *UserRepository) SubmitAgentVerification(ctx context.Context, verification *entity.AgentVerification) error {
+	submitted, err := r.store.SubmitAgentVerification(ctx, db.SubmitAgentVerificationParams{
+		UserID:             verification.UserID,
+		LicenseNumber:      verification.LicenseNumber,
+		BusinessName:       verification.BusinessName,
+		BusinessAddress:    verification.BusinessAddress,
+		BusinessPhone:      nullString(verification.BusinessPhone),
+		RegistrationNumber: nullString(verification.RegistrationNumber),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.ErrAgentVerificationInReview
+		}
+		return fmt.Errorf("failed to submit agent verification: %w", err)
+	}
+
+	verification.Status = submitted.Status
+	verification.ReviewNote = ""
+	verification.ReviewedBy = nil
+	verification.ReviewedAt = nil
+	verification.SubmittedAt = submitted.SubmittedAt
+	verification.UpdatedAt = submitted.UpdatedAt
+
+	return nil
+}
+
+// GetAgentVerification retrieves an agent's verification, or entity.ErrAgentVerificationNotFound.
+func (r *UserRepository) GetAgentVerification(ctx context.Context, userID uuid.UUID) (*entity.AgentVerification, error) {
+	verification, err := r.store.GetAgentVerification(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrAgentVerificationNotFound
+		}
+		return nil, fmt.Errorf("failed to get agent verification: %w", err)
+	}
+
+	return mapAgentVerification(verification), nil
+}
+
+// ListAgentVerifications returns one page of the verifications in a status,
+// oldest submission first.
+func (r *UserRepository) ListAgentVerifications(ctx context.Context, filter entity.AgentVerificationFilter) ([]*entity.AgentVerification, error) {
+	verifications, err := r.store.ListAgentVerifications(ctx, db.ListAgentVerificationsParams{
+		Status: filter.Status,
+		Limit:  int32(filter.Limit),
+		Offset: int32(filter.Offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agent verifications: %w", err)
+	}
+
+	result := make([]*entity.AgentVerification, 0, len(verifications))
+	for _, verification := range verifications {
+		result = append(result, mapAgentVerification(db.GetAgentVerificationRow(verification)))
+	}
+
+	return result, nil
+}
+
+// CountAgentVerifications returns how many verifications are in a status.
+func (r *UserRepository) CountAgentVerifications(ctx context.Context, status string) (int64, error) {
+	count, err := r.store.CountAgentVerifications(ctx, status)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count agent verifications: %w", err)
+	}
+
+	return count, nil
+}
+
+// ReviewAgentVerification records an administrator's decision on a pending
+// verification and updates the agent's verified badge to match. It returns
+// entity.ErrAgentVerificationNotPending if the verification is not pending.
+func (r *UserRepository) ReviewAgentVerification(ctx context.Context, userID uuid.UUID, status string, note string, reviewerID uuid.UUID) error {
+	err := r.store.ReviewAgentVerificationTx(ctx, db.ReviewAgentVerificationTxParams{
+		Review: db.ReviewAgentVerificationParams{
+			UserID:     userID,
+			Status:     status,
+			ReviewNote: nullString(note),
+			ReviewedBy: uuid.NullUUID{UUID: reviewerID, Valid: true},
+		},
+		Approved: status == entity.AgentVerificationApproved,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.ErrAgentVerificationNotPending
+		}
+		return fmt.Errorf("failed to review agent verification: %w", err)
+	}
+
+	return nil
+}
+
+// UploadAgentDocument uploads a verification document as an authenticated
+// Cloudinary asset, which can only be fetched with the signed URL stored for it.
+func (r *UserRepository) UploadAgentDocument(ctx context.Context, content io.Reader, document *entity.AgentDocument) error {
+	configs, err := config.LoadConfig(".")
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	cld, err := cloudinary.NewFromURL(configs.CloudinaryUrl)
+	if err != nil {
+		return fmt.Errorf("failed to create cloudinary client: %w", err)
+	}
+
+	result, err := cld.Upload.Upload(ctx, content, uploader.UploadParams{
+		PublicID:     document.ID.String(),
+		Folder:       agentDocumentFolder + "/" + document.UserID.String(),
+		Type:         api.Authenticated,
+		ResourceType: "auto",
+		Tags:         []string{document.UserID.String(), document.DocumentType},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload agent document: %w", err)
+	}
+
+	created, err := r.store.CreateAgentVerificationDocument(ctx, db.CreateAgentVerificationDocumentParams{
+		ID:           document.ID,
+		UserID:       document.UserID,
+		DocumentType: document.DocumentType,
+		Url:          result.SecureURL,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store agent document: %w", err)
+	}
+
+	*document = *mapAgentDocument(created)
+
+	return nil
+}
+
+// ListAgentDocuments returns the verification documents an agent uploaded, oldest first.
+func (r *UserRepository) ListAgentDocuments(ctx context.Context, userID uuid.UUID) ([]*entity.AgentDocument, error) {
+	documents, err := r.store.ListAgentVerificationDocuments(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agent documents: %w", err)
+	}
+
+	result := make([]*entity.AgentDocument, 0, len(documents))
+	for _, document := range documents {
+		result = append(result, mapAgentDocument(document))
+	}
+
+	return result, nil
+}
+
+func mapAgentVerification(verification db.GetAgentVerificationRow) *entity.AgentVerification {
+	result := &entity.AgentVerification{
+		UserID:             verification.UserID,
+		Email:              verification.Email,
+		FullName:           verification.Name,
+		LicenseNumber:      verification.LicenseNumber,
+		BusinessName:       verification.BusinessName,
+		BusinessAddress:    verification.BusinessAddress,
+		BusinessPhone:      verification.BusinessPhone.String,
+		RegistrationNumber: verification.RegistrationNumber.String,
+		Status:             verification.Status,
+		ReviewNote:         verification.ReviewNote.String,
+		ReviewedBy:         uuidPtr(verification.ReviewedBy),
+		SubmittedAt:        verification.SubmittedAt,
+		UpdatedAt:          verification.UpdatedAt,
+	}
+	if verification.ReviewedAt.Valid {
+		result.ReviewedAt = &verification.ReviewedAt.Time
+	}
+
+	return result
+}
+
+func mapAgentDocument(document db.AgentVerificationDocuments) *entity.AgentDocument {
+	return &entity.AgentDocument{
+		ID:           document.ID,
+		UserID:       document.UserID,
+		DocumentType: document.DocumentType,
+		URL:          document.Url,
+		UploadedAt:   document.UploadedAt,
+	}
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// CreateAPIKey stores a new API key. Only the hash of the key is stored.
+func (r *UserRepository) CreateAPIKey(ctx context.Context, key *entity.APIKey) error {
+	created, err := r.store.CreateAPIKey(ctx, db.CreateAPIKeyParams{
+		ID:             key.ID,
+		UserID:         key.UserID,
+		OrganizationID: nullUUID(key.OrganizationID),
+		Name:           key.Name,
+		Prefix:         key.Prefix,
+		KeyHash:        key.KeyHash,
+		Scopes:         key.Scopes,
+		ExpiresAt:      nullTime(key.ExpiresAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	key.CreatedAt = created.CreatedAt
+
+	return nil
+}
+
+// GetAPIKey retrieves an API key by its ID, or entity.ErrAPIKeyNotFound.
+func (r *UserRepository) GetAPIKey(ctx context.Context, keyID uuid.UUID) (*entity.APIKey, error) {
+	key, err := r.store.GetAPIKey(ctx, keyID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	return mapAPIKey(key), nil
+}
+
+// GetAPIKeyByHash retrieves an API key by the hash of the key, or entity.ErrAPIKeyNotFound.
+func (r *UserRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	key, err := r.store.GetAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	return mapAPIKey(key), nil
+}
+
+// ListAPIKeys returns a user's own API keys, or an organization's when
+// organizationID is set, newest first. Revoked and expired keys are included.
+func (r *UserRepository) ListAPIKeys(ctx context.Context, userID uuid.UUID, organizationID *uuid.UUID) ([]*entity.APIKey, error) {
+	var keys []db.ApiKeys
+	var err error
+	if organizationID != nil {
+		keys, err = r.store.ListOrganizationAPIKeys(ctx, nullUUID(organizationID))
+	} else {
+		keys, err = r.store.ListUserAPIKeys(ctx, userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+
+	result := make([]*entity.APIKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, mapAPIKey(key))
+	}
+
+	return result, nil
+}
+
+// CountActiveAPIKeys returns how many unrevoked, unexpired keys a user, or an
+// organization when organizationID is set, has.
+func (r *UserRepository) CountActiveAPIKeys(ctx context.Context, userID uuid.UUID, organizationID *uuid.UUID) (int64, error) {
+	var count int64
+	var err error
+	if organizationID != nil {
+		count, err = r.store.CountActiveOrganizationAPIKeys(ctx, nullUUID(organizationID))
+	} else {
+		count, err = r.store.CountActiveUserAPIKeys(ctx, userID)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to count api keys: %w", err)
+	}
+
+	return count, nil
+}
+
+// RevokeAPIKey revokes an API key and adds it to the revocation list checked by
+// the gateway, so the access tokens exchanged for it stop working too. It
+// returns entity.ErrAPIKeyNotFound if the key was already revoked.
+func (r *UserRepository) RevokeAPIKey(ctx context.Context, key *entity.APIKey) error {
+	err := r.store.RevokeAPIKeyTx(ctx, db.RevokeAPIKeyTxParams{
+		Key: db.ApiKeys{
+			ID:     key.ID,
+			UserID: key.UserID,
+		},
+		Reason: entity.SessionRevokedAPIKey,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.ErrAPIKeyNotFound
+		}
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	return nil
+}
+
+// TouchAPIKey records that an API key was just used.
+func (r *UserRepository) TouchAPIKey(ctx context.Context, keyID uuid.UUID) error {
+	if err := r.store.TouchAPIKey(ctx, keyID); err != nil {
+		return fmt.Errorf("failed to update api key last use: %w", err)
+	}
+
+	return nil
+}
+
+// CreateAPIKeyToken generates an access token for an API key acting as the
+// user who created it. The key's ID is used as the token's session so that
+// revoking the key revokes the token.
+func (r *UserRepository) CreateAPIKeyToken(ctx context.Context, key *entity.APIKey, user *entity.User, organizationRole string, duration time.Duration) (string, time.Time, error) {
+	tokenMaker := token.NewPasetoV4Maker(r.keyRing)
+
+	var organizationID string
+	if key.OrganizationID != nil {
+		organizationID = key.OrganizationID.String()
+	}
+
+	accessToken, payload, err := tokenMaker.CreateAPIKeyToken(key.ID.String(), user.Email, user.ID.String(), []string{user.Role}, key.Scopes, organizationID, organizationRole, duration)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create api key token: %w", err)
+	}
+
+	return accessToken, payload.ExpiredAt, nil
+}
+
+func mapAPIKey(key db.ApiKeys) *entity.APIKey {
+	result := &entity.APIKey{
+		ID:             key.ID,
+		UserID:         key.UserID,
+		OrganizationID: uuidPtr(key.OrganizationID),
+		Name:           key.Name,
+		Prefix:         key.Prefix,
+		KeyHash:        key.KeyHash,
+		Scopes:         key.Scopes,
+		CreatedAt:      key.CreatedAt,
+	}
+	if key.ExpiresAt.Valid {
+		result.ExpiresAt = &key.ExpiresAt.Time
+	}
+	if key.LastUsedAt.Valid {
+		result.LastUsedAt = &key.LastUsedAt.Time
+	}
+	if key.RevokedAt.Valid {
+		result.RevokedAt = &key.RevokedAt.Time
+	}
+
+	return result
+}
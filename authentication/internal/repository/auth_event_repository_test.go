@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAuthEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	userID := uuid.New()
+	event := &entity.AuthEvent{
+		ID:        uuid.New(),
+		EventType: entity.AuthEventLogin,
+		Outcome:   entity.AuthEventFailure,
+		UserID:    &userID,
+		Email:     "user@example.com",
+		IPAddress: "203.0.113.7",
+		Metadata:  map[string]string{"failure_reason": "invalid_password"},
+	}
+
+	store.EXPECT().
+		CreateAuthEvent(gomock.Any(), db.CreateAuthEventParams{
+			ID:        event.ID,
+			EventType: entity.AuthEventLogin,
+			Outcome:   entity.AuthEventFailure,
+			UserID:    uuid.NullUUID{UUID: userID, Valid: true},
+			Email:     sql.NullString{String: "user@example.com", Valid: true},
+			IpAddress: sql.NullString{String: "203.0.113.7", Valid: true},
+			Metadata:  json.RawMessage(`{"failure_reason":"invalid_password"}`),
+		}).
+		Return(nil)
+
+	err := repo.CreateAuthEvent(context.Background(), event)
+	require.NoError(t, err)
+}
+
+func TestGetLoginHistoryReadsAuthEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	userID := uuid.New()
+	impersonatorID := uuid.New()
+	createdAt := time.Now()
+
+	store.EXPECT().
+		ListLoginEvents(gomock.Any(), db.ListLoginEventsParams{
+			UserID:     uuid.NullUUID{UUID: userID, Valid: true},
+			EventTypes: entity.LoginEventTypes,
+			RowLimit:   10,
+		}).
+		Return([]db.AuthEvents{
+			{
+				ID:        uuid.New(),
+				EventType: entity.AuthEventImpersonation,
+				Outcome:   entity.AuthEventSuccess,
+				ActorID:   uuid.NullUUID{UUID: impersonatorID, Valid: true},
+				UserID:    uuid.NullUUID{UUID: userID, Valid: true},
+				Metadata:  json.RawMessage(`{"reason":"support ticket 42"}`),
+				CreatedAt: createdAt,
+			},
+			{
+				ID:        uuid.New(),
+				EventType: entity.AuthEventLogin,
+				Outcome:   entity.AuthEventFailure,
+				UserID:    uuid.NullUUID{UUID: userID, Valid: true},
+				IpAddress: sql.NullString{String: "203.0.113.7", Valid: true},
+				Device:    sql.NullString{String: "Chrome on macOS", Valid: true},
+				Location:  sql.NullString{String: "Lagos, Nigeria", Valid: true},
+				Metadata:  json.RawMessage(`{"failure_reason":"invalid_password"}`),
+			},
+		}, nil)
+
+	history, err := repo.GetLoginHistory(context.Background(), userID, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, impersonatorID, *history[0].ImpersonatorID)
+	require.Equal(t, "support ticket 42", history[0].ImpersonationReason)
+	require.Equal(t, createdAt, history[0].Timestamp)
+	require.True(t, history[0].Success)
+	require.Nil(t, history[1].ImpersonatorID)
+	require.False(t, history[1].Success)
+	require.Equal(t, "203.0.113.7", history[1].IpAddress)
+	require.Equal(t, "Chrome on macOS", history[1].Device)
+	require.Equal(t, "Lagos, Nigeria", history[1].Location)
+}
+
+func TestGetLoginFamiliarity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	userID := uuid.New()
+
+	store.EXPECT().
+		GetLoginFamiliarity(gomock.Any(), db.GetLoginFamiliarityParams{
+			UserID:            uuid.NullUUID{UUID: userID, Valid: true},
+			DeviceFingerprint: "fingerprint",
+			Location:          "Lagos, Nigeria",
+		}).
+		Return(db.GetLoginFamiliarityRow{PreviousLogins: 5, DeviceLogins: 2}, nil)
+
+	familiarity, err := repo.GetLoginFamiliarity(context.Background(), userID, "fingerprint", "Lagos, Nigeria")
+	require.NoError(t, err)
+	require.Equal(t, &entity.LoginFamiliarity{PreviousLogins: 5, DeviceLogins: 2}, familiarity)
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+)
+
+// GetAuthThrottle fetches the failure counter for a key, or nil if it has none.
+func (r *UserRepository) GetAuthThrottle(ctx context.Context, key entity.ThrottleKey) (*entity.AuthThrottle, error) {
+	throttle, err := r.store.GetAuthThrottle(ctx, db.GetAuthThrottleParams{
+		Scope:       key.Scope,
+		SubjectType: key.SubjectType,
+		Subject:     key.Subject,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve auth throttle: %w", err)
+	}
+
+	return mapAuthThrottle(throttle), nil
+}
+
+// RecordAuthFailure increments the failure counter for a key.
+func (r *UserRepository) RecordAuthFailure(ctx context.Context, key entity.ThrottleKey, windowStart time.Time) (*entity.AuthThrottle, error) {
+	throttle, err := r.store.RecordAuthFailure(ctx, db.RecordAuthFailureParams{
+		Scope:       key.Scope,
+		SubjectType: key.SubjectType,
+		Subject:     key.Subject,
+		WindowStart: windowStart,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record auth failure: %w", err)
+	}
+
+	return mapAuthThrottle(throttle), nil
+}
+
+// LockAuthThrottle locks a key until the given time.
+func (r *UserRepository) LockAuthThrottle(ctx context.Context, key entity.ThrottleKey, until time.Time) (*entity.AuthThrottle, error) {
+	throttle, err := r.store.LockAuthThrottle(ctx, db.LockAuthThrottleParams{
+		Scope:       key.Scope,
+		SubjectType: key.SubjectType,
+		Subject:     key.Subject,
+		LockedUntil: sql.NullTime{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock auth throttle: %w", err)
+	}
+
+	return mapAuthThrottle(throttle), nil
+}
+
+// ResetAuthThrottle clears the failure counter for a key.
+func (r *UserRepository) ResetAuthThrottle(ctx context.Context, key entity.ThrottleKey) error {
+	err := r.store.DeleteAuthThrottle(ctx, db.DeleteAuthThrottleParams{
+		Scope:       key.Scope,
+		SubjectType: key.SubjectType,
+		Subject:     key.Subject,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset auth throttle: %w", err)
+	}
+
+	return nil
+}
+
+// UnlockAccount clears every failure counter and lockout held against an email.
+func (r *UserRepository) UnlockAccount(ctx context.Context, email string) error {
+	err := r.store.DeleteAuthThrottlesBySubject(ctx, db.DeleteAuthThrottlesBySubjectParams{
+		SubjectType: entity.ThrottleSubjectAccount,
+		Subject:     email,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to unlock account: %w", err)
+	}
+
+	return nil
+}
+
+func mapAuthThrottle(throttle db.AuthThrottles) *entity.AuthThrottle {
+	result := &entity.AuthThrottle{
+		ThrottleKey: entity.ThrottleKey{
+			Scope:       throttle.Scope,
+			SubjectType: throttle.SubjectType,
+			Subject:     throttle.Subject,
+		},
+		FailedAttempts: int(throttle.FailedAttempts),
+		LockoutCount:   int(throttle.LockoutCount),
+		LastFailedAt:   throttle.LastFailedAt,
+	}
+
+	if throttle.LockedUntil.Valid {
+		result.LockedUntil = &throttle.LockedUntil.Time
+	}
+
+	return result
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+const dataExportsUnfinishedUserKey = "data_exports_unfinished_user_key"
+
+// CreateDataExport stores a pending data export. A user has at most one
+// unfinished export, so a second request returns entity.ErrDataExportInProgress.
+func (r *UserRepository) CreateDataExport(ctx context.Context, export *entity.DataExport) error {
+	created, err := r.store.CreateDataExport(ctx, db.CreateDataExportParams{
+		ID:     export.ID,
+		UserID: export.UserID,
+	})
+	if err != nil {
+		if isUniqueViolation(err, dataExportsUnfinishedUserKey) {
+			return entity.ErrDataExportInProgress
+		}
+		return fmt.Errorf("failed to create data export: %w", err)
+	}
+
+	*export = *mapDataExport(created)
+
+	return nil
+}
+
+// GetDataExport retrieves a data export by ID, or entity.ErrDataExportNotFound.
+func (r *UserRepository) GetDataExport(ctx context.Context, exportID uuid.UUID) (*entity.DataExport, error) {
+	export, err := r.store.GetDataExport(ctx, exportID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrDataExportNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve data export: %w", err)
+	}
+
+	return mapDataExport(export), nil
+}
+
+// ClaimDataExport marks the oldest pending export as building and returns it,
+// or nil if there is none. Concurrent workers never claim the same export.
+func (r *UserRepository) ClaimDataExport(ctx context.Context, staleBefore time.Time) (*entity.DataExport, error) {
+	export, err := r.store.ClaimDataExport(ctx, sql.NullTime{Time: staleBefore, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to claim data export: %w", err)
+	}
+
+	return mapDataExport(export), nil
+}
+
+// CompleteDataExport stores the file, size, download token hash and expiry of
+// a built export and marks it ready.
+func (r *UserRepository) CompleteDataExport(ctx context.Context, export *entity.DataExport) error {
+	err := r.store.CompleteDataExport(ctx, db.CompleteDataExportParams{
+		ID:                export.ID,
+		FileName:          nullString(export.FileName),
+		SizeBytes:         export.SizeBytes,
+		DownloadTokenHash: nullString(export.DownloadTokenHash),
+		ExpiresAt:         nullTime(export.ExpiresAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete data export: %w", err)
+	}
+
+	return nil
+}
+
+// FailDataExport records why an export could not be built.
+func (r *UserRepository) FailDataExport(ctx context.Context, exportID uuid.UUID, reason string, expiresAt time.Time) error {
+	err := r.store.FailDataExport(ctx, db.FailDataExportParams{
+		ID:        exportID,
+		Error:     nullString(reason),
+		ExpiresAt: sql.NullTime{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record data export failure: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredDataExports removes the exports that expired before now or
+// whose user was erased, returning them so their files can be removed too.
+func (r *UserRepository) DeleteExpiredDataExports(ctx context.Context, now time.Time) ([]*entity.DataExport, error) {
+	exports, err := r.store.DeleteExpiredDataExports(ctx, sql.NullTime{Time: now, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete expired data exports: %w", err)
+	}
+
+	result := make([]*entity.DataExport, 0, len(exports))
+	for _, export := range exports {
+		result = append(result, mapDataExport(export))
+	}
+
+	return result, nil
+}
+
+func mapDataExport(export db.DataExports) *entity.DataExport {
+	result := &entity.DataExport{
+		ID:                export.ID,
+		UserID:            export.UserID,
+		Status:            export.Status,
+		FileName:          export.FileName.String,
+		SizeBytes:         export.SizeBytes,
+		DownloadTokenHash: export.DownloadTokenHash.String,
+		Error:             export.Error.String,
+		CreatedAt:         export.CreatedAt,
+	}
+	if export.StartedAt.Valid {
+		result.StartedAt = &export.StartedAt.Time
+	}
+	if export.CompletedAt.Valid {
+		result.CompletedAt = &export.CompletedAt.Time
+	}
+	if export.ExpiresAt.Valid {
+		result.ExpiresAt = &export.ExpiresAt.Time
+	}
+
+	return result
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDataExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	export := &entity.DataExport{ID: uuid.New(), UserID: uuid.New()}
+	createdAt := time.Now()
+
+	store.EXPECT().
+		CreateDataExport(gomock.Any(), db.CreateDataExportParams{ID: export.ID, UserID: export.UserID}).
+		Return(db.DataExports{ID: export.ID, UserID: export.UserID, Status: entity.DataExportPending, CreatedAt: createdAt}, nil)
+
+	err := repo.CreateDataExport(context.Background(), export)
+	require.NoError(t, err)
+	require.Equal(t, entity.DataExportPending, export.Status)
+	require.Equal(t, createdAt, export.CreatedAt)
+}
+
+func TestCreateDataExportInProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	store.EXPECT().
+		CreateDataExport(gomock.Any(), gomock.Any()).
+		Return(db.DataExports{}, &pq.Error{Code: uniqueViolation, Constraint: dataExportsUnfinishedUserKey})
+
+	err := repo.CreateDataExport(context.Background(), &entity.DataExport{ID: uuid.New(), UserID: uuid.New()})
+	require.ErrorIs(t, err, entity.ErrDataExportInProgress)
+}
+
+func TestClaimDataExportNonePending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	store.EXPECT().
+		ClaimDataExport(gomock.Any(), gomock.Any()).
+		Return(db.DataExports{}, sql.ErrNoRows)
+
+	export, err := repo.ClaimDataExport(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.Nil(t, export)
+}
+
+func TestCompleteDataExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	expiresAt := time.Now().Add(time.Hour)
+	export := &entity.DataExport{
+		ID:                uuid.New(),
+		FileName:          "export.zip",
+		SizeBytes:         2048,
+		DownloadTokenHash: "hash",
+		ExpiresAt:         &expiresAt,
+	}
+
+	store.EXPECT().
+		CompleteDataExport(gomock.Any(), db.CompleteDataExportParams{
+			ID:                export.ID,
+			FileName:          sql.NullString{String: "export.zip", Valid: true},
+			SizeBytes:         2048,
+			DownloadTokenHash: sql.NullString{String: "hash", Valid: true},
+			ExpiresAt:         sql.NullTime{Time: expiresAt, Valid: true},
+		}).
+		Return(nil)
+
+	err := repo.CompleteDataExport(context.Background(), export)
+	require.NoError(t, err)
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const usersEmailKey = "users_email_key"
+
+// CreateEmailChange stores a pending email change. A user has at most one
+// pending change, so any earlier one is discarded.
+func (r *UserRepository) CreateEmailChange(ctx context.Context, change *entity.EmailChange) error {
+	if err := r.store.DeletePendingEmailChanges(ctx, change.UserID); err != nil {
+		return fmt.Errorf("failed to discard pending email changes: %w", err)
+	}
+
+	created, err := r.store.CreateEmailChange(ctx, db.CreateEmailChangeParams{
+		ID:               change.ID,
+		UserID:           change.UserID,
+		OldEmail:         change.OldEmail,
+		NewEmail:         change.NewEmail,
+		OldEmailVerified: change.OldEmailVerified,
+		RequestedIp:      sql.NullString{String: change.RequestedIP, Valid: change.RequestedIP != ""},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create email change: %w", err)
+	}
+
+	change.CreatedAt = created.CreatedAt
+
+	return nil
+}
+
+// GetPendingEmailChange retrieves the unconfirmed email change of a user, or
+// entity.ErrEmailChangeNotFound.
+func (r *UserRepository) GetPendingEmailChange(ctx context.Context, userID uuid.UUID) (*entity.EmailChange, error) {
+	change, err := r.store.GetPendingEmailChange(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrEmailChangeNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve email change: %w", err)
+	}
+
+	return mapEmailChange(change), nil
+}
+
+// ConfirmEmailChange moves the user to the new email and stores the revert
+// token hash and expiry set on the change.
+func (r *UserRepository) ConfirmEmailChange(ctx context.Context, change *entity.EmailChange) error {
+	if change.RevertExpiresAt == nil {
+		return fmt.Errorf("email change %s has no revert expiry", change.ID)
+	}
+
+	err := r.store.ConfirmEmailChangeTx(ctx, db.ConfirmEmailChangeTxParams{
+		ChangeID:        change.ID,
+		UserID:          change.UserID,
+		NewEmail:        change.NewEmail,
+		RevertTokenHash: change.RevertTokenHash,
+		RevertExpiresAt: *change.RevertExpiresAt,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.ErrEmailChangeNotFound
+		}
+		if isUniqueViolation(err, usersEmailKey) {
+			return entity.ErrEmailTaken
+		}
+		return fmt.Errorf("failed to confirm email change: %w", err)
+	}
+
+	return nil
+}
+
+// GetEmailChangeByRevertToken retrieves a confirmed email change by the hash
+// of its revert token, or entity.ErrEmailChangeRevertInvalid.
+func (r *UserRepository) GetEmailChangeByRevertToken(ctx context.Context, tokenHash string) (*entity.EmailChange, error) {
+	change, err := r.store.GetEmailChangeByRevertToken(ctx, sql.NullString{String: tokenHash, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrEmailChangeRevertInvalid
+		}
+		return nil, fmt.Errorf("failed to retrieve email change: %w", err)
+	}
+
+	return mapEmailChange(change), nil
+}
+
+// RevertEmailChange restores the user's previous email and its verification
+// status.
+func (r *UserRepository) RevertEmailChange(ctx context.Context, change *entity.EmailChange) error {
+	err := r.store.RevertEmailChangeTx(ctx, db.RevertEmailChangeTxParams{
+		ChangeID:         change.ID,
+		UserID:           change.UserID,
+		OldEmail:         change.OldEmail,
+		OldEmailVerified: change.OldEmailVerified,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.ErrEmailChangeRevertInvalid
+		}
+		if isUniqueViolation(err, usersEmailKey) {
+			return entity.ErrEmailTaken
+		}
+		return fmt.Errorf("failed to revert email change: %w", err)
+	}
+
+	return nil
+}
+
+// isUniqueViolation reports whether err is a violation of the named unique constraint
+func isUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == constraint
+}
+
+func mapEmailChange(change db.EmailChanges) *entity.EmailChange {
+	result := &entity.EmailChange{
+		ID:               change.ID,
+		UserID:           change.UserID,
+		OldEmail:         change.OldEmail,
+		NewEmail:         change.NewEmail,
+		OldEmailVerified: change.OldEmailVerified,
+		RequestedIP:      change.RequestedIp.String,
+		RevertTokenHash:  change.RevertTokenHash.String,
+		CreatedAt:        change.CreatedAt,
+	}
+
+	if change.RevertExpiresAt.Valid {
+		result.RevertExpiresAt = &change.RevertExpiresAt.Time
+	}
+	if change.ConfirmedAt.Valid {
+		result.ConfirmedAt = &change.ConfirmedAt.Time
+	}
+	if change.RevertedAt.Valid {
+		result.RevertedAt = &change.RevertedAt.Time
+	}
+
+	return result
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateEmailChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	change := &entity.EmailChange{
+		ID:               uuid.New(),
+		UserID:           uuid.New(),
+		OldEmail:         "old@example.com",
+		NewEmail:         "new@example.com",
+		OldEmailVerified: true,
+	}
+	createdAt := time.Now()
+
+	gomock.InOrder(
+		store.EXPECT().DeletePendingEmailChanges(gomock.Any(), change.UserID).Return(nil),
+		store.EXPECT().
+			CreateEmailChange(gomock.Any(), db.CreateEmailChangeParams{
+				ID:               change.ID,
+				UserID:           change.UserID,
+				OldEmail:         "old@example.com",
+				NewEmail:         "new@example.com",
+				OldEmailVerified: true,
+			}).
+			Return(db.EmailChanges{ID: change.ID, CreatedAt: createdAt}, nil),
+	)
+
+	err := repo.CreateEmailChange(context.Background(), change)
+	require.NoError(t, err)
+	require.Equal(t, createdAt, change.CreatedAt)
+}
+
+func TestConfirmEmailChangeErrors(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	change := &entity.EmailChange{
+		ID:              uuid.New(),
+		UserID:          uuid.New(),
+		NewEmail:        "new@example.com",
+		RevertTokenHash: "hash",
+		RevertExpiresAt: &expiresAt,
+	}
+
+	testCases := []struct {
+		name     string
+		storeErr error
+		expected error
+	}{
+		{name: "AlreadyConfirmed", storeErr: sql.ErrNoRows, expected: entity.ErrEmailChangeNotFound},
+		{name: "EmailTaken", storeErr: &pq.Error{Code: uniqueViolation, Constraint: usersEmailKey}, expected: entity.ErrEmailTaken},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+			store.EXPECT().
+				ConfirmEmailChangeTx(gomock.Any(), db.ConfirmEmailChangeTxParams{
+					ChangeID:        change.ID,
+					UserID:          change.UserID,
+					NewEmail:        "new@example.com",
+					RevertTokenHash: "hash",
+					RevertExpiresAt: expiresAt,
+				}).
+				Return(tc.storeErr)
+
+			err := repo.ConfirmEmailChange(context.Background(), change)
+			require.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestGetEmailChangeByRevertTokenNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	store.EXPECT().
+		GetEmailChangeByRevertToken(gomock.Any(), sql.NullString{String: "hash", Valid: true}).
+		Return(db.EmailChanges{}, sql.ErrNoRows)
+
+	_, err := repo.GetEmailChangeByRevertToken(context.Background(), "hash")
+	require.ErrorIs(t, err, entity.ErrEmailChangeRevertInvalid)
+}
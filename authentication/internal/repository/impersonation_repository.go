@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// CreateImpersonationToken generates an access token for an administrator
+// acting as a user. It carries the user's role, never the administrator's.
+func (r *UserRepository) CreateImpersonationToken(ctx context.Context, impersonatorID string, email string, userID string, sessionID string, role string, duration time.Duration) (string, time.Time, error) {
+	tokenMaker := token.NewPasetoV4Maker(r.keyRing)
+
+	accessToken, payload, err := tokenMaker.CreateImpersonationToken(impersonatorID, email, userID, sessionID, []string{role}, duration)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create impersonation token: %w", err)
+	}
+
+	return accessToken, payload.ExpiredAt, nil
+}
+
+// CreateImpersonationSession stores the session an administrator acts as a
+// user in, so it shows up in the user's sessions and login history.
+func (r *UserRepository) CreateImpersonationSession(ctx context.Context, session *entity.Session) error {
+	var impersonatorID uuid.NullUUID
+	if session.ImpersonatorID != nil {
+		impersonatorID = uuid.NullUUID{UUID: *session.ImpersonatorID, Valid: true}
+	}
+
+	created, err := r.store.CreateImpersonationSession(ctx, db.CreateImpersonationSessionParams{
+		SessionID:           session.SessionID,
+		UserID:              session.UserID,
+		Token:               session.Token,
+		ExpiresAt:           session.ExpiresAt,
+		IpAddress:           sql.NullString{String: session.IpAddress, Valid: session.IpAddress != ""},
+		UserAgent:           sql.NullString{String: session.UserAgent, Valid: session.UserAgent != ""},
+		ImpersonatorID:      impersonatorID,
+		ImpersonationReason: nullString(session.ImpersonationReason),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create impersonation session: %w", err)
+	}
+
+	session.CreatedAt = created.CreatedAt
+	session.LastActivity = created.LastActivity
+
+	return nil
+}
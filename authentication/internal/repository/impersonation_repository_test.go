@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateImpersonationSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	impersonatorID := uuid.New()
+	session := &entity.Session{
+		SessionID:           uuid.New(),
+		UserID:              uuid.New(),
+		Token:               "impersonation-token",
+		ExpiresAt:           time.Now().Add(15 * time.Minute),
+		IpAddress:           "203.0.113.7",
+		ImpersonatorID:      &impersonatorID,
+		ImpersonationReason: "listing does not show photos",
+	}
+	createdAt := time.Now()
+
+	store.EXPECT().
+		CreateImpersonationSession(gomock.Any(), db.CreateImpersonationSessionParams{
+			SessionID:           session.SessionID,
+			UserID:              session.UserID,
+			Token:               "impersonation-token",
+			ExpiresAt:           session.ExpiresAt,
+			IpAddress:           sql.NullString{String: "203.0.113.7", Valid: true},
+			ImpersonatorID:      uuid.NullUUID{UUID: impersonatorID, Valid: true},
+			ImpersonationReason: sql.NullString{String: "listing does not show photos", Valid: true},
+		}).
+		Return(db.Sessions{SessionID: session.SessionID, CreatedAt: createdAt, LastActivity: createdAt}, nil)
+
+	err := repo.CreateImpersonationSession(context.Background(), session)
+	require.NoError(t, err)
+	require.Equal(t, createdAt, session.CreatedAt)
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// CreateMagicLink stores a new sign-in link. Only the most recent link of a
+// user is usable, so the older unused ones are expired first.
+func (r *UserRepository) CreateMagicLink(ctx context.Context, link *entity.MagicLink) error {
+	// Links are kept for a week after expiring as a record of sign-ins, then cleaned up
+	if err := r.store.DeleteExpiredMagicLinks(ctx); err != nil {
+		log.Printf("failed to delete expired magic links: %v", err)
+	}
+
+	if err := r.store.ExpireMagicLinksByUserID(ctx, link.UserID); err != nil {
+		return fmt.Errorf("failed to expire previous magic links: %w", err)
+	}
+
+	created, err := r.store.CreateMagicLink(ctx, db.CreateMagicLinkParams{
+		ID:                 link.ID,
+		UserID:             link.UserID,
+		TokenHash:          link.TokenHash,
+		ExpiresAt:          link.ExpiresAt,
+		RequestedIp:        link.RequestedIP,
+		RequestedUserAgent: link.RequestedUserAgent,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create magic link: %w", err)
+	}
+
+	link.CreatedAt = created.CreatedAt
+
+	return nil
+}
+
+// GetMagicLinkByHash retrieves a magic link by the hash of its token.
+func (r *UserRepository) GetMagicLinkByHash(ctx context.Context, tokenHash string) (*entity.MagicLink, error) {
+	link, err := r.store.GetMagicLinkByHash(ctx, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrMagicLinkInvalid
+		}
+		return nil, fmt.Errorf("failed to retrieve magic link: %w", err)
+	}
+
+	return mapMagicLink(link), nil
+}
+
+// MarkMagicLinkUsed records that a link was exchanged for a session. Only one
+// caller can win for a given link; everyone else gets entity.ErrMagicLinkUsed.
+func (r *UserRepository) MarkMagicLinkUsed(ctx context.Context, linkID uuid.UUID, ipAddress, userAgent string) error {
+	rows, err := r.store.MarkMagicLinkUsed(ctx, db.MarkMagicLinkUsedParams{
+		ID:            linkID,
+		UsedIp:        sql.NullString{String: ipAddress, Valid: true},
+		UsedUserAgent: sql.NullString{String: userAgent, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark magic link used: %w", err)
+	}
+	if rows == 0 {
+		return entity.ErrMagicLinkUsed
+	}
+
+	return nil
+}
+
+func mapMagicLink(link db.MagicLinks) *entity.MagicLink {
+	result := &entity.MagicLink{
+		ID:                 link.ID,
+		UserID:             link.UserID,
+		TokenHash:          link.TokenHash,
+		ExpiresAt:          link.ExpiresAt,
+		CreatedAt:          link.CreatedAt,
+		RequestedIP:        link.RequestedIp,
+		RequestedUserAgent: link.RequestedUserAgent,
+		UsedIP:             link.UsedIp.String,
+		UsedUserAgent:      link.UsedUserAgent.String,
+	}
+
+	if link.UsedAt.Valid {
+		result.UsedAt = &link.UsedAt.Time
+	}
+
+	return result
+}
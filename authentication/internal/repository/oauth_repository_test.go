@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/config"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestJWKSServer publishes key under kid as a JSON Web Key Set
+func newTestJWKSServer(t *testing.T, kid string, key *rsa.PrivateKey) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func signTestIDToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": kid})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func newTestOAuthRepository(t *testing.T) (*OAuthRepositoryImpl, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	server := newTestJWKSServer(t, "test-key", key)
+
+	cfg := &config.Config{
+		GoogleClientID: "google-client-id",
+		AppleClientID:  "com.example.app",
+		GoogleJWKSURL:  server.URL,
+		AppleJWKSURL:   server.URL,
+		JWKSCacheTTL:   time.Hour,
+	}
+
+	return NewOAuthRepository(cfg).(*OAuthRepositoryImpl), key
+}
+
+func TestValidateGoogleToken(t *testing.T) {
+	repo, key := newTestOAuthRepository(t)
+
+	token := signTestIDToken(t, key, "test-key", map[string]any{
+		"iss":            "https://accounts.google.com",
+		"aud":            "google-client-id",
+		"sub":            "google-user-1",
+		"email":          "user@example.com",
+		"email_verified": true,
+		"name":           "Test User",
+		"picture":        "https://example.com/avatar.png",
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	})
+
+	info, err := repo.ValidateGoogleToken(context.Background(), token)
+	require.NoError(t, err)
+	require.Equal(t, "google-user-1", info.ID)
+	require.Equal(t, "user@example.com", info.Email)
+	require.Equal(t, "Test User", info.Name)
+	require.True(t, info.EmailVerified)
+	require.Equal(t, "google", info.Provider)
+}
+
+func TestValidateGoogleTokenWrongAudience(t *testing.T) {
+	repo, key := newTestOAuthRepository(t)
+
+	token := signTestIDToken(t, key, "test-key", map[string]any{
+		"iss": "accounts.google.com",
+		"aud": "another-client-id",
+		"sub": "google-user-1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	_, err := repo.ValidateGoogleToken(context.Background(), token)
+	require.ErrorIs(t, err, entity.ErrInvalidToken)
+}
+
+func TestValidateAppleToken(t *testing.T) {
+	repo, key := newTestOAuthRepository(t)
+
+	token := signTestIDToken(t, key, "test-key", map[string]any{
+		"iss":            "https://appleid.apple.com",
+		"aud":            "com.example.app",
+		"sub":            "apple-user-1",
+		"email":          "relay@privaterelay.appleid.com",
+		"email_verified": "true",
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	})
+
+	info, err := repo.ValidateAppleToken(context.Background(), token)
+	require.NoError(t, err)
+	require.Equal(t, "apple-user-1", info.ID)
+	require.True(t, info.EmailVerified)
+	require.Equal(t, "apple", info.Provider)
+}
+
+func TestValidateAppleTokenExpired(t *testing.T) {
+	repo, key := newTestOAuthRepository(t)
+
+	token := signTestIDToken(t, key, "test-key", map[string]any{
+		"iss": "https://appleid.apple.com",
+		"aud": "com.example.app",
+		"sub": "apple-user-1",
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	})
+
+	_, err := repo.ValidateAppleToken(context.Background(), token)
+	require.ErrorIs(t, err, entity.ErrTokenExpired)
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+)
+
+// CreateOAuthState stores a pending authorization code flow under the hash of its state.
+func (r *UserRepository) CreateOAuthState(ctx context.Context, state *entity.OAuthState) error {
+	// Abandoned flows are cleaned up whenever a new one starts
+	if err := r.store.DeleteExpiredOAuthStates(ctx); err != nil {
+		log.Printf("failed to delete expired oauth states: %v", err)
+	}
+
+	created, err := r.store.CreateOAuthState(ctx, db.CreateOAuthStateParams{
+		StateHash:    utils.HashToken(state.State),
+		Provider:     state.Provider,
+		CodeVerifier: state.CodeVerifier,
+		Nonce:        state.Nonce,
+		ExpiresAt:    state.ExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create oauth state: %w", err)
+	}
+
+	state.CreatedAt = created.CreatedAt
+	return nil
+}
+
+// ConsumeOAuthState removes and returns the flow a state belongs to, so each state can be used once.
+func (r *UserRepository) ConsumeOAuthState(ctx context.Context, state string) (*entity.OAuthState, error) {
+	consumed, err := r.store.ConsumeOAuthState(ctx, utils.HashToken(state))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrOAuthStateInvalid
+		}
+		return nil, fmt.Errorf("failed to consume oauth state: %w", err)
+	}
+
+	return &entity.OAuthState{
+		State:        state,
+		Provider:     consumed.Provider,
+		CodeVerifier: consumed.CodeVerifier,
+		Nonce:        consumed.Nonce,
+		CreatedAt:    consumed.CreatedAt,
+		ExpiresAt:    consumed.ExpiresAt,
+	}, nil
+}
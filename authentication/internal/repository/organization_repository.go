@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+const organizationMembersPkey = "organization_members_pkey"
+
+// CreateOrganization stores an organization with its creator as a member
+// holding ownerRole.
+func (r *UserRepository) CreateOrganization(ctx context.Context, organization *entity.Organization, ownerRole string) error {
+	created, err := r.store.CreateOrganizationTx(ctx, db.CreateOrganizationTxParams{
+		Organization: db.CreateOrganizationParams{
+			ID:        organization.ID,
+			Name:      organization.Name,
+			CreatedBy: nullUUID(organization.CreatedBy),
+		},
+		OwnerRole: ownerRole,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	*organization = *mapOrganization(created)
+	organization.Role = ownerRole
+
+	return nil
+}
+
+// GetOrganization retrieves an organization by ID, or entity.ErrOrganizationNotFound.
+func (r *UserRepository) GetOrganization(ctx context.Context, organizationID uuid.UUID) (*entity.Organization, error) {
+	organization, err := r.store.GetOrganization(ctx, organizationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve organization: %w", err)
+	}
+
+	return mapOrganization(organization), nil
+}
+
+// ListUserOrganizations returns the organizations a user is a member of, each
+// with the user's role in it.
+func (r *UserRepository) ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]*entity.Organization, error) {
+	organizations, err := r.store.ListUserOrganizations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	result := make([]*entity.Organization, 0, len(organizations))
+	for _, organization := range organizations {
+		mapped := mapOrganization(db.Organizations{
+			ID:        organization.ID,
+			Name:      organization.Name,
+			CreatedBy: organization.CreatedBy,
+			CreatedAt: organization.CreatedAt,
+			UpdatedAt: organization.UpdatedAt,
+		})
+		mapped.Role = organization.Role
+		result = append(result, mapped)
+	}
+
+	return result, nil
+}
+
+// GetOrganizationMember retrieves a user's membership of an organization, or
+// entity.ErrNotOrganizationMember.
+func (r *UserRepository) GetOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID) (*entity.OrganizationMember, error) {
+	member, err := r.store.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrNotOrganizationMember
+		}
+		return nil, fmt.Errorf("failed to retrieve organization member: %w", err)
+	}
+
+	return mapOrganizationMember(db.ListOrganizationMembersRow(member)), nil
+}
+
+// ListOrganizationMembers returns the members of an organization.
+func (r *UserRepository) ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]*entity.OrganizationMember, error) {
+	members, err := r.store.ListOrganizationMembers(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+
+	result := make([]*entity.OrganizationMember, 0, len(members))
+	for _, member := range members {
+		result = append(result, mapOrganizationMember(member))
+	}
+
+	return result, nil
+}
+
+// CountOrganizationOwners returns how many members own an organization.
+func (r *UserRepository) CountOrganizationOwners(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	count, err := r.store.CountOrganizationOwners(ctx, organizationID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count organization owners: %w", err)
+	}
+
+	return count, nil
+}
+
+// UpdateOrganizationMemberRole changes a member's role, or returns
+// entity.ErrNotOrganizationMember.
+func (r *UserRepository) UpdateOrganizationMemberRole(ctx context.Context, organizationID, userID uuid.UUID, role string) error {
+	rows, err := r.store.UpdateOrganizationMemberRole(ctx, db.UpdateOrganizationMemberRoleParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Role:           role,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update organization member role: %w", err)
+	}
+	if rows == 0 {
+		return entity.ErrNotOrganizationMember
+	}
+
+	return nil
+}
+
+// RemoveOrganizationMember removes a user from an organization and stops their
+// sessions acting for it, or returns entity.ErrNotOrganizationMember.
+func (r *UserRepository) RemoveOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID) error {
+	err := r.store.RemoveOrganizationMemberTx(ctx, db.DeleteOrganizationMemberParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.ErrNotOrganizationMember
+		}
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+
+	return nil
+}
+
+// CreateOrganizationInvitation stores a pending invitation, revoking any
+// invitation still pending for the same address so only the newest link works.
+func (r *UserRepository) CreateOrganizationInvitation(ctx context.Context, invitation *entity.OrganizationInvitation) error {
+	err := r.store.RevokePendingOrganizationInvitations(ctx, db.RevokePendingOrganizationInvitationsParams{
+		OrganizationID: invitation.OrganizationID,
+		Email:          invitation.Email,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke pending invitations: %w", err)
+	}
+
+	created, err := r.store.CreateOrganizationInvitation(ctx, db.CreateOrganizationInvitationParams{
+		ID:             invitation.ID,
+		OrganizationID: invitation.OrganizationID,
+		Email:          invitation.Email,
+		Role:           invitation.Role,
+		TokenHash:      invitation.TokenHash,
+		InvitedBy:      nullUUID(invitation.InvitedBy),
+		ExpiresAt:      invitation.ExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create organization invitation: %w", err)
+	}
+
+	invitation.Status = created.Status
+	invitation.CreatedAt = created.CreatedAt
+
+	return nil
+}
+
+// GetOrganizationInvitation retrieves an invitation by ID, or entity.ErrInvitationNotFound.
+func (r *UserRepository) GetOrganizationInvitation(ctx context.Context, invitationID uuid.UUID) (*entity.OrganizationInvitation, error) {
+	invitation, err := r.store.GetOrganizationInvitation(ctx, invitationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve organization invitation: %w", err)
+	}
+
+	return mapOrganizationInvitation(invitation), nil
+}
+
+// GetOrganizationInvitationByToken retrieves the invitation whose emailed token
+// hashes to tokenHash, or entity.ErrInvitationNotFound.
+func (r *UserRepository) GetOrganizationInvitationByToken(ctx context.Context, tokenHash string) (*entity.OrganizationInvitation, error) {
+	invitation, err := r.store.GetOrganizationInvitationByToken(ctx, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve organization invitation: %w", err)
+	}
+
+	return mapOrganizationInvitation(db.GetOrganizationInvitationRow(invitation)), nil
+}
+
+// ListPendingOrganizationInvitations returns the invitations of an
+// organization that have not been answered.
+func (r *UserRepository) ListPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID) ([]*entity.OrganizationInvitation, error) {
+	invitations, err := r.store.ListPendingOrganizationInvitations(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization invitations: %w", err)
+	}
+
+	result := make([]*entity.OrganizationInvitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		result = append(result, mapOrganizationInvitation(db.GetOrganizationInvitationRow(invitation)))
+	}
+
+	return result, nil
+}
+
+// AcceptOrganizationInvitation marks an invitation accepted and adds the user
+// to its organization with the invited role. It returns
+// entity.ErrInvitationInvalid if the invitation was answered or expired
+// meanwhile, and entity.ErrAlreadyOrganizationMember if the user already is one.
+func (r *UserRepository) AcceptOrganizationInvitation(ctx context.Context, invitation *entity.OrganizationInvitation, userID uuid.UUID) (*entity.OrganizationMember, error) {
+	member, err := r.store.AcceptOrganizationInvitationTx(ctx, db.AcceptOrganizationInvitationTxParams{
+		InvitationID:   invitation.ID,
+		Status:         entity.InvitationAccepted,
+		OrganizationID: invitation.OrganizationID,
+		UserID:         userID,
+		Role:           invitation.Role,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrInvitationInvalid
+		}
+		if isUniqueViolation(err, organizationMembersPkey) {
+			return nil, entity.ErrAlreadyOrganizationMember
+		}
+		return nil, fmt.Errorf("failed to accept organization invitation: %w", err)
+	}
+
+	return &entity.OrganizationMember{
+		OrganizationID: member.OrganizationID,
+		UserID:         member.UserID,
+		Email:          invitation.Email,
+		Role:           member.Role,
+		JoinedAt:       member.JoinedAt,
+	}, nil
+}
+
+// RespondToOrganizationInvitation records that a pending invitation was
+// declined or revoked, or returns entity.ErrInvitationInvalid if it no longer is pending.
+func (r *UserRepository) RespondToOrganizationInvitation(ctx context.Context, invitationID uuid.UUID, status string) error {
+	rows, err := r.store.RespondToOrganizationInvitation(ctx, db.RespondToOrganizationInvitationParams{
+		ID:     invitationID,
+		Status: status,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update organization invitation: %w", err)
+	}
+	if rows == 0 {
+		return entity.ErrInvitationInvalid
+	}
+
+	return nil
+}
+
+// SetSessionOrganization makes one of the user's sessions act for an
+// organization, or for none when organizationID is nil. It returns
+// entity.ErrSessionNotFound if the session is not the user's.
+func (r *UserRepository) SetSessionOrganization(ctx context.Context, sessionID, userID uuid.UUID, organizationID *uuid.UUID) error {
+	rows, err := r.store.SetSessionOrganization(ctx, db.SetSessionOrganizationParams{
+		SessionID:      sessionID,
+		UserID:         userID,
+		OrganizationID: nullUUID(organizationID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set session organization: %w", err)
+	}
+	if rows == 0 {
+		return entity.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+func mapOrganization(organization db.Organizations) *entity.Organization {
+	return &entity.Organization{
+		ID:        organization.ID,
+		Name:      organization.Name,
+		CreatedBy: uuidPtr(organization.CreatedBy),
+		CreatedAt: organization.CreatedAt,
+		UpdatedAt: organization.UpdatedAt,
+	}
+}
+
+func mapOrganizationMember(member db.ListOrganizationMembersRow) *entity.OrganizationMember {
+	return &entity.OrganizationMember{
+		OrganizationID: member.OrganizationID,
+		UserID:         member.UserID,
+		Email:          member.Email,
+		FullName:       member.Name,
+		Role:           member.Role,
+		JoinedAt:       member.JoinedAt,
+	}
+}
+
+func mapOrganizationInvitation(invitation db.GetOrganizationInvitationRow) *entity.OrganizationInvitation {
+	result := &entity.OrganizationInvitation{
+		ID:               invitation.ID,
+		OrganizationID:   invitation.OrganizationID,
+		OrganizationName: invitation.OrganizationName,
+		Email:            invitation.Email,
+		Role:             invitation.Role,
+		TokenHash:        invitation.TokenHash,
+		InvitedBy:        uuidPtr(invitation.InvitedBy),
+		Status:           invitation.Status,
+		CreatedAt:        invitation.CreatedAt,
+		ExpiresAt:        invitation.ExpiresAt,
+	}
+	if invitation.RespondedAt.Valid {
+		result.RespondedAt = &invitation.RespondedAt.Time
+	}
+
+	return result
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	ownerID := uuid.New()
+	organization := &entity.Organization{ID: uuid.New(), Name: "Lagos Homes", CreatedBy: &ownerID}
+	createdAt := time.Now()
+
+	store.EXPECT().
+		CreateOrganizationTx(gomock.Any(), db.CreateOrganizationTxParams{
+			Organization: db.CreateOrganizationParams{
+				ID:        organization.ID,
+				Name:      "Lagos Homes",
+				CreatedBy: uuid.NullUUID{UUID: ownerID, Valid: true},
+			},
+			OwnerRole: string(rbac.OrgRoleOwner),
+		}).
+		Return(db.Organizations{
+			ID:        organization.ID,
+			Name:      "Lagos Homes",
+			CreatedBy: uuid.NullUUID{UUID: ownerID, Valid: true},
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}, nil)
+
+	err := repo.CreateOrganization(context.Background(), organization, string(rbac.OrgRoleOwner))
+	require.NoError(t, err)
+	require.Equal(t, createdAt, organization.CreatedAt)
+	require.Equal(t, string(rbac.OrgRoleOwner), organization.Role)
+}
+
+func TestGetOrganizationMemberNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	store.EXPECT().
+		GetOrganizationMember(gomock.Any(), gomock.Any()).
+		Return(db.GetOrganizationMemberRow{}, sql.ErrNoRows)
+
+	_, err := repo.GetOrganizationMember(context.Background(), uuid.New(), uuid.New())
+	require.ErrorIs(t, err, entity.ErrNotOrganizationMember)
+}
+
+func TestCreateOrganizationInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	inviterID := uuid.New()
+	invitation := &entity.OrganizationInvitation{
+		ID:             uuid.New(),
+		OrganizationID: uuid.New(),
+		Email:          "agent@example.com",
+		Role:           string(rbac.OrgRoleAgent),
+		TokenHash:      "hash",
+		InvitedBy:      &inviterID,
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+	createdAt := time.Now()
+
+	gomock.InOrder(
+		store.EXPECT().
+			RevokePendingOrganizationInvitations(gomock.Any(), db.RevokePendingOrganizationInvitationsParams{
+				OrganizationID: invitation.OrganizationID,
+				Email:          "agent@example.com",
+			}).
+			Return(nil),
+		store.EXPECT().
+			CreateOrganizationInvitation(gomock.Any(), db.CreateOrganizationInvitationParams{
+				ID:             invitation.ID,
+				OrganizationID: invitation.OrganizationID,
+				Email:          "agent@example.com",
+				Role:           string(rbac.OrgRoleAgent),
+				TokenHash:      "hash",
+				InvitedBy:      uuid.NullUUID{UUID: inviterID, Valid: true},
+				ExpiresAt:      invitation.ExpiresAt,
+			}).
+			Return(db.OrganizationInvitations{ID: invitation.ID, Status: entity.InvitationPending, CreatedAt: createdAt}, nil),
+	)
+
+	err := repo.CreateOrganizationInvitation(context.Background(), invitation)
+	require.NoError(t, err)
+	require.Equal(t, entity.InvitationPending, invitation.Status)
+	require.Equal(t, createdAt, invitation.CreatedAt)
+}
+
+func TestAcceptOrganizationInvitationErrors(t *testing.T) {
+	invitation := &entity.OrganizationInvitation{
+		ID:             uuid.New(),
+		OrganizationID: uuid.New(),
+		Email:          "agent@example.com",
+		Role:           string(rbac.OrgRoleAgent),
+	}
+
+	testCases := []struct {
+		name     string
+		storeErr error
+		expected error
+	}{
+		{name: "NoLongerPending", storeErr: sql.ErrNoRows, expected: entity.ErrInvitationInvalid},
+		{name: "AlreadyMember", storeErr: &pq.Error{Code: uniqueViolation, Constraint: organizationMembersPkey}, expected: entity.ErrAlreadyOrganizationMember},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+			store.EXPECT().
+				AcceptOrganizationInvitationTx(gomock.Any(), gomock.Any()).
+				Return(db.OrganizationMembers{}, tc.storeErr)
+
+			_, err := repo.AcceptOrganizationInvitation(context.Background(), invitation, uuid.New())
+			require.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestRemoveOrganizationMemberNotMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	store.EXPECT().
+		RemoveOrganizationMemberTx(gomock.Any(), gomock.Any()).
+		Return(sql.ErrNoRows)
+
+	err := repo.RemoveOrganizationMember(context.Background(), uuid.New(), uuid.New())
+	require.ErrorIs(t, err, entity.ErrNotOrganizationMember)
+}
+
+func TestSetSessionOrganizationSessionNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	organizationID := uuid.New()
+	sessionID := uuid.New()
+	userID := uuid.New()
+
+	store.EXPECT().
+		SetSessionOrganization(gomock.Any(), db.SetSessionOrganizationParams{
+			SessionID:      sessionID,
+			UserID:         userID,
+			OrganizationID: uuid.NullUUID{UUID: organizationID, Valid: true},
+		}).
+		Return(int64(0), nil)
+
+	err := repo.SetSessionOrganization(context.Background(), sessionID, userID, &organizationID)
+	require.ErrorIs(t, err, entity.ErrSessionNotFound)
+}
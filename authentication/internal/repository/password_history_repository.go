@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/demola234/authentication/db/sqlc"
+
+	"github.com/google/uuid"
+)
+
+// AddPasswordHistory records a password hash the user is replacing and drops
+// all but the keep most recent entries.
+func (r *UserRepository) AddPasswordHistory(ctx context.Context, userID uuid.UUID, passwordHash string, keep int) error {
+	err := r.store.CreatePasswordHistory(ctx, db.CreatePasswordHistoryParams{
+		ID:           uuid.New(),
+		UserID:       userID,
+		PasswordHash: passwordHash,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record password history: %w", err)
+	}
+
+	err = r.store.TrimPasswordHistory(ctx, db.TrimPasswordHistoryParams{
+		UserID: userID,
+		Keep:   int32(keep),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to trim password history: %w", err)
+	}
+
+	return nil
+}
+
+// ListPasswordHistory returns the hashes of the user's most recently replaced passwords, newest first.
+func (r *UserRepository) ListPasswordHistory(ctx context.Context, userID uuid.UUID, limit int) ([]string, error) {
+	history, err := r.store.ListPasswordHistory(ctx, db.ListPasswordHistoryParams{
+		UserID: userID,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve password history: %w", err)
+	}
+
+	hashes := make([]string, 0, len(history))
+	for _, entry := range history {
+		hashes = append(hashes, entry.PasswordHash)
+	}
+
+	return hashes, nil
+}
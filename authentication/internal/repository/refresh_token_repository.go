@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// CreateRefreshToken stores a new refresh token, starting a new token family.
+func (r *UserRepository) CreateRefreshToken(ctx context.Context, refreshToken *entity.RefreshToken) error {
+	refreshToken.ExpiresAt = r.refreshTokenExpiry()
+
+	created, err := r.store.CreateRefreshToken(ctx, createRefreshTokenParams(refreshToken))
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	refreshToken.CreatedAt = created.CreatedAt
+
+	return nil
+}
+
+// GetRefreshTokenByHash retrieves a refresh token by the hash of its value.
+func (r *UserRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	refreshToken, err := r.store.GetRefreshTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("failed to retrieve refresh token: %w", err)
+	}
+
+	return mapRefreshToken(refreshToken), nil
+}
+
+// RotateRefreshToken marks the current refresh token as used and stores its
+// successor in the same family. A token that was already used yields
+// entity.ErrRefreshTokenReused.
+func (r *UserRepository) RotateRefreshToken(ctx context.Context, currentID uuid.UUID, next *entity.RefreshToken) error {
+	next.ExpiresAt = r.refreshTokenExpiry()
+
+	created, err := r.store.RotateRefreshTokenTx(ctx, db.RotateRefreshTokenTxParams{
+		CurrentID: currentID,
+		Next:      createRefreshTokenParams(next),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRefreshTokenAlreadyUsed) {
+			return entity.ErrRefreshTokenReused
+		}
+		return fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	next.CreatedAt = created.CreatedAt
+
+	return nil
+}
+
+// RevokeRefreshTokenFamily revokes every refresh token rotated from the same login.
+func (r *UserRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	err := r.store.RevokeRefreshTokenFamily(ctx, familyID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	return nil
+}
+
+// refreshTokenExpiry returns the expiry of a refresh token issued now.
+func (r *UserRepository) refreshTokenExpiry() time.Time {
+	return time.Now().Add(r.refreshTokenDuration).UTC()
+}
+
+func createRefreshTokenParams(refreshToken *entity.RefreshToken) db.CreateRefreshTokenParams {
+	var parentID uuid.NullUUID
+	if refreshToken.ParentID != nil {
+		parentID = uuid.NullUUID{UUID: *refreshToken.ParentID, Valid: true}
+	}
+
+	return db.CreateRefreshTokenParams{
+		ID:        refreshToken.ID,
+		SessionID: refreshToken.SessionID,
+		UserID:    refreshToken.UserID,
+		FamilyID:  refreshToken.FamilyID,
+		ParentID:  parentID,
+		TokenHash: refreshToken.TokenHash,
+		ExpiresAt: refreshToken.ExpiresAt,
+	}
+}
+
+func mapRefreshToken(refreshToken db.RefreshTokens) *entity.RefreshToken {
+	result := &entity.RefreshToken{
+		ID:        refreshToken.ID,
+		SessionID: refreshToken.SessionID,
+		UserID:    refreshToken.UserID,
+		FamilyID:  refreshToken.FamilyID,
+		TokenHash: refreshToken.TokenHash,
+		ExpiresAt: refreshToken.ExpiresAt,
+		CreatedAt: refreshToken.CreatedAt,
+	}
+
+	if refreshToken.ParentID.Valid {
+		result.ParentID = &refreshToken.ParentID.UUID
+	}
+	if refreshToken.UsedAt.Valid {
+		result.UsedAt = &refreshToken.UsedAt.Time
+	}
+	if refreshToken.RevokedAt.Valid {
+		result.RevokedAt = &refreshToken.RevokedAt.Time
+	}
+
+	return result
+}
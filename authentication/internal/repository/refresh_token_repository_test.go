@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRefreshTokenUsesConfiguredDuration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, time.Hour)
+
+	refreshToken := &entity.RefreshToken{
+		ID:        uuid.New(),
+		SessionID: uuid.New(),
+		UserID:    uuid.New(),
+		FamilyID:  uuid.New(),
+		TokenHash: "token-hash",
+	}
+
+	store.EXPECT().
+		CreateRefreshToken(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.CreateRefreshTokenParams) (db.RefreshTokens, error) {
+			require.WithinDuration(t, time.Now().Add(time.Hour), arg.ExpiresAt, time.Second)
+			return db.RefreshTokens{ID: arg.ID, ExpiresAt: arg.ExpiresAt, CreatedAt: time.Now()}, nil
+		})
+
+	err := repo.CreateRefreshToken(context.Background(), refreshToken)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Hour), refreshToken.ExpiresAt, time.Second)
+}
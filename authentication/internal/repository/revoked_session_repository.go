@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	db "github.com/demola234/authentication/db/sqlc"
+
+	"github.com/google/uuid"
+)
+
+// RevokeSessionTokens adds a session to the revocation list checked by the
+// gateway and revokes the refresh tokens issued for it.
+func (r *UserRepository) RevokeSessionTokens(ctx context.Context, userID, sessionID uuid.UUID, reason string) error {
+	// Entries outlive every access token they could apply to, so old ones are cleaned up here
+	if err := r.store.DeleteExpiredRevokedSessions(ctx); err != nil {
+		log.Printf("failed to delete expired revoked sessions: %v", err)
+	}
+
+	err := r.store.RevokeSessionTokens(ctx, db.RevokeSessionTokensParams{
+		SessionID: sessionID,
+		UserID:    userID,
+		Reason:    reason,
+		RevokedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke session tokens: %w", err)
+	}
+
+	if err := r.store.RevokeRefreshTokensBySessionID(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeUserSessionTokens adds every session of a user to the revocation list,
+// including those of the access tokens exchanged for their API keys.
+func (r *UserRepository) RevokeUserSessionTokens(ctx context.Context, userID uuid.UUID, reason string) error {
+	err := r.store.RevokeUserSessionTokens(ctx, db.RevokeUserSessionTokensParams{
+		UserID:    userID,
+		Reason:    reason,
+		RevokedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke session tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeSessionTokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	store.EXPECT().DeleteExpiredRevokedSessions(gomock.Any()).Return(nil)
+	store.EXPECT().
+		RevokeSessionTokens(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.RevokeSessionTokensParams) error {
+			require.Equal(t, sessionID, arg.SessionID)
+			require.Equal(t, userID, arg.UserID)
+			require.Equal(t, entity.SessionRevokedLogout, arg.Reason)
+			require.WithinDuration(t, time.Now(), arg.RevokedAt, time.Second)
+			return nil
+		})
+	store.EXPECT().RevokeRefreshTokensBySessionID(gomock.Any(), sessionID).Return(nil)
+
+	err := repo.RevokeSessionTokens(context.Background(), userID, sessionID, entity.SessionRevokedLogout)
+	require.NoError(t, err)
+}
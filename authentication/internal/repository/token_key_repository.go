@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// tokenKeyReloadInterval is how often the key ring is reloaded so rotations
+// done by other instances are picked up
+const tokenKeyReloadInterval = time.Minute
+
+// TokenKeyManager keeps the access token key ring in sync with the signing keys
+// stored in the database and rotates the active key on a schedule.
+type TokenKeyManager struct {
+	store       db.Store
+	ring        *token.KeyRing
+	aead        cipher.AEAD
+	rotateEvery time.Duration
+	retainFor   time.Duration
+}
+
+// NewTokenKeyManager creates a TokenKeyManager. Private keys are sealed with
+// encryptionKey before they are stored. Retired keys keep verifying tokens for
+// retainFor, which must cover the access token lifetime.
+func NewTokenKeyManager(store db.Store, ring *token.KeyRing, encryptionKey string, rotateEvery, retainFor time.Duration) (*TokenKeyManager, error) {
+	aead, err := chacha20poly1305.NewX([]byte(encryptionKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid token key encryption key: %w", err)
+	}
+
+	return &TokenKeyManager{
+		store:       store,
+		ring:        ring,
+		aead:        aead,
+		rotateEvery: rotateEvery,
+		retainFor:   retainFor,
+	}, nil
+}
+
+// Load replaces the key ring with the stored signing keys, creating the first
+// key when there is none yet.
+func (m *TokenKeyManager) Load(ctx context.Context) error {
+	stored, err := m.store.ListTokenSigningKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list token signing keys: %w", err)
+	}
+
+	keys := make([]*token.SigningKey, 0, len(stored))
+	activeID := ""
+	for _, s := range stored {
+		key, err := m.openKey(s)
+		if err != nil {
+			return err
+		}
+		if key.ExpiresAt == nil {
+			activeID = key.ID
+		}
+		keys = append(keys, key)
+	}
+
+	if activeID == "" {
+		return m.Rotate(ctx)
+	}
+
+	return m.ring.Set(keys, activeID)
+}
+
+// Rotate generates a new signing key, retires the active one and reloads the key ring.
+func (m *TokenKeyManager) Rotate(ctx context.Context) error {
+	next, err := token.GenerateSigningKey()
+	if err != nil {
+		return err
+	}
+
+	sealed, err := m.sealKey(next)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.store.RotateTokenSigningKeyTx(ctx, db.RotateTokenSigningKeyTxParams{
+		RetireAt: time.Now().Add(m.retainFor),
+		Next: db.CreateTokenSigningKeyParams{
+			ID:                  next.ID,
+			PublicKey:           token.EncodePublicKey(next.PrivateKey.Public().(ed25519.PublicKey)),
+			EncryptedPrivateKey: sealed,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rotate token signing key: %w", err)
+	}
+
+	if err := m.store.DeleteExpiredTokenSigningKeys(ctx); err != nil {
+		log.Printf("failed to delete expired token signing keys: %v", err)
+	}
+
+	return m.Load(ctx)
+}
+
+// Run reloads the key ring periodically and rotates the active key once it is
+// older than the rotation interval. It returns when ctx is cancelled.
+func (m *TokenKeyManager) Run(ctx context.Context) {
+	ticker := time.NewTicker(tokenKeyReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := m.Load(ctx); err != nil {
+			log.Printf("failed to reload token signing keys: %v", err)
+			continue
+		}
+
+		active, err := m.ring.Active()
+		if err != nil || time.Since(active.CreatedAt) < m.rotateEvery {
+			continue
+		}
+
+		if err := m.Rotate(ctx); err != nil {
+			log.Printf("failed to rotate token signing key: %v", err)
+		}
+	}
+}
+
+// sealKey encrypts a private key, binding the ciphertext to its key ID
+func (m *TokenKeyManager) sealKey(key *token.SigningKey) ([]byte, error) {
+	nonce := make([]byte, m.aead.NonceSize(), m.aead.NonceSize()+len(key.PrivateKey)+m.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return m.aead.Seal(nonce, nonce, key.PrivateKey, []byte(key.ID)), nil
+}
+
+// openKey decrypts a stored signing key and checks it matches its key ID
+func (m *TokenKeyManager) openKey(stored db.TokenSigningKeys) (*token.SigningKey, error) {
+	if len(stored.EncryptedPrivateKey) < m.aead.NonceSize() {
+		return nil, fmt.Errorf("token signing key %s is malformed", stored.ID)
+	}
+
+	nonce, sealed := stored.EncryptedPrivateKey[:m.aead.NonceSize()], stored.EncryptedPrivateKey[m.aead.NonceSize():]
+	privateKey, err := m.aead.Open(nil, nonce, sealed, []byte(stored.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token signing key %s: %w", stored.ID, err)
+	}
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, errors.New("token signing key has an invalid size")
+	}
+
+	var expiresAt *time.Time
+	if stored.ExpiresAt.Valid {
+		expiresAt = &stored.ExpiresAt.Time
+	}
+
+	key := token.NewSigningKey(ed25519.PrivateKey(privateKey), stored.CreatedAt, expiresAt)
+	if key.ID != stored.ID {
+		return nil, fmt.Errorf("token signing key %s does not match its public key", stored.ID)
+	}
+
+	return key, nil
+}
+
+// ListTokenKeys returns the public keys access tokens may currently be signed with.
+func (r *UserRepository) ListTokenKeys(ctx context.Context) ([]*entity.TokenKey, error) {
+	publicKeys := r.keyRing.PublicKeys()
+
+	keys := make([]*entity.TokenKey, 0, len(publicKeys))
+	for _, k := range publicKeys {
+		keys = append(keys, &entity.TokenKey{
+			KeyID:     k.ID,
+			PublicKey: token.EncodePublicKey(k.Key),
+			CreatedAt: k.CreatedAt,
+			ExpiresAt: k.ExpiresAt,
+		})
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"crypto/ed25519"
+	"database/sql"
+	"testing"
+	"time"
+
+	token_maker "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// testAccessTokenDuration is how long access tokens issued in tests last
+	testAccessTokenDuration = 15 * time.Minute
+	// testRefreshTokenDuration is how long refresh tokens issued in tests last
+	testRefreshTokenDuration = 24 * time.Hour
+)
+
+// newTestKeyRing returns a key ring with a freshly generated active key
+func newTestKeyRing(t *testing.T) *token_maker.KeyRing {
+	key, err := token_maker.GenerateSigningKey()
+	require.NoError(t, err)
+
+	ring := token_maker.NewKeyRing()
+	require.NoError(t, ring.Set([]*token_maker.SigningKey{key}, key.ID))
+	return ring
+}
+
+func newTestTokenKeyManager(t *testing.T, store db.Store, ring *token_maker.KeyRing) *TokenKeyManager {
+	manager, err := NewTokenKeyManager(store, ring, utils.RandomString(32), 24*time.Hour, time.Hour)
+	require.NoError(t, err)
+	return manager
+}
+
+func TestTokenKeyManagerCreatesFirstKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	ring := token_maker.NewKeyRing()
+	manager := newTestTokenKeyManager(t, store, ring)
+
+	var created db.TokenSigningKeys
+	gomock.InOrder(
+		store.EXPECT().ListTokenSigningKeys(gomock.Any()).Return(nil, nil),
+		store.EXPECT().
+			RotateTokenSigningKeyTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg db.RotateTokenSigningKeyTxParams) (db.TokenSigningKeys, error) {
+				require.WithinDuration(t, time.Now().Add(time.Hour), arg.RetireAt, time.Minute)
+				created = db.TokenSigningKeys{
+					ID:                  arg.Next.ID,
+					PublicKey:           arg.Next.PublicKey,
+					EncryptedPrivateKey: arg.Next.EncryptedPrivateKey,
+					CreatedAt:           time.Now(),
+				}
+				return created, nil
+			}),
+		store.EXPECT().DeleteExpiredTokenSigningKeys(gomock.Any()).Return(nil),
+		store.EXPECT().
+			ListTokenSigningKeys(gomock.Any()).
+			DoAndReturn(func(context.Context) ([]db.TokenSigningKeys, error) {
+				return []db.TokenSigningKeys{created}, nil
+			}),
+	)
+
+	require.NoError(t, manager.Load(context.Background()))
+
+	active, err := ring.Active()
+	require.NoError(t, err)
+	require.Equal(t, created.ID, active.ID)
+
+	// The private key is never stored in the clear
+	require.NotContains(t, string(created.EncryptedPrivateKey), string(active.PrivateKey))
+
+	publicKey, err := token_maker.DecodePublicKey(created.PublicKey)
+	require.NoError(t, err)
+	require.Equal(t, active.PrivateKey.Public().(ed25519.PublicKey), publicKey)
+}
+
+func TestTokenKeyManagerLoadsRetiredKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	ring := token_maker.NewKeyRing()
+	manager := newTestTokenKeyManager(t, store, ring)
+
+	active, err := token_maker.GenerateSigningKey()
+	require.NoError(t, err)
+	retired, err := token_maker.GenerateSigningKey()
+	require.NoError(t, err)
+
+	storedKey := func(key *token_maker.SigningKey, expiresAt sql.NullTime) db.TokenSigningKeys {
+		sealed, err := manager.sealKey(key)
+		require.NoError(t, err)
+		return db.TokenSigningKeys{
+			ID:                  key.ID,
+			PublicKey:           token_maker.EncodePublicKey(key.PrivateKey.Public().(ed25519.PublicKey)),
+			EncryptedPrivateKey: sealed,
+			CreatedAt:           time.Now(),
+			ExpiresAt:           expiresAt,
+		}
+	}
+
+	store.EXPECT().
+		ListTokenSigningKeys(gomock.Any()).
+		Return([]db.TokenSigningKeys{
+			storedKey(active, sql.NullTime{}),
+			storedKey(retired, sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}),
+		}, nil)
+
+	require.NoError(t, manager.Load(context.Background()))
+
+	current, err := ring.Active()
+	require.NoError(t, err)
+	require.Equal(t, active.ID, current.ID)
+
+	repo := NewUserRepository(store, ring, testAccessTokenDuration, testRefreshTokenDuration)
+	keys, err := repo.ListTokenKeys(context.Background())
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	for _, key := range keys {
+		if key.KeyID == active.ID {
+			require.Nil(t, key.ExpiresAt)
+		} else {
+			require.Equal(t, retired.ID, key.KeyID)
+			require.NotNil(t, key.ExpiresAt)
+		}
+	}
+}
+
+func TestTokenKeyManagerRejectsTamperedKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	manager := newTestTokenKeyManager(t, store, token_maker.NewKeyRing())
+
+	key, err := token_maker.GenerateSigningKey()
+	require.NoError(t, err)
+	other, err := token_maker.GenerateSigningKey()
+	require.NoError(t, err)
+
+	sealed, err := manager.sealKey(key)
+	require.NoError(t, err)
+
+	// A sealed key moved to another row fails to decrypt
+	_, err = manager.openKey(db.TokenSigningKeys{ID: other.ID, EncryptedPrivateKey: sealed})
+	require.Error(t, err)
+
+	// As does one sealed with a different encryption key
+	otherManager := newTestTokenKeyManager(t, store, token_maker.NewKeyRing())
+	_, err = otherManager.openKey(db.TokenSigningKeys{ID: key.ID, EncryptedPrivateKey: sealed})
+	require.Error(t, err)
+
+	opened, err := manager.openKey(db.TokenSigningKeys{ID: key.ID, EncryptedPrivateKey: sealed, CreatedAt: time.Now()})
+	require.NoError(t, err)
+	require.Equal(t, key.PrivateKey, opened.PrivateKey)
+}
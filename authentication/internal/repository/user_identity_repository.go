@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	uniqueViolation = "23505"
+
+	identityProviderUserKey = "user_identities_provider_user_key"
+	identityUserProviderKey = "user_identities_user_provider_key"
+)
+
+// CreateOAuthUser creates a user who signed up through a provider together with that identity.
+func (r *UserRepository) CreateOAuthUser(ctx context.Context, user *entity.User, identity *entity.UserIdentity) error {
+	userArg, err := createUserParams(user)
+	if err != nil {
+		return err
+	}
+
+	if identity.ID == uuid.Nil {
+		identity.ID = uuid.New()
+	}
+	identity.UserID = user.ID
+
+	_, err = r.store.CreateUserWithIdentityTx(ctx, db.CreateUserWithIdentityTxParams{
+		User:     userArg,
+		Identity: createUserIdentityParams(identity),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create oauth user: %w", identityError(err))
+	}
+
+	return nil
+}
+
+// CreateUserIdentity links a provider identity to an existing user.
+func (r *UserRepository) CreateUserIdentity(ctx context.Context, identity *entity.UserIdentity) error {
+	if identity.ID == uuid.Nil {
+		identity.ID = uuid.New()
+	}
+
+	created, err := r.store.CreateUserIdentity(ctx, createUserIdentityParams(identity))
+	if err != nil {
+		return fmt.Errorf("failed to link identity: %w", identityError(err))
+	}
+
+	*identity = *mapUserIdentity(created)
+	return nil
+}
+
+// GetUserIdentity finds the identity a provider knows a user by.
+func (r *UserRepository) GetUserIdentity(ctx context.Context, provider, providerUserID string) (*entity.UserIdentity, error) {
+	identity, err := r.store.GetUserIdentityByProvider(ctx, db.GetUserIdentityByProviderParams{
+		Provider:       provider,
+		ProviderUserID: providerUserID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrIdentityNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve identity: %w", err)
+	}
+
+	return mapUserIdentity(identity), nil
+}
+
+// ListUserIdentities returns every identity linked to a user, oldest first.
+func (r *UserRepository) ListUserIdentities(ctx context.Context, userID uuid.UUID) ([]*entity.UserIdentity, error) {
+	identities, err := r.store.ListUserIdentities(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+
+	result := make([]*entity.UserIdentity, 0, len(identities))
+	for _, identity := range identities {
+		result = append(result, mapUserIdentity(identity))
+	}
+
+	return result, nil
+}
+
+// TouchUserIdentity records that an identity was just used to sign in.
+func (r *UserRepository) TouchUserIdentity(ctx context.Context, identityID uuid.UUID) error {
+	if err := r.store.TouchUserIdentity(ctx, identityID); err != nil {
+		return fmt.Errorf("failed to update identity: %w", err)
+	}
+	return nil
+}
+
+// DeleteUserIdentity unlinks one of the user's identities.
+func (r *UserRepository) DeleteUserIdentity(ctx context.Context, userID uuid.UUID, identityID uuid.UUID) error {
+	rows, err := r.store.DeleteUserIdentity(ctx, db.DeleteUserIdentityParams{
+		ID:     identityID,
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to unlink identity: %w", err)
+	}
+	if rows == 0 {
+		return entity.ErrIdentityNotFound
+	}
+
+	return nil
+}
+
+func createUserIdentityParams(identity *entity.UserIdentity) db.CreateUserIdentityParams {
+	return db.CreateUserIdentityParams{
+		ID:             identity.ID,
+		UserID:         identity.UserID,
+		Provider:       identity.Provider,
+		ProviderUserID: identity.ProviderUserID,
+		Email:          sql.NullString{String: identity.Email, Valid: identity.Email != ""},
+	}
+}
+
+// identityError turns violations of the identity unique constraints into domain errors
+func identityError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) || pqErr.Code != uniqueViolation {
+		return err
+	}
+
+	switch pqErr.Constraint {
+	case identityProviderUserKey:
+		return entity.ErrIdentityAlreadyLinked
+	case identityUserProviderKey:
+		return entity.ErrProviderAlreadyLinked
+	default:
+		return err
+	}
+}
+
+func mapUserIdentity(identity db.UserIdentities) *entity.UserIdentity {
+	result := &entity.UserIdentity{
+		ID:             identity.ID,
+		UserID:         identity.UserID,
+		Provider:       identity.Provider,
+		ProviderUserID: identity.ProviderUserID,
+		Email:          identity.Email.String,
+		CreatedAt:      identity.CreatedAt,
+	}
+	if identity.LastUsedAt.Valid {
+		lastUsedAt := identity.LastUsedAt.Time
+		result.LastUsedAt = &lastUsedAt
+	}
+	return result
+}
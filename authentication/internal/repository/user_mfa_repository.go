@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// SaveUserMFA stores a pending (not yet confirmed) TOTP secret for a user.
+func (r *UserRepository) SaveUserMFA(ctx context.Context, userID uuid.UUID, secret string) (*entity.UserMFA, error) {
+	mfa, err := r.store.UpsertUserMfa(ctx, db.UpsertUserMfaParams{
+		UserID: userID,
+		Secret: secret,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save mfa secret: %w", err)
+	}
+
+	return mapUserMFA(mfa), nil
+}
+
+// GetUserMFA retrieves the TOTP factor of a user.
+func (r *UserRepository) GetUserMFA(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error) {
+	mfa, err := r.store.GetUserMfa(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrMFANotEnrolled
+		}
+		return nil, fmt.Errorf("failed to retrieve mfa: %w", err)
+	}
+
+	return mapUserMFA(mfa), nil
+}
+
+// EnableUserMFA marks the TOTP factor of a user as confirmed.
+func (r *UserRepository) EnableUserMFA(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.store.EnableUserMfa(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to enable mfa: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateMFALastUsedStep stores the last accepted TOTP step. It only moves
+// forward, so a code that was already accepted cannot be replayed.
+func (r *UserRepository) UpdateMFALastUsedStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	rows, err := r.store.UpdateMfaLastUsedStep(ctx, db.UpdateMfaLastUsedStepParams{
+		UserID:       userID,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to update mfa step: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// DeleteUserMFA removes the TOTP factor and recovery codes of a user.
+func (r *UserRepository) DeleteUserMFA(ctx context.Context, userID uuid.UUID) error {
+	if err := r.store.DeleteRecoveryCodesByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	if err := r.store.DeleteUserMfa(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete mfa: %w", err)
+	}
+
+	return nil
+}
+
+// ReplaceRecoveryCodes replaces the recovery codes of a user.
+func (r *UserRepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	err := r.store.ReplaceRecoveryCodesTx(ctx, db.ReplaceRecoveryCodesTxParams{
+		UserID:     userID,
+		CodeHashes: codeHashes,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replace recovery codes: %w", err)
+	}
+
+	return nil
+}
+
+// UseRecoveryCode redeems a recovery code of a user.
+func (r *UserRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	rows, err := r.store.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// CreateMFAChallenge stores a new second factor challenge.
+func (r *UserRepository) CreateMFAChallenge(ctx context.Context, challenge *entity.MFAChallenge) error {
+	_, err := r.store.CreateMfaChallenge(ctx, db.CreateMfaChallengeParams{
+		ID:        challenge.ID,
+		UserID:    challenge.UserID,
+		IpAddress: sql.NullString{String: challenge.IpAddress, Valid: challenge.IpAddress != ""},
+		UserAgent: sql.NullString{String: challenge.UserAgent, Valid: challenge.UserAgent != ""},
+		ExpiresAt: challenge.ExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create mfa challenge: %w", err)
+	}
+
+	return nil
+}
+
+// GetMFAChallenge retrieves a second factor challenge by its ID.
+func (r *UserRepository) GetMFAChallenge(ctx context.Context, challengeID uuid.UUID) (*entity.MFAChallenge, error) {
+	challenge, err := r.store.GetMfaChallenge(ctx, challengeID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrMFAChallengeInvalid
+		}
+		return nil, fmt.Errorf("failed to retrieve mfa challenge: %w", err)
+	}
+
+	var consumedAt *time.Time
+	if challenge.ConsumedAt.Valid {
+		consumedAt = &challenge.ConsumedAt.Time
+	}
+
+	return &entity.MFAChallenge{
+		ID:         challenge.ID,
+		UserID:     challenge.UserID,
+		Attempts:   int(challenge.Attempts),
+		IpAddress:  challenge.IpAddress.String,
+		UserAgent:  challenge.UserAgent.String,
+		ExpiresAt:  challenge.ExpiresAt,
+		ConsumedAt: consumedAt,
+		CreatedAt:  challenge.CreatedAt,
+	}, nil
+}
+
+// IncrementMFAChallengeAttempts records a failed attempt against a challenge.
+func (r *UserRepository) IncrementMFAChallengeAttempts(ctx context.Context, challengeID uuid.UUID) error {
+	_, err := r.store.IncrementMfaChallengeAttempts(ctx, challengeID)
+	if err != nil {
+		return fmt.Errorf("failed to update mfa challenge: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeMFAChallenge marks a challenge as completed.
+func (r *UserRepository) ConsumeMFAChallenge(ctx context.Context, challengeID uuid.UUID) (bool, error) {
+	rows, err := r.store.ConsumeMfaChallenge(ctx, challengeID)
+	if err != nil {
+		return false, fmt.Errorf("failed to consume mfa challenge: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+func mapUserMFA(mfa db.UserMfa) *entity.UserMFA {
+	var confirmedAt *time.Time
+	if mfa.ConfirmedAt.Valid {
+		confirmedAt = &mfa.ConfirmedAt.Time
+	}
+
+	return &entity.UserMFA{
+		UserID:       mfa.UserID,
+		Secret:       mfa.Secret,
+		Enabled:      mfa.Enabled,
+		ConfirmedAt:  confirmedAt,
+		LastUsedStep: mfa.LastUsedStep,
+		CreatedAt:    mfa.CreatedAt,
+		UpdatedAt:    mfa.UpdatedAt,
+	}
+}
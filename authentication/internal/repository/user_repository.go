@@ -24,7 +24,10 @@ import (
 // UserRepository implements the AuthRepository interface.
 // This struct interacts with the database using SQLC-generated code.
 type UserRepository struct {
-	store db.Store
+	store                db.Store
+	keyRing              *token.KeyRing
+	accessTokenDuration  time.Duration
+	refreshTokenDuration time.Duration
 }
 
 // UpdateUser implements repository.UserRepository.
@@ -82,9 +85,8 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user *entity.User) erro
 		},
 		Role: sql.NullString{
 			String: user.Role,
-			Valid:  true,
+			Valid:  user.Role != "",
 		},
-		Email: user.Email,
 		Password: sql.NullString{
 			String: user.Password,
 			Valid:  true,
@@ -104,11 +106,6 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user *entity.User) erro
 	user.IsActive = updatedUser.IsActive.Bool
 	user.EmailVerified = updatedUser.EmailVerified.Bool
 	user.Phone = updatedUser.Phone.String
-	user.Provider = utils.ProviderType{
-		Name:      updatedUser.Provider.String,
-		ID:        updatedUser.ProviderID.String,
-		TokenData: updatedUser.ProviderID.String,
-	}
 	user.EmailVerified = updatedUser.EmailVerified.Bool
 
 	return nil
@@ -116,32 +113,56 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user *entity.User) erro
 }
 
 // CreateToken implements repository.UserRepository.
-func (r *UserRepository) CreateToken(ctx context.Context, email string, userID string) (string, error) {
-	// Load configuration
-	configs, err := config.LoadConfig("../../")
+func (r *UserRepository) CreateToken(ctx context.Context, email string, userID string, sessionID string, role string) (string, time.Time, error) {
+	tokenMaker := token.NewPasetoV4Maker(r.keyRing)
+
+	organization, err := r.sessionOrganization(ctx, sessionID)
 	if err != nil {
-		log.Fatalf("Failed to load env file: %v", err)
+		return "", time.Time{}, err
 	}
 
-	tokenMaker, err := token.NewTokenMaker(configs.TokenSymmetricKey)
+	var accessToken string
+	var payload *token.Payload
+	if organization != nil {
+		accessToken, payload, err = tokenMaker.CreateOrganizationToken(email, userID, sessionID, []string{role}, organization.OrganizationID.String(), organization.Role, r.accessTokenDuration)
+	} else {
+		accessToken, payload, err = tokenMaker.CreateToken(email, userID, sessionID, []string{role}, r.accessTokenDuration)
+	}
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create access token: %w", err)
+	}
+
+	return accessToken, payload.ExpiredAt, nil
+}
 
+// sessionOrganization returns the organization a session acts for and the
+// user's role in it, or nil if it acts for none.
+func (r *UserRepository) sessionOrganization(ctx context.Context, sessionID string) (*db.GetSessionOrganizationRow, error) {
+	id, err := uuid.Parse(sessionID)
 	if err != nil {
-		log.Fatalf("Failed to load env file: %s", err)
+		return nil, nil
 	}
 
-	duration := time.Hour * 24
-	accessToken, _, err := tokenMaker.CreateToken(email, userID, duration)
+	organization, err := r.store.GetSessionOrganization(ctx, id)
 	if err != nil {
-		return "", fmt.Errorf("some went wrong: %d", err)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve session organization: %w", err)
 	}
 
-	return accessToken, nil
+	return &organization, nil
 }
 
-// NewUserRepository creates a new instance of UserRepository.
-func NewUserRepository(store db.Store) *UserRepository {
+// NewUserRepository creates a new instance of UserRepository. Access tokens
+// are signed with the active key of keyRing and expire after
+// accessTokenDuration; refresh tokens expire after refreshTokenDuration.
+func NewUserRepository(store db.Store, keyRing *token.KeyRing, accessTokenDuration time.Duration, refreshTokenDuration time.Duration) *UserRepository {
 	return &UserRepository{
-		store: store,
+		store:                store,
+		keyRing:              keyRing,
+		accessTokenDuration:  accessTokenDuration,
+		refreshTokenDuration: refreshTokenDuration,
 	}
 }
 
@@ -152,62 +173,37 @@ func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*ent
 		return nil, fmt.Errorf("failed to retrieve user by email %s: %w", email, err)
 	}
 
-	password := userDetails.Password.String
-
-	// Create ProviderType using the new unified format
-	provider := utils.ProviderType{}
-	if userDetails.Provider.Valid {
-		provider.SetProvider(userDetails.Provider.String, userDetails.ProviderID.String)
-	} else {
-		// Default to local if provider not set
-		provider.SetProvider("local", userDetails.Email)
-	}
-
-	return &entity.User{
-		ID:             userDetails.ID,
-		FullName:       userDetails.Name,
-		Username:       userDetails.Username,
-		Email:          userDetails.Email,
-		Provider:       provider,
-		ProviderID:     userDetails.ProviderID.String,
-		ProfilePicture: userDetails.ProfilePicture.String,
-		Role:           userDetails.Role.String,
-		Password:       password,
-		Phone:          userDetails.Phone.String,
-		EmailVerified:  userDetails.EmailVerified.Bool,
-		IsActive:       userDetails.IsActive.Bool,
-		LastLogin:      userDetails.LastLogin.Time,
-		CreatedAt:      userDetails.CreatedAt.Time,
-		UpdatedAt:      userDetails.UpdatedAt.Time,
-	}, nil
+	return mapUser(userDetails), nil
 }
 
 func (r *UserRepository) CreateUser(ctx context.Context, user *entity.User) error {
-	if user.ID == uuid.Nil {
-		user.ID = uuid.New()
+	arg, err := createUserParams(user)
+	if err != nil {
+		return err
 	}
 
-	// Get provider information using the unified method
-	provider, providerID := user.Provider.GetProviderInfo()
+	_, err = r.store.CreateUser(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("failed to create user: %w", err)
+	}
 
-	// Convert to sql.NullString
-	var providerIDNullable sql.NullString
-	if providerID != "" {
-		providerIDNullable = sql.NullString{String: providerID, Valid: true}
-	} else {
-		providerIDNullable = sql.NullString{Valid: false}
+	return nil
+}
+
+// createUserParams maps a new user onto the insert parameters, hashing the
+// password if one is set. Users who signed up through OAuth have none.
+func createUserParams(user *entity.User) (db.CreateUserParams, error) {
+	if user.ID == uuid.Nil {
+		user.ID = uuid.New()
 	}
 
-	// Hash password only for local users
 	var hashedPasswordString sql.NullString
-	if provider == "local" && user.Password != "" {
+	if user.Password != "" {
 		hashedPassword, err := utils.HashPassword(user.Password)
 		if err != nil {
-			return fmt.Errorf("failed to hash password: %w", err)
+			return db.CreateUserParams{}, fmt.Errorf("failed to hash password: %w", err)
 		}
 		hashedPasswordString = sql.NullString{String: hashedPassword, Valid: true}
-	} else {
-		hashedPasswordString = sql.NullString{Valid: false}
 	}
 
 	username := user.Username
@@ -219,7 +215,7 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *entity.User) erro
 		}
 	}
 
-	_, err := r.store.CreateUser(ctx, db.CreateUserParams{
+	return db.CreateUserParams{
 		ID:             user.ID,
 		Name:           user.FullName,
 		Username:       username,
@@ -229,18 +225,10 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *entity.User) erro
 		Bio:            sql.NullString{String: "", Valid: true},
 		Role:           sql.NullString{String: user.Role, Valid: user.Role != ""},
 		Phone:          sql.NullString{String: user.Phone, Valid: user.Phone != ""},
-		Provider:       sql.NullString{String: provider, Valid: true},
-		ProviderID:     providerIDNullable,
-		EmailVerified:  sql.NullBool{Bool: false, Valid: true},
+		EmailVerified:  sql.NullBool{Bool: user.EmailVerified, Valid: true},
 		IsActive:       sql.NullBool{Bool: true, Valid: true},
 		LastLogin:      sql.NullTime{Valid: false},
-	})
-
-	if err != nil {
-		return fmt.Errorf("failed to create user: %w", err)
-	}
-
-	return nil
+	}, nil
 }
 
 // UpdatePassword updates a user's password in the database.
@@ -273,25 +261,13 @@ func (r *UserRepository) UpdatePassword(ctx context.Context, email string, newPa
 func (r *UserRepository) GetUserByID(ctx context.Context, id string) (*entity.User, error) {
 	userDetails, err := r.store.GetUser(ctx, id)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrUserNotFound
+		}
 		return nil, err
 	}
 
-	password := userDetails.Password.String
-	if password == "" {
-		return nil, fmt.Errorf("password cannot be empty for user with ID %s", id)
-	}
-
-	return &entity.User{
-		ID:        userDetails.ID,
-		FullName:  userDetails.Name,
-		Email:     userDetails.Email,
-		CreatedAt: userDetails.CreatedAt.Time,
-		Password:  password,
-		Role:      userDetails.Role.String,
-		Phone:     userDetails.Phone.String,
-		UpdatedAt: userDetails.UpdatedAt.Time,
-	}, nil
-
+	return mapUser(userDetails), nil
 }
 
 func (r *UserRepository) GetUserSession(ctx context.Context, sessionID uuid.UUID) (*entity.Session, error) {
@@ -395,57 +371,6 @@ func (r *UserRepository) DeleteSession(ctx context.Context, id string) error {
 	return err
 }
 
-func (r *UserRepository) UpdateOtp(ctx context.Context, userOtp *entity.UpdateOtp) error {
-	// Get User ID from the session
-	user, err := r.GetUserByEmail(ctx, userOtp.Email)
-	if err != nil {
-		return err
-	}
-
-	// Call UpdateOtp with the mapped parameters
-	_, err = r.store.UpdateSession(ctx, db.UpdateSessionParams{
-		UserID:       user.ID,
-		Otp:          sql.NullString{String: userOtp.Otp, Valid: true},
-		OtpExpiresAt: sql.NullTime{Time: userOtp.OtpExpiresAt, Valid: true},
-		OtpAttempts:  sql.NullInt32{Int32: int32(userOtp.OtpAttempts), Valid: true},
-		OtpVerified:  sql.NullBool{Bool: userOtp.OTPVerified, Valid: true},
-	})
-	if err != nil {
-		return err
-	}
-
-	return nil
-
-}
-
-func (r *UserRepository) GetOtp(ctx context.Context, email string) (*entity.UpdateOtp, error) {
-	// Retrieve user by email
-	user, err := r.GetUserByEmail(ctx, email)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve user by email %s: %w", email, err)
-	}
-
-	// Convert userID from string to UUID
-	userUUID, err := uuid.Parse(string(user.ID.String()))
-	if err != nil {
-		return nil, fmt.Errorf("invalid user ID format: %w", err)
-	}
-
-	// Call GetOtp with the mapped parameters
-	otp, err := r.store.GetSessionByUserID(ctx, userUUID)
-	if err != nil {
-		return nil, err
-	}
-
-	// Map session details to the entity.Session struct
-	return &entity.UpdateOtp{
-		Otp:          otp.Otp.String,
-		OtpExpiresAt: otp.OtpExpiresAt.Time,
-		OtpAttempts:  int(otp.OtpAttempts.Int32),
-	}, nil
-
-}
-
 // CreatePasswordReset stores a password reset token
 func (r *UserRepository) CreatePasswordReset(ctx context.Context, userID uuid.UUID, token string, expiresAt time.Time) error {
 	// First, invalidate any existing reset tokens for this user
@@ -586,6 +511,12 @@ func (r *UserRepository) RevokeAllSessions(ctx context.Context, userID uuid.UUID
 		return fmt.Errorf("failed to revoke sessions: %w", err)
 	}
 
+	// Refresh tokens must not outlive the sessions they were issued for
+	err = r.store.RevokeRefreshTokensByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
 	return nil
 }
 
@@ -600,27 +531,40 @@ func (r *UserRepository) DeleteUser(ctx context.Context, userID uuid.UUID) error
 	return nil
 }
 
-// GetLoginHistory retrieves login history for a user
-func (r *UserRepository) GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.LoginHistoryEntry, error) {
-	// Get login history from database
-	history, err := r.store.GetLoginHistory(ctx, db.GetLoginHistoryParams{
-		UserID: userID,
-		Limit:  int32(limit),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve login history: %w", err)
+func mapUser(user db.Users) *entity.User {
+	result := &entity.User{
+		ID:             user.ID,
+		FullName:       user.Name,
+		Username:       user.Username,
+		Email:          user.Email,
+		Bio:            user.Bio.String,
+		ProfilePicture: user.ProfilePicture.String,
+		Role:           user.Role.String,
+		Password:       user.Password.String,
+		Phone:          user.Phone.String,
+		EmailVerified:  user.EmailVerified.Bool,
+		IsActive:       user.IsActive.Bool,
+		LastLogin:      user.LastLogin.Time,
+		CreatedAt:      user.CreatedAt.Time,
+		UpdatedAt:      user.UpdatedAt.Time,
+		LockedReason:   user.LockedReason.String,
 	}
 
-	// Map database login history to entity login history
-	var result []*entity.LoginHistoryEntry
-	for _, entry := range history {
-		result = append(result, &entity.LoginHistoryEntry{
-			ID:        entry.UserID,
-			UserID:    entry.UserID,
-			IpAddress: entry.IpAddress.String,
-			UserAgent: entry.UserAgent.String,
-		})
+	if user.LockedAt.Valid {
+		result.LockedAt = &user.LockedAt.Time
+	}
+	if user.DeletedAt.Valid {
+		result.DeletedAt = &user.DeletedAt.Time
+	}
+	if user.DeletionRequestedAt.Valid {
+		result.DeletionRequestedAt = &user.DeletionRequestedAt.Time
+	}
+	if user.DeletionScheduledFor.Valid {
+		result.DeletionScheduledFor = &user.DeletionScheduledFor.Time
+	}
+	if user.AgentVerifiedAt.Valid {
+		result.AgentVerifiedAt = &user.AgentVerifiedAt.Time
 	}
 
-	return result, nil
+	return result
 }
@@ -10,10 +10,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	"github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
 	mockdb "github.com/demola234/authentication/db/mock"
 	db "github.com/demola234/authentication/db/sqlc"
 	"github.com/demola234/authentication/internal/domain/entity"
 	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/shared/rbac"
 )
 
 func TestGetUserByEmail(t *testing.T) {
@@ -21,7 +23,7 @@ func TestGetUserByEmail(t *testing.T) {
 	defer ctrl.Finish()
 
 	store := mockdb.NewMockStore(ctrl)
-	repo := NewUserRepository(store)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
 
 	user := db.Users{
 		ID:        uuid.New(),
@@ -53,7 +55,7 @@ func TestCreateUser(t *testing.T) {
 	defer ctrl.Finish()
 
 	store := mockdb.NewMockStore(ctrl)
-	repo := NewUserRepository(store)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
 
 	user := &entity.User{
 		ID:       uuid.New(),
@@ -78,15 +80,46 @@ func TestCreateToken(t *testing.T) {
 	defer ctrl.Finish()
 
 	store := mockdb.NewMockStore(ctrl)
-	repo := NewUserRepository(store)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
 
 	email := utils.RandomEmail()
 	userID := uuid.New().String()
 
-	token, err := repo.CreateToken(context.Background(), email, userID)
+	store.EXPECT().
+		GetSessionOrganization(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.GetSessionOrganizationRow{}, sql.ErrNoRows)
+
+	token, expiresAt, err := repo.CreateToken(context.Background(), email, userID, uuid.New().String(), string(rbac.RoleBuyer))
 
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
+	require.WithinDuration(t, time.Now().Add(testAccessTokenDuration), expiresAt, time.Minute)
+}
+
+func TestCreateTokenWithOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	keyRing := newTestKeyRing(t)
+	repo := NewUserRepository(store, keyRing, testAccessTokenDuration, testRefreshTokenDuration)
+
+	sessionID := uuid.New()
+	organizationID := uuid.New()
+
+	store.EXPECT().
+		GetSessionOrganization(gomock.Any(), sessionID).
+		Times(1).
+		Return(db.GetSessionOrganizationRow{OrganizationID: organizationID, Role: string(rbac.OrgRoleManager)}, nil)
+
+	accessToken, _, err := repo.CreateToken(context.Background(), utils.RandomEmail(), uuid.New().String(), sessionID.String(), string(rbac.RoleAgent))
+	require.NoError(t, err)
+
+	payload, err := token_maker.NewPasetoV4Maker(keyRing).VerifyToken(accessToken)
+	require.NoError(t, err)
+	require.Equal(t, organizationID.String(), payload.OrganizationID)
+	require.Equal(t, string(rbac.OrgRoleManager), payload.OrganizationRole)
 }
 
 func TestUpdatePassword(t *testing.T) {
@@ -94,7 +127,7 @@ func TestUpdatePassword(t *testing.T) {
 	defer ctrl.Finish()
 
 	store := mockdb.NewMockStore(ctrl)
-	repo := NewUserRepository(store)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
 
 	userID := uuid.New().String()
 	newPassword := utils.RandomString(10)
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// CreateVerificationChallenge stores a new verification code. Only the most
+// recent challenge for a purpose is usable, so older pending ones are consumed.
+func (r *UserRepository) CreateVerificationChallenge(ctx context.Context, challenge *entity.VerificationChallenge) error {
+	// Challenges are kept for a day after expiring, then cleaned up
+	if err := r.store.DeleteExpiredVerificationChallenges(ctx); err != nil {
+		log.Printf("failed to delete expired verification challenges: %v", err)
+	}
+
+	err := r.store.InvalidateVerificationChallenges(ctx, db.InvalidateVerificationChallengesParams{
+		UserID:  challenge.UserID,
+		Purpose: challenge.Purpose,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to invalidate previous verification challenges: %w", err)
+	}
+
+	created, err := r.store.CreateVerificationChallenge(ctx, db.CreateVerificationChallengeParams{
+		ID:          challenge.ID,
+		UserID:      challenge.UserID,
+		Purpose:     challenge.Purpose,
+		CodeHash:    challenge.CodeHash,
+		Salt:        challenge.Salt,
+		MaxAttempts: int32(challenge.MaxAttempts),
+		IpAddress:   sql.NullString{String: challenge.IpAddress, Valid: challenge.IpAddress != ""},
+		UserAgent:   sql.NullString{String: challenge.UserAgent, Valid: challenge.UserAgent != ""},
+		ExpiresAt:   challenge.ExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create verification challenge: %w", err)
+	}
+
+	challenge.CreatedAt = created.CreatedAt
+
+	return nil
+}
+
+// GetActiveVerificationChallenge retrieves the latest unconsumed challenge of a
+// user for a purpose, or entity.ErrVerificationNotFound.
+func (r *UserRepository) GetActiveVerificationChallenge(ctx context.Context, userID uuid.UUID, purpose string) (*entity.VerificationChallenge, error) {
+	challenge, err := r.store.GetActiveVerificationChallenge(ctx, db.GetActiveVerificationChallengeParams{
+		UserID:  userID,
+		Purpose: purpose,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrVerificationNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve verification challenge: %w", err)
+	}
+
+	return mapVerificationChallenge(challenge), nil
+}
+
+// IncrementVerificationChallengeAttempts records a wrong code against a challenge
+// and returns the new attempt count.
+func (r *UserRepository) IncrementVerificationChallengeAttempts(ctx context.Context, challengeID uuid.UUID) (int, error) {
+	challenge, err := r.store.IncrementVerificationChallengeAttempts(ctx, challengeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update verification challenge: %w", err)
+	}
+
+	return int(challenge.Attempts), nil
+}
+
+// MarkVerificationChallengeVerified records that the correct code was entered,
+// returning false if the challenge was already verified, used up or expired.
+func (r *UserRepository) MarkVerificationChallengeVerified(ctx context.Context, challengeID uuid.UUID) (bool, error) {
+	rows, err := r.store.MarkVerificationChallengeVerified(ctx, challengeID)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify verification challenge: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// ConsumeVerificationChallenge uses up the verified challenge of a user for a
+// purpose, returning false if there is none.
+func (r *UserRepository) ConsumeVerificationChallenge(ctx context.Context, userID uuid.UUID, purpose string) (bool, error) {
+	rows, err := r.store.ConsumeVerificationChallenge(ctx, db.ConsumeVerificationChallengeParams{
+		UserID:  userID,
+		Purpose: purpose,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to consume verification challenge: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// SetVerificationChallengeResetToken stores the hash of the token a verified
+// challenge is exchanged for, returning false if the challenge is no longer
+// verified and pending.
+func (r *UserRepository) SetVerificationChallengeResetToken(ctx context.Context, challengeID uuid.UUID, tokenHash string) (bool, error) {
+	rows, err := r.store.SetVerificationChallengeResetToken(ctx, db.SetVerificationChallengeResetTokenParams{
+		ID:             challengeID,
+		ResetTokenHash: sql.NullString{String: tokenHash, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to store reset token: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// GetVerificationChallengeByResetToken retrieves the pending challenge a reset
+// token was issued for, or entity.ErrVerificationNotFound.
+func (r *UserRepository) GetVerificationChallengeByResetToken(ctx context.Context, tokenHash string) (*entity.VerificationChallenge, error) {
+	challenge, err := r.store.GetVerificationChallengeByResetToken(ctx, sql.NullString{String: tokenHash, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrVerificationNotFound
+		}
+		return nil, fmt.Errorf("failed to retrieve verification challenge: %w", err)
+	}
+
+	return mapVerificationChallenge(challenge), nil
+}
+
+// ConsumeVerificationChallengeByResetToken uses up the challenge a reset token
+// was issued for, returning false if it was already used or has expired.
+func (r *UserRepository) ConsumeVerificationChallengeByResetToken(ctx context.Context, tokenHash string) (bool, error) {
+	rows, err := r.store.ConsumeVerificationChallengeByResetToken(ctx, sql.NullString{String: tokenHash, Valid: true})
+	if err != nil {
+		return false, fmt.Errorf("failed to consume verification challenge: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// MarkEmailVerified records that a user proved they own their email address.
+func (r *UserRepository) MarkEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	if err := r.store.UpdateEmailVerification(ctx, userID); err != nil {
+		return fmt.Errorf("failed to mark email verified: %w", err)
+	}
+
+	return nil
+}
+
+func mapVerificationChallenge(challenge db.VerificationChallenges) *entity.VerificationChallenge {
+	result := &entity.VerificationChallenge{
+		ID:          challenge.ID,
+		UserID:      challenge.UserID,
+		Purpose:     challenge.Purpose,
+		CodeHash:    challenge.CodeHash,
+		Salt:        challenge.Salt,
+		Attempts:    int(challenge.Attempts),
+		MaxAttempts: int(challenge.MaxAttempts),
+		IpAddress:   challenge.IpAddress.String,
+		UserAgent:   challenge.UserAgent.String,
+		ExpiresAt:   challenge.ExpiresAt,
+		CreatedAt:   challenge.CreatedAt,
+	}
+
+	if challenge.VerifiedAt.Valid {
+		result.VerifiedAt = &challenge.VerifiedAt.Time
+	}
+	if challenge.ConsumedAt.Valid {
+		result.ConsumedAt = &challenge.ConsumedAt.Time
+	}
+
+	return result
+}
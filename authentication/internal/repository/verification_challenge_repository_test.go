@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/authentication/db/mock"
+	db "github.com/demola234/authentication/db/sqlc"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateVerificationChallenge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	challenge := &entity.VerificationChallenge{
+		ID:          uuid.New(),
+		UserID:      uuid.New(),
+		Purpose:     entity.VerificationPurposeSignup,
+		CodeHash:    "hash",
+		Salt:        "salt",
+		MaxAttempts: 5,
+		IpAddress:   "203.0.113.7",
+		ExpiresAt:   time.Now().Add(10 * time.Minute),
+	}
+	createdAt := time.Now()
+
+	gomock.InOrder(
+		store.EXPECT().DeleteExpiredVerificationChallenges(gomock.Any()).Return(nil),
+		store.EXPECT().
+			InvalidateVerificationChallenges(gomock.Any(), db.InvalidateVerificationChallengesParams{
+				UserID:  challenge.UserID,
+				Purpose: entity.VerificationPurposeSignup,
+			}).
+			Return(nil),
+		store.EXPECT().
+			CreateVerificationChallenge(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg db.CreateVerificationChallengeParams) (db.VerificationChallenges, error) {
+				require.Equal(t, challenge.ID, arg.ID)
+				require.Equal(t, "hash", arg.CodeHash)
+				require.Equal(t, "salt", arg.Salt)
+				require.Equal(t, int32(5), arg.MaxAttempts)
+				require.Equal(t, sql.NullString{String: "203.0.113.7", Valid: true}, arg.IpAddress)
+				require.False(t, arg.UserAgent.Valid)
+				return db.VerificationChallenges{ID: arg.ID, CreatedAt: createdAt}, nil
+			}),
+	)
+
+	err := repo.CreateVerificationChallenge(context.Background(), challenge)
+	require.NoError(t, err)
+	require.Equal(t, createdAt, challenge.CreatedAt)
+}
+
+func TestGetActiveVerificationChallengeNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	repo := NewUserRepository(store, newTestKeyRing(t), testAccessTokenDuration, testRefreshTokenDuration)
+
+	store.EXPECT().
+		GetActiveVerificationChallenge(gomock.Any(), gomock.Any()).
+		Return(db.VerificationChallenges{}, sql.ErrNoRows)
+
+	_, err := repo.GetActiveVerificationChallenge(context.Background(), uuid.New(), entity.VerificationPurposePasswordReset)
+	require.ErrorIs(t, err, entity.ErrVerificationNotFound)
+}
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/domain/repository"
+	"github.com/demola234/shared/events"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// accountDeletionGracePeriod is how long a user has to change their mind
+	// by signing in again before their account is erased
+	accountDeletionGracePeriod = 30 * 24 * time.Hour
+	// accountDeletionInterval is how often due deletions are carried out
+	accountDeletionInterval  = 10 * time.Minute
+	accountDeletionBatchSize = 100
+)
+
+// cancelScheduledDeletion keeps the account of a user who signs in during
+// the deletion grace period.
+func (u *userUsecase) cancelScheduledDeletion(ctx context.Context, user *entity.User) error {
+	if user.DeletionScheduledFor == nil {
+		return nil
+	}
+
+	cancelled, err := u.userRepo.CancelUserDeletion(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	user.DeletionRequestedAt = nil
+	user.DeletionScheduledFor = nil
+	if cancelled {
+		u.recordUserSuccess(ctx, entity.AuthEventAccountDeletionCancelled, user.ID, user.Email, nil)
+	}
+
+	return nil
+}
+
+// RunAccountDeletions erases the accounts whose deletion grace period has
+// ended and tells the services in erasureServices to erase their data too. It
+// runs every accountDeletionInterval and returns when ctx is cancelled.
+func RunAccountDeletions(ctx context.Context, userRepo repository.UserRepository, messageQueue repository.MessageQueue, erasureServices []string) {
+	ticker := time.NewTicker(accountDeletionInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := eraseDueAccounts(ctx, userRepo, erasureServices); err != nil {
+			log.Printf("failed to erase accounts: %v", err)
+		}
+		if err := publishErasures(ctx, userRepo, messageQueue); err != nil {
+			log.Printf("failed to publish account erasures: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// eraseDueAccounts deletes the users whose deletion is due. The erasure is
+// recorded in the same transaction so publishErasures picks it up even if
+// the service stops right after.
+func eraseDueAccounts(ctx context.Context, userRepo repository.UserRepository, erasureServices []string) error {
+	users, err := userRepo.ListUsersDueForDeletion(ctx, time.Now(), accountDeletionBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		erased, err := userRepo.EraseUser(ctx, user, erasureServices)
+		if err != nil {
+			return err
+		}
+		if !erased {
+			continue
+		}
+
+		// The email is left out since the audit log outlives the account
+		event := &entity.AuthEvent{
+			ID:        uuid.New(),
+			EventType: entity.AuthEventAccountDeleted,
+			Outcome:   entity.AuthEventSuccess,
+			ActorID:   &user.ID,
+			UserID:    &user.ID,
+		}
+		if err := userRepo.CreateAuthEvent(ctx, event); err != nil {
+			log.Printf("failed to record %s auth event: %v", event.EventType, err)
+		}
+	}
+
+	return nil
+}
+
+// publishErasures publishes user.deleted for every erasure that has not been
+// published yet. Consumers must tolerate the same event twice, since a crash
+// between publishing and marking it published sends it again.
+func publishErasures(ctx context.Context, userRepo repository.UserRepository, messageQueue repository.MessageQueue) error {
+	erasures, err := userRepo.ListUnpublishedErasures(ctx, accountDeletionBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, erasure := range erasures {
+		eventData, err := json.Marshal(events.UserDeleted{
+			UserID:      erasure.UserID.String(),
+			RequestedAt: erasure.RequestedAt.UTC(),
+			DeletedAt:   erasure.DeletedAt.UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s event: %w", events.UserDeletedKey, err)
+		}
+
+		if err := messageQueue.PublishMessage(ctx, []byte(events.UserDeletedKey), eventData); err != nil {
+			return fmt.Errorf("failed to publish %s event: %w", events.UserDeletedKey, err)
+		}
+
+		if err := userRepo.MarkErasurePublished(ctx, erasure.UserID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HandleErasureReports returns a handler for the reports services publish
+// once they have erased a deleted user's data. Reports that can never be
+// stored are logged and dropped rather than retried.
+func HandleErasureReports(userRepo repository.UserRepository) func(ctx context.Context, key, value []byte) error {
+	return func(ctx context.Context, key, value []byte) error {
+		if string(key) != events.ErasureReportKey {
+			return nil
+		}
+
+		var report events.ErasureReport
+		if err := json.Unmarshal(value, &report); err != nil {
+			log.Printf("dropping malformed %s event: %v", events.ErasureReportKey, err)
+			return nil
+		}
+
+		userID, err := uuid.Parse(report.UserID)
+		if err != nil {
+			log.Printf("dropping %s event with invalid user ID %q", events.ErasureReportKey, report.UserID)
+			return nil
+		}
+
+		updated, err := userRepo.UpdateAccountErasureService(ctx, userID, &entity.AccountErasureService{
+			Service: report.Service,
+			Status:  report.Status,
+			Items:   report.Items,
+			Detail:  report.Detail,
+		})
+		if err != nil {
+			return err
+		}
+		if !updated {
+			log.Printf("dropping %s event from %s for unknown erasure of user %s", events.ErasureReportKey, report.Service, userID)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/shared/events"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteAccountSchedulesDeletion(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mockMailer, new(MockMessageQueue))
+	ctx := context.Background()
+
+	hashedPassword, _ := utils.HashPassword("password123")
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com", FullName: "Test User", Password: hashedPassword}
+	var event *entity.AuthEvent
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("ScheduleUserDeletion", ctx, user.ID, mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockRepo.On("RevokeAllSessions", ctx, user.ID).Return(nil)
+	mockRepo.On("RevokeUserSessionTokens", ctx, user.ID, entity.SessionRevokedDeleted).Return(nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+		Run(func(args mock.Arguments) { event = args.Get(1).(*entity.AuthEvent) }).
+		Return(nil)
+
+	// Execute test
+	scheduledFor, err := useCase.DeleteAccount(ctx, "password123", user.ID.String())
+
+	// Assertions
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(accountDeletionGracePeriod), scheduledFor, time.Minute)
+	mockRepo.AssertNotCalled(t, "DeleteUser", mock.Anything, mock.Anything)
+	mockRepo.AssertCalled(t, "RevokeAllSessions", ctx, user.ID)
+
+	require.Equal(t, entity.AuthEventAccountDeletionScheduled, event.EventType)
+	require.Equal(t, entity.AuthEventSuccess, event.Outcome)
+
+	sent := mockMailer.Last()
+	require.NotNil(t, sent)
+	require.Contains(t, sent.Subject, "scheduled for deletion")
+	require.Contains(t, sent.TextBody, scheduledFor.UTC().Format(time.RFC1123))
+}
+
+func TestDeleteAccountAlreadyScheduled(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	hashedPassword, _ := utils.HashPassword("password123")
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com", Password: hashedPassword}
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("ScheduleUserDeletion", ctx, user.ID, mock.AnythingOfType("time.Time")).Return(false, nil)
+
+	// Execute test
+	_, err := useCase.DeleteAccount(ctx, "password123", user.ID.String())
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrDeletionAlreadyScheduled)
+	mockRepo.AssertNotCalled(t, "RevokeUserSessionTokens", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLoginUserCancelsScheduledDeletion(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "test@example.com"
+	hashedPassword, _ := utils.HashPassword("password123")
+	requestedAt := time.Now().Add(-24 * time.Hour)
+	scheduledFor := requestedAt.Add(accountDeletionGracePeriod)
+	mockUser := &entity.User{
+		ID:                   uuid.New(),
+		Email:                email,
+		Password:             hashedPassword,
+		DeletionRequestedAt:  &requestedAt,
+		DeletionScheduledFor: &scheduledFor,
+	}
+	var eventTypes []string
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+		Run(func(args mock.Arguments) { eventTypes = append(eventTypes, args.Get(1).(*entity.AuthEvent).EventType) }).
+		Return(nil)
+	mockRepo.On("GetLoginFamiliarity", ctx, mockUser.ID, mock.Anything, mock.Anything).Return(&entity.LoginFamiliarity{}, nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.Anything).Return(nil, nil)
+	mockRepo.On("ResetAuthThrottle", ctx, mock.Anything).Return(nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(mockUser, nil)
+	mockRepo.On("GetUserMFA", ctx, mockUser.ID).Return(nil, entity.ErrMFANotEnrolled)
+	mockRepo.On("CancelUserDeletion", ctx, mockUser.ID).Return(true, nil)
+	mockRepo.On("CreateToken", ctx, email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+
+	// Execute test
+	user, _, _, err := useCase.LoginUser(ctx, "password123", email)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Nil(t, user.DeletionScheduledFor)
+	require.Equal(t, []string{entity.AuthEventAccountDeletionCancelled, entity.AuthEventLogin}, eventTypes)
+	mockRepo.AssertCalled(t, "CancelUserDeletion", ctx, mockUser.ID)
+}
+
+func TestEraseDueAccounts(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	ctx := context.Background()
+
+	erased := &entity.User{ID: uuid.New(), Email: "erased@example.com"}
+	cancelled := &entity.User{ID: uuid.New(), Email: "cancelled@example.com"}
+	var event *entity.AuthEvent
+
+	// Mock behavior
+	mockRepo.On("ListUsersDueForDeletion", ctx, mock.AnythingOfType("time.Time"), accountDeletionBatchSize).
+		Return([]*entity.User{erased, cancelled}, nil)
+	mockRepo.On("EraseUser", ctx, erased, events.ErasureServices).Return(true, nil)
+	mockRepo.On("EraseUser", ctx, cancelled, events.ErasureServices).Return(false, nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+		Run(func(args mock.Arguments) { event = args.Get(1).(*entity.AuthEvent) }).
+		Return(nil).Once()
+
+	// Execute test
+	err := eraseDueAccounts(ctx, mockRepo, events.ErasureServices)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, entity.AuthEventAccountDeleted, event.EventType)
+	require.Equal(t, erased.ID, *event.UserID)
+	require.Empty(t, event.Email)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPublishErasures(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockQueue := new(MockMessageQueue)
+	ctx := context.Background()
+
+	deletedAt := time.Now()
+	erasure := &entity.AccountErasure{
+		UserID:      uuid.New(),
+		RequestedAt: deletedAt.Add(-accountDeletionGracePeriod),
+		DeletedAt:   &deletedAt,
+	}
+	var published events.UserDeleted
+
+	// Mock behavior
+	mockRepo.On("ListUnpublishedErasures", ctx, accountDeletionBatchSize).Return([]*entity.AccountErasure{erasure}, nil)
+	mockQueue.On("PublishMessage", ctx, []byte(events.UserDeletedKey), mock.Anything).
+		Run(func(args mock.Arguments) { require.NoError(t, json.Unmarshal(args.Get(2).([]byte), &published)) }).
+		Return(nil)
+	mockRepo.On("MarkErasurePublished", ctx, erasure.UserID).Return(nil)
+
+	// Execute test
+	err := publishErasures(ctx, mockRepo, mockQueue)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, erasure.UserID.String(), published.UserID)
+	require.WithinDuration(t, deletedAt, published.DeletedAt, time.Second)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPublishErasuresLeavesFailedEventUnpublished(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockQueue := new(MockMessageQueue)
+	ctx := context.Background()
+
+	deletedAt := time.Now()
+	erasure := &entity.AccountErasure{UserID: uuid.New(), RequestedAt: deletedAt, DeletedAt: &deletedAt}
+
+	// Mock behavior
+	mockRepo.On("ListUnpublishedErasures", ctx, accountDeletionBatchSize).Return([]*entity.AccountErasure{erasure}, nil)
+	mockQueue.On("PublishMessage", ctx, []byte(events.UserDeletedKey), mock.Anything).Return(context.DeadlineExceeded)
+
+	// Execute test
+	err := publishErasures(ctx, mockRepo, mockQueue)
+
+	// Assertions
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	mockRepo.AssertNotCalled(t, "MarkErasurePublished", mock.Anything, mock.Anything)
+}
+
+func TestHandleErasureReports(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	ctx := context.Background()
+	handle := HandleErasureReports(mockRepo)
+
+	userID := uuid.New()
+	report, _ := json.Marshal(events.ErasureReport{
+		UserID:  userID.String(),
+		Service: events.ServiceProperty,
+		Status:  events.ErasureCompleted,
+		Items:   3,
+	})
+
+	// Mock behavior
+	mockRepo.On("UpdateAccountErasureService", ctx, userID, &entity.AccountErasureService{
+		Service: events.ServiceProperty,
+		Status:  events.ErasureCompleted,
+		Items:   3,
+	}).Return(true, nil)
+
+	// Execute test
+	require.NoError(t, handle(ctx, []byte(events.ErasureReportKey), report))
+	require.NoError(t, handle(ctx, []byte(events.ErasureReportKey), []byte("not json")))
+	require.NoError(t, handle(ctx, []byte(events.UserDeletedKey), report))
+
+	// Assertions
+	mockRepo.AssertNumberOfCalls(t, "UpdateAccountErasureService", 1)
+}
+
+func TestGetErasureStatus(t *testing.T) {
+	ctx := context.Background()
+	requestedAt := time.Now().Add(-time.Hour)
+	scheduledFor := requestedAt.Add(accountDeletionGracePeriod)
+
+	t.Run("scheduled", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+		user := &entity.User{ID: uuid.New(), DeletionRequestedAt: &requestedAt, DeletionScheduledFor: &scheduledFor}
+
+		// Mock behavior
+		mockRepo.On("GetAccountErasure", ctx, user.ID).Return(nil, entity.ErrErasureNotFound)
+		mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+
+		// Execute test
+		erasure, err := useCase.GetErasureStatus(ctx, user.ID.String())
+
+		// Assertions
+		require.NoError(t, err)
+		require.Equal(t, entity.ErasureStateScheduled, erasure.State())
+		require.Equal(t, &scheduledFor, erasure.ScheduledFor)
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+		userID := uuid.New()
+		deletedAt := time.Now()
+
+		// Mock behavior
+		mockRepo.On("GetAccountErasure", ctx, userID).Return(&entity.AccountErasure{
+			UserID:      userID,
+			RequestedAt: requestedAt,
+			DeletedAt:   &deletedAt,
+			Services: []*entity.AccountErasureService{
+				{Service: events.ServiceProperty, Status: events.ErasureCompleted},
+				{Service: events.ServiceMessaging, Status: entity.ErasurePending},
+			},
+		}, nil)
+
+		// Execute test
+		erasure, err := useCase.GetErasureStatus(ctx, userID.String())
+
+		// Assertions
+		require.NoError(t, err)
+		require.Equal(t, entity.ErasureStateInProgress, erasure.State())
+		mockRepo.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not deleted", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+		user := &entity.User{ID: uuid.New()}
+
+		// Mock behavior
+		mockRepo.On("GetAccountErasure", ctx, user.ID).Return(nil, entity.ErrErasureNotFound)
+		mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+
+		// Execute test
+		_, err := useCase.GetErasureStatus(ctx, user.ID.String())
+
+		// Assertions
+		require.ErrorIs(t, err, entity.ErrErasureNotFound)
+	})
+}
@@ -0,0 +1,552 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/domain/repository"
+	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+)
+
+const (
+	adminDefaultPageSize = 20
+	adminMaxPageSize     = 100
+
+	// Impersonation tokens are short lived and cannot be refreshed
+	impersonationDefaultTTL = 15 * time.Minute
+	impersonationMaxTTL     = time.Hour
+
+	userImpersonatedEventKey = "user_impersonated"
+)
+
+// AdminUsecase defines the support staff operations on other users' accounts.
+// Methods that take an actorID refuse to act on the administrator's own account.
+type AdminUsecase interface {
+	ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int64, error)
+	GetUser(ctx context.Context, userID string) (*entity.User, error)
+	ListUserSessions(ctx context.Context, userID string) ([]*entity.Session, error)
+	ListUserLoginHistory(ctx context.Context, userID string, limit int) ([]*entity.LoginHistoryEntry, error)
+	ForceLogout(ctx context.Context, actorID string, userID string) error
+	LockUser(ctx context.Context, actorID string, userID string, reason string) error
+	UnlockUser(ctx context.Context, userID string) error
+	ChangeUserRole(ctx context.Context, actorID string, userID string, role string) (*entity.User, error)
+	TriggerPasswordReset(ctx context.Context, userID string) error
+	DeleteUser(ctx context.Context, actorID string, userID string) error
+	RestoreUser(ctx context.Context, userID string) error
+	ImpersonateUser(ctx context.Context, actorID string, userID string, reason string, duration time.Duration) (*entity.Impersonation, error)
+	ListAuthEvents(ctx context.Context, filter entity.AuthEventFilter) ([]*entity.AuthEvent, int64, error)
+	GetErasureStatus(ctx context.Context, userID string) (*entity.AccountErasure, error)
+	ListAgentVerifications(ctx context.Context, filter entity.AgentVerificationFilter) ([]*entity.AgentVerification, int64, error)
+	GetAgentVerification(ctx context.Context, userID string) (*entity.AgentVerification, error)
+	ReviewAgentVerification(ctx context.Context, actorID string, userID string, decision string, note string) (*entity.AgentVerification, error)
+}
+
+// adminUsecase implements the AdminUsecase interface on top of the user
+// flows, so a reset it triggers is the same one users request themselves.
+type adminUsecase struct {
+	users *userUsecase
+}
+
+// NewAdminUsecase creates a new instance of adminUsecase.
+func NewAdminUsecase(userRepo repository.UserRepository, mailer repository.Mailer, messageQueue repository.MessageQueue) AdminUsecase {
+	return &adminUsecase{users: &userUsecase{userRepo: userRepo, mailer: mailer, messageQueue: messageQueue}}
+}
+
+// ListUsers returns one page of the users matching filter and the total
+// number of matches.
+func (a *adminUsecase) ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int64, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = adminDefaultPageSize
+	}
+	filter.Limit = min(filter.Limit, adminMaxPageSize)
+	filter.Offset = max(filter.Offset, 0)
+
+	users, err := a.users.userRepo.ListUsers(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := a.users.userRepo.CountUsers(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+// GetUser retrieves any user, including locked and deleted ones.
+func (a *adminUsecase) GetUser(ctx context.Context, userID string) (*entity.User, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidUserID, err)
+	}
+
+	user, err := a.users.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
+	return user, nil
+}
+
+// ListUserSessions retrieves the active sessions of a user.
+func (a *adminUsecase) ListUserSessions(ctx context.Context, userID string) ([]*entity.Session, error) {
+	if _, err := a.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return a.users.GetSessions(ctx, userID)
+}
+
+// ListUserLoginHistory retrieves the most recent logins of a user.
+func (a *adminUsecase) ListUserLoginHistory(ctx context.Context, userID string, limit int) ([]*entity.LoginHistoryEntry, error) {
+	if _, err := a.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = adminDefaultPageSize
+	}
+	return a.users.GetLoginHistory(ctx, userID, min(limit, adminMaxPageSize))
+}
+
+// ForceLogout ends every session of a user and revokes the access tokens
+// issued for them.
+func (a *adminUsecase) ForceLogout(ctx context.Context, actorID string, userID string) error {
+	user, err := a.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := a.revokeSessions(ctx, user.ID); err != nil {
+		return err
+	}
+
+	a.recordAdminEvent(ctx, actorID, &entity.AuthEvent{
+		EventType: entity.AuthEventSessionRevoked,
+		UserID:    &user.ID,
+		Email:     user.Email,
+		Metadata:  map[string]string{"reason": entity.SessionRevokedByAdmin},
+	})
+
+	return nil
+}
+
+// LockUser stops a user from signing in and ends their sessions. Unlike a
+// brute-force lockout it lasts until an administrator unlocks the account.
+func (a *adminUsecase) LockUser(ctx context.Context, actorID string, userID string, reason string) error {
+	if actorID == userID {
+		return entity.ErrAdminSelfAction
+	}
+
+	user, err := a.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := a.users.userRepo.LockUser(ctx, user.ID, reason); err != nil {
+		return err
+	}
+
+	return a.revokeSessions(ctx, user.ID)
+}
+
+// UnlockUser lifts both an administrator lock and any brute-force lockout.
+func (a *adminUsecase) UnlockUser(ctx context.Context, userID string) error {
+	user, err := a.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := a.users.userRepo.UnlockUser(ctx, user.ID); err != nil {
+		return err
+	}
+
+	return a.users.UnlockAccount(ctx, userID)
+}
+
+// ChangeUserRole assigns a role to a user. Their sessions are ended so the
+// new role's permissions apply from their next sign in.
+func (a *adminUsecase) ChangeUserRole(ctx context.Context, actorID string, userID string, role string) (*entity.User, error) {
+	if actorID == userID {
+		return nil, entity.ErrAdminSelfAction
+	}
+
+	parsedRole, err := rbac.ParseRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := a.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role == string(parsedRole) {
+		return user, nil
+	}
+
+	if err := a.users.userRepo.UpdateUserRole(ctx, user.ID, string(parsedRole)); err != nil {
+		return nil, err
+	}
+
+	if err := a.revokeSessions(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	user.Role = string(parsedRole)
+
+	return user, nil
+}
+
+// TriggerPasswordReset emails the user a password reset code, exactly as if
+// they had asked for one.
+func (a *adminUsecase) TriggerPasswordReset(ctx context.Context, userID string) error {
+	user, err := a.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	otp, err := a.users.issueVerificationCode(ctx, user.ID, entity.VerificationPurposePasswordReset)
+	if err != nil {
+		return err
+	}
+
+	return a.users.sendPasswordResetEmail(ctx, user, otp, verificationCodeTTL)
+}
+
+// DeleteUser soft-deletes a user and ends their sessions. Their data is kept
+// so the account can be restored.
+func (a *adminUsecase) DeleteUser(ctx context.Context, actorID string, userID string) error {
+	if actorID == userID {
+		return entity.ErrAdminSelfAction
+	}
+
+	user, err := a.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := a.users.userRepo.SoftDeleteUser(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return entity.ErrAccountDeleted
+	}
+
+	if err := a.revokeSessions(ctx, user.ID); err != nil {
+		return err
+	}
+
+	a.recordAdminEvent(ctx, actorID, &entity.AuthEvent{
+		EventType: entity.AuthEventAccountDeleted,
+		UserID:    &user.ID,
+		Email:     user.Email,
+	})
+
+	return nil
+}
+
+// RestoreUser undoes a soft delete so the user can sign in again.
+func (a *adminUsecase) RestoreUser(ctx context.Context, userID string) error {
+	user, err := a.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	restored, err := a.users.userRepo.RestoreUser(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if !restored {
+		return entity.ErrUserNotDeleted
+	}
+
+	return nil
+}
+
+// ImpersonateUser starts a time-boxed session in which the administrator acts
+// as the user. The session shows up in the user's login history and its
+// token carries both IDs so every request made with it can be audited.
+func (a *adminUsecase) ImpersonateUser(ctx context.Context, actorID string, userID string, reason string, duration time.Duration) (*entity.Impersonation, error) {
+	if actorID == userID {
+		return nil, entity.ErrAdminSelfAction
+	}
+
+	impersonatorID, err := uuid.Parse(actorID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidUserID, err)
+	}
+
+	user, err := a.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.DeletedAt != nil {
+		return nil, entity.ErrAccountDeleted
+	}
+
+	// An impersonation token carries the user's role, so acting as another
+	// administrator would hand out admin rights without an audit trail
+	if user.Role == string(rbac.RoleAdmin) {
+		return nil, entity.ErrImpersonationNotAllowed
+	}
+
+	if duration <= 0 {
+		duration = impersonationDefaultTTL
+	}
+	duration = min(duration, impersonationMaxTTL)
+
+	sessionID := uuid.New()
+	accessToken, expiresAt, err := a.users.userRepo.CreateImpersonationToken(ctx, actorID, user.Email, user.ID.String(), sessionID.String(), user.Role, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	metaData := utils.ExtractMetaData(ctx)
+
+	session := &entity.Session{
+		SessionID:           sessionID,
+		UserID:              user.ID,
+		Token:               accessToken,
+		ExpiresAt:           expiresAt,
+		IpAddress:           metaData.ClientIP,
+		UserAgent:           metaData.UserAgent,
+		IsActive:            true,
+		OTPVerified:         true,
+		ImpersonatorID:      &impersonatorID,
+		ImpersonationReason: reason,
+	}
+
+	if err := a.users.userRepo.CreateImpersonationSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	impersonation := &entity.Impersonation{
+		SessionID:      sessionID,
+		ImpersonatorID: impersonatorID,
+		User:           user,
+		Reason:         reason,
+		AccessToken:    accessToken,
+		ExpiresAt:      expiresAt,
+	}
+
+	log.Printf("impersonation: admin %s started acting as user %s (session %s) until %s: %s",
+		impersonatorID, user.ID, sessionID, expiresAt.Format(time.RFC3339), reason)
+	a.recordAdminEvent(ctx, actorID, &entity.AuthEvent{
+		EventType: entity.AuthEventImpersonation,
+		UserID:    &user.ID,
+		Email:     user.Email,
+		SessionID: &sessionID,
+		Metadata:  map[string]string{"reason": reason, "expires_at": expiresAt.UTC().Format(time.RFC3339)},
+	})
+	a.publishUserImpersonated(ctx, impersonation)
+
+	return impersonation, nil
+}
+
+// ListAuthEvents returns one page of the security audit log matching filter
+// and the total number of matches.
+func (a *adminUsecase) ListAuthEvents(ctx context.Context, filter entity.AuthEventFilter) ([]*entity.AuthEvent, int64, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = adminDefaultPageSize
+	}
+	filter.Limit = min(filter.Limit, adminMaxPageSize)
+	filter.Offset = max(filter.Offset, 0)
+
+	events, err := a.users.userRepo.ListAuthEvents(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := a.users.userRepo.CountAuthEvents(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
+// GetErasureStatus reports how far the deletion of a user's account has got:
+// still in its grace period, or erased here and waiting on other services.
+func (a *adminUsecase) GetErasureStatus(ctx context.Context, userID string) (*entity.AccountErasure, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidUserID, err)
+	}
+
+	erasure, err := a.users.userRepo.GetAccountErasure(ctx, id)
+	if err == nil {
+		return erasure, nil
+	}
+	if !errors.Is(err, entity.ErrErasureNotFound) {
+		return nil, fmt.Errorf("failed to retrieve erasure: %w", err)
+	}
+
+	// Not erased yet, so the deletion can only be scheduled
+	user, err := a.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.DeletionScheduledFor == nil {
+		return nil, entity.ErrErasureNotFound
+	}
+
+	return &entity.AccountErasure{
+		UserID:       user.ID,
+		RequestedAt:  *user.DeletionRequestedAt,
+		ScheduledFor: user.DeletionScheduledFor,
+	}, nil
+}
+
+// ListAgentVerifications returns one page of the agent verifications in a
+// status, oldest first, and how many there are. Pending ones form the review
+// queue and are listed when no status is given.
+func (a *adminUsecase) ListAgentVerifications(ctx context.Context, filter entity.AgentVerificationFilter) ([]*entity.AgentVerification, int64, error) {
+	if filter.Status == "" {
+		filter.Status = entity.AgentVerificationPending
+	}
+	if !entity.ValidAgentVerificationStatus(filter.Status) {
+		return nil, 0, entity.ErrInvalidAgentVerificationStatus
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = adminDefaultPageSize
+	}
+	filter.Limit = min(filter.Limit, adminMaxPageSize)
+	filter.Offset = max(filter.Offset, 0)
+
+	verifications, err := a.users.userRepo.ListAgentVerifications(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := a.users.userRepo.CountAgentVerifications(ctx, filter.Status)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return verifications, total, nil
+}
+
+// GetAgentVerification returns an agent's verification with the documents
+// they uploaded.
+func (a *adminUsecase) GetAgentVerification(ctx context.Context, userID string) (*entity.AgentVerification, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidUserID, err)
+	}
+
+	return a.users.agentVerification(ctx, id)
+}
+
+// ReviewAgentVerification approves or rejects a pending verification, or asks
+// the agent for more information. Approving it gives the agent the verified
+// badge; the note is shown to the agent and is required unless approving.
+func (a *adminUsecase) ReviewAgentVerification(ctx context.Context, actorID string, userID string, decision string, note string) (*entity.AgentVerification, error) {
+	if actorID == userID {
+		return nil, entity.ErrAdminSelfAction
+	}
+
+	reviewerID, err := uuid.Parse(actorID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidUserID, err)
+	}
+
+	var status string
+	switch decision {
+	case entity.AgentReviewApprove:
+		status = entity.AgentVerificationApproved
+	case entity.AgentReviewReject:
+		status = entity.AgentVerificationRejected
+	case entity.AgentReviewRequestMoreInfo:
+		status = entity.AgentVerificationMoreInfoRequested
+	default:
+		return nil, entity.ErrInvalidAgentVerificationReview
+	}
+
+	note = strings.TrimSpace(note)
+	if status != entity.AgentVerificationApproved && note == "" {
+		return nil, entity.ErrAgentReviewNoteRequired
+	}
+
+	verification, err := a.GetAgentVerification(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if verification.Status != entity.AgentVerificationPending {
+		return nil, entity.ErrAgentVerificationNotPending
+	}
+
+	if err := a.users.userRepo.ReviewAgentVerification(ctx, verification.UserID, status, note, reviewerID); err != nil {
+		return nil, err
+	}
+
+	reviewedAt := time.Now()
+	verification.Status = status
+	verification.ReviewNote = note
+	verification.ReviewedBy = &reviewerID
+	verification.ReviewedAt = &reviewedAt
+
+	a.recordAdminEvent(ctx, actorID, &entity.AuthEvent{
+		EventType: entity.AuthEventAgentVerificationReviewed,
+		UserID:    &verification.UserID,
+		Email:     verification.Email,
+		Metadata:  map[string]string{"status": status},
+	})
+	a.users.publishAgentVerificationChanged(ctx, verification, entity.AgentVerificationPending, status == entity.AgentVerificationApproved)
+
+	return verification, nil
+}
+
+// recordAdminEvent records a successful action an administrator took on a
+// user's account in the security audit log.
+func (a *adminUsecase) recordAdminEvent(ctx context.Context, actorID string, event *entity.AuthEvent) {
+	event.Outcome = entity.AuthEventSuccess
+	if id, err := uuid.Parse(actorID); err == nil {
+		event.ActorID = &id
+	}
+
+	a.users.recordAuthEvent(ctx, event)
+}
+
+// publishUserImpersonated records the start of an impersonation. It is best
+// effort and never fails the request.
+func (a *adminUsecase) publishUserImpersonated(ctx context.Context, impersonation *entity.Impersonation) {
+	event := entity.UserImpersonatedEvent{
+		SessionID:      impersonation.SessionID,
+		ImpersonatorID: impersonation.ImpersonatorID,
+		UserID:         impersonation.User.ID,
+		Reason:         impersonation.Reason,
+		IPAddress:      clientIP(ctx),
+		ExpiresAt:      impersonation.ExpiresAt,
+		OccurredAt:     time.Now().UTC(),
+	}
+
+	eventData, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal %s event: %v", userImpersonatedEventKey, err)
+		return
+	}
+
+	if err := a.users.messageQueue.PublishMessage(ctx, []byte(userImpersonatedEventKey), eventData); err != nil {
+		log.Printf("failed to publish %s event: %v", userImpersonatedEventKey, err)
+	}
+}
+
+func (a *adminUsecase) revokeSessions(ctx context.Context, userID uuid.UUID) error {
+	if err := a.users.userRepo.RevokeAllSessions(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return a.users.userRepo.RevokeUserSessionTokens(ctx, userID, entity.SessionRevokedByAdmin)
+}
@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminListUsersClampsPage(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	users := []*entity.User{{ID: uuid.New(), Email: "buyer@example.com"}}
+	expected := entity.UserFilter{Email: "buyer", Limit: adminMaxPageSize, Offset: 0}
+
+	// Mock behavior
+	mockRepo.On("ListUsers", ctx, expected).Return(users, nil)
+	mockRepo.On("CountUsers", ctx, expected).Return(int64(42), nil)
+
+	// Execute test
+	result, total, err := useCase.ListUsers(ctx, entity.UserFilter{Email: "buyer", Limit: 1000, Offset: -5})
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, users, result)
+	require.Equal(t, int64(42), total)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAdminGetUserNotFound(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userID := uuid.New().String()
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, userID).Return(nil, entity.ErrUserNotFound)
+
+	// Execute test
+	_, err := useCase.GetUser(ctx, userID)
+	require.ErrorIs(t, err, entity.ErrUserNotFound)
+
+	_, err = useCase.GetUser(ctx, "not-a-uuid")
+	require.ErrorIs(t, err, entity.ErrInvalidUserID)
+}
+
+func TestAdminLockUser(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	actorID := uuid.New().String()
+	mockUser := &entity.User{ID: uuid.New(), Email: "user@example.com"}
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("LockUser", ctx, mockUser.ID, "chargeback fraud").Return(nil)
+	mockRepo.On("RevokeAllSessions", ctx, mockUser.ID).Return(nil)
+	mockRepo.On("RevokeUserSessionTokens", ctx, mockUser.ID, entity.SessionRevokedByAdmin).Return(nil)
+
+	// Execute test
+	err := useCase.LockUser(ctx, actorID, mockUser.ID.String(), "chargeback fraud")
+
+	// Assertions
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAdminRefusesSelfActions(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	actorID := uuid.New().String()
+
+	// Execute test
+	err := useCase.LockUser(ctx, actorID, actorID, "testing")
+	require.ErrorIs(t, err, entity.ErrAdminSelfAction)
+
+	_, err = useCase.ChangeUserRole(ctx, actorID, actorID, string(rbac.RoleBuyer))
+	require.ErrorIs(t, err, entity.ErrAdminSelfAction)
+
+	err = useCase.DeleteUser(ctx, actorID, actorID)
+	require.ErrorIs(t, err, entity.ErrAdminSelfAction)
+
+	// Assertions
+	mockRepo.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+}
+
+func TestAdminChangeUserRole(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	actorID := uuid.New().String()
+	mockUser := &entity.User{ID: uuid.New(), Role: string(rbac.RoleBuyer)}
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("UpdateUserRole", ctx, mockUser.ID, string(rbac.RoleAgent)).Return(nil)
+	mockRepo.On("RevokeAllSessions", ctx, mockUser.ID).Return(nil)
+	mockRepo.On("RevokeUserSessionTokens", ctx, mockUser.ID, entity.SessionRevokedByAdmin).Return(nil)
+
+	// Execute test
+	_, err := useCase.ChangeUserRole(ctx, actorID, mockUser.ID.String(), "superuser")
+	require.ErrorIs(t, err, rbac.ErrInvalidRole)
+
+	user, err := useCase.ChangeUserRole(ctx, actorID, mockUser.ID.String(), string(rbac.RoleAgent))
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, string(rbac.RoleAgent), user.Role)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAdminDeleteAndRestoreUser(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	actorID := uuid.New().String()
+	mockUser := &entity.User{ID: uuid.New()}
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("SoftDeleteUser", ctx, mockUser.ID).Return(true, nil).Once()
+	mockRepo.On("SoftDeleteUser", ctx, mockUser.ID).Return(false, nil).Once()
+	mockRepo.On("RevokeAllSessions", ctx, mockUser.ID).Return(nil).Once()
+	mockRepo.On("RevokeUserSessionTokens", ctx, mockUser.ID, entity.SessionRevokedByAdmin).Return(nil).Once()
+	mockRepo.On("RestoreUser", ctx, mockUser.ID).Return(true, nil).Once()
+	mockRepo.On("RestoreUser", ctx, mockUser.ID).Return(false, nil).Once()
+
+	// Execute test
+	require.NoError(t, useCase.DeleteUser(ctx, actorID, mockUser.ID.String()))
+	require.ErrorIs(t, useCase.DeleteUser(ctx, actorID, mockUser.ID.String()), entity.ErrAccountDeleted)
+
+	require.NoError(t, useCase.RestoreUser(ctx, mockUser.ID.String()))
+	require.ErrorIs(t, useCase.RestoreUser(ctx, mockUser.ID.String()), entity.ErrUserNotDeleted)
+
+	// Assertions
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAdminImpersonateUser(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), mockQueue)
+	ctx := context.Background()
+
+	actorID := uuid.New()
+	mockUser := &entity.User{ID: uuid.New(), Email: "buyer@example.com", Role: string(rbac.RoleBuyer)}
+	expiresAt := time.Now().Add(impersonationMaxTTL)
+	var session *entity.Session
+	var event *entity.AuthEvent
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+		Run(func(args mock.Arguments) { event = args.Get(1).(*entity.AuthEvent) }).
+		Return(nil)
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("CreateImpersonationToken", ctx, actorID.String(), mockUser.Email, mockUser.ID.String(), mock.AnythingOfType("string"), mockUser.Role, impersonationMaxTTL).
+		Return("impersonation-token", expiresAt, nil)
+	mockRepo.On("CreateImpersonationSession", ctx, mock.AnythingOfType("*entity.Session")).
+		Run(func(args mock.Arguments) { session = args.Get(1).(*entity.Session) }).
+		Return(nil)
+	mockQueue.On("PublishMessage", ctx, []byte(userImpersonatedEventKey), mock.Anything).Return(nil)
+
+	// Execute test
+	impersonation, err := useCase.ImpersonateUser(ctx, actorID.String(), mockUser.ID.String(), "listing does not show photos", 24*time.Hour)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, "impersonation-token", impersonation.AccessToken)
+	require.Equal(t, expiresAt, impersonation.ExpiresAt)
+	require.Equal(t, impersonation.SessionID, session.SessionID)
+	require.Equal(t, mockUser.ID, session.UserID)
+	require.Equal(t, actorID, *session.ImpersonatorID)
+	require.Equal(t, "listing does not show photos", session.ImpersonationReason)
+	require.Equal(t, entity.AuthEventImpersonation, event.EventType)
+	require.Equal(t, actorID, *event.ActorID)
+	require.Equal(t, mockUser.ID, *event.UserID)
+	require.Equal(t, session.SessionID, *event.SessionID)
+	require.Equal(t, "listing does not show photos", event.Metadata["reason"])
+	mockRepo.AssertExpectations(t)
+	mockQueue.AssertExpectations(t)
+}
+
+func TestAdminImpersonateUserRefusesAdmins(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	actorID := uuid.New().String()
+	admin := &entity.User{ID: uuid.New(), Role: string(rbac.RoleAdmin)}
+	deletedAt := time.Now()
+	deleted := &entity.User{ID: uuid.New(), Role: string(rbac.RoleBuyer), DeletedAt: &deletedAt}
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, admin.ID.String()).Return(admin, nil)
+	mockRepo.On("GetUserByID", ctx, deleted.ID.String()).Return(deleted, nil)
+
+	// Execute test
+	_, err := useCase.ImpersonateUser(ctx, actorID, admin.ID.String(), "testing", 0)
+	require.ErrorIs(t, err, entity.ErrImpersonationNotAllowed)
+
+	_, err = useCase.ImpersonateUser(ctx, actorID, deleted.ID.String(), "testing", 0)
+	require.ErrorIs(t, err, entity.ErrAccountDeleted)
+
+	_, err = useCase.ImpersonateUser(ctx, actorID, actorID, "testing", 0)
+	require.ErrorIs(t, err, entity.ErrAdminSelfAction)
+
+	// Assertions
+	mockRepo.AssertNotCalled(t, "CreateImpersonationToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdminListAuthEventsClampsPage(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userID := uuid.New()
+	events := []*entity.AuthEvent{{ID: uuid.New(), EventType: entity.AuthEventLogin, Outcome: entity.AuthEventFailure, UserID: &userID}}
+	expected := entity.AuthEventFilter{UserID: &userID, Outcome: entity.AuthEventFailure, Limit: adminDefaultPageSize}
+
+	// Mock behavior
+	mockRepo.On("ListAuthEvents", ctx, expected).Return(events, nil)
+	mockRepo.On("CountAuthEvents", ctx, expected).Return(int64(1), nil)
+
+	// Execute test
+	result, total, err := useCase.ListAuthEvents(ctx, entity.AuthEventFilter{UserID: &userID, Outcome: entity.AuthEventFailure, Offset: -1})
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, events, result)
+	require.Equal(t, int64(1), total)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/domain/repository"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// agentVerificationMaxDocuments caps how many documents an agent can upload
+	agentVerificationMaxDocuments = 10
+
+	agentVerificationChangedEventKey = "agent_verification_changed"
+)
+
+// AgentVerificationUsecase defines how agents prove who they are, with their
+// license, business details and ID documents, to earn the verified badge.
+type AgentVerificationUsecase interface {
+	SubmitAgentVerification(ctx context.Context, userID string, details *entity.AgentVerification) (*entity.AgentVerification, error)
+	GetAgentVerification(ctx context.Context, userID string) (*entity.AgentVerification, error)
+	UploadAgentDocument(ctx context.Context, userID string, documentType string, content io.Reader) (*entity.AgentDocument, error)
+}
+
+// agentVerificationUsecase implements the AgentVerificationUsecase interface.
+// Administrators review the submitted verifications through AdminUsecase.
+type agentVerificationUsecase struct {
+	users *userUsecase
+}
+
+// NewAgentVerificationUsecase creates a new instance of agentVerificationUsecase.
+func NewAgentVerificationUsecase(userRepo repository.UserRepository, messageQueue repository.MessageQueue) AgentVerificationUsecase {
+	return &agentVerificationUsecase{users: &userUsecase{userRepo: userRepo, messageQueue: messageQueue}}
+}
+
+// SubmitAgentVerification sends the agent's details and uploaded documents
+// for review. It can be submitted again after it is rejected or more
+// information is requested.
+func (a *agentVerificationUsecase) SubmitAgentVerification(ctx context.Context, userID string, details *entity.AgentVerification) (*entity.AgentVerification, error) {
+	user, err := a.agent(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &entity.AgentVerification{
+		UserID:             user.ID,
+		Email:              user.Email,
+		FullName:           user.FullName,
+		LicenseNumber:      strings.TrimSpace(details.LicenseNumber),
+		BusinessName:       strings.TrimSpace(details.BusinessName),
+		BusinessAddress:    strings.TrimSpace(details.BusinessAddress),
+		BusinessPhone:      strings.TrimSpace(details.BusinessPhone),
+		RegistrationNumber: strings.TrimSpace(details.RegistrationNumber),
+	}
+	if verification.LicenseNumber == "" || verification.BusinessName == "" || verification.BusinessAddress == "" {
+		return nil, entity.ErrInvalidAgentVerification
+	}
+
+	previousStatus := ""
+	existing, err := a.users.userRepo.GetAgentVerification(ctx, user.ID)
+	switch {
+	case err == nil:
+		if !existing.CanResubmit() {
+			return nil, submittedVerificationError(existing)
+		}
+		previousStatus = existing.Status
+	case !errors.Is(err, entity.ErrAgentVerificationNotFound):
+		return nil, err
+	}
+
+	documents, err := a.users.userRepo.ListAgentDocuments(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasAgentDocument(documents, entity.AgentDocumentID) {
+		return nil, entity.ErrAgentDocumentRequired
+	}
+
+	if err := a.users.userRepo.SubmitAgentVerification(ctx, verification); err != nil {
+		return nil, err
+	}
+	verification.Documents = documents
+
+	a.users.recordUserSuccess(ctx, entity.AuthEventAgentVerificationSubmitted, user.ID, user.Email, nil)
+	a.users.publishAgentVerificationChanged(ctx, verification, previousStatus, false)
+
+	return verification, nil
+}
+
+// GetAgentVerification returns the agent's verification with its documents.
+func (a *agentVerificationUsecase) GetAgentVerification(ctx context.Context, userID string) (*entity.AgentVerification, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidUserID, err)
+	}
+
+	return a.users.agentVerification(ctx, id)
+}
+
+// UploadAgentDocument stores a document supporting the agent's verification.
+// Documents cannot be added while the verification is being reviewed or once
+// it is approved.
+func (a *agentVerificationUsecase) UploadAgentDocument(ctx context.Context, userID string, documentType string, content io.Reader) (*entity.AgentDocument, error) {
+	if !entity.ValidAgentDocumentType(documentType) {
+		return nil, entity.ErrInvalidAgentDocumentType
+	}
+
+	user, err := a.agent(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := a.users.userRepo.GetAgentVerification(ctx, user.ID)
+	switch {
+	case err == nil:
+		if !existing.CanResubmit() {
+			return nil, submittedVerificationError(existing)
+		}
+	case !errors.Is(err, entity.ErrAgentVerificationNotFound):
+		return nil, err
+	}
+
+	documents, err := a.users.userRepo.ListAgentDocuments(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(documents) >= agentVerificationMaxDocuments {
+		return nil, entity.ErrTooManyAgentDocuments
+	}
+
+	document := &entity.AgentDocument{
+		ID:           uuid.New(),
+		UserID:       user.ID,
+		DocumentType: documentType,
+	}
+	if err := a.users.userRepo.UploadAgentDocument(ctx, content, document); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// agent returns the user if they hold the agent role
+func (a *agentVerificationUsecase) agent(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := a.users.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role != string(rbac.RoleAgent) {
+		return nil, entity.ErrNotAnAgent
+	}
+
+	return user, nil
+}
+
+// agentVerification returns a user's verification with its documents
+func (u *userUsecase) agentVerification(ctx context.Context, userID uuid.UUID) (*entity.AgentVerification, error) {
+	verification, err := u.userRepo.GetAgentVerification(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	verification.Documents, err = u.userRepo.ListAgentDocuments(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return verification, nil
+}
+
+// publishAgentVerificationChanged tells other services a verification changed
+// status. It is best effort and never fails the request.
+func (u *userUsecase) publishAgentVerificationChanged(ctx context.Context, verification *entity.AgentVerification, previousStatus string, verifiedAgent bool) {
+	event := entity.AgentVerificationChangedEvent{
+		UserID:         verification.UserID,
+		Status:         verification.Status,
+		PreviousStatus: previousStatus,
+		VerifiedAgent:  verifiedAgent,
+		ReviewedBy:     verification.ReviewedBy,
+		Note:           verification.ReviewNote,
+		OccurredAt:     time.Now().UTC(),
+	}
+
+	eventData, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal %s event: %v", agentVerificationChangedEventKey, err)
+		return
+	}
+
+	if err := u.messageQueue.PublishMessage(ctx, []byte(agentVerificationChangedEventKey), eventData); err != nil {
+		log.Printf("failed to publish %s event: %v", agentVerificationChangedEventKey, err)
+	}
+}
+
+// submittedVerificationError explains why a verification cannot be changed
+func submittedVerificationError(verification *entity.AgentVerification) error {
+	if verification.Status == entity.AgentVerificationApproved {
+		return entity.ErrAgentAlreadyVerified
+	}
+	return entity.ErrAgentVerificationInReview
+}
+
+func hasAgentDocument(documents []*entity.AgentDocument, documentType string) bool {
+	for _, document := range documents {
+		if document.DocumentType == documentType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newAgent() *entity.User {
+	return &entity.User{ID: uuid.New(), Email: "agent@example.com", FullName: "Ada Agent", Role: string(rbac.RoleAgent)}
+}
+
+func agentDetails() *entity.AgentVerification {
+	return &entity.AgentVerification{
+		LicenseNumber:   " LAG-1234 ",
+		BusinessName:    "Lagos Homes",
+		BusinessAddress: "12 Marina, Lagos",
+	}
+}
+
+func TestSubmitAgentVerification(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewAgentVerificationUsecase(mockRepo, mockQueue)
+	ctx := context.Background()
+
+	agent := newAgent()
+	documents := []*entity.AgentDocument{{ID: uuid.New(), UserID: agent.ID, DocumentType: entity.AgentDocumentID}}
+	var published []byte
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, agent.ID.String()).Return(agent, nil)
+	mockRepo.On("GetAgentVerification", ctx, agent.ID).Return(nil, entity.ErrAgentVerificationNotFound)
+	mockRepo.On("ListAgentDocuments", ctx, agent.ID).Return(documents, nil)
+	mockRepo.On("SubmitAgentVerification", ctx, mock.AnythingOfType("*entity.AgentVerification")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.AgentVerification).Status = entity.AgentVerificationPending
+		}).
+		Return(nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockQueue.On("PublishMessage", ctx, []byte(agentVerificationChangedEventKey), mock.Anything).
+		Run(func(args mock.Arguments) { published = args.Get(2).([]byte) }).
+		Return(nil)
+
+	// Execute test
+	verification, err := useCase.SubmitAgentVerification(ctx, agent.ID.String(), agentDetails())
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, "LAG-1234", verification.LicenseNumber)
+	require.Equal(t, entity.AgentVerificationPending, verification.Status)
+	require.Equal(t, documents, verification.Documents)
+
+	var event entity.AgentVerificationChangedEvent
+	require.NoError(t, json.Unmarshal(published, &event))
+	require.Equal(t, agent.ID, event.UserID)
+	require.Equal(t, entity.AgentVerificationPending, event.Status)
+	require.False(t, event.VerifiedAgent)
+}
+
+func TestSubmitAgentVerificationRequiresAgent(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAgentVerificationUsecase(mockRepo, new(MockMessageQueue))
+	ctx := context.Background()
+
+	buyer := &entity.User{ID: uuid.New(), Role: string(rbac.RoleBuyer)}
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, buyer.ID.String()).Return(buyer, nil)
+
+	// Execute test
+	_, err := useCase.SubmitAgentVerification(ctx, buyer.ID.String(), agentDetails())
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrNotAnAgent)
+	mockRepo.AssertNotCalled(t, "SubmitAgentVerification", mock.Anything, mock.Anything)
+}
+
+func TestSubmitAgentVerificationRequiresIDDocument(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAgentVerificationUsecase(mockRepo, new(MockMessageQueue))
+	ctx := context.Background()
+
+	agent := newAgent()
+	documents := []*entity.AgentDocument{{ID: uuid.New(), UserID: agent.ID, DocumentType: entity.AgentDocumentLicense}}
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, agent.ID.String()).Return(agent, nil)
+	mockRepo.On("GetAgentVerification", ctx, agent.ID).Return(nil, entity.ErrAgentVerificationNotFound)
+	mockRepo.On("ListAgentDocuments", ctx, agent.ID).Return(documents, nil)
+
+	// Execute test
+	_, err := useCase.SubmitAgentVerification(ctx, agent.ID.String(), agentDetails())
+	require.ErrorIs(t, err, entity.ErrAgentDocumentRequired)
+
+	_, err = useCase.SubmitAgentVerification(ctx, agent.ID.String(), &entity.AgentVerification{LicenseNumber: "LAG-1234"})
+	require.ErrorIs(t, err, entity.ErrInvalidAgentVerification)
+}
+
+func TestSubmitAgentVerificationOnlyAfterReview(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAgentVerificationUsecase(mockRepo, new(MockMessageQueue))
+	ctx := context.Background()
+
+	pending := newAgent()
+	approved := newAgent()
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, pending.ID.String()).Return(pending, nil)
+	mockRepo.On("GetUserByID", ctx, approved.ID.String()).Return(approved, nil)
+	mockRepo.On("GetAgentVerification", ctx, pending.ID).Return(&entity.AgentVerification{UserID: pending.ID, Status: entity.AgentVerificationPending}, nil)
+	mockRepo.On("GetAgentVerification", ctx, approved.ID).Return(&entity.AgentVerification{UserID: approved.ID, Status: entity.AgentVerificationApproved}, nil)
+
+	// Execute test
+	_, err := useCase.SubmitAgentVerification(ctx, pending.ID.String(), agentDetails())
+	require.ErrorIs(t, err, entity.ErrAgentVerificationInReview)
+
+	_, err = useCase.UploadAgentDocument(ctx, approved.ID.String(), entity.AgentDocumentID, strings.NewReader("scan"))
+	require.ErrorIs(t, err, entity.ErrAgentAlreadyVerified)
+}
+
+func TestResubmitAgentVerificationAfterMoreInfo(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewAgentVerificationUsecase(mockRepo, mockQueue)
+	ctx := context.Background()
+
+	agent := newAgent()
+	existing := &entity.AgentVerification{UserID: agent.ID, Status: entity.AgentVerificationMoreInfoRequested, ReviewNote: "License is expired"}
+	documents := []*entity.AgentDocument{{ID: uuid.New(), UserID: agent.ID, DocumentType: entity.AgentDocumentID}}
+	var published []byte
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, agent.ID.String()).Return(agent, nil)
+	mockRepo.On("GetAgentVerification", ctx, agent.ID).Return(existing, nil)
+	mockRepo.On("ListAgentDocuments", ctx, agent.ID).Return(documents, nil)
+	mockRepo.On("UploadAgentDocument", ctx, mock.Anything, mock.AnythingOfType("*entity.AgentDocument")).Return(nil)
+	mockRepo.On("SubmitAgentVerification", ctx, mock.AnythingOfType("*entity.AgentVerification")).Return(nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockQueue.On("PublishMessage", ctx, []byte(agentVerificationChangedEventKey), mock.Anything).
+		Run(func(args mock.Arguments) { published = args.Get(2).([]byte) }).
+		Return(nil)
+
+	// Execute test
+	document, err := useCase.UploadAgentDocument(ctx, agent.ID.String(), entity.AgentDocumentLicense, strings.NewReader("scan"))
+	require.NoError(t, err)
+	require.Equal(t, entity.AgentDocumentLicense, document.DocumentType)
+
+	_, err = useCase.SubmitAgentVerification(ctx, agent.ID.String(), agentDetails())
+
+	// Assertions
+	require.NoError(t, err)
+
+	var event entity.AgentVerificationChangedEvent
+	require.NoError(t, json.Unmarshal(published, &event))
+	require.Equal(t, entity.AgentVerificationMoreInfoRequested, event.PreviousStatus)
+}
+
+func TestUploadAgentDocumentLimits(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAgentVerificationUsecase(mockRepo, new(MockMessageQueue))
+	ctx := context.Background()
+
+	agent := newAgent()
+	documents := make([]*entity.AgentDocument, agentVerificationMaxDocuments)
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, agent.ID.String()).Return(agent, nil)
+	mockRepo.On("GetAgentVerification", ctx, agent.ID).Return(nil, entity.ErrAgentVerificationNotFound)
+	mockRepo.On("ListAgentDocuments", ctx, agent.ID).Return(documents, nil)
+
+	// Execute test
+	_, err := useCase.UploadAgentDocument(ctx, agent.ID.String(), "selfie", strings.NewReader("scan"))
+	require.ErrorIs(t, err, entity.ErrInvalidAgentDocumentType)
+
+	_, err = useCase.UploadAgentDocument(ctx, agent.ID.String(), entity.AgentDocumentID, strings.NewReader("scan"))
+	require.ErrorIs(t, err, entity.ErrTooManyAgentDocuments)
+	mockRepo.AssertNotCalled(t, "UploadAgentDocument", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdminApproveAgentVerification(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), mockQueue)
+	ctx := context.Background()
+
+	adminID := uuid.New()
+	agent := newAgent()
+	pending := &entity.AgentVerification{UserID: agent.ID, Email: agent.Email, Status: entity.AgentVerificationPending}
+	var event *entity.AuthEvent
+	var published []byte
+
+	// Mock behavior
+	mockRepo.On("GetAgentVerification", ctx, agent.ID).Return(pending, nil)
+	mockRepo.On("ListAgentDocuments", ctx, agent.ID).Return([]*entity.AgentDocument{}, nil)
+	mockRepo.On("ReviewAgentVerification", ctx, agent.ID, entity.AgentVerificationApproved, "", adminID).Return(nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+		Run(func(args mock.Arguments) { event = args.Get(1).(*entity.AuthEvent) }).
+		Return(nil)
+	mockQueue.On("PublishMessage", ctx, []byte(agentVerificationChangedEventKey), mock.Anything).
+		Run(func(args mock.Arguments) { published = args.Get(2).([]byte) }).
+		Return(nil)
+
+	// Execute test
+	verification, err := useCase.ReviewAgentVerification(ctx, adminID.String(), agent.ID.String(), entity.AgentReviewApprove, "")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, entity.AgentVerificationApproved, verification.Status)
+	require.Equal(t, adminID, *verification.ReviewedBy)
+	require.Equal(t, entity.AuthEventAgentVerificationReviewed, event.EventType)
+	require.Equal(t, adminID, *event.ActorID)
+
+	var changed entity.AgentVerificationChangedEvent
+	require.NoError(t, json.Unmarshal(published, &changed))
+	require.True(t, changed.VerifiedAgent)
+	require.Equal(t, entity.AgentVerificationPending, changed.PreviousStatus)
+}
+
+func TestAdminReviewAgentVerificationRules(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	adminID := uuid.New().String()
+	agent := newAgent()
+	rejected := &entity.AgentVerification{UserID: agent.ID, Status: entity.AgentVerificationRejected}
+
+	// Mock behavior
+	mockRepo.On("GetAgentVerification", ctx, agent.ID).Return(rejected, nil)
+	mockRepo.On("ListAgentDocuments", ctx, agent.ID).Return([]*entity.AgentDocument{}, nil)
+
+	// Execute test
+	_, err := useCase.ReviewAgentVerification(ctx, adminID, agent.ID.String(), entity.AgentReviewReject, " ")
+	require.ErrorIs(t, err, entity.ErrAgentReviewNoteRequired)
+
+	_, err = useCase.ReviewAgentVerification(ctx, adminID, agent.ID.String(), "escalate", "")
+	require.ErrorIs(t, err, entity.ErrInvalidAgentVerificationReview)
+
+	_, err = useCase.ReviewAgentVerification(ctx, adminID, adminID, entity.AgentReviewApprove, "")
+	require.ErrorIs(t, err, entity.ErrAdminSelfAction)
+
+	_, err = useCase.ReviewAgentVerification(ctx, adminID, agent.ID.String(), entity.AgentReviewRequestMoreInfo, "Please upload your license")
+	require.ErrorIs(t, err, entity.ErrAgentVerificationNotPending)
+	mockRepo.AssertNotCalled(t, "ReviewAgentVerification", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdminListAgentVerificationsDefaultsToPending(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAdminUsecase(mockRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	expected := entity.AgentVerificationFilter{Status: entity.AgentVerificationPending, Limit: adminDefaultPageSize}
+	verifications := []*entity.AgentVerification{{UserID: uuid.New(), Status: entity.AgentVerificationPending}}
+
+	// Mock behavior
+	mockRepo.On("ListAgentVerifications", ctx, expected).Return(verifications, nil)
+	mockRepo.On("CountAgentVerifications", ctx, entity.AgentVerificationPending).Return(int64(3), nil)
+
+	// Execute test
+	result, total, err := useCase.ListAgentVerifications(ctx, entity.AgentVerificationFilter{Offset: -1})
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, verifications, result)
+	require.Equal(t, int64(3), total)
+
+	_, _, err = useCase.ListAgentVerifications(ctx, entity.AgentVerificationFilter{Status: "archived"})
+	require.ErrorIs(t, err, entity.ErrInvalidAgentVerificationStatus)
+}
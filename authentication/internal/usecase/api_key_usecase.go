@@ -0,0 +1,308 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/domain/repository"
+	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/authentication/pkg/val"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// apiKeyPrefix starts every key so leaked keys are easy to recognise
+	apiKeyPrefix      = "rk_"
+	apiKeyTokenBytes  = 32
+	apiKeyVisibleSize = len(apiKeyPrefix) + 8
+
+	// apiKeyMaxActive caps the unrevoked, unexpired keys a user or organization can have
+	apiKeyMaxActive = 25
+
+	// apiKeyAccessTokenDuration is how long the access token exchanged for a
+	// key lasts, which bounds how stale its last use and scopes can be
+	apiKeyAccessTokenDuration = 5 * time.Minute
+)
+
+// APIKeyUsecase defines the operations on API keys, which integrations such as
+// partner portals and CRM sync jobs use instead of signing in. Methods that
+// take an organizationID manage the organization's keys rather than the
+// user's own.
+type APIKeyUsecase interface {
+	CreateAPIKey(ctx context.Context, userID string, organizationID string, name string, scopes []string, expiresAt *time.Time) (*entity.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, userID string, organizationID string) ([]*entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID string, keyID string) error
+	AuthenticateAPIKey(ctx context.Context, key string) (string, time.Time, error)
+}
+
+// apiKeyUsecase implements the APIKeyUsecase interface.
+type apiKeyUsecase struct {
+	users *userUsecase
+}
+
+// NewAPIKeyUsecase creates a new instance of apiKeyUsecase.
+func NewAPIKeyUsecase(userRepo repository.UserRepository) APIKeyUsecase {
+	return &apiKeyUsecase{users: &userUsecase{userRepo: userRepo}}
+}
+
+// CreateAPIKey creates a key for the user, or for the organization when
+// organizationID is set, which needs an owner or manager. The key grants only
+// scopes, which must be permissions of the user's role; administration is
+// never granted. The key is returned once and only its hash is stored.
+func (a *apiKeyUsecase) CreateAPIKey(ctx context.Context, userID string, organizationID string, name string, scopes []string, expiresAt *time.Time) (*entity.APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if err := val.ValidateStringField(name, 1, 100); err != nil {
+		return nil, "", fmt.Errorf("%w: %v", entity.ErrInvalidAPIKeyName, err)
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", entity.ErrInvalidAPIKeyExpiry
+	}
+
+	user, organization, err := a.owner(ctx, userID, organizationID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	scopes, err = apiKeyScopes(user.Role, scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	active, err := a.users.userRepo.CountActiveAPIKeys(ctx, user.ID, organization)
+	if err != nil {
+		return nil, "", err
+	}
+	if active >= apiKeyMaxActive {
+		return nil, "", entity.ErrTooManyAPIKeys
+	}
+
+	secret, err := utils.GenerateURLSafeToken(apiKeyTokenBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	rawKey := apiKeyPrefix + secret
+
+	key := &entity.APIKey{
+		ID:             uuid.New(),
+		UserID:         user.ID,
+		OrganizationID: organization,
+		Name:           name,
+		Prefix:         rawKey[:apiKeyVisibleSize],
+		KeyHash:        utils.HashToken(rawKey),
+		Scopes:         scopes,
+		ExpiresAt:      expiresAt,
+	}
+	if err := a.users.userRepo.CreateAPIKey(ctx, key); err != nil {
+		return nil, "", err
+	}
+
+	a.users.recordUserSuccess(ctx, entity.AuthEventAPIKeyCreated, user.ID, user.Email, apiKeyMetadata(key))
+
+	return key, rawKey, nil
+}
+
+// ListAPIKeys returns the user's own keys, or the organization's when
+// organizationID is set, including revoked and expired ones.
+func (a *apiKeyUsecase) ListAPIKeys(ctx context.Context, userID string, organizationID string) ([]*entity.APIKey, error) {
+	user, organization, err := a.owner(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.users.userRepo.ListAPIKeys(ctx, user.ID, organization)
+}
+
+// RevokeAPIKey stops one of the user's keys, or one of their organization's
+// if they are an owner or manager, from working. Access tokens already
+// exchanged for it are revoked with it.
+func (a *apiKeyUsecase) RevokeAPIKey(ctx context.Context, userID string, keyID string) error {
+	id, err := uuid.Parse(keyID)
+	if err != nil {
+		return entity.ErrAPIKeyNotFound
+	}
+
+	key, err := a.users.userRepo.GetAPIKey(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Keys of other users and organizations are reported as missing
+	var user *entity.User
+	if key.OrganizationID != nil {
+		user, _, err = a.owner(ctx, userID, key.OrganizationID.String())
+		if errors.Is(err, entity.ErrNotOrganizationMember) || errors.Is(err, entity.ErrOrganizationRoleForbidden) {
+			return entity.ErrAPIKeyNotFound
+		}
+	} else {
+		user, _, err = a.owner(ctx, userID, "")
+		if err == nil && key.UserID != user.ID {
+			return entity.ErrAPIKeyNotFound
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := a.users.userRepo.RevokeAPIKey(ctx, key); err != nil {
+		return err
+	}
+
+	a.users.recordUserSuccess(ctx, entity.AuthEventAPIKeyRevoked, user.ID, user.Email, apiKeyMetadata(key))
+
+	return nil
+}
+
+// AuthenticateAPIKey exchanges a key for a short-lived access token acting as
+// the user who created it, with only the key's scopes that the user still
+// holds. Organization keys stop working once their creator leaves the
+// organization. Every failure returns entity.ErrInvalidAPIKey so callers
+// cannot tell which keys exist.
+func (a *apiKeyUsecase) AuthenticateAPIKey(ctx context.Context, rawKey string) (string, time.Time, error) {
+	if !strings.HasPrefix(rawKey, apiKeyPrefix) {
+		return "", time.Time{}, entity.ErrInvalidAPIKey
+	}
+
+	key, err := a.users.userRepo.GetAPIKeyByHash(ctx, utils.HashToken(rawKey))
+	if err != nil {
+		if errors.Is(err, entity.ErrAPIKeyNotFound) {
+			return "", time.Time{}, entity.ErrInvalidAPIKey
+		}
+		return "", time.Time{}, err
+	}
+
+	now := time.Now()
+	if !key.IsUsable(now) {
+		return "", time.Time{}, entity.ErrInvalidAPIKey
+	}
+
+	user, err := a.users.userRepo.GetUserByID(ctx, key.UserID.String())
+	if err != nil {
+		if errors.Is(err, entity.ErrUserNotFound) {
+			return "", time.Time{}, entity.ErrInvalidAPIKey
+		}
+		return "", time.Time{}, err
+	}
+	if user.CanSignIn() != nil || !user.IsActive || user.DeletionRequestedAt != nil {
+		return "", time.Time{}, entity.ErrInvalidAPIKey
+	}
+
+	var organizationRole string
+	if key.OrganizationID != nil {
+		member, err := a.users.userRepo.GetOrganizationMember(ctx, *key.OrganizationID, user.ID)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotOrganizationMember) {
+				return "", time.Time{}, entity.ErrInvalidAPIKey
+			}
+			return "", time.Time{}, err
+		}
+		organizationRole = member.Role
+	}
+
+	// Permissions the user lost since the key was created are not granted
+	key.Scopes = grantedScopes(user.Role, key.Scopes)
+
+	duration := apiKeyAccessTokenDuration
+	if key.ExpiresAt != nil {
+		duration = min(duration, key.ExpiresAt.Sub(now))
+	}
+
+	accessToken, expiresAt, err := a.users.userRepo.CreateAPIKeyToken(ctx, key, user, organizationRole, duration)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if err := a.users.userRepo.TouchAPIKey(ctx, key.ID); err != nil {
+		log.Printf("failed to record use of api key %s: %v", key.ID, err)
+	}
+
+	return accessToken, expiresAt, nil
+}
+
+// owner returns the user and, when organizationID is set, the organization
+// whose keys they manage, which needs an owner or manager.
+func (a *apiKeyUsecase) owner(ctx context.Context, userID string, organizationID string) (*entity.User, *uuid.UUID, error) {
+	user, err := a.users.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if organizationID == "" {
+		return user, nil, nil
+	}
+
+	organization, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, nil, entity.ErrOrganizationNotFound
+	}
+
+	member, err := a.users.userRepo.GetOrganizationMember(ctx, organization, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !rbac.OrgRole(member.Role).CanManageMembers() {
+		return nil, nil, entity.ErrOrganizationRoleForbidden
+	}
+
+	return user, &member.OrganizationID, nil
+}
+
+// apiKeyScopes checks that every requested scope is a permission of role,
+// other than administration, and removes duplicates.
+func apiKeyScopes(role string, scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, entity.ErrInvalidAPIKeyScope
+	}
+
+	granted := grantedScopes(role, scopes)
+	if len(granted) != len(dedupeScopes(scopes)) {
+		return nil, entity.ErrInvalidAPIKeyScope
+	}
+
+	return granted, nil
+}
+
+// grantedScopes returns the scopes that role grants, other than
+// administration, which is only done by signed in users.
+func grantedScopes(role string, scopes []string) []string {
+	roleScopes := rbac.ScopesForRoles([]string{role})
+
+	granted := make([]string, 0, len(scopes))
+	for _, scope := range dedupeScopes(scopes) {
+		if scope != string(rbac.PermUserAdmin) && rbac.HasPermission(roleScopes, rbac.Permission(scope)) {
+			granted = append(granted, scope)
+		}
+	}
+
+	return granted
+}
+
+func dedupeScopes(scopes []string) []string {
+	seen := make(map[string]bool, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+
+	return result
+}
+
+func apiKeyMetadata(key *entity.APIKey) map[string]string {
+	metadata := map[string]string{
+		"api_key_id": key.ID.String(),
+		"prefix":     key.Prefix,
+	}
+	if key.OrganizationID != nil {
+		metadata["organization_id"] = key.OrganizationID.String()
+	}
+
+	return metadata
+}
@@ -0,0 +1,340 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newSeller() *entity.User {
+	return &entity.User{ID: uuid.New(), Email: "seller@example.com", Role: string(rbac.RoleSeller), IsActive: true}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAPIKeyUsecase(mockRepo)
+	ctx := context.Background()
+
+	seller := newSeller()
+	scopes := []string{string(rbac.PermPropertyRead), string(rbac.PermPropertyCreate), string(rbac.PermPropertyRead)}
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, seller.ID.String()).Return(seller, nil)
+	mockRepo.On("CountActiveAPIKeys", ctx, seller.ID, (*uuid.UUID)(nil)).Return(int64(0), nil)
+	mockRepo.On("CreateAPIKey", ctx, mock.AnythingOfType("*entity.APIKey")).Return(nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+
+	// Execute test
+	key, rawKey, err := useCase.CreateAPIKey(ctx, seller.ID.String(), "", " CRM sync ", scopes, nil)
+
+	// Assertions
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(rawKey, apiKeyPrefix))
+	require.Equal(t, "CRM sync", key.Name)
+	require.Equal(t, seller.ID, key.UserID)
+	require.Nil(t, key.OrganizationID)
+	require.Equal(t, []string{string(rbac.PermPropertyRead), string(rbac.PermPropertyCreate)}, key.Scopes)
+	require.Equal(t, utils.HashToken(rawKey), key.KeyHash)
+	require.True(t, strings.HasPrefix(rawKey, key.Prefix))
+	require.NotContains(t, key.KeyHash, rawKey)
+}
+
+func TestCreateAPIKeyRejectsUngrantedScopes(t *testing.T) {
+	buyer := &entity.User{ID: uuid.New(), Role: string(rbac.RoleBuyer), IsActive: true}
+	admin := &entity.User{ID: uuid.New(), Role: string(rbac.RoleAdmin), IsActive: true}
+
+	testCases := []struct {
+		name   string
+		user   *entity.User
+		scopes []string
+	}{
+		{"NoScopes", buyer, nil},
+		{"NotGrantedByRole", buyer, []string{string(rbac.PermPropertyCreate)}},
+		{"UnknownScope", buyer, []string{"property:everything"}},
+		{"Administration", admin, []string{string(rbac.PermUserAdmin)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+
+			useCase := NewAPIKeyUsecase(mockRepo)
+			ctx := context.Background()
+
+			// Mock behavior
+			mockRepo.On("GetUserByID", ctx, tc.user.ID.String()).Return(tc.user, nil)
+
+			// Execute test
+			_, _, err := useCase.CreateAPIKey(ctx, tc.user.ID.String(), "", "integration", tc.scopes, nil)
+
+			// Assertions
+			require.ErrorIs(t, err, entity.ErrInvalidAPIKeyScope)
+			mockRepo.AssertNotCalled(t, "CreateAPIKey", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreateAPIKeyValidation(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAPIKeyUsecase(mockRepo)
+	ctx := context.Background()
+
+	seller := newSeller()
+	scopes := []string{string(rbac.PermPropertyRead)}
+	past := time.Now().Add(-time.Hour)
+
+	// Execute test
+	_, _, nameErr := useCase.CreateAPIKey(ctx, seller.ID.String(), "", "  ", scopes, nil)
+	_, _, expiryErr := useCase.CreateAPIKey(ctx, seller.ID.String(), "", "integration", scopes, &past)
+
+	// Assertions
+	require.ErrorIs(t, nameErr, entity.ErrInvalidAPIKeyName)
+	require.ErrorIs(t, expiryErr, entity.ErrInvalidAPIKeyExpiry)
+	mockRepo.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+}
+
+func TestCreateAPIKeyTooMany(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAPIKeyUsecase(mockRepo)
+	ctx := context.Background()
+
+	seller := newSeller()
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, seller.ID.String()).Return(seller, nil)
+	mockRepo.On("CountActiveAPIKeys", ctx, seller.ID, (*uuid.UUID)(nil)).Return(int64(apiKeyMaxActive), nil)
+
+	// Execute test
+	_, _, err := useCase.CreateAPIKey(ctx, seller.ID.String(), "", "integration", []string{string(rbac.PermPropertyRead)}, nil)
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrTooManyAPIKeys)
+	mockRepo.AssertNotCalled(t, "CreateAPIKey", mock.Anything, mock.Anything)
+}
+
+func TestCreateOrganizationAPIKeyRequiresManager(t *testing.T) {
+	seller := newSeller()
+	organizationID := uuid.New()
+
+	testCases := []struct {
+		name  string
+		role  rbac.OrgRole
+		check func(t *testing.T, key *entity.APIKey, err error)
+	}{
+		{
+			name: "Manager",
+			role: rbac.OrgRoleManager,
+			check: func(t *testing.T, key *entity.APIKey, err error) {
+				require.NoError(t, err)
+				require.Equal(t, organizationID, *key.OrganizationID)
+			},
+		},
+		{
+			name: "Agent",
+			role: rbac.OrgRoleAgent,
+			check: func(t *testing.T, key *entity.APIKey, err error) {
+				require.ErrorIs(t, err, entity.ErrOrganizationRoleForbidden)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+
+			useCase := NewAPIKeyUsecase(mockRepo)
+			ctx := context.Background()
+
+			member := &entity.OrganizationMember{OrganizationID: organizationID, UserID: seller.ID, Role: string(tc.role)}
+
+			// Mock behavior
+			mockRepo.On("GetUserByID", ctx, seller.ID.String()).Return(seller, nil)
+			mockRepo.On("GetOrganizationMember", ctx, organizationID, seller.ID).Return(member, nil)
+			mockRepo.On("CountActiveAPIKeys", ctx, seller.ID, &organizationID).Return(int64(0), nil)
+			mockRepo.On("CreateAPIKey", ctx, mock.AnythingOfType("*entity.APIKey")).Return(nil)
+			mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+
+			// Execute test
+			key, _, err := useCase.CreateAPIKey(ctx, seller.ID.String(), organizationID.String(), "portal", []string{string(rbac.PermPropertyRead)}, nil)
+
+			// Assertions
+			tc.check(t, key, err)
+		})
+	}
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAPIKeyUsecase(mockRepo)
+	ctx := context.Background()
+
+	seller := newSeller()
+	key := &entity.APIKey{ID: uuid.New(), UserID: seller.ID}
+
+	// Mock behavior
+	mockRepo.On("GetAPIKey", ctx, key.ID).Return(key, nil)
+	mockRepo.On("GetUserByID", ctx, seller.ID.String()).Return(seller, nil)
+	mockRepo.On("RevokeAPIKey", ctx, key).Return(nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.MatchedBy(func(event *entity.AuthEvent) bool {
+		return event.EventType == entity.AuthEventAPIKeyRevoked
+	})).Return(nil)
+
+	// Execute test
+	err := useCase.RevokeAPIKey(ctx, seller.ID.String(), key.ID.String())
+
+	// Assertions
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRevokeAPIKeyOfAnotherUser(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAPIKeyUsecase(mockRepo)
+	ctx := context.Background()
+
+	seller := newSeller()
+	key := &entity.APIKey{ID: uuid.New(), UserID: uuid.New()}
+
+	// Mock behavior
+	mockRepo.On("GetAPIKey", ctx, key.ID).Return(key, nil)
+	mockRepo.On("GetUserByID", ctx, seller.ID.String()).Return(seller, nil)
+
+	// Execute test
+	err := useCase.RevokeAPIKey(ctx, seller.ID.String(), key.ID.String())
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrAPIKeyNotFound)
+	mockRepo.AssertNotCalled(t, "RevokeAPIKey", mock.Anything, mock.Anything)
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewAPIKeyUsecase(mockRepo)
+	ctx := context.Background()
+
+	seller := newSeller()
+	rawKey := apiKeyPrefix + "secret"
+	expiresAt := time.Now().Add(apiKeyAccessTokenDuration)
+	key := &entity.APIKey{
+		ID:     uuid.New(),
+		UserID: seller.ID,
+		Scopes: []string{string(rbac.PermPropertyRead), string(rbac.PermPropertyCreate)},
+	}
+
+	// The seller has since become a buyer, who cannot create listings
+	seller.Role = string(rbac.RoleBuyer)
+
+	// Mock behavior
+	mockRepo.On("GetAPIKeyByHash", ctx, utils.HashToken(rawKey)).Return(key, nil)
+	mockRepo.On("GetUserByID", ctx, seller.ID.String()).Return(seller, nil)
+	mockRepo.On("CreateAPIKeyToken", ctx, mock.MatchedBy(func(k *entity.APIKey) bool {
+		return len(k.Scopes) == 1 && k.Scopes[0] == string(rbac.PermPropertyRead)
+	}), seller, "", apiKeyAccessTokenDuration).Return("access-token", expiresAt, nil)
+	mockRepo.On("TouchAPIKey", ctx, key.ID).Return(nil)
+
+	// Execute test
+	accessToken, tokenExpiresAt, err := useCase.AuthenticateAPIKey(ctx, rawKey)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, "access-token", accessToken)
+	require.Equal(t, expiresAt, tokenExpiresAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAuthenticateAPIKeyInvalid(t *testing.T) {
+	rawKey := apiKeyPrefix + "secret"
+	past := time.Now().Add(-time.Minute)
+	organizationID := uuid.New()
+
+	testCases := []struct {
+		name       string
+		rawKey     string
+		buildStubs func(mockRepo *MockUserRepository, ctx context.Context)
+	}{
+		{
+			name:       "WrongFormat",
+			rawKey:     "secret",
+			buildStubs: func(mockRepo *MockUserRepository, ctx context.Context) {},
+		},
+		{
+			name:   "Unknown",
+			rawKey: rawKey,
+			buildStubs: func(mockRepo *MockUserRepository, ctx context.Context) {
+				mockRepo.On("GetAPIKeyByHash", ctx, utils.HashToken(rawKey)).Return(nil, entity.ErrAPIKeyNotFound)
+			},
+		},
+		{
+			name:   "Revoked",
+			rawKey: rawKey,
+			buildStubs: func(mockRepo *MockUserRepository, ctx context.Context) {
+				key := &entity.APIKey{ID: uuid.New(), UserID: uuid.New(), RevokedAt: &past}
+				mockRepo.On("GetAPIKeyByHash", ctx, utils.HashToken(rawKey)).Return(key, nil)
+			},
+		},
+		{
+			name:   "Expired",
+			rawKey: rawKey,
+			buildStubs: func(mockRepo *MockUserRepository, ctx context.Context) {
+				key := &entity.APIKey{ID: uuid.New(), UserID: uuid.New(), ExpiresAt: &past}
+				mockRepo.On("GetAPIKeyByHash", ctx, utils.HashToken(rawKey)).Return(key, nil)
+			},
+		},
+		{
+			name:   "LockedUser",
+			rawKey: rawKey,
+			buildStubs: func(mockRepo *MockUserRepository, ctx context.Context) {
+				user := newSeller()
+				user.LockedAt = &past
+				key := &entity.APIKey{ID: uuid.New(), UserID: user.ID}
+				mockRepo.On("GetAPIKeyByHash", ctx, utils.HashToken(rawKey)).Return(key, nil)
+				mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+			},
+		},
+		{
+			name:   "LeftOrganization",
+			rawKey: rawKey,
+			buildStubs: func(mockRepo *MockUserRepository, ctx context.Context) {
+				user := newSeller()
+				key := &entity.APIKey{ID: uuid.New(), UserID: user.ID, OrganizationID: &organizationID}
+				mockRepo.On("GetAPIKeyByHash", ctx, utils.HashToken(rawKey)).Return(key, nil)
+				mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+				mockRepo.On("GetOrganizationMember", ctx, organizationID, user.ID).Return(nil, entity.ErrNotOrganizationMember)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+
+			useCase := NewAPIKeyUsecase(mockRepo)
+			ctx := context.Background()
+
+			// Mock behavior
+			tc.buildStubs(mockRepo, ctx)
+
+			// Execute test
+			_, _, err := useCase.AuthenticateAPIKey(ctx, tc.rawKey)
+
+			// Assertions
+			require.ErrorIs(t, err, entity.ErrInvalidAPIKey)
+			mockRepo.AssertNotCalled(t, "CreateAPIKeyToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/domain/repository"
+	"github.com/demola234/authentication/pkg/geoip"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// authEventPruneInterval is how often events past the retention are deleted
+const authEventPruneInterval = time.Hour
+
+// Login methods recorded in the metadata of login events
+const (
+	loginMethodPassword  = "password"
+	loginMethodOAuth     = "oauth"
+	loginMethodMagicLink = "magic_link"
+	loginMethodMFA       = "mfa"
+)
+
+// describeClient fills in the caller's IP, user agent, device and coarse
+// location on an event about to be recorded.
+func describeClient(ctx context.Context, event *entity.AuthEvent) {
+	event.ID = uuid.New()
+	event.IPAddress = clientIP(ctx)
+	event.UserAgent = utils.ExtractMetaData(ctx).UserAgent
+
+	device := utils.ParseUserAgent(event.UserAgent)
+	event.Device = device.String()
+	event.DeviceFingerprint = device.Fingerprint()
+	event.Location = geoip.Locate(event.IPAddress).String()
+}
+
+// recordAuthEvent appends an event to the security audit log with the caller's
+// details. It is best effort and never fails the audited action.
+func (u *userUsecase) recordAuthEvent(ctx context.Context, event *entity.AuthEvent) {
+	describeClient(ctx, event)
+	u.storeAuthEvent(ctx, event)
+}
+
+func (u *userUsecase) storeAuthEvent(ctx context.Context, event *entity.AuthEvent) {
+	if err := u.userRepo.CreateAuthEvent(ctx, event); err != nil {
+		log.Printf("failed to record %s auth event: %v", event.EventType, err)
+	}
+}
+
+// recordUserSuccess records an action the user completed on their own account.
+func (u *userUsecase) recordUserSuccess(ctx context.Context, eventType string, userID uuid.UUID, email string, metadata map[string]string) {
+	u.recordAuthEvent(ctx, &entity.AuthEvent{
+		EventType: eventType,
+		Outcome:   entity.AuthEventSuccess,
+		ActorID:   &userID,
+		UserID:    &userID,
+		Email:     email,
+		Metadata:  metadata,
+	})
+}
+
+// recordUserFailure records a failed attempt against an account. The caller is
+// not recorded as the actor since the attempt did not prove who they are;
+// userID is nil when no account matched email.
+func (u *userUsecase) recordUserFailure(ctx context.Context, eventType string, userID *uuid.UUID, email string, reason string) {
+	u.recordAuthEvent(ctx, &entity.AuthEvent{
+		EventType: eventType,
+		Outcome:   entity.AuthEventFailure,
+		UserID:    userID,
+		Email:     email,
+		Metadata:  map[string]string{"failure_reason": reason},
+	})
+}
+
+// recordLogin records a completed login and alerts the user when it came from
+// a device or location their account has not signed in from before. session
+// is nil when the session is created later by the caller, as with password
+// logins.
+func (u *userUsecase) recordLogin(ctx context.Context, user *entity.User, session *entity.Session, method string) {
+	event := &entity.AuthEvent{
+		EventType: entity.AuthEventLogin,
+		Outcome:   entity.AuthEventSuccess,
+		ActorID:   &user.ID,
+		UserID:    &user.ID,
+		Email:     user.Email,
+		Metadata:  map[string]string{"method": method},
+	}
+	if session != nil {
+		event.SessionID = &session.SessionID
+	}
+
+	// Familiarity is checked before the login is stored so it does not count itself
+	describeClient(ctx, event)
+	unfamiliar := u.isUnfamiliarLogin(ctx, user.ID, event)
+	u.storeAuthEvent(ctx, event)
+
+	if unfamiliar {
+		u.notifyNewLogin(ctx, user, event)
+	}
+}
+
+// isUnfamiliarLogin reports whether a login comes from a device or location
+// the user has not signed in from before. A user's first login is not
+// unusual, and when the history cannot be read the login is treated as
+// unfamiliar so an alert is never silently dropped.
+func (u *userUsecase) isUnfamiliarLogin(ctx context.Context, userID uuid.UUID, event *entity.AuthEvent) bool {
+	familiarity, err := u.userRepo.GetLoginFamiliarity(ctx, userID, event.DeviceFingerprint, event.Location)
+	if err != nil {
+		log.Printf("failed to check login familiarity: %v", err)
+		return true
+	}
+
+	if familiarity.PreviousLogins == 0 {
+		return false
+	}
+	if event.DeviceFingerprint != "" && familiarity.DeviceLogins == 0 {
+		return true
+	}
+	return event.Location != "" && familiarity.LocationLogins == 0
+}
+
+// recordSessionRevoked records sessions of userID being ended by actorID, who
+// is an administrator when they differ. A nil sessionID means every session.
+func (u *userUsecase) recordSessionRevoked(ctx context.Context, actorID, userID uuid.UUID, sessionID *uuid.UUID, reason string) {
+	u.recordAuthEvent(ctx, &entity.AuthEvent{
+		EventType: entity.AuthEventSessionRevoked,
+		Outcome:   entity.AuthEventSuccess,
+		ActorID:   &actorID,
+		UserID:    &userID,
+		SessionID: sessionID,
+		Metadata:  map[string]string{"reason": reason},
+	})
+}
+
+// authFailureReason names why an attempt failed in the audit log
+func authFailureReason(err error) string {
+	var lockErr *entity.LockoutError
+
+	switch {
+	case errors.As(err, &lockErr):
+		return "locked_out"
+	case errors.Is(err, entity.ErrAccountLocked):
+		return "account_locked"
+	case errors.Is(err, entity.ErrAccountDeleted):
+		return "account_deleted"
+	case errors.Is(err, entity.ErrVerificationCodeInvalid), errors.Is(err, entity.ErrInvalidMFACode):
+		return "invalid_code"
+	case errors.Is(err, entity.ErrVerificationCodeExpired):
+		return "code_expired"
+	case errors.Is(err, entity.ErrVerificationAttemptsExceeded), errors.Is(err, entity.ErrMFAAttemptsExceeded):
+		return "attempts_exceeded"
+	case errors.Is(err, entity.ErrVerificationNotFound):
+		return "no_pending_code"
+	case errors.Is(err, entity.ErrMagicLinkUsed):
+		return "link_replayed"
+	case errors.Is(err, entity.ErrMagicLinkInvalid):
+		return "link_invalid"
+	}
+	return "error"
+}
+
+// RunAuthEventPruning deletes audit log events older than retention once an
+// hour. It returns when ctx is cancelled.
+func RunAuthEventPruning(ctx context.Context, userRepo repository.UserRepository, retention time.Duration) {
+	ticker := time.NewTicker(authEventPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		pruned, err := userRepo.PruneAuthEvents(ctx, time.Now().Add(-retention))
+		if err != nil {
+			log.Printf("failed to prune auth events: %v", err)
+		} else if pruned > 0 {
+			log.Printf("pruned %d auth events older than %s", pruned, retention)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLoginUserRecordsAuthEvents(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), mockQueue)
+	ctx := gatewayContext(t, metadata.Pairs("x-forwarded-for", "203.0.113.7", "user-agent", "test-agent"))
+
+	email := "test@example.com"
+	hashedPassword, _ := utils.HashPassword("password123")
+	mockUser := &entity.User{ID: uuid.New(), Email: email, Password: hashedPassword}
+	var events []*entity.AuthEvent
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+		Run(func(args mock.Arguments) { events = append(events, args.Get(1).(*entity.AuthEvent)) }).
+		Return(nil)
+	mockRepo.On("GetLoginFamiliarity", ctx, mockUser.ID, mock.Anything, mock.Anything).Return(&entity.LoginFamiliarity{}, nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.Anything).Return(nil, nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(mockUser, nil)
+	mockRepo.On("RecordAuthFailure", ctx, mock.Anything, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{}, nil)
+	mockRepo.On("ResetAuthThrottle", ctx, mock.Anything).Return(nil)
+	mockRepo.On("GetUserMFA", ctx, mockUser.ID).Return(nil, entity.ErrMFANotEnrolled)
+	mockRepo.On("CreateToken", ctx, email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+
+	// Execute test
+	_, _, _, err := useCase.LoginUser(ctx, "wrong-password", email)
+	require.Error(t, err)
+
+	_, _, _, err = useCase.LoginUser(ctx, "password123", email)
+	require.NoError(t, err)
+
+	// Assertions
+	require.Len(t, events, 2)
+
+	failure := events[0]
+	require.Equal(t, entity.AuthEventLogin, failure.EventType)
+	require.Equal(t, entity.AuthEventFailure, failure.Outcome)
+	require.Nil(t, failure.ActorID)
+	require.Equal(t, mockUser.ID, *failure.UserID)
+	require.Equal(t, "invalid_password", failure.Metadata["failure_reason"])
+	require.Equal(t, "203.0.113.7", failure.IPAddress)
+	require.Equal(t, "test-agent", failure.UserAgent)
+
+	success := events[1]
+	require.Equal(t, entity.AuthEventSuccess, success.Outcome)
+	require.Equal(t, mockUser.ID, *success.ActorID)
+	require.Equal(t, loginMethodPassword, success.Metadata["method"])
+	require.NotEqual(t, failure.ID, success.ID)
+}
+
+func TestLoginUserUnknownEmailRecordsAttempt(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "nobody@example.com"
+	var event *entity.AuthEvent
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+		Run(func(args mock.Arguments) { event = args.Get(1).(*entity.AuthEvent) }).
+		Return(nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.Anything).Return(nil, nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(nil, entity.ErrUserNotFound)
+	mockRepo.On("RecordAuthFailure", ctx, mock.Anything, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{}, nil)
+
+	// Execute test
+	_, _, _, err := useCase.LoginUser(ctx, "password123", email)
+
+	// Assertions
+	require.Error(t, err)
+	require.Nil(t, event.UserID)
+	require.Equal(t, email, event.Email)
+	require.Equal(t, "unknown_email", event.Metadata["failure_reason"])
+}
+
+func TestRecordLoginAlertsOnUnfamiliarDevice(t *testing.T) {
+	userAgent := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36"
+	fingerprint := utils.ParseUserAgent(userAgent).Fingerprint()
+
+	testCases := []struct {
+		name        string
+		familiarity *entity.LoginFamiliarity
+		err         error
+		alerted     bool
+	}{
+		{"first login", &entity.LoginFamiliarity{}, nil, false},
+		{"known device", &entity.LoginFamiliarity{PreviousLogins: 4, DeviceLogins: 2}, nil, false},
+		{"new device", &entity.LoginFamiliarity{PreviousLogins: 4}, nil, true},
+		{"history unavailable", nil, context.DeadlineExceeded, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+			mockMailer := mailer.NewMemoryMailer()
+
+			useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mockMailer, new(MockMessageQueue)).(*userUsecase)
+			ctx := gatewayContext(t, metadata.Pairs("x-forwarded-for", "203.0.113.7", "user-agent", userAgent))
+
+			user := &entity.User{ID: uuid.New(), Email: "test@example.com", FullName: "Test User"}
+			var event *entity.AuthEvent
+
+			// Mock behavior
+			mockRepo.On("GetLoginFamiliarity", ctx, user.ID, fingerprint, "").Return(tc.familiarity, tc.err)
+			mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+				Run(func(args mock.Arguments) { event = args.Get(1).(*entity.AuthEvent) }).
+				Return(nil)
+
+			// Execute test
+			useCase.recordLogin(ctx, user, nil, loginMethodPassword)
+
+			// Assertions
+			require.Equal(t, "Chrome on macOS", event.Device)
+			require.Equal(t, fingerprint, event.DeviceFingerprint)
+			if tc.alerted {
+				sent := mockMailer.Last()
+				require.NotNil(t, sent)
+				require.Contains(t, sent.TextBody, "Chrome on macOS")
+				require.Contains(t, sent.TextBody, "203.0.113.7")
+			} else {
+				require.Nil(t, mockMailer.Last())
+			}
+		})
+	}
+}
+
+func TestAuthFailureReason(t *testing.T) {
+	require.Equal(t, "locked_out", authFailureReason(&entity.LockoutError{}))
+	require.Equal(t, "account_locked", authFailureReason(entity.ErrAccountLocked))
+	require.Equal(t, "invalid_code", authFailureReason(entity.ErrInvalidMFACode))
+	require.Equal(t, "attempts_exceeded", authFailureReason(entity.ErrVerificationAttemptsExceeded))
+	require.Equal(t, "error", authFailureReason(context.Canceled))
+}
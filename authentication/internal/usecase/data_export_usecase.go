@@ -0,0 +1,431 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/domain/repository"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// dataExportTTL is how long a built export can be downloaded before it
+	// is deleted
+	dataExportTTL = 7 * 24 * time.Hour
+	// dataExportInterval is how often pending exports are built and expired
+	// ones deleted
+	dataExportInterval = 30 * time.Second
+	// dataExportStaleAfter is how long a build may run before another worker
+	// assumes it crashed and builds the export again
+	dataExportStaleAfter = 15 * time.Minute
+	dataExportTokenBytes = 32
+	dataExportLoginLimit = 1000
+
+	// dataExportFailedReason is shown to the user; the cause is only logged
+	dataExportFailedReason = "We could not collect all of your data. Please request a new export."
+)
+
+// DataExportUsecase defines the flow of a user downloading a copy of all the
+// data the services hold about them.
+type DataExportUsecase interface {
+	RequestDataExport(ctx context.Context, userID string) (*entity.DataExport, error)
+	GetDataExport(ctx context.Context, userID string, exportID string) (*entity.DataExport, error)
+	OpenDataExport(ctx context.Context, exportID string, token string) (*entity.DataExport, io.ReadCloser, error)
+	Run(ctx context.Context)
+}
+
+// dataExportUsecase implements the DataExportUsecase interface. Exports are
+// built in the background by Run from the user's own records and from every
+// source, each of which adds the files of another service.
+type dataExportUsecase struct {
+	users   *userUsecase
+	storage repository.ExportStorage
+	sources []repository.ExportSource
+}
+
+// NewDataExportUsecase creates a new instance of dataExportUsecase.
+func NewDataExportUsecase(userRepo repository.UserRepository, mailer repository.Mailer, storage repository.ExportStorage, sources ...repository.ExportSource) DataExportUsecase {
+	return &dataExportUsecase{
+		users:   &userUsecase{userRepo: userRepo, mailer: mailer},
+		storage: storage,
+		sources: sources,
+	}
+}
+
+// RequestDataExport queues an export of the user's data. The user is emailed
+// a download link once it is built.
+func (d *dataExportUsecase) RequestDataExport(ctx context.Context, userID string) (*entity.DataExport, error) {
+	user, err := d.users.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &entity.DataExport{ID: uuid.New(), UserID: user.ID}
+	if err := d.users.userRepo.CreateDataExport(ctx, export); err != nil {
+		return nil, err
+	}
+
+	d.users.recordUserSuccess(ctx, entity.AuthEventDataExportRequested, user.ID, user.Email, map[string]string{
+		"export_id": export.ID.String(),
+	})
+
+	return export, nil
+}
+
+// GetDataExport returns the status of one of the user's exports. Exports of
+// other users are reported as not found.
+func (d *dataExportUsecase) GetDataExport(ctx context.Context, userID string, exportID string) (*entity.DataExport, error) {
+	id, err := uuid.Parse(exportID)
+	if err != nil {
+		return nil, entity.ErrDataExportNotFound
+	}
+
+	export, err := d.users.userRepo.GetDataExport(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if export.UserID.String() != userID {
+		return nil, entity.ErrDataExportNotFound
+	}
+
+	return export, nil
+}
+
+// OpenDataExport checks the token of an emailed download link and opens the
+// export's ZIP file. The caller must close it.
+func (d *dataExportUsecase) OpenDataExport(ctx context.Context, exportID string, token string) (*entity.DataExport, io.ReadCloser, error) {
+	id, err := uuid.Parse(exportID)
+	if err != nil {
+		return nil, nil, entity.ErrDataExportLinkInvalid
+	}
+
+	export, err := d.users.userRepo.GetDataExport(ctx, id)
+	if err != nil {
+		if err == entity.ErrDataExportNotFound {
+			return nil, nil, entity.ErrDataExportLinkInvalid
+		}
+		return nil, nil, err
+	}
+
+	if export.Status != entity.DataExportReady || export.DownloadTokenHash == "" ||
+		subtle.ConstantTimeCompare([]byte(utils.HashToken(token)), []byte(export.DownloadTokenHash)) != 1 {
+		return nil, nil, entity.ErrDataExportLinkInvalid
+	}
+	if export.ExpiresAt == nil || !time.Now().Before(*export.ExpiresAt) {
+		return nil, nil, entity.ErrDataExportLinkInvalid
+	}
+
+	file, err := d.storage.Open(ctx, export.FileName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d.users.recordUserSuccess(ctx, entity.AuthEventDataExportDownloaded, export.UserID, "", map[string]string{
+		"export_id": export.ID.String(),
+	})
+
+	return export, file, nil
+}
+
+// Run builds pending exports and deletes expired ones every
+// dataExportInterval. It returns when ctx is cancelled.
+func (d *dataExportUsecase) Run(ctx context.Context) {
+	ticker := time.NewTicker(dataExportInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.buildPendingExports(ctx); err != nil {
+			log.Printf("failed to build data exports: %v", err)
+		}
+		if err := d.pruneExpiredExports(ctx); err != nil {
+			log.Printf("failed to delete expired data exports: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// buildPendingExports builds exports until none are left to claim. An export
+// that cannot be built is marked failed so the user can request another.
+func (d *dataExportUsecase) buildPendingExports(ctx context.Context) error {
+	for ctx.Err() == nil {
+		export, err := d.users.userRepo.ClaimDataExport(ctx, time.Now().Add(-dataExportStaleAfter))
+		if err != nil {
+			return err
+		}
+		if export == nil {
+			return nil
+		}
+
+		if err := d.buildExport(ctx, export); err != nil {
+			log.Printf("failed to build data export %s: %v", export.ID, err)
+			if err := d.users.userRepo.FailDataExport(ctx, export.ID, dataExportFailedReason, time.Now().Add(dataExportTTL)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// buildExport writes the ZIP of an export, marks it ready and emails the
+// user the download link.
+func (d *dataExportUsecase) buildExport(ctx context.Context, export *entity.DataExport) error {
+	user, err := d.users.userRepo.GetUserByID(ctx, export.UserID.String())
+	if err != nil {
+		return err
+	}
+
+	files, err := d.collectFiles(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	archive, err := writeExportArchive(export, files)
+	if err != nil {
+		return err
+	}
+
+	token, err := utils.GenerateURLSafeToken(dataExportTokenBytes)
+	if err != nil {
+		return err
+	}
+
+	export.FileName = export.ID.String() + ".zip"
+	if err := d.storage.Save(ctx, export.FileName, archive); err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(dataExportTTL)
+	export.SizeBytes = int64(len(archive))
+	export.DownloadTokenHash = utils.HashToken(token)
+	export.ExpiresAt = &expiresAt
+	if err := d.users.userRepo.CompleteDataExport(ctx, export); err != nil {
+		if err := d.storage.Delete(ctx, export.FileName); err != nil {
+			log.Printf("failed to delete data export file %s: %v", export.FileName, err)
+		}
+		return err
+	}
+
+	d.users.notifyDataExportReady(ctx, user, export.ID, token, dataExportTTL)
+
+	return nil
+}
+
+// collectFiles gathers the user's account records and the files of every
+// source. Entities are copied into export types so secrets such as password
+// hashes and session tokens are never written out.
+func (d *dataExportUsecase) collectFiles(ctx context.Context, user *entity.User) ([]*entity.ExportFile, error) {
+	sessions, err := d.users.userRepo.GetUserSessions(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := d.users.userRepo.GetLoginHistory(ctx, user.ID, dataExportLoginLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []*entity.ExportFile{
+		{Name: "profile.json", Content: newExportProfile(user)},
+		{Name: "sessions.json", Content: newExportSessions(sessions)},
+		{Name: "login_history.json", Content: newExportLoginHistory(history)},
+	}
+
+	for _, source := range d.sources {
+		sourceFiles, err := source.ExportUserData(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, sourceFiles...)
+	}
+
+	return files, nil
+}
+
+// pruneExpiredExports deletes the expired exports and their files.
+func (d *dataExportUsecase) pruneExpiredExports(ctx context.Context) error {
+	exports, err := d.users.userRepo.DeleteExpiredDataExports(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, export := range exports {
+		if export.FileName == "" {
+			continue
+		}
+		if err := d.storage.Delete(ctx, export.FileName); err != nil {
+			log.Printf("failed to delete data export file %s: %v", export.FileName, err)
+		}
+	}
+
+	return nil
+}
+
+// exportManifest is the export.json file describing the rest of the archive.
+type exportManifest struct {
+	ExportID    uuid.UUID `json:"export_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	RequestedAt time.Time `json:"requested_at"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Files       []string  `json:"files"`
+}
+
+// writeExportArchive returns a ZIP of the files and a manifest listing them.
+func writeExportArchive(export *entity.DataExport, files []*entity.ExportFile) ([]byte, error) {
+	manifest := exportManifest{
+		ExportID:    export.ID,
+		UserID:      export.UserID,
+		RequestedAt: export.CreatedAt.UTC(),
+		GeneratedAt: time.Now().UTC(),
+		Files:       make([]string, 0, len(files)),
+	}
+	for _, file := range files {
+		manifest.Files = append(manifest.Files, file.Name)
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, file := range append([]*entity.ExportFile{{Name: "export.json", Content: manifest}}, files...) {
+		content, err := marshalExportContent(file.Content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %w", file.Name, err)
+		}
+
+		w, err := archive.Create(file.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s to data export: %w", file.Name, err)
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, fmt.Errorf("failed to add %s to data export: %w", file.Name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write data export: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// marshalExportContent renders content as indented JSON, using the protobuf
+// field names for the responses of other services.
+func marshalExportContent(content any) ([]byte, error) {
+	if message, ok := content.(proto.Message); ok {
+		return protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}.Marshal(message)
+	}
+
+	return json.MarshalIndent(content, "", "  ")
+}
+
+type exportProfile struct {
+	ID                   uuid.UUID  `json:"id"`
+	FullName             string     `json:"name"`
+	Email                string     `json:"email"`
+	Username             string     `json:"username"`
+	Bio                  string     `json:"bio"`
+	ProfilePicture       string     `json:"profile_picture"`
+	Phone                string     `json:"phone"`
+	Role                 string     `json:"role"`
+	EmailVerified        bool       `json:"email_verified"`
+	LastLogin            time.Time  `json:"last_login"`
+	CreatedAt            time.Time  `json:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at"`
+	DeletionScheduledFor *time.Time `json:"deletion_scheduled_for,omitempty"`
+}
+
+func newExportProfile(user *entity.User) exportProfile {
+	return exportProfile{
+		ID:                   user.ID,
+		FullName:             user.FullName,
+		Email:                user.Email,
+		Username:             user.Username,
+		Bio:                  user.Bio,
+		ProfilePicture:       user.ProfilePicture,
+		Phone:                user.Phone,
+		Role:                 user.Role,
+		EmailVerified:        user.EmailVerified,
+		LastLogin:            user.LastLogin,
+		CreatedAt:            user.CreatedAt,
+		UpdatedAt:            user.UpdatedAt,
+		DeletionScheduledFor: user.DeletionScheduledFor,
+	}
+}
+
+type exportSession struct {
+	SessionID           uuid.UUID  `json:"session_id"`
+	IpAddress           string     `json:"ip_address,omitempty"`
+	UserAgent           string     `json:"user_agent,omitempty"`
+	IsActive            bool       `json:"is_active"`
+	CreatedAt           time.Time  `json:"created_at"`
+	LastActivity        time.Time  `json:"last_activity"`
+	ExpiresAt           time.Time  `json:"expires_at"`
+	RevokedAt           *time.Time `json:"revoked_at,omitempty"`
+	ImpersonatorID      *uuid.UUID `json:"impersonator_id,omitempty"`
+	ImpersonationReason string     `json:"impersonation_reason,omitempty"`
+}
+
+func newExportSessions(sessions []*entity.Session) []exportSession {
+	result := make([]exportSession, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, exportSession{
+			SessionID:           session.SessionID,
+			IpAddress:           session.IpAddress,
+			UserAgent:           session.UserAgent,
+			IsActive:            session.IsActive,
+			CreatedAt:           session.CreatedAt,
+			LastActivity:        session.LastActivity,
+			ExpiresAt:           session.ExpiresAt,
+			RevokedAt:           session.RevokedAt,
+			ImpersonatorID:      session.ImpersonatorID,
+			ImpersonationReason: session.ImpersonationReason,
+		})
+	}
+
+	return result
+}
+
+type exportLoginEntry struct {
+	Timestamp           time.Time  `json:"timestamp"`
+	IpAddress           string     `json:"ip_address,omitempty"`
+	UserAgent           string     `json:"user_agent,omitempty"`
+	Device              string     `json:"device,omitempty"`
+	Location            string     `json:"location,omitempty"`
+	Success             bool       `json:"success"`
+	ImpersonatorID      *uuid.UUID `json:"impersonator_id,omitempty"`
+	ImpersonationReason string     `json:"impersonation_reason,omitempty"`
+}
+
+func newExportLoginHistory(history []*entity.LoginHistoryEntry) []exportLoginEntry {
+	result := make([]exportLoginEntry, 0, len(history))
+	for _, entry := range history {
+		result = append(result, exportLoginEntry{
+			Timestamp:           entry.Timestamp,
+			IpAddress:           entry.IpAddress,
+			UserAgent:           entry.UserAgent,
+			Device:              entry.Device,
+			Location:            entry.Location,
+			Success:             entry.Success,
+			ImpersonatorID:      entry.ImpersonatorID,
+			ImpersonationReason: entry.ImpersonationReason,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,331 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// memoryExportStorage keeps export files in memory.
+type memoryExportStorage struct {
+	files map[string][]byte
+}
+
+func newMemoryExportStorage() *memoryExportStorage {
+	return &memoryExportStorage{files: map[string][]byte{}}
+}
+
+func (s *memoryExportStorage) Save(ctx context.Context, name string, data []byte) error {
+	s.files[name] = data
+	return nil
+}
+
+func (s *memoryExportStorage) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	data, ok := s.files[name]
+	if !ok {
+		return nil, errors.New("file not found")
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *memoryExportStorage) Delete(ctx context.Context, name string) error {
+	delete(s.files, name)
+	return nil
+}
+
+// fakeExportSource returns fixed files, or err.
+type fakeExportSource struct {
+	files []*entity.ExportFile
+	err   error
+}
+
+func (s *fakeExportSource) ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.ExportFile, error) {
+	return s.files, s.err
+}
+
+func TestRequestDataExport(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewDataExportUsecase(mockRepo, mailer.NewMemoryMailer(), newMemoryExportStorage())
+	ctx := context.Background()
+
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com"}
+	var event *entity.AuthEvent
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("CreateDataExport", ctx, mock.AnythingOfType("*entity.DataExport")).
+		Run(func(args mock.Arguments) { args.Get(1).(*entity.DataExport).Status = entity.DataExportPending }).
+		Return(nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+		Run(func(args mock.Arguments) { event = args.Get(1).(*entity.AuthEvent) }).
+		Return(nil)
+
+	// Execute test
+	export, err := useCase.RequestDataExport(ctx, user.ID.String())
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, user.ID, export.UserID)
+	require.Equal(t, entity.DataExportPending, export.Status)
+	require.Equal(t, entity.AuthEventDataExportRequested, event.EventType)
+	require.Equal(t, export.ID.String(), event.Metadata["export_id"])
+}
+
+func TestRequestDataExportInProgress(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewDataExportUsecase(mockRepo, mailer.NewMemoryMailer(), newMemoryExportStorage())
+	ctx := context.Background()
+
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com"}
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("CreateDataExport", ctx, mock.AnythingOfType("*entity.DataExport")).Return(entity.ErrDataExportInProgress)
+
+	// Execute test
+	_, err := useCase.RequestDataExport(ctx, user.ID.String())
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrDataExportInProgress)
+	mockRepo.AssertNotCalled(t, "CreateAuthEvent", mock.Anything, mock.Anything)
+}
+
+func TestGetDataExportOfAnotherUser(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewDataExportUsecase(mockRepo, mailer.NewMemoryMailer(), newMemoryExportStorage())
+	ctx := context.Background()
+
+	export := &entity.DataExport{ID: uuid.New(), UserID: uuid.New(), Status: entity.DataExportReady}
+
+	// Mock behavior
+	mockRepo.On("GetDataExport", ctx, export.ID).Return(export, nil)
+
+	// Execute test
+	_, err := useCase.GetDataExport(ctx, uuid.New().String(), export.ID.String())
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrDataExportNotFound)
+}
+
+func TestBuildPendingExports(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockMailer := mailer.NewMemoryMailer()
+	storage := newMemoryExportStorage()
+
+	listings, err := structpb.NewStruct(map[string]any{"title": "Two bedroom flat"})
+	require.NoError(t, err)
+	source := &fakeExportSource{files: []*entity.ExportFile{{Name: "properties.json", Content: listings}}}
+
+	useCase := NewDataExportUsecase(mockRepo, mockMailer, storage, source).(*dataExportUsecase)
+	ctx := context.Background()
+
+	hashedPassword, _ := utils.HashPassword("password123")
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com", FullName: "Test User", Password: hashedPassword}
+	sessions := []*entity.Session{{SessionID: uuid.New(), UserID: user.ID, Token: "refresh-token", Otp: "123456", IsActive: true}}
+	history := []*entity.LoginHistoryEntry{{ID: uuid.New(), UserID: user.ID, IpAddress: "203.0.113.7", Success: true}}
+	export := &entity.DataExport{ID: uuid.New(), UserID: user.ID, Status: entity.DataExportBuilding, CreatedAt: time.Now()}
+	var completed *entity.DataExport
+
+	// Mock behavior
+	mockRepo.On("ClaimDataExport", ctx, mock.AnythingOfType("time.Time")).Return(export, nil).Once()
+	mockRepo.On("ClaimDataExport", ctx, mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("GetUserSessions", ctx, user.ID).Return(sessions, nil)
+	mockRepo.On("GetLoginHistory", ctx, user.ID, dataExportLoginLimit).Return(history, nil)
+	mockRepo.On("CompleteDataExport", ctx, mock.AnythingOfType("*entity.DataExport")).
+		Run(func(args mock.Arguments) { completed = args.Get(1).(*entity.DataExport) }).
+		Return(nil)
+
+	// Execute test
+	err = useCase.buildPendingExports(ctx)
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, completed)
+	require.WithinDuration(t, time.Now().Add(dataExportTTL), *completed.ExpiresAt, time.Minute)
+
+	archive := storage.files[completed.FileName]
+	require.NotEmpty(t, archive)
+	require.Equal(t, int64(len(archive)), completed.SizeBytes)
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	contents := map[string]string{}
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		require.True(t, json.Valid(data), file.Name)
+		contents[file.Name] = string(data)
+	}
+	require.Len(t, contents, 5)
+	require.Contains(t, contents["export.json"], export.ID.String())
+	require.Contains(t, contents["profile.json"], "test@example.com")
+	require.NotContains(t, contents["profile.json"], hashedPassword)
+	require.Contains(t, contents["sessions.json"], sessions[0].SessionID.String())
+	require.NotContains(t, contents["sessions.json"], "refresh-token")
+	require.NotContains(t, contents["sessions.json"], "123456")
+	require.Contains(t, contents["login_history.json"], "203.0.113.7")
+	require.Contains(t, contents["properties.json"], "Two bedroom flat")
+
+	// The emailed link carries the token whose hash was stored
+	sent := mockMailer.Last()
+	require.NotNil(t, sent)
+	require.Equal(t, "test@example.com", sent.To)
+	match := regexp.MustCompile(`data-export/download\?id=([^&\s]+)&token=([A-Za-z0-9_-]+)`).FindStringSubmatch(sent.TextBody)
+	require.Len(t, match, 3)
+	require.Equal(t, export.ID.String(), match[1])
+	token, err := url.QueryUnescape(match[2])
+	require.NoError(t, err)
+	require.Equal(t, completed.DownloadTokenHash, utils.HashToken(token))
+}
+
+func TestBuildPendingExportsSourceFails(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockMailer := mailer.NewMemoryMailer()
+	storage := newMemoryExportStorage()
+	source := &fakeExportSource{err: errors.New("property service unavailable")}
+
+	useCase := NewDataExportUsecase(mockRepo, mockMailer, storage, source).(*dataExportUsecase)
+	ctx := context.Background()
+
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com"}
+	export := &entity.DataExport{ID: uuid.New(), UserID: user.ID, Status: entity.DataExportBuilding}
+
+	// Mock behavior
+	mockRepo.On("ClaimDataExport", ctx, mock.AnythingOfType("time.Time")).Return(export, nil).Once()
+	mockRepo.On("ClaimDataExport", ctx, mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("GetUserSessions", ctx, user.ID).Return([]*entity.Session{}, nil)
+	mockRepo.On("GetLoginHistory", ctx, user.ID, dataExportLoginLimit).Return([]*entity.LoginHistoryEntry{}, nil)
+	mockRepo.On("FailDataExport", ctx, export.ID, dataExportFailedReason, mock.AnythingOfType("time.Time")).Return(nil)
+
+	// Execute test
+	err := useCase.buildPendingExports(ctx)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Empty(t, storage.files)
+	require.Nil(t, mockMailer.Last())
+	mockRepo.AssertNotCalled(t, "CompleteDataExport", mock.Anything, mock.Anything)
+}
+
+func TestOpenDataExport(t *testing.T) {
+	token := "download-token"
+	expiresAt := time.Now().Add(time.Hour)
+	expiredAt := time.Now().Add(-time.Minute)
+
+	testCases := []struct {
+		name     string
+		token    string
+		export   *entity.DataExport
+		expected error
+	}{
+		{
+			name:   "Valid",
+			token:  token,
+			export: &entity.DataExport{Status: entity.DataExportReady, DownloadTokenHash: utils.HashToken(token), ExpiresAt: &expiresAt},
+		},
+		{
+			name:     "WrongToken",
+			token:    "other-token",
+			export:   &entity.DataExport{Status: entity.DataExportReady, DownloadTokenHash: utils.HashToken(token), ExpiresAt: &expiresAt},
+			expected: entity.ErrDataExportLinkInvalid,
+		},
+		{
+			name:     "Expired",
+			token:    token,
+			export:   &entity.DataExport{Status: entity.DataExportReady, DownloadTokenHash: utils.HashToken(token), ExpiresAt: &expiredAt},
+			expected: entity.ErrDataExportLinkInvalid,
+		},
+		{
+			name:     "NotReady",
+			token:    token,
+			export:   &entity.DataExport{Status: entity.DataExportBuilding},
+			expected: entity.ErrDataExportLinkInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+			storage := newMemoryExportStorage()
+
+			useCase := NewDataExportUsecase(mockRepo, mailer.NewMemoryMailer(), storage)
+			ctx := context.Background()
+
+			tc.export.ID = uuid.New()
+			tc.export.UserID = uuid.New()
+			tc.export.FileName = tc.export.ID.String() + ".zip"
+			storage.files[tc.export.FileName] = []byte("zip")
+
+			// Mock behavior
+			mockRepo.On("GetDataExport", ctx, tc.export.ID).Return(tc.export, nil)
+			mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+
+			// Execute test
+			_, file, err := useCase.OpenDataExport(ctx, tc.export.ID.String(), tc.token)
+
+			// Assertions
+			if tc.expected != nil {
+				require.ErrorIs(t, err, tc.expected)
+				mockRepo.AssertNotCalled(t, "CreateAuthEvent", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			defer file.Close()
+			data, err := io.ReadAll(file)
+			require.NoError(t, err)
+			require.Equal(t, "zip", string(data))
+			mockRepo.AssertCalled(t, "CreateAuthEvent", ctx, mock.MatchedBy(func(event *entity.AuthEvent) bool {
+				return event.EventType == entity.AuthEventDataExportDownloaded
+			}))
+		})
+	}
+}
+
+func TestPruneExpiredExports(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	storage := newMemoryExportStorage()
+
+	useCase := NewDataExportUsecase(mockRepo, mailer.NewMemoryMailer(), storage).(*dataExportUsecase)
+	ctx := context.Background()
+
+	expired := &entity.DataExport{ID: uuid.New(), Status: entity.DataExportReady, FileName: "expired.zip"}
+	failed := &entity.DataExport{ID: uuid.New(), Status: entity.DataExportFailed}
+	storage.files["expired.zip"] = []byte("zip")
+	storage.files["current.zip"] = []byte("zip")
+
+	// Mock behavior
+	mockRepo.On("DeleteExpiredDataExports", ctx, mock.AnythingOfType("time.Time")).
+		Return([]*entity.DataExport{expired, failed}, nil)
+
+	// Execute test
+	err := useCase.pruneExpiredExports(ctx)
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotContains(t, storage.files, "expired.zip")
+	require.Contains(t, storage.files, "current.zip")
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/authentication/pkg/val"
+
+	"github.com/google/uuid"
+)
+
+const (
+	emailChangeRevertTTL        = 7 * 24 * time.Hour
+	emailChangeRevertTokenBytes = 32
+)
+
+// RequestEmailChange starts moving a user to newEmail by emailing a
+// verification code to that address. The email only changes once the code is
+// confirmed, and requesting again replaces the pending change.
+func (u *userUsecase) RequestEmailChange(ctx context.Context, userID string, newEmail string) error {
+	newEmail = strings.TrimSpace(newEmail)
+	if err := val.ValidateEmail(newEmail); err != nil {
+		return err
+	}
+
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(user.Email, newEmail) {
+		return entity.ErrEmailUnchanged
+	}
+
+	if err := u.checkEmailAvailable(ctx, newEmail); err != nil {
+		return err
+	}
+
+	change := &entity.EmailChange{
+		ID:               uuid.New(),
+		UserID:           user.ID,
+		OldEmail:         user.Email,
+		NewEmail:         newEmail,
+		OldEmailVerified: user.EmailVerified,
+		RequestedIP:      clientIP(ctx),
+	}
+
+	if err := u.userRepo.CreateEmailChange(ctx, change); err != nil {
+		return err
+	}
+
+	code, err := u.issueVerificationCode(ctx, user.ID, entity.VerificationPurposeEmailChange)
+	if err != nil {
+		return err
+	}
+
+	return u.sendEmailChangeCode(ctx, user, newEmail, code, verificationCodeTTL)
+}
+
+// ConfirmEmailChange switches the user to the address of their pending email
+// change once the code sent there is entered. The new address counts as
+// verified, and the old address is told about the change with a link that
+// reverts it for emailChangeRevertTTL.
+func (u *userUsecase) ConfirmEmailChange(ctx context.Context, userID string, code string) (*entity.User, error) {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := u.userRepo.GetPendingEmailChange(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.checkVerificationCode(ctx, user.ID, entity.VerificationPurposeEmailChange, code); err != nil {
+		return nil, err
+	}
+
+	// The address may have been claimed since the change was requested; the
+	// unique constraint still catches a race between this check and the update
+	if err := u.checkEmailAvailable(ctx, change.NewEmail); err != nil {
+		return nil, err
+	}
+
+	token, err := utils.GenerateURLSafeToken(emailChangeRevertTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	revertExpiresAt := time.Now().Add(emailChangeRevertTTL).UTC()
+	change.RevertTokenHash = utils.HashToken(token)
+	change.RevertExpiresAt = &revertExpiresAt
+
+	if err := u.userRepo.ConfirmEmailChange(ctx, change); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.userRepo.ConsumeVerificationChallenge(ctx, user.ID, entity.VerificationPurposeEmailChange); err != nil {
+		return nil, err
+	}
+
+	u.notifyEmailChanged(ctx, user, change.NewEmail, token, emailChangeRevertTTL)
+
+	user.Email = change.NewEmail
+	user.EmailVerified = true
+
+	return user, nil
+}
+
+// RevertEmailChange undoes a confirmed email change using the link sent to the
+// old address. Since the change may not have been made by the account owner,
+// every session is signed out as well.
+func (u *userUsecase) RevertEmailChange(ctx context.Context, token string) error {
+	change, err := u.userRepo.GetEmailChangeByRevertToken(ctx, utils.HashToken(token))
+	if err != nil {
+		return err
+	}
+
+	if change.RevertedAt != nil || change.RevertExpiresAt == nil || time.Now().After(*change.RevertExpiresAt) {
+		return entity.ErrEmailChangeRevertInvalid
+	}
+
+	if err := u.userRepo.RevertEmailChange(ctx, change); err != nil {
+		return err
+	}
+
+	if err := u.userRepo.RevokeAllSessions(ctx, change.UserID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return u.userRepo.RevokeUserSessionTokens(ctx, change.UserID, entity.SessionRevokedEmailRevert)
+}
+
+// checkEmailAvailable returns entity.ErrEmailTaken if an account already uses email.
+func (u *userUsecase) checkEmailAvailable(ctx context.Context, email string) error {
+	existingUser, err := u.userRepo.GetUserByEmail(ctx, email)
+	if err == nil && existingUser != nil {
+		return entity.ErrEmailTaken
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestEmailChange(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mockMailer, new(MockMessageQueue))
+	ctx := context.Background()
+
+	mockUser := &entity.User{ID: uuid.New(), Email: "old@example.com", FullName: "Test User", EmailVerified: true}
+	var stored *entity.EmailChange
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("GetUserByEmail", ctx, "new@example.com").Return(nil, errors.New("user not found"))
+	mockRepo.On("CreateEmailChange", ctx, mock.AnythingOfType("*entity.EmailChange")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*entity.EmailChange) }).
+		Return(nil)
+	challenge := captureVerificationChallenge(mockRepo, ctx)
+
+	// Execute test
+	err := useCase.RequestEmailChange(ctx, mockUser.ID.String(), "new@example.com")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, "old@example.com", stored.OldEmail)
+	require.Equal(t, "new@example.com", stored.NewEmail)
+	require.True(t, stored.OldEmailVerified)
+	require.Equal(t, entity.VerificationPurposeEmailChange, challenge.Purpose)
+
+	// The code goes to the new address, never the old one
+	msg := mockMailer.Last()
+	require.Equal(t, "new@example.com", msg.To)
+	require.Equal(t, utils.HashOTP(sentOtp(t, msg), challenge.Salt), challenge.CodeHash)
+}
+
+func TestRequestEmailChangeRejectsTakenEmail(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mockMailer, new(MockMessageQueue))
+	ctx := context.Background()
+
+	mockUser := &entity.User{ID: uuid.New(), Email: "old@example.com"}
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("GetUserByEmail", ctx, "taken@example.com").Return(&entity.User{ID: uuid.New()}, nil)
+
+	// Execute test
+	err := useCase.RequestEmailChange(ctx, mockUser.ID.String(), "taken@example.com")
+	require.ErrorIs(t, err, entity.ErrEmailTaken)
+
+	err = useCase.RequestEmailChange(ctx, mockUser.ID.String(), "OLD@example.com")
+	require.ErrorIs(t, err, entity.ErrEmailUnchanged)
+
+	// Assertions
+	require.Nil(t, mockMailer.Last())
+	mockRepo.AssertNotCalled(t, "CreateEmailChange", mock.Anything, mock.Anything)
+}
+
+func TestConfirmEmailChange(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mockMailer, new(MockMessageQueue))
+	ctx := context.Background()
+
+	mockUser := &entity.User{ID: uuid.New(), Email: "old@example.com", FullName: "Test User"}
+	change := &entity.EmailChange{ID: uuid.New(), UserID: mockUser.ID, OldEmail: mockUser.Email, NewEmail: "new@example.com"}
+	challenge := newVerificationChallenge(mockUser.ID, entity.VerificationPurposeEmailChange, "123456")
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("GetPendingEmailChange", ctx, mockUser.ID).Return(change, nil)
+	mockRepo.On("GetActiveVerificationChallenge", ctx, mockUser.ID, entity.VerificationPurposeEmailChange).Return(challenge, nil)
+	mockRepo.On("MarkVerificationChallengeVerified", ctx, challenge.ID).Return(true, nil)
+	mockRepo.On("GetUserByEmail", ctx, "new@example.com").Return(nil, errors.New("user not found"))
+	mockRepo.On("ConfirmEmailChange", ctx, change).Return(nil)
+	mockRepo.On("ConsumeVerificationChallenge", ctx, mockUser.ID, entity.VerificationPurposeEmailChange).Return(true, nil)
+
+	// Execute test
+	user, err := useCase.ConfirmEmailChange(ctx, mockUser.ID.String(), "123456")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, "new@example.com", user.Email)
+	require.True(t, user.EmailVerified)
+	require.NotNil(t, change.RevertExpiresAt)
+	require.WithinDuration(t, time.Now().Add(emailChangeRevertTTL), *change.RevertExpiresAt, time.Second)
+
+	// The old address is told, with a link whose token is only stored hashed
+	msg := mockMailer.Last()
+	require.Equal(t, "old@example.com", msg.To)
+	match := regexp.MustCompile(`token=([A-Za-z0-9_-]+)`).FindStringSubmatch(msg.TextBody)
+	require.Len(t, match, 2)
+	token, err := url.QueryUnescape(match[1])
+	require.NoError(t, err)
+	require.Equal(t, utils.HashToken(token), change.RevertTokenHash)
+}
+
+func TestConfirmEmailChangeWrongCode(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mockMailer, new(MockMessageQueue))
+	ctx := context.Background()
+
+	mockUser := &entity.User{ID: uuid.New(), Email: "old@example.com"}
+	change := &entity.EmailChange{ID: uuid.New(), UserID: mockUser.ID, OldEmail: mockUser.Email, NewEmail: "new@example.com"}
+	challenge := newVerificationChallenge(mockUser.ID, entity.VerificationPurposeEmailChange, "123456")
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("GetPendingEmailChange", ctx, mockUser.ID).Return(change, nil)
+	mockRepo.On("GetActiveVerificationChallenge", ctx, mockUser.ID, entity.VerificationPurposeEmailChange).Return(challenge, nil)
+	mockRepo.On("IncrementVerificationChallengeAttempts", ctx, challenge.ID).Return(1, nil)
+
+	// Execute test
+	_, err := useCase.ConfirmEmailChange(ctx, mockUser.ID.String(), "654321")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrVerificationCodeInvalid)
+	require.Nil(t, mockMailer.Last())
+	mockRepo.AssertNotCalled(t, "ConfirmEmailChange", mock.Anything, mock.Anything)
+}
+
+func TestRevertEmailChange(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(time.Hour)
+	change := &entity.EmailChange{
+		ID:               uuid.New(),
+		UserID:           uuid.New(),
+		OldEmail:         "old@example.com",
+		NewEmail:         "new@example.com",
+		OldEmailVerified: true,
+		RevertExpiresAt:  &expiresAt,
+	}
+
+	// Mock behavior
+	mockRepo.On("GetEmailChangeByRevertToken", ctx, utils.HashToken("revert-token")).Return(change, nil)
+	mockRepo.On("RevertEmailChange", ctx, change).Return(nil)
+	mockRepo.On("RevokeAllSessions", ctx, change.UserID).Return(nil)
+	mockRepo.On("RevokeUserSessionTokens", ctx, change.UserID, entity.SessionRevokedEmailRevert).Return(nil)
+
+	// Execute test
+	err := useCase.RevertEmailChange(ctx, "revert-token")
+
+	// Assertions
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRevertEmailChangeExpired(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(-time.Minute)
+	change := &entity.EmailChange{ID: uuid.New(), UserID: uuid.New(), RevertExpiresAt: &expiresAt}
+
+	// Mock behavior
+	mockRepo.On("GetEmailChangeByRevertToken", ctx, utils.HashToken("revert-token")).Return(change, nil)
+
+	// Execute test
+	err := useCase.RevertEmailChange(ctx, "revert-token")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrEmailChangeRevertInvalid)
+	mockRepo.AssertNotCalled(t, "RevertEmailChange", mock.Anything, mock.Anything)
+}
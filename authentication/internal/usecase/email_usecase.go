@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// sendVerificationEmail emails the account verification OTP. Failing to deliver
+// it is an error since the user cannot finish registering without the code.
+func (u *userUsecase) sendVerificationEmail(ctx context.Context, user *entity.User, otp string, expiresIn time.Duration) error {
+	err := u.mailer.Send(ctx, &entity.Email{
+		To:       user.Email,
+		Template: entity.EmailTemplateVerification,
+		Data: map[string]any{
+			"Name":      user.FullName,
+			"Code":      otp,
+			"ExpiresIn": expiresIn.String(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+	return nil
+}
+
+// sendPasswordResetEmail emails the password reset OTP.
+func (u *userUsecase) sendPasswordResetEmail(ctx context.Context, user *entity.User, otp string, expiresIn time.Duration) error {
+	metaData := utils.ExtractMetaData(ctx)
+
+	err := u.mailer.Send(ctx, &entity.Email{
+		To:       user.Email,
+		Template: entity.EmailTemplatePasswordReset,
+		Data: map[string]any{
+			"Name":      user.FullName,
+			"Code":      otp,
+			"ExpiresIn": expiresIn.String(),
+			"IPAddress": metaData.ClientIP,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send password reset email: %w", err)
+	}
+	return nil
+}
+
+// sendMagicLinkEmail emails a sign-in link carrying the magic link token.
+func (u *userUsecase) sendMagicLinkEmail(ctx context.Context, user *entity.User, token string, expiresIn time.Duration) error {
+	metaData := utils.ExtractMetaData(ctx)
+
+	err := u.mailer.Send(ctx, &entity.Email{
+		To:       user.Email,
+		Template: entity.EmailTemplateMagicLink,
+		Data: map[string]any{
+			"Name":      user.FullName,
+			"Token":     token,
+			"ExpiresIn": expiresIn.String(),
+			"IPAddress": metaData.ClientIP,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send magic link email: %w", err)
+	}
+	return nil
+}
+
+// sendEmailChangeCode emails the code that confirms an email change to the new address.
+func (u *userUsecase) sendEmailChangeCode(ctx context.Context, user *entity.User, newEmail string, otp string, expiresIn time.Duration) error {
+	metaData := utils.ExtractMetaData(ctx)
+
+	err := u.mailer.Send(ctx, &entity.Email{
+		To:       newEmail,
+		Template: entity.EmailTemplateEmailChangeCode,
+		Data: map[string]any{
+			"Name":      user.FullName,
+			"Code":      otp,
+			"ExpiresIn": expiresIn.String(),
+			"IPAddress": metaData.ClientIP,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send email change code: %w", err)
+	}
+	return nil
+}
+
+// notifyNewLogin tells the user their account was signed in to from an
+// unfamiliar device or location. It is best effort: a delivery failure is
+// logged and never fails the login.
+func (u *userUsecase) notifyNewLogin(ctx context.Context, user *entity.User, login *entity.AuthEvent) {
+	u.notify(ctx, &entity.Email{
+		To:       user.Email,
+		Template: entity.EmailTemplateNewLogin,
+		Data: map[string]any{
+			"Name":      user.FullName,
+			"Time":      time.Now().UTC().Format(time.RFC1123),
+			"IPAddress": login.IPAddress,
+			"UserAgent": login.UserAgent,
+			"Device":    login.Device,
+			"Location":  login.Location,
+		},
+	})
+}
+
+// notifyAccountDeletion confirms to the user that their account will be
+// deleted at scheduledFor unless they sign in before then.
+func (u *userUsecase) notifyAccountDeletion(ctx context.Context, user *entity.User, scheduledFor time.Time) {
+	metaData := utils.ExtractMetaData(ctx)
+
+	u.notify(ctx, &entity.Email{
+		To:       user.Email,
+		Template: entity.EmailTemplateAccountDeletion,
+		Data: map[string]any{
+			"Name":         user.FullName,
+			"Time":         time.Now().UTC().Format(time.RFC1123),
+			"IPAddress":    metaData.ClientIP,
+			"DeletionDate": scheduledFor.UTC().Format(time.RFC1123),
+		},
+	})
+}
+
+// notifyEmailChanged tells the old address that the account moved to a new
+// email, with a link that reverts the change in case the user did not make it.
+func (u *userUsecase) notifyEmailChanged(ctx context.Context, user *entity.User, newEmail string, revertToken string, revertWindow time.Duration) {
+	metaData := utils.ExtractMetaData(ctx)
+
+	u.notify(ctx, &entity.Email{
+		To:       user.Email,
+		Template: entity.EmailTemplateEmailChanged,
+		Data: map[string]any{
+			"Name":      user.FullName,
+			"NewEmail":  newEmail,
+			"Token":     revertToken,
+			"ExpiresIn": revertWindow.String(),
+			"Time":      time.Now().UTC().Format(time.RFC1123),
+			"IPAddress": metaData.ClientIP,
+		},
+	})
+}
+
+// notifyDataExportReady sends the user the link to download their data
+// export, which works for expiresIn.
+func (u *userUsecase) notifyDataExportReady(ctx context.Context, user *entity.User, exportID uuid.UUID, token string, expiresIn time.Duration) {
+	u.notify(ctx, &entity.Email{
+		To:       user.Email,
+		Template: entity.EmailTemplateDataExport,
+		Data: map[string]any{
+			"Name":      user.FullName,
+			"ExportID":  exportID.String(),
+			"Token":     token,
+			"ExpiresIn": expiresIn.String(),
+		},
+	})
+}
+
+// notifyOrganizationInvitation sends the invited address the link to accept
+// or decline joining an organization, which works for expiresIn.
+func (u *userUsecase) notifyOrganizationInvitation(ctx context.Context, inviterName string, invitation *entity.OrganizationInvitation, token string, expiresIn time.Duration) {
+	u.notify(ctx, &entity.Email{
+		To:       invitation.Email,
+		Template: entity.EmailTemplateOrgInvitation,
+		Data: map[string]any{
+			"InviterName":      inviterName,
+			"OrganizationName": invitation.OrganizationName,
+			"Role":             invitation.Role,
+			"Token":            token,
+			"ExpiresIn":        expiresIn.String(),
+		},
+	})
+}
+
+func (u *userUsecase) notify(ctx context.Context, email *entity.Email) {
+	if err := u.mailer.Send(ctx, email); err != nil {
+		log.Printf("failed to send %s email to %s: %v", email.Template, email.To, err)
+	}
+}
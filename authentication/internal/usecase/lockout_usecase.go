@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// accountMaxFailures is how many consecutive failures an account tolerates before it is locked
+	accountMaxFailures = 5
+	// ipMaxFailures is how many consecutive failures a single IP tolerates across all accounts
+	ipMaxFailures = 20
+	// lockoutBaseDuration is the first lockout; every further lockout in the window doubles it
+	lockoutBaseDuration = time.Minute
+	lockoutMaxDuration  = time.Hour
+	// failureWindow is how long failures are remembered after the last one
+	failureWindow = 24 * time.Hour
+
+	accountLockedEventKey = "account_locked"
+)
+
+// throttleRule pairs a failure counter with the number of failures it allows
+type throttleRule struct {
+	key         entity.ThrottleKey
+	maxFailures int
+}
+
+// throttleRules returns the per-account and per-IP counters guarding an endpoint
+func throttleRules(ctx context.Context, scope string, email string) []throttleRule {
+	rules := []throttleRule{{
+		key:         entity.ThrottleKey{Scope: scope, SubjectType: entity.ThrottleSubjectAccount, Subject: strings.ToLower(email)},
+		maxFailures: accountMaxFailures,
+	}}
+
+	if ip := clientIP(ctx); ip != "" {
+		rules = append(rules, throttleRule{
+			key:         entity.ThrottleKey{Scope: scope, SubjectType: entity.ThrottleSubjectIP, Subject: ip},
+			maxFailures: ipMaxFailures,
+		})
+	}
+
+	return rules
+}
+
+// checkLockout returns a LockoutError if any of the counters is currently locked
+func (u *userUsecase) checkLockout(ctx context.Context, rules []throttleRule) error {
+	now := time.Now()
+	for _, rule := range rules {
+		throttle, err := u.userRepo.GetAuthThrottle(ctx, rule.key)
+		if err != nil {
+			return err
+		}
+		if throttle != nil && throttle.IsLocked(now) {
+			return &entity.LockoutError{Until: *throttle.LockedUntil}
+		}
+	}
+	return nil
+}
+
+// recordFailure counts a failed attempt against every counter and locks those
+// that reach their limit. It returns a LockoutError when a lockout was applied.
+func (u *userUsecase) recordFailure(ctx context.Context, rules []throttleRule, userID *uuid.UUID) error {
+	var lockErr *entity.LockoutError
+
+	for _, rule := range rules {
+		throttle, err := u.userRepo.RecordAuthFailure(ctx, rule.key, time.Now().Add(-failureWindow))
+		if err != nil {
+			return err
+		}
+
+		if throttle.FailedAttempts < rule.maxFailures {
+			continue
+		}
+
+		until := time.Now().Add(lockoutDuration(throttle.LockoutCount)).UTC()
+		locked, err := u.userRepo.LockAuthThrottle(ctx, rule.key, until)
+		if err != nil {
+			return err
+		}
+
+		u.publishAccountLocked(ctx, throttle, locked, userID)
+
+		if lockErr == nil || until.After(lockErr.Until) {
+			lockErr = &entity.LockoutError{Until: until}
+		}
+	}
+
+	if lockErr != nil {
+		return lockErr
+	}
+	return nil
+}
+
+// clearFailures resets the account counter after a successful attempt. The IP
+// counter is left alone so an attacker cannot reset it with their own account.
+func (u *userUsecase) clearFailures(ctx context.Context, rules []throttleRule) error {
+	for _, rule := range rules {
+		if rule.key.SubjectType != entity.ThrottleSubjectAccount {
+			continue
+		}
+		if err := u.userRepo.ResetAuthThrottle(ctx, rule.key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnlockAccount lifts every lockout held against a user's account.
+func (u *userUsecase) UnlockAccount(ctx context.Context, userID string) error {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
+	return u.userRepo.UnlockAccount(ctx, strings.ToLower(user.Email))
+}
+
+// lockoutDuration doubles the base lockout for every earlier lockout in the window
+func lockoutDuration(previousLockouts int) time.Duration {
+	duration := lockoutBaseDuration
+	for i := 0; i < previousLockouts && duration < lockoutMaxDuration; i++ {
+		duration *= 2
+	}
+	if duration > lockoutMaxDuration {
+		duration = lockoutMaxDuration
+	}
+	return duration
+}
+
+func (u *userUsecase) publishAccountLocked(ctx context.Context, failure *entity.AuthThrottle, locked *entity.AuthThrottle, userID *uuid.UUID) {
+	event := entity.AccountLockedEvent{
+		Scope:          locked.Scope,
+		SubjectType:    locked.SubjectType,
+		Subject:        locked.Subject,
+		FailedAttempts: failure.FailedAttempts,
+		LockoutCount:   locked.LockoutCount,
+		LockedUntil:    *locked.LockedUntil,
+		IPAddress:      clientIP(ctx),
+		OccurredAt:     time.Now().UTC(),
+	}
+	if locked.SubjectType == entity.ThrottleSubjectAccount {
+		event.UserID = userID
+	}
+
+	eventData, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal %s event: %v", accountLockedEventKey, err)
+		return
+	}
+
+	if err := u.messageQueue.PublishMessage(ctx, []byte(accountLockedEventKey), eventData); err != nil {
+		log.Printf("failed to publish %s event: %v", accountLockedEventKey, err)
+	}
+}
+
+// clientIP returns the caller's IP without a port
+func clientIP(ctx context.Context) string {
+	ip := utils.ExtractMetaData(ctx).ClientIP
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return ip
+}
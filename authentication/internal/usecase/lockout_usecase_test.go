@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// gatewayContext returns the context handlers receive for a call the gateway
+// signed and sent with md
+func gatewayContext(t *testing.T, md metadata.MD) context.Context {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	credentials, err := middleware.NewServiceCredentials(middleware.GatewayService, token.EncodeSecretKey(privateKey))
+	require.NoError(t, err)
+	authenticator, err := middleware.NewServiceAuthenticator([]string{middleware.GatewayService + "=" + token.EncodePublicKey(publicKey)}, nil, nil, nil)
+	require.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.AuthService/Login"}
+	var sent metadata.MD
+	err = credentials.UnaryClientInterceptor()(metadata.NewOutgoingContext(context.Background(), md), info.FullMethod, nil, nil, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			sent, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		})
+	require.NoError(t, err)
+
+	var ctx context.Context
+	_, err = authenticator.UnaryServerInterceptor()(metadata.NewIncomingContext(context.Background(), sent), nil, info,
+		func(handlerCtx context.Context, req interface{}) (interface{}, error) {
+			ctx = handlerCtx
+			return nil, nil
+		})
+	require.NoError(t, err)
+	return ctx
+}
+
+func TestLoginUserRejectedWhileLockedOut(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "test@example.com"
+	lockedUntil := time.Now().Add(10 * time.Minute)
+	key := entity.ThrottleKey{Scope: entity.ThrottleScopeLogin, SubjectType: entity.ThrottleSubjectAccount, Subject: email}
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetAuthThrottle", ctx, key).Return(&entity.AuthThrottle{ThrottleKey: key, LockedUntil: &lockedUntil}, nil)
+
+	// Execute test
+	_, _, _, err := useCase.LoginUser(ctx, "password123", email)
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrTooManyAttempts)
+
+	var lockErr *entity.LockoutError
+	require.ErrorAs(t, err, &lockErr)
+	require.WithinDuration(t, lockedUntil, lockErr.Until, time.Second)
+	mockRepo.AssertNotCalled(t, "GetUserByEmail", mock.Anything, mock.Anything)
+}
+
+func TestLoginUserWrongPasswordBelowThreshold(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), mockQueue)
+	ctx := context.Background()
+
+	email := "test@example.com"
+	hashedPassword, _ := utils.HashPassword("password123")
+	mockUser := &entity.User{ID: uuid.New(), Email: email, Password: hashedPassword}
+	key := entity.ThrottleKey{Scope: entity.ThrottleScopeLogin, SubjectType: entity.ThrottleSubjectAccount, Subject: email}
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetAuthThrottle", ctx, key).Return(nil, nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(mockUser, nil)
+	mockRepo.On("RecordAuthFailure", ctx, key, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: key, FailedAttempts: accountMaxFailures - 1}, nil)
+
+	// Execute test
+	_, _, _, err := useCase.LoginUser(ctx, "wrong-password", email)
+
+	// Assertions
+	require.Error(t, err)
+	require.NotErrorIs(t, err, entity.ErrTooManyAttempts)
+	mockRepo.AssertNotCalled(t, "LockAuthThrottle", mock.Anything, mock.Anything, mock.Anything)
+	mockQueue.AssertNotCalled(t, "PublishMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLoginUserLocksAccountAtThreshold(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), mockQueue)
+	ctx := gatewayContext(t, metadata.Pairs("x-forwarded-for", "203.0.113.7"))
+
+	email := "test@example.com"
+	hashedPassword, _ := utils.HashPassword("password123")
+	mockUser := &entity.User{ID: uuid.New(), Email: email, Password: hashedPassword}
+	accountKey := entity.ThrottleKey{Scope: entity.ThrottleScopeLogin, SubjectType: entity.ThrottleSubjectAccount, Subject: email}
+	ipKey := entity.ThrottleKey{Scope: entity.ThrottleScopeLogin, SubjectType: entity.ThrottleSubjectIP, Subject: "203.0.113.7"}
+
+	var published entity.AccountLockedEvent
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(mockUser, nil)
+	mockRepo.On("RecordAuthFailure", ctx, accountKey, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: accountKey, FailedAttempts: accountMaxFailures, LockoutCount: 1}, nil)
+	mockRepo.On("RecordAuthFailure", ctx, ipKey, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: ipKey, FailedAttempts: 1}, nil)
+	lockedUntil := time.Now().Add(2 * lockoutBaseDuration)
+	mockRepo.On("LockAuthThrottle", ctx, accountKey, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: accountKey, LockoutCount: 2, LockedUntil: &lockedUntil}, nil)
+	mockQueue.On("PublishMessage", ctx, []byte(accountLockedEventKey), mock.Anything).
+		Run(func(args mock.Arguments) { _ = json.Unmarshal(args.Get(2).([]byte), &published) }).
+		Return(nil)
+
+	// Execute test
+	_, _, _, err := useCase.LoginUser(ctx, "wrong-password", email)
+
+	// Assertions
+	var lockErr *entity.LockoutError
+	require.ErrorAs(t, err, &lockErr)
+	require.WithinDuration(t, time.Now().Add(2*lockoutBaseDuration), lockErr.Until, time.Second)
+
+	mockQueue.AssertExpectations(t)
+	require.Equal(t, entity.ThrottleSubjectAccount, published.SubjectType)
+	require.Equal(t, email, published.Subject)
+	require.Equal(t, &mockUser.ID, published.UserID)
+	require.Equal(t, "203.0.113.7", published.IPAddress)
+}
+
+func TestLockoutDuration(t *testing.T) {
+	require.Equal(t, lockoutBaseDuration, lockoutDuration(0))
+	require.Equal(t, 2*lockoutBaseDuration, lockoutDuration(1))
+	require.Equal(t, 8*lockoutBaseDuration, lockoutDuration(3))
+	require.Equal(t, lockoutMaxDuration, lockoutDuration(20))
+}
+
+func TestClientIP(t *testing.T) {
+	peerCtx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.4"), Port: 51234}})
+
+	t.Run("gateway reports the client", func(t *testing.T) {
+		ctx := gatewayContext(t, metadata.Pairs("x-forwarded-for", "10.0.0.1, 203.0.113.7"))
+		require.Equal(t, "203.0.113.7", clientIP(ctx))
+	})
+
+	t.Run("forwarded for without the gateway is ignored", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(peerCtx, metadata.Pairs("x-forwarded-for", "203.0.113.7"))
+		require.Equal(t, "198.51.100.4", clientIP(ctx))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		require.Equal(t, "", clientIP(context.Background()))
+	})
+}
+
+func TestUnlockAccount(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userID := uuid.New()
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, userID.String()).Return(&entity.User{ID: userID, Email: "Test@Example.com"}, nil)
+	mockRepo.On("UnlockAccount", ctx, "test@example.com").Return(nil)
+
+	// Execute test
+	err := useCase.UnlockAccount(ctx, userID.String())
+
+	// Assertions
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	magicLinkTTL        = 15 * time.Minute
+	magicLinkTokenBytes = 32
+
+	magicLinkRequestedEventKey = "magic_link_requested"
+	magicLinkUsedEventKey      = "magic_link_used"
+	magicLinkReplayedEventKey  = "magic_link_replayed"
+)
+
+// RequestMagicLink emails the user a single-use link that signs them in
+// without a password. Unknown and inactive accounts are ignored without an
+// error so the endpoint cannot be used to find out which emails are registered.
+// Every request counts against the email and the caller's IP until a link is
+// used, so repeated requests are locked out like failed logins.
+func (u *userUsecase) RequestMagicLink(ctx context.Context, email string) error {
+	rules := throttleRules(ctx, entity.ThrottleScopeMagicLink, email)
+	if err := u.checkLockout(ctx, rules); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventMagicLinkRequested, nil, email, authFailureReason(err))
+		return err
+	}
+
+	// Unknown emails are counted too so the lockout behaves the same for every email
+	user, err := u.userRepo.GetUserByEmail(ctx, email)
+	var userID *uuid.UUID
+	if err == nil {
+		userID = &user.ID
+	}
+	if lockErr := u.recordFailure(ctx, rules, userID); lockErr != nil {
+		u.recordUserFailure(ctx, entity.AuthEventMagicLinkRequested, userID, email, authFailureReason(lockErr))
+		return lockErr
+	}
+
+	if err != nil || !user.IsActive {
+		return nil
+	}
+
+	token, err := utils.GenerateURLSafeToken(magicLinkTokenBytes)
+	if err != nil {
+		return err
+	}
+
+	link := &entity.MagicLink{
+		ID:                 uuid.New(),
+		UserID:             user.ID,
+		TokenHash:          utils.HashToken(token),
+		ExpiresAt:          time.Now().Add(magicLinkTTL).UTC(),
+		RequestedIP:        clientIP(ctx),
+		RequestedUserAgent: utils.ExtractMetaData(ctx).UserAgent,
+	}
+
+	if err := u.userRepo.CreateMagicLink(ctx, link); err != nil {
+		return err
+	}
+
+	if err := u.sendMagicLinkEmail(ctx, user, token, magicLinkTTL); err != nil {
+		return err
+	}
+
+	u.publishMagicLinkEvent(ctx, magicLinkRequestedEventKey, link)
+	u.recordUserSuccess(ctx, entity.AuthEventMagicLinkRequested, user.ID, user.Email, map[string]string{"magic_link_id": link.ID.String()})
+
+	return nil
+}
+
+// ConsumeMagicLink exchanges a magic link token for a session. Each link works
+// once and only until it expires; presenting a used link again is published as
+// a replay. Like LoginUser, users with MFA get a challenge instead of a session.
+func (u *userUsecase) ConsumeMagicLink(ctx context.Context, token string) (*entity.User, *entity.Session, *entity.MFAChallenge, error) {
+	link, err := u.userRepo.GetMagicLinkByHash(ctx, utils.HashToken(token))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if link.UsedAt != nil {
+		u.publishMagicLinkEvent(ctx, magicLinkReplayedEventKey, link)
+		u.recordMagicLinkFailure(ctx, link, entity.ErrMagicLinkUsed)
+		return nil, nil, nil, entity.ErrMagicLinkUsed
+	}
+
+	if time.Now().After(link.ExpiresAt) {
+		u.recordMagicLinkFailure(ctx, link, entity.ErrMagicLinkInvalid)
+		return nil, nil, nil, entity.ErrMagicLinkInvalid
+	}
+
+	// Marking the link used is what makes it single use when two requests race
+	if err := u.userRepo.MarkMagicLinkUsed(ctx, link.ID, clientIP(ctx), utils.ExtractMetaData(ctx).UserAgent); err != nil {
+		if errors.Is(err, entity.ErrMagicLinkUsed) {
+			u.publishMagicLinkEvent(ctx, magicLinkReplayedEventKey, link)
+			u.recordMagicLinkFailure(ctx, link, err)
+		}
+		return nil, nil, nil, err
+	}
+
+	u.publishMagicLinkEvent(ctx, magicLinkUsedEventKey, link)
+
+	user, err := u.userRepo.GetUserByID(ctx, link.UserID.String())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
+	if !user.IsActive {
+		u.recordUserFailure(ctx, entity.AuthEventMagicLinkUsed, &user.ID, user.Email, authFailureReason(entity.ErrMagicLinkInvalid))
+		return nil, nil, nil, entity.ErrMagicLinkInvalid
+	}
+
+	if err := user.CanSignIn(); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventMagicLinkUsed, &user.ID, user.Email, authFailureReason(err))
+		return nil, nil, nil, err
+	}
+
+	u.recordUserSuccess(ctx, entity.AuthEventMagicLinkUsed, user.ID, user.Email, map[string]string{"magic_link_id": link.ID.String()})
+
+	// Using a link shows the requests came from the account owner
+	if err := u.clearFailures(ctx, throttleRules(ctx, entity.ThrottleScopeMagicLink, user.Email)); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Require a second factor if the user has confirmed MFA
+	challenge, err := u.createMFAChallenge(ctx, user.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if challenge != nil {
+		return user, nil, challenge, nil
+	}
+
+	if err := u.cancelScheduledDeletion(ctx, user); err != nil {
+		return nil, nil, nil, err
+	}
+
+	session, err := u.createVerifiedSession(ctx, user)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	u.recordLogin(ctx, user, session, loginMethodMagicLink)
+
+	return user, session, nil, nil
+}
+
+// recordMagicLinkFailure records a link that was refused, such as one presented
+// again after it was used
+func (u *userUsecase) recordMagicLinkFailure(ctx context.Context, link *entity.MagicLink, err error) {
+	u.recordAuthEvent(ctx, &entity.AuthEvent{
+		EventType: entity.AuthEventMagicLinkUsed,
+		Outcome:   entity.AuthEventFailure,
+		UserID:    &link.UserID,
+		Metadata:  map[string]string{"failure_reason": authFailureReason(err), "magic_link_id": link.ID.String()},
+	})
+}
+
+// publishMagicLinkEvent records a magic link being requested, used or
+// replayed. It is best effort and never fails the request.
+func (u *userUsecase) publishMagicLinkEvent(ctx context.Context, eventKey string, link *entity.MagicLink) {
+	event := entity.MagicLinkEvent{
+		Type:        eventKey,
+		MagicLinkID: link.ID,
+		UserID:      link.UserID,
+		IPAddress:   clientIP(ctx),
+		UserAgent:   utils.ExtractMetaData(ctx).UserAgent,
+		OccurredAt:  time.Now().UTC(),
+	}
+
+	eventData, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal %s event: %v", eventKey, err)
+		return
+	}
+
+	if err := u.messageQueue.PublishMessage(ctx, []byte(eventKey), eventData); err != nil {
+		log.Printf("failed to publish %s event: %v", eventKey, err)
+	}
+}
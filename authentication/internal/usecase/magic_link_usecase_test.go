@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// captureAuthEvents records the audit events the usecase stores
+func captureAuthEvents(mockRepo *MockUserRepository, ctx context.Context) *[]*entity.AuthEvent {
+	events := &[]*entity.AuthEvent{}
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+		Run(func(args mock.Arguments) { *events = append(*events, args.Get(1).(*entity.AuthEvent)) }).
+		Return(nil)
+	return events
+}
+
+func TestRequestMagicLink(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+	mockMailer := mailer.NewMemoryMailer()
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mockMailer, mockQueue)
+	ctx := context.Background()
+
+	mockUser := &entity.User{ID: uuid.New(), Email: "test@example.com", FullName: "Test User", IsActive: true}
+	key := entity.ThrottleKey{Scope: entity.ThrottleScopeMagicLink, SubjectType: entity.ThrottleSubjectAccount, Subject: mockUser.Email}
+	var stored *entity.MagicLink
+
+	// Mock behavior
+	events := captureAuthEvents(mockRepo, ctx)
+	mockRepo.On("GetAuthThrottle", ctx, key).Return(nil, nil)
+	mockRepo.On("RecordAuthFailure", ctx, key, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: key, FailedAttempts: 1}, nil)
+	mockRepo.On("GetUserByEmail", ctx, mockUser.Email).Return(mockUser, nil)
+	mockRepo.On("CreateMagicLink", ctx, mock.AnythingOfType("*entity.MagicLink")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*entity.MagicLink) }).
+		Return(nil)
+	mockQueue.On("PublishMessage", ctx, []byte(magicLinkRequestedEventKey), mock.Anything).Return(nil)
+
+	// Execute test
+	err := useCase.RequestMagicLink(ctx, mockUser.Email)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, mockUser.ID, stored.UserID)
+	require.WithinDuration(t, time.Now().Add(magicLinkTTL), stored.ExpiresAt, time.Second)
+	mockQueue.AssertExpectations(t)
+
+	// Only the hash of the emailed token is stored
+	match := regexp.MustCompile(`token=([A-Za-z0-9_-]+)`).FindStringSubmatch(mockMailer.Last().TextBody)
+	require.Len(t, match, 2)
+	token, err := url.QueryUnescape(match[1])
+	require.NoError(t, err)
+	require.NotEqual(t, token, stored.TokenHash)
+	require.Equal(t, utils.HashToken(token), stored.TokenHash)
+	require.Equal(t, mockUser.Email, mockMailer.Last().To)
+
+	// The request is kept in the audit log
+	require.Len(t, *events, 1)
+	require.Equal(t, entity.AuthEventMagicLinkRequested, (*events)[0].EventType)
+	require.Equal(t, entity.AuthEventSuccess, (*events)[0].Outcome)
+	require.Equal(t, stored.ID.String(), (*events)[0].Metadata["magic_link_id"])
+}
+
+func TestRequestMagicLinkUnknownEmail(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mockMailer, new(MockMessageQueue))
+	ctx := context.Background()
+
+	key := entity.ThrottleKey{Scope: entity.ThrottleScopeMagicLink, SubjectType: entity.ThrottleSubjectAccount, Subject: "nobody@example.com"}
+
+	// Mock behavior
+	mockRepo.On("GetAuthThrottle", ctx, key).Return(nil, nil)
+	mockRepo.On("RecordAuthFailure", ctx, key, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: key, FailedAttempts: 1}, nil)
+	mockRepo.On("GetUserByEmail", ctx, "nobody@example.com").Return(nil, errors.New("user not found"))
+
+	// Execute test
+	err := useCase.RequestMagicLink(ctx, "nobody@example.com")
+
+	// Assertions: unknown emails count against the throttle like registered ones
+	require.NoError(t, err)
+	require.Nil(t, mockMailer.Last())
+	mockRepo.AssertCalled(t, "RecordAuthFailure", ctx, key, mock.AnythingOfType("time.Time"))
+	mockRepo.AssertNotCalled(t, "CreateMagicLink", mock.Anything, mock.Anything)
+}
+
+func TestRequestMagicLinkRejectedWhileLockedOut(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mockMailer, new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "test@example.com"
+	lockedUntil := time.Now().Add(10 * time.Minute)
+	key := entity.ThrottleKey{Scope: entity.ThrottleScopeMagicLink, SubjectType: entity.ThrottleSubjectAccount, Subject: email}
+
+	// Mock behavior
+	events := captureAuthEvents(mockRepo, ctx)
+	mockRepo.On("GetAuthThrottle", ctx, key).Return(&entity.AuthThrottle{ThrottleKey: key, LockedUntil: &lockedUntil}, nil)
+
+	// Execute test
+	err := useCase.RequestMagicLink(ctx, email)
+
+	// Assertions
+	var lockErr *entity.LockoutError
+	require.ErrorAs(t, err, &lockErr)
+	require.WithinDuration(t, lockedUntil, lockErr.Until, time.Second)
+	require.Nil(t, mockMailer.Last())
+	mockRepo.AssertNotCalled(t, "GetUserByEmail", mock.Anything, mock.Anything)
+
+	require.Len(t, *events, 1)
+	require.Equal(t, entity.AuthEventMagicLinkRequested, (*events)[0].EventType)
+	require.Equal(t, "locked_out", (*events)[0].Metadata["failure_reason"])
+}
+
+func TestRequestMagicLinkLocksAtThreshold(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockMailer := mailer.NewMemoryMailer()
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mockMailer, mockQueue)
+	ctx := context.Background()
+
+	mockUser := &entity.User{ID: uuid.New(), Email: "test@example.com", IsActive: true}
+	key := entity.ThrottleKey{Scope: entity.ThrottleScopeMagicLink, SubjectType: entity.ThrottleSubjectAccount, Subject: mockUser.Email}
+	lockedUntil := time.Now().Add(lockoutBaseDuration)
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetAuthThrottle", ctx, key).Return(nil, nil)
+	mockRepo.On("GetUserByEmail", ctx, mockUser.Email).Return(mockUser, nil)
+	mockRepo.On("RecordAuthFailure", ctx, key, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: key, FailedAttempts: accountMaxFailures}, nil)
+	mockRepo.On("LockAuthThrottle", ctx, key, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: key, LockoutCount: 1, LockedUntil: &lockedUntil}, nil)
+	mockQueue.On("PublishMessage", ctx, []byte(accountLockedEventKey), mock.Anything).Return(nil)
+
+	// Execute test
+	err := useCase.RequestMagicLink(ctx, mockUser.Email)
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrTooManyAttempts)
+	require.Nil(t, mockMailer.Last())
+	mockRepo.AssertNotCalled(t, "CreateMagicLink", mock.Anything, mock.Anything)
+	mockQueue.AssertExpectations(t)
+}
+
+func TestConsumeMagicLink(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), mockQueue)
+	ctx := context.Background()
+
+	mockUser := &entity.User{ID: uuid.New(), Email: "test@example.com", IsActive: true}
+	link := &entity.MagicLink{ID: uuid.New(), UserID: mockUser.ID, TokenHash: utils.HashToken("magic-token"), ExpiresAt: time.Now().Add(time.Minute)}
+
+	// Mock behavior
+	events := captureAuthEvents(mockRepo, ctx)
+	mockRepo.On("GetLoginFamiliarity", ctx, mock.Anything, mock.Anything, mock.Anything).Return(&entity.LoginFamiliarity{}, nil)
+	mockRepo.On("GetMagicLinkByHash", ctx, link.TokenHash).Return(link, nil)
+	mockRepo.On("ResetAuthThrottle", ctx, entity.ThrottleKey{Scope: entity.ThrottleScopeMagicLink, SubjectType: entity.ThrottleSubjectAccount, Subject: mockUser.Email}).Return(nil)
+	mockRepo.On("MarkMagicLinkUsed", ctx, link.ID, "", "").Return(nil)
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("GetUserMFA", ctx, mockUser.ID).Return(nil, entity.ErrMFANotEnrolled)
+	mockRepo.On("CreateToken", ctx, mockUser.Email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+	mockQueue.On("PublishMessage", ctx, []byte(magicLinkUsedEventKey), mock.Anything).Return(nil)
+
+	// Execute test
+	user, session, challenge, err := useCase.ConsumeMagicLink(ctx, "magic-token")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Nil(t, challenge)
+	require.Equal(t, mockUser, user)
+	require.Equal(t, mockUser.ID, session.UserID)
+	mockRepo.AssertExpectations(t)
+	mockQueue.AssertExpectations(t)
+
+	eventTypes := make([]string, 0, len(*events))
+	for _, event := range *events {
+		eventTypes = append(eventTypes, event.EventType)
+	}
+	require.Contains(t, eventTypes, entity.AuthEventMagicLinkUsed)
+}
+
+func TestConsumeMagicLinkRejected(t *testing.T) {
+	usedAt := time.Now().Add(-time.Minute)
+
+	testCases := []struct {
+		name      string
+		link      *entity.MagicLink
+		markErr   error
+		err       error
+		reason    string
+		replayed  bool
+		markCalls bool
+	}{
+		{
+			name:     "already used",
+			link:     &entity.MagicLink{ExpiresAt: time.Now().Add(time.Minute), UsedAt: &usedAt},
+			err:      entity.ErrMagicLinkUsed,
+			reason:   "link_replayed",
+			replayed: true,
+		},
+		{
+			name:   "expired",
+			link:   &entity.MagicLink{ExpiresAt: time.Now().Add(-time.Minute)},
+			err:    entity.ErrMagicLinkInvalid,
+			reason: "link_invalid",
+		},
+		{
+			name:      "used concurrently",
+			link:      &entity.MagicLink{ExpiresAt: time.Now().Add(time.Minute)},
+			markErr:   entity.ErrMagicLinkUsed,
+			err:       entity.ErrMagicLinkUsed,
+			reason:    "link_replayed",
+			replayed:  true,
+			markCalls: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+			mockOauthRepo := new(MockOauthRepository)
+			mockQueue := new(MockMessageQueue)
+
+			useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), mockQueue)
+			ctx := context.Background()
+
+			tc.link.ID = uuid.New()
+			tc.link.UserID = uuid.New()
+
+			// Mock behavior
+			events := captureAuthEvents(mockRepo, ctx)
+			mockRepo.On("GetMagicLinkByHash", ctx, utils.HashToken("magic-token")).Return(tc.link, nil)
+			if tc.markCalls {
+				mockRepo.On("MarkMagicLinkUsed", ctx, tc.link.ID, "", "").Return(tc.markErr)
+			}
+			if tc.replayed {
+				mockQueue.On("PublishMessage", ctx, []byte(magicLinkReplayedEventKey), mock.Anything).Return(nil)
+			}
+
+			// Execute test
+			_, _, _, err := useCase.ConsumeMagicLink(ctx, "magic-token")
+
+			// Assertions
+			require.ErrorIs(t, err, tc.err)
+			require.Len(t, *events, 1)
+			require.Equal(t, entity.AuthEventMagicLinkUsed, (*events)[0].EventType)
+			require.Equal(t, entity.AuthEventFailure, (*events)[0].Outcome)
+			require.Equal(t, tc.reason, (*events)[0].Metadata["failure_reason"])
+			require.Equal(t, tc.link.UserID, *(*events)[0].UserID)
+			mockQueue.AssertExpectations(t)
+			mockRepo.AssertNotCalled(t, "CreateSession", mock.Anything, mock.Anything)
+			if !tc.markCalls {
+				mockRepo.AssertNotCalled(t, "MarkMagicLinkUsed", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	mfaIssuer            = "Realio"
+	mfaChallengeTTL      = 5 * time.Minute
+	mfaMaxAttempts       = 5
+	mfaRecoveryCodeCount = 10
+)
+
+// EnrollMFA generates a new TOTP secret for the user. The factor stays
+// inactive until it is confirmed with a valid code.
+func (u *userUsecase) EnrollMFA(ctx context.Context, userID string) (*entity.MFAEnrollment, error) {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
+	existing, err := u.userRepo.GetUserMFA(ctx, user.ID)
+	if err != nil && !errors.Is(err, entity.ErrMFANotEnrolled) {
+		return nil, fmt.Errorf("failed to retrieve mfa: %w", err)
+	}
+	if existing != nil && existing.Enabled {
+		return nil, entity.ErrMFAAlreadyEnabled
+	}
+
+	key, err := utils.GenerateTOTPKey(mfaIssuer, user.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.userRepo.SaveUserMFA(ctx, user.ID, key.Secret); err != nil {
+		return nil, err
+	}
+
+	return &entity.MFAEnrollment{
+		Secret:     key.Secret,
+		OtpauthURL: key.OtpauthURL,
+		QRCode:     key.QRCode,
+	}, nil
+}
+
+// ConfirmMFA activates a pending TOTP factor and returns the user's recovery codes.
+func (u *userUsecase) ConfirmMFA(ctx context.Context, userID string, code string) ([]string, error) {
+	userId, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format: %w", err)
+	}
+
+	mfa, err := u.userRepo.GetUserMFA(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if mfa.Enabled {
+		return nil, entity.ErrMFAAlreadyEnabled
+	}
+
+	if err := u.checkTOTP(ctx, mfa, code); err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepo.EnableUserMFA(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	return u.issueRecoveryCodes(ctx, userId)
+}
+
+// VerifyMFA completes a login by checking the second factor against a pending challenge.
+// Either a TOTP code or an unused recovery code is accepted. Wrong codes count
+// against the same account and IP lockouts as wrong passwords, so issuing
+// fresh challenges does not buy more guesses.
+func (u *userUsecase) VerifyMFA(ctx context.Context, challengeID string, code string) (*entity.User, *entity.Session, error) {
+	challengeId, err := uuid.Parse(challengeID)
+	if err != nil {
+		return nil, nil, entity.ErrMFAChallengeInvalid
+	}
+
+	challenge, err := u.userRepo.GetMFAChallenge(ctx, challengeId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if challenge.ConsumedAt != nil || time.Now().After(challenge.ExpiresAt) {
+		return nil, nil, entity.ErrMFAChallengeInvalid
+	}
+
+	if challenge.Attempts >= mfaMaxAttempts {
+		return nil, nil, entity.ErrMFAAttemptsExceeded
+	}
+
+	user, err := u.userRepo.GetUserByID(ctx, challenge.UserID.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
+	rules := throttleRules(ctx, entity.ThrottleScopeLogin, user.Email)
+	if err := u.checkLockout(ctx, rules); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventLogin, &user.ID, user.Email, authFailureReason(err))
+		return nil, nil, err
+	}
+
+	// The account may have been locked or deleted since the challenge was issued
+	if err := user.CanSignIn(); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventLogin, &user.ID, user.Email, authFailureReason(err))
+		return nil, nil, err
+	}
+
+	mfa, err := u.userRepo.GetUserMFA(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !mfa.Enabled {
+		return nil, nil, entity.ErrMFANotEnabled
+	}
+
+	if err := u.checkSecondFactor(ctx, mfa, code); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventLogin, &user.ID, user.Email, authFailureReason(err))
+		if errors.Is(err, entity.ErrInvalidMFACode) {
+			if incErr := u.userRepo.IncrementMFAChallengeAttempts(ctx, challengeId); incErr != nil {
+				return nil, nil, incErr
+			}
+			if lockErr := u.recordFailure(ctx, rules, &user.ID); lockErr != nil {
+				return nil, nil, lockErr
+			}
+		}
+		return nil, nil, err
+	}
+
+	consumed, err := u.userRepo.ConsumeMFAChallenge(ctx, challengeId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !consumed {
+		return nil, nil, entity.ErrMFAChallengeInvalid
+	}
+
+	// Both factors are proven, so the account's failures are forgiven
+	if err := u.clearFailures(ctx, rules); err != nil {
+		return nil, nil, err
+	}
+
+	if err := u.cancelScheduledDeletion(ctx, user); err != nil {
+		return nil, nil, err
+	}
+
+	// The second factor does not verify the email either
+	session, err := u.createSession(ctx, user, user.EmailVerified)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u.recordLogin(ctx, user, session, loginMethodMFA)
+
+	return user, session, nil
+}
+
+// DisableMFA removes the user's TOTP factor after re-checking both the password and a second factor.
+func (u *userUsecase) DisableMFA(ctx context.Context, userID string, password string, code string) error {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
+	if err := utils.CheckPassword(password, user.Password); err != nil {
+		return fmt.Errorf("password is incorrect: %w", err)
+	}
+
+	mfa, err := u.userRepo.GetUserMFA(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if mfa.Enabled {
+		if err := u.checkSecondFactor(ctx, mfa, code); err != nil {
+			return err
+		}
+	}
+
+	return u.userRepo.DeleteUserMFA(ctx, user.ID)
+}
+
+// RegenerateRecoveryCodes invalidates the user's recovery codes and issues a new set.
+func (u *userUsecase) RegenerateRecoveryCodes(ctx context.Context, userID string, code string) ([]string, error) {
+	userId, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format: %w", err)
+	}
+
+	mfa, err := u.userRepo.GetUserMFA(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !mfa.Enabled {
+		return nil, entity.ErrMFANotEnabled
+	}
+
+	if err := u.checkTOTP(ctx, mfa, code); err != nil {
+		return nil, err
+	}
+
+	return u.issueRecoveryCodes(ctx, userId)
+}
+
+// createMFAChallenge returns a pending challenge if the user has MFA enabled, or nil otherwise.
+func (u *userUsecase) createMFAChallenge(ctx context.Context, userID uuid.UUID) (*entity.MFAChallenge, error) {
+	mfa, err := u.userRepo.GetUserMFA(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrMFANotEnrolled) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve mfa: %w", err)
+	}
+
+	if !mfa.Enabled {
+		return nil, nil
+	}
+
+	metaData := utils.ExtractMetaData(ctx)
+
+	challenge := &entity.MFAChallenge{
+		ID:        uuid.New(),
+		UserID:    userID,
+		IpAddress: metaData.ClientIP,
+		UserAgent: metaData.UserAgent,
+		ExpiresAt: time.Now().Add(mfaChallengeTTL).UTC(),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := u.userRepo.CreateMFAChallenge(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+// checkSecondFactor accepts either a TOTP code or a recovery code.
+func (u *userUsecase) checkSecondFactor(ctx context.Context, mfa *entity.UserMFA, code string) error {
+	if utils.ValidateOTP(code) {
+		return u.checkTOTP(ctx, mfa, code)
+	}
+
+	used, err := u.userRepo.UseRecoveryCode(ctx, mfa.UserID, utils.HashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return entity.ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+// checkTOTP validates a TOTP code and records its time step so it cannot be reused.
+func (u *userUsecase) checkTOTP(ctx context.Context, mfa *entity.UserMFA, code string) error {
+	step, ok := utils.ValidateTOTP(code, mfa.Secret, time.Now())
+	if !ok || step <= mfa.LastUsedStep {
+		return entity.ErrInvalidMFACode
+	}
+
+	updated, err := u.userRepo.UpdateMFALastUsedStep(ctx, mfa.UserID, step)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return entity.ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+// issueRecoveryCodes generates a new set of recovery codes, storing only their hashes.
+func (u *userUsecase) issueRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	codes, err := utils.GenerateRecoveryCodes(mfaRecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, utils.HashRecoveryCode(code))
+	}
+
+	if err := u.userRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
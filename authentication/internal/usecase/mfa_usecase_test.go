@@ -0,0 +1,308 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginUserWithMFA(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	password := "password123"
+	hashedPassword, _ := utils.HashPassword(password)
+	email := "test@example.com"
+
+	mockUser := &entity.User{
+		ID:       uuid.New(),
+		Email:    email,
+		Password: hashedPassword,
+	}
+
+	// Mock behavior
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("ResetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(mockUser, nil)
+	mockRepo.On("GetUserMFA", ctx, mockUser.ID).Return(&entity.UserMFA{UserID: mockUser.ID, Enabled: true}, nil)
+	mockRepo.On("CreateMFAChallenge", ctx, mock.AnythingOfType("*entity.MFAChallenge")).Return(nil)
+
+	// Execute test
+	user, session, challenge, err := useCase.LoginUser(ctx, password, email)
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	require.Nil(t, session)
+	require.NotNil(t, challenge)
+	require.Equal(t, mockUser.ID, challenge.UserID)
+	require.True(t, challenge.ExpiresAt.After(time.Now()))
+	mockRepo.AssertNotCalled(t, "ResetAuthThrottle", ctx, mock.Anything)
+}
+
+func TestConfirmMFA(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	key, err := utils.GenerateTOTPKey("Realio", "test@example.com")
+	require.NoError(t, err)
+
+	userID := uuid.New()
+	code, err := totp.GenerateCode(key.Secret, time.Now())
+	require.NoError(t, err)
+
+	// Mock behavior
+	mockRepo.On("GetUserMFA", ctx, userID).Return(&entity.UserMFA{UserID: userID, Secret: key.Secret}, nil)
+	mockRepo.On("UpdateMFALastUsedStep", ctx, userID, mock.AnythingOfType("int64")).Return(true, nil)
+	mockRepo.On("EnableUserMFA", ctx, userID).Return(nil)
+	mockRepo.On("ReplaceRecoveryCodes", ctx, userID, mock.AnythingOfType("[]string")).Return(nil)
+
+	// Execute test
+	codes, err := useCase.ConfirmMFA(ctx, userID.String(), code)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Len(t, codes, mfaRecoveryCodeCount)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestConfirmMFAInvalidCode(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	key, err := utils.GenerateTOTPKey("Realio", "test@example.com")
+	require.NoError(t, err)
+
+	userID := uuid.New()
+
+	// Mock behavior
+	mockRepo.On("GetUserMFA", ctx, userID).Return(&entity.UserMFA{UserID: userID, Secret: key.Secret}, nil)
+
+	// Execute test
+	_, err = useCase.ConfirmMFA(ctx, userID.String(), "000000x")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrInvalidMFACode)
+	mockRepo.AssertNotCalled(t, "EnableUserMFA", ctx, userID)
+}
+
+func TestVerifyMFARecoveryCode(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userID := uuid.New()
+	challengeID := uuid.New()
+	recoveryCode := "abcde-12345"
+
+	mockUser := &entity.User{
+		ID:    userID,
+		Email: "test@example.com",
+	}
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetLoginFamiliarity", ctx, mock.Anything, mock.Anything, mock.Anything).Return(&entity.LoginFamiliarity{}, nil)
+	mockRepo.On("GetMFAChallenge", ctx, challengeID).Return(&entity.MFAChallenge{
+		ID:        challengeID,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("ResetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil)
+	mockRepo.On("GetUserMFA", ctx, userID).Return(&entity.UserMFA{UserID: userID, Enabled: true}, nil)
+	mockRepo.On("UseRecoveryCode", ctx, userID, utils.HashRecoveryCode(recoveryCode)).Return(true, nil)
+	mockRepo.On("ConsumeMFAChallenge", ctx, challengeID).Return(true, nil)
+	mockRepo.On("GetUserByID", ctx, userID.String()).Return(mockUser, nil)
+	mockRepo.On("CreateToken", ctx, mockUser.Email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+
+	// Execute test
+	user, session, err := useCase.VerifyMFA(ctx, challengeID.String(), recoveryCode)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, userID, user.ID)
+	require.Equal(t, userID, session.UserID)
+	mockRepo.AssertCalled(t, "ResetAuthThrottle", ctx, entity.ThrottleKey{
+		Scope: entity.ThrottleScopeLogin, SubjectType: entity.ThrottleSubjectAccount, Subject: mockUser.Email,
+	})
+}
+
+func TestVerifyMFAInvalidCodeCountsAttempt(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userID := uuid.New()
+	challengeID := uuid.New()
+	mockUser := &entity.User{ID: userID, Email: "test@example.com"}
+	accountKey := entity.ThrottleKey{Scope: entity.ThrottleScopeLogin, SubjectType: entity.ThrottleSubjectAccount, Subject: mockUser.Email}
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetMFAChallenge", ctx, challengeID).Return(&entity.MFAChallenge{
+		ID:        challengeID,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+	mockRepo.On("GetUserByID", ctx, userID.String()).Return(mockUser, nil)
+	mockRepo.On("GetAuthThrottle", ctx, accountKey).Return(nil, nil)
+	mockRepo.On("GetUserMFA", ctx, userID).Return(&entity.UserMFA{UserID: userID, Enabled: true}, nil)
+	mockRepo.On("UseRecoveryCode", ctx, userID, mock.AnythingOfType("string")).Return(false, nil)
+	mockRepo.On("IncrementMFAChallengeAttempts", ctx, challengeID).Return(nil)
+	mockRepo.On("RecordAuthFailure", ctx, accountKey, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: accountKey, FailedAttempts: 1}, nil)
+
+	// Execute test
+	_, _, err := useCase.VerifyMFA(ctx, challengeID.String(), "wrong-code")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrInvalidMFACode)
+	mockRepo.AssertCalled(t, "IncrementMFAChallengeAttempts", ctx, challengeID)
+	mockRepo.AssertCalled(t, "RecordAuthFailure", ctx, accountKey, mock.AnythingOfType("time.Time"))
+}
+
+func TestVerifyMFALocksAccountAtThreshold(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockQueue := new(MockMessageQueue)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), mockQueue)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	challengeID := uuid.New()
+	mockUser := &entity.User{ID: userID, Email: "test@example.com"}
+	accountKey := entity.ThrottleKey{Scope: entity.ThrottleScopeLogin, SubjectType: entity.ThrottleSubjectAccount, Subject: mockUser.Email}
+	lockedUntil := time.Now().Add(lockoutBaseDuration)
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetMFAChallenge", ctx, challengeID).Return(&entity.MFAChallenge{
+		ID:        challengeID,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+	mockRepo.On("GetUserByID", ctx, userID.String()).Return(mockUser, nil)
+	mockRepo.On("GetAuthThrottle", ctx, accountKey).Return(nil, nil)
+	mockRepo.On("GetUserMFA", ctx, userID).Return(&entity.UserMFA{UserID: userID, Enabled: true}, nil)
+	mockRepo.On("UseRecoveryCode", ctx, userID, mock.AnythingOfType("string")).Return(false, nil)
+	mockRepo.On("IncrementMFAChallengeAttempts", ctx, challengeID).Return(nil)
+	mockRepo.On("RecordAuthFailure", ctx, accountKey, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: accountKey, FailedAttempts: accountMaxFailures}, nil)
+	mockRepo.On("LockAuthThrottle", ctx, accountKey, mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{ThrottleKey: accountKey, LockoutCount: 1, LockedUntil: &lockedUntil}, nil)
+	mockQueue.On("PublishMessage", ctx, []byte(accountLockedEventKey), mock.Anything).Return(nil)
+
+	// Execute test
+	_, _, err := useCase.VerifyMFA(ctx, challengeID.String(), "wrong-code")
+
+	// Assertions
+	var lockErr *entity.LockoutError
+	require.ErrorAs(t, err, &lockErr)
+}
+
+func TestVerifyMFARejectedWhileLockedOut(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userID := uuid.New()
+	challengeID := uuid.New()
+	mockUser := &entity.User{ID: userID, Email: "test@example.com"}
+	lockedUntil := time.Now().Add(time.Minute)
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetMFAChallenge", ctx, challengeID).Return(&entity.MFAChallenge{
+		ID:        challengeID,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+	mockRepo.On("GetUserByID", ctx, userID.String()).Return(mockUser, nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(&entity.AuthThrottle{LockedUntil: &lockedUntil}, nil)
+
+	// Execute test
+	_, _, err := useCase.VerifyMFA(ctx, challengeID.String(), "123456")
+
+	// Assertions
+	var lockErr *entity.LockoutError
+	require.ErrorAs(t, err, &lockErr)
+	mockRepo.AssertNotCalled(t, "GetUserMFA", ctx, userID)
+}
+
+func TestVerifyMFAAccountLockedSinceChallenge(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userID := uuid.New()
+	challengeID := uuid.New()
+	lockedAt := time.Now()
+	mockUser := &entity.User{ID: userID, Email: "test@example.com", LockedAt: &lockedAt}
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetMFAChallenge", ctx, challengeID).Return(&entity.MFAChallenge{
+		ID:        challengeID,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+	mockRepo.On("GetUserByID", ctx, userID.String()).Return(mockUser, nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+
+	// Execute test
+	_, _, err := useCase.VerifyMFA(ctx, challengeID.String(), "123456")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrAccountLocked)
+	mockRepo.AssertNotCalled(t, "ConsumeMFAChallenge", ctx, challengeID)
+}
+
+func TestVerifyMFAAttemptsExceeded(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	challengeID := uuid.New()
+
+	// Mock behavior
+	mockRepo.On("GetMFAChallenge", ctx, challengeID).Return(&entity.MFAChallenge{
+		ID:        challengeID,
+		UserID:    uuid.New(),
+		Attempts:  mfaMaxAttempts,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+
+	// Execute test
+	_, _, err := useCase.VerifyMFA(ctx, challengeID.String(), "123456")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrMFAAttemptsExceeded)
+}
@@ -0,0 +1,362 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// oauthStateTTL is how long a user has to finish signing in at the provider
+	oauthStateTTL = 10 * time.Minute
+
+	oauthStateBytes = 32
+	// oauthVerifierBytes gives a 43 character PKCE code verifier, the minimum RFC 7636 allows
+	oauthVerifierBytes = 32
+)
+
+// RegisterWithOAuth creates an account from a provider's ID token. An email
+// that already belongs to an account is never taken over; its owner has to
+// sign in and link the provider instead.
+func (u *userUsecase) RegisterWithOAuth(ctx context.Context, provider string, token string) (*entity.User, *entity.Session, error) {
+	userInfo, err := u.validateOAuthToken(ctx, provider, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The identity is already registered
+	_, err = u.userRepo.GetUserIdentity(ctx, userInfo.Provider, userInfo.ID)
+	if err == nil {
+		return nil, nil, ErrUserAlreadyExists
+	}
+	if !errors.Is(err, entity.ErrIdentityNotFound) {
+		return nil, nil, fmt.Errorf("failed to retrieve identity: %w", err)
+	}
+
+	return u.registerFromProvider(ctx, userInfo)
+}
+
+// registerFromProvider creates a user and their first identity from a
+// provider's profile and starts a session for them.
+func (u *userUsecase) registerFromProvider(ctx context.Context, userInfo *entity.OAuthUserInfo) (*entity.User, *entity.Session, error) {
+	if userInfo.Email == "" {
+		return nil, nil, entity.ErrOAuthEmailMissing
+	}
+
+	// Check if the user already exists
+	existingUser, err := u.userRepo.GetUserByEmail(ctx, userInfo.Email)
+	if err == nil && existingUser != nil {
+		return nil, nil, entity.ErrEmailAlreadyInUse
+	}
+
+	user := &entity.User{
+		ID:             uuid.New(),
+		FullName:       userInfo.Name,
+		Email:          userInfo.Email,
+		Role:           string(rbac.DefaultRole),
+		ProfilePicture: userInfo.Picture,
+		EmailVerified:  userInfo.EmailVerified,
+		IsActive:       true,
+		LastLogin:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+	}
+
+	identity := &entity.UserIdentity{
+		Provider:       userInfo.Provider,
+		ProviderUserID: userInfo.ID,
+		Email:          userInfo.Email,
+	}
+
+	// Save the user and the identity they signed up with together
+	if err := u.userRepo.CreateOAuthUser(ctx, user, identity); err != nil {
+		return nil, nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	session, err := u.createVerifiedSession(ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, session, nil
+}
+
+// LoginWithOAuth signs in the user linked to the provider identity in the ID
+// token. Like LoginUser, a second factor challenge is returned instead of a
+// session when the user has MFA enabled.
+func (u *userUsecase) LoginWithOAuth(ctx context.Context, provider string, token string) (*entity.User, *entity.Session, *entity.MFAChallenge, error) {
+	userInfo, err := u.validateOAuthToken(ctx, provider, token)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	identity, err := u.userRepo.GetUserIdentity(ctx, userInfo.Provider, userInfo.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrIdentityNotFound) {
+			return nil, nil, nil, entity.ErrAccountNotLinked
+		}
+		return nil, nil, nil, fmt.Errorf("failed to retrieve identity: %w", err)
+	}
+
+	return u.signInWithIdentity(ctx, identity)
+}
+
+// signInWithIdentity completes a sign in through a linked identity, asking
+// for a second factor when the user has MFA enabled.
+func (u *userUsecase) signInWithIdentity(ctx context.Context, identity *entity.UserIdentity) (*entity.User, *entity.Session, *entity.MFAChallenge, error) {
+	user, err := u.userRepo.GetUserByID(ctx, identity.UserID.String())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
+	if err := user.CanSignIn(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := u.userRepo.TouchUserIdentity(ctx, identity.ID); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Require a second factor if the user has confirmed MFA
+	challenge, err := u.createMFAChallenge(ctx, user.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if challenge != nil {
+		return user, nil, challenge, nil
+	}
+
+	if err := u.cancelScheduledDeletion(ctx, user); err != nil {
+		return nil, nil, nil, err
+	}
+
+	session, err := u.createVerifiedSession(ctx, user)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	u.recordLogin(ctx, user, session, loginMethodOAuth+":"+identity.Provider)
+
+	return user, session, nil, nil
+}
+
+// StartOAuth begins the authorization code flow with PKCE at a provider. The
+// state, code verifier and nonce stay on the server; the client only gets the
+// URL to send the user to.
+func (u *userUsecase) StartOAuth(ctx context.Context, provider string) (*entity.OAuthAuthorization, error) {
+	state := &entity.OAuthState{
+		Provider:  strings.ToLower(provider),
+		ExpiresAt: time.Now().Add(oauthStateTTL),
+	}
+
+	var err error
+	if state.State, err = utils.GenerateURLSafeToken(oauthStateBytes); err != nil {
+		return nil, err
+	}
+	if state.CodeVerifier, err = utils.GenerateURLSafeToken(oauthVerifierBytes); err != nil {
+		return nil, err
+	}
+	if state.Nonce, err = utils.GenerateURLSafeToken(oauthStateBytes); err != nil {
+		return nil, err
+	}
+
+	authURL, err := u.oauthRepo.AuthCodeURL(state.Provider, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepo.CreateOAuthState(ctx, state); err != nil {
+		return nil, err
+	}
+
+	return &entity.OAuthAuthorization{
+		URL:       authURL,
+		State:     state.State,
+		ExpiresAt: state.ExpiresAt,
+	}, nil
+}
+
+// CompleteOAuth handles the provider's callback: the state is redeemed once,
+// the code is exchanged with its PKCE verifier, and the user is signed in, or
+// signed up if the identity is new.
+func (u *userUsecase) CompleteOAuth(ctx context.Context, provider string, code string, state string) (*entity.User, *entity.Session, *entity.MFAChallenge, error) {
+	pending, err := u.userRepo.ConsumeOAuthState(ctx, state)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if pending.Provider != strings.ToLower(provider) || pending.IsExpired(time.Now()) {
+		return nil, nil, nil, entity.ErrOAuthStateInvalid
+	}
+
+	userInfo, err := u.oauthRepo.ExchangeCode(ctx, pending.Provider, code, pending)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	if userInfo.Provider == "" {
+		userInfo.Provider = pending.Provider
+	}
+
+	identity, err := u.userRepo.GetUserIdentity(ctx, userInfo.Provider, userInfo.ID)
+	if err == nil {
+		return u.signInWithIdentity(ctx, identity)
+	}
+	if !errors.Is(err, entity.ErrIdentityNotFound) {
+		return nil, nil, nil, fmt.Errorf("failed to retrieve identity: %w", err)
+	}
+
+	user, session, err := u.registerFromProvider(ctx, userInfo)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return user, session, nil, nil
+}
+
+// LinkIdentity adds the provider identity in the ID token to a signed in user.
+func (u *userUsecase) LinkIdentity(ctx context.Context, userID string, provider string, token string) (*entity.UserIdentity, error) {
+	userId, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format: %w", err)
+	}
+
+	userInfo, err := u.validateOAuthToken(ctx, provider, token)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.userRepo.GetUserIdentity(ctx, userInfo.Provider, userInfo.ID)
+	if err == nil {
+		// Linking the same identity twice is a no-op
+		if existing.UserID == userId {
+			return existing, nil
+		}
+		return nil, entity.ErrIdentityAlreadyLinked
+	}
+	if !errors.Is(err, entity.ErrIdentityNotFound) {
+		return nil, fmt.Errorf("failed to retrieve identity: %w", err)
+	}
+
+	identity := &entity.UserIdentity{
+		UserID:         userId,
+		Provider:       userInfo.Provider,
+		ProviderUserID: userInfo.ID,
+		Email:          userInfo.Email,
+	}
+
+	if err := u.userRepo.CreateUserIdentity(ctx, identity); err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+// ListIdentities returns the provider identities linked to a user.
+func (u *userUsecase) ListIdentities(ctx context.Context, userID string) ([]*entity.UserIdentity, error) {
+	userId, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format: %w", err)
+	}
+
+	return u.userRepo.ListUserIdentities(ctx, userId)
+}
+
+// UnlinkIdentity removes a provider identity from a user, unless it is the
+// only way left for them to sign in.
+func (u *userUsecase) UnlinkIdentity(ctx context.Context, userID string, identityID string) error {
+	userId, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID format: %w", err)
+	}
+
+	identityId, err := uuid.Parse(identityID)
+	if err != nil {
+		return entity.ErrIdentityNotFound
+	}
+
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
+	identities, err := u.userRepo.ListUserIdentities(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, identity := range identities {
+		if identity.ID == identityId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return entity.ErrIdentityNotFound
+	}
+
+	// A user without a password signs in through their identities only
+	if user.Password == "" && len(identities) == 1 {
+		return entity.ErrLastSignInMethod
+	}
+
+	return u.userRepo.DeleteUserIdentity(ctx, userId, identityId)
+}
+
+// validateOAuthToken verifies a provider ID token and fills in the provider name.
+func (u *userUsecase) validateOAuthToken(ctx context.Context, provider string, token string) (*entity.OAuthUserInfo, error) {
+	userInfo, err := u.oauthRepo.ValidateProviderToken(ctx, provider, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate OAuth token: %w", err)
+	}
+
+	if userInfo.Provider == "" {
+		userInfo.Provider = strings.ToLower(provider)
+	}
+
+	return userInfo, nil
+}
+
+// createVerifiedSession starts a session for a user who proved who they are
+// through a provider or a magic link, so no OTP is needed.
+func (u *userUsecase) createVerifiedSession(ctx context.Context, user *entity.User) (*entity.Session, error) {
+	return u.createSession(ctx, user, true)
+}
+
+// createSession starts a session for the user, marked OTP verified only when
+// otpVerified is set.
+func (u *userUsecase) createSession(ctx context.Context, user *entity.User, otpVerified bool) (*entity.Session, error) {
+	sessionID := uuid.New()
+	token, _, err := u.userRepo.CreateToken(ctx, user.Email, user.ID.String(), sessionID.String(), user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token for email %s: %w", user.Email, err)
+	}
+
+	metaData := utils.ExtractMetaData(ctx)
+
+	session := &entity.Session{
+		SessionID:    sessionID,
+		UserID:       user.ID,
+		Token:        token,
+		CreatedAt:    time.Now().UTC(),
+		ExpiresAt:    time.Now().Add(24 * time.Hour).UTC(),
+		LastActivity: time.Now().UTC(),
+		IpAddress:    metaData.ClientIP,
+		UserAgent:    metaData.UserAgent,
+		IsActive:     true,
+		OTPVerified:  otpVerified,
+	}
+
+	if err := u.userRepo.CreateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return session, nil
+}
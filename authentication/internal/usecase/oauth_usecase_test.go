@@ -0,0 +1,334 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func googleUserInfo() *entity.OAuthUserInfo {
+	return &entity.OAuthUserInfo{
+		ID:            "google-user-1",
+		Email:         "test@example.com",
+		Name:          "Test User",
+		EmailVerified: true,
+		Provider:      "google",
+	}
+}
+
+func TestRegisterWithOAuthCreatesUserAndIdentity(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userInfo := googleUserInfo()
+	var identity *entity.UserIdentity
+
+	// Mock behavior
+	mockOauthRepo.On("ValidateProviderToken", ctx, "google", "id-token").Return(userInfo, nil)
+	mockRepo.On("GetUserIdentity", ctx, "google", userInfo.ID).Return(nil, entity.ErrIdentityNotFound)
+	mockRepo.On("GetUserByEmail", ctx, userInfo.Email).Return(nil, errors.New("user not found"))
+	mockRepo.On("CreateOAuthUser", ctx, mock.AnythingOfType("*entity.User"), mock.AnythingOfType("*entity.UserIdentity")).
+		Run(func(args mock.Arguments) { identity = args.Get(2).(*entity.UserIdentity) }).
+		Return(nil)
+	mockRepo.On("CreateToken", ctx, userInfo.Email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+
+	// Execute test
+	user, session, err := useCase.RegisterWithOAuth(ctx, "google", "id-token")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, userInfo.Email, user.Email)
+	require.True(t, user.EmailVerified)
+	require.Empty(t, user.Password)
+	require.Equal(t, user.ID, session.UserID)
+	require.True(t, session.OTPVerified)
+	require.Equal(t, "google", identity.Provider)
+	require.Equal(t, userInfo.ID, identity.ProviderUserID)
+}
+
+func TestRegisterWithOAuthRejectsExistingEmail(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userInfo := googleUserInfo()
+
+	// Mock behavior
+	mockOauthRepo.On("ValidateProviderToken", ctx, "google", "id-token").Return(userInfo, nil)
+	mockRepo.On("GetUserIdentity", ctx, "google", userInfo.ID).Return(nil, entity.ErrIdentityNotFound)
+	mockRepo.On("GetUserByEmail", ctx, userInfo.Email).Return(&entity.User{ID: uuid.New(), Email: userInfo.Email}, nil)
+
+	// Execute test
+	_, _, err := useCase.RegisterWithOAuth(ctx, "google", "id-token")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrEmailAlreadyInUse)
+	mockRepo.AssertNotCalled(t, "CreateOAuthUser", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything)
+}
+
+func TestLoginWithOAuth(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mockMailer, new(MockMessageQueue))
+	ctx := context.Background()
+
+	userInfo := googleUserInfo()
+	mockUser := &entity.User{ID: uuid.New(), Email: userInfo.Email, FullName: userInfo.Name}
+	identity := &entity.UserIdentity{ID: uuid.New(), UserID: mockUser.ID, Provider: "google", ProviderUserID: userInfo.ID}
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetLoginFamiliarity", ctx, mock.Anything, mock.Anything, mock.Anything).Return(&entity.LoginFamiliarity{}, nil)
+	mockOauthRepo.On("ValidateProviderToken", ctx, "google", "id-token").Return(userInfo, nil)
+	mockRepo.On("GetUserIdentity", ctx, "google", userInfo.ID).Return(identity, nil)
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("TouchUserIdentity", ctx, identity.ID).Return(nil)
+	mockRepo.On("GetUserMFA", ctx, mockUser.ID).Return(nil, entity.ErrMFANotEnrolled)
+	mockRepo.On("CreateToken", ctx, mockUser.Email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+
+	// Execute test
+	user, session, challenge, err := useCase.LoginWithOAuth(ctx, "google", "id-token")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Nil(t, challenge)
+	require.Equal(t, mockUser, user)
+	require.Equal(t, mockUser.ID, session.UserID)
+	require.Nil(t, mockMailer.Last(), "a first sign-in is not an unfamiliar one")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestLoginWithOAuthUnlinkedIdentity(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userInfo := googleUserInfo()
+
+	// Mock behavior
+	mockOauthRepo.On("ValidateProviderToken", ctx, "google", "id-token").Return(userInfo, nil)
+	mockRepo.On("GetUserIdentity", ctx, "google", userInfo.ID).Return(nil, entity.ErrIdentityNotFound)
+
+	// Execute test
+	_, _, _, err := useCase.LoginWithOAuth(ctx, "google", "id-token")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrAccountNotLinked)
+	mockRepo.AssertNotCalled(t, "GetUserByEmail", mock.Anything, mock.Anything)
+}
+
+func TestStartOAuth(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	var stored *entity.OAuthState
+
+	// Mock behavior
+	mockOauthRepo.On("AuthCodeURL", "github", mock.AnythingOfType("*entity.OAuthState")).
+		Return("https://github.com/login/oauth/authorize?state=abc", nil)
+	mockRepo.On("CreateOAuthState", ctx, mock.AnythingOfType("*entity.OAuthState")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*entity.OAuthState) }).
+		Return(nil)
+
+	// Execute test
+	authorization, err := useCase.StartOAuth(ctx, "GitHub")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, "https://github.com/login/oauth/authorize?state=abc", authorization.URL)
+	require.Equal(t, stored.State, authorization.State)
+	require.Equal(t, "github", stored.Provider)
+	require.NotEmpty(t, stored.CodeVerifier)
+	require.NotEmpty(t, stored.Nonce)
+	require.NotEqual(t, stored.State, stored.CodeVerifier)
+	require.WithinDuration(t, time.Now().Add(oauthStateTTL), authorization.ExpiresAt, time.Second)
+}
+
+func TestCompleteOAuthRejectsInvalidState(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pending *entity.OAuthState
+	}{
+		{"provider mismatch", &entity.OAuthState{State: "state", Provider: "microsoft", ExpiresAt: time.Now().Add(time.Minute)}},
+		{"expired", &entity.OAuthState{State: "state", Provider: "github", ExpiresAt: time.Now().Add(-time.Minute)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+			mockOauthRepo := new(MockOauthRepository)
+
+			useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+			ctx := context.Background()
+
+			// Mock behavior
+			mockRepo.On("ConsumeOAuthState", ctx, "state").Return(tc.pending, nil)
+
+			// Execute test
+			_, _, _, err := useCase.CompleteOAuth(ctx, "github", "code", "state")
+
+			// Assertions
+			require.ErrorIs(t, err, entity.ErrOAuthStateInvalid)
+			mockOauthRepo.AssertNotCalled(t, "ExchangeCode", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCompleteOAuthSignsInLinkedIdentity(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	pending := &entity.OAuthState{State: "state", Provider: "github", CodeVerifier: "verifier", ExpiresAt: time.Now().Add(time.Minute)}
+	userInfo := &entity.OAuthUserInfo{ID: "42", Email: "octocat@example.com", Name: "octocat", EmailVerified: true, Provider: "github"}
+	mockUser := &entity.User{ID: uuid.New(), Email: userInfo.Email}
+	identity := &entity.UserIdentity{ID: uuid.New(), UserID: mockUser.ID, Provider: "github", ProviderUserID: userInfo.ID}
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetLoginFamiliarity", ctx, mock.Anything, mock.Anything, mock.Anything).Return(&entity.LoginFamiliarity{}, nil)
+	mockRepo.On("ConsumeOAuthState", ctx, "state").Return(pending, nil)
+	mockOauthRepo.On("ExchangeCode", ctx, "github", "code", pending).Return(userInfo, nil)
+	mockRepo.On("GetUserIdentity", ctx, "github", userInfo.ID).Return(identity, nil)
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("TouchUserIdentity", ctx, identity.ID).Return(nil)
+	mockRepo.On("GetUserMFA", ctx, mockUser.ID).Return(nil, entity.ErrMFANotEnrolled)
+	mockRepo.On("CreateToken", ctx, mockUser.Email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+
+	// Execute test
+	user, session, challenge, err := useCase.CompleteOAuth(ctx, "github", "code", "state")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Nil(t, challenge)
+	require.Equal(t, mockUser, user)
+	require.Equal(t, mockUser.ID, session.UserID)
+	mockRepo.AssertNotCalled(t, "CreateOAuthUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCompleteOAuthRegistersNewIdentity(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	pending := &entity.OAuthState{State: "state", Provider: "microsoft", CodeVerifier: "verifier", ExpiresAt: time.Now().Add(time.Minute)}
+	userInfo := &entity.OAuthUserInfo{ID: "ms-user-1", Email: "user@contoso.com", Name: "Contoso User", Provider: "microsoft"}
+	var identity *entity.UserIdentity
+
+	// Mock behavior
+	mockRepo.On("ConsumeOAuthState", ctx, "state").Return(pending, nil)
+	mockOauthRepo.On("ExchangeCode", ctx, "microsoft", "code", pending).Return(userInfo, nil)
+	mockRepo.On("GetUserIdentity", ctx, "microsoft", userInfo.ID).Return(nil, entity.ErrIdentityNotFound)
+	mockRepo.On("GetUserByEmail", ctx, userInfo.Email).Return(nil, errors.New("user not found"))
+	mockRepo.On("CreateOAuthUser", ctx, mock.AnythingOfType("*entity.User"), mock.AnythingOfType("*entity.UserIdentity")).
+		Run(func(args mock.Arguments) { identity = args.Get(2).(*entity.UserIdentity) }).
+		Return(nil)
+	mockRepo.On("CreateToken", ctx, userInfo.Email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+
+	// Execute test
+	user, session, challenge, err := useCase.CompleteOAuth(ctx, "microsoft", "code", "state")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Nil(t, challenge)
+	require.False(t, user.EmailVerified)
+	require.Equal(t, user.ID, session.UserID)
+	require.Equal(t, "microsoft", identity.Provider)
+	require.Equal(t, userInfo.ID, identity.ProviderUserID)
+}
+
+func TestLinkIdentityOwnedByAnotherUser(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	userInfo := googleUserInfo()
+	userID := uuid.New()
+	owned := &entity.UserIdentity{ID: uuid.New(), UserID: uuid.New(), Provider: "google", ProviderUserID: userInfo.ID}
+
+	// Mock behavior
+	mockOauthRepo.On("ValidateProviderToken", ctx, "google", "id-token").Return(userInfo, nil)
+	mockRepo.On("GetUserIdentity", ctx, "google", userInfo.ID).Return(owned, nil)
+
+	// Execute test
+	_, err := useCase.LinkIdentity(ctx, userID.String(), "google", "id-token")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrIdentityAlreadyLinked)
+	mockRepo.AssertNotCalled(t, "CreateUserIdentity", mock.Anything, mock.Anything)
+}
+
+func TestUnlinkIdentity(t *testing.T) {
+	userID := uuid.New()
+	google := &entity.UserIdentity{ID: uuid.New(), UserID: userID, Provider: "google"}
+	apple := &entity.UserIdentity{ID: uuid.New(), UserID: userID, Provider: "apple"}
+
+	testCases := []struct {
+		name       string
+		password   string
+		identities []*entity.UserIdentity
+		err        error
+	}{
+		{"another identity remains", "", []*entity.UserIdentity{google, apple}, nil},
+		{"password remains", "hashed-password", []*entity.UserIdentity{google}, nil},
+		{"last sign in method", "", []*entity.UserIdentity{google}, entity.ErrLastSignInMethod},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+			mockOauthRepo := new(MockOauthRepository)
+
+			useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+			ctx := context.Background()
+
+			// Mock behavior
+			mockRepo.On("GetUserByID", ctx, userID.String()).Return(&entity.User{ID: userID, Password: tc.password}, nil)
+			mockRepo.On("ListUserIdentities", ctx, userID).Return(tc.identities, nil)
+			mockRepo.On("DeleteUserIdentity", ctx, userID, google.ID).Return(nil)
+
+			// Execute test
+			err := useCase.UnlinkIdentity(ctx, userID.String(), google.ID.String())
+
+			// Assertions
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				mockRepo.AssertNotCalled(t, "DeleteUserIdentity", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			mockRepo.AssertCalled(t, "DeleteUserIdentity", ctx, userID, google.ID)
+		})
+	}
+}
@@ -0,0 +1,413 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/internal/domain/repository"
+	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/authentication/pkg/val"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+)
+
+const (
+	organizationInvitationTTL        = 7 * 24 * time.Hour
+	organizationInvitationTokenBytes = 32
+)
+
+// OrganizationUsecase defines the operations on organizations, such as
+// agencies, and their members. Methods that take a userID act as that user
+// and check their role in the organization.
+type OrganizationUsecase interface {
+	CreateOrganization(ctx context.Context, userID string, name string) (*entity.Organization, error)
+	ListOrganizations(ctx context.Context, userID string) ([]*entity.Organization, error)
+	GetOrganization(ctx context.Context, userID string, organizationID string) (*entity.Organization, []*entity.OrganizationMember, error)
+	InviteMember(ctx context.Context, userID string, organizationID string, email string, role string) (*entity.OrganizationInvitation, error)
+	ListInvitations(ctx context.Context, userID string, organizationID string) ([]*entity.OrganizationInvitation, error)
+	RevokeInvitation(ctx context.Context, userID string, organizationID string, invitationID string) error
+	AcceptInvitation(ctx context.Context, userID string, token string) (*entity.OrganizationMember, error)
+	DeclineInvitation(ctx context.Context, token string) error
+	UpdateMemberRole(ctx context.Context, userID string, organizationID string, memberID string, role string) error
+	RemoveMember(ctx context.Context, userID string, organizationID string, memberID string) error
+	SwitchOrganization(ctx context.Context, userID string, sessionID string, organizationID string) (string, time.Time, error)
+}
+
+// organizationUsecase implements the OrganizationUsecase interface on top of
+// the user flows, so invitations are sent and audited like other account emails.
+type organizationUsecase struct {
+	users *userUsecase
+}
+
+// NewOrganizationUsecase creates a new instance of organizationUsecase.
+func NewOrganizationUsecase(userRepo repository.UserRepository, mailer repository.Mailer) OrganizationUsecase {
+	return &organizationUsecase{users: &userUsecase{userRepo: userRepo, mailer: mailer}}
+}
+
+// CreateOrganization creates an organization owned by the user.
+func (o *organizationUsecase) CreateOrganization(ctx context.Context, userID string, name string) (*entity.Organization, error) {
+	name = strings.TrimSpace(name)
+	if err := val.ValidateStringField(name, 2, 255); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidOrganizationName, err)
+	}
+
+	user, err := o.users.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	organization := &entity.Organization{
+		ID:        uuid.New(),
+		Name:      name,
+		CreatedBy: &user.ID,
+	}
+	if err := o.users.userRepo.CreateOrganization(ctx, organization, string(rbac.OrgRoleOwner)); err != nil {
+		return nil, err
+	}
+
+	o.users.recordUserSuccess(ctx, entity.AuthEventOrganizationJoined, user.ID, user.Email, map[string]string{
+		"organization_id": organization.ID.String(),
+		"role":            string(rbac.OrgRoleOwner),
+	})
+
+	return organization, nil
+}
+
+// ListOrganizations returns the organizations the user is a member of, each
+// with the user's role in it.
+func (o *organizationUsecase) ListOrganizations(ctx context.Context, userID string) ([]*entity.Organization, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidUserID, err)
+	}
+
+	return o.users.userRepo.ListUserOrganizations(ctx, id)
+}
+
+// GetOrganization returns an organization and its members. Only members can
+// see them.
+func (o *organizationUsecase) GetOrganization(ctx context.Context, userID string, organizationID string) (*entity.Organization, []*entity.OrganizationMember, error) {
+	member, err := o.membership(ctx, userID, organizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organization, err := o.users.userRepo.GetOrganization(ctx, member.OrganizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	organization.Role = member.Role
+
+	members, err := o.users.userRepo.ListOrganizationMembers(ctx, member.OrganizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return organization, members, nil
+}
+
+// InviteMember emails an invitation to join the organization with role.
+// Owners can invite any role and managers only agents. Inviting an address
+// again replaces its pending invitation.
+func (o *organizationUsecase) InviteMember(ctx context.Context, userID string, organizationID string, email string, role string) (*entity.OrganizationInvitation, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if err := val.ValidateEmail(email); err != nil {
+		return nil, err
+	}
+
+	parsedRole, err := rbac.ParseOrgRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	inviter, err := o.membership(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !rbac.OrgRole(inviter.Role).CanAssign(parsedRole) {
+		return nil, entity.ErrOrganizationRoleForbidden
+	}
+
+	organization, err := o.users.userRepo.GetOrganization(ctx, inviter.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := utils.GenerateURLSafeToken(organizationInvitationTokenBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+
+	invitation := &entity.OrganizationInvitation{
+		ID:               uuid.New(),
+		OrganizationID:   organization.ID,
+		OrganizationName: organization.Name,
+		Email:            email,
+		Role:             string(parsedRole),
+		TokenHash:        utils.HashToken(token),
+		InvitedBy:        &inviter.UserID,
+		ExpiresAt:        time.Now().Add(organizationInvitationTTL),
+	}
+	if err := o.users.userRepo.CreateOrganizationInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	o.users.notifyOrganizationInvitation(ctx, inviter.FullName, invitation, token, organizationInvitationTTL)
+
+	return invitation, nil
+}
+
+// ListInvitations returns the organization's unanswered invitations. Only
+// members who manage members can see them.
+func (o *organizationUsecase) ListInvitations(ctx context.Context, userID string, organizationID string) ([]*entity.OrganizationInvitation, error) {
+	member, err := o.membership(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !rbac.OrgRole(member.Role).CanManageMembers() {
+		return nil, entity.ErrOrganizationRoleForbidden
+	}
+
+	return o.users.userRepo.ListPendingOrganizationInvitations(ctx, member.OrganizationID)
+}
+
+// RevokeInvitation stops a pending invitation's link from working. Managers
+// can only revoke invitations of agents.
+func (o *organizationUsecase) RevokeInvitation(ctx context.Context, userID string, organizationID string, invitationID string) error {
+	member, err := o.membership(ctx, userID, organizationID)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(invitationID)
+	if err != nil {
+		return entity.ErrInvitationNotFound
+	}
+
+	invitation, err := o.users.userRepo.GetOrganizationInvitation(ctx, id)
+	if err != nil {
+		return err
+	}
+	if invitation.OrganizationID != member.OrganizationID {
+		return entity.ErrInvitationNotFound
+	}
+	if !rbac.OrgRole(member.Role).CanAssign(rbac.OrgRole(invitation.Role)) {
+		return entity.ErrOrganizationRoleForbidden
+	}
+
+	return o.users.userRepo.RespondToOrganizationInvitation(ctx, invitation.ID, entity.InvitationRevoked)
+}
+
+// AcceptInvitation adds the user to the organization of the invitation whose
+// link carried token. The invitation must have been sent to the user's email.
+func (o *organizationUsecase) AcceptInvitation(ctx context.Context, userID string, token string) (*entity.OrganizationMember, error) {
+	invitation, err := o.pendingInvitation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := o.users.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, entity.ErrInvitationEmailMismatch
+	}
+
+	member, err := o.users.userRepo.AcceptOrganizationInvitation(ctx, invitation, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	member.FullName = user.FullName
+
+	o.users.recordUserSuccess(ctx, entity.AuthEventOrganizationJoined, user.ID, user.Email, map[string]string{
+		"organization_id": invitation.OrganizationID.String(),
+		"role":            invitation.Role,
+	})
+
+	return member, nil
+}
+
+// DeclineInvitation turns down the invitation whose link carried token.
+// Holding the link is enough, so an address without an account can decline too.
+func (o *organizationUsecase) DeclineInvitation(ctx context.Context, token string) error {
+	invitation, err := o.pendingInvitation(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return o.users.userRepo.RespondToOrganizationInvitation(ctx, invitation.ID, entity.InvitationDeclined)
+}
+
+// UpdateMemberRole changes a member's role. Since managers can only assign the
+// agent role, in effect only owners change roles. The organization always
+// keeps an owner. The member's access tokens carry the new role from their
+// next refresh.
+func (o *organizationUsecase) UpdateMemberRole(ctx context.Context, userID string, organizationID string, memberID string, role string) error {
+	parsedRole, err := rbac.ParseOrgRole(role)
+	if err != nil {
+		return err
+	}
+
+	actor, target, err := o.manageableMember(ctx, userID, organizationID, memberID)
+	if err != nil {
+		return err
+	}
+	if !rbac.OrgRole(actor.Role).CanAssign(parsedRole) {
+		return entity.ErrOrganizationRoleForbidden
+	}
+
+	if target.Role == string(parsedRole) {
+		return nil
+	}
+	if err := o.keepOwner(ctx, target); err != nil {
+		return err
+	}
+
+	return o.users.userRepo.UpdateOrganizationMemberRole(ctx, target.OrganizationID, target.UserID, string(parsedRole))
+}
+
+// RemoveMember removes a member from the organization, or lets the user leave
+// it when memberID is their own ID. The organization always keeps an owner.
+func (o *organizationUsecase) RemoveMember(ctx context.Context, userID string, organizationID string, memberID string) error {
+	var target *entity.OrganizationMember
+	var err error
+	if userID == memberID {
+		target, err = o.membership(ctx, userID, organizationID)
+	} else {
+		_, target, err = o.manageableMember(ctx, userID, organizationID, memberID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := o.keepOwner(ctx, target); err != nil {
+		return err
+	}
+
+	if err := o.users.userRepo.RemoveOrganizationMember(ctx, target.OrganizationID, target.UserID); err != nil {
+		return err
+	}
+
+	actorID, _ := uuid.Parse(userID)
+	o.users.recordAuthEvent(ctx, &entity.AuthEvent{
+		EventType: entity.AuthEventOrganizationLeft,
+		Outcome:   entity.AuthEventSuccess,
+		ActorID:   &actorID,
+		UserID:    &target.UserID,
+		Email:     target.Email,
+		Metadata:  map[string]string{"organization_id": target.OrganizationID.String()},
+	})
+
+	return nil
+}
+
+// SwitchOrganization makes the user's session act for one of their
+// organizations, or for none when organizationID is empty, and returns an
+// access token carrying the organization and the user's role in it.
+func (o *organizationUsecase) SwitchOrganization(ctx context.Context, userID string, sessionID string, organizationID string) (string, time.Time, error) {
+	user, err := o.users.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	session, err := uuid.Parse(sessionID)
+	if err != nil {
+		return "", time.Time{}, entity.ErrSessionNotFound
+	}
+
+	var organization *uuid.UUID
+	if organizationID != "" {
+		member, err := o.membership(ctx, userID, organizationID)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		organization = &member.OrganizationID
+	}
+
+	if err := o.users.userRepo.SetSessionOrganization(ctx, session, user.ID, organization); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return o.users.userRepo.CreateToken(ctx, user.Email, userID, sessionID, user.Role)
+}
+
+// membership returns the user's membership of the organization, or
+// entity.ErrNotOrganizationMember.
+func (o *organizationUsecase) membership(ctx context.Context, userID string, organizationID string) (*entity.OrganizationMember, error) {
+	user, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidUserID, err)
+	}
+
+	organization, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, entity.ErrOrganizationNotFound
+	}
+
+	return o.users.userRepo.GetOrganizationMember(ctx, organization, user)
+}
+
+// manageableMember returns the user's membership and that of another member
+// they are allowed to manage given both roles.
+func (o *organizationUsecase) manageableMember(ctx context.Context, userID string, organizationID string, memberID string) (*entity.OrganizationMember, *entity.OrganizationMember, error) {
+	actor, err := o.membership(ctx, userID, organizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !rbac.OrgRole(actor.Role).CanManageMembers() {
+		return nil, nil, entity.ErrOrganizationRoleForbidden
+	}
+
+	target, err := o.membership(ctx, memberID, organizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !rbac.OrgRole(actor.Role).CanAssign(rbac.OrgRole(target.Role)) {
+		return nil, nil, entity.ErrOrganizationRoleForbidden
+	}
+
+	return actor, target, nil
+}
+
+// keepOwner refuses to take the owner role away from the organization's last owner.
+func (o *organizationUsecase) keepOwner(ctx context.Context, member *entity.OrganizationMember) error {
+	if member.Role != string(rbac.OrgRoleOwner) {
+		return nil
+	}
+
+	owners, err := o.users.userRepo.CountOrganizationOwners(ctx, member.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return entity.ErrLastOrganizationOwner
+	}
+
+	return nil
+}
+
+// pendingInvitation returns the invitation whose link carried token, or
+// entity.ErrInvitationInvalid if it was answered or has expired.
+func (o *organizationUsecase) pendingInvitation(ctx context.Context, token string) (*entity.OrganizationInvitation, error) {
+	if token == "" {
+		return nil, entity.ErrInvitationInvalid
+	}
+
+	invitation, err := o.users.userRepo.GetOrganizationInvitationByToken(ctx, utils.HashToken(token))
+	if err != nil {
+		if err == entity.ErrInvitationNotFound {
+			return nil, entity.ErrInvitationInvalid
+		}
+		return nil, err
+	}
+	if !invitation.IsPending() {
+		return nil, entity.ErrInvitationInvalid
+	}
+
+	return invitation, nil
+}
@@ -0,0 +1,312 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/shared/rbac"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOrganization(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewOrganizationUsecase(mockRepo, mailer.NewMemoryMailer())
+	ctx := context.Background()
+
+	user := &entity.User{ID: uuid.New(), Email: "owner@example.com"}
+	var event *entity.AuthEvent
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("CreateOrganization", ctx, mock.AnythingOfType("*entity.Organization"), string(rbac.OrgRoleOwner)).Return(nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+		Run(func(args mock.Arguments) { event = args.Get(1).(*entity.AuthEvent) }).
+		Return(nil)
+
+	// Execute test
+	organization, err := useCase.CreateOrganization(ctx, user.ID.String(), "  Lagos Homes ")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, "Lagos Homes", organization.Name)
+	require.Equal(t, user.ID, *organization.CreatedBy)
+	require.Equal(t, entity.AuthEventOrganizationJoined, event.EventType)
+	require.Equal(t, organization.ID.String(), event.Metadata["organization_id"])
+}
+
+func TestInviteMember(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewOrganizationUsecase(mockRepo, mockMailer)
+	ctx := context.Background()
+
+	organization := &entity.Organization{ID: uuid.New(), Name: "Lagos Homes"}
+	manager := &entity.OrganizationMember{OrganizationID: organization.ID, UserID: uuid.New(), FullName: "Ada Manager", Role: string(rbac.OrgRoleManager)}
+	var stored *entity.OrganizationInvitation
+
+	// Mock behavior
+	mockRepo.On("GetOrganizationMember", ctx, organization.ID, manager.UserID).Return(manager, nil)
+	mockRepo.On("GetOrganization", ctx, organization.ID).Return(organization, nil)
+	mockRepo.On("CreateOrganizationInvitation", ctx, mock.AnythingOfType("*entity.OrganizationInvitation")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*entity.OrganizationInvitation) }).
+		Return(nil)
+
+	// Execute test
+	invitation, err := useCase.InviteMember(ctx, manager.UserID.String(), organization.ID.String(), " Agent@Example.com", "agent")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, "agent@example.com", invitation.Email)
+	require.Equal(t, manager.UserID, *stored.InvitedBy)
+	require.WithinDuration(t, time.Now().Add(organizationInvitationTTL), stored.ExpiresAt, time.Minute)
+
+	// Only the hash of the emailed token is stored
+	msg := mockMailer.Last()
+	require.Equal(t, "agent@example.com", msg.To)
+	require.Contains(t, msg.TextBody, "Ada Manager")
+	match := regexp.MustCompile(`token=([A-Za-z0-9_-]+)`).FindStringSubmatch(msg.TextBody)
+	require.Len(t, match, 2)
+	require.Equal(t, utils.HashToken(match[1]), stored.TokenHash)
+}
+
+func TestInviteMemberForbidden(t *testing.T) {
+	organizationID := uuid.New()
+
+	testCases := []struct {
+		name        string
+		inviterRole rbac.OrgRole
+		role        string
+	}{
+		{name: "AgentInvites", inviterRole: rbac.OrgRoleAgent, role: "agent"},
+		{name: "ManagerInvitesManager", inviterRole: rbac.OrgRoleManager, role: "manager"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+			mockMailer := mailer.NewMemoryMailer()
+
+			useCase := NewOrganizationUsecase(mockRepo, mockMailer)
+			ctx := context.Background()
+
+			inviter := &entity.OrganizationMember{OrganizationID: organizationID, UserID: uuid.New(), Role: string(tc.inviterRole)}
+
+			// Mock behavior
+			mockRepo.On("GetOrganizationMember", ctx, organizationID, inviter.UserID).Return(inviter, nil)
+
+			// Execute test
+			_, err := useCase.InviteMember(ctx, inviter.UserID.String(), organizationID.String(), "agent@example.com", tc.role)
+
+			// Assertions
+			require.ErrorIs(t, err, entity.ErrOrganizationRoleForbidden)
+			require.Nil(t, mockMailer.Last())
+			mockRepo.AssertNotCalled(t, "CreateOrganizationInvitation", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewOrganizationUsecase(mockRepo, mailer.NewMemoryMailer())
+	ctx := context.Background()
+
+	user := &entity.User{ID: uuid.New(), Email: "agent@example.com", FullName: "Test Agent"}
+	invitation := &entity.OrganizationInvitation{
+		ID:             uuid.New(),
+		OrganizationID: uuid.New(),
+		Email:          "Agent@example.com",
+		Role:           string(rbac.OrgRoleAgent),
+		Status:         entity.InvitationPending,
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+	member := &entity.OrganizationMember{OrganizationID: invitation.OrganizationID, UserID: user.ID, Role: invitation.Role}
+
+	// Mock behavior
+	mockRepo.On("GetOrganizationInvitationByToken", ctx, utils.HashToken("invite-token")).Return(invitation, nil)
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("AcceptOrganizationInvitation", ctx, invitation, user.ID).Return(member, nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+
+	// Execute test
+	joined, err := useCase.AcceptInvitation(ctx, user.ID.String(), "invite-token")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, string(rbac.OrgRoleAgent), joined.Role)
+	require.Equal(t, "Test Agent", joined.FullName)
+}
+
+func TestAcceptInvitationErrors(t *testing.T) {
+	user := &entity.User{ID: uuid.New(), Email: "agent@example.com"}
+
+	testCases := []struct {
+		name       string
+		invitation *entity.OrganizationInvitation
+		expected   error
+	}{
+		{
+			name:       "OtherEmail",
+			invitation: &entity.OrganizationInvitation{Email: "someone@example.com", Status: entity.InvitationPending, ExpiresAt: time.Now().Add(time.Hour)},
+			expected:   entity.ErrInvitationEmailMismatch,
+		},
+		{
+			name:       "Expired",
+			invitation: &entity.OrganizationInvitation{Email: user.Email, Status: entity.InvitationPending, ExpiresAt: time.Now().Add(-time.Minute)},
+			expected:   entity.ErrInvitationInvalid,
+		},
+		{
+			name:       "Declined",
+			invitation: &entity.OrganizationInvitation{Email: user.Email, Status: entity.InvitationDeclined, ExpiresAt: time.Now().Add(time.Hour)},
+			expected:   entity.ErrInvitationInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+
+			useCase := NewOrganizationUsecase(mockRepo, mailer.NewMemoryMailer())
+			ctx := context.Background()
+
+			// Mock behavior
+			mockRepo.On("GetOrganizationInvitationByToken", ctx, utils.HashToken("invite-token")).Return(tc.invitation, nil)
+			mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+
+			// Execute test
+			_, err := useCase.AcceptInvitation(ctx, user.ID.String(), "invite-token")
+
+			// Assertions
+			require.ErrorIs(t, err, tc.expected)
+			mockRepo.AssertNotCalled(t, "AcceptOrganizationInvitation", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUpdateMemberRoleKeepsLastOwner(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewOrganizationUsecase(mockRepo, mailer.NewMemoryMailer())
+	ctx := context.Background()
+
+	owner := &entity.OrganizationMember{OrganizationID: uuid.New(), UserID: uuid.New(), Role: string(rbac.OrgRoleOwner)}
+
+	// Mock behavior
+	mockRepo.On("GetOrganizationMember", ctx, owner.OrganizationID, owner.UserID).Return(owner, nil)
+	mockRepo.On("CountOrganizationOwners", ctx, owner.OrganizationID).Return(int64(1), nil)
+
+	// Execute test
+	err := useCase.UpdateMemberRole(ctx, owner.UserID.String(), owner.OrganizationID.String(), owner.UserID.String(), "manager")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrLastOrganizationOwner)
+	mockRepo.AssertNotCalled(t, "UpdateOrganizationMemberRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRemoveMember(t *testing.T) {
+	organizationID := uuid.New()
+	manager := &entity.OrganizationMember{OrganizationID: organizationID, UserID: uuid.New(), Role: string(rbac.OrgRoleManager)}
+	agent := &entity.OrganizationMember{OrganizationID: organizationID, UserID: uuid.New(), Email: "agent@example.com", Role: string(rbac.OrgRoleAgent)}
+	owner := &entity.OrganizationMember{OrganizationID: organizationID, UserID: uuid.New(), Role: string(rbac.OrgRoleOwner)}
+
+	testCases := []struct {
+		name     string
+		actor    *entity.OrganizationMember
+		target   *entity.OrganizationMember
+		expected error
+	}{
+		{name: "ManagerRemovesAgent", actor: manager, target: agent},
+		{name: "AgentLeaves", actor: agent, target: agent},
+		{name: "ManagerRemovesOwner", actor: manager, target: owner, expected: entity.ErrOrganizationRoleForbidden},
+		{name: "AgentRemovesManager", actor: agent, target: manager, expected: entity.ErrOrganizationRoleForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+
+			useCase := NewOrganizationUsecase(mockRepo, mailer.NewMemoryMailer())
+			ctx := context.Background()
+			var event *entity.AuthEvent
+
+			// Mock behavior
+			mockRepo.On("GetOrganizationMember", ctx, organizationID, tc.actor.UserID).Return(tc.actor, nil)
+			mockRepo.On("GetOrganizationMember", ctx, organizationID, tc.target.UserID).Return(tc.target, nil)
+			mockRepo.On("RemoveOrganizationMember", ctx, organizationID, tc.target.UserID).Return(nil)
+			mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).
+				Run(func(args mock.Arguments) { event = args.Get(1).(*entity.AuthEvent) }).
+				Return(nil)
+
+			// Execute test
+			err := useCase.RemoveMember(ctx, tc.actor.UserID.String(), organizationID.String(), tc.target.UserID.String())
+
+			// Assertions
+			if tc.expected != nil {
+				require.ErrorIs(t, err, tc.expected)
+				mockRepo.AssertNotCalled(t, "RemoveOrganizationMember", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, entity.AuthEventOrganizationLeft, event.EventType)
+			require.Equal(t, tc.actor.UserID, *event.ActorID)
+			require.Equal(t, tc.target.UserID, *event.UserID)
+		})
+	}
+}
+
+func TestSwitchOrganization(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewOrganizationUsecase(mockRepo, mailer.NewMemoryMailer())
+	ctx := context.Background()
+
+	user := &entity.User{ID: uuid.New(), Email: "agent@example.com", Role: string(rbac.RoleAgent)}
+	member := &entity.OrganizationMember{OrganizationID: uuid.New(), UserID: user.ID, Role: string(rbac.OrgRoleAgent)}
+	sessionID := uuid.New()
+	expiresAt := time.Now().Add(15 * time.Minute)
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("GetOrganizationMember", ctx, member.OrganizationID, user.ID).Return(member, nil)
+	mockRepo.On("SetSessionOrganization", ctx, sessionID, user.ID, &member.OrganizationID).Return(nil)
+	mockRepo.On("CreateToken", ctx, user.Email).Return("access-token", expiresAt, nil)
+
+	// Execute test
+	accessToken, expiry, err := useCase.SwitchOrganization(ctx, user.ID.String(), sessionID.String(), member.OrganizationID.String())
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, "access-token", accessToken)
+	require.Equal(t, expiresAt, expiry)
+}
+
+func TestSwitchOrganizationNotMember(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewOrganizationUsecase(mockRepo, mailer.NewMemoryMailer())
+	ctx := context.Background()
+
+	user := &entity.User{ID: uuid.New()}
+	organizationID := uuid.New()
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("GetOrganizationMember", ctx, organizationID, user.ID).Return(nil, entity.ErrNotOrganizationMember)
+
+	// Execute test
+	_, _, err := useCase.SwitchOrganization(ctx, user.ID.String(), uuid.New().String(), organizationID.String())
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrNotOrganizationMember)
+	mockRepo.AssertNotCalled(t, "SetSessionOrganization", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
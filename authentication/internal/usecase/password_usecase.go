@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/authentication/pkg/val"
+)
+
+// checkNewPassword validates a password the user is switching to against the
+// password policy and rejects it if it matches one of their recent passwords.
+func (u *userUsecase) checkNewPassword(ctx context.Context, user *entity.User, password string) error {
+	policy := val.CurrentPasswordPolicy()
+	if err := policy.Validate(password, user.Email, user.FullName); err != nil {
+		return err
+	}
+
+	if policy.HistorySize <= 0 {
+		return nil
+	}
+
+	// The current password counts towards the history size
+	if user.Password != "" && utils.CheckPassword(password, user.Password) == nil {
+		return entity.ErrPasswordReused
+	}
+
+	if policy.HistorySize == 1 {
+		return nil
+	}
+
+	history, err := u.userRepo.ListPasswordHistory(ctx, user.ID, policy.HistorySize-1)
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range history {
+		if utils.CheckPassword(password, hash) == nil {
+			return entity.ErrPasswordReused
+		}
+	}
+
+	return nil
+}
+
+// replacePassword stores a new password hash for the user, moving the current
+// one into their password history.
+func (u *userUsecase) replacePassword(ctx context.Context, user *entity.User, hashedPassword string) error {
+	if keep := val.CurrentPasswordPolicy().HistorySize - 1; keep > 0 && user.Password != "" {
+		if err := u.userRepo.AddPasswordHistory(ctx, user.ID, user.Password, keep); err != nil {
+			return err
+		}
+	}
+
+	if err := u.userRepo.UpdatePassword(ctx, user.Email, hashedPassword); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	user.Password = hashedPassword
+
+	return nil
+}
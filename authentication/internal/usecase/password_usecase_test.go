@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/authentication/pkg/val"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangePasswordRejectsRecentPassword(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	currentPassword := "current-pass-1"
+	previousPassword := "previous-pass-1"
+	email := "test@example.com"
+	currentHash, _ := utils.HashPassword(currentPassword)
+	previousHash, _ := utils.HashPassword(previousPassword)
+
+	mockUser := &entity.User{
+		ID:            uuid.New(),
+		Email:         email,
+		Password:      currentHash,
+		EmailVerified: true,
+	}
+
+	// Mock behavior
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("ListPasswordHistory", ctx, mockUser.ID, val.DefaultPasswordPolicy.HistorySize-1).Return([]string{previousHash}, nil)
+
+	// Execute test
+	err := useCase.ChangePassword(ctx, currentPassword, previousPassword, mockUser.ID.String())
+	require.ErrorIs(t, err, entity.ErrPasswordReused)
+
+	err = useCase.ChangePassword(ctx, currentPassword, currentPassword, mockUser.ID.String())
+	require.ErrorIs(t, err, entity.ErrPasswordReused)
+
+	mockRepo.AssertNotCalled(t, "UpdatePassword", ctx, email, mock.Anything)
+}
+
+func TestChangePasswordEnforcesPolicy(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	currentPassword := "current-pass-1"
+	email := "jane.doe@example.com"
+	currentHash, _ := utils.HashPassword(currentPassword)
+
+	mockUser := &entity.User{
+		ID:       uuid.New(),
+		Email:    email,
+		FullName: "Jane Doe",
+		Password: currentHash,
+	}
+
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+
+	testCases := []struct {
+		name     string
+		password string
+		err      error
+	}{
+		{name: "TooShort", password: "ab1", err: val.ErrPasswordTooShort},
+		{name: "SingleClass", password: "abcdefghijk", err: val.ErrPasswordCharacterClass},
+		{name: "Repeats", password: "aaaa1234bcd", err: val.ErrPasswordRepeats},
+		{name: "ContainsName", password: "janeRocks123", err: val.ErrPasswordContainsUser},
+		{name: "ContainsEmail", password: "example2024!", err: val.ErrPasswordContainsUser},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := useCase.ChangePassword(ctx, currentPassword, tc.password, mockUser.ID.String())
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	mockRepo.AssertNotCalled(t, "UpdatePassword", ctx, email, mock.Anything)
+}
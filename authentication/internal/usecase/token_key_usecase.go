@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+)
+
+// GetTokenKeys returns the public keys other services verify access tokens with.
+func (u *userUsecase) GetTokenKeys(ctx context.Context) ([]*entity.TokenKey, error) {
+	return u.userRepo.ListTokenKeys(ctx)
+}
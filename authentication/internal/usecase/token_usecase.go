@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// IssueTokens creates a short-lived access token and starts a new refresh token family for the session.
+func (u *userUsecase) IssueTokens(ctx context.Context, user *entity.User, sessionID uuid.UUID) (*entity.TokenPair, error) {
+	accessToken, accessExpiresAt, err := u.userRepo.CreateToken(ctx, user.Email, user.ID.String(), sessionID.String(), user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token for email %s: %w", user.Email, err)
+	}
+
+	refreshToken, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	stored := &entity.RefreshToken{
+		ID:        uuid.New(),
+		SessionID: sessionID,
+		UserID:    user.ID,
+		FamilyID:  uuid.New(),
+		TokenHash: utils.HashToken(refreshToken),
+	}
+
+	if err := u.userRepo.CreateRefreshToken(ctx, stored); err != nil {
+		return nil, err
+	}
+
+	return &entity.TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: stored.ExpiresAt,
+	}, nil
+}
+
+// RefreshToken exchanges a refresh token for a new token pair. Every refresh
+// token can only be used once; presenting one that was already exchanged
+// revokes its whole family, logging out both the attacker and the victim.
+func (u *userUsecase) RefreshToken(ctx context.Context, refreshToken string) (*entity.User, *entity.TokenPair, error) {
+	current, err := u.userRepo.GetRefreshTokenByHash(ctx, utils.HashToken(refreshToken))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if current.RevokedAt != nil || time.Now().After(current.ExpiresAt) {
+		return nil, nil, entity.ErrInvalidRefreshToken
+	}
+
+	if current.UsedAt != nil {
+		return nil, nil, u.revokeRefreshTokenFamily(ctx, current)
+	}
+
+	user, err := u.userRepo.GetUserByID(ctx, current.UserID.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve user: %w", err)
+	}
+
+	if err := user.CanSignIn(); err != nil {
+		return nil, nil, err
+	}
+
+	// Only signing in cancels a scheduled deletion, so a refresh cannot keep
+	// the account alive
+	if user.DeletionScheduledFor != nil {
+		return nil, nil, entity.ErrDeletionPending
+	}
+
+	nextToken, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next := &entity.RefreshToken{
+		ID:        uuid.New(),
+		SessionID: current.SessionID,
+		UserID:    current.UserID,
+		FamilyID:  current.FamilyID,
+		ParentID:  &current.ID,
+		TokenHash: utils.HashToken(nextToken),
+	}
+
+	if err := u.userRepo.RotateRefreshToken(ctx, current.ID, next); err != nil {
+		// Lost a race with another exchange of the same token
+		if errors.Is(err, entity.ErrRefreshTokenReused) {
+			return nil, nil, u.revokeRefreshTokenFamily(ctx, current)
+		}
+		return nil, nil, err
+	}
+
+	accessToken, accessExpiresAt, err := u.userRepo.CreateToken(ctx, user.Email, user.ID.String(), current.SessionID.String(), user.Role)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate token for email %s: %w", user.Email, err)
+	}
+
+	return user, &entity.TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          nextToken,
+		RefreshTokenExpiresAt: next.ExpiresAt,
+	}, nil
+}
+
+// revokeRefreshTokenFamily revokes a token family after reuse was detected, along
+// with the access tokens of its session, and reports the reuse.
+func (u *userUsecase) revokeRefreshTokenFamily(ctx context.Context, reused *entity.RefreshToken) error {
+	if err := u.userRepo.RevokeRefreshTokenFamily(ctx, reused.FamilyID); err != nil {
+		return err
+	}
+
+	if err := u.userRepo.RevokeSessionTokens(ctx, reused.UserID, reused.SessionID, entity.SessionRevokedTokenReuse); err != nil {
+		return err
+	}
+
+	return entity.ErrRefreshTokenReused
+}
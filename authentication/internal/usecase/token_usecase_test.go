@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueTokens(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com"}
+	sessionID := uuid.New()
+
+	// Mock behavior
+	mockRepo.On("CreateToken", ctx, user.Email).Return("access-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateRefreshToken", ctx, mock.MatchedBy(func(token *entity.RefreshToken) bool {
+		return token.SessionID == sessionID && token.UserID == user.ID && token.ParentID == nil
+	})).Return(nil)
+
+	// Execute test
+	tokens, err := useCase.IssueTokens(ctx, user, sessionID)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, "access-token", tokens.AccessToken)
+	require.NotEmpty(t, tokens.RefreshToken)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRefreshTokenRotates(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	refreshToken := "current-refresh-token"
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com"}
+	current := &entity.RefreshToken{
+		ID:        uuid.New(),
+		SessionID: uuid.New(),
+		UserID:    user.ID,
+		FamilyID:  uuid.New(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	// Mock behavior
+	mockRepo.On("GetRefreshTokenByHash", ctx, utils.HashToken(refreshToken)).Return(current, nil)
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("RotateRefreshToken", ctx, current.ID, mock.MatchedBy(func(next *entity.RefreshToken) bool {
+		return next.FamilyID == current.FamilyID && next.ParentID != nil && *next.ParentID == current.ID
+	})).Return(nil)
+	mockRepo.On("CreateToken", ctx, user.Email).Return("access-token", time.Now().Add(15*time.Minute), nil)
+
+	// Execute test
+	refreshedUser, tokens, err := useCase.RefreshToken(ctx, refreshToken)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, user.ID, refreshedUser.ID)
+	require.NotEqual(t, refreshToken, tokens.RefreshToken)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRefreshTokenPendingDeletion(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	refreshToken := "current-refresh-token"
+	scheduledFor := time.Now().Add(accountDeletionGracePeriod)
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com", DeletionScheduledFor: &scheduledFor}
+	current := &entity.RefreshToken{
+		ID:        uuid.New(),
+		SessionID: uuid.New(),
+		UserID:    user.ID,
+		FamilyID:  uuid.New(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	// Mock behavior
+	mockRepo.On("GetRefreshTokenByHash", ctx, utils.HashToken(refreshToken)).Return(current, nil)
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+
+	// Execute test
+	_, _, err := useCase.RefreshToken(ctx, refreshToken)
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrDeletionPending)
+	mockRepo.AssertNotCalled(t, "RotateRefreshToken", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "CancelUserDeletion", mock.Anything, mock.Anything)
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	refreshToken := "used-refresh-token"
+	usedAt := time.Now().Add(-time.Minute)
+	current := &entity.RefreshToken{
+		ID:        uuid.New(),
+		SessionID: uuid.New(),
+		UserID:    uuid.New(),
+		FamilyID:  uuid.New(),
+		ExpiresAt: time.Now().Add(time.Hour),
+		UsedAt:    &usedAt,
+	}
+
+	// Mock behavior
+	mockRepo.On("GetRefreshTokenByHash", ctx, utils.HashToken(refreshToken)).Return(current, nil)
+	mockRepo.On("RevokeRefreshTokenFamily", ctx, current.FamilyID).Return(nil)
+	mockRepo.On("RevokeSessionTokens", ctx, current.UserID, current.SessionID, entity.SessionRevokedTokenReuse).Return(nil)
+
+	// Execute test
+	_, _, err := useCase.RefreshToken(ctx, refreshToken)
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrRefreshTokenReused)
+	mockRepo.AssertCalled(t, "RevokeRefreshTokenFamily", ctx, current.FamilyID)
+	mockRepo.AssertCalled(t, "RevokeSessionTokens", ctx, current.UserID, current.SessionID, entity.SessionRevokedTokenReuse)
+	mockRepo.AssertNotCalled(t, "RotateRefreshToken", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRefreshTokenExpired(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	refreshToken := "expired-refresh-token"
+
+	// Mock behavior
+	mockRepo.On("GetRefreshTokenByHash", ctx, utils.HashToken(refreshToken)).Return(&entity.RefreshToken{
+		ID:        uuid.New(),
+		FamilyID:  uuid.New(),
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}, nil)
+
+	// Execute test
+	_, _, err := useCase.RefreshToken(ctx, refreshToken)
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrInvalidRefreshToken)
+}
@@ -5,125 +5,79 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
 	"time"
 
 	"github.com/demola234/authentication/internal/domain/entity"
 	"github.com/demola234/authentication/internal/domain/repository"
 	"github.com/demola234/authentication/pkg/utils"
 	"github.com/demola234/authentication/pkg/val"
+	"github.com/demola234/shared/rbac"
 
 	"github.com/google/uuid"
 )
 
 var (
 	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrRoleNotAllowed    = errors.New("role cannot be self-assigned")
 )
 
 // UserUsecase defines the interface for user-related business logic.
 type UserUsecase interface {
 	RegisterUser(ctx context.Context, fullName string, password string, email string, role string, phone string) (*entity.User, *entity.Session, error)
-	LoginUser(ctx context.Context, password, email string) (*entity.User, error)
-	ChangePassword(ctx context.Context, currentPassword, newPassword, id string) error
+	LoginUser(ctx context.Context, password, email string) (*entity.User, *entity.Session, *entity.MFAChallenge, error)
+	ChangePassword(ctx context.Context, currentPassword, newPassword, userID string) error
 	GetSession(ctx context.Context, id string) (*entity.Session, error)
-	GenerateToken(ctx context.Context, email string, userID string) (string, error)
+	GenerateToken(ctx context.Context, email string, userID string, sessionID string, role string) (string, error)
+	IssueTokens(ctx context.Context, user *entity.User, sessionID uuid.UUID) (*entity.TokenPair, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*entity.User, *entity.TokenPair, error)
 	ResendOtp(ctx context.Context, email string) error
 	GetUser(ctx context.Context, userId string) (*entity.User, error)
-	LogOut(ctx context.Context, userId string) error
-	VerifyOtp(ctx context.Context, email string, otp string) (bool, error)
+	LogOut(ctx context.Context, userId string, sessionId string) error
+	VerifyOtp(ctx context.Context, email string, otp string) (*entity.User, *entity.Session, error)
 	RegisterWithOAuth(ctx context.Context, provider, token string) (*entity.User, *entity.Session, error)
-	LoginWithOAuth(ctx context.Context, provider, token string) (*entity.User, *entity.Session, error)
+	LoginWithOAuth(ctx context.Context, provider, token string) (*entity.User, *entity.Session, *entity.MFAChallenge, error)
+	LinkIdentity(ctx context.Context, userID string, provider, token string) (*entity.UserIdentity, error)
+	ListIdentities(ctx context.Context, userID string) ([]*entity.UserIdentity, error)
+	UnlinkIdentity(ctx context.Context, userID string, identityID string) error
+	StartOAuth(ctx context.Context, provider string) (*entity.OAuthAuthorization, error)
+	CompleteOAuth(ctx context.Context, provider string, code string, state string) (*entity.User, *entity.Session, *entity.MFAChallenge, error)
+	RequestMagicLink(ctx context.Context, email string) error
+	ConsumeMagicLink(ctx context.Context, token string) (*entity.User, *entity.Session, *entity.MFAChallenge, error)
+	GetTokenKeys(ctx context.Context) ([]*entity.TokenKey, error)
 	UppdateProfileImage(ctx context.Context, content io.Reader, userId uuid.UUID) (string, error)
 	ForgetPassword(ctx context.Context, email string) error
-	ResetPassword(ctx context.Context, token string, newPassword string) error
-	VerifyResetPassword(ctx context.Context, email string, otp string) error
+	ResetPassword(ctx context.Context, resetToken string, newPassword string) error
+	VerifyResetPassword(ctx context.Context, email string, otp string) (string, error)
 	GetUserProfile(ctx context.Context, userID string) (*entity.UserProfile, error)
 	UpdateUserProfile(ctx context.Context, profile *entity.UserProfile, userID string) (*entity.UserProfile, error)
 	GetSessions(ctx context.Context, userID string) ([]*entity.Session, error)
 	RevokeSession(ctx context.Context, sessionID string, userID string) error
 	DeactivateAccount(ctx context.Context, password string, userID string) error
-	DeleteAccount(ctx context.Context, password string, userID string) error
+	DeleteAccount(ctx context.Context, password string, userID string) (time.Time, error)
 	GetLoginHistory(ctx context.Context, userID string, limit int) ([]*entity.LoginHistoryEntry, error)
+	EnrollMFA(ctx context.Context, userID string) (*entity.MFAEnrollment, error)
+	ConfirmMFA(ctx context.Context, userID string, code string) ([]string, error)
+	VerifyMFA(ctx context.Context, challengeID string, code string) (*entity.User, *entity.Session, error)
+	DisableMFA(ctx context.Context, userID string, password string, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userID string, code string) ([]string, error)
+	UnlockAccount(ctx context.Context, userID string) error
+	RequestEmailChange(ctx context.Context, userID string, newEmail string) error
+	ConfirmEmailChange(ctx context.Context, userID string, code string) (*entity.User, error)
+	RevertEmailChange(ctx context.Context, token string) error
 }
 
 // userUsecase implements the UserUsecase interface.
 type userUsecase struct {
-	userRepo  repository.UserRepository
-	oauthRepo repository.OAuthRepository
-}
-
-// RegisterWithOAuth implements UserUsecase.
-func (u *userUsecase) RegisterWithOAuth(ctx context.Context, provider string, token string) (*entity.User, *entity.Session, error) {
-	userInfo, err := u.oauthRepo.ValidateProviderToken(ctx, provider, token)
-	userID := uuid.New()
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to validate OAuth token: %w", err)
-	}
-
-	// Check if the user already exists
-	existingUser, err := u.userRepo.GetUserByEmail(ctx, userInfo.Email)
-	if err == nil && existingUser != nil {
-		currentProvider, _ := existingUser.Provider.GetProviderInfo()
-		if currentProvider != provider {
-			// Update to new provider
-			existingUser.Provider.SetProvider(provider, userInfo.ID)
-			existingUser.ProviderID = userInfo.ID
-
-		}
-	}
-
-	metaData := utils.ExtractMetaData(ctx)
-
-	session := &entity.Session{
-		SessionID:    uuid.New(),
-		UserID:       userID,
-		Token:        token,
-		CreatedAt:    time.Now().UTC(),
-		ExpiresAt:    time.Now().Add(24 * time.Hour).UTC(),
-		LastActivity: time.Now().UTC(),
-		IpAddress:    metaData.ClientIP,
-		UserAgent:    metaData.UserAgent,
-		IsActive:     true,
-		OTPVerified:  true,
-		OtpExpiresAt: time.Now().Add(5 * time.Minute),
-	}
-
-	userDetails := &entity.User{
-		FullName: userInfo.Name,
-		Email:    userInfo.Email,
-		Phone:    "",
-		Role:     "user",
-		ID:       userID,
-		Bio:      "",
-		Provider: utils.ProviderType{
-			Name:      provider,
-			ID:        userInfo.ID,
-			TokenData: provider,
-		},
-		ProviderID:     userInfo.ID,
-		Username:       userInfo.Name,
-		ProfilePicture: userInfo.Picture,
-		EmailVerified:  userInfo.EmailVerified,
-		IsActive:       true,
-		LastLogin:      time.Now().UTC(),
-		UpdatedAt:      time.Now().UTC(),
-	}
-
-	// Save user in the repository
-	if err := u.userRepo.CreateUser(ctx, userDetails); err != nil {
-		return nil, nil, fmt.Errorf("failed to create user: %w", err)
-	}
-
-	// Save session in the repository
-	if err := u.userRepo.CreateSession(ctx, session); err != nil {
-		return nil, nil, fmt.Errorf("failed to create session: %w", err)
-	}
-
-	return existingUser, session, nil
+	userRepo     repository.UserRepository
+	oauthRepo    repository.OAuthRepository
+	mailer       repository.Mailer
+	messageQueue repository.MessageQueue
 }
 
 // NewUserUsecase creates a new instance of userUsecase.
-func NewUserUsecase(userRepo repository.UserRepository, oauthRepo repository.OAuthRepository) UserUsecase {
-	return &userUsecase{userRepo: userRepo, oauthRepo: oauthRepo}
+func NewUserUsecase(userRepo repository.UserRepository, oauthRepo repository.OAuthRepository, mailer repository.Mailer, messageQueue repository.MessageQueue) UserUsecase {
+	return &userUsecase{userRepo: userRepo, oauthRepo: oauthRepo, mailer: mailer, messageQueue: messageQueue}
 }
 
 func (u *userUsecase) UppdateProfileImage(ctx context.Context, content io.Reader, userId uuid.UUID) (string, error) {
@@ -137,8 +91,8 @@ func (u *userUsecase) UppdateProfileImage(ctx context.Context, content io.Reader
 }
 
 // GenerateToken implements UserUsecase.
-func (u *userUsecase) GenerateToken(ctx context.Context, email string, userID string) (string, error) {
-	token, err := u.userRepo.CreateToken(ctx, email, userID)
+func (u *userUsecase) GenerateToken(ctx context.Context, email string, userID string, sessionID string, role string) (string, error) {
+	token, _, err := u.userRepo.CreateToken(ctx, email, userID, sessionID, role)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate token for email %s: %w", email, err)
 	}
@@ -155,8 +109,25 @@ func (u *userUsecase) RegisterUser(ctx context.Context, fullName, password, emai
 		return nil, nil, fmt.Errorf("user with email %s already exists", email)
 	}
 
+	// Only non-privileged roles can be picked at registration
+	userRole := rbac.DefaultRole
+	if role != "" {
+		userRole, err = rbac.ParseRole(role)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !userRole.IsSelfAssignable() {
+			return nil, nil, ErrRoleNotAllowed
+		}
+	}
+
+	if err := val.ValidatePassword(password, email, fullName); err != nil {
+		return nil, nil, err
+	}
+
 	// Generate a new token for the session
-	token, err := u.userRepo.CreateToken(ctx, email, userID.String())
+	sessionID := uuid.New()
+	token, _, err := u.userRepo.CreateToken(ctx, email, userID.String(), sessionID.String(), string(userRole))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to generate token for email %s: %w", email, err)
 	}
@@ -167,7 +138,7 @@ func (u *userUsecase) RegisterUser(ctx context.Context, fullName, password, emai
 		Email:    email,
 		FullName: fullName,
 		Password: password,
-		Role:     role,
+		Role:     string(userRole),
 		Phone:    phone,
 	}
 
@@ -176,7 +147,7 @@ func (u *userUsecase) RegisterUser(ctx context.Context, fullName, password, emai
 
 	// Create a new session entity with a unique ID and expiration time
 	session := &entity.Session{
-		SessionID:    uuid.New(),
+		SessionID:    sessionID,
 		UserID:       user.ID,
 		Token:        token,
 		CreatedAt:    time.Now().UTC(),
@@ -185,10 +156,6 @@ func (u *userUsecase) RegisterUser(ctx context.Context, fullName, password, emai
 		IpAddress:    metaData.ClientIP,
 		UserAgent:    metaData.UserAgent,
 		IsActive:     true,
-		Otp:          utils.RandomOtp(),
-		OTPVerified:  false,
-		OtpExpiresAt: time.Now().Add(5 * time.Minute),
-		OtpAttempts:  0,
 	}
 
 	// Save user in the repository
@@ -201,39 +168,132 @@ func (u *userUsecase) RegisterUser(ctx context.Context, fullName, password, emai
 		return nil, nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
+	// Email the verification code to the new user
+	otp, err := u.issueVerificationCode(ctx, user.ID, entity.VerificationPurposeSignup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := u.sendVerificationEmail(ctx, user, otp, verificationCodeTTL); err != nil {
+		return nil, nil, err
+	}
+
 	return user, session, nil
 }
 
-// LoginUser authenticates a user by email and password.
-func (u *userUsecase) LoginUser(ctx context.Context, password string, email string) (*entity.User, error) {
+// LoginUser authenticates a user by email and password. When the user has MFA
+// enabled, a second factor challenge is returned instead of completing the login.
+// Repeated failures lock the account and the caller's IP out with an entity.LockoutError.
+// Every completed login starts a session of its own.
+func (u *userUsecase) LoginUser(ctx context.Context, password string, email string) (*entity.User, *entity.Session, *entity.MFAChallenge, error) {
+	// Reject the attempt outright while the account or IP is locked out
+	rules := throttleRules(ctx, entity.ThrottleScopeLogin, email)
+	if err := u.checkLockout(ctx, rules); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventLogin, nil, email, authFailureReason(err))
+		return nil, nil, nil, err
+	}
+
 	// Retrieve user by email
 	user, err := u.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve user: %w", err)
+		u.recordUserFailure(ctx, entity.AuthEventLogin, nil, email, "unknown_email")
+		if lockErr := u.recordFailure(ctx, rules, nil); lockErr != nil {
+			return nil, nil, nil, lockErr
+		}
+		return nil, nil, nil, fmt.Errorf("failed to retrieve user: %w", err)
 	}
 
 	// Check if the provided password matches the stored hash
 	err = utils.CheckPassword(password, user.Password)
 	if err != nil {
-		return nil, err
+		u.recordUserFailure(ctx, entity.AuthEventLogin, &user.ID, email, "invalid_password")
+		if lockErr := u.recordFailure(ctx, rules, &user.ID); lockErr != nil {
+			return nil, nil, nil, lockErr
+		}
+		return nil, nil, nil, err
 	}
 
-	return user, nil
+	// Only tell the caller the account is locked or deleted once they proved they own it
+	if err := user.CanSignIn(); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventLogin, &user.ID, email, authFailureReason(err))
+		return nil, nil, nil, err
+	}
+
+	u.rehashPassword(ctx, user, password)
+
+	// Require a second factor if the user has confirmed MFA
+	challenge, err := u.createMFAChallenge(ctx, user.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// The login is only complete here when no second factor is required. The
+	// account's failures are kept until VerifyMFA, so a known password does
+	// not reset the guesses left at the second factor.
+	if challenge != nil {
+		return user, nil, challenge, nil
+	}
+
+	if err := u.clearFailures(ctx, rules); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := u.cancelScheduledDeletion(ctx, user); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// A password alone does not verify the email; unverified users still
+	// confirm the code sent to them
+	session, err := u.createSession(ctx, user, user.EmailVerified)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	u.recordLogin(ctx, user, session, loginMethodPassword)
+
+	return user, session, nil, nil
+}
+
+// rehashPassword replaces a password hash made with an outdated algorithm or
+// cost now that the plaintext is known. Failing to do so does not fail the login.
+func (u *userUsecase) rehashPassword(ctx context.Context, user *entity.User, password string) {
+	if !utils.PasswordNeedsRehash(user.Password) {
+		return
+	}
+
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		log.Printf("failed to rehash password for user %s: %v", user.ID, err)
+		return
+	}
+
+	if err := u.userRepo.UpdatePassword(ctx, user.Email, hashedPassword); err != nil {
+		log.Printf("failed to store rehashed password for user %s: %v", user.ID, err)
+		return
+	}
+
+	user.Password = hashedPassword
 }
 
 // ChangePassword updates a user's password.
-func (u *userUsecase) ChangePassword(ctx context.Context, currentPassword string, newPassword string, email string) error {
+func (u *userUsecase) ChangePassword(ctx context.Context, currentPassword string, newPassword string, userID string) error {
 	// Retrieve the user by ID to verify the current password
-	user, err := u.userRepo.GetUserByEmail(ctx, email)
+	user, err := u.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve user by ID %s: %w", email, err)
+		return fmt.Errorf("failed to retrieve user by ID %s: %w", userID, err)
 	}
 
 	// Check if current password is correct
 	if err := utils.CheckPassword(currentPassword, user.Password); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventPasswordChange, &user.ID, user.Email, "invalid_password")
 		return fmt.Errorf("current password is incorrect: %w", err)
 	}
 
+	// Enforce the password policy and stop recent passwords from being reused
+	if err := u.checkNewPassword(ctx, user, newPassword); err != nil {
+		return err
+	}
+
 	// Hash the new password
 	hashedPassword, err := utils.HashPassword(newPassword)
 	if err != nil {
@@ -251,16 +311,17 @@ func (u *userUsecase) ChangePassword(ctx context.Context, currentPassword string
 	}
 
 	// Check if user is verified
-	if !session.OTPVerified {
+	if !user.EmailVerified {
 		return fmt.Errorf("user is not verified")
 	}
 
 	// Update the password in the repository
-	err = u.userRepo.UpdatePassword(ctx, email, hashedPassword)
-	if err != nil {
-		return fmt.Errorf("failed to update password: %w", err)
+	if err := u.replacePassword(ctx, user, hashedPassword); err != nil {
+		return err
 	}
 
+	u.recordUserSuccess(ctx, entity.AuthEventPasswordChange, user.ID, user.Email, map[string]string{"method": "change"})
+
 	return nil
 }
 
@@ -275,18 +336,30 @@ func (u *userUsecase) GetUser(ctx context.Context, userId string) (*entity.User,
 	return user, nil
 }
 
-// LogOut implements UserUsecase.
-func (u *userUsecase) LogOut(ctx context.Context, userId string) error {
-	// Retrieve user by email
-	err := u.userRepo.DeleteSession(ctx, userId)
+// LogOut revokes the session the caller's access token belongs to, so the
+// token stops being accepted before it expires.
+func (u *userUsecase) LogOut(ctx context.Context, userId string, sessionId string) error {
+	userID, err := uuid.Parse(userId)
+	if err != nil {
+		return fmt.Errorf("invalid user ID format: %w", err)
+	}
+
+	sessionID, err := uuid.Parse(sessionId)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve user by userId %s: %w", userId, err)
+		return fmt.Errorf("invalid session ID format: %w", err)
+	}
+
+	if err := u.userRepo.RevokeSessionTokens(ctx, userID, sessionID, entity.SessionRevokedLogout); err != nil {
+		return err
 	}
 
+	u.recordSessionRevoked(ctx, userID, userID, &sessionID, entity.SessionRevokedLogout)
+
 	return nil
 }
 
-// ResendOtp implements UserUsecase.
+// ResendOtp emails the user a new signup verification code, replacing any
+// code sent before.
 func (u *userUsecase) ResendOtp(ctx context.Context, email string) error {
 	// Retrieve user by email
 	user, err := u.userRepo.GetUserByEmail(ctx, email)
@@ -294,21 +367,16 @@ func (u *userUsecase) ResendOtp(ctx context.Context, email string) error {
 		return fmt.Errorf("failed to retrieve user by email %s: %w", email, err)
 	}
 
-	//
-
-	// Retrieve user by email
-	err = u.userRepo.UpdateOtp(ctx, &entity.UpdateOtp{
-		Otp:          utils.RandomOtp(),
-		OtpAttempts:  0,
-		Email:        user.Email,
-		OtpExpiresAt: time.Now().Add(time.Minute * 10),
-	})
+	if user.EmailVerified {
+		return fmt.Errorf("email already verified")
+	}
 
+	otp, err := u.issueVerificationCode(ctx, user.ID, entity.VerificationPurposeSignup)
 	if err != nil {
-		return fmt.Errorf("failed to update otp: %w", err)
+		return err
 	}
 
-	return nil
+	return u.sendVerificationEmail(ctx, user, otp, verificationCodeTTL)
 }
 
 // GetSession implements UserUsecase.
@@ -327,60 +395,60 @@ func (u *userUsecase) GetSession(ctx context.Context, id string) (*entity.Sessio
 
 }
 
-// VerifyOtp implements UserUsecase.
-func (u *userUsecase) VerifyOtp(ctx context.Context, email string, otp string) (bool, error) {
-	// Retrieve user by email
-	user, err := u.userRepo.GetUserByEmail(ctx, email)
-	if err != nil {
-		return false, fmt.Errorf("failed to retrieve user by email %s: %w", email, err)
+// VerifyOtp checks the signup verification code emailed to the user and marks
+// their email as verified. The user is signed in with a new session.
+func (u *userUsecase) VerifyOtp(ctx context.Context, email string, otp string) (*entity.User, *entity.Session, error) {
+	rules := throttleRules(ctx, entity.ThrottleScopeOTP, email)
+	if err := u.checkLockout(ctx, rules); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventOTPVerification, nil, email, authFailureReason(err))
+		return nil, nil, err
 	}
 
-	otpUpdate, err := u.userRepo.GetOtp(ctx, user.ID.String())
+	// Retrieve user by email
+	user, err := u.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
-		return false, fmt.Errorf("failed to retrieve user session: %w", err)
-	}
-
-	if otpUpdate.OTPVerified {
-		return false, fmt.Errorf("otp already verified")
+		return nil, nil, fmt.Errorf("failed to retrieve user by email %s: %w", email, err)
 	}
 
-	if otpUpdate.OtpAttempts >= 10 {
-		return false, fmt.Errorf("otp attempts exceeded")
+	if user.EmailVerified {
+		return nil, nil, fmt.Errorf("otp already verified")
 	}
 
-	if otpUpdate.Otp != otp {
-		return false, fmt.Errorf("invalid otp %s: %s", otp, otpUpdate.Otp)
+	if _, err := u.checkVerificationCode(ctx, user.ID, entity.VerificationPurposeSignup, otp); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventOTPVerification, &user.ID, email, authFailureReason(err))
+		if errors.Is(err, entity.ErrVerificationCodeInvalid) {
+			if lockErr := u.recordFailure(ctx, rules, &user.ID); lockErr != nil {
+				return nil, nil, lockErr
+			}
+		}
+		return nil, nil, err
 	}
 
-	session, err := u.userRepo.GetUserSession(ctx, user.ID)
-	if err != nil {
-		return false, fmt.Errorf("failed to retrieve user session: %w", err)
+	if _, err := u.userRepo.ConsumeVerificationChallenge(ctx, user.ID, entity.VerificationPurposeSignup); err != nil {
+		return nil, nil, err
 	}
 
-	if session.OtpExpiresAt.After(time.Now()) {
-		return false, fmt.Errorf("otp expired")
+	if err := u.userRepo.MarkEmailVerified(ctx, user.ID); err != nil {
+		return nil, nil, err
 	}
 
-	if session.OtpAttempts >= 10 {
-		return false, fmt.Errorf("otp attempts exceeded")
+	if err := u.clearFailures(ctx, rules); err != nil {
+		return nil, nil, err
 	}
 
-	// Update the password in the repository
-	err = u.userRepo.UpdateOtp(ctx, &entity.UpdateOtp{
-		OtpAttempts: session.OtpAttempts + 1,
-		Email:       user.Email,
-		OTPVerified: true,
-	})
+	u.recordUserSuccess(ctx, entity.AuthEventOTPVerification, user.ID, user.Email, map[string]string{"purpose": entity.VerificationPurposeSignup})
 
+	session, err := u.createVerifiedSession(ctx, user)
 	if err != nil {
-		return false, fmt.Errorf("failed to update password: %w", err)
+		return nil, nil, err
 	}
 
-	return true, nil
-
+	return user, session, nil
 }
 
-// ForgetPassword implements UserUsecase with 6-digit OTP
+// ForgetPassword emails the user a 6-digit code for resetting their password.
+// Unknown emails are ignored without an error so the endpoint cannot be used to
+// find out which emails are registered.
 func (u *userUsecase) ForgetPassword(ctx context.Context, email string) error {
 	// Check if the user exists
 	user, err := u.userRepo.GetUserByEmail(ctx, email)
@@ -388,114 +456,95 @@ func (u *userUsecase) ForgetPassword(ctx context.Context, email string) error {
 		return nil
 	}
 
-	// Generate a secure 6-digit OTP
-	otp := utils.RandomOtp()
+	otp, err := u.issueVerificationCode(ctx, user.ID, entity.VerificationPurposePasswordReset)
 	if err != nil {
-		return fmt.Errorf("failed to generate OTP: %w", err)
+		return err
 	}
 
-	// Update the OTP for the user - reusing your existing UpdateOtp method
-	err = u.userRepo.UpdateOtp(ctx, &entity.UpdateOtp{
-		Otp:          otp,
-		OtpAttempts:  0,
-		Email:        user.Email,
-		OTPVerified:  false,
-		OtpExpiresAt: time.Now().Add(10 * time.Minute), // OTP valid for 10 minutes
-	})
-
-	if err != nil {
-		return fmt.Errorf("failed to update OTP: %w", err)
-	}
-
-	// Get metadata for logging
-	metaData := utils.ExtractMetaData(ctx)
-
-	// For development, log the OTP
-	fmt.Printf("[Password Reset] User: %s, IP: %s, OTP: %s\n",
-		user.Email, metaData.ClientIP, otp)
-	return nil
+	return u.sendPasswordResetEmail(ctx, user, otp, verificationCodeTTL)
 }
 
-// VerifyResetPassword verifies the OTP for password reset
-func (u *userUsecase) VerifyResetPassword(ctx context.Context, email string, otp string) error {
+// VerifyResetPassword verifies the OTP for password reset and returns the
+// single-use token ResetPassword requires. Only a hash of the token is stored,
+// and verifying the code again replaces it.
+func (u *userUsecase) VerifyResetPassword(ctx context.Context, email string, otp string) (string, error) {
 	// Validate OTP format
 	if !utils.ValidateOTP(otp) {
-		return fmt.Errorf("invalid OTP format")
+		return "", fmt.Errorf("invalid otp format")
+	}
+
+	rules := throttleRules(ctx, entity.ThrottleScopeOTP, email)
+	if err := u.checkLockout(ctx, rules); err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventOTPVerification, nil, email, authFailureReason(err))
+		return "", err
 	}
 
 	// Check if the user exists
 	user, err := u.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
-		return fmt.Errorf("user not found")
+		return "", fmt.Errorf("user not found")
 	}
 
-	// Get the current OTP details using your existing GetOtp method
-	otpDetails, err := u.userRepo.GetOtp(ctx, user.ID.String())
+	challenge, err := u.checkVerificationCode(ctx, user.ID, entity.VerificationPurposePasswordReset, otp)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve OTP details: %w", err)
+		u.recordUserFailure(ctx, entity.AuthEventOTPVerification, &user.ID, email, authFailureReason(err))
+		if errors.Is(err, entity.ErrVerificationCodeInvalid) {
+			if lockErr := u.recordFailure(ctx, rules, &user.ID); lockErr != nil {
+				return "", lockErr
+			}
+		}
+		return "", err
 	}
 
-	// Check if OTP is already verified
-	if otpDetails.OTPVerified {
-		return fmt.Errorf("OTP already verified")
+	resetToken, err := utils.GenerateURLSafeToken(resetTokenBytes)
+	if err != nil {
+		return "", err
 	}
 
-	// Check if max attempts exceeded
-	if otpDetails.OtpAttempts >= 10 {
-		return fmt.Errorf("maximum OTP attempts exceeded")
+	// Guards against the challenge being used or expiring since the code was checked
+	stored, err := u.userRepo.SetVerificationChallengeResetToken(ctx, challenge.ID, utils.HashToken(resetToken))
+	if err != nil {
+		return "", err
 	}
-
-	// Check if OTP has expired
-	if time.Now().After(otpDetails.OtpExpiresAt) {
-		return fmt.Errorf("OTP has expired")
+	if !stored {
+		return "", entity.ErrVerificationCodeExpired
 	}
 
-	// Check if OTP matches
-	if otpDetails.Otp != otp {
-		// Increment attempts and update
-		err = u.userRepo.UpdateOtp(ctx, &entity.UpdateOtp{
-			Email:       user.Email,
-			OtpAttempts: otpDetails.OtpAttempts + 1,
-		})
-		if err != nil {
-			return fmt.Errorf("failed to update OTP attempts: %w", err)
-		}
-		return fmt.Errorf("invalid OTP")
+	if err := u.clearFailures(ctx, rules); err != nil {
+		return "", err
 	}
 
-	// OTP is valid, mark as verified
-	err = u.userRepo.UpdateOtp(ctx, &entity.UpdateOtp{
-		Email:       user.Email,
-		OTPVerified: true,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to mark OTP as verified: %w", err)
-	}
+	u.recordUserSuccess(ctx, entity.AuthEventOTPVerification, user.ID, user.Email, map[string]string{"purpose": entity.VerificationPurposePasswordReset})
 
-	return nil
+	return resetToken, nil
 }
 
-// ResetPassword sets a new password after OTP verification
-func (u *userUsecase) ResetPassword(ctx context.Context, email string, newPassword string) error {
-	// Check if the user exists
-	user, err := u.userRepo.GetUserByEmail(ctx, email)
-	if err != nil {
-		return fmt.Errorf("user not found")
+// ResetPassword sets a new password for the user a reset token was issued to.
+// The token is used up with its challenge, so each one resets the password once.
+func (u *userUsecase) ResetPassword(ctx context.Context, resetToken string, newPassword string) error {
+	if resetToken == "" {
+		return entity.ErrResetTokenInvalid
 	}
+	tokenHash := utils.HashToken(resetToken)
 
-	// Get the session to check if OTP was verified
-	session, err := u.userRepo.GetUserSession(ctx, user.ID)
+	challenge, err := u.userRepo.GetVerificationChallengeByResetToken(ctx, tokenHash)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve user session: %w", err)
+		if errors.Is(err, entity.ErrVerificationNotFound) {
+			return entity.ErrResetTokenInvalid
+		}
+		return err
+	}
+	if challenge.Purpose != entity.VerificationPurposePasswordReset {
+		return entity.ErrResetTokenInvalid
 	}
 
-	// Check if OTP was verified
-	if !session.OTPVerified {
-		return fmt.Errorf("OTP not verified, cannot reset password")
+	user, err := u.userRepo.GetUserByID(ctx, challenge.UserID.String())
+	if err != nil {
+		return fmt.Errorf("user not found")
 	}
 
-	// Validate the new password
-	if err := val.ValidatePassword(newPassword); err != nil {
+	// Enforce the password policy and stop recent passwords from being reused
+	if err := u.checkNewPassword(ctx, user, newPassword); err != nil {
 		return fmt.Errorf("invalid password: %w", err)
 	}
 
@@ -505,27 +554,20 @@ func (u *userUsecase) ResetPassword(ctx context.Context, email string, newPasswo
 		return fmt.Errorf("failed to hash new password: %w", err)
 	}
 
-	// Update the password
-	err = u.userRepo.UpdatePassword(ctx, email, hashedPassword)
+	consumed, err := u.userRepo.ConsumeVerificationChallengeByResetToken(ctx, tokenHash)
 	if err != nil {
-		return fmt.Errorf("failed to update password: %w", err)
+		return err
 	}
-
-	// Reset the OTP verification status
-	err = u.userRepo.UpdateOtp(ctx, &entity.UpdateOtp{
-		Email:       user.Email,
-		OTPVerified: false,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to reset OTP verification status: %w", err)
+	if !consumed {
+		return entity.ErrResetTokenInvalid
 	}
 
-	// Get metadata for logging
-	metaData := utils.ExtractMetaData(ctx)
+	// Update the password
+	if err := u.replacePassword(ctx, user, hashedPassword); err != nil {
+		return err
+	}
 
-	// Log the password change
-	fmt.Printf("[Password Changed] User: %s, IP: %s\n",
-		user.Email, metaData.ClientIP)
+	u.recordUserSuccess(ctx, entity.AuthEventPasswordChange, user.ID, user.Email, map[string]string{"method": "reset"})
 
 	return nil
 }
@@ -615,6 +657,14 @@ func (u *userUsecase) RevokeSession(ctx context.Context, sessionID string, userI
 		return fmt.Errorf("failed to revoke session: %w", err)
 	}
 
+	// Access tokens already issued for the session stop working too
+	err = u.userRepo.RevokeSessionTokens(ctx, userId, sessID, entity.SessionRevokedByUser)
+	if err != nil {
+		return err
+	}
+
+	u.recordSessionRevoked(ctx, userId, userId, &sessID, entity.SessionRevokedByUser)
+
 	return nil
 }
 
@@ -629,6 +679,7 @@ func (u *userUsecase) DeactivateAccount(ctx context.Context, password string, us
 	// Verify password
 	err = utils.CheckPassword(password, user.Password)
 	if err != nil {
+		u.recordUserFailure(ctx, entity.AuthEventAccountDeactivated, &user.ID, user.Email, "invalid_password")
 		return fmt.Errorf("password is incorrect: %w", err)
 	}
 
@@ -652,46 +703,67 @@ func (u *userUsecase) DeactivateAccount(ctx context.Context, password string, us
 		return fmt.Errorf("failed to revoke sessions: %w", err)
 	}
 
-	// Log the account deactivation
-	metaData := utils.ExtractMetaData(ctx)
-	fmt.Printf("Account deactivated for user %s from IP %s at %s\n",
-		user.Email, metaData.ClientIP, time.Now().Format(time.RFC3339))
+	err = u.userRepo.RevokeUserSessionTokens(ctx, userId, entity.SessionRevokedDeactivated)
+	if err != nil {
+		return err
+	}
+
+	u.recordUserSuccess(ctx, entity.AuthEventAccountDeactivated, user.ID, user.Email, nil)
 
 	return nil
 }
 
-// DeleteAccount permanently deletes a user account
-func (u *userUsecase) DeleteAccount(ctx context.Context, password string, userID string) error {
+// DeleteAccount schedules a user account for deletion once the grace period
+// ends, returning when it will be erased. Signing in before then cancels it.
+func (u *userUsecase) DeleteAccount(ctx context.Context, password string, userID string) (time.Time, error) {
 	// Retrieve the user
 	user, err := u.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve user: %w", err)
+		return time.Time{}, fmt.Errorf("failed to retrieve user: %w", err)
 	}
 
 	// Verify password
 	err = utils.CheckPassword(password, user.Password)
 	if err != nil {
-		return fmt.Errorf("password is incorrect: %w", err)
+		u.recordUserFailure(ctx, entity.AuthEventAccountDeletionScheduled, &user.ID, user.Email, "invalid_password")
+		return time.Time{}, fmt.Errorf("password is incorrect: %w", err)
 	}
 
 	// Parse the user ID
 	userId, err := uuid.Parse(userID)
 	if err != nil {
-		return fmt.Errorf("invalid user ID format: %w", err)
+		return time.Time{}, fmt.Errorf("invalid user ID format: %w", err)
 	}
 
-	// Delete user from repository
-	err = u.userRepo.DeleteUser(ctx, userId)
+	scheduledFor := time.Now().Add(accountDeletionGracePeriod)
+	scheduled, err := u.userRepo.ScheduleUserDeletion(ctx, userId, scheduledFor)
 	if err != nil {
-		return fmt.Errorf("failed to delete account: %w", err)
+		return time.Time{}, err
+	}
+	if !scheduled {
+		return time.Time{}, entity.ErrDeletionAlreadyScheduled
 	}
 
-	// Log the account deletion
-	metaData := utils.ExtractMetaData(ctx)
-	fmt.Printf("Account deleted for user %s from IP %s at %s\n",
-		user.Email, metaData.ClientIP, time.Now().Format(time.RFC3339))
+	// Sign the user out everywhere, refresh tokens included; signing in again
+	// is how they keep the account
+	err = u.userRepo.RevokeAllSessions(ctx, userId)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
 
-	return nil
+	err = u.userRepo.RevokeUserSessionTokens(ctx, userId, entity.SessionRevokedDeleted)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	u.recordUserSuccess(ctx, entity.AuthEventAccountDeletionScheduled, user.ID, user.Email, map[string]string{
+		"scheduled_for": scheduledFor.UTC().Format(time.RFC3339),
+	})
+
+	// Tell the user when the account goes and how to keep it
+	u.notifyAccountDeletion(ctx, user, scheduledFor)
+
+	return scheduledFor, nil
 }
 
 // GetLoginHistory retrieves the login history for a user
@@ -702,8 +774,7 @@ func (u *userUsecase) GetLoginHistory(ctx context.Context, userID string, limit
 		return nil, fmt.Errorf("invalid user ID format: %w", err)
 	}
 
-	// Retrieve login history from repository
-	// Note: You would need to add a method to your repository to get login history
+	// Logins are read from the security audit log
 	history, err := u.userRepo.GetLoginHistory(ctx, userId, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve login history: %w", err)
@@ -733,8 +804,3 @@ func (u *userUsecase) GetUserProfile(ctx context.Context, userID string) (*entit
 
 	return profile, nil
 }
-
-// LoginWithOAuth implements UserUsecase.
-func (u *userUsecase) LoginWithOAuth(ctx context.Context, provider string, token string) (*entity.User, *entity.Session, error) {
-	panic("unimplemented")
-}
@@ -3,15 +3,20 @@ package usecase
 import (
 	"context"
 	"io"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/demola234/authentication/infrastructure/mailer"
 	"github.com/demola234/authentication/internal/domain/entity"
 	"github.com/demola234/authentication/pkg/utils"
+	"github.com/demola234/authentication/pkg/val"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/metadata"
 )
 
 // MockUserRepository is a mock implementation of UserRepository
@@ -96,15 +101,6 @@ type MockOauthRepository struct {
 	mock.Mock
 }
 
-// GetOtp implements repository.UserRepository.
-func (m *MockUserRepository) GetOtp(ctx context.Context, id string) (*entity.UpdateOtp, error) {
-	args := m.Called(ctx, id)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).(*entity.UpdateOtp), args.Error(1)
-}
-
 // UpdateSession implements repository.UserRepository.
 func (m *MockUserRepository) UpdateSession(ctx context.Context, session *entity.Session) error {
 	args := m.Called(ctx, session)
@@ -124,9 +120,9 @@ func (m *MockUserRepository) GetUserByEmail(ctx context.Context, email string) (
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
-func (m *MockUserRepository) CreateToken(ctx context.Context, email string, userID string) (string, error) {
+func (m *MockUserRepository) CreateToken(ctx context.Context, email string, userID string, sessionID string, role string) (string, time.Time, error) {
 	args := m.Called(ctx, email)
-	return args.String(0), args.Error(1)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
 }
 
 func (m *MockUserRepository) CreateSession(ctx context.Context, session *entity.Session) error {
@@ -160,11 +156,6 @@ func (m *MockUserRepository) DeleteSession(ctx context.Context, userId string) e
 	return args.Error(0)
 }
 
-func (m *MockUserRepository) UpdateOtp(ctx context.Context, updateOtp *entity.UpdateOtp) error {
-	args := m.Called(ctx, updateOtp)
-	return args.Error(0)
-}
-
 func (m *MockOauthRepository) ValidateGoogleToken(ctx context.Context, token string) (*entity.OAuthUserInfo, error) {
 	args := m.Called(ctx, token)
 	if args.Get(0) == nil {
@@ -196,23 +187,38 @@ func (m *MockOauthRepository) ValidateProviderToken(ctx context.Context, provide
 	return args.Get(0).(*entity.OAuthUserInfo), args.Error(1)
 }
 
+func (m *MockOauthRepository) AuthCodeURL(provider string, state *entity.OAuthState) (string, error) {
+	args := m.Called(provider, state)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockOauthRepository) ExchangeCode(ctx context.Context, provider, code string, state *entity.OAuthState) (*entity.OAuthUserInfo, error) {
+	args := m.Called(ctx, provider, code, state)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OAuthUserInfo), args.Error(1)
+}
+
 func TestRegisterUser(t *testing.T) {
 	mockRepo := new(MockUserRepository)
 	mockOauthRepo := new(MockOauthRepository)
-	useCase := NewUserUsecase(mockRepo, mockOauthRepo)
+	mockMailer := mailer.NewMemoryMailer()
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mockMailer, new(MockMessageQueue))
 	ctx := context.Background()
 
 	fullName := "Test User"
 	password := "password123"
 	email := "test@example.com"
-	role := "user"
+	role := "buyer"
 	phone := "1234567890"
 
 	// Mock behavior
 	mockRepo.On("GetUserByEmail", ctx, email).Return(nil, nil)
-	mockRepo.On("CreateToken", ctx, email).Return("test-token", nil)
+	mockRepo.On("CreateToken", ctx, email).Return("test-token", time.Now().Add(15*time.Minute), nil)
 	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
 	mockRepo.On("CreateUser", ctx, mock.AnythingOfType("*entity.User")).Return(nil)
+	challenge := captureVerificationChallenge(mockRepo, ctx)
 
 	// Execute test
 	user, session, err := useCase.RegisterUser(ctx, fullName, password, email, role, phone)
@@ -225,13 +231,99 @@ func TestRegisterUser(t *testing.T) {
 	require.Equal(t, fullName, user.FullName)
 	require.Equal(t, role, user.Role)
 	require.Equal(t, phone, user.Phone)
+
+	sent := mockMailer.Last()
+	require.NotNil(t, sent)
+	require.Equal(t, email, sent.To)
+
+	otp := sentOtp(t, sent)
+	require.Contains(t, sent.HTMLBody, otp)
+	require.Equal(t, entity.VerificationPurposeSignup, challenge.Purpose)
+	require.Equal(t, user.ID, challenge.UserID)
+	require.True(t, utils.CompareOTP(otp, challenge.Salt, challenge.CodeHash))
+	require.Empty(t, session.Otp)
+}
+
+func TestRegisterUserRejectsPrivilegedRole(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "test@example.com"
+
+	// Mock behavior
+	mockRepo.On("GetUserByEmail", ctx, email).Return(nil, nil)
+
+	// Execute test
+	_, _, err := useCase.RegisterUser(ctx, "Test User", "password123", email, "admin", "1234567890")
+
+	// Assertions
+	require.ErrorIs(t, err, ErrRoleNotAllowed)
+	mockRepo.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything)
 }
 
 func TestLoginUser(t *testing.T) {
 	mockRepo := new(MockUserRepository)
 	mockOauthRepo := new(MockOauthRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mockMailer, new(MockMessageQueue))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) Firefox/127.0"))
+
+	password := "password123"
+	hashedPassword, _ := utils.HashPassword(password)
+	email := "test@example.com"
+
+	mockUser := &entity.User{
+		ID:            uuid.New(),
+		Email:         email,
+		Password:      hashedPassword,
+		FullName:      "Test User",
+		EmailVerified: true,
+	}
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetLoginFamiliarity", ctx, mockUser.ID, mock.Anything, "").
+		Return(&entity.LoginFamiliarity{PreviousLogins: 3, LocationLogins: 3}, nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("ResetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(mockUser, nil)
+
+	mockRepo.On("GetUserMFA", ctx, mockUser.ID).Return(nil, entity.ErrMFANotEnrolled)
+	mockRepo.On("CreateToken", ctx, email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+
+	// Execute test
+	user, session, challenge, err := useCase.LoginUser(ctx, password, email)
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	require.Nil(t, challenge)
+	require.Equal(t, email, user.Email)
+	require.Equal(t, mockUser.ID, session.UserID)
+	require.True(t, session.OTPVerified)
 
-	useCase := NewUserUsecase(mockRepo, mockOauthRepo)
+	// Every login gets a session of its own
+	_, second, _, err := useCase.LoginUser(ctx, password, email)
+	require.NoError(t, err)
+	require.NotEqual(t, session.SessionID, second.SessionID)
+	mockRepo.AssertNotCalled(t, "GetUserSession", mock.Anything, mock.Anything)
+
+	sent := mockMailer.Last()
+	require.NotNil(t, sent)
+	require.Equal(t, email, sent.To)
+	require.Contains(t, sent.Subject, "New sign-in")
+	require.Contains(t, sent.TextBody, "Firefox on Windows")
+}
+
+func TestLoginUserUnverifiedEmail(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
 	ctx := context.Background()
 
 	password := "password123"
@@ -242,26 +334,73 @@ func TestLoginUser(t *testing.T) {
 		ID:       uuid.New(),
 		Email:    email,
 		Password: hashedPassword,
-		FullName: "Test User",
 	}
 
 	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetLoginFamiliarity", ctx, mockUser.ID, mock.Anything, "").Return(&entity.LoginFamiliarity{PreviousLogins: 1}, nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("ResetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil)
 	mockRepo.On("GetUserByEmail", ctx, email).Return(mockUser, nil)
+	mockRepo.On("GetUserMFA", ctx, mockUser.ID).Return(nil, entity.ErrMFANotEnrolled)
+	mockRepo.On("CreateToken", ctx, email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
 
 	// Execute test
-	user, err := useCase.LoginUser(ctx, password, email)
+	_, session, _, err := useCase.LoginUser(ctx, password, email)
 
 	// Assertions
 	require.NoError(t, err)
-	require.NotNil(t, user)
-	require.Equal(t, email, user.Email)
+	require.False(t, session.OTPVerified)
+}
+
+func TestLoginUserRehashesLegacyPassword(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	password := "password123"
+	legacyHash, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	email := "test@example.com"
+
+	mockUser := &entity.User{
+		ID:       uuid.New(),
+		Email:    email,
+		Password: string(legacyHash),
+	}
+
+	var rehashed string
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetLoginFamiliarity", ctx, mock.Anything, mock.Anything, mock.Anything).Return(&entity.LoginFamiliarity{}, nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("ResetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(mockUser, nil)
+	mockRepo.On("UpdatePassword", ctx, email, mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { rehashed = args.String(2) }).
+		Return(nil)
+	mockRepo.On("GetUserMFA", ctx, mockUser.ID).Return(nil, entity.ErrMFANotEnrolled)
+	mockRepo.On("CreateToken", ctx, email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+
+	// Execute test
+	_, _, _, err := useCase.LoginUser(ctx, password, email)
+
+	// Assertions
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(rehashed, "$argon2id$"))
+	require.NoError(t, utils.CheckPassword(password, rehashed))
+	require.False(t, utils.PasswordNeedsRehash(rehashed))
 }
 
 func TestChangePassword(t *testing.T) {
 	mockRepo := new(MockUserRepository)
 	mockOauthRepo := new(MockOauthRepository)
 
-	useCase := NewUserUsecase(mockRepo, mockOauthRepo)
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
 	ctx := context.Background()
 
 	currentPassword := "oldpassword123"
@@ -270,27 +409,33 @@ func TestChangePassword(t *testing.T) {
 	hashedOldPassword, _ := utils.HashPassword(currentPassword)
 
 	mockUser := &entity.User{
-		ID:       uuid.New(),
-		Email:    email,
-		Password: hashedOldPassword,
+		ID:            uuid.New(),
+		Email:         email,
+		Password:      hashedOldPassword,
+		EmailVerified: true,
 	}
 
 	// Mock behavior
-	mockRepo.On("GetUserByEmail", ctx, email).Return(mockUser, nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetUserByID", ctx, mockUser.ID.String()).Return(mockUser, nil)
+	mockRepo.On("GetUserSession", ctx, mockUser.ID).Return(&entity.Session{IsActive: true}, nil)
+	mockRepo.On("ListPasswordHistory", ctx, mockUser.ID, val.DefaultPasswordPolicy.HistorySize-1).Return([]string{}, nil)
+	mockRepo.On("AddPasswordHistory", ctx, mockUser.ID, hashedOldPassword, val.DefaultPasswordPolicy.HistorySize-1).Return(nil)
 	mockRepo.On("UpdatePassword", ctx, email, mock.AnythingOfType("string")).Return(nil)
 
 	// Execute test
-	err := useCase.ChangePassword(ctx, currentPassword, newPassword, email)
+	err := useCase.ChangePassword(ctx, currentPassword, newPassword, mockUser.ID.String())
 
 	// Assertions
 	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
 }
 
 func TestGetSession(t *testing.T) {
 	mockRepo := new(MockUserRepository)
 	mockOauthRepo := new(MockOauthRepository)
 
-	useCase := NewUserUsecase(mockRepo, mockOauthRepo)
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
 	ctx := context.Background()
 
 	sessionID := uuid.New().String()
@@ -302,7 +447,7 @@ func TestGetSession(t *testing.T) {
 	}
 
 	// Mock behavior
-	mockRepo.On("GetUserSession", ctx, sessionID).Return(mockSession, nil)
+	mockRepo.On("GetUserSession", ctx, mockSession.SessionID).Return(mockSession, nil)
 
 	// Execute test
 	session, err := useCase.GetSession(ctx, sessionID)
@@ -318,38 +463,79 @@ func TestLogOut(t *testing.T) {
 	mockRepo := new(MockUserRepository)
 	mockOauthRepo := new(MockOauthRepository)
 
-	useCase := NewUserUsecase(mockRepo, mockOauthRepo)
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mailer.NewMemoryMailer(), new(MockMessageQueue))
 	ctx := context.Background()
 
-	userID := uuid.New().String()
+	userID := uuid.New()
+	sessionID := uuid.New()
 
 	// Mock behavior
-	mockRepo.On("DeleteSession", ctx, userID).Return(nil)
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("RevokeSessionTokens", ctx, userID, sessionID, entity.SessionRevokedLogout).Return(nil)
 
 	// Execute test
-	err := useCase.LogOut(ctx, userID)
+	err := useCase.LogOut(ctx, userID.String(), sessionID.String())
 
 	// Assertions
 	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
 }
 
 func TestResendOtp(t *testing.T) {
 	mockRepo := new(MockUserRepository)
 	mockOauthRepo := new(MockOauthRepository)
+	mockMailer := mailer.NewMemoryMailer()
 
-	useCase := NewUserUsecase(mockRepo, mockOauthRepo)
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mockMailer, new(MockMessageQueue))
 	ctx := context.Background()
 
 	email := "test@example.com"
 
 	// Mock behavior
-	mockRepo.On("UpdateOtp", ctx, mock.AnythingOfType("*entity.UpdateOtp")).Return(nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(&entity.User{ID: uuid.New(), Email: email}, nil)
+	challenge := captureVerificationChallenge(mockRepo, ctx)
 
 	// Execute test
 	err := useCase.ResendOtp(ctx, email)
 
 	// Assertions
 	require.NoError(t, err)
+	require.Len(t, mockMailer.Messages(), 1)
+
+	otp := sentOtp(t, mockMailer.Last())
+	require.Equal(t, entity.VerificationPurposeSignup, challenge.Purpose)
+	require.True(t, utils.CompareOTP(otp, challenge.Salt, challenge.CodeHash))
+}
+
+func TestForgetPasswordSendsResetEmail(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockOauthRepo := new(MockOauthRepository)
+	mockMailer := mailer.NewMemoryMailer()
+
+	useCase := NewUserUsecase(mockRepo, mockOauthRepo, mockMailer, new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "test@example.com"
+
+	// Mock behavior
+	mockRepo.On("GetUserByEmail", ctx, email).Return(&entity.User{ID: uuid.New(), Email: email, FullName: "Test User"}, nil)
+	challenge := captureVerificationChallenge(mockRepo, ctx)
+
+	// Execute test
+	err := useCase.ForgetPassword(ctx, email)
+
+	// Assertions
+	require.NoError(t, err)
+
+	sent := mockMailer.Last()
+	require.NotNil(t, sent)
+	require.Equal(t, email, sent.To)
+	require.Contains(t, sent.Subject, "Reset")
+	require.Contains(t, sent.HTMLBody, "Test User")
+
+	otp := sentOtp(t, sent)
+	require.Equal(t, entity.VerificationPurposePasswordReset, challenge.Purpose)
+	require.True(t, utils.CompareOTP(otp, challenge.Salt, challenge.CodeHash))
 }
 
 // UpdateUser implements repository.UserRepository.
@@ -358,3 +544,782 @@ func (m *MockUserRepository) UpdateUser(ctx context.Context, user *entity.User)
 	return args.Error(0)
 
 }
+
+// SaveUserMFA implements repository.UserRepository.
+func (m *MockUserRepository) SaveUserMFA(ctx context.Context, userID uuid.UUID, secret string) (*entity.UserMFA, error) {
+	args := m.Called(ctx, userID, secret)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserMFA), args.Error(1)
+}
+
+// GetUserMFA implements repository.UserRepository.
+func (m *MockUserRepository) GetUserMFA(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserMFA), args.Error(1)
+}
+
+// EnableUserMFA implements repository.UserRepository.
+func (m *MockUserRepository) EnableUserMFA(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// UpdateMFALastUsedStep implements repository.UserRepository.
+func (m *MockUserRepository) UpdateMFALastUsedStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	args := m.Called(ctx, userID, step)
+	return args.Bool(0), args.Error(1)
+}
+
+// DeleteUserMFA implements repository.UserRepository.
+func (m *MockUserRepository) DeleteUserMFA(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// ReplaceRecoveryCodes implements repository.UserRepository.
+func (m *MockUserRepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	args := m.Called(ctx, userID, codeHashes)
+	return args.Error(0)
+}
+
+// UseRecoveryCode implements repository.UserRepository.
+func (m *MockUserRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	args := m.Called(ctx, userID, codeHash)
+	return args.Bool(0), args.Error(1)
+}
+
+// CreateMFAChallenge implements repository.UserRepository.
+func (m *MockUserRepository) CreateMFAChallenge(ctx context.Context, challenge *entity.MFAChallenge) error {
+	args := m.Called(ctx, challenge)
+	return args.Error(0)
+}
+
+// GetMFAChallenge implements repository.UserRepository.
+func (m *MockUserRepository) GetMFAChallenge(ctx context.Context, challengeID uuid.UUID) (*entity.MFAChallenge, error) {
+	args := m.Called(ctx, challengeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.MFAChallenge), args.Error(1)
+}
+
+// IncrementMFAChallengeAttempts implements repository.UserRepository.
+func (m *MockUserRepository) IncrementMFAChallengeAttempts(ctx context.Context, challengeID uuid.UUID) error {
+	args := m.Called(ctx, challengeID)
+	return args.Error(0)
+}
+
+// ConsumeMFAChallenge implements repository.UserRepository.
+func (m *MockUserRepository) ConsumeMFAChallenge(ctx context.Context, challengeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, challengeID)
+	return args.Bool(0), args.Error(1)
+}
+
+// CreateRefreshToken implements repository.UserRepository.
+func (m *MockUserRepository) CreateRefreshToken(ctx context.Context, refreshToken *entity.RefreshToken) error {
+	args := m.Called(ctx, refreshToken)
+	return args.Error(0)
+}
+
+// GetRefreshTokenByHash implements repository.UserRepository.
+func (m *MockUserRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.RefreshToken), args.Error(1)
+}
+
+// RotateRefreshToken implements repository.UserRepository.
+func (m *MockUserRepository) RotateRefreshToken(ctx context.Context, currentID uuid.UUID, next *entity.RefreshToken) error {
+	args := m.Called(ctx, currentID, next)
+	return args.Error(0)
+}
+
+// RevokeRefreshTokenFamily implements repository.UserRepository.
+func (m *MockUserRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	args := m.Called(ctx, familyID)
+	return args.Error(0)
+}
+
+// GetAuthThrottle implements repository.UserRepository.
+func (m *MockUserRepository) GetAuthThrottle(ctx context.Context, key entity.ThrottleKey) (*entity.AuthThrottle, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AuthThrottle), args.Error(1)
+}
+
+// RecordAuthFailure implements repository.UserRepository.
+func (m *MockUserRepository) RecordAuthFailure(ctx context.Context, key entity.ThrottleKey, windowStart time.Time) (*entity.AuthThrottle, error) {
+	args := m.Called(ctx, key, windowStart)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AuthThrottle), args.Error(1)
+}
+
+// LockAuthThrottle implements repository.UserRepository.
+func (m *MockUserRepository) LockAuthThrottle(ctx context.Context, key entity.ThrottleKey, until time.Time) (*entity.AuthThrottle, error) {
+	args := m.Called(ctx, key, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AuthThrottle), args.Error(1)
+}
+
+// ResetAuthThrottle implements repository.UserRepository.
+func (m *MockUserRepository) ResetAuthThrottle(ctx context.Context, key entity.ThrottleKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// UnlockAccount implements repository.UserRepository.
+func (m *MockUserRepository) UnlockAccount(ctx context.Context, email string) error {
+	args := m.Called(ctx, email)
+	return args.Error(0)
+}
+
+// CreateOAuthUser implements repository.UserRepository.
+func (m *MockUserRepository) CreateOAuthUser(ctx context.Context, user *entity.User, identity *entity.UserIdentity) error {
+	args := m.Called(ctx, user, identity)
+	return args.Error(0)
+}
+
+// CreateUserIdentity implements repository.UserRepository.
+func (m *MockUserRepository) CreateUserIdentity(ctx context.Context, identity *entity.UserIdentity) error {
+	args := m.Called(ctx, identity)
+	return args.Error(0)
+}
+
+// GetUserIdentity implements repository.UserRepository.
+func (m *MockUserRepository) GetUserIdentity(ctx context.Context, provider, providerUserID string) (*entity.UserIdentity, error) {
+	args := m.Called(ctx, provider, providerUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserIdentity), args.Error(1)
+}
+
+// ListUserIdentities implements repository.UserRepository.
+func (m *MockUserRepository) ListUserIdentities(ctx context.Context, userID uuid.UUID) ([]*entity.UserIdentity, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.UserIdentity), args.Error(1)
+}
+
+// TouchUserIdentity implements repository.UserRepository.
+func (m *MockUserRepository) TouchUserIdentity(ctx context.Context, identityID uuid.UUID) error {
+	args := m.Called(ctx, identityID)
+	return args.Error(0)
+}
+
+// DeleteUserIdentity implements repository.UserRepository.
+func (m *MockUserRepository) DeleteUserIdentity(ctx context.Context, userID uuid.UUID, identityID uuid.UUID) error {
+	args := m.Called(ctx, userID, identityID)
+	return args.Error(0)
+}
+
+// CreateOAuthState implements repository.UserRepository.
+func (m *MockUserRepository) CreateOAuthState(ctx context.Context, state *entity.OAuthState) error {
+	args := m.Called(ctx, state)
+	return args.Error(0)
+}
+
+// ConsumeOAuthState implements repository.UserRepository.
+func (m *MockUserRepository) ConsumeOAuthState(ctx context.Context, state string) (*entity.OAuthState, error) {
+	args := m.Called(ctx, state)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OAuthState), args.Error(1)
+}
+
+// CreateMagicLink implements repository.UserRepository.
+func (m *MockUserRepository) CreateMagicLink(ctx context.Context, link *entity.MagicLink) error {
+	args := m.Called(ctx, link)
+	return args.Error(0)
+}
+
+// GetMagicLinkByHash implements repository.UserRepository.
+func (m *MockUserRepository) GetMagicLinkByHash(ctx context.Context, tokenHash string) (*entity.MagicLink, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.MagicLink), args.Error(1)
+}
+
+// MarkMagicLinkUsed implements repository.UserRepository.
+func (m *MockUserRepository) MarkMagicLinkUsed(ctx context.Context, linkID uuid.UUID, ipAddress, userAgent string) error {
+	args := m.Called(ctx, linkID, ipAddress, userAgent)
+	return args.Error(0)
+}
+
+// MockMessageQueue is a mock implementation of repository.MessageQueue
+type MockMessageQueue struct {
+	mock.Mock
+}
+
+// PublishMessage implements repository.MessageQueue.
+func (m *MockMessageQueue) PublishMessage(ctx context.Context, key, value []byte) error {
+	args := m.Called(ctx, key, value)
+	return args.Error(0)
+}
+
+// ListTokenKeys implements repository.UserRepository.
+func (m *MockUserRepository) ListTokenKeys(ctx context.Context) ([]*entity.TokenKey, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TokenKey), args.Error(1)
+}
+
+// RevokeSessionTokens implements repository.UserRepository.
+func (m *MockUserRepository) RevokeSessionTokens(ctx context.Context, userID, sessionID uuid.UUID, reason string) error {
+	args := m.Called(ctx, userID, sessionID, reason)
+	return args.Error(0)
+}
+
+// RevokeUserSessionTokens implements repository.UserRepository.
+func (m *MockUserRepository) RevokeUserSessionTokens(ctx context.Context, userID uuid.UUID, reason string) error {
+	args := m.Called(ctx, userID, reason)
+	return args.Error(0)
+}
+
+// CreateVerificationChallenge implements repository.UserRepository.
+func (m *MockUserRepository) CreateVerificationChallenge(ctx context.Context, challenge *entity.VerificationChallenge) error {
+	args := m.Called(ctx, challenge)
+	return args.Error(0)
+}
+
+// GetActiveVerificationChallenge implements repository.UserRepository.
+func (m *MockUserRepository) GetActiveVerificationChallenge(ctx context.Context, userID uuid.UUID, purpose string) (*entity.VerificationChallenge, error) {
+	args := m.Called(ctx, userID, purpose)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.VerificationChallenge), args.Error(1)
+}
+
+// IncrementVerificationChallengeAttempts implements repository.UserRepository.
+func (m *MockUserRepository) IncrementVerificationChallengeAttempts(ctx context.Context, challengeID uuid.UUID) (int, error) {
+	args := m.Called(ctx, challengeID)
+	return args.Int(0), args.Error(1)
+}
+
+// MarkVerificationChallengeVerified implements repository.UserRepository.
+func (m *MockUserRepository) MarkVerificationChallengeVerified(ctx context.Context, challengeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, challengeID)
+	return args.Bool(0), args.Error(1)
+}
+
+// SetVerificationChallengeResetToken implements repository.UserRepository.
+func (m *MockUserRepository) SetVerificationChallengeResetToken(ctx context.Context, challengeID uuid.UUID, tokenHash string) (bool, error) {
+	args := m.Called(ctx, challengeID, tokenHash)
+	return args.Bool(0), args.Error(1)
+}
+
+// GetVerificationChallengeByResetToken implements repository.UserRepository.
+func (m *MockUserRepository) GetVerificationChallengeByResetToken(ctx context.Context, tokenHash string) (*entity.VerificationChallenge, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.VerificationChallenge), args.Error(1)
+}
+
+// ConsumeVerificationChallengeByResetToken implements repository.UserRepository.
+func (m *MockUserRepository) ConsumeVerificationChallengeByResetToken(ctx context.Context, tokenHash string) (bool, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Bool(0), args.Error(1)
+}
+
+// ConsumeVerificationChallenge implements repository.UserRepository.
+func (m *MockUserRepository) ConsumeVerificationChallenge(ctx context.Context, userID uuid.UUID, purpose string) (bool, error) {
+	args := m.Called(ctx, userID, purpose)
+	return args.Bool(0), args.Error(1)
+}
+
+// MarkEmailVerified implements repository.UserRepository.
+func (m *MockUserRepository) MarkEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// AddPasswordHistory implements repository.UserRepository.
+func (m *MockUserRepository) AddPasswordHistory(ctx context.Context, userID uuid.UUID, passwordHash string, keep int) error {
+	args := m.Called(ctx, userID, passwordHash, keep)
+	return args.Error(0)
+}
+
+// ListPasswordHistory implements repository.UserRepository.
+func (m *MockUserRepository) ListPasswordHistory(ctx context.Context, userID uuid.UUID, limit int) ([]string, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// CreateEmailChange implements repository.UserRepository.
+func (m *MockUserRepository) CreateEmailChange(ctx context.Context, change *entity.EmailChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+// GetPendingEmailChange implements repository.UserRepository.
+func (m *MockUserRepository) GetPendingEmailChange(ctx context.Context, userID uuid.UUID) (*entity.EmailChange, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.EmailChange), args.Error(1)
+}
+
+// ConfirmEmailChange implements repository.UserRepository.
+func (m *MockUserRepository) ConfirmEmailChange(ctx context.Context, change *entity.EmailChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+// GetEmailChangeByRevertToken implements repository.UserRepository.
+func (m *MockUserRepository) GetEmailChangeByRevertToken(ctx context.Context, tokenHash string) (*entity.EmailChange, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.EmailChange), args.Error(1)
+}
+
+// RevertEmailChange implements repository.UserRepository.
+func (m *MockUserRepository) RevertEmailChange(ctx context.Context, change *entity.EmailChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+// ListUsers implements repository.UserRepository.
+func (m *MockUserRepository) ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+// CountUsers implements repository.UserRepository.
+func (m *MockUserRepository) CountUsers(ctx context.Context, filter entity.UserFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// LockUser implements repository.UserRepository.
+func (m *MockUserRepository) LockUser(ctx context.Context, userID uuid.UUID, reason string) error {
+	args := m.Called(ctx, userID, reason)
+	return args.Error(0)
+}
+
+// UnlockUser implements repository.UserRepository.
+func (m *MockUserRepository) UnlockUser(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// UpdateUserRole implements repository.UserRepository.
+func (m *MockUserRepository) UpdateUserRole(ctx context.Context, userID uuid.UUID, role string) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+// SoftDeleteUser implements repository.UserRepository.
+func (m *MockUserRepository) SoftDeleteUser(ctx context.Context, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+// RestoreUser implements repository.UserRepository.
+func (m *MockUserRepository) RestoreUser(ctx context.Context, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+// CreateImpersonationToken implements repository.UserRepository.
+func (m *MockUserRepository) CreateImpersonationToken(ctx context.Context, impersonatorID string, email string, userID string, sessionID string, role string, duration time.Duration) (string, time.Time, error) {
+	args := m.Called(ctx, impersonatorID, email, userID, sessionID, role, duration)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
+}
+
+// CreateImpersonationSession implements repository.UserRepository.
+func (m *MockUserRepository) CreateImpersonationSession(ctx context.Context, session *entity.Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+// CreateAuthEvent implements repository.UserRepository.
+func (m *MockUserRepository) CreateAuthEvent(ctx context.Context, event *entity.AuthEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+// ListAuthEvents implements repository.UserRepository.
+func (m *MockUserRepository) ListAuthEvents(ctx context.Context, filter entity.AuthEventFilter) ([]*entity.AuthEvent, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AuthEvent), args.Error(1)
+}
+
+// CountAuthEvents implements repository.UserRepository.
+func (m *MockUserRepository) CountAuthEvents(ctx context.Context, filter entity.AuthEventFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// PruneAuthEvents implements repository.UserRepository.
+func (m *MockUserRepository) PruneAuthEvents(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetLoginFamiliarity implements repository.UserRepository.
+func (m *MockUserRepository) GetLoginFamiliarity(ctx context.Context, userID uuid.UUID, deviceFingerprint, location string) (*entity.LoginFamiliarity, error) {
+	args := m.Called(ctx, userID, deviceFingerprint, location)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.LoginFamiliarity), args.Error(1)
+}
+
+// ScheduleUserDeletion implements repository.UserRepository.
+func (m *MockUserRepository) ScheduleUserDeletion(ctx context.Context, userID uuid.UUID, scheduledFor time.Time) (bool, error) {
+	args := m.Called(ctx, userID, scheduledFor)
+	return args.Bool(0), args.Error(1)
+}
+
+// CancelUserDeletion implements repository.UserRepository.
+func (m *MockUserRepository) CancelUserDeletion(ctx context.Context, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+// ListUsersDueForDeletion implements repository.UserRepository.
+func (m *MockUserRepository) ListUsersDueForDeletion(ctx context.Context, now time.Time, limit int) ([]*entity.User, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+// EraseUser implements repository.UserRepository.
+func (m *MockUserRepository) EraseUser(ctx context.Context, user *entity.User, services []string) (bool, error) {
+	args := m.Called(ctx, user, services)
+	return args.Bool(0), args.Error(1)
+}
+
+// ListUnpublishedErasures implements repository.UserRepository.
+func (m *MockUserRepository) ListUnpublishedErasures(ctx context.Context, limit int) ([]*entity.AccountErasure, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AccountErasure), args.Error(1)
+}
+
+// MarkErasurePublished implements repository.UserRepository.
+func (m *MockUserRepository) MarkErasurePublished(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// GetAccountErasure implements repository.UserRepository.
+func (m *MockUserRepository) GetAccountErasure(ctx context.Context, userID uuid.UUID) (*entity.AccountErasure, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AccountErasure), args.Error(1)
+}
+
+// UpdateAccountErasureService implements repository.UserRepository.
+func (m *MockUserRepository) UpdateAccountErasureService(ctx context.Context, userID uuid.UUID, service *entity.AccountErasureService) (bool, error) {
+	args := m.Called(ctx, userID, service)
+	return args.Bool(0), args.Error(1)
+}
+
+// CreateDataExport implements repository.UserRepository.
+func (m *MockUserRepository) CreateDataExport(ctx context.Context, export *entity.DataExport) error {
+	args := m.Called(ctx, export)
+	return args.Error(0)
+}
+
+// GetDataExport implements repository.UserRepository.
+func (m *MockUserRepository) GetDataExport(ctx context.Context, exportID uuid.UUID) (*entity.DataExport, error) {
+	args := m.Called(ctx, exportID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DataExport), args.Error(1)
+}
+
+// ClaimDataExport implements repository.UserRepository.
+func (m *MockUserRepository) ClaimDataExport(ctx context.Context, staleBefore time.Time) (*entity.DataExport, error) {
+	args := m.Called(ctx, staleBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DataExport), args.Error(1)
+}
+
+// CompleteDataExport implements repository.UserRepository.
+func (m *MockUserRepository) CompleteDataExport(ctx context.Context, export *entity.DataExport) error {
+	args := m.Called(ctx, export)
+	return args.Error(0)
+}
+
+// FailDataExport implements repository.UserRepository.
+func (m *MockUserRepository) FailDataExport(ctx context.Context, exportID uuid.UUID, reason string, expiresAt time.Time) error {
+	args := m.Called(ctx, exportID, reason, expiresAt)
+	return args.Error(0)
+}
+
+// DeleteExpiredDataExports implements repository.UserRepository.
+func (m *MockUserRepository) DeleteExpiredDataExports(ctx context.Context, now time.Time) ([]*entity.DataExport, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.DataExport), args.Error(1)
+}
+
+// CreateOrganization implements repository.UserRepository.
+func (m *MockUserRepository) CreateOrganization(ctx context.Context, organization *entity.Organization, ownerRole string) error {
+	args := m.Called(ctx, organization, ownerRole)
+	return args.Error(0)
+}
+
+// GetOrganization implements repository.UserRepository.
+func (m *MockUserRepository) GetOrganization(ctx context.Context, organizationID uuid.UUID) (*entity.Organization, error) {
+	args := m.Called(ctx, organizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Organization), args.Error(1)
+}
+
+// ListUserOrganizations implements repository.UserRepository.
+func (m *MockUserRepository) ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]*entity.Organization, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Organization), args.Error(1)
+}
+
+// GetOrganizationMember implements repository.UserRepository.
+func (m *MockUserRepository) GetOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID) (*entity.OrganizationMember, error) {
+	args := m.Called(ctx, organizationID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OrganizationMember), args.Error(1)
+}
+
+// ListOrganizationMembers implements repository.UserRepository.
+func (m *MockUserRepository) ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]*entity.OrganizationMember, error) {
+	args := m.Called(ctx, organizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.OrganizationMember), args.Error(1)
+}
+
+// CountOrganizationOwners implements repository.UserRepository.
+func (m *MockUserRepository) CountOrganizationOwners(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, organizationID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// UpdateOrganizationMemberRole implements repository.UserRepository.
+func (m *MockUserRepository) UpdateOrganizationMemberRole(ctx context.Context, organizationID, userID uuid.UUID, role string) error {
+	args := m.Called(ctx, organizationID, userID, role)
+	return args.Error(0)
+}
+
+// RemoveOrganizationMember implements repository.UserRepository.
+func (m *MockUserRepository) RemoveOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID) error {
+	args := m.Called(ctx, organizationID, userID)
+	return args.Error(0)
+}
+
+// CreateOrganizationInvitation implements repository.UserRepository.
+func (m *MockUserRepository) CreateOrganizationInvitation(ctx context.Context, invitation *entity.OrganizationInvitation) error {
+	args := m.Called(ctx, invitation)
+	return args.Error(0)
+}
+
+// GetOrganizationInvitation implements repository.UserRepository.
+func (m *MockUserRepository) GetOrganizationInvitation(ctx context.Context, invitationID uuid.UUID) (*entity.OrganizationInvitation, error) {
+	args := m.Called(ctx, invitationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OrganizationInvitation), args.Error(1)
+}
+
+// GetOrganizationInvitationByToken implements repository.UserRepository.
+func (m *MockUserRepository) GetOrganizationInvitationByToken(ctx context.Context, tokenHash string) (*entity.OrganizationInvitation, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OrganizationInvitation), args.Error(1)
+}
+
+// ListPendingOrganizationInvitations implements repository.UserRepository.
+func (m *MockUserRepository) ListPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID) ([]*entity.OrganizationInvitation, error) {
+	args := m.Called(ctx, organizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.OrganizationInvitation), args.Error(1)
+}
+
+// AcceptOrganizationInvitation implements repository.UserRepository.
+func (m *MockUserRepository) AcceptOrganizationInvitation(ctx context.Context, invitation *entity.OrganizationInvitation, userID uuid.UUID) (*entity.OrganizationMember, error) {
+	args := m.Called(ctx, invitation, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OrganizationMember), args.Error(1)
+}
+
+// RespondToOrganizationInvitation implements repository.UserRepository.
+func (m *MockUserRepository) RespondToOrganizationInvitation(ctx context.Context, invitationID uuid.UUID, status string) error {
+	args := m.Called(ctx, invitationID, status)
+	return args.Error(0)
+}
+
+// SetSessionOrganization implements repository.UserRepository.
+func (m *MockUserRepository) SetSessionOrganization(ctx context.Context, sessionID, userID uuid.UUID, organizationID *uuid.UUID) error {
+	args := m.Called(ctx, sessionID, userID, organizationID)
+	return args.Error(0)
+}
+
+// SubmitAgentVerification implements repository.UserRepository.
+func (m *MockUserRepository) SubmitAgentVerification(ctx context.Context, verification *entity.AgentVerification) error {
+	args := m.Called(ctx, verification)
+	return args.Error(0)
+}
+
+// GetAgentVerification implements repository.UserRepository.
+func (m *MockUserRepository) GetAgentVerification(ctx context.Context, userID uuid.UUID) (*entity.AgentVerification, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AgentVerification), args.Error(1)
+}
+
+// ListAgentVerifications implements repository.UserRepository.
+func (m *MockUserRepository) ListAgentVerifications(ctx context.Context, filter entity.AgentVerificationFilter) ([]*entity.AgentVerification, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AgentVerification), args.Error(1)
+}
+
+// CountAgentVerifications implements repository.UserRepository.
+func (m *MockUserRepository) CountAgentVerifications(ctx context.Context, status string) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ReviewAgentVerification implements repository.UserRepository.
+func (m *MockUserRepository) ReviewAgentVerification(ctx context.Context, userID uuid.UUID, status string, note string, reviewerID uuid.UUID) error {
+	args := m.Called(ctx, userID, status, note, reviewerID)
+	return args.Error(0)
+}
+
+// UploadAgentDocument implements repository.UserRepository.
+func (m *MockUserRepository) UploadAgentDocument(ctx context.Context, content io.Reader, document *entity.AgentDocument) error {
+	args := m.Called(ctx, content, document)
+	return args.Error(0)
+}
+
+// ListAgentDocuments implements repository.UserRepository.
+func (m *MockUserRepository) ListAgentDocuments(ctx context.Context, userID uuid.UUID) ([]*entity.AgentDocument, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AgentDocument), args.Error(1)
+}
+
+// CreateAPIKey implements repository.UserRepository.
+func (m *MockUserRepository) CreateAPIKey(ctx context.Context, key *entity.APIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// GetAPIKey implements repository.UserRepository.
+func (m *MockUserRepository) GetAPIKey(ctx context.Context, keyID uuid.UUID) (*entity.APIKey, error) {
+	args := m.Called(ctx, keyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.APIKey), args.Error(1)
+}
+
+// GetAPIKeyByHash implements repository.UserRepository.
+func (m *MockUserRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	args := m.Called(ctx, keyHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.APIKey), args.Error(1)
+}
+
+// ListAPIKeys implements repository.UserRepository.
+func (m *MockUserRepository) ListAPIKeys(ctx context.Context, userID uuid.UUID, organizationID *uuid.UUID) ([]*entity.APIKey, error) {
+	args := m.Called(ctx, userID, organizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.APIKey), args.Error(1)
+}
+
+// CountActiveAPIKeys implements repository.UserRepository.
+func (m *MockUserRepository) CountActiveAPIKeys(ctx context.Context, userID uuid.UUID, organizationID *uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID, organizationID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// RevokeAPIKey implements repository.UserRepository.
+func (m *MockUserRepository) RevokeAPIKey(ctx context.Context, key *entity.APIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// TouchAPIKey implements repository.UserRepository.
+func (m *MockUserRepository) TouchAPIKey(ctx context.Context, keyID uuid.UUID) error {
+	args := m.Called(ctx, keyID)
+	return args.Error(0)
+}
+
+// CreateAPIKeyToken implements repository.UserRepository.
+func (m *MockUserRepository) CreateAPIKeyToken(ctx context.Context, key *entity.APIKey, user *entity.User, organizationRole string, duration time.Duration) (string, time.Time, error) {
+	args := m.Called(ctx, key, user, organizationRole, duration)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	verificationCodeTTL     = 10 * time.Minute
+	verificationMaxAttempts = 5
+	resetTokenBytes         = 32
+)
+
+// issueVerificationCode creates a challenge for purpose and returns the code to
+// email to the user. Any code previously issued for the same purpose stops working.
+func (u *userUsecase) issueVerificationCode(ctx context.Context, userID uuid.UUID, purpose string) (string, error) {
+	code, err := utils.GenerateOTP()
+	if err != nil {
+		return "", err
+	}
+
+	salt, err := utils.GenerateOTPSalt()
+	if err != nil {
+		return "", err
+	}
+
+	challenge := &entity.VerificationChallenge{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Purpose:     purpose,
+		CodeHash:    utils.HashOTP(code, salt),
+		Salt:        salt,
+		MaxAttempts: verificationMaxAttempts,
+		IpAddress:   clientIP(ctx),
+		UserAgent:   utils.ExtractMetaData(ctx).UserAgent,
+		ExpiresAt:   time.Now().Add(verificationCodeTTL).UTC(),
+	}
+
+	if err := u.userRepo.CreateVerificationChallenge(ctx, challenge); err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// checkVerificationCode validates code against the user's pending challenge for
+// purpose, marks it verified and returns it. A wrong code counts against the
+// challenge, which stops accepting codes once its attempts are used up.
+func (u *userUsecase) checkVerificationCode(ctx context.Context, userID uuid.UUID, purpose string, code string) (*entity.VerificationChallenge, error) {
+	if !utils.ValidateOTP(code) {
+		return nil, entity.ErrVerificationCodeInvalid
+	}
+
+	challenge, err := u.userRepo.GetActiveVerificationChallenge(ctx, userID, purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.Attempts >= challenge.MaxAttempts {
+		return nil, entity.ErrVerificationAttemptsExceeded
+	}
+
+	if time.Now().After(challenge.ExpiresAt) {
+		return nil, entity.ErrVerificationCodeExpired
+	}
+
+	if !utils.CompareOTP(code, challenge.Salt, challenge.CodeHash) {
+		if _, err := u.userRepo.IncrementVerificationChallengeAttempts(ctx, challenge.ID); err != nil {
+			return nil, err
+		}
+		return nil, entity.ErrVerificationCodeInvalid
+	}
+
+	// A challenge that is already verified is waiting to be consumed, e.g. by
+	// ResetPassword, so entering the same code again is not an error
+	if challenge.VerifiedAt != nil {
+		return challenge, nil
+	}
+
+	// Guards against a concurrent request using up the last attempt or the challenge expiring
+	verified, err := u.userRepo.MarkVerificationChallengeVerified(ctx, challenge.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, entity.ErrVerificationCodeInvalid
+	}
+
+	return challenge, nil
+}
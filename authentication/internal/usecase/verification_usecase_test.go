@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/demola234/authentication/infrastructure/mailer"
+	"github.com/demola234/authentication/internal/domain/entity"
+	"github.com/demola234/authentication/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var otpPattern = regexp.MustCompile(`\b\d{6}\b`)
+
+// captureVerificationChallenge records the challenge the usecase stores
+func captureVerificationChallenge(mockRepo *MockUserRepository, ctx context.Context) *entity.VerificationChallenge {
+	challenge := &entity.VerificationChallenge{}
+	mockRepo.On("CreateVerificationChallenge", ctx, mock.AnythingOfType("*entity.VerificationChallenge")).
+		Run(func(args mock.Arguments) { *challenge = *args.Get(1).(*entity.VerificationChallenge) }).
+		Return(nil)
+	return challenge
+}
+
+// sentOtp extracts the verification code from an email
+func sentOtp(t *testing.T, msg *mailer.Message) string {
+	t.Helper()
+	require.NotNil(t, msg)
+
+	otp := otpPattern.FindString(msg.TextBody)
+	require.NotEmpty(t, otp, "email does not contain a verification code")
+	return otp
+}
+
+func newVerificationChallenge(userID uuid.UUID, purpose string, code string) *entity.VerificationChallenge {
+	salt, _ := utils.GenerateOTPSalt()
+	return &entity.VerificationChallenge{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Purpose:     purpose,
+		CodeHash:    utils.HashOTP(code, salt),
+		Salt:        salt,
+		MaxAttempts: verificationMaxAttempts,
+		ExpiresAt:   time.Now().Add(verificationCodeTTL),
+	}
+}
+
+func TestIssueVerificationCodeStoresOnlyHash(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue)).(*userUsecase)
+	ctx := context.Background()
+
+	challenge := captureVerificationChallenge(mockRepo, ctx)
+
+	userID := uuid.New()
+	code, err := useCase.issueVerificationCode(ctx, userID, entity.VerificationPurposeLogin)
+	require.NoError(t, err)
+	require.True(t, utils.ValidateOTP(code))
+
+	require.Equal(t, userID, challenge.UserID)
+	require.Equal(t, entity.VerificationPurposeLogin, challenge.Purpose)
+	require.Equal(t, verificationMaxAttempts, challenge.MaxAttempts)
+	require.NotEqual(t, code, challenge.CodeHash)
+	require.NotContains(t, challenge.CodeHash, code)
+	require.NotEmpty(t, challenge.Salt)
+	require.True(t, utils.CompareOTP(code, challenge.Salt, challenge.CodeHash))
+	require.WithinDuration(t, time.Now().Add(verificationCodeTTL), challenge.ExpiresAt, time.Minute)
+}
+
+func TestVerifyOtp(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "test@example.com"
+	user := &entity.User{ID: uuid.New(), Email: email}
+	challenge := newVerificationChallenge(user.ID, entity.VerificationPurposeSignup, "123456")
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("ResetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(user, nil)
+	mockRepo.On("GetActiveVerificationChallenge", ctx, user.ID, entity.VerificationPurposeSignup).Return(challenge, nil)
+	mockRepo.On("MarkVerificationChallengeVerified", ctx, challenge.ID).Return(true, nil)
+	mockRepo.On("ConsumeVerificationChallenge", ctx, user.ID, entity.VerificationPurposeSignup).Return(true, nil)
+	mockRepo.On("MarkEmailVerified", ctx, user.ID).Return(nil)
+	mockRepo.On("CreateToken", ctx, email).Return("test-token", time.Now().Add(15*time.Minute), nil)
+	mockRepo.On("CreateSession", ctx, mock.AnythingOfType("*entity.Session")).Return(nil)
+
+	// Execute test
+	verified, session, err := useCase.VerifyOtp(ctx, email, "123456")
+
+	// Assertions
+	require.NoError(t, err)
+	require.Equal(t, user.ID, verified.ID)
+	require.Equal(t, user.ID, session.UserID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestVerifyOtpWrongCodeCountsAttempt(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "test@example.com"
+	user := &entity.User{ID: uuid.New(), Email: email}
+	challenge := newVerificationChallenge(user.ID, entity.VerificationPurposeSignup, "123456")
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("RecordAuthFailure", ctx, mock.AnythingOfType("entity.ThrottleKey"), mock.AnythingOfType("time.Time")).
+		Return(&entity.AuthThrottle{FailedAttempts: 1}, nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(user, nil)
+	mockRepo.On("GetActiveVerificationChallenge", ctx, user.ID, entity.VerificationPurposeSignup).Return(challenge, nil)
+	mockRepo.On("IncrementVerificationChallengeAttempts", ctx, challenge.ID).Return(1, nil)
+
+	// Execute test
+	_, session, err := useCase.VerifyOtp(ctx, email, "654321")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrVerificationCodeInvalid)
+	require.Nil(t, session)
+	require.NotContains(t, err.Error(), "123456")
+	mockRepo.AssertNotCalled(t, "MarkEmailVerified", ctx, user.ID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestVerifyOtpExpired(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "test@example.com"
+	user := &entity.User{ID: uuid.New(), Email: email}
+	challenge := newVerificationChallenge(user.ID, entity.VerificationPurposeSignup, "123456")
+	challenge.ExpiresAt = time.Now().Add(-time.Minute)
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(user, nil)
+	mockRepo.On("GetActiveVerificationChallenge", ctx, user.ID, entity.VerificationPurposeSignup).Return(challenge, nil)
+
+	// Execute test
+	_, _, err := useCase.VerifyOtp(ctx, email, "123456")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrVerificationCodeExpired)
+	mockRepo.AssertNotCalled(t, "MarkVerificationChallengeVerified", ctx, challenge.ID)
+}
+
+func TestVerifyResetPasswordAttemptsExceeded(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "test@example.com"
+	user := &entity.User{ID: uuid.New(), Email: email}
+	challenge := newVerificationChallenge(user.ID, entity.VerificationPurposePasswordReset, "123456")
+	challenge.Attempts = challenge.MaxAttempts
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(user, nil)
+	mockRepo.On("GetActiveVerificationChallenge", ctx, user.ID, entity.VerificationPurposePasswordReset).Return(challenge, nil)
+
+	// Execute test: even the right code is refused once the attempts are used up
+	resetToken, err := useCase.VerifyResetPassword(ctx, email, "123456")
+
+	// Assertions
+	require.ErrorIs(t, err, entity.ErrVerificationAttemptsExceeded)
+	require.Empty(t, resetToken)
+	mockRepo.AssertNotCalled(t, "MarkVerificationChallengeVerified", ctx, challenge.ID)
+}
+
+func TestVerifyResetPasswordIssuesResetToken(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	email := "test@example.com"
+	user := &entity.User{ID: uuid.New(), Email: email}
+	challenge := newVerificationChallenge(user.ID, entity.VerificationPurposePasswordReset, "123456")
+
+	var storedHash string
+
+	// Mock behavior
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil, nil)
+	mockRepo.On("ResetAuthThrottle", ctx, mock.AnythingOfType("entity.ThrottleKey")).Return(nil)
+	mockRepo.On("GetUserByEmail", ctx, email).Return(user, nil)
+	mockRepo.On("GetActiveVerificationChallenge", ctx, user.ID, entity.VerificationPurposePasswordReset).Return(challenge, nil)
+	mockRepo.On("MarkVerificationChallengeVerified", ctx, challenge.ID).Return(true, nil)
+	mockRepo.On("SetVerificationChallengeResetToken", ctx, challenge.ID, mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { storedHash = args.String(2) }).
+		Return(true, nil)
+
+	// Execute test
+	resetToken, err := useCase.VerifyResetPassword(ctx, email, "123456")
+
+	// Assertions: only a hash of the token is stored
+	require.NoError(t, err)
+	require.NotEmpty(t, resetToken)
+	require.Equal(t, utils.HashToken(resetToken), storedHash)
+	require.NotEqual(t, resetToken, storedHash)
+}
+
+func TestResetPasswordRequiresResetToken(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	// Mock behavior
+	mockRepo.On("GetVerificationChallengeByResetToken", ctx, utils.HashToken("unknown-token")).Return(nil, entity.ErrVerificationNotFound)
+
+	// Execute test: neither a missing nor an unknown token resets the password
+	err := useCase.ResetPassword(ctx, "", "NewPassword123!")
+	require.ErrorIs(t, err, entity.ErrResetTokenInvalid)
+
+	err = useCase.ResetPassword(ctx, "unknown-token", "NewPassword123!")
+	require.ErrorIs(t, err, entity.ErrResetTokenInvalid)
+
+	mockRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestResetPasswordConsumesResetToken(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUsecase(mockRepo, new(MockOauthRepository), mailer.NewMemoryMailer(), new(MockMessageQueue))
+	ctx := context.Background()
+
+	user := &entity.User{ID: uuid.New(), Email: "test@example.com"}
+	challenge := newVerificationChallenge(user.ID, entity.VerificationPurposePasswordReset, "123456")
+	tokenHash := utils.HashToken("reset-token")
+
+	// Mock behavior: the token is accepted once and rejected when replayed
+	mockRepo.On("CreateAuthEvent", ctx, mock.AnythingOfType("*entity.AuthEvent")).Return(nil)
+	mockRepo.On("GetVerificationChallengeByResetToken", ctx, tokenHash).Return(challenge, nil)
+	mockRepo.On("GetUserByID", ctx, user.ID.String()).Return(user, nil)
+	mockRepo.On("ListPasswordHistory", ctx, user.ID, mock.AnythingOfType("int")).Return([]string{}, nil)
+	mockRepo.On("ConsumeVerificationChallengeByResetToken", ctx, tokenHash).Return(true, nil).Once()
+	mockRepo.On("ConsumeVerificationChallengeByResetToken", ctx, tokenHash).Return(false, nil).Once()
+	mockRepo.On("UpdatePassword", ctx, user.Email, mock.AnythingOfType("string")).Return(nil).Once()
+
+	// Execute test
+	err := useCase.ResetPassword(ctx, "reset-token", "NewPassword123!")
+	require.NoError(t, err)
+
+	err = useCase.ResetPassword(ctx, "reset-token", "OtherPassword456!")
+	require.ErrorIs(t, err, entity.ErrResetTokenInvalid)
+
+	mockRepo.AssertNumberOfCalls(t, "UpdatePassword", 1)
+}
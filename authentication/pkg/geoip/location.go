@@ -0,0 +1,105 @@
+package geoip
+
+import (
+	"log"
+	"net"
+	"strings"
+	"sync"
+)
+
+// Location is the coarse place an address belongs to. Any field may be empty
+// when the database does not know it.
+type Location struct {
+	City        string
+	Region      string
+	Country     string
+	CountryCode string
+}
+
+// String formats the location for display, e.g. "Lagos, Nigeria"
+func (l Location) String() string {
+	parts := make([]string, 0, 2)
+	if l.City != "" {
+		parts = append(parts, l.City)
+	}
+	switch {
+	case l.Country != "":
+		parts = append(parts, l.Country)
+	case l.CountryCode != "":
+		parts = append(parts, l.CountryCode)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// place is a city, country or subdivision in a GeoIP2 record
+type place struct {
+	IsoCode string            `maxminddb:"iso_code"`
+	Names   map[string]string `maxminddb:"names"`
+}
+
+// Record holds the fields of a GeoIP2/GeoLite2 City or Country record that
+// Location needs
+type Record struct {
+	City              place   `maxminddb:"city"`
+	Country           place   `maxminddb:"country"`
+	RegisteredCountry place   `maxminddb:"registered_country"`
+	Subdivisions      []place `maxminddb:"subdivisions"`
+}
+
+// LocationOf reads the location fields of a record
+func LocationOf(record *Record) Location {
+	country := record.Country
+	if country.IsoCode == "" && len(country.Names) == 0 {
+		country = record.RegisteredCountry
+	}
+
+	location := Location{
+		City:        record.City.Names["en"],
+		Country:     country.Names["en"],
+		CountryCode: country.IsoCode,
+	}
+	if len(record.Subdivisions) > 0 {
+		location.Region = record.Subdivisions[0].Names["en"]
+	}
+	return location
+}
+
+// Locate returns the location of ip in r, or an empty location when it is unknown
+func (r *Reader) Locate(ip string) Location {
+	address := net.ParseIP(ip)
+	if address == nil {
+		return Location{}
+	}
+
+	record, err := r.Lookup(address)
+	if err != nil {
+		log.Printf("failed to look up %s in geoip database: %v", ip, err)
+		return Location{}
+	}
+	return LocationOf(record)
+}
+
+var (
+	databaseMu sync.RWMutex
+	database   *Reader
+)
+
+// SetDatabase replaces the database used by Locate. It is called once at
+// startup when a database file is configured; until then Locate knows nothing.
+func SetDatabase(r *Reader) {
+	databaseMu.Lock()
+	defer databaseMu.Unlock()
+	database = r
+}
+
+// Locate returns the location of ip in the configured database
+func Locate(ip string) Location {
+	databaseMu.RLock()
+	r := database
+	databaseMu.RUnlock()
+
+	if r == nil {
+		return Location{}
+	}
+	return r.Locate(ip)
+}
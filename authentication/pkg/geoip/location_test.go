@@ -0,0 +1,36 @@
+package geoip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationOf(t *testing.T) {
+	record := &Record{
+		City:         place{Names: map[string]string{"en": "Lagos"}},
+		Country:      place{IsoCode: "NG", Names: map[string]string{"en": "Nigeria"}},
+		Subdivisions: []place{{Names: map[string]string{"en": "Lagos State"}}},
+	}
+
+	location := LocationOf(record)
+	require.Equal(t, Location{City: "Lagos", Region: "Lagos State", Country: "Nigeria", CountryCode: "NG"}, location)
+	require.Equal(t, "Lagos, Nigeria", location.String())
+
+	// Anycast and satellite ranges only carry the registered country
+	location = LocationOf(&Record{RegisteredCountry: place{IsoCode: "US"}})
+	require.Equal(t, Location{CountryCode: "US"}, location)
+	require.Equal(t, "US", location.String())
+
+	require.Equal(t, Location{}, LocationOf(&Record{}))
+}
+
+func TestNewReaderRejectsInvalidDatabase(t *testing.T) {
+	_, err := NewReader([]byte("not a database"))
+	require.Error(t, err)
+}
+
+func TestLocateWithoutDatabase(t *testing.T) {
+	SetDatabase(nil)
+	require.Equal(t, Location{}, Locate("203.0.113.7"))
+}
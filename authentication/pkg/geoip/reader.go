@@ -0,0 +1,49 @@
+package geoip
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// Reader looks up IP addresses in a MaxMind DB file such as GeoLite2-City or
+// GeoLite2-Country. It is safe for concurrent use.
+type Reader struct {
+	db *maxminddb.Reader
+}
+
+// Open reads the database at path
+func Open(path string) (*Reader, error) {
+	db, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open geoip database: %w", err)
+	}
+
+	return &Reader{db: db}, nil
+}
+
+// NewReader parses a database already in memory
+func NewReader(buf []byte) (*Reader, error) {
+	db, err := maxminddb.FromBytes(buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse geoip database: %w", err)
+	}
+
+	return &Reader{db: db}, nil
+}
+
+// Lookup returns the record stored for ip. The record is empty when the
+// database has none.
+func (r *Reader) Lookup(ip net.IP) (*Record, error) {
+	var record Record
+	if err := r.db.Lookup(ip, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Close releases the database
+func (r *Reader) Close() error {
+	return r.db.Close()
+}
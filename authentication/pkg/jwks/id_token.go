@@ -0,0 +1,196 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformedToken   = errors.New("malformed id token")
+	ErrInvalidSignature = errors.New("invalid id token signature")
+	ErrInvalidIssuer    = errors.New("invalid id token issuer")
+	ErrInvalidAudience  = errors.New("invalid id token audience")
+	ErrTokenExpired     = errors.New("id token has expired")
+	ErrTokenNotYetValid = errors.New("id token is not valid yet")
+)
+
+// VerifyOptions are the claims an ID token must satisfy
+type VerifyOptions struct {
+	// Issuers lists the accepted values of the iss claim
+	Issuers []string
+	// IssuerFunc accepts issuers that cannot be listed up front, such as
+	// per-tenant issuers of multi-tenant providers. It is used when set.
+	IssuerFunc func(issuer string) bool
+	// Audience is the client ID the token must have been issued to
+	Audience string
+	// Leeway allows for clock skew when checking exp, nbf and iat
+	Leeway time.Duration
+}
+
+// Claims holds the standard and profile claims of a verified ID token
+type Claims struct {
+	Issuer        string
+	Subject       string
+	Audience      []string
+	ExpiresAt     time.Time
+	IssuedAt      time.Time
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+	Nonce         string
+}
+
+type rawClaims struct {
+	Iss           string          `json:"iss"`
+	Sub           string          `json:"sub"`
+	Aud           json.RawMessage `json:"aud"`
+	Exp           int64           `json:"exp"`
+	Iat           int64           `json:"iat"`
+	Nbf           int64           `json:"nbf"`
+	Email         string          `json:"email"`
+	EmailVerified json.RawMessage `json:"email_verified"`
+	Name          string          `json:"name"`
+	Picture       string          `json:"picture"`
+	Nonce         string          `json:"nonce"`
+}
+
+// VerifyIDToken checks an RS256 signed ID token against the cached keys and
+// validates its issuer, audience and lifetime.
+func (c *Cache) VerifyIDToken(ctx context.Context, token string, opts VerifyOptions) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("%w: unsupported alg %q", ErrMalformedToken, header.Alg)
+	}
+
+	key, err := c.Key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, ErrInvalidSignature
+	}
+
+	var raw rawClaims
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return nil, err
+	}
+
+	claims, err := raw.toClaims()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := claims.validate(c.now(), opts); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+func (c *Claims) validate(now time.Time, opts VerifyOptions) error {
+	if opts.IssuerFunc != nil {
+		if !opts.IssuerFunc(c.Issuer) {
+			return ErrInvalidIssuer
+		}
+	} else if !slices.Contains(opts.Issuers, c.Issuer) {
+		return ErrInvalidIssuer
+	}
+
+	if opts.Audience == "" || !slices.Contains(c.Audience, opts.Audience) {
+		return ErrInvalidAudience
+	}
+
+	if c.ExpiresAt.IsZero() || now.After(c.ExpiresAt.Add(opts.Leeway)) {
+		return ErrTokenExpired
+	}
+
+	if !c.IssuedAt.IsZero() && now.Add(opts.Leeway).Before(c.IssuedAt) {
+		return ErrTokenNotYetValid
+	}
+
+	return nil
+}
+
+func (r *rawClaims) toClaims() (*Claims, error) {
+	claims := &Claims{
+		Issuer:  r.Iss,
+		Subject: r.Sub,
+		Email:   r.Email,
+		Name:    r.Name,
+		Picture: r.Picture,
+		Nonce:   r.Nonce,
+	}
+
+	if r.Exp != 0 {
+		claims.ExpiresAt = time.Unix(r.Exp, 0)
+	}
+	// A token carrying nbf is not acceptable before it, just like iat
+	if notBefore := max(r.Iat, r.Nbf); notBefore != 0 {
+		claims.IssuedAt = time.Unix(notBefore, 0)
+	}
+
+	// aud is either a single string or an array of strings
+	if len(r.Aud) > 0 {
+		var single string
+		if err := json.Unmarshal(r.Aud, &single); err == nil {
+			claims.Audience = []string{single}
+		} else if err := json.Unmarshal(r.Aud, &claims.Audience); err != nil {
+			return nil, ErrMalformedToken
+		}
+	}
+
+	// Google sends email_verified as a boolean, Apple as the string "true" or "false"
+	if len(r.EmailVerified) > 0 {
+		var verified bool
+		if err := json.Unmarshal(r.EmailVerified, &verified); err == nil {
+			claims.EmailVerified = verified
+		} else {
+			var verifiedString string
+			if err := json.Unmarshal(r.EmailVerified, &verifiedString); err != nil {
+				return nil, ErrMalformedToken
+			}
+			claims.EmailVerified = verifiedString == "true"
+		}
+	}
+
+	return claims, nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrMalformedToken
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return ErrMalformedToken
+	}
+	return nil
+}
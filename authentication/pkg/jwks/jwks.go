@@ -0,0 +1,164 @@
+package jwks
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var (
+	ErrKeyNotFound = errors.New("signing key not found")
+)
+
+const (
+	// minRefreshInterval stops tokens with made up key IDs from hammering the provider
+	minRefreshInterval = time.Minute
+)
+
+// KeySource fetches the current set of RSA signing keys indexed by key ID
+type KeySource interface {
+	FetchKeys(ctx context.Context) (map[string]*rsa.PublicKey, error)
+}
+
+// HTTPKeySource fetches a JSON Web Key Set from a URL
+type HTTPKeySource struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPKeySource creates a key source for the JWKS published at url
+func NewHTTPKeySource(url string, client *http.Client) *HTTPKeySource {
+	return &HTTPKeySource{url: url, client: client}
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// FetchKeys implements KeySource
+func (s *HTTPKeySource) FetchKeys(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch jwks: %s", resp.Status)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to decode jwks: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+
+		key, err := parseRSAKey(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+
+	return keys, nil
+}
+
+func parseRSAKey(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode modulus: %w", err)
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode exponent: %w", err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() < 3 {
+		return nil, fmt.Errorf("invalid exponent")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
+
+// Cache keeps the keys from a KeySource in memory for a TTL. A key ID that is
+// not in the cache triggers an early refresh so provider key rotation is picked
+// up without waiting for the TTL to run out.
+type Cache struct {
+	source     KeySource
+	ttl        time.Duration
+	minRefresh time.Duration
+	now        func() time.Time
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+// NewCache creates a cache over source whose keys are refetched after ttl
+func NewCache(source KeySource, ttl time.Duration) *Cache {
+	return &Cache{
+		source:     source,
+		ttl:        ttl,
+		minRefresh: minRefreshInterval,
+		now:        time.Now,
+	}
+}
+
+// Key returns the public key with the given key ID
+func (c *Cache) Key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	expired := c.keys == nil || now.Sub(c.fetchedAt) >= c.ttl
+
+	if !expired {
+		if key, ok := c.keys[kid]; ok {
+			return key, nil
+		}
+		if now.Sub(c.fetchedAt) < c.minRefresh {
+			return nil, ErrKeyNotFound
+		}
+	}
+
+	keys, err := c.source.FetchKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.keys = keys
+	c.fetchedAt = now
+
+	key, ok := c.keys[kid]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
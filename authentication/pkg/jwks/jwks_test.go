@@ -0,0 +1,216 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer   = "https://accounts.example.com"
+	testAudience = "client-id.example.com"
+)
+
+// fakeJWKSServer serves a JSON Web Key Set whose keys can be rotated by tests
+type fakeJWKSServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	keys     map[string]*rsa.PrivateKey
+	requests atomic.Int32
+}
+
+func newFakeJWKSServer(t *testing.T) *fakeJWKSServer {
+	f := &fakeJWKSServer{keys: map[string]*rsa.PrivateKey{}}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.requests.Add(1)
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		var set struct {
+			Keys []jsonWebKey `json:"keys"`
+		}
+		for kid, key := range f.keys {
+			set.Keys = append(set.Keys, jsonWebKey{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeJWKSServer) addKey(t *testing.T, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.keys[kid] = key
+	return key
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"iss":            testIssuer,
+		"aud":            testAudience,
+		"sub":            "1234567890",
+		"email":          "user@example.com",
+		"email_verified": true,
+		"name":           "Test User",
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func verifyOptions() VerifyOptions {
+	return VerifyOptions{Issuers: []string{testIssuer}, Audience: testAudience, Leeway: time.Minute}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	server := newFakeJWKSServer(t)
+	key := server.addKey(t, "key-1")
+	cache := NewCache(NewHTTPKeySource(server.URL, server.Client()), time.Hour)
+
+	claims, err := cache.VerifyIDToken(context.Background(), signToken(t, key, "key-1", validClaims()), verifyOptions())
+	require.NoError(t, err)
+	require.Equal(t, "1234567890", claims.Subject)
+	require.Equal(t, "user@example.com", claims.Email)
+	require.True(t, claims.EmailVerified)
+	require.Equal(t, []string{testAudience}, claims.Audience)
+}
+
+func TestVerifyIDTokenRejectsInvalidClaims(t *testing.T) {
+	server := newFakeJWKSServer(t)
+	key := server.addKey(t, "key-1")
+	cache := NewCache(NewHTTPKeySource(server.URL, server.Client()), time.Hour)
+
+	testCases := []struct {
+		name   string
+		modify func(claims map[string]any)
+		err    error
+	}{
+		{"wrong issuer", func(c map[string]any) { c["iss"] = "https://evil.example.com" }, ErrInvalidIssuer},
+		{"wrong audience", func(c map[string]any) { c["aud"] = []string{"someone-else"} }, ErrInvalidAudience},
+		{"expired", func(c map[string]any) { c["exp"] = time.Now().Add(-time.Hour).Unix() }, ErrTokenExpired},
+		{"missing expiry", func(c map[string]any) { delete(c, "exp") }, ErrTokenExpired},
+		{"issued in the future", func(c map[string]any) { c["iat"] = time.Now().Add(time.Hour).Unix() }, ErrTokenNotYetValid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			claims := validClaims()
+			tc.modify(claims)
+
+			_, err := cache.VerifyIDToken(context.Background(), signToken(t, key, "key-1", claims), verifyOptions())
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestVerifyIDTokenRejectsBadSignature(t *testing.T) {
+	server := newFakeJWKSServer(t)
+	server.addKey(t, "key-1")
+	cache := NewCache(NewHTTPKeySource(server.URL, server.Client()), time.Hour)
+
+	// Signed by a key that is not the one published under key-1
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, err = cache.VerifyIDToken(context.Background(), signToken(t, other, "key-1", validClaims()), verifyOptions())
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	_, err = cache.VerifyIDToken(context.Background(), "not-a-token", verifyOptions())
+	require.ErrorIs(t, err, ErrMalformedToken)
+}
+
+func TestCacheRefreshesOnUnknownKid(t *testing.T) {
+	server := newFakeJWKSServer(t)
+	first := server.addKey(t, "key-1")
+	cache := NewCache(NewHTTPKeySource(server.URL, server.Client()), time.Hour)
+
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	_, err := cache.VerifyIDToken(context.Background(), signToken(t, first, "key-1", validClaims()), verifyOptions())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, server.requests.Load())
+
+	// Cached keys are reused
+	_, err = cache.VerifyIDToken(context.Background(), signToken(t, first, "key-1", validClaims()), verifyOptions())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, server.requests.Load())
+
+	// The provider rotates keys; an unknown kid right after a fetch is not refetched
+	second := server.addKey(t, "key-2")
+	_, err = cache.VerifyIDToken(context.Background(), signToken(t, second, "key-2", validClaims()), verifyOptions())
+	require.ErrorIs(t, err, ErrKeyNotFound)
+	require.EqualValues(t, 1, server.requests.Load())
+
+	// Once the minimum refresh interval has passed the unknown kid triggers a refresh
+	now = now.Add(minRefreshInterval)
+	_, err = cache.VerifyIDToken(context.Background(), signToken(t, second, "key-2", validClaims()), verifyOptions())
+	require.NoError(t, err)
+	require.EqualValues(t, 2, server.requests.Load())
+}
+
+func TestCacheRefreshesAfterTTL(t *testing.T) {
+	server := newFakeJWKSServer(t)
+	key := server.addKey(t, "key-1")
+	cache := NewCache(NewHTTPKeySource(server.URL, server.Client()), 10*time.Minute)
+
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	_, err := cache.Key(context.Background(), "key-1")
+	require.NoError(t, err)
+
+	now = now.Add(10 * time.Minute)
+	_, err = cache.VerifyIDToken(context.Background(), signToken(t, key, "key-1", validClaims()), verifyOptions())
+	require.NoError(t, err)
+	require.EqualValues(t, 2, server.requests.Load())
+}
+
+func TestEmailVerifiedAsString(t *testing.T) {
+	server := newFakeJWKSServer(t)
+	key := server.addKey(t, "key-1")
+	cache := NewCache(NewHTTPKeySource(server.URL, server.Client()), time.Hour)
+
+	claims := validClaims()
+	claims["email_verified"] = "true"
+
+	verified, err := cache.VerifyIDToken(context.Background(), signToken(t, key, "key-1", claims), verifyOptions())
+	require.NoError(t, err)
+	require.True(t, verified.EmailVerified)
+}
@@ -2,7 +2,9 @@ package utils
 
 import (
 	"context"
-	"log"
+	"strings"
+
+	"github.com/demola234/api_gateway/infrastructure/middleware"
 
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -25,21 +27,38 @@ type MetaData struct {
 func ExtractMetaData(ctx context.Context) *MetaData {
 	mtdt := &MetaData{}
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		log.Printf("metadata: %v", md)
 		if userAgent := md.Get(grpcGateWayUserAgentHeader); len(userAgent) > 0 {
 			mtdt.UserAgent = userAgent[0]
 		}
 		if userAgent := md.Get(grpcUserAgentHeader); len(userAgent) > 0 {
 			mtdt.UserAgent = userAgent[0]
 		}
-		if clientIp := md.Get(xForwardedFor); len(clientIp) > 0 {
-			mtdt.ClientIP = clientIp[0]
+
+		// Only the gateway's report of the client IP is trusted. It appends the
+		// IP it resolved through its own trusted proxies last, so any hops a
+		// client put before it are ignored.
+		if service, ok := middleware.ServiceFromContext(ctx); ok && service == middleware.GatewayService {
+			mtdt.ClientIP = lastForwardedHop(md.Get(xForwardedFor))
 		}
 	}
 
-	if p, ok := peer.FromContext(ctx); ok {
+	// Everyone else is identified by the peer address
+	if p, ok := peer.FromContext(ctx); ok && mtdt.ClientIP == "" {
 		mtdt.ClientIP = p.Addr.String()
 	}
 
 	return mtdt
 }
+
+// lastForwardedHop returns the right-most address in X-Forwarded-For values
+func lastForwardedHop(values []string) string {
+	for i := len(values) - 1; i >= 0; i-- {
+		hops := strings.Split(values[i], ",")
+		for j := len(hops) - 1; j >= 0; j-- {
+			if hop := strings.TrimSpace(hops[j]); hop != "" {
+				return hop
+			}
+		}
+	}
+	return ""
+}
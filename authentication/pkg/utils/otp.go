@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+)
+
+const (
+	otpDigits = 6
+	otpSalt   = 16
+)
+
+// GenerateOTP returns a random 6 digit code from a cryptographically secure source
+func GenerateOTP() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate otp: %w", err)
+	}
+
+	return fmt.Sprintf("%0*d", otpDigits, n.Int64()), nil
+}
+
+// GenerateOTPSalt returns a random hex encoded salt for HashOTP
+func GenerateOTPSalt() (string, error) {
+	b := make([]byte, otpSalt)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate otp salt: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// HashOTP returns the hex encoded SHA-256 hash of a salted code, which is what
+// gets stored instead of the code itself
+func HashOTP(otp string, salt string) string {
+	sum := sha256.Sum256([]byte(salt + ":" + otp))
+	return hex.EncodeToString(sum[:])
+}
+
+// CompareOTP reports whether otp hashes to hash under salt, in constant time
+func CompareOTP(otp string, salt string, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashOTP(otp, salt)), []byte(hash)) == 1
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateOTP(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		otp, err := GenerateOTP()
+		require.NoError(t, err)
+		require.True(t, ValidateOTP(otp), otp)
+	}
+}
+
+func TestHashOTP(t *testing.T) {
+	salt, err := GenerateOTPSalt()
+	require.NoError(t, err)
+
+	hash := HashOTP("123456", salt)
+	require.NotContains(t, hash, "123456")
+	require.True(t, CompareOTP("123456", salt, hash))
+	require.False(t, CompareOTP("123457", salt, hash))
+
+	// The same code hashes differently under another salt
+	otherSalt, err := GenerateOTPSalt()
+	require.NoError(t, err)
+	require.NotEqual(t, hash, HashOTP("123456", otherSalt))
+	require.False(t, CompareOTP("123456", otherSalt, hash))
+}
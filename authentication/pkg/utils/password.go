@@ -1,20 +1,197 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
-func HashPassword(password string) (string, error) {
-	hashpassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if (err != nil) {
+// ErrPasswordMismatch is returned when a password does not match its hash. It is
+// the bcrypt error so callers that already compare against that keep working.
+var ErrPasswordMismatch = bcrypt.ErrMismatchedHashAndPassword
+
+// ErrUnknownPasswordHash is returned for hashes in a format no hasher understands
+var ErrUnknownPasswordHash = errors.New("unknown password hash format")
+
+const argon2idPrefix = "$argon2id$"
+
+// Argon2Params are the Argon2id cost parameters. Memory is in KiB.
+type Argon2Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2Params follow the OWASP recommendation for Argon2id
+var DefaultArgon2Params = Argon2Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// PasswordHasher hashes new passwords with Argon2id in the PHC string format,
+// e.g. $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>. It still verifies bcrypt
+// hashes so existing users can sign in and be moved over.
+type PasswordHasher struct {
+	params Argon2Params
+}
+
+// NewPasswordHasher creates a hasher using params, falling back to the defaults for unset values
+func NewPasswordHasher(params Argon2Params) *PasswordHasher {
+	if params.Memory == 0 {
+		params.Memory = DefaultArgon2Params.Memory
+	}
+	if params.Iterations == 0 {
+		params.Iterations = DefaultArgon2Params.Iterations
+	}
+	if params.Parallelism == 0 {
+		params.Parallelism = DefaultArgon2Params.Parallelism
+	}
+	if params.SaltLength == 0 {
+		params.SaltLength = DefaultArgon2Params.SaltLength
+	}
+	if params.KeyLength == 0 {
+		params.KeyLength = DefaultArgon2Params.KeyLength
+	}
+
+	return &PasswordHasher{params: params}
+}
+
+// Hash returns the PHC encoded Argon2id hash of password
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
 		return "", fmt.Errorf("failed to hash password %w", err)
 	}
-	return string(hashpassword), nil
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix, argon2.Version, h.params.Memory, h.params.Iterations, h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
 }
 
-func CheckPassword(password string, hashPassword string) error{
-	return bcrypt.CompareHashAndPassword([]byte(hashPassword), []byte(password))
+// Verify checks password against an Argon2id or bcrypt hash
+func (h *PasswordHasher) Verify(password string, hash string) error {
+	switch {
+	case strings.HasPrefix(hash, argon2idPrefix):
+		params, salt, key, err := decodeArgon2id(hash)
+		if err != nil {
+			return err
+		}
+
+		candidate := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+		if subtle.ConstantTimeCompare(candidate, key) != 1 {
+			return ErrPasswordMismatch
+		}
+		return nil
+
+	case isBcryptHash(hash):
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+
+	default:
+		return ErrUnknownPasswordHash
+	}
 }
 
+// NeedsRehash reports whether hash was made with another algorithm or with
+// parameters that differ from the hasher's
+func (h *PasswordHasher) NeedsRehash(hash string) bool {
+	if !strings.HasPrefix(hash, argon2idPrefix) {
+		return true
+	}
+
+	params, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		uint32(len(salt)) != h.params.SaltLength ||
+		uint32(len(key)) != h.params.KeyLength
+}
+
+func decodeArgon2id(hash string) (Argon2Params, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=..,t=..,p=..", salt, key
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return Argon2Params{}, nil, nil, ErrUnknownPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return Argon2Params{}, nil, nil, fmt.Errorf("unsupported argon2 version %q", parts[2])
+	}
+
+	var params Argon2Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return Argon2Params{}, nil, nil, fmt.Errorf("invalid argon2 parameters: %w", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return Argon2Params{}, nil, nil, fmt.Errorf("invalid argon2 salt: %w", err)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return Argon2Params{}, nil, nil, fmt.Errorf("invalid argon2 hash: %w", err)
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
+
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+var (
+	passwordHasherMu sync.RWMutex
+	passwordHasher   = NewPasswordHasher(DefaultArgon2Params)
+)
+
+// SetPasswordHasher replaces the hasher used by HashPassword, CheckPassword and
+// PasswordNeedsRehash. It is called once at startup with the configured costs.
+func SetPasswordHasher(h *PasswordHasher) {
+	passwordHasherMu.Lock()
+	defer passwordHasherMu.Unlock()
+	passwordHasher = h
+}
+
+func defaultPasswordHasher() *PasswordHasher {
+	passwordHasherMu.RLock()
+	defer passwordHasherMu.RUnlock()
+	return passwordHasher
+}
+
+// HashPassword hashes a password with the configured hasher
+func HashPassword(password string) (string, error) {
+	return defaultPasswordHasher().Hash(password)
+}
+
+// CheckPassword checks a password against a hash made by HashPassword or a legacy bcrypt hash
+func CheckPassword(password string, hashPassword string) error {
+	return defaultPasswordHasher().Verify(password, hashPassword)
+}
+
+// PasswordNeedsRehash reports whether a stored hash should be replaced on the next successful login
+func PasswordNeedsRehash(hashPassword string) bool {
+	return defaultPasswordHasher().NeedsRehash(hashPassword)
+}
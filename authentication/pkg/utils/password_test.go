@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -28,3 +29,39 @@ func TestPassword(t *testing.T) {
 	require.NotEmpty(t, hashPassword2)
 	require.NotEmpty(t, hashPassword, hashPassword2)
 }
+
+func TestPasswordHasherArgon2id(t *testing.T) {
+	hasher := NewPasswordHasher(Argon2Params{Memory: 8 * 1024, Iterations: 1, Parallelism: 1})
+
+	hash, err := hasher.Hash("correct horse")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=8192,t=1,p=1$"))
+
+	require.NoError(t, hasher.Verify("correct horse", hash))
+	require.ErrorIs(t, hasher.Verify("battery staple", hash), ErrPasswordMismatch)
+	require.False(t, hasher.NeedsRehash(hash))
+
+	// Raising the cost marks existing hashes as outdated but they still verify
+	stronger := NewPasswordHasher(Argon2Params{Memory: 8 * 1024, Iterations: 2, Parallelism: 1})
+	require.True(t, stronger.NeedsRehash(hash))
+	require.NoError(t, stronger.Verify("correct horse", hash))
+}
+
+func TestPasswordHasherLegacyBcrypt(t *testing.T) {
+	hasher := NewPasswordHasher(Argon2Params{Memory: 8 * 1024, Iterations: 1, Parallelism: 1})
+
+	legacy, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	require.NoError(t, hasher.Verify("correct horse", string(legacy)))
+	require.ErrorIs(t, hasher.Verify("battery staple", string(legacy)), ErrPasswordMismatch)
+	require.True(t, hasher.NeedsRehash(string(legacy)))
+}
+
+func TestPasswordHasherRejectsUnknownFormat(t *testing.T) {
+	hasher := NewPasswordHasher(DefaultArgon2Params)
+
+	require.ErrorIs(t, hasher.Verify("password", "plaintext"), ErrUnknownPasswordHash)
+	require.ErrorIs(t, hasher.Verify("password", "$argon2id$v=19$broken"), ErrUnknownPasswordHash)
+	require.True(t, hasher.NeedsRehash("$argon2id$v=19$broken"))
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// refreshTokenBytes is the amount of entropy in an opaque refresh token
+const refreshTokenBytes = 32
+
+// GenerateRefreshToken returns a new opaque, URL safe refresh token
+func GenerateRefreshToken() (string, error) {
+	b := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken returns the hex encoded SHA-256 hash of an opaque token, which is
+// what gets stored instead of the token itself
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateURLSafeToken returns n random bytes encoded as unpadded base64url,
+// suitable for OAuth state, nonce and PKCE code verifier values
+func GenerateURLSafeToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
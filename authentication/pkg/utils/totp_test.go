@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTOTPKey(t *testing.T) {
+	email := RandomEmail()
+
+	key, err := GenerateTOTPKey("Realio", email)
+	require.NoError(t, err)
+	require.NotEmpty(t, key.Secret)
+	require.True(t, strings.HasPrefix(key.OtpauthURL, "otpauth://totp/"))
+	require.Contains(t, key.OtpauthURL, "issuer=Realio")
+	require.True(t, strings.HasPrefix(key.QRCode, "data:image/png;base64,"))
+}
+
+func TestValidateTOTP(t *testing.T) {
+	key, err := GenerateTOTPKey("Realio", RandomEmail())
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := totp.GenerateCodeCustom(key.Secret, now, totp.ValidateOpts{
+		Period:    TOTPPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	})
+	require.NoError(t, err)
+
+	step, ok := ValidateTOTP(code, key.Secret, now)
+	require.True(t, ok)
+	require.Equal(t, now.Unix()/TOTPPeriod, step)
+
+	// A code from the previous period is still accepted within the allowed skew
+	_, ok = ValidateTOTP(code, key.Secret, now.Add(TOTPPeriod*time.Second))
+	require.True(t, ok)
+
+	// A code far outside the allowed skew is rejected
+	_, ok = ValidateTOTP(code, key.Secret, now.Add(10*TOTPPeriod*time.Second))
+	require.False(t, ok)
+
+	_, ok = ValidateTOTP("abcdef", key.Secret, now)
+	require.False(t, ok)
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	require.NoError(t, err)
+	require.Len(t, codes, 10)
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		require.Len(t, code, 11)
+		require.False(t, seen[code])
+		seen[code] = true
+	}
+
+	hash := HashRecoveryCode(codes[0])
+	require.Len(t, hash, 64)
+	require.Equal(t, hash, HashRecoveryCode(" "+strings.ToUpper(codes[0])+" "))
+	require.Equal(t, hash, HashRecoveryCode(strings.ReplaceAll(codes[0], "-", "")))
+	require.NotEqual(t, hash, HashRecoveryCode(codes[1]))
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	DeviceTypeDesktop = "desktop"
+	DeviceTypeMobile  = "mobile"
+	DeviceTypeTablet  = "tablet"
+	DeviceTypeBot     = "bot"
+	DeviceTypeOther   = "other"
+)
+
+// Device is the browser, operating system and form factor a user agent
+// describes. Versions are left out so a browser update does not look like a
+// new device.
+type Device struct {
+	Browser string
+	OS      string
+	Type    string
+}
+
+// userAgentRule maps a user agent token to a name. Rules are checked in order,
+// so more specific tokens (Edge, Opera) come before the engines they embed.
+type userAgentRule struct {
+	token string
+	name  string
+}
+
+var browserRules = []userAgentRule{
+	{"edg/", "Edge"},
+	{"edga/", "Edge"},
+	{"edgios/", "Edge"},
+	{"opr/", "Opera"},
+	{"samsungbrowser/", "Samsung Internet"},
+	{"firefox/", "Firefox"},
+	{"fxios/", "Firefox"},
+	{"crios/", "Chrome"},
+	{"chrome/", "Chrome"},
+	{"safari/", "Safari"},
+	{"grpc-", "gRPC client"},
+	{"okhttp/", "OkHttp"},
+	{"dart/", "Dart"},
+	{"curl/", "curl"},
+	{"postmanruntime/", "Postman"},
+}
+
+var osRules = []userAgentRule{
+	{"iphone", "iOS"},
+	{"ipad", "iPadOS"},
+	{"android", "Android"},
+	{"windows", "Windows"},
+	{"cros", "ChromeOS"},
+	{"mac os x", "macOS"},
+	{"macintosh", "macOS"},
+	{"linux", "Linux"},
+}
+
+var botTokens = []string{"bot", "crawler", "spider", "slurp"}
+
+// ParseUserAgent picks out the device a user agent string belongs to. Parts it
+// cannot recognise are "Unknown"; an empty user agent gives a zero Device.
+func ParseUserAgent(userAgent string) Device {
+	ua := strings.ToLower(strings.TrimSpace(userAgent))
+	if ua == "" {
+		return Device{}
+	}
+
+	device := Device{
+		Browser: matchUserAgent(ua, browserRules),
+		OS:      matchUserAgent(ua, osRules),
+	}
+
+	switch {
+	case containsAny(ua, botTokens):
+		device.Type = DeviceTypeBot
+	case strings.Contains(ua, "ipad") || strings.Contains(ua, "tablet") ||
+		(strings.Contains(ua, "android") && !strings.Contains(ua, "mobile")):
+		device.Type = DeviceTypeTablet
+	case strings.Contains(ua, "mobi") || strings.Contains(ua, "iphone"):
+		device.Type = DeviceTypeMobile
+	case device.OS == "Windows" || device.OS == "macOS" || device.OS == "Linux" || device.OS == "ChromeOS":
+		device.Type = DeviceTypeDesktop
+	default:
+		device.Type = DeviceTypeOther
+	}
+
+	return device
+}
+
+// String formats the device for display, e.g. "Chrome on macOS"
+func (d Device) String() string {
+	if d == (Device{}) {
+		return ""
+	}
+	return d.Browser + " on " + d.OS
+}
+
+// Fingerprint identifies the device across logins without storing the raw
+// user agent. It is empty for a zero Device.
+func (d Device) Fingerprint() string {
+	if d == (Device{}) {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(d.Browser + "|" + d.OS + "|" + d.Type))
+	return hex.EncodeToString(sum[:])
+}
+
+func matchUserAgent(ua string, rules []userAgentRule) string {
+	for _, rule := range rules {
+		if strings.Contains(ua, rule.token) {
+			return rule.name
+		}
+	}
+	return "Unknown"
+}
+
+func containsAny(s string, tokens []string) bool {
+	for _, token := range tokens {
+		if strings.Contains(s, token) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUserAgent(t *testing.T) {
+	testCases := []struct {
+		userAgent string
+		expected  Device
+	}{
+		{
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36",
+			expected:  Device{Browser: "Chrome", OS: "macOS", Type: DeviceTypeDesktop},
+		},
+		{
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36 Edg/126.0.0.0",
+			expected:  Device{Browser: "Edge", OS: "Windows", Type: DeviceTypeDesktop},
+		},
+		{
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1",
+			expected:  Device{Browser: "Safari", OS: "iOS", Type: DeviceTypeMobile},
+		},
+		{
+			userAgent: "Mozilla/5.0 (Linux; Android 14; SM-X710) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36",
+			expected:  Device{Browser: "Chrome", OS: "Android", Type: DeviceTypeTablet},
+		},
+		{
+			userAgent: "grpc-go/1.64.0",
+			expected:  Device{Browser: "gRPC client", OS: "Unknown", Type: DeviceTypeOther},
+		},
+		{
+			userAgent: "",
+			expected:  Device{},
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, ParseUserAgent(tc.userAgent), tc.userAgent)
+	}
+}
+
+func TestDeviceFingerprint(t *testing.T) {
+	chrome126 := ParseUserAgent("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36")
+	chrome127 := ParseUserAgent("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/127.0.0.0 Safari/537.36")
+	firefox := ParseUserAgent("Mozilla/5.0 (Macintosh; Intel Mac OS X 14.5; rv:127.0) Gecko/20100101 Firefox/127.0")
+
+	require.Equal(t, "Chrome on macOS", chrome126.String())
+	require.Len(t, chrome126.Fingerprint(), 64)
+	require.Equal(t, chrome126.Fingerprint(), chrome127.Fingerprint())
+	require.NotEqual(t, chrome126.Fingerprint(), firefox.Fingerprint())
+	require.Empty(t, Device{}.Fingerprint())
+	require.Empty(t, Device{}.String())
+}
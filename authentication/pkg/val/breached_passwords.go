@@ -0,0 +1,68 @@
+package val
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// breachedPrefixLength is the number of hex characters of the SHA-1 hash that
+// select a range file, as in the Have I Been Pwned range API
+const breachedPrefixLength = 5
+
+// BreachedPasswords checks passwords against a local copy of a breached
+// password list laid out like the Have I Been Pwned range API: one file per
+// 5 character SHA-1 prefix, named <PREFIX>.txt, whose lines are the remaining
+// 35 characters of each hash followed by :<count>. Only the file for the
+// password's prefix is read, so the full list never has to fit in memory and
+// no password or hash leaves the machine.
+type BreachedPasswords struct {
+	dir string
+}
+
+// NewBreachedPasswords opens the range files in dir
+func NewBreachedPasswords(dir string) (*BreachedPasswords, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached password list: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("breached password list %s is not a directory", dir)
+	}
+
+	return &BreachedPasswords{dir: dir}, nil
+}
+
+// Contains reports whether password appears in the list
+func (b *BreachedPasswords) Contains(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:breachedPrefixLength], hash[breachedPrefixLength:]
+
+	file, err := os.Open(filepath.Join(b.dir, prefix+".txt"))
+	if err != nil {
+		// No file means no breached password has this prefix
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		candidate, _, _ := strings.Cut(line, ":")
+		if strings.EqualFold(candidate, suffix) {
+			return true, nil
+		}
+	}
+
+	return false, scanner.Err()
+}
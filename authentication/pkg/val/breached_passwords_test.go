@@ -0,0 +1,43 @@
+package val
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreachedPasswords(t *testing.T) {
+	dir := t.TempDir()
+
+	sum := sha1.Sum([]byte("P@ssw0rd"))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	rangeFile := "0018A45C4D1DEF81644B54AB7F969B88D65:1\n" + hash[5:] + ":52000\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, hash[:5]+".txt"), []byte(rangeFile), 0o600))
+
+	breached, err := NewBreachedPasswords(dir)
+	require.NoError(t, err)
+
+	found, err := breached.Contains("P@ssw0rd")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// Same prefix file, different suffix
+	found, err = breached.Contains("P@ssw0rd!")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	policy := DefaultPasswordPolicy
+	policy.Breached = breached
+	require.ErrorIs(t, policy.Validate("P@ssw0rd"), ErrPasswordBreached)
+	require.NoError(t, policy.Validate("Unlisted-Passw0rd"))
+}
+
+func TestNewBreachedPasswordsRequiresDirectory(t *testing.T) {
+	_, err := NewBreachedPasswords(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
@@ -0,0 +1,172 @@
+package val
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+var (
+	ErrPasswordTooShort        = errors.New("password is too short")
+	ErrPasswordTooLong         = errors.New("password is too long")
+	ErrPasswordCharacterClass  = errors.New("password does not use enough kinds of characters")
+	ErrPasswordRepeats         = errors.New("password repeats the same character too many times")
+	ErrPasswordContainsUser    = errors.New("password must not contain your name or email")
+	ErrPasswordBreached        = errors.New("password has appeared in a data breach, choose another one")
+	ErrPasswordBreachCheckFail = errors.New("failed to check password against breached passwords")
+)
+
+// PasswordPolicy describes what a new password has to look like. Zero values
+// disable the corresponding rule.
+type PasswordPolicy struct {
+	MinLength int
+	MaxLength int
+	// MinCharacterClasses is how many of lower case, upper case, digits and
+	// symbols have to be used
+	MinCharacterClasses int
+	// MaxRepeats is the longest allowed run of the same character
+	MaxRepeats int
+	// CheckUserInfo rejects passwords containing parts of the user's name or email
+	CheckUserInfo bool
+	// HistorySize is how many of the user's most recent passwords, including
+	// the current one, cannot be used again
+	HistorySize int
+	// Breached rejects passwords found in a breached password list
+	Breached *BreachedPasswords
+}
+
+// DefaultPasswordPolicy is used until SetPasswordPolicy is called
+var DefaultPasswordPolicy = PasswordPolicy{
+	MinLength:           8,
+	MaxLength:           128,
+	MinCharacterClasses: 2,
+	MaxRepeats:          3,
+	CheckUserInfo:       true,
+	HistorySize:         5,
+}
+
+// Validate checks password against the policy. userInputs are values such as
+// the user's email and full name that the password must not contain.
+func (p PasswordPolicy) Validate(password string, userInputs ...string) error {
+	length := len([]rune(password))
+	if p.MinLength > 0 && length < p.MinLength {
+		return fmt.Errorf("%w: use at least %d characters", ErrPasswordTooShort, p.MinLength)
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return fmt.Errorf("%w: use at most %d characters", ErrPasswordTooLong, p.MaxLength)
+	}
+
+	if p.MinCharacterClasses > 0 && characterClasses(password) < p.MinCharacterClasses {
+		return fmt.Errorf("%w: mix at least %d of lower case, upper case, digits and symbols", ErrPasswordCharacterClass, p.MinCharacterClasses)
+	}
+
+	if p.MaxRepeats > 0 && longestRun(password) > p.MaxRepeats {
+		return ErrPasswordRepeats
+	}
+
+	if p.CheckUserInfo && containsUserInfo(password, userInputs) {
+		return ErrPasswordContainsUser
+	}
+
+	if p.Breached != nil {
+		breached, err := p.Breached.Contains(password)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrPasswordBreachCheckFail, err)
+		}
+		if breached {
+			return ErrPasswordBreached
+		}
+	}
+
+	return nil
+}
+
+// IsPasswordPolicyViolation reports whether err means the password was
+// rejected, as opposed to the check itself failing
+func IsPasswordPolicyViolation(err error) bool {
+	return errors.Is(err, ErrPasswordTooShort) ||
+		errors.Is(err, ErrPasswordTooLong) ||
+		errors.Is(err, ErrPasswordCharacterClass) ||
+		errors.Is(err, ErrPasswordRepeats) ||
+		errors.Is(err, ErrPasswordContainsUser) ||
+		errors.Is(err, ErrPasswordBreached)
+}
+
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, used := range []bool{lower, upper, digit, symbol} {
+		if used {
+			classes++
+		}
+	}
+	return classes
+}
+
+func longestRun(password string) int {
+	longest, run := 0, 0
+	var previous rune
+	for i, r := range password {
+		if i > 0 && r == previous {
+			run++
+		} else {
+			run = 1
+		}
+		previous = r
+		longest = max(longest, run)
+	}
+	return longest
+}
+
+// minUserInfoToken keeps short fragments such as initials from rejecting passwords
+const minUserInfoToken = 4
+
+func containsUserInfo(password string, userInputs []string) bool {
+	lower := strings.ToLower(password)
+	for _, input := range userInputs {
+		tokens := strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, token := range tokens {
+			if len(token) >= minUserInfoToken && strings.Contains(lower, token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var (
+	passwordPolicyMu sync.RWMutex
+	passwordPolicy   = DefaultPasswordPolicy
+)
+
+// SetPasswordPolicy replaces the policy used by ValidatePassword. It is called
+// once at startup with the configured rules.
+func SetPasswordPolicy(policy PasswordPolicy) {
+	passwordPolicyMu.Lock()
+	defer passwordPolicyMu.Unlock()
+	passwordPolicy = policy
+}
+
+// CurrentPasswordPolicy returns the policy used by ValidatePassword
+func CurrentPasswordPolicy() PasswordPolicy {
+	passwordPolicyMu.RLock()
+	defer passwordPolicyMu.RUnlock()
+	return passwordPolicy
+}
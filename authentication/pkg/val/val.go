@@ -8,7 +8,6 @@ import (
 
 var (
 	isValidUsername = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
-	isValidPassword = regexp.MustCompile(`^[a-zA-Z0-9!@#$&*]+$`).MatchString
 	isValidFullName = regexp.MustCompile(`^[a-zA-Z]{2,}\s[a-zA-Z]{1,}'?-?[a-zA-Z]{2,}\s?([a-zA-Z]{1,})?$`).MatchString
 )
 
@@ -32,15 +31,10 @@ func ValidateUsername(username string) error {
 	return nil
 }
 
-func ValidatePassword(password string) error {
-	err := ValidateStringField(password, 6, 50)
-	if err != nil {
-		return err
-	}
-	if !isValidPassword(password) {
-		return fmt.Errorf("password must contain special characters")
-	}
-	return nil
+// ValidatePassword checks a new password against the configured password
+// policy. userInputs are the user's email, name and similar values.
+func ValidatePassword(password string, userInputs ...string) error {
+	return CurrentPasswordPolicy().Validate(password, userInputs...)
 }
 
 func ValidateEmail(emails string) error {
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"log"
 	"net"
 	"net/http"
@@ -9,10 +10,14 @@ import (
 	"os/signal"
 	"time"
 
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	"github.com/demola234/api_gateway/infrastructure/middleware/revocation"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
 	"github.com/demola234/messaging/config"
 	"github.com/demola234/messaging/db/mongo"
 	pb "github.com/demola234/messaging/infrastructure/api/grpc"
 	grpcHandler "github.com/demola234/messaging/infrastructure/api/message_handler"
+	"github.com/demola234/messaging/infrastructure/messaging/kafka"
 	"github.com/demola234/messaging/infrastructure/socket"
 	"github.com/demola234/messaging/internal/repository"
 	"github.com/demola234/messaging/internal/usecase"
@@ -51,6 +56,44 @@ func main() {
 	conversationRepo := repository.NewConversationRepository(db, "conversations")
 	messageUsecase := usecase.NewMessagingUseCase(messageRepo, conversationRepo)
 
+	// Anonymize the messages of deleted accounts and report back to the authentication service
+	erasureReports := kafka.NewKafkaProducer(configs.KafkaBrokers, configs.ErasureReportsTopic)
+	defer erasureReports.Close()
+	erasureUseCase := usecase.NewErasureUseCase(messageRepo, conversationRepo, erasureReports)
+
+	authEvents := kafka.NewKafkaConsumer(configs.KafkaBrokers, configs.AuthEventsTopic, configs.KafkaGroupID)
+	defer authEvents.Close()
+	go func() {
+		if err := authEvents.Consume(context.Background(), erasureUseCase.HandleMessage); err != nil {
+			log.Printf("auth event consumer stopped: %v", err)
+		}
+	}()
+
+	// Only trusted services may call, forwarding the access token of the user
+	// each call is made for, which is verified against the public keys the
+	// authentication service publishes
+	tokenKeys := token.NewRemoteKeySet(configs.TokenKeysURL, http.DefaultClient, configs.TokenKeysCacheTTL)
+
+	// Tokens of revoked sessions are rejected as at the gateway
+	authDB, err := sql.Open("postgres", configs.AuthDBSource)
+	if err != nil {
+		log.Fatalf("Failed to connect to authentication database: %v", err)
+	}
+	defer authDB.Close()
+
+	revocations := revocation.NewList(authDB, configs.RevocationCacheSize)
+	go func() {
+		if err := revocations.Listen(context.Background(), configs.AuthDBSource); err != nil {
+			log.Printf("Failed to listen for session revocations: %v", err)
+		}
+	}()
+
+	// No method accepts tokens exchanged for an API key
+	serviceAuth, err := middleware.NewServiceAuthenticator(configs.TrustedServiceKeys, token.NewPasetoV4Verifier(tokenKeys), revocations, nil)
+	if err != nil {
+		log.Fatalf("Invalid trusted service keys: %v", err)
+	}
+
 	// WebSocket handler
 	webSocketHandler := socket.NewMessageWebSocket(messageUsecase)
 
@@ -58,7 +101,7 @@ func main() {
 	errChan := make(chan error)
 
 	go func() {
-		if err := startGRPCServer(configs.GRPCServerAddress, messageUsecase); err != nil {
+		if err := startGRPCServer(configs.GRPCServerAddress, messageUsecase, serviceAuth); err != nil {
 			errChan <- err
 		}
 	}()
@@ -82,13 +125,13 @@ func main() {
 	}
 }
 
-func startGRPCServer(address string, messageUsecase usecase.MessagingUseCase) error {
+func startGRPCServer(address string, messageUsecase usecase.MessagingUseCase, serviceAuth *middleware.ServiceAuthenticator) error {
 	lis, err := net.Listen("tcp", address)
 	if err != nil {
 		return err
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(serviceAuth.UnaryServerInterceptor()))
 	messageService := grpcHandler.NewMessageHandler(messageUsecase)
 
 	pb.RegisterMessagingServiceServer(grpcServer, messageService)
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 )
 
@@ -12,6 +14,24 @@ type Config struct {
 	Port              string `mapstructure:"PORT"`
 	MongoURI          string `mapstructure:"MONGO_URI"`
 	GRPCServerAddress string `mapstructure:"GRPC_SERVER_ADDRESS"`
+
+	// Public keys access tokens are verified with, published by the authentication service
+	TokenKeysURL      string        `mapstructure:"TOKEN_KEYS_URL"`
+	TokenKeysCacheTTL time.Duration `mapstructure:"TOKEN_KEYS_CACHE_TTL"`
+
+	// "<service>=<k4.public key>" entries for the services allowed to call this one
+	TrustedServiceKeys []string `mapstructure:"TRUSTED_SERVICE_KEYS"`
+
+	// Revocation list kept by the authentication service
+	AuthDBSource        string `mapstructure:"AUTH_DB_SOURCE"`
+	RevocationCacheSize int    `mapstructure:"REVOCATION_CACHE_SIZE"`
+
+	// Account deletions from the authentication service, and where this
+	// service reports anonymizing the deleted users' messages
+	KafkaBrokers        []string `mapstructure:"KAFKA_BROKERS"`
+	KafkaGroupID        string   `mapstructure:"KAFKA_GROUP_ID"`
+	AuthEventsTopic     string   `mapstructure:"AUTH_EVENTS_TOPIC"`
+	ErasureReportsTopic string   `mapstructure:"ERASURE_REPORTS_TOPIC"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -2,13 +2,17 @@ package messageHandler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/demola234/api_gateway/infrastructure/middleware"
 	"github.com/demola234/messaging/internal/domain/entity"
 	"github.com/demola234/messaging/internal/usecase"
 
 	pb "github.com/demola234/messaging/infrastructure/api/grpc"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -26,17 +30,22 @@ func NewMessageHandler(messageUseCase usecase.MessagingUseCase) *MessageHandler
 
 // SendMessage handles the SendMessage gRPC request.
 func (h *MessageHandler) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
+	// Messages are always sent as the user whose access token came with the call
+	senderID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	message := &entity.Message{
 		ConversationID: req.GetConversationId(),
-		SenderID:       req.GetSenderId(),
+		SenderID:       senderID,
 		ReceiverID:     req.GetReceiverId(),
 		Content:        req.GetContent(),
 		IsRead:         false,
 		IsDeleted:      false,
 	}
 
-	_, err := h.messageUseCase.SendMessage(ctx, message)
+	_, err = h.messageUseCase.SendMessage(ctx, message)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send message: %w", err)
 	}
@@ -46,9 +55,14 @@ func (h *MessageHandler) SendMessage(ctx context.Context, req *pb.SendMessageReq
 
 // GetMessages handles the GetMessages gRPC request.
 func (h *MessageHandler) GetMessages(ctx context.Context, req *pb.GetMessagesRequest) (*pb.GetMessagesResponse, error) {
-	messages, err := h.messageUseCase.GetMessages(ctx, req.GetConversationId(), &req.IncludeDeleted)
+	userID, err := callerID(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get messages: %w", err)
+		return nil, err
+	}
+
+	messages, err := h.messageUseCase.GetMessages(ctx, userID, req.GetConversationId(), &req.IncludeDeleted)
+	if err != nil {
+		return nil, messageError(err, "failed to get messages")
 	}
 
 	var pbMessages []*pb.Message
@@ -70,9 +84,14 @@ func (h *MessageHandler) GetMessages(ctx context.Context, req *pb.GetMessagesReq
 
 // DeleteMessages handles the DeleteMessages gRPC request.
 func (h *MessageHandler) DeleteMessages(ctx context.Context, req *pb.DeleteMessagesRequest) (*pb.DeleteMessagesResponse, error) {
-	err := h.messageUseCase.DeleteMessages(ctx, req.GetConversationId())
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.messageUseCase.DeleteMessages(ctx, userID, req.GetConversationId())
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete messages: %w", err)
+		return nil, messageError(err, "failed to delete messages")
 	}
 
 	return &pb.DeleteMessagesResponse{Status: "Messages deleted successfully"}, nil
@@ -80,9 +99,14 @@ func (h *MessageHandler) DeleteMessages(ctx context.Context, req *pb.DeleteMessa
 
 // UpdateMessage handles the UpdateMessage gRPC request.
 func (h *MessageHandler) UpdateMessage(ctx context.Context, req *pb.UpdateMessageRequest) (*pb.UpdateMessageResponse, error) {
-	err := h.messageUseCase.UpdateMessage(ctx, req.GetMessageId(), req.GetContent())
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.messageUseCase.UpdateMessage(ctx, userID, req.GetMessageId(), req.GetContent())
 	if err != nil {
-		return nil, fmt.Errorf("failed to update message: %w", err)
+		return nil, messageError(err, "failed to update message")
 	}
 
 	return &pb.UpdateMessageResponse{Status: "Message updated successfully"}, nil
@@ -90,9 +114,14 @@ func (h *MessageHandler) UpdateMessage(ctx context.Context, req *pb.UpdateMessag
 
 // UpdateMessageReadStatus handles the UpdateMessageReadStatus gRPC request.
 func (h *MessageHandler) UpdateMessageReadStatus(ctx context.Context, req *pb.UpdateMessageReadStatusRequest) (*pb.UpdateMessageReadStatusResponse, error) {
-	err := h.messageUseCase.UpdateMessageReadStatus(ctx, req.GetMessageId(), req.GetIsRead())
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.messageUseCase.UpdateMessageReadStatus(ctx, userID, req.GetMessageId(), req.GetIsRead())
 	if err != nil {
-		return nil, fmt.Errorf("failed to update read status: %w", err)
+		return nil, messageError(err, "failed to update read status")
 	}
 
 	return &pb.UpdateMessageReadStatusResponse{Status: "Message read status updated successfully"}, nil
@@ -100,6 +129,14 @@ func (h *MessageHandler) UpdateMessageReadStatus(ctx context.Context, req *pb.Up
 
 // GetConversationBetweenUsers handles the GetConversationBetweenUsers gRPC request.
 func (h *MessageHandler) GetConversationBetweenUsers(ctx context.Context, req *pb.GetConversationBetweenUsersRequest) (*pb.GetConversationBetweenUsersResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userID != req.GetUser1Id() && userID != req.GetUser2Id() {
+		return nil, status.Errorf(codes.PermissionDenied, "only participants can read a conversation")
+	}
+
 	conversations, err := h.messageUseCase.GetConversationBetweenUsers(ctx, req.GetUser1Id(), req.GetUser2Id())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get conversations between users: %w", err)
@@ -124,7 +161,12 @@ func (h *MessageHandler) GetConversationBetweenUsers(ctx context.Context, req *p
 }
 
 func (h *MessageHandler) GetConversations(ctx context.Context, req *pb.GetConversationsRequest) (*pb.GetConversationsResponse, error) {
-	conversations, err := h.messageUseCase.GetAllConversations(ctx, req.GetUserId())
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversations, err := h.messageUseCase.GetAllConversations(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get conversations for user: %w", err)
 	}
@@ -146,3 +188,24 @@ func (h *MessageHandler) GetConversations(ctx context.Context, req *pb.GetConver
 	}
 	return &pb.GetConversationsResponse{Conversations: pbConversations}, nil
 }
+
+// callerID returns the ID of the user whose access token came with the call
+func callerID(ctx context.Context) (string, error) {
+	payload, ok := middleware.PayloadFromContext(ctx)
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "caller identity not found")
+	}
+	return payload.UserID, nil
+}
+
+// messageError maps messaging usecase errors to gRPC statuses
+func messageError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrNotParticipant), errors.Is(err, entity.ErrNotSender):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, entity.ErrConversationNotFound), errors.Is(err, entity.ErrMessageNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	default:
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+}
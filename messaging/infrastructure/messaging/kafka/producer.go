@@ -0,0 +1,35 @@
+package kafka
+
+import (
+	"context"
+	"log"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type KafkaProducer struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaProducer(brokers []string, topic string) *KafkaProducer {
+	writer := kafka.NewWriter(kafka.WriterConfig{
+		Brokers: brokers,
+		Topic:   topic,
+	})
+	return &KafkaProducer{writer: writer}
+}
+
+func (p *KafkaProducer) PublishMessage(ctx context.Context, key, value []byte) error {
+	err := p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   key,
+		Value: value,
+	})
+	if err != nil {
+		log.Printf("failed to publish message: %v", err)
+	}
+	return err
+}
+
+func (p *KafkaProducer) Close() {
+	p.writer.Close()
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "errors"
+
+var (
+	ErrConversationNotFound = errors.New("conversation not found")
+	ErrMessageNotFound      = errors.New("message not found")
+	ErrNotParticipant       = errors.New("only participants can access a conversation")
+	ErrNotSender            = errors.New("only the sender can change a message")
+)
+
+// HasParticipant reports whether the user takes part in the conversation
+func (c *Conversation) HasParticipant(userID string) bool {
+	for _, participant := range c.Participants {
+		if participant == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,9 @@ import (
 )
 
 type ConversationRepository interface {
+	// Get a conversation by its ID
+	GetConversation(ctx context.Context, conversationID string) (*entity.Conversation, error)
+
 	// Get all conversations for a specific user
 	GetConversations(ctx context.Context, userID string) ([]entity.Conversation, error)
 
@@ -21,4 +24,7 @@ type ConversationRepository interface {
 
 	// Get All conversations betweens different users
 	GetAllConversations(ctx context.Context, userID string) ([]entity.Conversation, error)
+
+	// Replace a deleted user in the conversations they took part in
+	AnonymizeUserConversations(ctx context.Context, userID string) (int64, error)
 }
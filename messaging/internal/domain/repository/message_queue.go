@@ -0,0 +1,7 @@
+package repository
+
+import "context"
+
+type MessageQueue interface {
+	PublishMessage(ctx context.Context, key, value []byte) error
+}
@@ -10,6 +10,9 @@ type MessageRepository interface {
 	// Save a new message
 	SaveMessage(ctx context.Context, message *entity.Message) error
 
+	// Get a message by its ID
+	GetMessage(ctx context.Context, messageID string) (*entity.Message, error)
+
 	// Get all messages for a conversation between two users
 	GetMessages(ctx context.Context, conversationID string, includeDeleted *bool) ([]entity.Message, error)
 
@@ -21,4 +24,7 @@ type MessageRepository interface {
 
 	// Update the read status of a message
 	UpdateMessageReadStatus(ctx context.Context, messageID string, isRead bool) error
+
+	// Replace a deleted user's ID in their messages and blank the content they sent
+	AnonymizeUserMessages(ctx context.Context, userID string) (int64, error)
 }
@@ -2,15 +2,18 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/demola234/messaging/db/mongo"
 	"github.com/demola234/messaging/internal/domain/entity"
 	"github.com/demola234/messaging/internal/domain/repository"
+	"github.com/demola234/shared/events"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
 )
 
 type conversationRepository struct {
@@ -23,6 +26,24 @@ func NewConversationRepository(db mongo.Database, collectionName string) reposit
 	}
 }
 
+// GetConversation implements repository.ConversationRepository
+func (c *conversationRepository) GetConversation(ctx context.Context, conversationID string) (*entity.Conversation, error) {
+	id, err := primitive.ObjectIDFromHex(conversationID)
+	if err != nil {
+		return nil, entity.ErrConversationNotFound
+	}
+
+	var conversation entity.Conversation
+	if err := c.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&conversation); err != nil {
+		if errors.Is(err, mongodriver.ErrNoDocuments) {
+			return nil, entity.ErrConversationNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch conversation: %w", err)
+	}
+
+	return &conversation, nil
+}
+
 func (c *conversationRepository) GetConversations(ctx context.Context, userID string) ([]entity.Conversation, error) {
 	filter := bson.M{"participants": userID}
 
@@ -116,3 +137,29 @@ func (c *conversationRepository) GetAllConversations(ctx context.Context, userID
 
 	return conversations, nil
 }
+
+// AnonymizeUserConversations implements repository.ConversationRepository.
+// Conversations stay with the other participant; the deleted user's place in
+// them, and their last message, are replaced.
+func (c *conversationRepository) AnonymizeUserConversations(ctx context.Context, userID string) (int64, error) {
+	now := time.Now()
+
+	_, err := c.collection.UpdateMany(ctx, bson.M{"lastMessage.senderId": userID}, bson.M{"$set": bson.M{
+		"lastMessage.senderId": events.DeletedUserID,
+		"lastMessage.content":  "",
+		"updatedAt":            now,
+	}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize last messages: %w", err)
+	}
+
+	result, err := c.collection.UpdateMany(ctx, bson.M{"participants": userID}, bson.M{"$set": bson.M{
+		"participants.$": events.DeletedUserID,
+		"updatedAt":      now,
+	}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize conversations: %w", err)
+	}
+
+	return result.ModifiedCount, nil
+}
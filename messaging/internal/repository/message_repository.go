@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -9,9 +10,11 @@ import (
 	"github.com/demola234/messaging/db/mongo"
 	"github.com/demola234/messaging/internal/domain/entity"
 	"github.com/demola234/messaging/internal/domain/repository"
+	"github.com/demola234/shared/events"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
 )
 
 type messageRepository struct {
@@ -29,6 +32,24 @@ func (m *messageRepository) SaveMessage(ctx context.Context, message *entity.Mes
 	return err
 }
 
+// GetMessage implements repository.MessageRepository
+func (m *messageRepository) GetMessage(ctx context.Context, messageID string) (*entity.Message, error) {
+	id, err := primitive.ObjectIDFromHex(messageID)
+	if err != nil {
+		return nil, entity.ErrMessageNotFound
+	}
+
+	var message entity.Message
+	if err := m.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&message); err != nil {
+		if errors.Is(err, mongodriver.ErrNoDocuments) {
+			return nil, entity.ErrMessageNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch message: %w", err)
+	}
+
+	return &message, nil
+}
+
 func (m *messageRepository) GetMessages(ctx context.Context, conversationID string, includeDeleted *bool) ([]entity.Message, error) {
 	// objectID, err := primitive.ObjectIDFromHex(conversationID)
 	// if err != nil {
@@ -128,3 +149,30 @@ func (m *messageRepository) UpdateMessageReadStatus(ctx context.Context, message
 
 	return nil
 }
+
+// AnonymizeUserMessages implements repository.MessageRepository. The other
+// participant keeps the messages they sent, but the deleted user's own
+// messages lose their content.
+func (m *messageRepository) AnonymizeUserMessages(ctx context.Context, userID string) (int64, error) {
+	now := time.Now()
+
+	sent, err := m.collection.UpdateMany(ctx, bson.M{"senderId": userID}, bson.M{"$set": bson.M{
+		"senderId":  events.DeletedUserID,
+		"content":   "",
+		"isDeleted": true,
+		"updatedAt": now,
+	}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize sent messages: %w", err)
+	}
+
+	received, err := m.collection.UpdateMany(ctx, bson.M{"receiverId": userID}, bson.M{"$set": bson.M{
+		"receiverId": events.DeletedUserID,
+		"updatedAt":  now,
+	}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize received messages: %w", err)
+	}
+
+	return sent.ModifiedCount + received.ModifiedCount, nil
+}
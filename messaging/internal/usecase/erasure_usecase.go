@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/demola234/messaging/internal/domain/repository"
+	"github.com/demola234/shared/events"
+)
+
+// ErasureUseCase anonymizes the messages and conversations of users whose
+// accounts were deleted.
+type ErasureUseCase interface {
+	HandleMessage(ctx context.Context, key, value []byte) error
+}
+
+type erasureUseCase struct {
+	messageRepo      repository.MessageRepository
+	conversationRepo repository.ConversationRepository
+	reports          repository.MessageQueue
+}
+
+// NewErasureUseCase creates a new instance of ErasureUseCase that publishes
+// its erasure reports to reports.
+func NewErasureUseCase(
+	messageRepo repository.MessageRepository,
+	conversationRepo repository.ConversationRepository,
+	reports repository.MessageQueue,
+) ErasureUseCase {
+	return &erasureUseCase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		reports:          reports,
+	}
+}
+
+// HandleMessage anonymizes a deleted user's messages and conversations and
+// reports back to the authentication service. Handling the same user.deleted
+// event twice is harmless since nothing is left to match the user's ID.
+func (uc *erasureUseCase) HandleMessage(ctx context.Context, key, value []byte) error {
+	if string(key) != events.UserDeletedKey {
+		return nil
+	}
+
+	var event events.UserDeleted
+	if err := json.Unmarshal(value, &event); err != nil {
+		log.Printf("dropping malformed %s event: %v", events.UserDeletedKey, err)
+		return nil
+	}
+	if event.UserID == "" {
+		log.Printf("dropping %s event without a user ID", events.UserDeletedKey)
+		return nil
+	}
+
+	messages, err := uc.messageRepo.AnonymizeUserMessages(ctx, event.UserID)
+	if err != nil {
+		uc.report(ctx, event.UserID, events.ErasureFailed, 0, "failed to anonymize messages")
+		return err
+	}
+
+	conversations, err := uc.conversationRepo.AnonymizeUserConversations(ctx, event.UserID)
+	if err != nil {
+		uc.report(ctx, event.UserID, events.ErasureFailed, messages, "failed to anonymize conversations")
+		return err
+	}
+
+	return uc.report(ctx, event.UserID, events.ErasureCompleted, messages+conversations, "")
+}
+
+func (uc *erasureUseCase) report(ctx context.Context, userID, status string, items int64, detail string) error {
+	eventData, err := json.Marshal(events.ErasureReport{
+		UserID:     userID,
+		Service:    events.ServiceMessaging,
+		Status:     status,
+		Items:      items,
+		Detail:     detail,
+		ReportedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal erasure report: %w", err)
+	}
+
+	if err := uc.reports.PublishMessage(ctx, []byte(events.ErasureReportKey), eventData); err != nil {
+		return fmt.Errorf("failed to publish erasure report: %w", err)
+	}
+
+	return nil
+}
@@ -14,10 +14,10 @@ import (
 
 type MessagingUseCase interface {
 	SendMessage(ctx context.Context, message *entity.Message) (entity.Message, error)
-	GetMessages(ctx context.Context, conversationID string, includeDeleted *bool) ([]entity.Message, error)
-	DeleteMessages(ctx context.Context, conversationID string) error
-	UpdateMessage(ctx context.Context, messageID string, content string) error
-	UpdateMessageReadStatus(ctx context.Context, messageID string, isRead bool) error
+	GetMessages(ctx context.Context, userID string, conversationID string, includeDeleted *bool) ([]entity.Message, error)
+	DeleteMessages(ctx context.Context, userID string, conversationID string) error
+	UpdateMessage(ctx context.Context, userID string, messageID string, content string) error
+	UpdateMessageReadStatus(ctx context.Context, userID string, messageID string, isRead bool) error
 	GetConversationBetweenUsers(ctx context.Context, user1ID, user2ID string) ([]entity.Conversation, error)
 	GetAllConversations(ctx context.Context, userID string) ([]entity.Conversation, error)
 }
@@ -94,12 +94,16 @@ func (uc *messagingUseCase) SendMessage(ctx context.Context, message *entity.Mes
 	return *message, nil
 }
 
-func (uc *messagingUseCase) GetMessages(ctx context.Context, conversationID string, includeDeleted *bool) ([]entity.Message, error) {
+func (uc *messagingUseCase) GetMessages(ctx context.Context, userID string, conversationID string, includeDeleted *bool) ([]entity.Message, error) {
 	// Validate input
 	if conversationID == "" {
 		return nil, errors.New("conversation ID cannot be empty")
 	}
 
+	if err := uc.requireParticipant(ctx, userID, conversationID); err != nil {
+		return nil, err
+	}
+
 	// Retrieve messages from the repository
 	messages, err := uc.messageRepo.GetMessages(ctx, conversationID, includeDeleted)
 	if err != nil {
@@ -109,7 +113,11 @@ func (uc *messagingUseCase) GetMessages(ctx context.Context, conversationID stri
 	return messages, nil
 }
 
-func (uc *messagingUseCase) DeleteMessages(ctx context.Context, conversationID string) error {
+func (uc *messagingUseCase) DeleteMessages(ctx context.Context, userID string, conversationID string) error {
+	if err := uc.requireParticipant(ctx, userID, conversationID); err != nil {
+		return err
+	}
+
 	// Perform a delete on messages in the conversation
 	if err := uc.messageRepo.DeleteMessages(ctx, conversationID); err != nil {
 		return fmt.Errorf("failed to delete messages for conversation ID %s: %w", conversationID, err)
@@ -118,7 +126,17 @@ func (uc *messagingUseCase) DeleteMessages(ctx context.Context, conversationID s
 	return nil
 }
 
-func (uc *messagingUseCase) UpdateMessage(ctx context.Context, messageID string, content string) error {
+func (uc *messagingUseCase) UpdateMessage(ctx context.Context, userID string, messageID string, content string) error {
+	message, err := uc.messageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		return err
+	}
+
+	// Only the sender can edit what they wrote
+	if message.SenderID != userID {
+		return entity.ErrNotSender
+	}
+
 	// Update the message content
 	if err := uc.messageRepo.UpdateMessage(ctx, messageID, content); err != nil {
 		return fmt.Errorf("failed to update message with ID %s: %w", messageID, err)
@@ -127,7 +145,16 @@ func (uc *messagingUseCase) UpdateMessage(ctx context.Context, messageID string,
 	return nil
 }
 
-func (uc *messagingUseCase) UpdateMessageReadStatus(ctx context.Context, messageID string, isRead bool) error {
+func (uc *messagingUseCase) UpdateMessageReadStatus(ctx context.Context, userID string, messageID string, isRead bool) error {
+	message, err := uc.messageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.requireParticipant(ctx, userID, message.ConversationID); err != nil {
+		return err
+	}
+
 	// Update the read status of a specific message
 	if err := uc.messageRepo.UpdateMessageReadStatus(ctx, messageID, isRead); err != nil {
 		return fmt.Errorf("failed to update read status for message ID %s: %w", messageID, err)
@@ -164,3 +191,16 @@ func (uc *messagingUseCase) GetAllConversations(ctx context.Context, userID stri
 
 	return conversations, nil
 }
+
+// requireParticipant returns ErrNotParticipant unless the user takes part in the conversation
+func (uc *messagingUseCase) requireParticipant(ctx context.Context, userID string, conversationID string) error {
+	conversation, err := uc.conversationRepo.GetConversation(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+
+	if !conversation.HasParticipant(userID) {
+		return entity.ErrNotParticipant
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/demola234/messaging/internal/domain/entity"
+	"github.com/demola234/messaging/internal/domain/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// fakeConversationRepository serves conversations from memory; methods the
+// tests do not use are left to the embedded interface
+type fakeConversationRepository struct {
+	repository.ConversationRepository
+	conversations map[string]*entity.Conversation
+}
+
+func (f *fakeConversationRepository) GetConversation(ctx context.Context, conversationID string) (*entity.Conversation, error) {
+	conversation, ok := f.conversations[conversationID]
+	if !ok {
+		return nil, entity.ErrConversationNotFound
+	}
+	return conversation, nil
+}
+
+// fakeMessageRepository serves messages from memory and records changes
+type fakeMessageRepository struct {
+	repository.MessageRepository
+	messages map[string]*entity.Message
+	changed  []string
+}
+
+func (f *fakeMessageRepository) GetMessage(ctx context.Context, messageID string) (*entity.Message, error) {
+	message, ok := f.messages[messageID]
+	if !ok {
+		return nil, entity.ErrMessageNotFound
+	}
+	return message, nil
+}
+
+func (f *fakeMessageRepository) GetMessages(ctx context.Context, conversationID string, includeDeleted *bool) ([]entity.Message, error) {
+	var messages []entity.Message
+	for _, message := range f.messages {
+		if message.ConversationID == conversationID {
+			messages = append(messages, *message)
+		}
+	}
+	return messages, nil
+}
+
+func (f *fakeMessageRepository) DeleteMessages(ctx context.Context, conversationID string) error {
+	f.changed = append(f.changed, "delete "+conversationID)
+	return nil
+}
+
+func (f *fakeMessageRepository) UpdateMessage(ctx context.Context, messageID string, content string) error {
+	f.changed = append(f.changed, "update "+messageID)
+	return nil
+}
+
+func (f *fakeMessageRepository) UpdateMessageReadStatus(ctx context.Context, messageID string, isRead bool) error {
+	f.changed = append(f.changed, "read "+messageID)
+	return nil
+}
+
+func TestMessagingUseCaseParticipants(t *testing.T) {
+	const sender, receiver, outsider = "user-1", "user-2", "user-3"
+
+	conversationID := primitive.NewObjectID().Hex()
+	message := &entity.Message{
+		ID:             primitive.NewObjectID(),
+		ConversationID: conversationID,
+		SenderID:       sender,
+		ReceiverID:     receiver,
+		Content:        "Is the flat still available?",
+	}
+	messageID := message.ID.Hex()
+
+	setup := func() (MessagingUseCase, *fakeMessageRepository) {
+		messages := &fakeMessageRepository{messages: map[string]*entity.Message{messageID: message}}
+		conversations := &fakeConversationRepository{conversations: map[string]*entity.Conversation{
+			conversationID: {Participants: []string{sender, receiver}},
+		}}
+		return NewMessagingUseCase(messages, conversations), messages
+	}
+
+	t.Run("participants read the conversation", func(t *testing.T) {
+		uc, _ := setup()
+
+		for _, userID := range []string{sender, receiver} {
+			messages, err := uc.GetMessages(context.Background(), userID, conversationID, nil)
+			require.NoError(t, err)
+			assert.Len(t, messages, 1)
+		}
+	})
+
+	t.Run("another user cannot read the conversation", func(t *testing.T) {
+		uc, _ := setup()
+
+		_, err := uc.GetMessages(context.Background(), outsider, conversationID, nil)
+		assert.ErrorIs(t, err, entity.ErrNotParticipant)
+	})
+
+	t.Run("another user cannot delete the conversation's messages", func(t *testing.T) {
+		uc, messages := setup()
+
+		err := uc.DeleteMessages(context.Background(), outsider, conversationID)
+		assert.ErrorIs(t, err, entity.ErrNotParticipant)
+		assert.Empty(t, messages.changed)
+	})
+
+	t.Run("only the sender edits a message", func(t *testing.T) {
+		uc, messages := setup()
+
+		for _, userID := range []string{receiver, outsider} {
+			err := uc.UpdateMessage(context.Background(), userID, messageID, "changed")
+			assert.ErrorIs(t, err, entity.ErrNotSender)
+		}
+		assert.Empty(t, messages.changed)
+
+		require.NoError(t, uc.UpdateMessage(context.Background(), sender, messageID, "changed"))
+		assert.Equal(t, []string{"update " + messageID}, messages.changed)
+	})
+
+	t.Run("another user cannot mark a message read", func(t *testing.T) {
+		uc, messages := setup()
+
+		err := uc.UpdateMessageReadStatus(context.Background(), outsider, messageID, true)
+		assert.ErrorIs(t, err, entity.ErrNotParticipant)
+		assert.Empty(t, messages.changed)
+
+		require.NoError(t, uc.UpdateMessageReadStatus(context.Background(), receiver, messageID, true))
+		assert.Equal(t, []string{"read " + messageID}, messages.changed)
+	})
+
+	t.Run("unknown conversation", func(t *testing.T) {
+		uc, _ := setup()
+
+		_, err := uc.GetMessages(context.Background(), sender, primitive.NewObjectID().Hex(), nil)
+		assert.ErrorIs(t, err, entity.ErrConversationNotFound)
+	})
+}
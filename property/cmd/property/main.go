@@ -1,10 +1,15 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"net"
+	"net/http"
 
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	"github.com/demola234/api_gateway/infrastructure/middleware/revocation"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
 	"github.com/demola234/property/config"
 	db "github.com/demola234/property/db/sqlc"
 	pb "github.com/demola234/property/infrastructure/api/grpc"
@@ -12,6 +17,7 @@ import (
 	"github.com/demola234/property/infrastructure/messaging/kafka"
 	"github.com/demola234/property/internal/repository"
 	"github.com/demola234/property/internal/usecases"
+	"github.com/demola234/shared/rbac"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -48,6 +54,19 @@ func main() {
 
 	propertyService := grpcHandler.NewPropertyHandler(propertyUsecase)
 
+	// Remove the listings of deleted accounts and report back to the authentication service
+	erasureReports := kafka.NewKafkaProducer(configs.KafkaBrokers, configs.ErasureReportsTopic)
+	defer erasureReports.Close()
+	erasureUsecase := usecases.NewErasureUsecase(propertyRepo, kafkaProducer, erasureReports)
+
+	authEvents := kafka.NewKafkaConsumer(configs.KafkaBrokers, configs.AuthEventsTopic, configs.KafkaGroupID)
+	defer authEvents.Close()
+	go func() {
+		if err := authEvents.Consume(context.Background(), erasureUsecase.HandleMessage); err != nil {
+			log.Printf("auth event consumer stopped: %v", err)
+		}
+	}()
+
 	// Start the gRPC server
 	lis, err := net.Listen("tcp", configs.GRPCServerAddress)
 	if err != nil {
@@ -56,7 +75,39 @@ func main() {
 
 	log.Printf("gRPC server listening on %s", configs.GRPCServerAddress)
 
-	grpcServer := grpc.NewServer()
+	// Only trusted services may call, forwarding the access token of the user
+	// each call is made for, which is verified against the public keys the
+	// authentication service publishes
+	tokenKeys := token.NewRemoteKeySet(configs.TokenKeysURL, http.DefaultClient, configs.TokenKeysCacheTTL)
+
+	// Tokens of revoked sessions are rejected as at the gateway
+	authDB, err := sql.Open("postgres", configs.AuthDBSource)
+	if err != nil {
+		log.Fatalf("failed to connect to authentication database: %v", err)
+	}
+	defer authDB.Close()
+
+	revocations := revocation.NewList(authDB, configs.RevocationCacheSize)
+	go func() {
+		if err := revocations.Listen(context.Background(), configs.AuthDBSource); err != nil {
+			log.Printf("failed to listen for session revocations: %v", err)
+		}
+	}()
+
+	// No method accepts tokens exchanged for an API key
+	serviceAuth, err := middleware.NewServiceAuthenticator(configs.TrustedServiceKeys, token.NewPasetoV4Verifier(tokenKeys), revocations, nil)
+	if err != nil {
+		log.Fatalf("invalid trusted service keys: %v", err)
+	}
+
+	// Writes are gated by permission; reads stay open to the gateway
+	permissionInterceptor := middleware.PermissionInterceptor(map[string]rbac.Permission{
+		pb.PropertyService_CreateProperty_FullMethodName: rbac.PermPropertyCreate,
+		pb.PropertyService_UpdateProperty_FullMethodName: rbac.PermPropertyUpdate,
+		pb.PropertyService_DeleteProperty_FullMethodName: rbac.PermPropertyDelete,
+	})
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(serviceAuth.UnaryServerInterceptor(), permissionInterceptor))
 	pb.RegisterPropertyServiceServer(grpcServer, propertyService)
 	reflection.Register(grpcServer)
 
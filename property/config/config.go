@@ -2,6 +2,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 )
 
@@ -9,10 +11,25 @@ type Config struct {
 	DBDriver          string   `mapstructure:"DB_DRIVER"`
 	DBSource          string   `mapstructure:"DB_SOURCE"`
 	GRPCServerAddress string   `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey string   `mapstructure:"TOKEN_SYMMETRIC_KEY"`
 	KafkaBrokers      []string `mapstructure:"KAFKA_BROKERS"`
 	KafkaTopic        string   `mapstructure:"KAFKA_TOPIC"`
 	KafkaGroupID      string   `mapstructure:"KAFKA_GROUP_ID"`
+
+	// Account deletions from the authentication service, and where this
+	// service reports erasing the deleted users' listings
+	AuthEventsTopic     string `mapstructure:"AUTH_EVENTS_TOPIC"`
+	ErasureReportsTopic string `mapstructure:"ERASURE_REPORTS_TOPIC"`
+
+	// Public keys access tokens are verified with, published by the authentication service
+	TokenKeysURL      string        `mapstructure:"TOKEN_KEYS_URL"`
+	TokenKeysCacheTTL time.Duration `mapstructure:"TOKEN_KEYS_CACHE_TTL"`
+
+	// "<service>=<k4.public key>" entries for the services allowed to call this one
+	TrustedServiceKeys []string `mapstructure:"TRUSTED_SERVICE_KEYS"`
+
+	// Revocation list kept by the authentication service
+	AuthDBSource        string `mapstructure:"AUTH_DB_SOURCE"`
+	RevocationCacheSize int    `mapstructure:"REVOCATION_CACHE_SIZE"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
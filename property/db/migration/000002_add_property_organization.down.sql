@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_property_organization_id;
+ALTER TABLE "Property" DROP COLUMN IF EXISTS "organization_id";
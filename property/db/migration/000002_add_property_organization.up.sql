@@ -0,0 +1,6 @@
+-- Listings can be attributed to an organization, such as an agency, as well as to their owner
+ALTER TABLE "Property" ADD COLUMN "organization_id" UUID;
+
+CREATE INDEX idx_property_organization_id ON "Property"("organization_id", "created_at" DESC);
+
+COMMENT ON COLUMN "Property"."organization_id" IS 'Reference to the organization the owner listed it for, external microservice (Optional)';
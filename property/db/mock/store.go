@@ -6,9 +6,9 @@ package mockdb
 
 import (
 	context "context"
-	db "github.com/demola234/property/db/sqlc"
 	reflect "reflect"
 
+	db "github.com/demola234/property/db/sqlc"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -36,6 +36,21 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// DeletePropertiesByOwnerID mocks base method.
+func (m *MockStore) DeletePropertiesByOwnerID(arg0 context.Context, arg1 uuid.NullUUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePropertiesByOwnerID", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePropertiesByOwnerID indicates an expected call of DeletePropertiesByOwnerID.
+func (mr *MockStoreMockRecorder) DeletePropertiesByOwnerID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePropertiesByOwnerID", reflect.TypeOf((*MockStore)(nil).DeletePropertiesByOwnerID), arg0, arg1)
+}
+
 // DeleteProperty mocks base method.
 func (m *MockStore) DeleteProperty(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -50,6 +65,21 @@ func (mr *MockStoreMockRecorder) DeleteProperty(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProperty", reflect.TypeOf((*MockStore)(nil).DeleteProperty), arg0, arg1)
 }
 
+// GetPropertiesByOrganizationID mocks base method.
+func (m *MockStore) GetPropertiesByOrganizationID(arg0 context.Context, arg1 db.GetPropertiesByOrganizationIDParams) ([]db.Property, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPropertiesByOrganizationID", arg0, arg1)
+	ret0, _ := ret[0].([]db.Property)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPropertiesByOrganizationID indicates an expected call of GetPropertiesByOrganizationID.
+func (mr *MockStoreMockRecorder) GetPropertiesByOrganizationID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertiesByOrganizationID", reflect.TypeOf((*MockStore)(nil).GetPropertiesByOrganizationID), arg0, arg1)
+}
+
 // GetPropertiesByOwnerID mocks base method.
 func (m *MockStore) GetPropertiesByOwnerID(arg0 context.Context, arg1 db.GetPropertiesByOwnerIDParams) ([]db.Property, error) {
 	m.ctrl.T.Helper()
@@ -1,10 +1,10 @@
 -- name: InsertProperty :one
 INSERT INTO "Property" (
     id, title, description, price, type, address, zip_code, owner_id, images, 
-    no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at
+    no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, organization_id, created_at, updated_at
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9, 
-    $10, $11, $12, $13, $14, now(), now()
+    $10, $11, $12, $13, $14, $15, now(), now()
 )
 RETURNING *;
 
@@ -45,4 +45,15 @@ WHERE id = $1;
 SELECT * FROM "Property"
 WHERE owner_id = $1
 ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
\ No newline at end of file
+LIMIT $2 OFFSET $3;
+
+-- name: GetPropertiesByOrganizationID :many
+SELECT * FROM "Property"
+WHERE organization_id = $1
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: DeletePropertiesByOwnerID :many
+DELETE FROM "Property"
+WHERE owner_id = $1
+RETURNING id;
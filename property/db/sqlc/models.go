@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.24.0
+//   sqlc v1.29.0
 
 package db
 
@@ -114,6 +114,7 @@ type Property struct {
 	Address string `json:"address"`
 	// Zip code (Optional)
 	ZipCode sql.NullString `json:"zip_code"`
+	City    sql.NullString `json:"city"`
 	// Reference to the user (seller), external microservice
 	OwnerID uuid.NullUUID `json:"owner_id"`
 	// List of image URLs (Optional)
@@ -132,4 +133,6 @@ type Property struct {
 	CreatedAt sql.NullTime `json:"created_at"`
 	// Timestamp of last update
 	UpdatedAt sql.NullTime `json:"updated_at"`
+	// Reference to the organization the owner listed it for, external microservice (Optional)
+	OrganizationID uuid.NullUUID `json:"organization_id"`
 }
@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.24.0
+//   sqlc v1.29.0
 // source: property.sql
 
 package db
@@ -14,6 +14,35 @@ import (
 	"github.com/sqlc-dev/pqtype"
 )
 
+const deletePropertiesByOwnerID = `-- name: DeletePropertiesByOwnerID :many
+DELETE FROM "Property"
+WHERE owner_id = $1
+RETURNING id
+`
+
+func (q *Queries) DeletePropertiesByOwnerID(ctx context.Context, ownerID uuid.NullUUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, deletePropertiesByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteProperty = `-- name: DeleteProperty :exec
 DELETE FROM "Property"
 WHERE id = $1
@@ -24,8 +53,63 @@ func (q *Queries) DeleteProperty(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const getPropertiesByOrganizationID = `-- name: GetPropertiesByOrganizationID :many
+SELECT id, title, description, price, type, address, zip_code, city, owner_id, images, no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at, organization_id FROM "Property"
+WHERE organization_id = $1
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+type GetPropertiesByOrganizationIDParams struct {
+	OrganizationID uuid.NullUUID `json:"organization_id"`
+	Limit          int32         `json:"limit"`
+	Offset         int32         `json:"offset"`
+}
+
+func (q *Queries) GetPropertiesByOrganizationID(ctx context.Context, arg GetPropertiesByOrganizationIDParams) ([]Property, error) {
+	rows, err := q.db.QueryContext(ctx, getPropertiesByOrganizationID, arg.OrganizationID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Property{}
+	for rows.Next() {
+		var i Property
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Price,
+			&i.Type,
+			&i.Address,
+			&i.ZipCode,
+			&i.City,
+			&i.OwnerID,
+			pq.Array(&i.Images),
+			&i.NoOfBedRooms,
+			&i.NoOfBathRooms,
+			&i.NoOfToilets,
+			&i.GeoLocation,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPropertiesByOwnerID = `-- name: GetPropertiesByOwnerID :many
-SELECT id, title, description, price, type, address, zip_code, owner_id, images, no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at FROM "Property"
+SELECT id, title, description, price, type, address, zip_code, city, owner_id, images, no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at, organization_id FROM "Property"
 WHERE owner_id = $1
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3
@@ -54,6 +138,7 @@ func (q *Queries) GetPropertiesByOwnerID(ctx context.Context, arg GetPropertiesB
 			&i.Type,
 			&i.Address,
 			&i.ZipCode,
+			&i.City,
 			&i.OwnerID,
 			pq.Array(&i.Images),
 			&i.NoOfBedRooms,
@@ -63,6 +148,7 @@ func (q *Queries) GetPropertiesByOwnerID(ctx context.Context, arg GetPropertiesB
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.OrganizationID,
 		); err != nil {
 			return nil, err
 		}
@@ -78,7 +164,7 @@ func (q *Queries) GetPropertiesByOwnerID(ctx context.Context, arg GetPropertiesB
 }
 
 const getPropertyByID = `-- name: GetPropertyByID :one
-SELECT id, title, description, price, type, address, zip_code, owner_id, images, no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at FROM "Property"
+SELECT id, title, description, price, type, address, zip_code, city, owner_id, images, no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at, organization_id FROM "Property"
 WHERE id = $1
 `
 
@@ -93,6 +179,7 @@ func (q *Queries) GetPropertyByID(ctx context.Context, id uuid.UUID) (Property,
 		&i.Type,
 		&i.Address,
 		&i.ZipCode,
+		&i.City,
 		&i.OwnerID,
 		pq.Array(&i.Images),
 		&i.NoOfBedRooms,
@@ -102,6 +189,7 @@ func (q *Queries) GetPropertyByID(ctx context.Context, id uuid.UUID) (Property,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -109,29 +197,30 @@ func (q *Queries) GetPropertyByID(ctx context.Context, id uuid.UUID) (Property,
 const insertProperty = `-- name: InsertProperty :one
 INSERT INTO "Property" (
     id, title, description, price, type, address, zip_code, owner_id, images, 
-    no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at
+    no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, organization_id, created_at, updated_at
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9, 
-    $10, $11, $12, $13, $14, now(), now()
+    $10, $11, $12, $13, $14, $15, now(), now()
 )
-RETURNING id, title, description, price, type, address, zip_code, owner_id, images, no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at
+RETURNING id, title, description, price, type, address, zip_code, city, owner_id, images, no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at, organization_id
 `
 
 type InsertPropertyParams struct {
-	ID            uuid.UUID             `json:"id"`
-	Title         string                `json:"title"`
-	Description   sql.NullString        `json:"description"`
-	Price         string                `json:"price"`
-	Type          NullPropertyType      `json:"type"`
-	Address       string                `json:"address"`
-	ZipCode       sql.NullString        `json:"zip_code"`
-	OwnerID       uuid.NullUUID         `json:"owner_id"`
-	Images        []string              `json:"images"`
-	NoOfBedRooms  sql.NullInt32         `json:"no_of_bed_rooms"`
-	NoOfBathRooms sql.NullInt32         `json:"no_of_bath_rooms"`
-	NoOfToilets   sql.NullInt32         `json:"no_of_toilets"`
-	GeoLocation   pqtype.NullRawMessage `json:"geo_location"`
-	Status        NullPropertyStatus    `json:"status"`
+	ID             uuid.UUID             `json:"id"`
+	Title          string                `json:"title"`
+	Description    sql.NullString        `json:"description"`
+	Price          string                `json:"price"`
+	Type           NullPropertyType      `json:"type"`
+	Address        string                `json:"address"`
+	ZipCode        sql.NullString        `json:"zip_code"`
+	OwnerID        uuid.NullUUID         `json:"owner_id"`
+	Images         []string              `json:"images"`
+	NoOfBedRooms   sql.NullInt32         `json:"no_of_bed_rooms"`
+	NoOfBathRooms  sql.NullInt32         `json:"no_of_bath_rooms"`
+	NoOfToilets    sql.NullInt32         `json:"no_of_toilets"`
+	GeoLocation    pqtype.NullRawMessage `json:"geo_location"`
+	Status         NullPropertyStatus    `json:"status"`
+	OrganizationID uuid.NullUUID         `json:"organization_id"`
 }
 
 func (q *Queries) InsertProperty(ctx context.Context, arg InsertPropertyParams) (Property, error) {
@@ -150,6 +239,7 @@ func (q *Queries) InsertProperty(ctx context.Context, arg InsertPropertyParams)
 		arg.NoOfToilets,
 		arg.GeoLocation,
 		arg.Status,
+		arg.OrganizationID,
 	)
 	var i Property
 	err := row.Scan(
@@ -160,6 +250,7 @@ func (q *Queries) InsertProperty(ctx context.Context, arg InsertPropertyParams)
 		&i.Type,
 		&i.Address,
 		&i.ZipCode,
+		&i.City,
 		&i.OwnerID,
 		pq.Array(&i.Images),
 		&i.NoOfBedRooms,
@@ -169,12 +260,13 @@ func (q *Queries) InsertProperty(ctx context.Context, arg InsertPropertyParams)
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
 const listProperties = `-- name: ListProperties :many
-SELECT id, title, description, price, type, address, zip_code, owner_id, images, no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at FROM "Property"
+SELECT id, title, description, price, type, address, zip_code, city, owner_id, images, no_of_bed_rooms, no_of_bath_rooms, no_of_toilets, geo_location, status, created_at, updated_at, organization_id FROM "Property"
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2
 `
@@ -201,6 +293,7 @@ func (q *Queries) ListProperties(ctx context.Context, arg ListPropertiesParams)
 			&i.Type,
 			&i.Address,
 			&i.ZipCode,
+			&i.City,
 			&i.OwnerID,
 			pq.Array(&i.Images),
 			&i.NoOfBedRooms,
@@ -210,6 +303,7 @@ func (q *Queries) ListProperties(ctx context.Context, arg ListPropertiesParams)
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.OrganizationID,
 		); err != nil {
 			return nil, err
 		}
@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.24.0
+//   sqlc v1.29.0
 
 package db
 
@@ -11,7 +11,9 @@ import (
 )
 
 type Querier interface {
+	DeletePropertiesByOwnerID(ctx context.Context, ownerID uuid.NullUUID) ([]uuid.UUID, error)
 	DeleteProperty(ctx context.Context, id uuid.UUID) error
+	GetPropertiesByOrganizationID(ctx context.Context, arg GetPropertiesByOrganizationIDParams) ([]Property, error)
 	GetPropertiesByOwnerID(ctx context.Context, arg GetPropertiesByOwnerIDParams) ([]Property, error)
 	GetPropertyByID(ctx context.Context, id uuid.UUID) (Property, error)
 	InsertProperty(ctx context.Context, arg InsertPropertyParams) (Property, error)
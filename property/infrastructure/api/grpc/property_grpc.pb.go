@@ -19,12 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PropertyService_CreateProperty_FullMethodName       = "/pb.PropertyService/CreateProperty"
-	PropertyService_UpdateProperty_FullMethodName       = "/pb.PropertyService/UpdateProperty"
-	PropertyService_GetPropertyByID_FullMethodName      = "/pb.PropertyService/GetPropertyByID"
-	PropertyService_GetProperties_FullMethodName        = "/pb.PropertyService/GetProperties"
-	PropertyService_GetPropertiesByOwner_FullMethodName = "/pb.PropertyService/GetPropertiesByOwner"
-	PropertyService_DeleteProperty_FullMethodName       = "/pb.PropertyService/DeleteProperty"
+	PropertyService_CreateProperty_FullMethodName              = "/pb.PropertyService/CreateProperty"
+	PropertyService_UpdateProperty_FullMethodName              = "/pb.PropertyService/UpdateProperty"
+	PropertyService_GetPropertyByID_FullMethodName             = "/pb.PropertyService/GetPropertyByID"
+	PropertyService_GetProperties_FullMethodName               = "/pb.PropertyService/GetProperties"
+	PropertyService_GetPropertiesByOwner_FullMethodName        = "/pb.PropertyService/GetPropertiesByOwner"
+	PropertyService_GetPropertiesByOrganization_FullMethodName = "/pb.PropertyService/GetPropertiesByOrganization"
+	PropertyService_DeleteProperty_FullMethodName              = "/pb.PropertyService/DeleteProperty"
 )
 
 // PropertyServiceClient is the client API for PropertyService service.
@@ -36,6 +37,7 @@ type PropertyServiceClient interface {
 	GetPropertyByID(ctx context.Context, in *GetPropertyByIDRequest, opts ...grpc.CallOption) (*GetPropertyByIDResponse, error)
 	GetProperties(ctx context.Context, in *GetPropertiesRequest, opts ...grpc.CallOption) (*GetPropertiesResponse, error)
 	GetPropertiesByOwner(ctx context.Context, in *GetPropertiesByOwnerRequest, opts ...grpc.CallOption) (*GetPropertiesByOwnerResponse, error)
+	GetPropertiesByOrganization(ctx context.Context, in *GetPropertiesByOrganizationRequest, opts ...grpc.CallOption) (*GetPropertiesByOrganizationResponse, error)
 	DeleteProperty(ctx context.Context, in *DeletePropertyRequest, opts ...grpc.CallOption) (*DeletePropertyResponse, error)
 }
 
@@ -97,6 +99,16 @@ func (c *propertyServiceClient) GetPropertiesByOwner(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *propertyServiceClient) GetPropertiesByOrganization(ctx context.Context, in *GetPropertiesByOrganizationRequest, opts ...grpc.CallOption) (*GetPropertiesByOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPropertiesByOrganizationResponse)
+	err := c.cc.Invoke(ctx, PropertyService_GetPropertiesByOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *propertyServiceClient) DeleteProperty(ctx context.Context, in *DeletePropertyRequest, opts ...grpc.CallOption) (*DeletePropertyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeletePropertyResponse)
@@ -116,6 +128,7 @@ type PropertyServiceServer interface {
 	GetPropertyByID(context.Context, *GetPropertyByIDRequest) (*GetPropertyByIDResponse, error)
 	GetProperties(context.Context, *GetPropertiesRequest) (*GetPropertiesResponse, error)
 	GetPropertiesByOwner(context.Context, *GetPropertiesByOwnerRequest) (*GetPropertiesByOwnerResponse, error)
+	GetPropertiesByOrganization(context.Context, *GetPropertiesByOrganizationRequest) (*GetPropertiesByOrganizationResponse, error)
 	DeleteProperty(context.Context, *DeletePropertyRequest) (*DeletePropertyResponse, error)
 	mustEmbedUnimplementedPropertyServiceServer()
 }
@@ -142,6 +155,9 @@ func (UnimplementedPropertyServiceServer) GetProperties(context.Context, *GetPro
 func (UnimplementedPropertyServiceServer) GetPropertiesByOwner(context.Context, *GetPropertiesByOwnerRequest) (*GetPropertiesByOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPropertiesByOwner not implemented")
 }
+func (UnimplementedPropertyServiceServer) GetPropertiesByOrganization(context.Context, *GetPropertiesByOrganizationRequest) (*GetPropertiesByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPropertiesByOrganization not implemented")
+}
 func (UnimplementedPropertyServiceServer) DeleteProperty(context.Context, *DeletePropertyRequest) (*DeletePropertyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProperty not implemented")
 }
@@ -256,6 +272,24 @@ func _PropertyService_GetPropertiesByOwner_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PropertyService_GetPropertiesByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertiesByOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).GetPropertiesByOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_GetPropertiesByOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).GetPropertiesByOrganization(ctx, req.(*GetPropertiesByOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PropertyService_DeleteProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeletePropertyRequest)
 	if err := dec(in); err != nil {
@@ -301,6 +335,10 @@ var PropertyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPropertiesByOwner",
 			Handler:    _PropertyService_GetPropertiesByOwner_Handler,
 		},
+		{
+			MethodName: "GetPropertiesByOrganization",
+			Handler:    _PropertyService_GetPropertiesByOrganization_Handler,
+		},
 		{
 			MethodName: "DeleteProperty",
 			Handler:    _PropertyService_DeleteProperty_Handler,
@@ -5,12 +5,16 @@ import (
 	"encoding/json"
 	"strconv"
 
+	"github.com/demola234/api_gateway/infrastructure/middleware"
+	token "github.com/demola234/api_gateway/infrastructure/middleware/token_maker"
 	pb "github.com/demola234/property/infrastructure/api/grpc"
 	"github.com/demola234/property/internal/domain/entity"
 	"github.com/demola234/property/internal/usecases"
+	"github.com/demola234/shared/rbac"
 
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -28,7 +32,12 @@ func NewPropertyHandler(propertyUsecase usecases.PropertyUsecase) *PropertyHandl
 }
 
 func (p *PropertyHandler) CreateProperty(ctx context.Context, req *pb.CreatePropertyRequest) (*pb.CreatePropertyResponse, error) {
-	userID, err := uuid.Parse(req.GetOwnerId())
+	// Listings belong to the user whose access token came with the call, whatever owner_id says
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(caller.UserID)
 	if err != nil {
 		return nil, status.Errorf(400, "Invalid user ID")
 	}
@@ -53,6 +62,15 @@ func (p *PropertyHandler) CreateProperty(ctx context.Context, req *pb.CreateProp
 		Status:  req.GetStatus(),
 	}
 
+	// Listings created while acting for an organization belong to it as well
+	if caller.OrganizationID != "" {
+		organizationID, err := uuid.Parse(caller.OrganizationID)
+		if err != nil {
+			return nil, status.Errorf(400, "Invalid organization ID")
+		}
+		property.OrganizationID = uuid.NullUUID{UUID: organizationID, Valid: true}
+	}
+
 	p.propertyUsecase.CreateProperty(ctx, property)
 
 	propertyResponse := &pb.CreatePropertyResponse{
@@ -72,43 +90,9 @@ func (p *PropertyHandler) GetProperties(ctx context.Context, req *pb.GetProperti
 
 	propertyResponses := make([]*pb.Property, len(properties))
 	for i, property := range properties {
-		price, err := strconv.ParseFloat(property.Price, 64)
-		if err != nil {
-			return nil, status.Errorf(500, "Unable to parse price: %v", err)
-		}
-
-		noOfBedRooms, err := strconv.Atoi(property.NoOfBedRooms)
+		propertyResponses[i], err = toPbProperty(property)
 		if err != nil {
-			return nil, status.Errorf(500, "Unable to parse no of bedrooms: %v", err)
-		}
-
-		noOfBathRooms, err := strconv.Atoi(property.NoOfBathRooms)
-		if err != nil {
-			return nil, status.Errorf(500, "Unable to parse no of bathrooms: %v", err)
-		}
-
-		noOfToilets, err := strconv.Atoi(property.NoOfToilets)
-		if err != nil {
-			return nil, status.Errorf(500, "Unable to parse no of toilets: %v", err)
-		}
-
-		propertyResponses[i] = &pb.Property{
-			Id:            property.ID.String(),
-			Title:         property.Title,
-			Description:   property.Description,
-			Price:         price,
-			Type:          property.Type,
-			Address:       property.Address,
-			ZipCode:       property.ZipCode,
-			Images:        property.Images,
-			OwnerId:       property.OwnerID.UUID.String(),
-			NoOfBedrooms:  int32(noOfBedRooms),
-			NoOfBathrooms: int32(noOfBathRooms),
-			NoOfToilets:   int32(noOfToilets),
-			GeoLocation:   string(property.GeoLocation.RawMessage),
-			Status:        property.Status,
-			CreatedAt:     timestamppb.New(property.CreatedAt),
-			UpdatedAt:     timestamppb.New(property.UpdatedAt),
+			return nil, err
 		}
 	}
 
@@ -132,47 +116,37 @@ func (p *PropertyHandler) GetPropertiesByOwner(ctx context.Context, req *pb.GetP
 
 	propertyResponses := make([]*pb.Property, len(properties))
 	for i, property := range properties {
-		price, err := strconv.ParseFloat(property.Price, 64)
+		propertyResponses[i], err = toPbProperty(property)
 		if err != nil {
-			return nil, status.Errorf(500, "Unable to parse price: %v", err)
+			return nil, err
 		}
+	}
 
-		noOfBedRooms, err := strconv.Atoi(property.NoOfBedRooms)
-		if err != nil {
-			return nil, status.Errorf(500, "Unable to parse no of bedrooms: %v", err)
-		}
+	return &pb.GetPropertiesByOwnerResponse{
+		Properties: propertyResponses,
+	}, nil
+}
 
-		noOfBathRooms, err := strconv.Atoi(property.NoOfBathRooms)
-		if err != nil {
-			return nil, status.Errorf(500, "Unable to parse no of bathrooms: %v", err)
-		}
+func (p *PropertyHandler) GetPropertiesByOrganization(ctx context.Context, req *pb.GetPropertiesByOrganizationRequest) (*pb.GetPropertiesByOrganizationResponse, error) {
+	uuidOrganization, err := uuid.Parse(req.GetOrganizationId())
+	if err != nil {
+		return nil, status.Errorf(400, "Invalid organization ID")
+	}
 
-		noOfToilets, err := strconv.Atoi(property.NoOfToilets)
-		if err != nil {
-			return nil, status.Errorf(500, "Unable to parse no of toilets: %v", err)
-		}
+	properties, err := p.propertyUsecase.GetPropertiesByOrganization(ctx, uuidOrganization, req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, status.Errorf(500, "Unable to get properties: %v", err)
+	}
 
-		propertyResponses[i] = &pb.Property{
-			Id:            property.ID.String(),
-			Title:         property.Title,
-			Description:   property.Description,
-			Price:         price,
-			Type:          property.Type,
-			Address:       property.Address,
-			ZipCode:       property.ZipCode,
-			Images:        property.Images,
-			OwnerId:       property.OwnerID.UUID.String(),
-			NoOfBedrooms:  int32(noOfBedRooms),
-			NoOfBathrooms: int32(noOfBathRooms),
-			NoOfToilets:   int32(noOfToilets),
-			GeoLocation:   string(property.GeoLocation.RawMessage),
-			Status:        property.Status,
-			CreatedAt:     timestamppb.New(property.CreatedAt),
-			UpdatedAt:     timestamppb.New(property.UpdatedAt),
+	propertyResponses := make([]*pb.Property, len(properties))
+	for i, property := range properties {
+		propertyResponses[i], err = toPbProperty(property)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return &pb.GetPropertiesByOwnerResponse{
+	return &pb.GetPropertiesByOrganizationResponse{
 		Properties: propertyResponses,
 	}, nil
 }
@@ -188,43 +162,9 @@ func (p *PropertyHandler) GetPropertyByID(ctx context.Context, req *pb.GetProper
 		return nil, status.Errorf(500, "Unable to get property: %v", err)
 	}
 
-	price, err := strconv.ParseFloat(property.Price, 64)
+	propertyResponse, err := toPbProperty(property)
 	if err != nil {
-		return nil, status.Errorf(500, "Unable to parse price: %v", err)
-	}
-
-	noOfBedRooms, err := strconv.Atoi(property.NoOfBedRooms)
-	if err != nil {
-		return nil, status.Errorf(500, "Unable to parse no of bedrooms: %v", err)
-	}
-
-	noOfBathRooms, err := strconv.Atoi(property.NoOfBathRooms)
-	if err != nil {
-		return nil, status.Errorf(500, "Unable to parse no of bathrooms: %v", err)
-	}
-
-	noOfToilets, err := strconv.Atoi(property.NoOfToilets)
-	if err != nil {
-		return nil, status.Errorf(500, "Unable to parse no of toilets: %v", err)
-	}
-
-	propertyResponse := &pb.Property{
-		Id:            property.ID.String(),
-		Title:         property.Title,
-		Description:   property.Description,
-		Price:         price,
-		Type:          property.Type,
-		Address:       property.Address,
-		ZipCode:       property.ZipCode,
-		Images:        property.Images,
-		OwnerId:       property.OwnerID.UUID.String(),
-		NoOfBedrooms:  int32(noOfBedRooms),
-		NoOfBathrooms: int32(noOfBathRooms),
-		NoOfToilets:   int32(noOfToilets),
-		GeoLocation:   string(property.GeoLocation.RawMessage),
-		Status:        property.Status,
-		CreatedAt:     timestamppb.New(property.CreatedAt),
-		UpdatedAt:     timestamppb.New(property.UpdatedAt),
+		return nil, err
 	}
 
 	return &pb.GetPropertyByIDResponse{
@@ -246,8 +186,8 @@ func (p *PropertyHandler) UpdateProperty(ctx context.Context, req *pb.UpdateProp
 		return nil, status.Errorf(500, "Unable to get property: %v", err)
 	}
 
-	// Allow only owner to update property
-	if UserProperty.OwnerID.UUID.String() != req.GetOwnerId() {
+	// Allow only the owner, or the managers of its organization, to update property
+	if !canManageProperty(ctx, UserProperty) {
 		return nil, status.Errorf(401, "You are not authorized to update this property")
 	}
 
@@ -292,8 +232,8 @@ func (p *PropertyHandler) DeleteProperty(ctx context.Context, req *pb.DeleteProp
 		return nil, status.Errorf(500, "Unable to get property: %v", err)
 	}
 
-	// Allow only owner to update property
-	if UserProperty.OwnerID.UUID.String() != req.GetOwnerId() {
+	// Allow only the owner, or the managers of its organization, to update property
+	if !canManageProperty(ctx, UserProperty) {
 		return nil, status.Errorf(401, "You are not authorized to update this property")
 	}
 
@@ -305,3 +245,80 @@ func (p *PropertyHandler) DeleteProperty(ctx context.Context, req *pb.DeleteProp
 
 	return &pb.DeletePropertyResponse{}, nil
 }
+
+// canManageProperty reports whether the caller may change the property: its
+// owner always can, and so can owners and managers of the organization it is
+// listed under while acting for that organization.
+func canManageProperty(ctx context.Context, property *entity.Property) bool {
+	payload, ok := middleware.PayloadFromContext(ctx)
+	if !ok {
+		return false
+	}
+	if property.OwnerID.Valid && property.OwnerID.UUID.String() == payload.UserID {
+		return true
+	}
+	if !property.OrganizationID.Valid || !payload.InOrganization(property.OrganizationID.UUID.String()) {
+		return false
+	}
+	return rbac.OrgRole(payload.OrganizationRole).CanManageListings()
+}
+
+// callerFromContext returns the user whose access token came with the call
+func callerFromContext(ctx context.Context) (*token.Payload, error) {
+	payload, ok := middleware.PayloadFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "caller identity not found")
+	}
+	return payload, nil
+}
+
+// toPbProperty converts a property to its API representation. The counts and
+// price are stored as strings, so a malformed value is an internal error.
+func toPbProperty(property *entity.Property) (*pb.Property, error) {
+	price, err := strconv.ParseFloat(property.Price, 64)
+	if err != nil {
+		return nil, status.Errorf(500, "Unable to parse price: %v", err)
+	}
+
+	noOfBedRooms, err := strconv.Atoi(property.NoOfBedRooms)
+	if err != nil {
+		return nil, status.Errorf(500, "Unable to parse no of bedrooms: %v", err)
+	}
+
+	noOfBathRooms, err := strconv.Atoi(property.NoOfBathRooms)
+	if err != nil {
+		return nil, status.Errorf(500, "Unable to parse no of bathrooms: %v", err)
+	}
+
+	noOfToilets, err := strconv.Atoi(property.NoOfToilets)
+	if err != nil {
+		return nil, status.Errorf(500, "Unable to parse no of toilets: %v", err)
+	}
+
+	return &pb.Property{
+		Id:             property.ID.String(),
+		Title:          property.Title,
+		Description:    property.Description,
+		Price:          price,
+		Type:           property.Type,
+		Address:        property.Address,
+		ZipCode:        property.ZipCode,
+		Images:         property.Images,
+		OwnerId:        property.OwnerID.UUID.String(),
+		NoOfBedrooms:   int32(noOfBedRooms),
+		NoOfBathrooms:  int32(noOfBathRooms),
+		NoOfToilets:    int32(noOfToilets),
+		GeoLocation:    string(property.GeoLocation.RawMessage),
+		Status:         property.Status,
+		CreatedAt:      timestamppb.New(property.CreatedAt),
+		UpdatedAt:      timestamppb.New(property.UpdatedAt),
+		OrganizationId: organizationID(property),
+	}, nil
+}
+
+func organizationID(property *entity.Property) string {
+	if !property.OrganizationID.Valid {
+		return ""
+	}
+	return property.OrganizationID.UUID.String()
+}
@@ -13,4 +13,6 @@ type PropertyRepository interface {
 	UpdateProperty(property *entity.Property) error
 	DeleteProperty(id uuid.UUID) error
 	GetPropertiesByOwner(ownerID uuid.NullUUID, limit, offset int32) ([]*entity.Property, error)
+	DeletePropertiesByOwner(ownerID uuid.UUID) ([]uuid.UUID, error)
+	GetPropertiesByOrganization(organizationID uuid.UUID, limit, offset int32) ([]*entity.Property, error)
 }
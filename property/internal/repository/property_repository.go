@@ -42,20 +42,21 @@ func (r *PropertyRepository) CreateProperty(property *entity.Property) error {
 	}
 
 	arg := db.InsertPropertyParams{
-		ID:            uuid.New(),
-		Title:         property.Title,
-		Description:   sql.NullString{String: property.Description, Valid: true},
-		Price:         property.Price,
-		Type:          db.NullPropertyType{PropertyType: db.PropertyType(property.Type), Valid: true},
-		Address:       property.Address,
-		ZipCode:       sql.NullString{String: property.ZipCode, Valid: true},
-		OwnerID:       property.OwnerID,
-		Images:        property.Images,
-		NoOfBedRooms:  sql.NullInt32{Int32: int32(NoOfBedRoom), Valid: true},
-		NoOfBathRooms: sql.NullInt32{Int32: int32(NoOfBathRooms), Valid: true},
-		NoOfToilets:   sql.NullInt32{Int32: int32(NoOfToilets), Valid: true},
-		GeoLocation:   property.GeoLocation,
-		Status:        db.NullPropertyStatus{PropertyStatus: db.PropertyStatus(property.Status), Valid: true},
+		ID:             uuid.New(),
+		Title:          property.Title,
+		Description:    sql.NullString{String: property.Description, Valid: true},
+		Price:          property.Price,
+		Type:           db.NullPropertyType{PropertyType: db.PropertyType(property.Type), Valid: true},
+		Address:        property.Address,
+		ZipCode:        sql.NullString{String: property.ZipCode, Valid: true},
+		OwnerID:        property.OwnerID,
+		Images:         property.Images,
+		NoOfBedRooms:   sql.NullInt32{Int32: int32(NoOfBedRoom), Valid: true},
+		NoOfBathRooms:  sql.NullInt32{Int32: int32(NoOfBathRooms), Valid: true},
+		NoOfToilets:    sql.NullInt32{Int32: int32(NoOfToilets), Valid: true},
+		GeoLocation:    property.GeoLocation,
+		Status:         db.NullPropertyStatus{PropertyStatus: db.PropertyStatus(property.Status), Valid: true},
+		OrganizationID: property.OrganizationID,
 	}
 
 	_, err = r.store.InsertProperty(context.Background(), arg)
@@ -104,24 +105,7 @@ func (r *PropertyRepository) GetPropertyByID(id uuid.UUID) (*entity.Property, er
 		return nil, err
 	}
 
-	return &entity.Property{
-		ID:            property.ID,
-		Title:         property.Title,
-		Description:   property.Description.String,
-		Price:         property.Price,
-		Type:          string(property.Type.PropertyType),
-		Address:       property.Address,
-		ZipCode:       property.ZipCode.String,
-		OwnerID:       property.OwnerID,
-		Images:        property.Images,
-		NoOfBedRooms:  strconv.Itoa(int(property.NoOfBedRooms.Int32)),
-		NoOfBathRooms: strconv.Itoa(int(property.NoOfBathRooms.Int32)),
-		NoOfToilets:   strconv.Itoa(int(property.NoOfToilets.Int32)),
-		GeoLocation:   property.GeoLocation,
-		Status:        string(property.Status.PropertyStatus),
-		CreatedAt:     property.CreatedAt.Time,
-		UpdatedAt:     property.UpdatedAt.Time,
-	}, nil
+	return mapProperty(property), nil
 
 }
 
@@ -136,24 +120,7 @@ func (r *PropertyRepository) GetProperties(limit, offset int32) ([]*entity.Prope
 
 	result := make([]*entity.Property, len(properties))
 	for i, property := range properties {
-		result[i] = &entity.Property{
-			ID:            property.ID,
-			Title:         property.Title,
-			Description:   property.Description.String,
-			Price:         property.Price,
-			Type:          string(property.Type.PropertyType),
-			Address:       property.Address,
-			ZipCode:       property.ZipCode.String,
-			OwnerID:       property.OwnerID,
-			Images:        property.Images,
-			NoOfBedRooms:  strconv.Itoa(int(property.NoOfBedRooms.Int32)),
-			NoOfBathRooms: strconv.Itoa(int(property.NoOfBathRooms.Int32)),
-			NoOfToilets:   strconv.Itoa(int(property.NoOfToilets.Int32)),
-			GeoLocation:   property.GeoLocation,
-			Status:        string(property.Status.PropertyStatus),
-			CreatedAt:     property.CreatedAt.Time,
-			UpdatedAt:     property.UpdatedAt.Time,
-		}
+		result[i] = mapProperty(property)
 	}
 
 	return result, nil
@@ -171,24 +138,26 @@ func (r *PropertyRepository) GetPropertiesByOwner(ownerID uuid.NullUUID, limit,
 
 	result := make([]*entity.Property, len(properties))
 	for i, property := range properties {
-		result[i] = &entity.Property{
-			ID:            property.ID,
-			Title:         property.Title,
-			Description:   property.Description.String,
-			Price:         property.Price,
-			Type:          string(property.Type.PropertyType),
-			Address:       property.Address,
-			ZipCode:       property.ZipCode.String,
-			OwnerID:       property.OwnerID,
-			Images:        property.Images,
-			NoOfBedRooms:  strconv.Itoa(int(property.NoOfBedRooms.Int32)),
-			NoOfBathRooms: strconv.Itoa(int(property.NoOfBathRooms.Int32)),
-			NoOfToilets:   strconv.Itoa(int(property.NoOfToilets.Int32)),
-			GeoLocation:   property.GeoLocation,
-			Status:        string(property.Status.PropertyStatus),
-			CreatedAt:     property.CreatedAt.Time,
-			UpdatedAt:     property.UpdatedAt.Time,
-		}
+		result[i] = mapProperty(property)
+	}
+
+	return result, nil
+}
+
+// GetPropertiesByOrganization retrieves the listings of an organization, newest first
+func (r *PropertyRepository) GetPropertiesByOrganization(organizationID uuid.UUID, limit, offset int32) ([]*entity.Property, error) {
+	properties, err := r.store.GetPropertiesByOrganizationID(context.Background(), db.GetPropertiesByOrganizationIDParams{
+		OrganizationID: uuid.NullUUID{UUID: organizationID, Valid: true},
+		Limit:          limit,
+		Offset:         offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Property, len(properties))
+	for i, property := range properties {
+		result[i] = mapProperty(property)
 	}
 
 	return result, nil
@@ -197,3 +166,30 @@ func (r *PropertyRepository) GetPropertiesByOwner(ownerID uuid.NullUUID, limit,
 func (r *PropertyRepository) DeleteProperty(id uuid.UUID) error {
 	return r.store.DeleteProperty(context.Background(), id)
 }
+
+// DeletePropertiesByOwner deletes every listing of an owner and returns the IDs of the deleted listings
+func (r *PropertyRepository) DeletePropertiesByOwner(ownerID uuid.UUID) ([]uuid.UUID, error) {
+	return r.store.DeletePropertiesByOwnerID(context.Background(), uuid.NullUUID{UUID: ownerID, Valid: true})
+}
+
+func mapProperty(property db.Property) *entity.Property {
+	return &entity.Property{
+		ID:             property.ID,
+		Title:          property.Title,
+		Description:    property.Description.String,
+		Price:          property.Price,
+		Type:           string(property.Type.PropertyType),
+		Address:        property.Address,
+		ZipCode:        property.ZipCode.String,
+		OwnerID:        property.OwnerID,
+		OrganizationID: property.OrganizationID,
+		Images:         property.Images,
+		NoOfBedRooms:   strconv.Itoa(int(property.NoOfBedRooms.Int32)),
+		NoOfBathRooms:  strconv.Itoa(int(property.NoOfBathRooms.Int32)),
+		NoOfToilets:    strconv.Itoa(int(property.NoOfToilets.Int32)),
+		GeoLocation:    property.GeoLocation,
+		Status:         string(property.Status.PropertyStatus),
+		CreatedAt:      property.CreatedAt.Time,
+		UpdatedAt:      property.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/demola234/property/internal/domain/entity"
+	"github.com/demola234/property/internal/domain/repository"
+	"github.com/demola234/shared/events"
+
+	"github.com/google/uuid"
+)
+
+// ErasureUsecase removes the listings of users whose accounts were deleted
+type ErasureUsecase interface {
+	HandleMessage(ctx context.Context, key, value []byte) error
+}
+
+type erasureUsecase struct {
+	propertyRepo repository.PropertyRepository
+	messageQueue entity.MessageQueue
+	reports      entity.MessageQueue
+}
+
+// NewErasureUsecase creates an ErasureUsecase that publishes property events
+// to messageQueue and its erasure reports to reports.
+func NewErasureUsecase(propertyRepo repository.PropertyRepository, mq entity.MessageQueue, reports entity.MessageQueue) ErasureUsecase {
+	return &erasureUsecase{
+		propertyRepo: propertyRepo,
+		messageQueue: mq,
+		reports:      reports,
+	}
+}
+
+// HandleMessage deletes every listing of a deleted user and reports back to
+// the authentication service. A user.deleted event can arrive more than once;
+// the second time there is simply nothing left to delete.
+func (e *erasureUsecase) HandleMessage(ctx context.Context, key, value []byte) error {
+	if string(key) != events.UserDeletedKey {
+		return nil
+	}
+
+	var event events.UserDeleted
+	if err := json.Unmarshal(value, &event); err != nil {
+		log.Printf("dropping malformed %s event: %v", events.UserDeletedKey, err)
+		return nil
+	}
+
+	ownerID, err := uuid.Parse(event.UserID)
+	if err != nil {
+		log.Printf("dropping %s event with invalid user ID %q", events.UserDeletedKey, event.UserID)
+		return nil
+	}
+
+	ids, err := e.propertyRepo.DeletePropertiesByOwner(ownerID)
+	if err != nil {
+		e.report(ctx, event.UserID, events.ErasureFailed, 0, "failed to delete listings")
+		return fmt.Errorf("failed to delete properties of user %s: %w", ownerID, err)
+	}
+
+	// Listings are gone either way, so a search index missing the event
+	// should not hold up the report
+	for _, id := range ids {
+		eventData, err := json.Marshal(map[string]interface{}{
+			"event": "property_deleted",
+			"id":    id.String(),
+		})
+		if err != nil {
+			log.Printf("Failed to marshal delete event data: %v", err)
+			continue
+		}
+		if err := e.messageQueue.PublishMessage(ctx, []byte("property_deleted"), eventData); err != nil {
+			log.Printf("Failed to publish property deleted event: %v", err)
+		}
+	}
+
+	return e.report(ctx, event.UserID, events.ErasureCompleted, int64(len(ids)), "")
+}
+
+func (e *erasureUsecase) report(ctx context.Context, userID, status string, items int64, detail string) error {
+	eventData, err := json.Marshal(events.ErasureReport{
+		UserID:     userID,
+		Service:    events.ServiceProperty,
+		Status:     status,
+		Items:      items,
+		Detail:     detail,
+		ReportedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal erasure report: %w", err)
+	}
+
+	if err := e.reports.PublishMessage(ctx, []byte(events.ErasureReportKey), eventData); err != nil {
+		return fmt.Errorf("failed to publish erasure report: %w", err)
+	}
+
+	return nil
+}
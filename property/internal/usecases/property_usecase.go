@@ -16,6 +16,7 @@ type PropertyUsecase interface {
 	CreateProperty(ctx context.Context, property *entity.Property) error
 	GetProperties(ctx context.Context, limit, offset int32) ([]*entity.Property, error)
 	GetPropertiesByOwner(ctx context.Context, ownerID uuid.NullUUID, limit, offset int32) ([]*entity.Property, error)
+	GetPropertiesByOrganization(ctx context.Context, organizationID uuid.UUID, limit, offset int32) ([]*entity.Property, error)
 	GetPropertyByID(ctx context.Context, id uuid.UUID) (*entity.Property, error)
 	UpdateProperty(ctx context.Context, property *entity.Property) error
 	DeleteProperty(ctx context.Context, id uuid.UUID) error
@@ -89,6 +90,12 @@ func (p *propertyUsecase) GetPropertiesByOwner(ctx context.Context, ownerID uuid
 	return p.propertyRepo.GetPropertiesByOwner(ownerID, limit, offset)
 }
 
+// GetPropertiesByOrganization retrieves a list of properties listed under an organization.
+func (p *propertyUsecase) GetPropertiesByOrganization(ctx context.Context, organizationID uuid.UUID, limit, offset int32) ([]*entity.Property, error) {
+
+	return p.propertyRepo.GetPropertiesByOrganization(organizationID, limit, offset)
+}
+
 // GetPropertyByID retrieves a single property by its ID.
 func (p *propertyUsecase) GetPropertyByID(ctx context.Context, id uuid.UUID) (*entity.Property, error) {
 
@@ -0,0 +1,51 @@
+package events
+
+import "time"
+
+// Keys of the events services exchange about user accounts
+const (
+	// UserDeletedKey is published by the authentication service once an
+	// account has been erased, after its deletion grace period
+	UserDeletedKey = "user.deleted"
+	// ErasureReportKey is published by every service that holds user data
+	// once it has handled a UserDeleted event
+	ErasureReportKey = "user.erasure_report"
+)
+
+// Services that erase their copy of a deleted user's data
+const (
+	ServiceProperty  = "property"
+	ServiceMessaging = "messaging"
+)
+
+// ErasureServices are the services the authentication service waits on
+// before an erasure is complete
+var ErasureServices = []string{ServiceProperty, ServiceMessaging}
+
+// Erasure statuses reported by a service
+const (
+	ErasureCompleted = "completed"
+	ErasureFailed    = "failed"
+)
+
+// DeletedUserID replaces a deleted user's ID wherever a record is kept for
+// the other people it belongs to, such as a conversation
+const DeletedUserID = "deleted-user"
+
+// UserDeleted tells other services to erase or anonymize a user's data
+type UserDeleted struct {
+	UserID      string    `json:"user_id"`
+	RequestedAt time.Time `json:"requested_at"`
+	DeletedAt   time.Time `json:"deleted_at"`
+}
+
+// ErasureReport is a service's answer to UserDeleted. Items counts the records
+// removed or anonymized; Detail explains a failure.
+type ErasureReport struct {
+	UserID     string    `json:"user_id"`
+	Service    string    `json:"service"`
+	Status     string    `json:"status"`
+	Items      int64     `json:"items"`
+	Detail     string    `json:"detail,omitempty"`
+	ReportedAt time.Time `json:"reported_at"`
+}
@@ -0,0 +1,53 @@
+package rbac
+
+import (
+	"errors"
+	"strings"
+)
+
+// OrgRole is a member's role within an organization such as an agency. It
+// applies on top of the member's own Role.
+type OrgRole string
+
+const (
+	OrgRoleOwner   OrgRole = "owner"
+	OrgRoleManager OrgRole = "manager"
+	OrgRoleAgent   OrgRole = "agent"
+)
+
+var ErrInvalidOrgRole = errors.New("invalid organization role")
+
+// ParseOrgRole converts a stored organization role name into an OrgRole
+func ParseOrgRole(role string) (OrgRole, error) {
+	r := OrgRole(strings.ToLower(strings.TrimSpace(role)))
+	switch r {
+	case OrgRoleOwner, OrgRoleManager, OrgRoleAgent:
+		return r, nil
+	}
+
+	return "", ErrInvalidOrgRole
+}
+
+// CanManageMembers reports whether the role may invite and remove members
+func (r OrgRole) CanManageMembers() bool {
+	return r == OrgRoleOwner || r == OrgRoleManager
+}
+
+// CanAssign reports whether the role may give a member the role other.
+// Managers may only bring in agents; owners may assign any role.
+func (r OrgRole) CanAssign(other OrgRole) bool {
+	switch r {
+	case OrgRoleOwner:
+		return true
+	case OrgRoleManager:
+		return other == OrgRoleAgent
+	}
+
+	return false
+}
+
+// CanManageListings reports whether the role may change every listing of the
+// organization rather than only the member's own
+func (r OrgRole) CanManageListings() bool {
+	return r == OrgRoleOwner || r == OrgRoleManager
+}
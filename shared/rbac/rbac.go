@@ -0,0 +1,130 @@
+package rbac
+
+import (
+	"errors"
+	"strings"
+)
+
+// Role is a named set of permissions assigned to a user
+type Role string
+
+const (
+	RoleBuyer  Role = "buyer"
+	RoleSeller Role = "seller"
+	RoleAgent  Role = "agent"
+	RoleAdmin  Role = "admin"
+)
+
+// DefaultRole is assigned when a user registers without choosing a role
+const DefaultRole = RoleBuyer
+
+// Permission is a single action a caller may be allowed to perform
+type Permission string
+
+const (
+	PermPropertyRead   Permission = "property:read"
+	PermPropertyCreate Permission = "property:create"
+	PermPropertyUpdate Permission = "property:update"
+	PermPropertyDelete Permission = "property:delete"
+	PermMessageRead    Permission = "message:read"
+	PermMessageSend    Permission = "message:send"
+	PermUserAdmin      Permission = "user:admin"
+
+	// PermOrganizationRead lets an API key read the organizations of the user
+	// who created it. Signed in users are never checked for it.
+	PermOrganizationRead Permission = "organization:read"
+)
+
+var ErrInvalidRole = errors.New("invalid role")
+
+// rolePermissions defines which permissions every role grants
+var rolePermissions = map[Role][]Permission{
+	RoleBuyer: {
+		PermPropertyRead,
+		PermMessageRead,
+		PermMessageSend,
+		PermOrganizationRead,
+	},
+	RoleSeller: {
+		PermPropertyRead,
+		PermPropertyCreate,
+		PermPropertyUpdate,
+		PermPropertyDelete,
+		PermMessageRead,
+		PermMessageSend,
+		PermOrganizationRead,
+	},
+	RoleAgent: {
+		PermPropertyRead,
+		PermPropertyCreate,
+		PermPropertyUpdate,
+		PermPropertyDelete,
+		PermMessageRead,
+		PermMessageSend,
+		PermOrganizationRead,
+	},
+	RoleAdmin: {
+		PermPropertyRead,
+		PermPropertyCreate,
+		PermPropertyUpdate,
+		PermPropertyDelete,
+		PermMessageRead,
+		PermMessageSend,
+		PermOrganizationRead,
+		PermUserAdmin,
+	},
+}
+
+// ParseRole converts a stored role name into a Role
+func ParseRole(role string) (Role, error) {
+	r := Role(strings.ToLower(strings.TrimSpace(role)))
+	if _, ok := rolePermissions[r]; !ok {
+		return "", ErrInvalidRole
+	}
+
+	return r, nil
+}
+
+// IsSelfAssignable reports whether users may pick the role themselves at registration
+func (r Role) IsSelfAssignable() bool {
+	return r == RoleBuyer || r == RoleSeller || r == RoleAgent
+}
+
+// Permissions returns the permissions granted to the role
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+// ScopesForRoles returns the de-duplicated permission names granted by the given roles.
+// Unknown roles grant nothing.
+func ScopesForRoles(roles []string) []string {
+	seen := make(map[Permission]bool)
+	scopes := make([]string, 0)
+
+	for _, name := range roles {
+		role, err := ParseRole(name)
+		if err != nil {
+			continue
+		}
+
+		for _, perm := range role.Permissions() {
+			if !seen[perm] {
+				seen[perm] = true
+				scopes = append(scopes, string(perm))
+			}
+		}
+	}
+
+	return scopes
+}
+
+// HasPermission reports whether the scopes contain the permission
+func HasPermission(scopes []string, perm Permission) bool {
+	for _, scope := range scopes {
+		if scope == string(perm) {
+			return true
+		}
+	}
+
+	return false
+}